@@ -21,6 +21,109 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ResolutionStatus 事件进展状态
+type ResolutionStatus int32
+
+const (
+	ResolutionStatus_RESOLUTION_STATUS_UNSPECIFIED ResolutionStatus = 0 // 未设置（无后续进展）
+	ResolutionStatus_RESOLUTION_STATUS_ONGOING     ResolutionStatus = 1 // 进行中
+	ResolutionStatus_RESOLUTION_STATUS_ESCALATED   ResolutionStatus = 2 // 已升级（如已起诉、已仲裁）
+	ResolutionStatus_RESOLUTION_STATUS_RESOLVED    ResolutionStatus = 3 // 已解决（如工资已到账）
+)
+
+// Enum value maps for ResolutionStatus.
+var (
+	ResolutionStatus_name = map[int32]string{
+		0: "RESOLUTION_STATUS_UNSPECIFIED",
+		1: "RESOLUTION_STATUS_ONGOING",
+		2: "RESOLUTION_STATUS_ESCALATED",
+		3: "RESOLUTION_STATUS_RESOLVED",
+	}
+	ResolutionStatus_value = map[string]int32{
+		"RESOLUTION_STATUS_UNSPECIFIED": 0,
+		"RESOLUTION_STATUS_ONGOING":     1,
+		"RESOLUTION_STATUS_ESCALATED":   2,
+		"RESOLUTION_STATUS_RESOLVED":    3,
+	}
+)
+
+func (x ResolutionStatus) Enum() *ResolutionStatus {
+	p := new(ResolutionStatus)
+	*p = x
+	return p
+}
+
+func (x ResolutionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ResolutionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_content_v1_content_proto_enumTypes[0].Descriptor()
+}
+
+func (ResolutionStatus) Type() protoreflect.EnumType {
+	return &file_content_v1_content_proto_enumTypes[0]
+}
+
+func (x ResolutionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ResolutionStatus.Descriptor instead.
+func (ResolutionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{0}
+}
+
+// PostView 列表返回的帖子字段
+type PostView int32
+
+const (
+	PostView_POST_VIEW_UNSPECIFIED PostView = 0 // 未设置（等同 FULL）
+	PostView_POST_VIEW_BASIC       PostView = 1 // 摘要：返回 summary，不返回 content 和 content_html
+	PostView_POST_VIEW_FULL        PostView = 2 // 完整内容
+)
+
+// Enum value maps for PostView.
+var (
+	PostView_name = map[int32]string{
+		0: "POST_VIEW_UNSPECIFIED",
+		1: "POST_VIEW_BASIC",
+		2: "POST_VIEW_FULL",
+	}
+	PostView_value = map[string]int32{
+		"POST_VIEW_UNSPECIFIED": 0,
+		"POST_VIEW_BASIC":       1,
+		"POST_VIEW_FULL":        2,
+	}
+)
+
+func (x PostView) Enum() *PostView {
+	p := new(PostView)
+	*p = x
+	return p
+}
+
+func (x PostView) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PostView) Descriptor() protoreflect.EnumDescriptor {
+	return file_content_v1_content_proto_enumTypes[1].Descriptor()
+}
+
+func (PostView) Type() protoreflect.EnumType {
+	return &file_content_v1_content_proto_enumTypes[1]
+}
+
+func (x PostView) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PostView.Descriptor instead.
+func (PostView) EnumDescriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{1}
+}
+
 // CreatePostRequest 创建请求
 type CreatePostRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -100,11 +203,12 @@ func (x *CreatePostRequest) GetOccurredAt() int64 {
 
 // CreatePostResponse 创建响应
 type CreatePostResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`           // 帖子 ID
-	CreatedAt     int64                  `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // 创建时间（Unix 时间戳）
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PostId          string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`                            // 帖子 ID
+	CreatedAt       int64                  `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                  // 创建时间（Unix 时间戳）
+	ManagementToken string                 `protobuf:"bytes,3,opt,name=management_token,json=managementToken,proto3" json:"management_token,omitempty"` // 管理令牌（仅在创建时返回一次，用于追加后续进展）
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *CreatePostResponse) Reset() {
@@ -151,14 +255,23 @@ func (x *CreatePostResponse) GetCreatedAt() int64 {
 	return 0
 }
 
+func (x *CreatePostResponse) GetManagementToken() string {
+	if x != nil {
+		return x.ManagementToken
+	}
+	return ""
+}
+
 // ListPostsRequest 列表请求
 type ListPostsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CityCode      string                 `protobuf:"bytes,1,opt,name=city_code,json=cityCode,proto3" json:"city_code,omitempty"`  // 城市代码
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                         // 页码（从 1 开始）
-	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CityCode         string                 `protobuf:"bytes,1,opt,name=city_code,json=cityCode,proto3" json:"city_code,omitempty"`                                                           // 城市代码
+	Page             int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                                                                                  // 页码（从 1 开始）
+	PageSize         int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                                                          // 每页数量
+	ResolutionStatus ResolutionStatus       `protobuf:"varint,4,opt,name=resolution_status,json=resolutionStatus,proto3,enum=content.v1.ResolutionStatus" json:"resolution_status,omitempty"` // 进展状态过滤（可选，UNSPECIFIED 表示不过滤）
+	View             PostView               `protobuf:"varint,5,opt,name=view,proto3,enum=content.v1.PostView" json:"view,omitempty"`                                                         // 返回字段（可选，默认 FULL）
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *ListPostsRequest) Reset() {
@@ -212,6 +325,20 @@ func (x *ListPostsRequest) GetPageSize() int32 {
 	return 0
 }
 
+func (x *ListPostsRequest) GetResolutionStatus() ResolutionStatus {
+	if x != nil {
+		return x.ResolutionStatus
+	}
+	return ResolutionStatus_RESOLUTION_STATUS_UNSPECIFIED
+}
+
+func (x *ListPostsRequest) GetView() PostView {
+	if x != nil {
+		return x.View
+	}
+	return PostView_POST_VIEW_UNSPECIFIED
+}
+
 // ListPostsResponse 列表响应
 type ListPostsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -281,6 +408,295 @@ func (x *ListPostsResponse) GetPageSize() int32 {
 	return 0
 }
 
+// ListTrendingPostsRequest 热门列表请求
+type ListTrendingPostsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CityCode      string                 `protobuf:"bytes,1,opt,name=city_code,json=cityCode,proto3" json:"city_code,omitempty"`   // 城市代码（可选，为空表示所有城市）
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                          // 页码（从 1 开始）
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`  // 每页数量
+	View          PostView               `protobuf:"varint,4,opt,name=view,proto3,enum=content.v1.PostView" json:"view,omitempty"` // 返回字段（可选，默认 FULL）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrendingPostsRequest) Reset() {
+	*x = ListTrendingPostsRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrendingPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrendingPostsRequest) ProtoMessage() {}
+
+func (x *ListTrendingPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrendingPostsRequest.ProtoReflect.Descriptor instead.
+func (*ListTrendingPostsRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListTrendingPostsRequest) GetCityCode() string {
+	if x != nil {
+		return x.CityCode
+	}
+	return ""
+}
+
+func (x *ListTrendingPostsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListTrendingPostsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTrendingPostsRequest) GetView() PostView {
+	if x != nil {
+		return x.View
+	}
+	return PostView_POST_VIEW_UNSPECIFIED
+}
+
+// ListRelatedPostsRequest 相关内容请求
+type ListRelatedPostsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`         // 帖子 ID
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                        // 返回数量（可选，默认 5，最多 20）
+	View          PostView               `protobuf:"varint,3,opt,name=view,proto3,enum=content.v1.PostView" json:"view,omitempty"` // 返回字段（可选，默认 FULL）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRelatedPostsRequest) Reset() {
+	*x = ListRelatedPostsRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRelatedPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRelatedPostsRequest) ProtoMessage() {}
+
+func (x *ListRelatedPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRelatedPostsRequest.ProtoReflect.Descriptor instead.
+func (*ListRelatedPostsRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListRelatedPostsRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *ListRelatedPostsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListRelatedPostsRequest) GetView() PostView {
+	if x != nil {
+		return x.View
+	}
+	return PostView_POST_VIEW_UNSPECIFIED
+}
+
+// WatchPostsRequest 实时订阅请求
+type WatchPostsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CityCode      string                 `protobuf:"bytes,1,opt,name=city_code,json=cityCode,proto3" json:"city_code,omitempty"`   // 城市代码（可选，为空表示所有城市）
+	Company       string                 `protobuf:"bytes,2,opt,name=company,proto3" json:"company,omitempty"`                     // 公司名称（可选，忽略"有限公司"等写法差异）
+	View          PostView               `protobuf:"varint,3,opt,name=view,proto3,enum=content.v1.PostView" json:"view,omitempty"` // 返回字段（可选，默认 FULL）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchPostsRequest) Reset() {
+	*x = WatchPostsRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPostsRequest) ProtoMessage() {}
+
+func (x *WatchPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPostsRequest.ProtoReflect.Descriptor instead.
+func (*WatchPostsRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *WatchPostsRequest) GetCityCode() string {
+	if x != nil {
+		return x.CityCode
+	}
+	return ""
+}
+
+func (x *WatchPostsRequest) GetCompany() string {
+	if x != nil {
+		return x.Company
+	}
+	return ""
+}
+
+func (x *WatchPostsRequest) GetView() PostView {
+	if x != nil {
+		return x.View
+	}
+	return PostView_POST_VIEW_UNSPECIFIED
+}
+
+// WatchPostsResponse 实时推送的新帖子
+type WatchPostsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Post          *Post                  `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"` // 新发布的帖子
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchPostsResponse) Reset() {
+	*x = WatchPostsResponse{}
+	mi := &file_content_v1_content_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPostsResponse) ProtoMessage() {}
+
+func (x *WatchPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPostsResponse.ProtoReflect.Descriptor instead.
+func (*WatchPostsResponse) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *WatchPostsResponse) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+// ListMyPostsRequest 我的帖子列表请求（用户由访问令牌确定）
+type ListMyPostsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`                         // 页码（从 1 开始）
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyPostsRequest) Reset() {
+	*x = ListMyPostsRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyPostsRequest) ProtoMessage() {}
+
+func (x *ListMyPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyPostsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyPostsRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListMyPostsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListMyPostsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
 // GetPostRequest 详情请求
 type GetPostRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -291,7 +707,7 @@ type GetPostRequest struct {
 
 func (x *GetPostRequest) Reset() {
 	*x = GetPostRequest{}
-	mi := &file_content_v1_content_proto_msgTypes[4]
+	mi := &file_content_v1_content_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -303,7 +719,7 @@ func (x *GetPostRequest) String() string {
 func (*GetPostRequest) ProtoMessage() {}
 
 func (x *GetPostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_content_v1_content_proto_msgTypes[4]
+	mi := &file_content_v1_content_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -316,7 +732,7 @@ func (x *GetPostRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPostRequest.ProtoReflect.Descriptor instead.
 func (*GetPostRequest) Descriptor() ([]byte, []int) {
-	return file_content_v1_content_proto_rawDescGZIP(), []int{4}
+	return file_content_v1_content_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetPostRequest) GetPostId() string {
@@ -329,14 +745,16 @@ func (x *GetPostRequest) GetPostId() string {
 // GetPostResponse 详情响应
 type GetPostResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Post          *Post                  `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"` // 帖子详情
+	Post          *Post                  `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`                                        // 帖子详情
+	Timeline      []*FollowUp            `protobuf:"bytes,2,rep,name=timeline,proto3" json:"timeline,omitempty"`                                // 作者后续进展时间线（按时间正序）
+	OfficialReply *OfficialReply         `protobuf:"bytes,3,opt,name=official_reply,json=officialReply,proto3" json:"official_reply,omitempty"` // 企业官方回应（可选，未回应时为空）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetPostResponse) Reset() {
 	*x = GetPostResponse{}
-	mi := &file_content_v1_content_proto_msgTypes[5]
+	mi := &file_content_v1_content_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -348,7 +766,7 @@ func (x *GetPostResponse) String() string {
 func (*GetPostResponse) ProtoMessage() {}
 
 func (x *GetPostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_content_v1_content_proto_msgTypes[5]
+	mi := &file_content_v1_content_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -361,7 +779,7 @@ func (x *GetPostResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPostResponse.ProtoReflect.Descriptor instead.
 func (*GetPostResponse) Descriptor() ([]byte, []int) {
-	return file_content_v1_content_proto_rawDescGZIP(), []int{5}
+	return file_content_v1_content_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetPostResponse) GetPost() *Post {
@@ -371,20 +789,35 @@ func (x *GetPostResponse) GetPost() *Post {
 	return nil
 }
 
+func (x *GetPostResponse) GetTimeline() []*FollowUp {
+	if x != nil {
+		return x.Timeline
+	}
+	return nil
+}
+
+func (x *GetPostResponse) GetOfficialReply() *OfficialReply {
+	if x != nil {
+		return x.OfficialReply
+	}
+	return nil
+}
+
 // SearchPostsRequest 搜索请求
 type SearchPostsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Keyword       string                 `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`                    // 搜索关键词
-	CityCode      string                 `protobuf:"bytes,2,opt,name=city_code,json=cityCode,proto3" json:"city_code,omitempty"`  // 城市代码（可选，空字符串表示搜索所有城市）
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                         // 页码（从 1 开始）
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量
+	Keyword       string                 `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`                     // 搜索关键词
+	CityCode      string                 `protobuf:"bytes,2,opt,name=city_code,json=cityCode,proto3" json:"city_code,omitempty"`   // 城市代码（可选，空字符串表示搜索所有城市）
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                          // 页码（从 1 开始）
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`  // 每页数量
+	View          PostView               `protobuf:"varint,5,opt,name=view,proto3,enum=content.v1.PostView" json:"view,omitempty"` // 返回字段（可选，默认 FULL）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SearchPostsRequest) Reset() {
 	*x = SearchPostsRequest{}
-	mi := &file_content_v1_content_proto_msgTypes[6]
+	mi := &file_content_v1_content_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -396,7 +829,7 @@ func (x *SearchPostsRequest) String() string {
 func (*SearchPostsRequest) ProtoMessage() {}
 
 func (x *SearchPostsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_content_v1_content_proto_msgTypes[6]
+	mi := &file_content_v1_content_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -409,7 +842,7 @@ func (x *SearchPostsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchPostsRequest.ProtoReflect.Descriptor instead.
 func (*SearchPostsRequest) Descriptor() ([]byte, []int) {
-	return file_content_v1_content_proto_rawDescGZIP(), []int{6}
+	return file_content_v1_content_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *SearchPostsRequest) GetKeyword() string {
@@ -440,6 +873,13 @@ func (x *SearchPostsRequest) GetPageSize() int32 {
 	return 0
 }
 
+func (x *SearchPostsRequest) GetView() PostView {
+	if x != nil {
+		return x.View
+	}
+	return PostView_POST_VIEW_UNSPECIFIED
+}
+
 // SearchPostsResponse 搜索响应
 type SearchPostsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -453,7 +893,7 @@ type SearchPostsResponse struct {
 
 func (x *SearchPostsResponse) Reset() {
 	*x = SearchPostsResponse{}
-	mi := &file_content_v1_content_proto_msgTypes[7]
+	mi := &file_content_v1_content_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -465,7 +905,7 @@ func (x *SearchPostsResponse) String() string {
 func (*SearchPostsResponse) ProtoMessage() {}
 
 func (x *SearchPostsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_content_v1_content_proto_msgTypes[7]
+	mi := &file_content_v1_content_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -478,7 +918,7 @@ func (x *SearchPostsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchPostsResponse.ProtoReflect.Descriptor instead.
 func (*SearchPostsResponse) Descriptor() ([]byte, []int) {
-	return file_content_v1_content_proto_rawDescGZIP(), []int{7}
+	return file_content_v1_content_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *SearchPostsResponse) GetPosts() []*Post {
@@ -511,21 +951,24 @@ func (x *SearchPostsResponse) GetPageSize() int32 {
 
 // Post 帖子
 type Post struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                    // 帖子 ID
-	Company       string                 `protobuf:"bytes,2,opt,name=company,proto3" json:"company,omitempty"`                          // 公司名称
-	CityCode      string                 `protobuf:"bytes,3,opt,name=city_code,json=cityCode,proto3" json:"city_code,omitempty"`        // 城市代码
-	CityName      string                 `protobuf:"bytes,4,opt,name=city_name,json=cityName,proto3" json:"city_name,omitempty"`        // 城市名称
-	Content       string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`                          // 内容
-	OccurredAt    int64                  `protobuf:"varint,6,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"` // 发生时间（Unix 时间戳，0 表示未设置）
-	CreatedAt     int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`    // 创建时间（Unix 时间戳）
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                                                       // 帖子 ID
+	Company          string                 `protobuf:"bytes,2,opt,name=company,proto3" json:"company,omitempty"`                                                                             // 公司名称
+	CityCode         string                 `protobuf:"bytes,3,opt,name=city_code,json=cityCode,proto3" json:"city_code,omitempty"`                                                           // 城市代码
+	CityName         string                 `protobuf:"bytes,4,opt,name=city_name,json=cityName,proto3" json:"city_name,omitempty"`                                                           // 城市名称
+	Content          string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`                                                                             // 内容
+	OccurredAt       int64                  `protobuf:"varint,6,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`                                                    // 发生时间（Unix 时间戳，0 表示未设置）
+	CreatedAt        int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                                                       // 创建时间（Unix 时间戳）
+	ResolutionStatus ResolutionStatus       `protobuf:"varint,8,opt,name=resolution_status,json=resolutionStatus,proto3,enum=content.v1.ResolutionStatus" json:"resolution_status,omitempty"` // 最新进展状态
+	ContentHtml      string                 `protobuf:"bytes,9,opt,name=content_html,json=contentHtml,proto3" json:"content_html,omitempty"`                                                  // 内容渲染后的安全 HTML（content 为受限 Markdown）
+	Summary          string                 `protobuf:"bytes,10,opt,name=summary,proto3" json:"summary,omitempty"`                                                                            // 纯文本摘要（仅列表和搜索返回）
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Post) Reset() {
 	*x = Post{}
-	mi := &file_content_v1_content_proto_msgTypes[8]
+	mi := &file_content_v1_content_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -537,7 +980,7 @@ func (x *Post) String() string {
 func (*Post) ProtoMessage() {}
 
 func (x *Post) ProtoReflect() protoreflect.Message {
-	mi := &file_content_v1_content_proto_msgTypes[8]
+	mi := &file_content_v1_content_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -550,7 +993,7 @@ func (x *Post) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Post.ProtoReflect.Descriptor instead.
 func (*Post) Descriptor() ([]byte, []int) {
-	return file_content_v1_content_proto_rawDescGZIP(), []int{8}
+	return file_content_v1_content_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *Post) GetId() string {
@@ -602,6 +1045,295 @@ func (x *Post) GetCreatedAt() int64 {
 	return 0
 }
 
+func (x *Post) GetResolutionStatus() ResolutionStatus {
+	if x != nil {
+		return x.ResolutionStatus
+	}
+	return ResolutionStatus_RESOLUTION_STATUS_UNSPECIFIED
+}
+
+func (x *Post) GetContentHtml() string {
+	if x != nil {
+		return x.ContentHtml
+	}
+	return ""
+}
+
+func (x *Post) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+// FollowUp 后续进展
+type FollowUp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                           // 进展 ID
+	Status        ResolutionStatus       `protobuf:"varint,2,opt,name=status,proto3,enum=content.v1.ResolutionStatus" json:"status,omitempty"` // 进展状态
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`                                       // 进展内容
+	CreatedAt     int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`           // 追加时间（Unix 时间戳）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowUp) Reset() {
+	*x = FollowUp{}
+	mi := &file_content_v1_content_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowUp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowUp) ProtoMessage() {}
+
+func (x *FollowUp) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowUp.ProtoReflect.Descriptor instead.
+func (*FollowUp) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *FollowUp) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FollowUp) GetStatus() ResolutionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ResolutionStatus_RESOLUTION_STATUS_UNSPECIFIED
+}
+
+func (x *FollowUp) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *FollowUp) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// OfficialReply 企业官方回应（经域名验证的企业代表发布，每帖一条）
+type OfficialReply struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                               // 回应 ID
+	Company        string                 `protobuf:"bytes,2,opt,name=company,proto3" json:"company,omitempty"`                                     // 公司名称
+	VerifiedDomain string                 `protobuf:"bytes,3,opt,name=verified_domain,json=verifiedDomain,proto3" json:"verified_domain,omitempty"` // 已验证的企业域名
+	Content        string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`                                     // 回应内容
+	Badge          string                 `protobuf:"bytes,5,opt,name=badge,proto3" json:"badge,omitempty"`                                         // 展示徽章（如 VERIFIED_COMPANY）
+	CreatedAt      int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`               // 回应时间（Unix 时间戳）
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OfficialReply) Reset() {
+	*x = OfficialReply{}
+	mi := &file_content_v1_content_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OfficialReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfficialReply) ProtoMessage() {}
+
+func (x *OfficialReply) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfficialReply.ProtoReflect.Descriptor instead.
+func (*OfficialReply) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *OfficialReply) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OfficialReply) GetCompany() string {
+	if x != nil {
+		return x.Company
+	}
+	return ""
+}
+
+func (x *OfficialReply) GetVerifiedDomain() string {
+	if x != nil {
+		return x.VerifiedDomain
+	}
+	return ""
+}
+
+func (x *OfficialReply) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *OfficialReply) GetBadge() string {
+	if x != nil {
+		return x.Badge
+	}
+	return ""
+}
+
+func (x *OfficialReply) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// AppendFollowUpRequest 追加后续进展请求
+type AppendFollowUpRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PostId          string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`                            // 帖子 ID
+	ManagementToken string                 `protobuf:"bytes,2,opt,name=management_token,json=managementToken,proto3" json:"management_token,omitempty"` // 管理令牌
+	Status          ResolutionStatus       `protobuf:"varint,3,opt,name=status,proto3,enum=content.v1.ResolutionStatus" json:"status,omitempty"`        // 进展状态
+	Note            string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`                                              // 进展内容
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AppendFollowUpRequest) Reset() {
+	*x = AppendFollowUpRequest{}
+	mi := &file_content_v1_content_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppendFollowUpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppendFollowUpRequest) ProtoMessage() {}
+
+func (x *AppendFollowUpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppendFollowUpRequest.ProtoReflect.Descriptor instead.
+func (*AppendFollowUpRequest) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AppendFollowUpRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *AppendFollowUpRequest) GetManagementToken() string {
+	if x != nil {
+		return x.ManagementToken
+	}
+	return ""
+}
+
+func (x *AppendFollowUpRequest) GetStatus() ResolutionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ResolutionStatus_RESOLUTION_STATUS_UNSPECIFIED
+}
+
+func (x *AppendFollowUpRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+// AppendFollowUpResponse 追加后续进展响应
+type AppendFollowUpResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FollowUp      *FollowUp              `protobuf:"bytes,1,opt,name=follow_up,json=followUp,proto3" json:"follow_up,omitempty"` // 新追加的进展
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppendFollowUpResponse) Reset() {
+	*x = AppendFollowUpResponse{}
+	mi := &file_content_v1_content_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppendFollowUpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppendFollowUpResponse) ProtoMessage() {}
+
+func (x *AppendFollowUpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_content_v1_content_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppendFollowUpResponse.ProtoReflect.Descriptor instead.
+func (*AppendFollowUpResponse) Descriptor() ([]byte, []int) {
+	return file_content_v1_content_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AppendFollowUpResponse) GetFollowUp() *FollowUp {
+	if x != nil {
+		return x.FollowUp
+	}
+	return nil
+}
+
 var File_content_v1_content_proto protoreflect.FileDescriptor
 
 const file_content_v1_content_proto_rawDesc = "" +
@@ -614,34 +1346,58 @@ const file_content_v1_content_proto_rawDesc = "" +
 	"\tcity_name\x18\x03 \x01(\tR\bcityName\x12\x18\n" +
 	"\acontent\x18\x04 \x01(\tR\acontent\x12\x1f\n" +
 	"\voccurred_at\x18\x05 \x01(\x03R\n" +
-	"occurredAt\"L\n" +
+	"occurredAt\"w\n" +
 	"\x12CreatePostResponse\x12\x17\n" +
 	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\x02 \x01(\x03R\tcreatedAt\"`\n" +
+	"created_at\x18\x02 \x01(\x03R\tcreatedAt\x12)\n" +
+	"\x10management_token\x18\x03 \x01(\tR\x0fmanagementToken\"\xd5\x01\n" +
 	"\x10ListPostsRequest\x12\x1b\n" +
 	"\tcity_code\x18\x01 \x01(\tR\bcityCode\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\x82\x01\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12I\n" +
+	"\x11resolution_status\x18\x04 \x01(\x0e2\x1c.content.v1.ResolutionStatusR\x10resolutionStatus\x12(\n" +
+	"\x04view\x18\x05 \x01(\x0e2\x14.content.v1.PostViewR\x04view\"\x82\x01\n" +
 	"\x11ListPostsResponse\x12&\n" +
 	"\x05posts\x18\x01 \x03(\v2\x10.content.v1.PostR\x05posts\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\")\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x92\x01\n" +
+	"\x18ListTrendingPostsRequest\x12\x1b\n" +
+	"\tcity_code\x18\x01 \x01(\tR\bcityCode\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12(\n" +
+	"\x04view\x18\x04 \x01(\x0e2\x14.content.v1.PostViewR\x04view\"r\n" +
+	"\x17ListRelatedPostsRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12(\n" +
+	"\x04view\x18\x03 \x01(\x0e2\x14.content.v1.PostViewR\x04view\"t\n" +
+	"\x11WatchPostsRequest\x12\x1b\n" +
+	"\tcity_code\x18\x01 \x01(\tR\bcityCode\x12\x18\n" +
+	"\acompany\x18\x02 \x01(\tR\acompany\x12(\n" +
+	"\x04view\x18\x03 \x01(\x0e2\x14.content.v1.PostViewR\x04view\":\n" +
+	"\x12WatchPostsResponse\x12$\n" +
+	"\x04post\x18\x01 \x01(\v2\x10.content.v1.PostR\x04post\"E\n" +
+	"\x12ListMyPostsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\")\n" +
 	"\x0eGetPostRequest\x12\x17\n" +
-	"\apost_id\x18\x01 \x01(\tR\x06postId\"7\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\"\xab\x01\n" +
 	"\x0fGetPostResponse\x12$\n" +
-	"\x04post\x18\x01 \x01(\v2\x10.content.v1.PostR\x04post\"|\n" +
+	"\x04post\x18\x01 \x01(\v2\x10.content.v1.PostR\x04post\x120\n" +
+	"\btimeline\x18\x02 \x03(\v2\x14.content.v1.FollowUpR\btimeline\x12@\n" +
+	"\x0eofficial_reply\x18\x03 \x01(\v2\x19.content.v1.OfficialReplyR\rofficialReply\"\xa6\x01\n" +
 	"\x12SearchPostsRequest\x12\x18\n" +
 	"\akeyword\x18\x01 \x01(\tR\akeyword\x12\x1b\n" +
 	"\tcity_code\x18\x02 \x01(\tR\bcityCode\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x84\x01\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12(\n" +
+	"\x04view\x18\x05 \x01(\x0e2\x14.content.v1.PostViewR\x04view\"\x84\x01\n" +
 	"\x13SearchPostsResponse\x12&\n" +
 	"\x05posts\x18\x01 \x03(\v2\x10.content.v1.PostR\x05posts\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xc4\x01\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xcc\x02\n" +
 	"\x04Post\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
 	"\acompany\x18\x02 \x01(\tR\acompany\x12\x1b\n" +
@@ -651,13 +1407,53 @@ const file_content_v1_content_proto_rawDesc = "" +
 	"\voccurred_at\x18\x06 \x01(\x03R\n" +
 	"occurredAt\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\a \x01(\x03R\tcreatedAt2\xbb\x02\n" +
+	"created_at\x18\a \x01(\x03R\tcreatedAt\x12I\n" +
+	"\x11resolution_status\x18\b \x01(\x0e2\x1c.content.v1.ResolutionStatusR\x10resolutionStatus\x12!\n" +
+	"\fcontent_html\x18\t \x01(\tR\vcontentHtml\x12\x18\n" +
+	"\asummary\x18\n" +
+	" \x01(\tR\asummary\"\x83\x01\n" +
+	"\bFollowUp\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x124\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1c.content.v1.ResolutionStatusR\x06status\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\x03R\tcreatedAt\"\xb1\x01\n" +
+	"\rOfficialReply\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\acompany\x18\x02 \x01(\tR\acompany\x12'\n" +
+	"\x0fverified_domain\x18\x03 \x01(\tR\x0everifiedDomain\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12\x14\n" +
+	"\x05badge\x18\x05 \x01(\tR\x05badge\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\"\xa5\x01\n" +
+	"\x15AppendFollowUpRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\x12)\n" +
+	"\x10management_token\x18\x02 \x01(\tR\x0fmanagementToken\x124\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1c.content.v1.ResolutionStatusR\x06status\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\"K\n" +
+	"\x16AppendFollowUpResponse\x121\n" +
+	"\tfollow_up\x18\x01 \x01(\v2\x14.content.v1.FollowUpR\bfollowUp*\x95\x01\n" +
+	"\x10ResolutionStatus\x12!\n" +
+	"\x1dRESOLUTION_STATUS_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19RESOLUTION_STATUS_ONGOING\x10\x01\x12\x1f\n" +
+	"\x1bRESOLUTION_STATUS_ESCALATED\x10\x02\x12\x1e\n" +
+	"\x1aRESOLUTION_STATUS_RESOLVED\x10\x03*N\n" +
+	"\bPostView\x12\x19\n" +
+	"\x15POST_VIEW_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fPOST_VIEW_BASIC\x10\x01\x12\x12\n" +
+	"\x0ePOST_VIEW_FULL\x10\x022\xe3\x05\n" +
 	"\x0eContentService\x12K\n" +
 	"\n" +
 	"CreatePost\x12\x1d.content.v1.CreatePostRequest\x1a\x1e.content.v1.CreatePostResponse\x12H\n" +
 	"\tListPosts\x12\x1c.content.v1.ListPostsRequest\x1a\x1d.content.v1.ListPostsResponse\x12B\n" +
 	"\aGetPost\x12\x1a.content.v1.GetPostRequest\x1a\x1b.content.v1.GetPostResponse\x12N\n" +
-	"\vSearchPosts\x12\x1e.content.v1.SearchPostsRequest\x1a\x1f.content.v1.SearchPostsResponseB2Z0fuck_boss/backend/api/proto/content/v1;contentv1b\x06proto3"
+	"\vSearchPosts\x12\x1e.content.v1.SearchPostsRequest\x1a\x1f.content.v1.SearchPostsResponse\x12W\n" +
+	"\x0eAppendFollowUp\x12!.content.v1.AppendFollowUpRequest\x1a\".content.v1.AppendFollowUpResponse\x12L\n" +
+	"\vListMyPosts\x12\x1e.content.v1.ListMyPostsRequest\x1a\x1d.content.v1.ListPostsResponse\x12X\n" +
+	"\x11ListTrendingPosts\x12$.content.v1.ListTrendingPostsRequest\x1a\x1d.content.v1.ListPostsResponse\x12V\n" +
+	"\x10ListRelatedPosts\x12#.content.v1.ListRelatedPostsRequest\x1a\x1d.content.v1.ListPostsResponse\x12M\n" +
+	"\n" +
+	"WatchPosts\x12\x1d.content.v1.WatchPostsRequest\x1a\x1e.content.v1.WatchPostsResponse0\x01B2Z0fuck_boss/backend/api/proto/content/v1;contentv1b\x06proto3"
 
 var (
 	file_content_v1_content_proto_rawDescOnce sync.Once
@@ -671,35 +1467,70 @@ func file_content_v1_content_proto_rawDescGZIP() []byte {
 	return file_content_v1_content_proto_rawDescData
 }
 
-var file_content_v1_content_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_content_v1_content_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_content_v1_content_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_content_v1_content_proto_goTypes = []any{
-	(*CreatePostRequest)(nil),   // 0: content.v1.CreatePostRequest
-	(*CreatePostResponse)(nil),  // 1: content.v1.CreatePostResponse
-	(*ListPostsRequest)(nil),    // 2: content.v1.ListPostsRequest
-	(*ListPostsResponse)(nil),   // 3: content.v1.ListPostsResponse
-	(*GetPostRequest)(nil),      // 4: content.v1.GetPostRequest
-	(*GetPostResponse)(nil),     // 5: content.v1.GetPostResponse
-	(*SearchPostsRequest)(nil),  // 6: content.v1.SearchPostsRequest
-	(*SearchPostsResponse)(nil), // 7: content.v1.SearchPostsResponse
-	(*Post)(nil),                // 8: content.v1.Post
+	(ResolutionStatus)(0),            // 0: content.v1.ResolutionStatus
+	(PostView)(0),                    // 1: content.v1.PostView
+	(*CreatePostRequest)(nil),        // 2: content.v1.CreatePostRequest
+	(*CreatePostResponse)(nil),       // 3: content.v1.CreatePostResponse
+	(*ListPostsRequest)(nil),         // 4: content.v1.ListPostsRequest
+	(*ListPostsResponse)(nil),        // 5: content.v1.ListPostsResponse
+	(*ListTrendingPostsRequest)(nil), // 6: content.v1.ListTrendingPostsRequest
+	(*ListRelatedPostsRequest)(nil),  // 7: content.v1.ListRelatedPostsRequest
+	(*WatchPostsRequest)(nil),        // 8: content.v1.WatchPostsRequest
+	(*WatchPostsResponse)(nil),       // 9: content.v1.WatchPostsResponse
+	(*ListMyPostsRequest)(nil),       // 10: content.v1.ListMyPostsRequest
+	(*GetPostRequest)(nil),           // 11: content.v1.GetPostRequest
+	(*GetPostResponse)(nil),          // 12: content.v1.GetPostResponse
+	(*SearchPostsRequest)(nil),       // 13: content.v1.SearchPostsRequest
+	(*SearchPostsResponse)(nil),      // 14: content.v1.SearchPostsResponse
+	(*Post)(nil),                     // 15: content.v1.Post
+	(*FollowUp)(nil),                 // 16: content.v1.FollowUp
+	(*OfficialReply)(nil),            // 17: content.v1.OfficialReply
+	(*AppendFollowUpRequest)(nil),    // 18: content.v1.AppendFollowUpRequest
+	(*AppendFollowUpResponse)(nil),   // 19: content.v1.AppendFollowUpResponse
 }
 var file_content_v1_content_proto_depIdxs = []int32{
-	8, // 0: content.v1.ListPostsResponse.posts:type_name -> content.v1.Post
-	8, // 1: content.v1.GetPostResponse.post:type_name -> content.v1.Post
-	8, // 2: content.v1.SearchPostsResponse.posts:type_name -> content.v1.Post
-	0, // 3: content.v1.ContentService.CreatePost:input_type -> content.v1.CreatePostRequest
-	2, // 4: content.v1.ContentService.ListPosts:input_type -> content.v1.ListPostsRequest
-	4, // 5: content.v1.ContentService.GetPost:input_type -> content.v1.GetPostRequest
-	6, // 6: content.v1.ContentService.SearchPosts:input_type -> content.v1.SearchPostsRequest
-	1, // 7: content.v1.ContentService.CreatePost:output_type -> content.v1.CreatePostResponse
-	3, // 8: content.v1.ContentService.ListPosts:output_type -> content.v1.ListPostsResponse
-	5, // 9: content.v1.ContentService.GetPost:output_type -> content.v1.GetPostResponse
-	7, // 10: content.v1.ContentService.SearchPosts:output_type -> content.v1.SearchPostsResponse
-	7, // [7:11] is the sub-list for method output_type
-	3, // [3:7] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	0,  // 0: content.v1.ListPostsRequest.resolution_status:type_name -> content.v1.ResolutionStatus
+	1,  // 1: content.v1.ListPostsRequest.view:type_name -> content.v1.PostView
+	15, // 2: content.v1.ListPostsResponse.posts:type_name -> content.v1.Post
+	1,  // 3: content.v1.ListTrendingPostsRequest.view:type_name -> content.v1.PostView
+	1,  // 4: content.v1.ListRelatedPostsRequest.view:type_name -> content.v1.PostView
+	1,  // 5: content.v1.WatchPostsRequest.view:type_name -> content.v1.PostView
+	15, // 6: content.v1.WatchPostsResponse.post:type_name -> content.v1.Post
+	15, // 7: content.v1.GetPostResponse.post:type_name -> content.v1.Post
+	16, // 8: content.v1.GetPostResponse.timeline:type_name -> content.v1.FollowUp
+	17, // 9: content.v1.GetPostResponse.official_reply:type_name -> content.v1.OfficialReply
+	1,  // 10: content.v1.SearchPostsRequest.view:type_name -> content.v1.PostView
+	15, // 11: content.v1.SearchPostsResponse.posts:type_name -> content.v1.Post
+	0,  // 12: content.v1.Post.resolution_status:type_name -> content.v1.ResolutionStatus
+	0,  // 13: content.v1.FollowUp.status:type_name -> content.v1.ResolutionStatus
+	0,  // 14: content.v1.AppendFollowUpRequest.status:type_name -> content.v1.ResolutionStatus
+	16, // 15: content.v1.AppendFollowUpResponse.follow_up:type_name -> content.v1.FollowUp
+	2,  // 16: content.v1.ContentService.CreatePost:input_type -> content.v1.CreatePostRequest
+	4,  // 17: content.v1.ContentService.ListPosts:input_type -> content.v1.ListPostsRequest
+	11, // 18: content.v1.ContentService.GetPost:input_type -> content.v1.GetPostRequest
+	13, // 19: content.v1.ContentService.SearchPosts:input_type -> content.v1.SearchPostsRequest
+	18, // 20: content.v1.ContentService.AppendFollowUp:input_type -> content.v1.AppendFollowUpRequest
+	10, // 21: content.v1.ContentService.ListMyPosts:input_type -> content.v1.ListMyPostsRequest
+	6,  // 22: content.v1.ContentService.ListTrendingPosts:input_type -> content.v1.ListTrendingPostsRequest
+	7,  // 23: content.v1.ContentService.ListRelatedPosts:input_type -> content.v1.ListRelatedPostsRequest
+	8,  // 24: content.v1.ContentService.WatchPosts:input_type -> content.v1.WatchPostsRequest
+	3,  // 25: content.v1.ContentService.CreatePost:output_type -> content.v1.CreatePostResponse
+	5,  // 26: content.v1.ContentService.ListPosts:output_type -> content.v1.ListPostsResponse
+	12, // 27: content.v1.ContentService.GetPost:output_type -> content.v1.GetPostResponse
+	14, // 28: content.v1.ContentService.SearchPosts:output_type -> content.v1.SearchPostsResponse
+	19, // 29: content.v1.ContentService.AppendFollowUp:output_type -> content.v1.AppendFollowUpResponse
+	5,  // 30: content.v1.ContentService.ListMyPosts:output_type -> content.v1.ListPostsResponse
+	5,  // 31: content.v1.ContentService.ListTrendingPosts:output_type -> content.v1.ListPostsResponse
+	5,  // 32: content.v1.ContentService.ListRelatedPosts:output_type -> content.v1.ListPostsResponse
+	9,  // 33: content.v1.ContentService.WatchPosts:output_type -> content.v1.WatchPostsResponse
+	25, // [25:34] is the sub-list for method output_type
+	16, // [16:25] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_content_v1_content_proto_init() }
@@ -712,13 +1543,14 @@ func file_content_v1_content_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_content_v1_content_proto_rawDesc), len(file_content_v1_content_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   9,
+			NumEnums:      2,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_content_v1_content_proto_goTypes,
 		DependencyIndexes: file_content_v1_content_proto_depIdxs,
+		EnumInfos:         file_content_v1_content_proto_enumTypes,
 		MessageInfos:      file_content_v1_content_proto_msgTypes,
 	}.Build()
 	File_content_v1_content_proto = out.File
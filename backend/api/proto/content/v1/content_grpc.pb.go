@@ -19,10 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ContentService_CreatePost_FullMethodName  = "/content.v1.ContentService/CreatePost"
-	ContentService_ListPosts_FullMethodName   = "/content.v1.ContentService/ListPosts"
-	ContentService_GetPost_FullMethodName     = "/content.v1.ContentService/GetPost"
-	ContentService_SearchPosts_FullMethodName = "/content.v1.ContentService/SearchPosts"
+	ContentService_CreatePost_FullMethodName        = "/content.v1.ContentService/CreatePost"
+	ContentService_ListPosts_FullMethodName         = "/content.v1.ContentService/ListPosts"
+	ContentService_GetPost_FullMethodName           = "/content.v1.ContentService/GetPost"
+	ContentService_SearchPosts_FullMethodName       = "/content.v1.ContentService/SearchPosts"
+	ContentService_AppendFollowUp_FullMethodName    = "/content.v1.ContentService/AppendFollowUp"
+	ContentService_ListMyPosts_FullMethodName       = "/content.v1.ContentService/ListMyPosts"
+	ContentService_ListTrendingPosts_FullMethodName = "/content.v1.ContentService/ListTrendingPosts"
+	ContentService_ListRelatedPosts_FullMethodName  = "/content.v1.ContentService/ListRelatedPosts"
+	ContentService_WatchPosts_FullMethodName        = "/content.v1.ContentService/WatchPosts"
 )
 
 // ContentServiceClient is the client API for ContentService service.
@@ -39,6 +44,17 @@ type ContentServiceClient interface {
 	GetPost(ctx context.Context, in *GetPostRequest, opts ...grpc.CallOption) (*GetPostResponse, error)
 	// SearchPosts 搜索内容
 	SearchPosts(ctx context.Context, in *SearchPostsRequest, opts ...grpc.CallOption) (*SearchPostsResponse, error)
+	// AppendFollowUp 作者追加后续进展（需要管理令牌）
+	AppendFollowUp(ctx context.Context, in *AppendFollowUpRequest, opts ...grpc.CallOption) (*AppendFollowUpResponse, error)
+	// ListMyPosts 获取当前登录用户发布的内容（需要访问令牌）
+	ListMyPosts(ctx context.Context, in *ListMyPostsRequest, opts ...grpc.CallOption) (*ListPostsResponse, error)
+	// ListTrendingPosts 获取热门内容（按浏览、后续进展和官方回应的时间衰减热度排序）
+	ListTrendingPosts(ctx context.Context, in *ListTrendingPostsRequest, opts ...grpc.CallOption) (*ListPostsResponse, error)
+	// ListRelatedPosts 获取相关内容（同一公司的帖子优先，其次是同城内容相似的帖子）
+	ListRelatedPosts(ctx context.Context, in *ListRelatedPostsRequest, opts ...grpc.CallOption) (*ListPostsResponse, error)
+	// WatchPosts 订阅新发布的内容（服务端流），可按城市或公司筛选
+	// 只推送订阅之后发布的帖子；流因服务重启或客户端处理过慢而中断时返回 UNAVAILABLE，客户端应重新订阅并用 ListPosts 补齐
+	WatchPosts(ctx context.Context, in *WatchPostsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchPostsResponse], error)
 }
 
 type contentServiceClient struct {
@@ -89,6 +105,65 @@ func (c *contentServiceClient) SearchPosts(ctx context.Context, in *SearchPostsR
 	return out, nil
 }
 
+func (c *contentServiceClient) AppendFollowUp(ctx context.Context, in *AppendFollowUpRequest, opts ...grpc.CallOption) (*AppendFollowUpResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AppendFollowUpResponse)
+	err := c.cc.Invoke(ctx, ContentService_AppendFollowUp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) ListMyPosts(ctx context.Context, in *ListMyPostsRequest, opts ...grpc.CallOption) (*ListPostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPostsResponse)
+	err := c.cc.Invoke(ctx, ContentService_ListMyPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) ListTrendingPosts(ctx context.Context, in *ListTrendingPostsRequest, opts ...grpc.CallOption) (*ListPostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPostsResponse)
+	err := c.cc.Invoke(ctx, ContentService_ListTrendingPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) ListRelatedPosts(ctx context.Context, in *ListRelatedPostsRequest, opts ...grpc.CallOption) (*ListPostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPostsResponse)
+	err := c.cc.Invoke(ctx, ContentService_ListRelatedPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) WatchPosts(ctx context.Context, in *WatchPostsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchPostsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ContentService_ServiceDesc.Streams[0], ContentService_WatchPosts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchPostsRequest, WatchPostsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ContentService_WatchPostsClient = grpc.ServerStreamingClient[WatchPostsResponse]
+
 // ContentServiceServer is the server API for ContentService service.
 // All implementations must embed UnimplementedContentServiceServer
 // for forward compatibility.
@@ -103,6 +178,17 @@ type ContentServiceServer interface {
 	GetPost(context.Context, *GetPostRequest) (*GetPostResponse, error)
 	// SearchPosts 搜索内容
 	SearchPosts(context.Context, *SearchPostsRequest) (*SearchPostsResponse, error)
+	// AppendFollowUp 作者追加后续进展（需要管理令牌）
+	AppendFollowUp(context.Context, *AppendFollowUpRequest) (*AppendFollowUpResponse, error)
+	// ListMyPosts 获取当前登录用户发布的内容（需要访问令牌）
+	ListMyPosts(context.Context, *ListMyPostsRequest) (*ListPostsResponse, error)
+	// ListTrendingPosts 获取热门内容（按浏览、后续进展和官方回应的时间衰减热度排序）
+	ListTrendingPosts(context.Context, *ListTrendingPostsRequest) (*ListPostsResponse, error)
+	// ListRelatedPosts 获取相关内容（同一公司的帖子优先，其次是同城内容相似的帖子）
+	ListRelatedPosts(context.Context, *ListRelatedPostsRequest) (*ListPostsResponse, error)
+	// WatchPosts 订阅新发布的内容（服务端流），可按城市或公司筛选
+	// 只推送订阅之后发布的帖子；流因服务重启或客户端处理过慢而中断时返回 UNAVAILABLE，客户端应重新订阅并用 ListPosts 补齐
+	WatchPosts(*WatchPostsRequest, grpc.ServerStreamingServer[WatchPostsResponse]) error
 	mustEmbedUnimplementedContentServiceServer()
 }
 
@@ -125,6 +211,21 @@ func (UnimplementedContentServiceServer) GetPost(context.Context, *GetPostReques
 func (UnimplementedContentServiceServer) SearchPosts(context.Context, *SearchPostsRequest) (*SearchPostsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchPosts not implemented")
 }
+func (UnimplementedContentServiceServer) AppendFollowUp(context.Context, *AppendFollowUpRequest) (*AppendFollowUpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppendFollowUp not implemented")
+}
+func (UnimplementedContentServiceServer) ListMyPosts(context.Context, *ListMyPostsRequest) (*ListPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyPosts not implemented")
+}
+func (UnimplementedContentServiceServer) ListTrendingPosts(context.Context, *ListTrendingPostsRequest) (*ListPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrendingPosts not implemented")
+}
+func (UnimplementedContentServiceServer) ListRelatedPosts(context.Context, *ListRelatedPostsRequest) (*ListPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRelatedPosts not implemented")
+}
+func (UnimplementedContentServiceServer) WatchPosts(*WatchPostsRequest, grpc.ServerStreamingServer[WatchPostsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchPosts not implemented")
+}
 func (UnimplementedContentServiceServer) mustEmbedUnimplementedContentServiceServer() {}
 func (UnimplementedContentServiceServer) testEmbeddedByValue()                        {}
 
@@ -218,6 +319,89 @@ func _ContentService_SearchPosts_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ContentService_AppendFollowUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppendFollowUpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).AppendFollowUp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_AppendFollowUp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).AppendFollowUp(ctx, req.(*AppendFollowUpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_ListMyPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).ListMyPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_ListMyPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).ListMyPosts(ctx, req.(*ListMyPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_ListTrendingPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrendingPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).ListTrendingPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_ListTrendingPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).ListTrendingPosts(ctx, req.(*ListTrendingPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_ListRelatedPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRelatedPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).ListRelatedPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_ListRelatedPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).ListRelatedPosts(ctx, req.(*ListRelatedPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_WatchPosts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchPostsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ContentServiceServer).WatchPosts(m, &grpc.GenericServerStream[WatchPostsRequest, WatchPostsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ContentService_WatchPostsServer = grpc.ServerStreamingServer[WatchPostsResponse]
+
 // ContentService_ServiceDesc is the grpc.ServiceDesc for ContentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -241,7 +425,29 @@ var ContentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SearchPosts",
 			Handler:    _ContentService_SearchPosts_Handler,
 		},
+		{
+			MethodName: "AppendFollowUp",
+			Handler:    _ContentService_AppendFollowUp_Handler,
+		},
+		{
+			MethodName: "ListMyPosts",
+			Handler:    _ContentService_ListMyPosts_Handler,
+		},
+		{
+			MethodName: "ListTrendingPosts",
+			Handler:    _ContentService_ListTrendingPosts_Handler,
+		},
+		{
+			MethodName: "ListRelatedPosts",
+			Handler:    _ContentService_ListRelatedPosts_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchPosts",
+			Handler:       _ContentService_WatchPosts_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "content/v1/content.proto",
 }
@@ -0,0 +1,785 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+
+	"fuck_boss/backend/internal/application/admin"
+	companyapp "fuck_boss/backend/internal/application/company"
+	"fuck_boss/backend/internal/application/content"
+	"fuck_boss/backend/internal/application/dto"
+	moderationapp "fuck_boss/backend/internal/application/moderation"
+	notificationapp "fuck_boss/backend/internal/application/notification"
+	"fuck_boss/backend/internal/application/search"
+	domainadmin "fuck_boss/backend/internal/domain/admin"
+	domaincontent "fuck_boss/backend/internal/domain/content"
+	"fuck_boss/backend/internal/infrastructure/config"
+	"fuck_boss/backend/internal/infrastructure/logger"
+	"fuck_boss/backend/internal/infrastructure/persistence/postgres"
+	redispersistence "fuck_boss/backend/internal/infrastructure/persistence/redis"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// adminCommand is a subcommand of the admin subcommand.
+type adminCommand struct {
+	// name is the command name.
+	name string
+
+	// args describes the positional arguments in the usage.
+	args string
+
+	// summary is a one-line description.
+	summary string
+
+	// nargs is the number of positional arguments (-1 for any number).
+	nargs int
+
+	// audited reports whether runs of the command are recorded in the audit log.
+	// Commands that change data are; read-only commands are not.
+	audited bool
+
+	// setup defines the flags of the command and returns the function running it.
+	// The function's result is printed as JSON.
+	setup func(flags *flag.FlagSet) func(ctx context.Context, env *adminEnv, args []string) (interface{}, error)
+}
+
+// adminCommands are the admin commands, in usage order.
+var adminCommands = []adminCommand{
+	{name: "hide-post", args: "<post-id>", summary: "hide a visible post", nargs: 1, audited: true, setup: moderatePostCommand(content.ModerationActionHide)},
+	{name: "remove-post", args: "<post-id>", summary: "remove a visible or hidden post (it can be restored)", nargs: 1, audited: true, setup: moderatePostCommand(content.ModerationActionRemove)},
+	{name: "restore-post", args: "<post-id>", summary: "make a hidden or removed post visible again", nargs: 1, audited: true, setup: moderatePostCommand(content.ModerationActionRestore)},
+	{name: "merge-companies", args: "<from> <into>", summary: "rename a company in all posts, representatives, watches and approved domains", nargs: 2, audited: true, setup: mergeCompaniesCommand},
+	{name: "approve-company-domain", args: "<company> <domain>", summary: "approve a domain checked to belong to a company, so its representatives can be verified", nargs: 2, audited: true, setup: approveCompanyDomainCommand},
+	{name: "list-company-domains", summary: "list the approved company domains", nargs: 0, setup: listCompanyDomainsCommand},
+	{name: "revoke-company-domain", args: "<company> <domain>", summary: "revoke a company domain; its representatives can no longer reply", nargs: 2, audited: true, setup: revokeCompanyDomainCommand},
+	{name: "flush-cache", args: "[namespace...]", summary: "delete cached data (" + cacheNamespaceNames() + "; default all)", nargs: -1, audited: true, setup: flushCacheCommand},
+	{name: "reset-rate-limit", args: "<client-ip>", summary: "reset the hourly rate limits of a client IP address", nargs: 1, audited: true, setup: resetRateLimitCommand},
+	{name: "rebuild-search", summary: "rebuild the full-text search index of posts", nargs: 0, audited: true, setup: rebuildSearchCommand},
+	{name: "create-api-key", summary: "issue an API key for the admin RPCs (the key is only printed once)", nargs: 0, audited: true, setup: createAPIKeyCommand},
+	{name: "list-api-keys", summary: "list API keys with their scopes, expiry and last use", nargs: 0, setup: listAPIKeysCommand},
+	{name: "revoke-api-key", args: "<key-id>", summary: "revoke an API key", nargs: 1, audited: true, setup: revokeAPIKeyCommand},
+	{name: "assign-moderator", args: "<account>", summary: "make a user (ID, email or username) a moderator, or change their role and cities", nargs: 1, audited: true, setup: assignModeratorCommand},
+	{name: "list-moderators", summary: "list moderators with their roles and cities", nargs: 0, setup: listModeratorsCommand},
+	{name: "remove-moderator", args: "<account>", summary: "take the moderator role away from a user", nargs: 1, audited: true, setup: removeModeratorCommand},
+	{name: "unban-user", args: "<account>", summary: "lift the ban a moderator put on a user", nargs: 1, audited: true, setup: unbanUserCommand},
+	{name: "config", summary: "print the effective configuration (secrets redacted)", nargs: 0, setup: configCommand},
+}
+
+// adminError is the JSON printed when an admin command fails.
+type adminError struct {
+	Error adminErrorBody `json:"error"`
+}
+
+// adminErrorBody describes the error of a failed admin command.
+type adminErrorBody struct {
+	Code    string                 `json:"code"`
+	Message string                 `json:"message"`
+	Details map[string]interface{} `json:"details,omitempty"`
+}
+
+// runAdmin runs the admin subcommand: it runs an operator command built on
+// the application use cases, prints its result (or error) as JSON on stdout,
+// and returns the exit code.
+func runAdmin(args []string) int {
+	if len(args) == 0 || args[0] == "-h" || args[0] == "-help" || args[0] == "--help" {
+		printAdminUsage()
+		if len(args) == 0 {
+			return 2
+		}
+		return 0
+	}
+
+	var cmd *adminCommand
+	for i := range adminCommands {
+		if adminCommands[i].name == args[0] {
+			cmd = &adminCommands[i]
+		}
+	}
+	if cmd == nil {
+		fmt.Fprintf(os.Stderr, "Unknown admin command %q\n\n", args[0])
+		printAdminUsage()
+		return 2
+	}
+
+	flags := flag.NewFlagSet("admin "+cmd.name, flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: server admin %s [flags] %s\n\n%s.\n\n", cmd.name, cmd.args, cmd.summary)
+		flags.PrintDefaults()
+	}
+	run := cmd.setup(flags)
+	if err := flags.Parse(args[1:]); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+	if cmd.nargs >= 0 && flags.NArg() != cmd.nargs {
+		fmt.Fprintf(os.Stderr, "admin %s takes %d argument(s): %s\n", cmd.name, cmd.nargs, cmd.args)
+		return 2
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load configuration: %v\n", err)
+		return 1
+	}
+
+	// Log to stderr so that stdout only has the JSON result
+	log, err := logger.NewLoggerFromConfig(&logger.LogConfig{
+		Level:            cfg.Log.Level,
+		Format:           cfg.Log.Format,
+		OutputPaths:      []string{"stderr"},
+		ErrorOutputPaths: []string{"stderr"},
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to initialize logger: %v\n", err)
+		return 1
+	}
+	defer log.Sync()
+
+	env := &adminEnv{cfg: cfg, log: log}
+	defer env.close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	result, err := run(ctx, env, flags.Args())
+	if cmd.audited {
+		env.audit(ctx, cmd, flags, err)
+	}
+	if err != nil {
+		printAdminJSON(newAdminError(err))
+		return 1
+	}
+	printAdminJSON(result)
+	return 0
+}
+
+// printAdminUsage prints the list of admin commands.
+func printAdminUsage() {
+	fmt.Fprintf(os.Stderr, "Usage: server admin <command> [flags] [arguments]\n\n"+
+		"Operator commands. The result is printed as JSON on stdout; on failure an\n"+
+		"{\"error\": {...}} object is printed and the exit code is 1.\n\nCommands:\n")
+	for _, cmd := range adminCommands {
+		fmt.Fprintf(os.Stderr, "  %-18s %s\n", cmd.name, cmd.summary)
+	}
+	fmt.Fprintf(os.Stderr, "\nRun 'server admin <command> -h' for the flags of a command.\n")
+}
+
+// printAdminJSON prints v as indented JSON on stdout.
+func printAdminJSON(v interface{}) {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to write result: %v\n", err)
+	}
+}
+
+// newAdminError converts an error to the JSON error object.
+func newAdminError(err error) *adminError {
+	var appErr *apperrors.AppError
+	if apperrors.As(err, &appErr) {
+		message := appErr.Message
+		if appErr.Cause != nil {
+			message += ": " + appErr.Cause.Error()
+		}
+		return &adminError{Error: adminErrorBody{Code: string(appErr.Code), Message: message, Details: appErr.Details}}
+	}
+	return &adminError{Error: adminErrorBody{Code: string(apperrors.ErrCodeInternal), Message: err.Error()}}
+}
+
+// adminEnv connects to the database and Redis when a command first needs them.
+type adminEnv struct {
+	cfg *config.Config
+	log logger.Logger
+
+	db          *sql.DB
+	redisClient *redis.Client
+}
+
+// database returns the database connection.
+func (e *adminEnv) database() (*sql.DB, error) {
+	if e.db == nil {
+		db, err := connectDatabase(e.cfg.Database, e.log)
+		if err != nil {
+			return nil, apperrors.NewUnavailableError(err.Error())
+		}
+		e.db = db
+	}
+	return e.db, nil
+}
+
+// redis returns the Redis client. Commands that change posts need it too, so
+// that caches are cleared; they fail before changing anything without it.
+func (e *adminEnv) redis() (*redis.Client, error) {
+	if e.redisClient == nil {
+		client, err := connectRedis(e.cfg.Redis, e.log)
+		if err != nil {
+			return nil, apperrors.NewUnavailableError(err.Error())
+		}
+		e.redisClient = client
+	}
+	return e.redisClient, nil
+}
+
+// close closes the connections opened.
+func (e *adminEnv) close() {
+	if e.db != nil {
+		e.db.Close()
+	}
+	if e.redisClient != nil {
+		e.redisClient.Close()
+	}
+}
+
+// audit records a run of an audited command in the audit log, with the flags
+// set on the command line as details. The command has already run, so a
+// failure to record it is logged but does not change the exit code.
+func (e *adminEnv) audit(ctx context.Context, cmd *adminCommand, flags *flag.FlagSet, runErr error) {
+	db, err := e.database()
+	if err != nil {
+		e.log.Error("Failed to record audit event", zap.String("action", "admin "+cmd.name), zap.Error(err))
+		return
+	}
+
+	event := admin.RecordAuditEventCommand{
+		Actor:   domainadmin.CLIActor,
+		Action:  "admin " + cmd.name,
+		Outcome: auditOutcome(runErr),
+	}
+	if cmd.nargs > 0 {
+		event.Target = flags.Arg(0)
+	}
+	details := make(map[string]interface{})
+	flags.Visit(func(f *flag.Flag) {
+		details[f.Name] = f.Value.String()
+	})
+	if flags.NArg() > 0 {
+		details["args"] = flags.Args()
+	}
+	if len(details) > 0 {
+		if encoded, err := json.Marshal(details); err == nil {
+			event.Details = string(encoded)
+		}
+	}
+
+	uc := admin.NewRecordAuditEventUseCase(postgres.NewAuditRepository(db))
+	if err := uc.Execute(context.WithoutCancel(ctx), event); err != nil {
+		e.log.Error("Failed to record audit event", zap.String("action", event.Action), zap.Error(err))
+	}
+}
+
+// auditOutcome returns the audit outcome of a command: "OK", or the name of
+// the gRPC status code the error would have over the API, so that commands and
+// RPCs can be filtered the same way.
+func auditOutcome(err error) string {
+	if err == nil {
+		return codes.OK.String()
+	}
+	var appErr *apperrors.AppError
+	if !apperrors.As(err, &appErr) {
+		return codes.Internal.String()
+	}
+	switch appErr.Code {
+	case apperrors.ErrCodeValidation:
+		return codes.InvalidArgument.String()
+	case apperrors.ErrCodeNotFound:
+		return codes.NotFound.String()
+	case apperrors.ErrCodeUnauthenticated:
+		return codes.Unauthenticated.String()
+	case apperrors.ErrCodeForbidden:
+		return codes.PermissionDenied.String()
+	case apperrors.ErrCodeConflict:
+		return codes.AlreadyExists.String()
+	case apperrors.ErrCodeRateLimit:
+		return codes.ResourceExhausted.String()
+	case apperrors.ErrCodeUnavailable:
+		return codes.Unavailable.String()
+	default:
+		return codes.Internal.String()
+	}
+}
+
+// connect returns the database connection and the Redis client.
+func (e *adminEnv) connect() (*sql.DB, *redis.Client, error) {
+	redisClient, err := e.redis()
+	if err != nil {
+		return nil, nil, err
+	}
+	db, err := e.database()
+	if err != nil {
+		return nil, nil, err
+	}
+	return db, redisClient, nil
+}
+
+// postModerationResult is the JSON result of hide-post, remove-post and restore-post.
+type postModerationResult struct {
+	PostID      string    `json:"post_id"`
+	Company     string    `json:"company"`
+	CityCode    string    `json:"city_code"`
+	Status      string    `json:"status"`
+	Reason      string    `json:"reason,omitempty"`
+	ModeratedAt time.Time `json:"moderated_at"`
+}
+
+// moderatePostCommand returns the setup of a post moderation command.
+func moderatePostCommand(action content.ModerationAction) func(*flag.FlagSet) func(context.Context, *adminEnv, []string) (interface{}, error) {
+	return func(flags *flag.FlagSet) func(context.Context, *adminEnv, []string) (interface{}, error) {
+		var reason *string
+		if action != content.ModerationActionRestore {
+			reason = flags.String("reason", "", fmt.Sprintf("why the post is moderated, shown to its author (required, at most %d characters)", domaincontent.MaxModerationReasonLength))
+		}
+		notify := flags.Bool("notify", true, "notify the author of the post (posts of logged-in users only)")
+
+		return func(ctx context.Context, env *adminEnv, args []string) (interface{}, error) {
+			db, redisClient, err := env.connect()
+			if err != nil {
+				return nil, err
+			}
+
+			var notifier content.ModerationNotifier
+			if *notify {
+				notifier = notificationapp.NewNotifier(postgres.NewNotificationRepository(db), postgres.NewWatchRepository(db))
+			}
+			cmd := content.ModeratePostCommand{PostID: args[0], Action: action}
+			if reason != nil {
+				cmd.Reason = *reason
+			}
+
+			history := redispersistence.NewPostBroker(redisClient, int64(env.cfg.LiveFeed.HistorySize))
+			uc := content.NewModeratePostUseCase(postgres.NewPostRepository(db), redispersistence.NewCacheRepository(redisClient), notifier, history)
+			result, err := uc.Execute(ctx, cmd)
+			if err != nil {
+				return nil, err
+			}
+			return &postModerationResult{
+				PostID:      result.PostID,
+				Company:     result.Company,
+				CityCode:    result.CityCode,
+				Status:      result.Status,
+				Reason:      result.Reason,
+				ModeratedAt: result.ModeratedAt,
+			}, nil
+		}
+	}
+}
+
+// companyMergeResult is the JSON result of merge-companies.
+type companyMergeResult struct {
+	From            string `json:"from"`
+	Into            string `json:"into"`
+	Posts           int    `json:"posts"`
+	Representatives int    `json:"representatives"`
+	Watches         int    `json:"watches"`
+	Domains         int    `json:"domains"`
+}
+
+// mergeCompaniesCommand is the setup of merge-companies.
+func mergeCompaniesCommand(flags *flag.FlagSet) func(context.Context, *adminEnv, []string) (interface{}, error) {
+	return func(ctx context.Context, env *adminEnv, args []string) (interface{}, error) {
+		db, redisClient, err := env.connect()
+		if err != nil {
+			return nil, err
+		}
+
+		uc := companyapp.NewMergeCompaniesUseCase(postgres.NewCompanyMergeRepository(db), redispersistence.NewCacheRepository(redisClient))
+		result, err := uc.Execute(ctx, companyapp.MergeCompaniesCommand{From: args[0], Into: args[1]})
+		if err != nil {
+			return nil, err
+		}
+		return &companyMergeResult{
+			From:            result.From,
+			Into:            result.Into,
+			Posts:           result.Posts,
+			Representatives: result.Representatives,
+			Watches:         result.Watches,
+			Domains:         result.Domains,
+		}, nil
+	}
+}
+
+// companyDomainResult is the JSON form of a company domain in the company
+// domain commands (revoke-company-domain has no approval time).
+type companyDomainResult struct {
+	Company    string     `json:"company"`
+	Domain     string     `json:"domain"`
+	ApprovedAt *time.Time `json:"approved_at,omitempty"`
+}
+
+// newCompanyDomainResult converts an approved company domain to its JSON form.
+func newCompanyDomainResult(approval *dto.CompanyDomainDTO) *companyDomainResult {
+	return &companyDomainResult{
+		Company:    approval.Company,
+		Domain:     approval.Domain,
+		ApprovedAt: &approval.ApprovedAt,
+	}
+}
+
+// approveCompanyDomainCommand is the setup of approve-company-domain.
+func approveCompanyDomainCommand(flags *flag.FlagSet) func(context.Context, *adminEnv, []string) (interface{}, error) {
+	return func(ctx context.Context, env *adminEnv, args []string) (interface{}, error) {
+		db, err := env.database()
+		if err != nil {
+			return nil, err
+		}
+
+		uc := companyapp.NewApproveCompanyDomainUseCase(postgres.NewDomainApprovalRepository(db))
+		approval, err := uc.Execute(ctx, companyapp.CompanyDomainCommand{Company: args[0], Domain: args[1]})
+		if err != nil {
+			return nil, err
+		}
+		return newCompanyDomainResult(approval), nil
+	}
+}
+
+// listCompanyDomainsCommand is the setup of list-company-domains.
+func listCompanyDomainsCommand(flags *flag.FlagSet) func(context.Context, *adminEnv, []string) (interface{}, error) {
+	return func(ctx context.Context, env *adminEnv, args []string) (interface{}, error) {
+		db, err := env.database()
+		if err != nil {
+			return nil, err
+		}
+
+		uc := companyapp.NewListCompanyDomainsUseCase(postgres.NewDomainApprovalRepository(db))
+		approvals, err := uc.Execute(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results := make([]*companyDomainResult, 0, len(approvals))
+		for _, approval := range approvals {
+			results = append(results, newCompanyDomainResult(approval))
+		}
+		return results, nil
+	}
+}
+
+// revokeCompanyDomainCommand is the setup of revoke-company-domain.
+func revokeCompanyDomainCommand(flags *flag.FlagSet) func(context.Context, *adminEnv, []string) (interface{}, error) {
+	return func(ctx context.Context, env *adminEnv, args []string) (interface{}, error) {
+		db, err := env.database()
+		if err != nil {
+			return nil, err
+		}
+
+		uc := companyapp.NewRevokeCompanyDomainUseCase(postgres.NewDomainApprovalRepository(db))
+		cmd := companyapp.CompanyDomainCommand{Company: args[0], Domain: args[1]}
+		if err := uc.Execute(ctx, cmd); err != nil {
+			return nil, err
+		}
+		return &companyDomainResult{Company: cmd.Company, Domain: cmd.Domain}, nil
+	}
+}
+
+// flushCacheResult is the JSON result of flush-cache.
+type flushCacheResult struct {
+	Flushed []string `json:"flushed"`
+}
+
+// flushCacheCommand is the setup of flush-cache.
+func flushCacheCommand(flags *flag.FlagSet) func(context.Context, *adminEnv, []string) (interface{}, error) {
+	return func(ctx context.Context, env *adminEnv, args []string) (interface{}, error) {
+		redisClient, err := env.redis()
+		if err != nil {
+			return nil, err
+		}
+
+		uc := admin.NewFlushCacheUseCase(redispersistence.NewCacheRepository(redisClient))
+		patterns, err := uc.Execute(ctx, admin.FlushCacheCommand{Namespaces: args})
+		if err != nil {
+			return nil, err
+		}
+		return &flushCacheResult{Flushed: patterns}, nil
+	}
+}
+
+// cacheNamespaceNames returns the names of the cache namespaces for the usage.
+func cacheNamespaceNames() string {
+	names := make([]string, 0, len(admin.CacheNamespaces))
+	for _, ns := range admin.CacheNamespaces {
+		names = append(names, ns.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+// resetRateLimitResult is the JSON result of reset-rate-limit.
+type resetRateLimitResult struct {
+	ClientIP string   `json:"client_ip"`
+	Reset    []string `json:"reset"`
+}
+
+// resetRateLimitCommand is the setup of reset-rate-limit.
+func resetRateLimitCommand(flags *flag.FlagSet) func(context.Context, *adminEnv, []string) (interface{}, error) {
+	actions := flags.String("actions", "", "comma-separated actions to reset (default all)")
+
+	return func(ctx context.Context, env *adminEnv, args []string) (interface{}, error) {
+		redisClient, err := env.redis()
+		if err != nil {
+			return nil, err
+		}
+
+		cmd := admin.ResetRateLimitCommand{ClientIP: args[0]}
+		if *actions != "" {
+			cmd.Actions = strings.Split(*actions, ",")
+		}
+
+		uc := admin.NewResetRateLimitUseCase(redispersistence.NewRateLimiter(redisClient))
+		keys, err := uc.Execute(ctx, cmd)
+		if err != nil {
+			return nil, err
+		}
+		return &resetRateLimitResult{ClientIP: cmd.ClientIP, Reset: keys}, nil
+	}
+}
+
+// rebuildSearchResult is the JSON result of rebuild-search.
+type rebuildSearchResult struct {
+	Rebuilt    bool  `json:"rebuilt"`
+	DurationMS int64 `json:"duration_ms"`
+}
+
+// rebuildSearchCommand is the setup of rebuild-search.
+func rebuildSearchCommand(flags *flag.FlagSet) func(context.Context, *adminEnv, []string) (interface{}, error) {
+	return func(ctx context.Context, env *adminEnv, args []string) (interface{}, error) {
+		db, redisClient, err := env.connect()
+		if err != nil {
+			return nil, err
+		}
+
+		started := time.Now()
+		uc := search.NewRebuildIndexUseCase(postgres.NewSearchIndex(db), redispersistence.NewCacheRepository(redisClient))
+		if err := uc.Execute(ctx); err != nil {
+			return nil, err
+		}
+		return &rebuildSearchResult{Rebuilt: true, DurationMS: time.Since(started).Milliseconds()}, nil
+	}
+}
+
+// apiKeyResult is the JSON form of an API key in create-api-key and list-api-keys.
+type apiKeyResult struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	Key        string     `json:"key,omitempty"`
+}
+
+// newAPIKeyResult converts an API key to its JSON form.
+func newAPIKeyResult(key *dto.APIKeyDTO) *apiKeyResult {
+	return &apiKeyResult{
+		ID:         key.ID,
+		Name:       key.Name,
+		Prefix:     key.Prefix,
+		Scopes:     key.Scopes,
+		ExpiresAt:  key.ExpiresAt,
+		CreatedAt:  key.CreatedAt,
+		LastUsedAt: key.LastUsedAt,
+		RevokedAt:  key.RevokedAt,
+		Key:        key.Key,
+	}
+}
+
+// createAPIKeyCommand is the setup of create-api-key.
+func createAPIKeyCommand(flags *flag.FlagSet) func(context.Context, *adminEnv, []string) (interface{}, error) {
+	name := flags.String("name", "", fmt.Sprintf("who or what uses the key (required, at most %d characters)", domainadmin.MaxAPIKeyNameLength))
+	scopes := flags.String("scopes", "", "comma-separated scopes to grant: moderate, export, admin (required)")
+	expiresIn := flags.Duration("expires-in", 0, "how long the key works, e.g. 720h (default no expiry)")
+
+	return func(ctx context.Context, env *adminEnv, args []string) (interface{}, error) {
+		cmd := admin.CreateAPIKeyCommand{Name: *name}
+		if *scopes != "" {
+			cmd.Scopes = strings.Split(*scopes, ",")
+		}
+		if *expiresIn != 0 {
+			expiresAt := time.Now().Add(*expiresIn)
+			cmd.ExpiresAt = &expiresAt
+		}
+
+		db, err := env.database()
+		if err != nil {
+			return nil, err
+		}
+
+		uc := admin.NewCreateAPIKeyUseCase(postgres.NewAPIKeyRepository(db))
+		key, err := uc.Execute(ctx, cmd)
+		if err != nil {
+			return nil, err
+		}
+		return newAPIKeyResult(key), nil
+	}
+}
+
+// listAPIKeysCommand is the setup of list-api-keys.
+func listAPIKeysCommand(flags *flag.FlagSet) func(context.Context, *adminEnv, []string) (interface{}, error) {
+	return func(ctx context.Context, env *adminEnv, args []string) (interface{}, error) {
+		db, err := env.database()
+		if err != nil {
+			return nil, err
+		}
+
+		uc := admin.NewListAPIKeysUseCase(postgres.NewAPIKeyRepository(db))
+		keys, err := uc.Execute(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results := make([]*apiKeyResult, 0, len(keys))
+		for _, key := range keys {
+			results = append(results, newAPIKeyResult(key))
+		}
+		return results, nil
+	}
+}
+
+// revokeAPIKeyCommand is the setup of revoke-api-key.
+func revokeAPIKeyCommand(flags *flag.FlagSet) func(context.Context, *adminEnv, []string) (interface{}, error) {
+	return func(ctx context.Context, env *adminEnv, args []string) (interface{}, error) {
+		db, err := env.database()
+		if err != nil {
+			return nil, err
+		}
+
+		uc := admin.NewRevokeAPIKeyUseCase(postgres.NewAPIKeyRepository(db))
+		key, err := uc.Execute(ctx, admin.RevokeAPIKeyCommand{ID: args[0]})
+		if err != nil {
+			return nil, err
+		}
+		return newAPIKeyResult(key), nil
+	}
+}
+
+// moderatorResult is the JSON form of a moderator in assign-moderator and list-moderators.
+type moderatorResult struct {
+	UserID      string    `json:"user_id"`
+	Role        string    `json:"role"`
+	Permissions []string  `json:"permissions"`
+	Cities      []string  `json:"cities"`
+	AssignedAt  time.Time `json:"assigned_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// newModeratorResult converts a moderator to its JSON form.
+// Moderators covering all cities are shown with the cities ["all"].
+func newModeratorResult(moderator *dto.ModeratorDTO) *moderatorResult {
+	cities := moderator.CityCodes
+	if len(cities) == 0 {
+		cities = []string{"all"}
+	}
+	return &moderatorResult{
+		UserID:      moderator.UserID,
+		Role:        moderator.Role,
+		Permissions: moderator.Permissions,
+		Cities:      cities,
+		AssignedAt:  moderator.AssignedAt,
+		UpdatedAt:   moderator.UpdatedAt,
+	}
+}
+
+// assignModeratorCommand is the setup of assign-moderator.
+func assignModeratorCommand(flags *flag.FlagSet) func(context.Context, *adminEnv, []string) (interface{}, error) {
+	role := flags.String("role", "", "JUNIOR (view the queue, hide posts) or SENIOR (also remove, restore and ban) (required)")
+	cities := flags.String("cities", "all", `comma-separated city codes the moderator covers, or "all"`)
+
+	return func(ctx context.Context, env *adminEnv, args []string) (interface{}, error) {
+		cmd := moderationapp.AssignModeratorCommand{Account: args[0], Role: *role}
+		if value := strings.TrimSpace(*cities); value != "" && !strings.EqualFold(value, "all") {
+			cmd.CityCodes = strings.Split(value, ",")
+		}
+
+		db, err := env.database()
+		if err != nil {
+			return nil, err
+		}
+
+		uc := moderationapp.NewAssignModeratorUseCase(postgres.NewModeratorRepository(db), postgres.NewUserRepository(db))
+		moderator, err := uc.Execute(ctx, cmd)
+		if err != nil {
+			return nil, err
+		}
+		return newModeratorResult(moderator), nil
+	}
+}
+
+// listModeratorsCommand is the setup of list-moderators.
+func listModeratorsCommand(flags *flag.FlagSet) func(context.Context, *adminEnv, []string) (interface{}, error) {
+	return func(ctx context.Context, env *adminEnv, args []string) (interface{}, error) {
+		db, err := env.database()
+		if err != nil {
+			return nil, err
+		}
+
+		uc := moderationapp.NewListModeratorsUseCase(postgres.NewModeratorRepository(db))
+		moderators, err := uc.Execute(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results := make([]*moderatorResult, 0, len(moderators))
+		for _, moderator := range moderators {
+			results = append(results, newModeratorResult(moderator))
+		}
+		return results, nil
+	}
+}
+
+// userResult is the JSON result of remove-moderator and unban-user.
+type userResult struct {
+	UserID string `json:"user_id"`
+}
+
+// removeModeratorCommand is the setup of remove-moderator.
+func removeModeratorCommand(flags *flag.FlagSet) func(context.Context, *adminEnv, []string) (interface{}, error) {
+	return func(ctx context.Context, env *adminEnv, args []string) (interface{}, error) {
+		db, err := env.database()
+		if err != nil {
+			return nil, err
+		}
+
+		uc := moderationapp.NewRemoveModeratorUseCase(postgres.NewModeratorRepository(db), postgres.NewUserRepository(db))
+		userID, err := uc.Execute(ctx, moderationapp.RemoveModeratorCommand{Account: args[0]})
+		if err != nil {
+			return nil, err
+		}
+		return &userResult{UserID: userID}, nil
+	}
+}
+
+// unbanUserCommand is the setup of unban-user.
+func unbanUserCommand(flags *flag.FlagSet) func(context.Context, *adminEnv, []string) (interface{}, error) {
+	return func(ctx context.Context, env *adminEnv, args []string) (interface{}, error) {
+		db, err := env.database()
+		if err != nil {
+			return nil, err
+		}
+
+		uc := moderationapp.NewUnbanUserUseCase(postgres.NewUserRepository(db))
+		userID, err := uc.Execute(ctx, moderationapp.UnbanUserCommand{Account: args[0]})
+		if err != nil {
+			return nil, err
+		}
+		return &userResult{UserID: userID}, nil
+	}
+}
+
+// configCommand is the setup of config.
+func configCommand(flags *flag.FlagSet) func(context.Context, *adminEnv, []string) (interface{}, error) {
+	return func(ctx context.Context, env *adminEnv, args []string) (interface{}, error) {
+		return env.cfg.Settings(), nil
+	}
+}
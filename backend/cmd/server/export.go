@@ -0,0 +1,163 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"go.uber.org/zap"
+
+	"fuck_boss/backend/internal/application/content"
+	"fuck_boss/backend/internal/infrastructure/dataset"
+	"fuck_boss/backend/internal/infrastructure/logger"
+	"fuck_boss/backend/internal/infrastructure/persistence/postgres"
+)
+
+// dateLayout is the layout of the --from and --to flags.
+const dateLayout = "2006-01-02"
+
+// runExport runs the export subcommand: it writes the posts matching the
+// flags to a dataset file and its manifest, and returns the exit code.
+func runExport(args []string) int {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: server export [flags]\n\n"+
+			"Exports posts to a CSV, JSON Lines or Parquet file and writes a manifest\n"+
+			"with its SHA-256 checksum to <output>%s.\n"+
+			"Only public fields are exported (never IP addresses, authors or management tokens).\n\n", dataset.ManifestSuffix)
+		flags.PrintDefaults()
+	}
+	formatName := flags.String("format", "csv", "file format: csv, jsonl or parquet")
+	output := flags.String("output", "", "output file (default posts.<format>)")
+	cityCode := flags.String("city", "", "only posts in this city (city code)")
+	company := flags.String("company", "", "only posts about this company (exact name)")
+	status := flags.String("status", "", "only posts with this resolution status: ONGOING, ESCALATED or RESOLVED")
+	fromDate := flags.String("from", "", "only posts created on or after this date (YYYY-MM-DD, UTC)")
+	toDate := flags.String("to", "", "only posts created on or before this date (YYYY-MM-DD, UTC)")
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+	if flags.NArg() > 0 {
+		fmt.Fprintf(os.Stderr, "Unexpected arguments: %v\n", flags.Args())
+		return 2
+	}
+
+	format, err := dataset.ParseFormat(*formatName)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+	if *output == "" {
+		*output = "posts." + string(format)
+	}
+
+	query := content.ExportPostsQuery{
+		CityCode:         *cityCode,
+		Company:          *company,
+		ResolutionStatus: *status,
+	}
+	if *fromDate != "" {
+		from, err := time.Parse(dateLayout, *fromDate)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Invalid --from date: %v\n", err)
+			return 2
+		}
+		query.From = &from
+	}
+	if *toDate != "" {
+		to, err := time.Parse(dateLayout, *toDate)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Invalid --to date: %v\n", err)
+			return 2
+		}
+		// The date is inclusive: export up to the start of the next day
+		to = to.AddDate(0, 0, 1)
+		query.To = &to
+	}
+
+	// Recorded in the manifest as given
+	filters := make(map[string]string)
+	for name, value := range map[string]string{
+		"city":    *cityCode,
+		"company": *company,
+		"status":  *status,
+		"from":    *fromDate,
+		"to":      *toDate,
+	} {
+		if value != "" {
+			filters[name] = value
+		}
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load configuration: %v\n", err)
+		return 1
+	}
+
+	// Log to stderr like the rest of the command's output
+	log, err := logger.NewLoggerFromConfig(&logger.LogConfig{
+		Level:            cfg.Log.Level,
+		Format:           cfg.Log.Format,
+		OutputPaths:      []string{"stderr"},
+		ErrorOutputPaths: []string{"stderr"},
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to initialize logger: %v\n", err)
+		return 1
+	}
+	defer log.Sync()
+
+	db, err := connectDatabase(cfg.Database, log)
+	if err != nil {
+		log.Error("Failed to connect to database", zap.Error(err))
+		return 1
+	}
+	defer db.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	file, err := dataset.CreateFile(*output, format)
+	if err != nil {
+		log.Error("Failed to create output file", zap.Error(err))
+		return 1
+	}
+
+	exportPostsUseCase := content.NewExportPostsUseCase(postgres.NewPostRepository(db))
+	count, err := exportPostsUseCase.Execute(ctx, query, file.Write)
+	if err != nil {
+		file.Abort()
+		log.Error("Export failed", zap.Int("exported", count), zap.Error(err))
+		return 1
+	}
+
+	entry, err := file.Commit()
+	if err != nil {
+		log.Error("Failed to write output file", zap.Error(err))
+		return 1
+	}
+
+	manifestPath := dataset.ManifestPath(*output)
+	if err := dataset.WriteManifest(manifestPath, dataset.NewPostManifest(format, filters, entry)); err != nil {
+		log.Error("Failed to write manifest", zap.Error(err))
+		return 1
+	}
+
+	log.Info("Export finished",
+		zap.String("output", *output),
+		zap.String("manifest", manifestPath),
+		zap.Int("rows", entry.Rows),
+		zap.Int64("bytes", entry.Bytes),
+		zap.String("sha256", entry.SHA256),
+	)
+	return 0
+}
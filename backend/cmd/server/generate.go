@@ -0,0 +1,200 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"go.uber.org/zap"
+
+	"fuck_boss/backend/internal/application/content"
+	"fuck_boss/backend/internal/infrastructure/dataset"
+	"fuck_boss/backend/internal/infrastructure/logger"
+	"fuck_boss/backend/internal/infrastructure/persistence/postgres"
+	"fuck_boss/backend/internal/infrastructure/synthetic"
+)
+
+// generateProgressInterval is how often (in posts) generate logs its progress.
+const generateProgressInterval = 100000
+
+// runGenerate runs the generate subcommand: it generates synthetic posts and
+// writes them to a JSON Lines file or imports them into the database, and
+// returns the exit code.
+func runGenerate(args []string) int {
+	flags := flag.NewFlagSet("generate", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: server generate [flags]\n\n"+
+			"Generates realistic synthetic posts for load tests and demo environments and\n"+
+			"imports them into the database, or writes them to a JSON Lines file that the\n"+
+			"import subcommand accepts. The same flags always generate the same posts.\n\n")
+		flags.PrintDefaults()
+	}
+	count := flags.Int("count", 0, "number of posts to generate (required)")
+	seed := flags.Int64("seed", 1, "random seed")
+	companies := flags.Int("companies", synthetic.DefaultCompanies, "number of distinct companies")
+	zipfS := flags.Float64("zipf", synthetic.DefaultZipfS, "Zipf exponent of company popularity (greater than 1)")
+	days := flags.Int("days", synthetic.DefaultDays, "spread created_at over this many days before --until")
+	untilDate := flags.String("until", "", "end of the created_at window (YYYY-MM-DD, UTC, exclusive; default today)")
+	output := flags.String("output", "", "write JSON Lines to this file instead of the database")
+	source := flags.String("source", "synthetic", "import source of the posts in the database")
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+	if flags.NArg() > 0 {
+		fmt.Fprintf(os.Stderr, "Unexpected arguments: %v\n", flags.Args())
+		return 2
+	}
+	if *count <= 0 {
+		fmt.Fprintln(os.Stderr, "--count must be positive")
+		return 2
+	}
+
+	until := time.Now().UTC().Truncate(24 * time.Hour)
+	if *untilDate != "" {
+		var err error
+		until, err = time.Parse(dateLayout, *untilDate)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Invalid --until date: %v\n", err)
+			return 2
+		}
+		if until.After(time.Now()) {
+			fmt.Fprintln(os.Stderr, "--until cannot be in the future")
+			return 2
+		}
+	}
+
+	generator, err := synthetic.NewGenerator(synthetic.Options{
+		Count:     *count,
+		Seed:      *seed,
+		Companies: *companies,
+		ZipfS:     *zipfS,
+		Until:     until,
+		Days:      *days,
+	})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+
+	if *output != "" {
+		return generateFile(*output, generator)
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load configuration: %v\n", err)
+		return 1
+	}
+
+	// Log to stderr like the other subcommands
+	log, err := logger.NewLoggerFromConfig(&logger.LogConfig{
+		Level:            cfg.Log.Level,
+		Format:           cfg.Log.Format,
+		OutputPaths:      []string{"stderr"},
+		ErrorOutputPaths: []string{"stderr"},
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to initialize logger: %v\n", err)
+		return 1
+	}
+	defer log.Sync()
+
+	db, err := connectDatabase(cfg.Database, log)
+	if err != nil {
+		log.Error("Failed to connect to database", zap.Error(err))
+		return 1
+	}
+	defer db.Close()
+
+	cacheRepo, closeCache := connectImportCache(cfg.Redis, log)
+	defer closeCache()
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	// Generated posts go through the import, so they are validated like
+	// imported datasets and generating again with the same seed adds nothing
+	started := time.Now()
+	generated := 0
+	next := func() (*content.ImportPostRecord, error) {
+		record, err := generator.Next()
+		if err == nil {
+			generated++
+			if generated%generateProgressInterval == 0 {
+				log.Info("Generating posts",
+					zap.Int("generated", generated),
+					zap.Int("count", *count),
+					zap.Duration("elapsed", time.Since(started)),
+				)
+			}
+		}
+		return record, err
+	}
+
+	importPostsUseCase := content.NewImportPostsUseCase(postgres.NewPostImportRepository(db), cacheRepo)
+	report, err := importPostsUseCase.Execute(ctx, content.ImportPostsCommand{Source: *source}, next)
+	if err != nil {
+		log.Error("Generate failed", zap.Int("generated", generated), zap.Error(err))
+		return 1
+	}
+	for _, rowErr := range report.Errors {
+		log.Warn("Invalid generated post", zap.String("external_id", rowErr.ExternalID), zap.String("error", rowErr.Error))
+	}
+
+	log.Info("Generate finished",
+		zap.String("source", *source),
+		zap.Int("imported", report.Imported),
+		zap.Int("skipped", report.Skipped),
+		zap.Int("invalid", report.Invalid),
+		zap.Duration("elapsed", time.Since(started)),
+	)
+	if report.Invalid > 0 {
+		return 1
+	}
+	return 0
+}
+
+// generateFile writes the generated posts to a JSON Lines file and returns
+// the exit code.
+func generateFile(path string, generator *synthetic.Generator) int {
+	file, err := os.Create(path)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	w := dataset.NewImportWriter(file)
+	for {
+		record, err := generator.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err == nil {
+			err = w.Write(record)
+		}
+		if err != nil {
+			file.Close()
+			fmt.Fprintf(os.Stderr, "Failed to write %s: %v\n", path, err)
+			return 1
+		}
+	}
+	if err := w.Close(); err != nil {
+		file.Close()
+		fmt.Fprintf(os.Stderr, "Failed to write %s: %v\n", path, err)
+		return 1
+	}
+	if err := file.Close(); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to write %s: %v\n", path, err)
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,223 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"go.uber.org/zap"
+
+	"fuck_boss/backend/internal/application/cache"
+	"fuck_boss/backend/internal/application/content"
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/infrastructure/config"
+	"fuck_boss/backend/internal/infrastructure/dataset"
+	"fuck_boss/backend/internal/infrastructure/logger"
+	"fuck_boss/backend/internal/infrastructure/persistence/postgres"
+	redispersistence "fuck_boss/backend/internal/infrastructure/persistence/redis"
+)
+
+// importReport is the JSON report written with --report.
+type importReport struct {
+	Source   string           `json:"source"`
+	File     string           `json:"file"`
+	DryRun   bool             `json:"dry_run"`
+	Rows     int              `json:"rows"`
+	Imported int              `json:"imported"`
+	Skipped  int              `json:"skipped"`
+	Invalid  int              `json:"invalid"`
+	Errors   []importRowError `json:"errors"`
+}
+
+// importRowError is a rejected row in the JSON report.
+type importRowError struct {
+	Line       int    `json:"line"`
+	ExternalID string `json:"external_id,omitempty"`
+	Error      string `json:"error"`
+}
+
+// newImportReport converts the import result to the JSON report.
+func newImportReport(source, path string, result *dto.ImportReportDTO) *importReport {
+	report := &importReport{
+		Source:   source,
+		File:     path,
+		DryRun:   result.DryRun,
+		Rows:     result.Rows,
+		Imported: result.Imported,
+		Skipped:  result.Skipped,
+		Invalid:  result.Invalid,
+		Errors:   make([]importRowError, len(result.Errors)),
+	}
+	for i, rowErr := range result.Errors {
+		report.Errors[i] = importRowError{Line: rowErr.Line, ExternalID: rowErr.ExternalID, Error: rowErr.Error}
+	}
+	return report
+}
+
+// runImport runs the import subcommand: it validates the rows of a CSV or
+// JSON Lines file like new posts and imports the valid ones, printing a
+// per-row error report, and returns the exit code.
+func runImport(args []string) int {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: server import [flags] <file>\n\n"+
+			"Imports posts from a CSV (with a header row) or JSON Lines file with the columns\n"+
+			"external_id, created_at, city_code, city_name, company, content and optionally\n"+
+			"occurred_at and resolution_status. Rows already imported from the same --source\n"+
+			"are skipped, so an import can be run again after fixing the invalid rows.\n\n")
+		flags.PrintDefaults()
+	}
+	formatName := flags.String("format", "", "file format: csv or jsonl (default from the file extension)")
+	source := flags.String("source", "", "name of the dataset, e.g. partner-a (required; external IDs are unique per source)")
+	dryRun := flags.Bool("dry-run", false, "validate the rows and report what would be imported without writing anything")
+	timezone := flags.String("timezone", "UTC", "time zone of created_at values without an offset, e.g. Asia/Shanghai")
+	reportPath := flags.String("report", "", "also write the report as JSON to this file")
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return 2
+	}
+	path := flags.Arg(0)
+
+	if *source == "" {
+		fmt.Fprintln(os.Stderr, "--source is required")
+		return 2
+	}
+	if *formatName == "" {
+		*formatName = strings.TrimPrefix(filepath.Ext(path), ".")
+	}
+	format, err := dataset.ParseFormat(*formatName)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+	location, err := time.LoadLocation(*timezone)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid --timezone: %v\n", err)
+		return 2
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	defer file.Close()
+
+	reader, err := dataset.NewPostReader(format, file)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", path, err)
+		return 1
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load configuration: %v\n", err)
+		return 1
+	}
+
+	// Log to stderr; the report goes to stdout
+	log, err := logger.NewLoggerFromConfig(&logger.LogConfig{
+		Level:            cfg.Log.Level,
+		Format:           cfg.Log.Format,
+		OutputPaths:      []string{"stderr"},
+		ErrorOutputPaths: []string{"stderr"},
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to initialize logger: %v\n", err)
+		return 1
+	}
+	defer log.Sync()
+
+	db, err := connectDatabase(cfg.Database, log)
+	if err != nil {
+		log.Error("Failed to connect to database", zap.Error(err))
+		return 1
+	}
+	defer db.Close()
+
+	var cacheRepo cache.CacheRepository
+	if !*dryRun {
+		var closeCache func()
+		cacheRepo, closeCache = connectImportCache(cfg.Redis, log)
+		defer closeCache()
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	importPostsUseCase := content.NewImportPostsUseCase(postgres.NewPostImportRepository(db), cacheRepo)
+	report, err := importPostsUseCase.Execute(ctx, content.ImportPostsCommand{
+		Source:   *source,
+		DryRun:   *dryRun,
+		Location: location,
+	}, reader.Read)
+	if err != nil {
+		if report != nil {
+			log.Error("Import failed", zap.Int("rows", report.Rows), zap.Int("imported", report.Imported), zap.Error(err))
+		} else {
+			log.Error("Import failed", zap.Error(err))
+		}
+		return 1
+	}
+
+	for _, rowErr := range report.Errors {
+		if rowErr.ExternalID != "" {
+			fmt.Printf("line %d [%s]: %s\n", rowErr.Line, rowErr.ExternalID, rowErr.Error)
+		} else {
+			fmt.Printf("line %d: %s\n", rowErr.Line, rowErr.Error)
+		}
+	}
+	if report.Invalid > len(report.Errors) {
+		fmt.Printf("... and %d more invalid rows\n", report.Invalid-len(report.Errors))
+	}
+	verb := "imported"
+	if report.DryRun {
+		verb = "would import"
+	}
+	fmt.Printf("%d rows: %s %d, skipped %d already imported, %d invalid\n",
+		report.Rows, verb, report.Imported, report.Skipped, report.Invalid)
+
+	if *reportPath != "" {
+		data, err := json.MarshalIndent(newImportReport(*source, path, report), "", "  ")
+		if err == nil {
+			err = os.WriteFile(*reportPath, append(data, '\n'), 0o644)
+		}
+		if err != nil {
+			log.Error("Failed to write report", zap.Error(err))
+			return 1
+		}
+	}
+
+	// Let scripts tell a clean import from one with rejected rows
+	if report.Invalid > 0 {
+		return 3
+	}
+	return 0
+}
+
+// connectImportCache connects to Redis to clear the post list caches after
+// importing. The caches expire anyway, so Redis is optional: on failure it
+// logs a warning and returns a nil repository. The returned function closes
+// the connection.
+func connectImportCache(cfg config.RedisConfig, log logger.Logger) (cache.CacheRepository, func()) {
+	redisClient, err := connectRedis(cfg, log)
+	if err != nil {
+		log.Warn("Failed to connect to Redis, post list caches will not be cleared", zap.Error(err))
+		return nil, func() {}
+	}
+	return redispersistence.NewCacheRepository(redisClient), func() { redisClient.Close() }
+}
@@ -4,11 +4,14 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
 	"database/sql"
 	"fmt"
+	"html/template"
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
@@ -19,19 +22,53 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 
+	adminv1 "fuck_boss/backend/api/proto/admin/v1"
+	authv1 "fuck_boss/backend/api/proto/auth/v1"
+	companyv1 "fuck_boss/backend/api/proto/company/v1"
 	contentv1 "fuck_boss/backend/api/proto/content/v1"
+	moderationv1 "fuck_boss/backend/api/proto/moderation/v1"
+	notificationv1 "fuck_boss/backend/api/proto/notification/v1"
+	watchlistv1 "fuck_boss/backend/api/proto/watchlist/v1"
+	adminapp "fuck_boss/backend/internal/application/admin"
+	attachmentapp "fuck_boss/backend/internal/application/attachment"
+	"fuck_boss/backend/internal/application/blob"
+	companyapp "fuck_boss/backend/internal/application/company"
 	"fuck_boss/backend/internal/application/content"
+	identityapp "fuck_boss/backend/internal/application/identity"
+	moderationapp "fuck_boss/backend/internal/application/moderation"
+	notificationapp "fuck_boss/backend/internal/application/notification"
 	"fuck_boss/backend/internal/application/search"
+	"fuck_boss/backend/internal/application/verification"
+	watchlistapp "fuck_boss/backend/internal/application/watchlist"
+	"fuck_boss/backend/internal/infrastructure/auth"
+	blobinfra "fuck_boss/backend/internal/infrastructure/blob"
+	challengeinfra "fuck_boss/backend/internal/infrastructure/challenge"
 	"fuck_boss/backend/internal/infrastructure/config"
+	imaginginfra "fuck_boss/backend/internal/infrastructure/imaging"
 	"fuck_boss/backend/internal/infrastructure/logger"
 	"fuck_boss/backend/internal/infrastructure/persistence/postgres"
 	redispersistence "fuck_boss/backend/internal/infrastructure/persistence/redis"
+	verificationinfra "fuck_boss/backend/internal/infrastructure/verification"
 	grpchandler "fuck_boss/backend/internal/presentation/grpc"
 	"fuck_boss/backend/internal/presentation/middleware"
 	resthandler "fuck_boss/backend/internal/presentation/rest"
 )
 
 func main() {
+	// Subcommands
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		os.Exit(runExport(os.Args[2:]))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "import" {
+		os.Exit(runImport(os.Args[2:]))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "generate" {
+		os.Exit(runGenerate(os.Args[2:]))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "admin" {
+		os.Exit(runAdmin(os.Args[2:]))
+	}
+
 	// Load configuration
 	cfg, err := loadConfig()
 	if err != nil {
@@ -101,12 +138,141 @@ func main() {
 	postRepo := postgres.NewPostRepository(db)
 	cacheRepo := redispersistence.NewCacheRepository(redisClient)
 	rateLimiter := redispersistence.NewRateLimiter(redisClient)
+	representativeRepo := postgres.NewRepresentativeRepository(db)
+	domainApprovalRepo := postgres.NewDomainApprovalRepository(db)
+	challengeVerifier := challengeinfra.NewDefaultVerifier(10 * time.Second)
+	userRepo := postgres.NewUserRepository(db)
+	refreshTokenRepo := postgres.NewRefreshTokenRepository(db)
+	deviceRepo := postgres.NewDeviceRepository(db)
+	bookmarkRepo := postgres.NewBookmarkRepository(db)
+	watchRepo := postgres.NewWatchRepository(db)
+	notificationRepo := postgres.NewNotificationRepository(db)
+	notifier := notificationapp.NewNotifier(notificationRepo, watchRepo)
+	attachmentRepo := postgres.NewAttachmentRepository(db)
+	engagementRepo := postgres.NewEngagementRepository(db)
+	apiKeyRepo := postgres.NewAPIKeyRepository(db)
+	auditRepo := postgres.NewAuditRepository(db)
+	moderatorRepo := postgres.NewModeratorRepository(db)
+	appealRepo := postgres.NewAppealRepository(db)
+	viewCounter := redispersistence.NewViewCounter(redisClient)
+	rankingStore := redispersistence.NewRankingStore(redisClient)
+	postBroker := redispersistence.NewPostBroker(redisClient, int64(cfg.LiveFeed.HistorySize))
+
+	// Initialize attachment storage
+	blobStore, err := newBlobStore(cfg.Attachment)
+	if err != nil {
+		log.Error("Failed to initialize attachment storage", zap.Error(err))
+		os.Exit(1)
+	}
+	sitemapStore, err := blobinfra.NewFileBlobStore(cfg.Sitemap.Dir)
+	if err != nil {
+		log.Error("Failed to initialize sitemap storage", zap.Error(err))
+		os.Exit(1)
+	}
+
+	// Initialize share card rendering
+	shareCardRenderer, err := imaginginfra.NewShareCardRenderer()
+	if err != nil {
+		log.Error("Failed to initialize share card renderer", zap.Error(err))
+		os.Exit(1)
+	}
+	if !shareCardRenderer.Covers("北京公司") {
+		log.Error("No CJK font is embedded, share cards cannot show Chinese text (see scripts/subset_font.sh)")
+		os.Exit(1)
+	}
+
+	// Initialize authentication
+	passwordHasher := auth.NewArgon2Hasher(auth.DefaultArgon2Params())
+	tokenIssuer, err := newTokenIssuer(cfg.Auth, log)
+	if err != nil {
+		log.Error("Failed to initialize token issuer", zap.Error(err))
+		os.Exit(1)
+	}
+
+	// Initialize verification codes
+	codeSender, err := newCodeSender(cfg.Verification, log)
+	if err != nil {
+		log.Error("Failed to initialize verification code sender", zap.Error(err))
+		os.Exit(1)
+	}
+	verificationService := verification.NewService(
+		cacheRepo,
+		rateLimiter,
+		codeSender,
+		time.Duration(cfg.Verification.CodeTTL)*time.Second,
+		cfg.Verification.MaxAttempts,
+	)
+	var registerCodes identityapp.CodeVerifier
+	if cfg.Verification.RequireOnRegister {
+		registerCodes = verificationService
+	}
 
 	// Initialize use cases
-	createUseCase := content.NewCreatePostUseCase(postRepo, cacheRepo, rateLimiter)
+	createUseCase := content.NewCreatePostUseCase(postRepo, cacheRepo, rateLimiter, notifier, postBroker)
 	listUseCase := content.NewListPostsUseCase(postRepo, cacheRepo)
 	getUseCase := content.NewGetPostUseCase(postRepo, cacheRepo)
 	searchUseCase := search.NewSearchPostsUseCase(postRepo, cacheRepo)
+	followUpUseCase := content.NewAppendFollowUpUseCase(postRepo, cacheRepo)
+	registerRepresentativeUseCase := companyapp.NewRegisterRepresentativeUseCase(representativeRepo, rateLimiter)
+	verifyRepresentativeUseCase := companyapp.NewVerifyRepresentativeUseCase(representativeRepo, domainApprovalRepo, challengeVerifier, rateLimiter)
+	officialReplyUseCase := companyapp.NewPostOfficialReplyUseCase(representativeRepo, domainApprovalRepo, postRepo, cacheRepo, notifier)
+	listMyPostsUseCase := content.NewListMyPostsUseCase(postRepo)
+	registerUseCase := identityapp.NewRegisterUseCase(userRepo, refreshTokenRepo, passwordHasher, tokenIssuer, rateLimiter, registerCodes)
+	loginUseCase := identityapp.NewLoginUseCase(userRepo, refreshTokenRepo, passwordHasher, tokenIssuer, rateLimiter)
+	refreshUseCase := identityapp.NewRefreshUseCase(userRepo, refreshTokenRepo, tokenIssuer)
+	logoutUseCase := identityapp.NewLogoutUseCase(refreshTokenRepo)
+	issueDeviceTokenUseCase := watchlistapp.NewIssueDeviceTokenUseCase(deviceRepo, rateLimiter)
+	addBookmarkUseCase := watchlistapp.NewAddBookmarkUseCase(bookmarkRepo, deviceRepo, postRepo)
+	removeBookmarkUseCase := watchlistapp.NewRemoveBookmarkUseCase(bookmarkRepo, deviceRepo)
+	listBookmarksUseCase := watchlistapp.NewListBookmarksUseCase(bookmarkRepo, deviceRepo, postRepo)
+	watchCompanyUseCase := watchlistapp.NewWatchCompanyUseCase(watchRepo, deviceRepo)
+	unwatchCompanyUseCase := watchlistapp.NewUnwatchCompanyUseCase(watchRepo, deviceRepo)
+	listWatchedCompaniesUseCase := watchlistapp.NewListWatchedCompaniesUseCase(watchRepo, deviceRepo)
+	listWatchedPostsUseCase := watchlistapp.NewListWatchedPostsUseCase(watchRepo, deviceRepo, postRepo)
+	listNotificationsUseCase := notificationapp.NewListNotificationsUseCase(notificationRepo)
+	countUnreadUseCase := notificationapp.NewCountUnreadUseCase(notificationRepo)
+	markReadUseCase := notificationapp.NewMarkReadUseCase(notificationRepo)
+	markAllReadUseCase := notificationapp.NewMarkAllReadUseCase(notificationRepo)
+	uploadAttachmentUseCase := attachmentapp.NewUploadAttachmentUseCase(attachmentRepo, postRepo, blobStore, imaginginfra.NewProcessor())
+	listAttachmentsUseCase := attachmentapp.NewListAttachmentsUseCase(attachmentRepo)
+	downloadAttachmentUseCase := attachmentapp.NewDownloadAttachmentUseCase(attachmentRepo, blobStore)
+	recordViewUseCase := content.NewRecordViewUseCase(viewCounter)
+	listTrendingUseCase := content.NewListTrendingPostsUseCase(postRepo, rankingStore)
+	refreshTrendingUseCase := content.NewRefreshTrendingUseCase(
+		engagementRepo,
+		viewCounter,
+		rankingStore,
+		time.Duration(cfg.Trending.Window)*time.Hour,
+		cfg.Trending.Size,
+	)
+	listRelatedUseCase := content.NewListRelatedPostsUseCase(postRepo, cacheRepo)
+	watchPostsUseCase := content.NewWatchPostsUseCase(postBroker, postBroker)
+	listFeedPostsUseCase := content.NewListFeedPostsUseCase(postRepo, cacheRepo)
+	refreshRelatedUseCase := content.NewRefreshRelatedUseCase(
+		postRepo,
+		cacheRepo,
+		time.Duration(cfg.Related.Window)*time.Hour,
+		cfg.Related.MaxPosts,
+		cfg.Related.Limit,
+		// Keep entries across one failed refresh
+		3*time.Duration(cfg.Related.RefreshInterval)*time.Second,
+	)
+	refreshSitemapUseCase := content.NewRefreshSitemapUseCase(postRepo, sitemapStore, cfg.Site.BaseURL, 0)
+	getSitemapUseCase := content.NewGetSitemapUseCase(sitemapStore)
+	getShareCardUseCase := content.NewGetShareCardUseCase(getUseCase, cacheRepo, shareCardRenderer, cfg.Site.Name)
+	moderatePostUseCase := content.NewModeratePostUseCase(postRepo, cacheRepo, notifier, postBroker)
+	exportPostsUseCase := content.NewExportPostsUseCase(postRepo)
+	authenticateAPIKeyUseCase := adminapp.NewAuthenticateAPIKeyUseCase(apiKeyRepo)
+	recordAuditEventUseCase := adminapp.NewRecordAuditEventUseCase(auditRepo)
+	listAuditEventsUseCase := adminapp.NewListAuditEventsUseCase(auditRepo)
+	authorizeModeratorUseCase := moderationapp.NewAuthorizeModeratorUseCase(moderatorRepo)
+	listModerationQueueUseCase := moderationapp.NewListModerationQueueUseCase(moderatorRepo, postRepo)
+	moderatorModeratePostUseCase := moderationapp.NewModeratePostUseCase(moderatorRepo, postRepo, moderatePostUseCase)
+	banUserUseCase := moderationapp.NewBanUserUseCase(moderatorRepo, postRepo, userRepo)
+	fileAppealUseCase := moderationapp.NewFileAppealUseCase(postRepo, appealRepo)
+	getAppealStatusUseCase := moderationapp.NewGetAppealStatusUseCase(postRepo, appealRepo)
+	listAppealsUseCase := moderationapp.NewListAppealsUseCase(moderatorRepo, appealRepo, postRepo)
+	decideAppealUseCase := moderationapp.NewDecideAppealUseCase(moderatorRepo, appealRepo, postRepo, moderatePostUseCase)
 
 	// Create gRPC service
 	contentService := grpchandler.NewContentService(
@@ -114,20 +280,111 @@ func main() {
 		listUseCase,
 		getUseCase,
 		searchUseCase,
+		followUpUseCase,
+		listMyPostsUseCase,
+		recordViewUseCase,
+		listTrendingUseCase,
+		listRelatedUseCase,
+		watchPostsUseCase,
+	)
+	companyService := grpchandler.NewCompanyService(
+		registerRepresentativeUseCase,
+		verifyRepresentativeUseCase,
+		officialReplyUseCase,
+	)
+	authService := grpchandler.NewAuthService(
+		registerUseCase,
+		loginUseCase,
+		refreshUseCase,
+		logoutUseCase,
+		verificationService,
 	)
+	watchlistService := grpchandler.NewWatchlistService(
+		issueDeviceTokenUseCase,
+		addBookmarkUseCase,
+		removeBookmarkUseCase,
+		listBookmarksUseCase,
+		watchCompanyUseCase,
+		unwatchCompanyUseCase,
+		listWatchedCompaniesUseCase,
+		listWatchedPostsUseCase,
+	)
+	notificationService := grpchandler.NewNotificationService(
+		listNotificationsUseCase,
+		countUnreadUseCase,
+		markReadUseCase,
+		markAllReadUseCase,
+	)
+	adminService := grpchandler.NewAdminService(
+		moderatePostUseCase,
+		listAuditEventsUseCase,
+	)
+	moderationService := grpchandler.NewModerationService(
+		listModerationQueueUseCase,
+		moderatorModeratePostUseCase,
+		banUserUseCase,
+		fileAppealUseCase,
+		getAppealStatusUseCase,
+		listAppealsUseCase,
+		decideAppealUseCase,
+	)
+
+	// Forwarding headers are only believed from the configured reverse proxies
+	trustedProxies, err := middleware.ParseTrustedProxies(cfg.GRPC.TrustedProxies)
+	if err != nil {
+		log.Error("Invalid trusted proxies", zap.Error(err))
+		os.Exit(1)
+	}
 
 	// Create gRPC server with middleware
 	grpcServer := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
 			middleware.RecoveryInterceptor(log),
+			middleware.ClientIPInterceptor(trustedProxies),
+			middleware.AuthInterceptor(tokenIssuer, log,
+				contentv1.ContentService_ListMyPosts_FullMethodName,
+				notificationv1.NotificationService_ListNotifications_FullMethodName,
+				notificationv1.NotificationService_GetUnreadCount_FullMethodName,
+				notificationv1.NotificationService_MarkNotificationRead_FullMethodName,
+				notificationv1.NotificationService_MarkAllNotificationsRead_FullMethodName,
+				moderationv1.ModerationService_ListModerationQueue_FullMethodName,
+				moderationv1.ModerationService_ModeratePost_FullMethodName,
+				moderationv1.ModerationService_BanUser_FullMethodName,
+				moderationv1.ModerationService_ListAppeals_FullMethodName,
+				moderationv1.ModerationService_DecideAppeal_FullMethodName,
+			),
+			middleware.APIKeyInterceptor(authenticateAPIKeyUseCase, recordAuditEventUseCase, log, trustedProxies, map[string]string{
+				adminv1.AdminService_ModeratePost_FullMethodName:    "moderate",
+				adminv1.AdminService_ListAuditEvents_FullMethodName: "admin",
+			}),
+			// ModeratePost needs at least HIDE_POST here; the use case checks the permission of the action.
+			// FileAppeal and GetAppealStatus are for authors and check the management token instead.
+			middleware.ModeratorInterceptor(authorizeModeratorUseCase, recordAuditEventUseCase, log, trustedProxies, map[string]string{
+				moderationv1.ModerationService_ListModerationQueue_FullMethodName: "VIEW_QUEUE",
+				moderationv1.ModerationService_ModeratePost_FullMethodName:        "HIDE_POST",
+				moderationv1.ModerationService_BanUser_FullMethodName:             "BAN_USER",
+				moderationv1.ModerationService_ListAppeals_FullMethodName:         "DECIDE_APPEAL",
+				moderationv1.ModerationService_DecideAppeal_FullMethodName:        "DECIDE_APPEAL",
+			}),
 			middleware.LoggingInterceptor(log),
 		),
+		grpc.ChainStreamInterceptor(
+			middleware.StreamRecoveryInterceptor(log),
+			middleware.StreamClientIPInterceptor(trustedProxies),
+			middleware.StreamLoggingInterceptor(log),
+		),
 		grpc.MaxRecvMsgSize(cfg.GRPC.MaxRecvMsgSize),
 		grpc.MaxSendMsgSize(cfg.GRPC.MaxSendMsgSize),
 	)
 
 	// Register services
 	contentv1.RegisterContentServiceServer(grpcServer, contentService)
+	companyv1.RegisterCompanyServiceServer(grpcServer, companyService)
+	authv1.RegisterAuthServiceServer(grpcServer, authService)
+	watchlistv1.RegisterWatchlistServiceServer(grpcServer, watchlistService)
+	notificationv1.RegisterNotificationServiceServer(grpcServer, notificationService)
+	adminv1.RegisterAdminServiceServer(grpcServer, adminService)
+	moderationv1.RegisterModerationServiceServer(grpcServer, moderationService)
 
 	// Enable reflection for gRPC tools (e.g., grpcurl, grpcui)
 	reflection.Register(grpcServer)
@@ -138,6 +395,9 @@ func main() {
 			// Allow all origins for development (in production, restrict this)
 			return true
 		}),
+		// Server-streaming RPCs (WatchPosts) can also use the websocket transport
+		grpcweb.WithWebsockets(true),
+		grpcweb.WithWebsocketPingInterval(30*time.Second),
 		grpcweb.WithWebsocketOriginFunc(func(req *http.Request) bool {
 			// Allow all origins for WebSocket connections
 			return true
@@ -150,32 +410,177 @@ func main() {
 		listUseCase,
 		getUseCase,
 		searchUseCase,
+		followUpUseCase,
+		listMyPostsUseCase,
+		recordViewUseCase,
+		listTrendingUseCase,
+		listRelatedUseCase,
+		log,
+	)
+	postStreamHandler := resthandler.NewPostStreamHandler(
+		watchPostsUseCase,
+		resthandler.StreamLimits{
+			HeartbeatInterval:   time.Duration(cfg.LiveFeed.HeartbeatInterval) * time.Second,
+			MaxConnections:      cfg.LiveFeed.MaxConnections,
+			MaxConnectionsPerIP: cfg.LiveFeed.MaxConnectionsPerIP,
+			MaxDuration:         time.Duration(cfg.LiveFeed.MaxConnectionDuration) * time.Second,
+		},
+		log,
+	)
+	feedHandler := resthandler.NewFeedHandler(listFeedPostsUseCase, cfg.Site.BaseURL, cfg.Site.Name, log)
+	var spaAssets template.HTML
+	if cfg.Site.SPAIndex != "" {
+		// Without the SPA assets the pages are still served, just not taken over by the SPA
+		spaAssets, err = resthandler.LoadSPAAssets(cfg.Site.SPAIndex)
+		if err != nil {
+			log.Warn("Failed to load SPA assets, serving plain pages", zap.Error(err))
+		}
+	}
+	pageHandler := resthandler.NewPageHandler(
+		getUseCase,
+		listUseCase,
+		recordViewUseCase,
+		cfg.Site.BaseURL,
+		cfg.Site.Name,
+		spaAssets,
 		log,
 	)
+	sitemapHandler := resthandler.NewSitemapHandler(getSitemapUseCase, log)
+	shareCardHandler := resthandler.NewShareCardHandler(getShareCardUseCase, log)
+	companyHandler := resthandler.NewCompanyHandler(
+		registerRepresentativeUseCase,
+		verifyRepresentativeUseCase,
+		officialReplyUseCase,
+		log,
+	)
+	authHandler := resthandler.NewAuthHandler(
+		registerUseCase,
+		loginUseCase,
+		refreshUseCase,
+		logoutUseCase,
+		verificationService,
+		log,
+	)
+	watchlistHandler := resthandler.NewWatchlistHandler(
+		issueDeviceTokenUseCase,
+		addBookmarkUseCase,
+		removeBookmarkUseCase,
+		listBookmarksUseCase,
+		watchCompanyUseCase,
+		unwatchCompanyUseCase,
+		listWatchedCompaniesUseCase,
+		listWatchedPostsUseCase,
+		log,
+	)
+	notificationHandler := resthandler.NewNotificationHandler(
+		listNotificationsUseCase,
+		countUnreadUseCase,
+		markReadUseCase,
+		markAllReadUseCase,
+		log,
+	)
+	attachmentHandler := resthandler.NewAttachmentHandler(
+		uploadAttachmentUseCase,
+		listAttachmentsUseCase,
+		downloadAttachmentUseCase,
+		log,
+	)
+	adminHandler := resthandler.NewAdminHandler(exportPostsUseCase, log)
 
 	// Create HTTP mux for routing
 	mux := http.NewServeMux()
 
+	// authenticated resolves the optional bearer access token before calling the handler
+	authenticated := middleware.AuthMiddleware(tokenIssuer)
+
+	// apiKey requires an API key with the scope and records the call in the audit log
+	apiKey := func(scope string) func(http.HandlerFunc) http.HandlerFunc {
+		return middleware.APIKeyMiddleware(authenticateAPIKeyUseCase, recordAuditEventUseCase, log, trustedProxies, scope)
+	}
+
 	// REST API routes with CORS support
-	mux.HandleFunc("/api/posts", middleware.CORSMiddleware(func(w http.ResponseWriter, r *http.Request) {
+	mux.HandleFunc("/api/posts", middleware.CORSMiddleware(authenticated(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method == http.MethodPost {
 			restHandler.CreatePost(w, r)
 		} else {
 			restHandler.ListPosts(w, r)
 		}
-	}))
+	})))
 	mux.HandleFunc("/api/posts/", middleware.CORSMiddleware(func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Path == "/api/posts/" {
 			restHandler.ListPosts(w, r)
+		} else if strings.HasSuffix(r.URL.Path, "/follow-ups") {
+			restHandler.AppendFollowUp(w, r)
+		} else if strings.HasSuffix(r.URL.Path, "/official-reply") {
+			companyHandler.PostOfficialReply(w, r)
+		} else if strings.HasSuffix(r.URL.Path, "/attachments") {
+			attachmentHandler.Attachments(w, r)
+		} else if strings.HasSuffix(r.URL.Path, "/related") {
+			restHandler.ListRelatedPosts(w, r)
 		} else {
-			restHandler.GetPost(w, r)
+			// Logged-in viewers are counted by user instead of IP
+			authenticated(restHandler.GetPost)(w, r)
 		}
 	}))
 	mux.HandleFunc("/api/posts/search", middleware.CORSMiddleware(restHandler.SearchPosts))
+	mux.HandleFunc("/api/posts/trending", middleware.CORSMiddleware(restHandler.ListTrendingPosts))
+	mux.HandleFunc("/api/posts/stream", middleware.CORSMiddleware(postStreamHandler.StreamPosts))
+	mux.HandleFunc("/feeds/city/", middleware.CORSMiddleware(feedHandler.CityFeed))
+	mux.HandleFunc("/feeds/company/", middleware.CORSMiddleware(feedHandler.CompanyFeed))
+	mux.HandleFunc("/feeds/search", middleware.CORSMiddleware(feedHandler.SearchFeed))
+	mux.HandleFunc("/p/", middleware.CORSMiddleware(pageHandler.PostPage))
+	mux.HandleFunc("/city/", middleware.CORSMiddleware(pageHandler.CityPage))
+	mux.HandleFunc("/company/", middleware.CORSMiddleware(pageHandler.CompanyPage))
+	mux.HandleFunc("/sitemap.xml", middleware.CORSMiddleware(sitemapHandler.Sitemap))
+	mux.HandleFunc(resthandler.ShareCardURLPrefix, middleware.CORSMiddleware(shareCardHandler.ShareCard))
+	mux.HandleFunc(resthandler.AttachmentURLPrefix, middleware.CORSMiddleware(attachmentHandler.DownloadAttachment))
+	mux.HandleFunc("/api/representatives", middleware.CORSMiddleware(companyHandler.RegisterRepresentative))
+	mux.HandleFunc("/api/representatives/", middleware.CORSMiddleware(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/verify") {
+			companyHandler.VerifyRepresentative(w, r)
+		} else {
+			http.NotFound(w, r)
+		}
+	}))
+	mux.HandleFunc("/api/auth/register", middleware.CORSMiddleware(authHandler.Register))
+	mux.HandleFunc("/api/auth/login", middleware.CORSMiddleware(authHandler.Login))
+	mux.HandleFunc("/api/auth/refresh", middleware.CORSMiddleware(authHandler.Refresh))
+	mux.HandleFunc("/api/auth/logout", middleware.CORSMiddleware(authHandler.Logout))
+	mux.HandleFunc("/api/auth/verification-codes", middleware.CORSMiddleware(authHandler.SendVerificationCode))
+	mux.HandleFunc("/api/me/posts", middleware.CORSMiddleware(authenticated(restHandler.ListMyPosts)))
+	mux.HandleFunc("/api/devices", middleware.CORSMiddleware(watchlistHandler.IssueDeviceToken))
+	mux.HandleFunc("/api/bookmarks", middleware.CORSMiddleware(authenticated(watchlistHandler.Bookmarks)))
+	mux.HandleFunc("/api/bookmarks/", middleware.CORSMiddleware(authenticated(watchlistHandler.RemoveBookmark)))
+	mux.HandleFunc("/api/watches", middleware.CORSMiddleware(authenticated(watchlistHandler.Watches)))
+	mux.HandleFunc("/api/watches/posts", middleware.CORSMiddleware(authenticated(func(w http.ResponseWriter, r *http.Request) {
+		// A company literally named "posts" can still be unwatched
+		if r.Method == http.MethodDelete {
+			watchlistHandler.UnwatchCompany(w, r)
+		} else {
+			watchlistHandler.ListWatchedPosts(w, r)
+		}
+	})))
+	mux.HandleFunc("/api/watches/", middleware.CORSMiddleware(authenticated(watchlistHandler.UnwatchCompany)))
+	mux.HandleFunc("/api/notifications", middleware.CORSMiddleware(authenticated(notificationHandler.ListNotifications)))
+	mux.HandleFunc("/api/notifications/unread-count", middleware.CORSMiddleware(authenticated(notificationHandler.UnreadCount)))
+	mux.HandleFunc("/api/notifications/read-all", middleware.CORSMiddleware(authenticated(notificationHandler.MarkAllRead)))
+	mux.HandleFunc("/api/admin/export", middleware.CORSMiddleware(apiKey("export")(adminHandler.ExportPosts)))
+	mux.HandleFunc("/api/notifications/", middleware.CORSMiddleware(authenticated(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/read") {
+			notificationHandler.MarkRead(w, r)
+		} else {
+			http.NotFound(w, r)
+		}
+	})))
 
 	// gRPC Web handler (already has CORS support via grpcweb)
 	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
-		if wrappedServer.IsGrpcWebRequest(r) || wrappedServer.IsAcceptableGrpcCorsRequest(r) {
+		// Sitemap shards must live at the site root to cover its URLs
+		if resthandler.IsSitemapPath(r.URL.Path) {
+			sitemapHandler.Sitemap(w, r)
+			return
+		}
+		if wrappedServer.IsGrpcWebRequest(r) || wrappedServer.IsGrpcWebSocketRequest(r) || wrappedServer.IsAcceptableGrpcCorsRequest(r) {
 			wrappedServer.ServeHTTP(w, r)
 		} else {
 			// Fallback to standard gRPC for non-Web requests
@@ -186,8 +591,9 @@ func main() {
 	// Start HTTP server for gRPC Web and REST API
 	httpAddr := fmt.Sprintf(":%d", cfg.GRPC.Port)
 	httpServer := &http.Server{
-		Addr:    httpAddr,
-		Handler: mux,
+		Addr: httpAddr,
+		// Handlers read the client address resolved here, never the forwarding headers
+		Handler: middleware.ClientIPHandler(trustedProxies, mux),
 	}
 
 	log.Info("gRPC server listening",
@@ -197,6 +603,13 @@ func main() {
 		zap.Bool("grpc_web_enabled", true),
 	)
 
+	// Start background jobs
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+	go runJob(jobsCtx, "refresh trending posts", time.Duration(cfg.Trending.RefreshInterval)*time.Second, refreshTrendingUseCase.Execute, log)
+	go runJob(jobsCtx, "refresh related posts", time.Duration(cfg.Related.RefreshInterval)*time.Second, refreshRelatedUseCase.Execute, log)
+	go runJob(jobsCtx, "refresh sitemap", time.Duration(cfg.Sitemap.RefreshInterval)*time.Second, refreshSitemapUseCase.Execute, log)
+
 	// Start server in a goroutine
 	serverErrors := make(chan error, 1)
 	go func() {
@@ -220,6 +633,13 @@ func main() {
 
 	// Graceful shutdown
 	log.Info("Starting graceful shutdown...")
+	stopJobs()
+
+	// End live feed streams, so clients reconnect to another instance instead of
+	// holding the shutdown until the timeout
+	if err := postBroker.Close(); err != nil {
+		log.Warn("Error closing live feed", zap.Error(err))
+	}
 
 	// Create shutdown context with timeout
 	shutdownCtx, cancelShutdown := context.WithTimeout(context.Background(), 30*time.Second)
@@ -274,6 +694,74 @@ func loadConfig() (*config.Config, error) {
 	return cfg, nil
 }
 
+// newTokenIssuer creates the access token issuer from the auth configuration.
+// Without a configured secret, a random one is generated: tokens then do not
+// survive a restart and are not shared between instances.
+func newTokenIssuer(cfg config.AuthConfig, log logger.Logger) (*auth.JWTIssuer, error) {
+	secret := []byte(cfg.JWTSecret)
+	if len(secret) == 0 {
+		secret = make([]byte, auth.MinJWTSecretLength)
+		if _, err := rand.Read(secret); err != nil {
+			return nil, fmt.Errorf("failed to generate JWT secret: %w", err)
+		}
+		log.Warn("auth.jwt_secret is not configured, using a random secret (access tokens will not survive a restart)",
+			zap.String("env_var", "FUCK_BOSS_AUTH_JWT_SECRET"),
+		)
+	}
+
+	return auth.NewJWTIssuer(
+		secret,
+		cfg.Issuer,
+		time.Duration(cfg.AccessTokenTTL)*time.Second,
+		time.Duration(cfg.RefreshTokenTTL)*time.Second,
+	)
+}
+
+// newCodeSender creates the verification code sender selected by verification.sender.
+// The file sender does not deliver anything and logs a warning so it is not used in production by accident.
+func newCodeSender(cfg config.VerificationConfig, log logger.Logger) (verification.Sender, error) {
+	if strings.EqualFold(cfg.Sender, "smtp") {
+		return verificationinfra.NewSMTPSender(
+			cfg.SMTP.Host,
+			cfg.SMTP.Port,
+			cfg.SMTP.Username,
+			cfg.SMTP.Password,
+			cfg.SMTP.From,
+		)
+	}
+
+	log.Warn("verification codes are written to local files instead of being delivered",
+		zap.String("dir", cfg.FileDir),
+	)
+	return verificationinfra.NewFileSender(cfg.FileDir)
+}
+
+// newBlobStore creates the attachment blob store selected by the configuration.
+// Only the local filesystem is supported; config validation rejects other stores.
+func newBlobStore(cfg config.AttachmentConfig) (blob.BlobStore, error) {
+	return blobinfra.NewFileBlobStore(cfg.Dir)
+}
+
+// runJob runs fn once and then every interval until ctx is cancelled.
+// Every instance runs the jobs; they are idempotent, so concurrent runs only
+// repeat work.
+func runJob(ctx context.Context, name string, interval time.Duration, fn func(context.Context) error, log logger.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := fn(ctx); err != nil && ctx.Err() == nil {
+			log.Warn("Background job failed", zap.String("job", name), zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
 // connectDatabase connects to PostgreSQL database.
 func connectDatabase(cfg config.DatabaseConfig, log logger.Logger) (*sql.DB, error) {
 	dsn := fmt.Sprintf("postgres://%s:%s@%s:%d/%s?sslmode=%s",
@@ -450,5 +938,10 @@ func runMigrations(db *sql.DB, log logger.Logger) error {
 		}
 	}
 
+	// Apply versioned migrations (all idempotent)
+	if err := postgres.Migrate(ctx, db); err != nil {
+		return fmt.Errorf("failed to apply migrations: %w", err)
+	}
+
 	return nil
 }
@@ -0,0 +1,169 @@
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/domain/admin"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// RecordAuditEventCommand represents one privileged call to record.
+type RecordAuditEventCommand struct {
+	// APIKeyID is the API key that made the call (empty for the admin subcommands).
+	APIKeyID string
+
+	// Actor is the API key name, or admin.CLIActor (required).
+	Actor string
+
+	// Action is the gRPC method, HTTP endpoint or admin subcommand (required).
+	Action string
+
+	// Target is the ID of what the call acted on (optional).
+	Target string
+
+	// Outcome is "OK" or the gRPC status code name (required).
+	Outcome string
+
+	// ClientIP is the address the call came from (optional).
+	ClientIP string
+
+	// Details is a JSON object with the call parameters (optional).
+	Details string
+}
+
+// RecordAuditEventUseCase appends privileged calls to the audit log.
+type RecordAuditEventUseCase struct {
+	// repo is the audit repository.
+	repo admin.AuditRepository
+}
+
+// NewRecordAuditEventUseCase creates a new RecordAuditEventUseCase instance.
+func NewRecordAuditEventUseCase(repo admin.AuditRepository) *RecordAuditEventUseCase {
+	return &RecordAuditEventUseCase{
+		repo: repo,
+	}
+}
+
+// Execute appends the call to the audit log.
+func (uc *RecordAuditEventUseCase) Execute(ctx context.Context, cmd RecordAuditEventCommand) error {
+	event, err := admin.NewAuditEvent(cmd.APIKeyID, cmd.Actor, cmd.Action, cmd.Target, cmd.Outcome, cmd.ClientIP, cmd.Details)
+	if err != nil {
+		return apperrors.NewValidationErrorWithDetails("invalid audit event", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	return uc.repo.Append(ctx, event)
+}
+
+// ListAuditEventsQuery represents the query parameters for listing audit events.
+type ListAuditEventsQuery struct {
+	// APIKeyID restricts results to calls made with one API key (optional).
+	APIKeyID string
+
+	// Action restricts results to one action (optional).
+	Action string
+
+	// Target restricts results to calls on one target (optional).
+	Target string
+
+	// Since restricts results to events at or after this time (optional).
+	Since *time.Time
+
+	// Until restricts results to events before this time (optional).
+	Until *time.Time
+
+	// Cursor is the NextCursor of the previous page (optional, empty for the first page).
+	Cursor string
+
+	// PageSize is the number of items per page (default: 50, max: 200).
+	PageSize int
+}
+
+// ListAuditEventsUseCase lists the audit log, newest first, with cursor paging.
+type ListAuditEventsUseCase struct {
+	// repo is the audit repository.
+	repo admin.AuditRepository
+}
+
+// NewListAuditEventsUseCase creates a new ListAuditEventsUseCase instance.
+func NewListAuditEventsUseCase(repo admin.AuditRepository) *ListAuditEventsUseCase {
+	return &ListAuditEventsUseCase{
+		repo: repo,
+	}
+}
+
+// Execute executes the list audit events query.
+func (uc *ListAuditEventsUseCase) Execute(ctx context.Context, query ListAuditEventsQuery) (*dto.AuditEventsListDTO, error) {
+	// 1. Validate input
+	pageSize := query.PageSize
+	if pageSize < 1 {
+		pageSize = 50
+	}
+	if pageSize > 200 {
+		pageSize = 200
+	}
+
+	if query.APIKeyID != "" {
+		if _, err := uuid.Parse(query.APIKeyID); err != nil {
+			return nil, apperrors.NewValidationErrorWithDetails("invalid API key ID", map[string]interface{}{
+				"id": query.APIKeyID,
+			})
+		}
+	}
+	if query.Since != nil && query.Until != nil && !query.Since.Before(*query.Until) {
+		return nil, apperrors.NewValidationError("since must be before until")
+	}
+
+	var after *admin.AuditCursor
+	if query.Cursor != "" {
+		cursor, err := admin.ParseAuditCursor(query.Cursor)
+		if err != nil {
+			return nil, apperrors.NewValidationErrorWithDetails("invalid cursor", map[string]interface{}{
+				"error": err.Error(),
+			})
+		}
+		after = &cursor
+	}
+
+	filter := admin.AuditFilter{
+		APIKeyID: query.APIKeyID,
+		Action:   query.Action,
+		Target:   query.Target,
+		Since:    query.Since,
+		Until:    query.Until,
+	}
+
+	// 2. Query one extra event to know whether there is a next page
+	events, err := uc.repo.Find(ctx, filter, after, pageSize+1)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to query audit events", err)
+	}
+
+	result := &dto.AuditEventsListDTO{
+		Events: make([]*dto.AuditEventDTO, 0, len(events)),
+	}
+	if len(events) > pageSize {
+		events = events[:pageSize]
+		result.NextCursor = admin.AuditCursorAfter(events[pageSize-1]).String()
+	}
+	for _, e := range events {
+		result.Events = append(result.Events, &dto.AuditEventDTO{
+			ID:         e.ID(),
+			OccurredAt: e.OccurredAt(),
+			APIKeyID:   e.APIKeyID(),
+			Actor:      e.Actor(),
+			Action:     e.Action(),
+			Target:     e.Target(),
+			Outcome:    e.Outcome(),
+			ClientIP:   e.ClientIP(),
+			Details:    e.Details(),
+		})
+	}
+
+	return result, nil
+}
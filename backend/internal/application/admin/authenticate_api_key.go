@@ -0,0 +1,83 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/domain/admin"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// AuthenticateAPIKeyCommand represents the command to check an API key for a call.
+type AuthenticateAPIKeyCommand struct {
+	// Key is the raw API key presented by the caller.
+	Key string
+
+	// Scope is the scope the call requires (moderate, export or admin).
+	Scope string
+}
+
+// AuthenticateAPIKeyUseCase checks API keys for privileged calls and tracks
+// when each key was last used.
+type AuthenticateAPIKeyUseCase struct {
+	// repo is the APIKey repository.
+	repo admin.APIKeyRepository
+}
+
+// NewAuthenticateAPIKeyUseCase creates a new AuthenticateAPIKeyUseCase instance.
+func NewAuthenticateAPIKeyUseCase(repo admin.APIKeyRepository) *AuthenticateAPIKeyUseCase {
+	return &AuthenticateAPIKeyUseCase{
+		repo: repo,
+	}
+}
+
+// Execute returns the key if it is active and grants the scope.
+// Missing, unknown, revoked and expired keys get an UNAUTHENTICATED error;
+// a valid key without the scope gets a FORBIDDEN error together with the key,
+// so the denied call can still be attributed in the audit log.
+func (uc *AuthenticateAPIKeyUseCase) Execute(ctx context.Context, cmd AuthenticateAPIKeyCommand) (*dto.APIKeyDTO, error) {
+	// 1. Validate input
+	scope, err := admin.NewScope(cmd.Scope)
+	if err != nil {
+		return nil, apperrors.NewInternalErrorWithCause("invalid scope for method", err)
+	}
+	raw := strings.TrimSpace(cmd.Key)
+	if raw == "" {
+		return nil, apperrors.NewUnauthenticatedError("API key required")
+	}
+	if !strings.HasPrefix(raw, admin.APIKeyPrefix) {
+		return nil, apperrors.NewUnauthenticatedError("invalid API key")
+	}
+
+	// 2. Find the key by its hash
+	key, err := uc.repo.FindByHash(ctx, admin.HashAPIKey(raw))
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, apperrors.NewUnauthenticatedError("invalid API key")
+		}
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to query API key", err)
+	}
+
+	// 3. Check the key
+	now := time.Now()
+	if err := key.Authorize(scope, now); err != nil {
+		switch {
+		case errors.Is(err, admin.ErrScopeNotGranted):
+			return toAPIKeyDTO(key), apperrors.NewForbiddenError("API key does not grant the " + scope.String() + " scope")
+		case errors.Is(err, admin.ErrAPIKeyExpired):
+			return nil, apperrors.NewUnauthenticatedError("API key has expired")
+		default:
+			return nil, apperrors.NewUnauthenticatedError("API key has been revoked")
+		}
+	}
+
+	// 4. Track usage (errors are ignored; the call is already authorized)
+	if key.Use(now) {
+		_ = uc.repo.TouchLastUsed(ctx, key.ID(), now)
+	}
+
+	return toAPIKeyDTO(key), nil
+}
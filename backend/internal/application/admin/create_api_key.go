@@ -0,0 +1,87 @@
+package admin
+
+import (
+	"context"
+	"time"
+
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/domain/admin"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// CreateAPIKeyCommand represents the command to issue an API key.
+type CreateAPIKeyCommand struct {
+	// Name describes who or what uses the key (required, up to 100 characters).
+	Name string
+
+	// Scopes are the scopes to grant: moderate, export or admin (at least one).
+	Scopes []string
+
+	// ExpiresAt is when the key stops working (optional, must be in the future).
+	ExpiresAt *time.Time
+}
+
+// CreateAPIKeyUseCase issues API keys for privileged RPCs.
+type CreateAPIKeyUseCase struct {
+	// repo is the APIKey repository.
+	repo admin.APIKeyRepository
+}
+
+// NewCreateAPIKeyUseCase creates a new CreateAPIKeyUseCase instance.
+func NewCreateAPIKeyUseCase(repo admin.APIKeyRepository) *CreateAPIKeyUseCase {
+	return &CreateAPIKeyUseCase{
+		repo: repo,
+	}
+}
+
+// Execute issues the key. The result is the only place the raw key (dto.APIKeyDTO.Key)
+// ever appears; only its hash is stored.
+func (uc *CreateAPIKeyUseCase) Execute(ctx context.Context, cmd CreateAPIKeyCommand) (*dto.APIKeyDTO, error) {
+	// 1. Validate input
+	scopes := make([]admin.Scope, 0, len(cmd.Scopes))
+	for _, value := range cmd.Scopes {
+		scope, err := admin.NewScope(value)
+		if err != nil {
+			return nil, apperrors.NewValidationErrorWithDetails("invalid scope", map[string]interface{}{
+				"scope": value,
+			})
+		}
+		scopes = append(scopes, scope)
+	}
+
+	// 2. Issue the key
+	key, raw, err := admin.IssueAPIKey(cmd.Name, scopes, cmd.ExpiresAt)
+	if err != nil {
+		return nil, apperrors.NewValidationErrorWithDetails("invalid API key", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	// 3. Save the key
+	if err := uc.repo.Save(ctx, key); err != nil {
+		return nil, err
+	}
+
+	result := toAPIKeyDTO(key)
+	result.Key = raw
+	return result, nil
+}
+
+// toAPIKeyDTO converts an APIKey entity to an APIKeyDTO (without the raw key).
+func toAPIKeyDTO(key *admin.APIKey) *dto.APIKeyDTO {
+	scopes := make([]string, 0, len(key.Scopes()))
+	for _, scope := range key.Scopes() {
+		scopes = append(scopes, scope.String())
+	}
+
+	return &dto.APIKeyDTO{
+		ID:         key.ID(),
+		Name:       key.Name(),
+		Prefix:     key.Prefix(),
+		Scopes:     scopes,
+		ExpiresAt:  key.ExpiresAt(),
+		CreatedAt:  key.CreatedAt(),
+		LastUsedAt: key.LastUsedAt(),
+		RevokedAt:  key.RevokedAt(),
+	}
+}
@@ -0,0 +1,98 @@
+// Package admin provides use cases for operators: maintenance tasks that have
+// no public API and are run from the admin subcommand of the server, and the
+// API keys and audit log that guard privileged RPCs.
+package admin
+
+import (
+	"context"
+	"strings"
+
+	"fuck_boss/backend/internal/application/cache"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// CacheNamespace is a group of cache keys that can be flushed together.
+type CacheNamespace struct {
+	// Name is the namespace name given by operators.
+	Name string
+
+	// Pattern matches the cache keys of the namespace.
+	Pattern string
+}
+
+// CacheNamespaces are the cache namespaces FlushCacheUseCase can flush.
+// They only hold data that is rebuilt from the database on a cache miss or by
+// a background job; rate limits, verification codes and rankings are not caches.
+var CacheNamespaces = []CacheNamespace{
+	// Post details and share cards
+	{Name: "post", Pattern: "post:*"},
+	// Post lists by city
+	{Name: "posts", Pattern: "posts:*"},
+	// Feeds by city, company and keyword
+	{Name: "feed", Pattern: "feed:*"},
+	// Search results
+	{Name: "search", Pattern: "search:*"},
+	// Related posts (recomputed by the related posts job)
+	{Name: "related", Pattern: "related:*"},
+}
+
+// FlushCacheCommand represents the command to flush cache namespaces.
+type FlushCacheCommand struct {
+	// Namespaces are the names of the namespaces to flush (see CacheNamespaces).
+	// Empty flushes all namespaces.
+	Namespaces []string
+}
+
+// FlushCacheUseCase deletes cached data by namespace, e.g. after fixing data
+// in the database by hand.
+type FlushCacheUseCase struct {
+	// cacheRepo is the cache repository.
+	cacheRepo cache.CacheRepository
+}
+
+// NewFlushCacheUseCase creates a new FlushCacheUseCase instance.
+func NewFlushCacheUseCase(cacheRepo cache.CacheRepository) *FlushCacheUseCase {
+	return &FlushCacheUseCase{
+		cacheRepo: cacheRepo,
+	}
+}
+
+// Execute flushes the namespaces and returns the patterns deleted, in
+// CacheNamespaces order. Unknown namespaces are rejected before anything is deleted.
+func (uc *FlushCacheUseCase) Execute(ctx context.Context, cmd FlushCacheCommand) ([]string, error) {
+	// 1. Resolve the namespaces
+	selected := make(map[string]bool, len(cmd.Namespaces))
+	for _, name := range cmd.Namespaces {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if findCacheNamespace(name) == nil {
+			return nil, apperrors.NewValidationErrorWithDetails("unknown cache namespace", map[string]interface{}{
+				"namespace": name,
+			})
+		}
+		selected[name] = true
+	}
+
+	// 2. Delete the keys of each namespace
+	var patterns []string
+	for _, ns := range CacheNamespaces {
+		if len(selected) > 0 && !selected[ns.Name] {
+			continue
+		}
+		if err := uc.cacheRepo.DeleteByPattern(ctx, ns.Pattern); err != nil {
+			return patterns, err
+		}
+		patterns = append(patterns, ns.Pattern)
+	}
+
+	return patterns, nil
+}
+
+// findCacheNamespace returns the namespace with the name, or nil.
+func findCacheNamespace(name string) *CacheNamespace {
+	for i := range CacheNamespaces {
+		if CacheNamespaces[i].Name == name {
+			return &CacheNamespaces[i]
+		}
+	}
+	return nil
+}
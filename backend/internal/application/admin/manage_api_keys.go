@@ -0,0 +1,89 @@
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/domain/admin"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// ListAPIKeysUseCase lists all API keys, including revoked ones.
+type ListAPIKeysUseCase struct {
+	// repo is the APIKey repository.
+	repo admin.APIKeyRepository
+}
+
+// NewListAPIKeysUseCase creates a new ListAPIKeysUseCase instance.
+func NewListAPIKeysUseCase(repo admin.APIKeyRepository) *ListAPIKeysUseCase {
+	return &ListAPIKeysUseCase{
+		repo: repo,
+	}
+}
+
+// Execute returns all API keys, newest first.
+func (uc *ListAPIKeysUseCase) Execute(ctx context.Context) ([]*dto.APIKeyDTO, error) {
+	keys, err := uc.repo.FindAll(ctx)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to query API keys", err)
+	}
+
+	result := make([]*dto.APIKeyDTO, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, toAPIKeyDTO(key))
+	}
+	return result, nil
+}
+
+// RevokeAPIKeyCommand represents the command to revoke an API key.
+type RevokeAPIKeyCommand struct {
+	// ID is the ID of the key (required).
+	ID string
+}
+
+// RevokeAPIKeyUseCase revokes API keys. Revoked keys are kept, so the audit
+// log can still name them.
+type RevokeAPIKeyUseCase struct {
+	// repo is the APIKey repository.
+	repo admin.APIKeyRepository
+}
+
+// NewRevokeAPIKeyUseCase creates a new RevokeAPIKeyUseCase instance.
+func NewRevokeAPIKeyUseCase(repo admin.APIKeyRepository) *RevokeAPIKeyUseCase {
+	return &RevokeAPIKeyUseCase{
+		repo: repo,
+	}
+}
+
+// Execute revokes the key and returns it. Revoking a revoked key keeps the
+// original revocation time.
+func (uc *RevokeAPIKeyUseCase) Execute(ctx context.Context, cmd RevokeAPIKeyCommand) (*dto.APIKeyDTO, error) {
+	// 1. Validate input
+	if _, err := uuid.Parse(cmd.ID); err != nil {
+		return nil, apperrors.NewValidationErrorWithDetails("invalid API key ID", map[string]interface{}{
+			"id": cmd.ID,
+		})
+	}
+
+	// 2. Load the key
+	key, err := uc.repo.FindByID(ctx, cmd.ID)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to query API key", err)
+	}
+
+	// 3. Revoke and save
+	if !key.IsRevoked() {
+		key.Revoke(time.Now())
+		if err := uc.repo.Save(ctx, key); err != nil {
+			return nil, err
+		}
+	}
+
+	return toAPIKeyDTO(key), nil
+}
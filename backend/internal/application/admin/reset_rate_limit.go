@@ -0,0 +1,86 @@
+package admin
+
+import (
+	"context"
+	"net"
+	"strings"
+	"time"
+
+	"fuck_boss/backend/internal/application/ratelimit"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// ResetRateLimitCommand represents the command to reset the rate limits of a client.
+type ResetRateLimitCommand struct {
+	// ClientIP is the IP address of the client (required).
+	ClientIP string
+
+	// Actions are the rate limited actions to reset (see ratelimit.ClientActions).
+	// Empty resets all of them.
+	Actions []string
+}
+
+// ResetRateLimitUseCase resets the per-IP rate limits of a client, e.g. an
+// office behind one NAT address that hit the hourly post limit.
+type ResetRateLimitUseCase struct {
+	// rateLimiter is the rate limiter.
+	rateLimiter ratelimit.RateLimiter
+}
+
+// NewResetRateLimitUseCase creates a new ResetRateLimitUseCase instance.
+func NewResetRateLimitUseCase(rateLimiter ratelimit.RateLimiter) *ResetRateLimitUseCase {
+	return &ResetRateLimitUseCase{
+		rateLimiter: rateLimiter,
+	}
+}
+
+// Execute resets the current hour's counters of the client and returns the
+// keys reset. Counters of earlier hours have already expired.
+func (uc *ResetRateLimitUseCase) Execute(ctx context.Context, cmd ResetRateLimitCommand) ([]string, error) {
+	// 1. Validate input
+	if cmd.ClientIP == "" {
+		return nil, apperrors.NewValidationError("client IP is required")
+	}
+	if net.ParseIP(cmd.ClientIP) == nil {
+		return nil, apperrors.NewValidationErrorWithDetails("invalid client IP", map[string]interface{}{
+			"client_ip": cmd.ClientIP,
+		})
+	}
+
+	actions := ratelimit.ClientActions
+	if len(cmd.Actions) > 0 {
+		actions = make([]string, 0, len(cmd.Actions))
+		for _, action := range cmd.Actions {
+			action = strings.ToLower(strings.TrimSpace(action))
+			if !isClientAction(action) {
+				return nil, apperrors.NewValidationErrorWithDetails("unknown rate limited action", map[string]interface{}{
+					"action": action,
+				})
+			}
+			actions = append(actions, action)
+		}
+	}
+
+	// 2. Reset the counters (keys use the address as the server sees it)
+	now := time.Now()
+	keys := make([]string, 0, len(actions))
+	for _, action := range actions {
+		key := ratelimit.ClientKey(action, cmd.ClientIP, now)
+		if err := uc.rateLimiter.Reset(ctx, key); err != nil {
+			return keys, err
+		}
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// isClientAction reports whether the action is rate limited per client IP address.
+func isClientAction(action string) bool {
+	for _, a := range ratelimit.ClientActions {
+		if a == action {
+			return true
+		}
+	}
+	return false
+}
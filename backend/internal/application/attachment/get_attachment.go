@@ -0,0 +1,165 @@
+package attachment
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/google/uuid"
+
+	"fuck_boss/backend/internal/application/blob"
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/domain/attachment"
+	"fuck_boss/backend/internal/domain/content"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// ListAttachmentsQuery represents the query for the attachments of a post.
+type ListAttachmentsQuery struct {
+	// PostID is the ID of the post (required).
+	PostID string
+}
+
+// ListAttachmentsUseCase lists the attachments of a post, oldest first.
+type ListAttachmentsUseCase struct {
+	// repo is the Attachment repository.
+	repo attachment.AttachmentRepository
+}
+
+// NewListAttachmentsUseCase creates a new ListAttachmentsUseCase instance.
+func NewListAttachmentsUseCase(repo attachment.AttachmentRepository) *ListAttachmentsUseCase {
+	return &ListAttachmentsUseCase{
+		repo: repo,
+	}
+}
+
+// Execute executes the list attachments query.
+// A post without attachments (or a missing post) yields an empty list.
+func (uc *ListAttachmentsUseCase) Execute(ctx context.Context, query ListAttachmentsQuery) ([]*dto.AttachmentDTO, error) {
+	if query.PostID == "" {
+		return nil, apperrors.NewValidationError("post ID is required")
+	}
+
+	postID, err := content.NewPostID(query.PostID)
+	if err != nil {
+		return nil, apperrors.NewValidationErrorWithDetails("invalid post ID", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	attachments, err := uc.repo.FindByPost(ctx, postID)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to query attachments", err)
+	}
+
+	result := make([]*dto.AttachmentDTO, 0, len(attachments))
+	for _, a := range attachments {
+		result = append(result, toAttachmentDTO(a))
+	}
+
+	return result, nil
+}
+
+// DownloadAttachmentQuery represents the query for downloading an attachment.
+type DownloadAttachmentQuery struct {
+	// ID is the attachment ID (required).
+	ID string
+
+	// Thumbnail selects the thumbnail of an image instead of the file itself.
+	Thumbnail bool
+}
+
+// Download is an opened attachment (or thumbnail).
+type Download struct {
+	// Attachment is the attachment metadata.
+	Attachment *dto.AttachmentDTO
+
+	// ContentType is the media type of Body.
+	ContentType string
+
+	// Size is the length of Body in bytes (0 if unknown).
+	Size int64
+
+	// FileName is the file name offered for download.
+	FileName string
+
+	// ETag is the strong entity tag of Body (without quotes).
+	ETag string
+
+	// Body is the file content. The caller must close it.
+	Body io.ReadCloser
+}
+
+// DownloadAttachmentUseCase opens an attachment for download.
+type DownloadAttachmentUseCase struct {
+	// repo is the Attachment repository.
+	repo attachment.AttachmentRepository
+
+	// store is the blob store holding file content.
+	store blob.BlobStore
+}
+
+// NewDownloadAttachmentUseCase creates a new DownloadAttachmentUseCase instance.
+func NewDownloadAttachmentUseCase(
+	repo attachment.AttachmentRepository,
+	store blob.BlobStore,
+) *DownloadAttachmentUseCase {
+	return &DownloadAttachmentUseCase{
+		repo:  repo,
+		store: store,
+	}
+}
+
+// Execute executes the download attachment query.
+func (uc *DownloadAttachmentUseCase) Execute(ctx context.Context, query DownloadAttachmentQuery) (*Download, error) {
+	// 1. Validate input
+	if query.ID == "" {
+		return nil, apperrors.NewValidationError("attachment ID is required")
+	}
+	if _, err := uuid.Parse(query.ID); err != nil {
+		return nil, apperrors.NewValidationErrorWithDetails("invalid attachment ID", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	// 2. Load the metadata
+	a, err := uc.repo.FindByID(ctx, query.ID)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to query attachment", err)
+	}
+
+	// 3. Select the file
+	download := &Download{
+		Attachment:  toAttachmentDTO(a),
+		ContentType: a.ContentType().String(),
+		Size:        a.Size(),
+		FileName:    a.FileName(),
+		ETag:        a.Checksum(),
+	}
+	key := a.StorageKey()
+	if query.Thumbnail {
+		if !a.HasThumbnail() {
+			return nil, apperrors.NewNotFoundError("thumbnail")
+		}
+		key = a.ThumbnailKey()
+		download.ContentType = a.ThumbnailType().String()
+		download.Size = 0
+		download.FileName = a.ThumbnailFileName()
+		download.ETag = a.Checksum() + "-thumb"
+	}
+
+	// 4. Open the file
+	body, err := uc.store.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, blob.ErrNotFound) {
+			return nil, apperrors.NewNotFoundError("attachment")
+		}
+		return nil, apperrors.NewInternalErrorWithCause("failed to open attachment", err)
+	}
+	download.Body = body
+
+	return download, nil
+}
@@ -0,0 +1,50 @@
+package attachment
+
+// MaxRegions is the maximum number of sensitive regions per image.
+const MaxRegions = 20
+
+// Region is a rectangle of an image, in pixels of the image as displayed
+// (after EXIF orientation is applied), that the author marked as sensitive.
+type Region struct {
+	// X is the left edge.
+	X int
+
+	// Y is the top edge.
+	Y int
+
+	// Width is the width of the rectangle.
+	Width int
+
+	// Height is the height of the rectangle.
+	Height int
+}
+
+// ProcessedImage is a sanitized image and its thumbnail.
+type ProcessedImage struct {
+	// Data is the re-encoded image without any metadata.
+	Data []byte
+
+	// ContentType is the media type of Data (may differ from the upload, e.g. WebP becomes PNG).
+	ContentType string
+
+	// Width is the image width in pixels.
+	Width int
+
+	// Height is the image height in pixels.
+	Height int
+
+	// Thumbnail is the re-encoded thumbnail.
+	Thumbnail []byte
+
+	// ThumbnailContentType is the media type of Thumbnail.
+	ThumbnailContentType string
+}
+
+// ImageProcessor sanitizes uploaded images before they are stored.
+// Implementations are in Infrastructure Layer (e.g., pure-Go decoders and encoders).
+type ImageProcessor interface {
+	// Process decodes the image, blurs the regions, and re-encodes the image and a
+	// size-bounded thumbnail without metadata (EXIF, XMP, comments, ...).
+	// Returns an error if the data is not a supported image or is too large to decode.
+	Process(data []byte, regions []Region) (*ProcessedImage, error)
+}
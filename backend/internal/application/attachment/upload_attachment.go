@@ -0,0 +1,224 @@
+// Package attachment provides use cases for evidence attachments.
+package attachment
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+
+	"fuck_boss/backend/internal/application/blob"
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/domain/attachment"
+	"fuck_boss/backend/internal/domain/content"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// MaxFileSize is the largest accepted file in bytes, for handlers that limit request bodies.
+const MaxFileSize = attachment.MaxSize
+
+// UploadAttachmentCommand represents the command to attach a file to a post.
+type UploadAttachmentCommand struct {
+	// PostID is the ID of the post to attach to (required).
+	PostID string
+
+	// ManagementToken is the token returned when the post was created (required).
+	ManagementToken string
+
+	// Data is the file content (required, at most attachment.MaxSize bytes).
+	Data []byte
+
+	// Regions are parts of an image to blur before it is stored (optional, images only).
+	Regions []Region
+}
+
+// UploadAttachmentUseCase handles attaching evidence files to posts.
+// Only the author (holder of the management token) can attach files.
+// Images are sanitized before they are stored; the uploaded original is never kept.
+type UploadAttachmentUseCase struct {
+	// repo is the Attachment repository.
+	repo attachment.AttachmentRepository
+
+	// postRepo is the Post repository, used to check the post and its management token.
+	postRepo content.PostRepository
+
+	// store is the blob store holding file content.
+	store blob.BlobStore
+
+	// images sanitizes image uploads and creates their thumbnails.
+	images ImageProcessor
+}
+
+// NewUploadAttachmentUseCase creates a new UploadAttachmentUseCase instance.
+func NewUploadAttachmentUseCase(
+	repo attachment.AttachmentRepository,
+	postRepo content.PostRepository,
+	store blob.BlobStore,
+	images ImageProcessor,
+) *UploadAttachmentUseCase {
+	return &UploadAttachmentUseCase{
+		repo:     repo,
+		postRepo: postRepo,
+		store:    store,
+		images:   images,
+	}
+}
+
+// Execute executes the upload attachment command.
+// The content type is sniffed from the data; the type declared by the client is ignored.
+func (uc *UploadAttachmentUseCase) Execute(ctx context.Context, cmd UploadAttachmentCommand) (*dto.AttachmentDTO, error) {
+	// 1. Validate input
+	if cmd.PostID == "" {
+		return nil, apperrors.NewValidationError("post ID is required")
+	}
+	if cmd.ManagementToken == "" {
+		return nil, apperrors.NewValidationError("management token is required")
+	}
+	if len(cmd.Data) == 0 {
+		return nil, apperrors.NewValidationError("file is required")
+	}
+	if len(cmd.Data) > attachment.MaxSize {
+		return nil, apperrors.NewValidationErrorWithDetails("file is too large", map[string]interface{}{
+			"limit": attachment.MaxSize,
+		})
+	}
+
+	postID, err := content.NewPostID(cmd.PostID)
+	if err != nil {
+		return nil, apperrors.NewValidationErrorWithDetails("invalid post ID", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	// 2. Sniff the content type
+	contentType, err := attachment.NewContentType(http.DetectContentType(cmd.Data))
+	if err != nil {
+		return nil, apperrors.NewValidationErrorWithDetails("unsupported file type", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+	if err := validateRegions(cmd.Regions, contentType); err != nil {
+		return nil, err
+	}
+
+	// 3. Verify authorship
+	post, err := uc.postRepo.FindByID(ctx, postID)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to query post", err)
+	}
+	if !post.VerifyManagementToken(cmd.ManagementToken) {
+		return nil, apperrors.NewForbiddenError("management token does not match post")
+	}
+
+	// 4. Check the per-post limit
+	count, err := uc.repo.CountByPost(ctx, postID)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to count attachments", err)
+	}
+	if count >= attachment.MaxPerPost {
+		return nil, apperrors.NewValidationErrorWithDetails("too many attachments", map[string]interface{}{
+			"limit": attachment.MaxPerPost,
+		})
+	}
+
+	// 5. Sanitize images (strip metadata, blur regions, create the thumbnail)
+	data := cmd.Data
+	var processed *ProcessedImage
+	if contentType.IsImage() {
+		processed, err = uc.images.Process(cmd.Data, cmd.Regions)
+		if err != nil {
+			return nil, apperrors.NewValidationErrorWithDetails("invalid image", map[string]interface{}{
+				"error": err.Error(),
+			})
+		}
+		data = processed.Data
+		if contentType, err = attachment.NewContentType(processed.ContentType); err != nil {
+			return nil, apperrors.NewInternalErrorWithCause("image processor returned an unsupported type", err)
+		}
+	}
+
+	// 6. Create the entity
+	sum := sha256.Sum256(data)
+	a, err := attachment.NewAttachment(postID, contentType, int64(len(data)), hex.EncodeToString(sum[:]))
+	if err != nil {
+		return nil, apperrors.NewValidationErrorWithDetails("invalid attachment", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+	if processed != nil {
+		thumbnailType, err := attachment.NewContentType(processed.ThumbnailContentType)
+		if err != nil {
+			return nil, apperrors.NewInternalErrorWithCause("image processor returned an unsupported type", err)
+		}
+		if err := a.AssignImageInfo(processed.Width, processed.Height, thumbnailType); err != nil {
+			return nil, apperrors.NewInternalErrorWithCause("image processor returned invalid image info", err)
+		}
+	}
+
+	// 7. Store the files, then the metadata
+	if err := uc.store.Put(ctx, a.StorageKey(), bytes.NewReader(data)); err != nil {
+		return nil, apperrors.NewInternalErrorWithCause("failed to store attachment", err)
+	}
+	if processed != nil {
+		if err := uc.store.Put(ctx, a.ThumbnailKey(), bytes.NewReader(processed.Thumbnail)); err != nil {
+			uc.deleteFiles(ctx, a)
+			return nil, apperrors.NewInternalErrorWithCause("failed to store thumbnail", err)
+		}
+	}
+	if err := uc.repo.Save(ctx, a); err != nil {
+		uc.deleteFiles(ctx, a)
+		return nil, err
+	}
+
+	return toAttachmentDTO(a), nil
+}
+
+// deleteFiles removes the stored files of an attachment whose upload failed,
+// so no unreferenced files are left behind (errors are ignored).
+func (uc *UploadAttachmentUseCase) deleteFiles(ctx context.Context, a *attachment.Attachment) {
+	_ = uc.store.Delete(ctx, a.StorageKey())
+	if a.HasThumbnail() {
+		_ = uc.store.Delete(ctx, a.ThumbnailKey())
+	}
+}
+
+// validateRegions validates the sensitive regions of an upload.
+func validateRegions(regions []Region, contentType attachment.ContentType) error {
+	if len(regions) == 0 {
+		return nil
+	}
+	if !contentType.IsImage() {
+		return apperrors.NewValidationError("regions are only supported for images")
+	}
+	if len(regions) > MaxRegions {
+		return apperrors.NewValidationErrorWithDetails("too many regions", map[string]interface{}{
+			"limit": MaxRegions,
+		})
+	}
+	for _, r := range regions {
+		if r.X < 0 || r.Y < 0 || r.Width <= 0 || r.Height <= 0 {
+			return apperrors.NewValidationError("invalid region: coordinates must be non-negative and size positive")
+		}
+	}
+	return nil
+}
+
+// toAttachmentDTO converts an Attachment entity to an AttachmentDTO.
+func toAttachmentDTO(a *attachment.Attachment) *dto.AttachmentDTO {
+	return &dto.AttachmentDTO{
+		ID:           a.ID(),
+		PostID:       a.PostID().String(),
+		ContentType:  a.ContentType().String(),
+		Size:         a.Size(),
+		Checksum:     a.Checksum(),
+		FileName:     a.FileName(),
+		Width:        a.Width(),
+		Height:       a.Height(),
+		HasThumbnail: a.HasThumbnail(),
+		CreatedAt:    a.CreatedAt(),
+	}
+}
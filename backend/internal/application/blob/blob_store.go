@@ -0,0 +1,31 @@
+// Package blob provides the blob store interface for application layer.
+// This interface is defined in Application Layer to follow Dependency Inversion Principle.
+package blob
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrNotFound is returned by BlobStore.Get when no blob is stored under the key.
+var ErrNotFound = errors.New("blob not found")
+
+// BlobStore stores opaque binary objects under string keys.
+// Implementations are in Infrastructure Layer (e.g., local filesystem; an S3-compatible
+// store can be added later without touching the use cases).
+// Keys are slash-separated paths such as "posts/<post id>/<file>"; callers only use
+// keys they generated themselves.
+type BlobStore interface {
+	// Put stores the content read from r under key, replacing any existing blob.
+	// The blob must not become visible to Get before it is completely written.
+	Put(ctx context.Context, key string, r io.Reader) error
+
+	// Get opens the blob stored under key. The caller must close the reader.
+	// Returns ErrNotFound if the blob does not exist.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+
+	// Delete removes the blob stored under key.
+	// Deleting a missing blob is not an error.
+	Delete(ctx context.Context, key string) error
+}
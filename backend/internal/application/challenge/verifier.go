@@ -0,0 +1,23 @@
+// Package challenge provides the domain ownership verifier interface for application layer.
+// This interface is defined in Application Layer to follow Dependency Inversion Principle.
+package challenge
+
+import (
+	"context"
+
+	"fuck_boss/backend/internal/domain/company"
+)
+
+// Verifier checks whether a domain ownership challenge has been published.
+// Implementations are in Infrastructure Layer (e.g., DNS TXT lookup, HTTPS file fetch);
+// tests can stub it without network access.
+//
+// A passed challenge only proves control of the domain. That the domain belongs
+// to the company is checked separately against company.DomainApprovalRepository.
+type Verifier interface {
+	// Verify reports whether the challenge code is published on the company domain
+	// using the challenge's method.
+	// Returns false (and no error) if the code is missing or does not match,
+	// and an error only if the check itself could not be performed.
+	Verify(ctx context.Context, challenge company.Challenge) (bool, error)
+}
@@ -0,0 +1,145 @@
+package company
+
+import (
+	"context"
+
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/domain/company"
+	"fuck_boss/backend/internal/domain/content"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// CompanyDomainCommand represents the command to approve or revoke a company domain.
+type CompanyDomainCommand struct {
+	// Company is the company name exactly as it appears in posts (required).
+	Company string
+
+	// Domain is the company domain (required, e.g., "example.com").
+	Domain string
+}
+
+// ApproveCompanyDomainUseCase approves a domain for a company after an
+// operator has checked that the company owns it. It is an operator action of
+// the admin subcommands; representatives can only be verified for approved
+// company domains.
+type ApproveCompanyDomainUseCase struct {
+	// approvalRepo is the DomainApproval repository.
+	approvalRepo company.DomainApprovalRepository
+}
+
+// NewApproveCompanyDomainUseCase creates a new ApproveCompanyDomainUseCase instance.
+func NewApproveCompanyDomainUseCase(approvalRepo company.DomainApprovalRepository) *ApproveCompanyDomainUseCase {
+	return &ApproveCompanyDomainUseCase{
+		approvalRepo: approvalRepo,
+	}
+}
+
+// Execute approves the domain for the company and returns the approval.
+// Approving an already approved domain is not an error.
+func (uc *ApproveCompanyDomainUseCase) Execute(ctx context.Context, cmd CompanyDomainCommand) (*dto.CompanyDomainDTO, error) {
+	companyName, domain, err := parseCompanyDomain(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	approval := company.NewDomainApproval(companyName, domain)
+	if err := uc.approvalRepo.Save(ctx, approval); err != nil {
+		return nil, err
+	}
+
+	return toCompanyDomainDTO(approval), nil
+}
+
+// RevokeCompanyDomainUseCase revokes the approval of a company domain.
+// Representatives of the domain can no longer reply for the company; replies
+// already posted are kept.
+type RevokeCompanyDomainUseCase struct {
+	// approvalRepo is the DomainApproval repository.
+	approvalRepo company.DomainApprovalRepository
+}
+
+// NewRevokeCompanyDomainUseCase creates a new RevokeCompanyDomainUseCase instance.
+func NewRevokeCompanyDomainUseCase(approvalRepo company.DomainApprovalRepository) *RevokeCompanyDomainUseCase {
+	return &RevokeCompanyDomainUseCase{
+		approvalRepo: approvalRepo,
+	}
+}
+
+// Execute revokes the approval.
+// Returns a NOT_FOUND error if the domain is not approved for the company.
+func (uc *RevokeCompanyDomainUseCase) Execute(ctx context.Context, cmd CompanyDomainCommand) error {
+	companyName, domain, err := parseCompanyDomain(cmd)
+	if err != nil {
+		return err
+	}
+
+	return uc.approvalRepo.Delete(ctx, companyName, domain)
+}
+
+// ListCompanyDomainsUseCase lists the approved company domains.
+type ListCompanyDomainsUseCase struct {
+	// approvalRepo is the DomainApproval repository.
+	approvalRepo company.DomainApprovalRepository
+}
+
+// NewListCompanyDomainsUseCase creates a new ListCompanyDomainsUseCase instance.
+func NewListCompanyDomainsUseCase(approvalRepo company.DomainApprovalRepository) *ListCompanyDomainsUseCase {
+	return &ListCompanyDomainsUseCase{
+		approvalRepo: approvalRepo,
+	}
+}
+
+// Execute returns all approved company domains, ordered by company name and domain.
+func (uc *ListCompanyDomainsUseCase) Execute(ctx context.Context) ([]*dto.CompanyDomainDTO, error) {
+	approvals, err := uc.approvalRepo.FindAll(ctx)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to query company domains", err)
+	}
+
+	result := make([]*dto.CompanyDomainDTO, 0, len(approvals))
+	for _, approval := range approvals {
+		result = append(result, toCompanyDomainDTO(approval))
+	}
+	return result, nil
+}
+
+// parseCompanyDomain validates the company name and domain of the command.
+func parseCompanyDomain(cmd CompanyDomainCommand) (content.CompanyName, company.CompanyDomain, error) {
+	companyName, err := content.NewCompanyName(cmd.Company)
+	if err != nil {
+		return content.CompanyName{}, company.CompanyDomain{}, apperrors.NewValidationErrorWithDetails("invalid company name", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	domain, err := company.NewCompanyDomain(cmd.Domain)
+	if err != nil {
+		return content.CompanyName{}, company.CompanyDomain{}, apperrors.NewValidationErrorWithDetails("invalid company domain", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	return companyName, domain, nil
+}
+
+// toCompanyDomainDTO converts a DomainApproval entity to CompanyDomainDTO.
+func toCompanyDomainDTO(approval *company.DomainApproval) *dto.CompanyDomainDTO {
+	return &dto.CompanyDomainDTO{
+		Company:    approval.Company().String(),
+		Domain:     approval.Domain().String(),
+		ApprovedAt: approval.ApprovedAt(),
+	}
+}
+
+// ensureDomainApproved returns a FORBIDDEN error unless an operator approved
+// the representative's domain for its company.
+func ensureDomainApproved(ctx context.Context, approvalRepo company.DomainApprovalRepository, representative *company.Representative) error {
+	approved, err := approvalRepo.IsApproved(ctx, representative.Company(), representative.Domain())
+	if err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to query company domain", err)
+	}
+	if !approved {
+		return apperrors.NewForbiddenError(company.ErrDomainNotApproved.Error())
+	}
+	return nil
+}
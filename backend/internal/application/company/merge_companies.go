@@ -0,0 +1,87 @@
+package company
+
+import (
+	"context"
+
+	"fuck_boss/backend/internal/application/cache"
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/domain/company"
+	"fuck_boss/backend/internal/domain/content"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// MergeCompaniesCommand represents the command to merge a company into another.
+type MergeCompaniesCommand struct {
+	// From is the company name to merge away, e.g. a misspelling (required).
+	From string
+
+	// Into is the company name to keep (required, different from From).
+	Into string
+}
+
+// MergeCompaniesUseCase merges duplicate company names: posts, representatives,
+// watches and approved domains of one company move to another, so they are
+// listed, watched and replied to as one company.
+type MergeCompaniesUseCase struct {
+	// mergeRepo is the company merge repository.
+	mergeRepo company.CompanyMergeRepository
+
+	// cacheRepo is the cache repository for cache invalidation.
+	cacheRepo cache.CacheRepository
+}
+
+// NewMergeCompaniesUseCase creates a new MergeCompaniesUseCase instance.
+func NewMergeCompaniesUseCase(
+	mergeRepo company.CompanyMergeRepository,
+	cacheRepo cache.CacheRepository,
+) *MergeCompaniesUseCase {
+	return &MergeCompaniesUseCase{
+		mergeRepo: mergeRepo,
+		cacheRepo: cacheRepo,
+	}
+}
+
+// Execute executes the merge companies command and returns what was moved.
+func (uc *MergeCompaniesUseCase) Execute(ctx context.Context, cmd MergeCompaniesCommand) (*dto.CompanyMergeDTO, error) {
+	// 1. Validate input
+	from, err := content.NewCompanyName(cmd.From)
+	if err != nil {
+		return nil, apperrors.NewValidationErrorWithDetails("invalid company to merge", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	into, err := content.NewCompanyName(cmd.Into)
+	if err != nil {
+		return nil, apperrors.NewValidationErrorWithDetails("invalid target company", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	if from.Equals(into) {
+		return nil, apperrors.NewValidationError("cannot merge a company into itself")
+	}
+
+	// 2. Rename the company everywhere
+	result, err := uc.mergeRepo.Merge(ctx, from, into)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Clear the caches that may contain the company name (errors are ignored)
+	if result.Posts > 0 {
+		_ = uc.cacheRepo.DeleteByPattern(ctx, "post:*")
+		_ = uc.cacheRepo.DeleteByPattern(ctx, "posts:*")
+		_ = uc.cacheRepo.DeleteByPattern(ctx, "feed:*")
+		_ = uc.cacheRepo.DeleteByPattern(ctx, "search:*")
+	}
+
+	return &dto.CompanyMergeDTO{
+		From:            from.String(),
+		Into:            into.String(),
+		Posts:           result.Posts,
+		Representatives: result.Representatives,
+		Watches:         result.Watches,
+		Domains:         result.Domains,
+	}, nil
+}
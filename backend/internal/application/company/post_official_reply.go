@@ -0,0 +1,197 @@
+// Package company provides use cases for company representatives and their right of reply.
+package company
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"fuck_boss/backend/internal/application/cache"
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/domain/company"
+	"fuck_boss/backend/internal/domain/content"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// PostOfficialReplyCommand represents the command to attach an official reply to a post.
+type PostOfficialReplyCommand struct {
+	// PostID is the ID of the post to reply to (required).
+	PostID string
+
+	// RepresentativeID is the ID of the verified representative (required).
+	RepresentativeID string
+
+	// AccessToken is the representative's access token (required).
+	AccessToken string
+
+	// Content is the reply text (required, 10-5000 characters).
+	Content string
+}
+
+// ReplyNotifier notifies the author of a post about an official reply.
+// It is implemented by notification.Notifier.
+type ReplyNotifier interface {
+	NotifyCompanyReply(ctx context.Context, post *content.Post, reply *content.OfficialReply) error
+}
+
+// PostOfficialReplyUseCase handles the company right of reply.
+// Only verified representatives of the company named in the post, whose domain
+// is still approved for the company, may reply, only once per post, and the
+// reply never modifies the original post content.
+type PostOfficialReplyUseCase struct {
+	// representativeRepo is the Representative repository.
+	representativeRepo company.RepresentativeRepository
+
+	// approvalRepo is the repository of operator-approved company domains.
+	approvalRepo company.DomainApprovalRepository
+
+	// postRepo is the Post repository.
+	postRepo content.PostRepository
+
+	// cacheRepo is the cache repository for cache invalidation.
+	cacheRepo cache.CacheRepository
+
+	// notifier notifies the post author (nil disables notifications).
+	notifier ReplyNotifier
+}
+
+// NewPostOfficialReplyUseCase creates a new PostOfficialReplyUseCase instance.
+func NewPostOfficialReplyUseCase(
+	representativeRepo company.RepresentativeRepository,
+	approvalRepo company.DomainApprovalRepository,
+	postRepo content.PostRepository,
+	cacheRepo cache.CacheRepository,
+	notifier ReplyNotifier,
+) *PostOfficialReplyUseCase {
+	return &PostOfficialReplyUseCase{
+		representativeRepo: representativeRepo,
+		approvalRepo:       approvalRepo,
+		postRepo:           postRepo,
+		cacheRepo:          cacheRepo,
+		notifier:           notifier,
+	}
+}
+
+// Execute executes the post official reply command.
+// It returns the attached reply.
+func (uc *PostOfficialReplyUseCase) Execute(ctx context.Context, cmd PostOfficialReplyCommand) (*dto.OfficialReplyDTO, error) {
+	// 1. Validate input
+	if err := uc.validateCommand(cmd); err != nil {
+		return nil, err
+	}
+
+	postID, err := content.NewPostID(cmd.PostID)
+	if err != nil {
+		return nil, apperrors.NewValidationErrorWithDetails("invalid post ID", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	representativeID, err := company.NewRepresentativeID(cmd.RepresentativeID)
+	if err != nil {
+		return nil, apperrors.NewValidationErrorWithDetails("invalid representative ID", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	replyContent, err := content.NewReplyContent(cmd.Content)
+	if err != nil {
+		return nil, apperrors.NewValidationErrorWithDetails("invalid reply content", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	// 2. Load and authenticate the representative
+	representative, err := uc.representativeRepo.FindByID(ctx, representativeID)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to query representative", err)
+	}
+
+	if err := representative.Authenticate(cmd.AccessToken); err != nil {
+		return nil, apperrors.NewForbiddenError("access token does not match representative")
+	}
+	if err := representative.EnsureCanSpeak(); err != nil {
+		return nil, apperrors.NewForbiddenError(err.Error())
+	}
+
+	// Representatives verified before approvals existed, or whose approval was
+	// revoked, cannot reply
+	if err := ensureDomainApproved(ctx, uc.approvalRepo, representative); err != nil {
+		return nil, err
+	}
+
+	// 3. Load the post
+	post, err := uc.postRepo.FindByID(ctx, postID)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to query post", err)
+	}
+
+	// 4. Attach the reply (enforces company match and one reply per post)
+	reply, err := post.AttachOfficialReply(
+		representative.ID().String(),
+		representative.Company(),
+		representative.Domain().String(),
+		replyContent,
+	)
+	if err != nil {
+		switch {
+		case errors.Is(err, content.ErrCompanyMismatch):
+			return nil, apperrors.NewForbiddenError(err.Error())
+		case errors.Is(err, content.ErrOfficialReplyExists):
+			return nil, apperrors.NewConflictError(err.Error())
+		default:
+			return nil, apperrors.NewValidationErrorWithDetails("invalid official reply", map[string]interface{}{
+				"error": err.Error(),
+			})
+		}
+	}
+
+	// 5. Save the post together with the reply
+	if err := uc.postRepo.Save(ctx, post); err != nil {
+		return nil, err
+	}
+
+	// 6. Clear the cached post detail (errors are ignored)
+	_ = uc.cacheRepo.Delete(ctx, fmt.Sprintf("post:%s", post.ID().String()))
+
+	// 7. Notify the post author (errors are ignored; the reply is already saved)
+	if uc.notifier != nil {
+		_ = uc.notifier.NotifyCompanyReply(ctx, post, reply)
+	}
+
+	return &dto.OfficialReplyDTO{
+		ID:             reply.ID(),
+		Company:        representative.Company().String(),
+		VerifiedDomain: reply.VerifiedDomain(),
+		Content:        reply.Content().String(),
+		Badge:          reply.Badge(),
+		CreatedAt:      reply.CreatedAt(),
+	}, nil
+}
+
+// validateCommand validates the post official reply command.
+func (uc *PostOfficialReplyUseCase) validateCommand(cmd PostOfficialReplyCommand) error {
+	if cmd.PostID == "" {
+		return apperrors.NewValidationError("post ID is required")
+	}
+
+	if cmd.RepresentativeID == "" {
+		return apperrors.NewValidationError("representative ID is required")
+	}
+
+	if cmd.AccessToken == "" {
+		return apperrors.NewValidationError("access token is required")
+	}
+
+	if cmd.Content == "" {
+		return apperrors.NewValidationError("reply content is required")
+	}
+
+	return nil
+}
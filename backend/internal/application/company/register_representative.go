@@ -0,0 +1,146 @@
+// Package company provides use cases for company representatives and their right of reply.
+package company
+
+import (
+	"context"
+	"time"
+
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/application/ratelimit"
+	"fuck_boss/backend/internal/domain/company"
+	"fuck_boss/backend/internal/domain/content"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// RegisterRepresentativeCommand represents the command to register a company representative.
+type RegisterRepresentativeCommand struct {
+	// Company is the company name exactly as it appears in posts (required, 1-100 characters).
+	Company string
+
+	// Domain is the company domain to verify (required, e.g., "example.com").
+	Domain string
+
+	// ChallengeMethod is how the domain will be verified (required, DNS_TXT or FILE).
+	ChallengeMethod string
+
+	// ClientIP is the client IP address for rate limiting (required).
+	ClientIP string
+}
+
+// RegisterRepresentativeUseCase handles the registration of company representatives.
+// A new representative is pending until the domain challenge is verified, which
+// also requires an operator to approve the domain for the company.
+type RegisterRepresentativeUseCase struct {
+	// repo is the Representative repository.
+	repo company.RepresentativeRepository
+
+	// rateLimiter is the rate limiter for preventing abuse.
+	rateLimiter ratelimit.RateLimiter
+}
+
+// NewRegisterRepresentativeUseCase creates a new RegisterRepresentativeUseCase instance.
+func NewRegisterRepresentativeUseCase(
+	repo company.RepresentativeRepository,
+	rateLimiter ratelimit.RateLimiter,
+) *RegisterRepresentativeUseCase {
+	return &RegisterRepresentativeUseCase{
+		repo:        repo,
+		rateLimiter: rateLimiter,
+	}
+}
+
+// Execute executes the register representative command.
+// It returns the pending representative with challenge instructions and the raw access token.
+func (uc *RegisterRepresentativeUseCase) Execute(ctx context.Context, cmd RegisterRepresentativeCommand) (*dto.RepresentativeDTO, error) {
+	// 1. Validate input
+	if err := uc.validateCommand(cmd); err != nil {
+		return nil, err
+	}
+
+	// 2. Check rate limit (5 registrations per hour per IP)
+	rateLimitKey := ratelimit.ClientKey("representative", cmd.ClientIP, time.Now())
+	allowed, err := uc.rateLimiter.Allow(ctx, rateLimitKey, 5, time.Hour)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("rate limit check failed", err)
+	}
+	if !allowed {
+		return nil, apperrors.NewRateLimitError("rate limit exceeded: maximum 5 registrations per hour")
+	}
+
+	// 3. Create domain value objects
+	companyName, err := content.NewCompanyName(cmd.Company)
+	if err != nil {
+		return nil, apperrors.NewValidationErrorWithDetails("invalid company name", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	domain, err := company.NewCompanyDomain(cmd.Domain)
+	if err != nil {
+		return nil, apperrors.NewValidationErrorWithDetails("invalid company domain", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	method, err := company.NewChallengeMethod(cmd.ChallengeMethod)
+	if err != nil {
+		return nil, apperrors.NewValidationErrorWithDetails("invalid challenge method", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	// 4. Create the pending representative
+	representative, token, err := company.NewRepresentative(companyName, domain, method)
+	if err != nil {
+		return nil, apperrors.NewInternalErrorWithCause("failed to register representative", err)
+	}
+
+	// 5. Save to repository
+	if err := uc.repo.Save(ctx, representative); err != nil {
+		return nil, err
+	}
+
+	// 6. Convert to DTO and return (the raw token is only returned here)
+	result := toRepresentativeDTO(representative)
+	result.AccessToken = token.String()
+	return result, nil
+}
+
+// validateCommand validates the register representative command.
+func (uc *RegisterRepresentativeUseCase) validateCommand(cmd RegisterRepresentativeCommand) error {
+	if cmd.Company == "" {
+		return apperrors.NewValidationError("company name is required")
+	}
+
+	if cmd.Domain == "" {
+		return apperrors.NewValidationError("company domain is required")
+	}
+
+	if cmd.ChallengeMethod == "" {
+		return apperrors.NewValidationError("challenge method is required")
+	}
+
+	if cmd.ClientIP == "" {
+		return apperrors.NewValidationError("client IP is required for rate limiting")
+	}
+
+	return nil
+}
+
+// toRepresentativeDTO converts a Representative entity to RepresentativeDTO.
+func toRepresentativeDTO(representative *company.Representative) *dto.RepresentativeDTO {
+	challenge := representative.Challenge()
+	return &dto.RepresentativeDTO{
+		ID:                   representative.ID().String(),
+		Company:              representative.Company().String(),
+		Domain:               representative.Domain().String(),
+		Status:               representative.Status().String(),
+		ChallengeMethod:      challenge.Method().String(),
+		ChallengeRecordName:  challenge.RecordName(),
+		ChallengeRecordValue: challenge.RecordValue(),
+		ChallengeFileURL:     challenge.FileURL(),
+		ChallengeCode:        challenge.Code(),
+		CreatedAt:            representative.CreatedAt(),
+		VerifiedAt:           representative.VerifiedAt(),
+	}
+}
@@ -0,0 +1,132 @@
+// Package company provides use cases for company representatives and their right of reply.
+package company
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"fuck_boss/backend/internal/application/challenge"
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/application/ratelimit"
+	"fuck_boss/backend/internal/domain/company"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// VerifyRepresentativeCommand represents the command to check a representative's domain challenge.
+type VerifyRepresentativeCommand struct {
+	// RepresentativeID is the ID returned at registration (required).
+	RepresentativeID string
+
+	// AccessToken is the token returned at registration (required).
+	AccessToken string
+}
+
+// VerifyRepresentativeUseCase checks the domain challenge of a pending representative.
+// The check itself is delegated to a challenge.Verifier (DNS TXT or HTTPS file).
+// A challenge only proves control of the domain, so the domain must also have
+// been approved for the company by an operator.
+type VerifyRepresentativeUseCase struct {
+	// repo is the Representative repository.
+	repo company.RepresentativeRepository
+
+	// approvalRepo is the repository of operator-approved company domains.
+	approvalRepo company.DomainApprovalRepository
+
+	// verifier checks whether the challenge is published on the company domain.
+	verifier challenge.Verifier
+
+	// rateLimiter limits how often a representative can trigger external checks.
+	rateLimiter ratelimit.RateLimiter
+}
+
+// NewVerifyRepresentativeUseCase creates a new VerifyRepresentativeUseCase instance.
+func NewVerifyRepresentativeUseCase(
+	repo company.RepresentativeRepository,
+	approvalRepo company.DomainApprovalRepository,
+	verifier challenge.Verifier,
+	rateLimiter ratelimit.RateLimiter,
+) *VerifyRepresentativeUseCase {
+	return &VerifyRepresentativeUseCase{
+		repo:         repo,
+		approvalRepo: approvalRepo,
+		verifier:     verifier,
+		rateLimiter:  rateLimiter,
+	}
+}
+
+// Execute executes the verify representative command.
+// It returns the representative; verified representatives are returned unchanged.
+func (uc *VerifyRepresentativeUseCase) Execute(ctx context.Context, cmd VerifyRepresentativeCommand) (*dto.RepresentativeDTO, error) {
+	// 1. Validate input
+	if cmd.RepresentativeID == "" {
+		return nil, apperrors.NewValidationError("representative ID is required")
+	}
+	if cmd.AccessToken == "" {
+		return nil, apperrors.NewValidationError("access token is required")
+	}
+
+	representativeID, err := company.NewRepresentativeID(cmd.RepresentativeID)
+	if err != nil {
+		return nil, apperrors.NewValidationErrorWithDetails("invalid representative ID", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	// 2. Load and authenticate the representative
+	representative, err := uc.repo.FindByID(ctx, representativeID)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to query representative", err)
+	}
+
+	if err := representative.Authenticate(cmd.AccessToken); err != nil {
+		return nil, apperrors.NewForbiddenError("access token does not match representative")
+	}
+
+	if representative.IsVerified() {
+		return toRepresentativeDTO(representative), nil
+	}
+
+	// 3. Only domains an operator approved for the company can be verified
+	if err := ensureDomainApproved(ctx, uc.approvalRepo, representative); err != nil {
+		return nil, err
+	}
+
+	// 4. Check rate limit (10 checks per hour per representative)
+	rateLimitKey := fmt.Sprintf("rate_limit:representative_verify:%s:%s", representativeID.String(), time.Now().Format("2006-01-02-15"))
+	allowed, err := uc.rateLimiter.Allow(ctx, rateLimitKey, 10, time.Hour)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("rate limit check failed", err)
+	}
+	if !allowed {
+		return nil, apperrors.NewRateLimitError("rate limit exceeded: maximum 10 verification attempts per hour")
+	}
+
+	// 5. Check the domain challenge
+	ok, err := uc.verifier.Verify(ctx, representative.Challenge())
+	if err != nil {
+		return nil, apperrors.NewInternalErrorWithCause("failed to check domain challenge", err)
+	}
+	if !ok {
+		pending := representative.Challenge()
+		details := map[string]interface{}{"method": pending.Method().String()}
+		if pending.Method() == company.ChallengeMethodDNSTXT {
+			details["recordName"] = pending.RecordName()
+			details["recordValue"] = pending.RecordValue()
+		} else {
+			details["fileURL"] = pending.FileURL()
+		}
+		return nil, apperrors.NewValidationErrorWithDetails("domain challenge not found", details)
+	}
+
+	// 6. Mark verified and save
+	representative.MarkVerified()
+	if err := uc.repo.Save(ctx, representative); err != nil {
+		return nil, err
+	}
+
+	return toRepresentativeDTO(representative), nil
+}
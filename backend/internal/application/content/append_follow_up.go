@@ -0,0 +1,145 @@
+// Package content provides use cases for content management.
+package content
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"fuck_boss/backend/internal/application/cache"
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/domain/content"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// AppendFollowUpCommand represents the command to append a follow-up to a post.
+type AppendFollowUpCommand struct {
+	// PostID is the ID of the post to append to (required).
+	PostID string
+
+	// ManagementToken is the token returned when the post was created (required).
+	ManagementToken string
+
+	// Status is the resolution status tag (required, ONGOING, ESCALATED or RESOLVED).
+	Status string
+
+	// Note is the follow-up text (required, 2-2000 characters).
+	Note string
+}
+
+// AppendFollowUpUseCase handles appending author follow-ups to posts.
+// It verifies authorship through the management token and invalidates cached views.
+type AppendFollowUpUseCase struct {
+	// repo is the Post repository.
+	repo content.PostRepository
+
+	// cacheRepo is the cache repository for cache invalidation.
+	cacheRepo cache.CacheRepository
+}
+
+// NewAppendFollowUpUseCase creates a new AppendFollowUpUseCase instance.
+func NewAppendFollowUpUseCase(
+	repo content.PostRepository,
+	cacheRepo cache.CacheRepository,
+) *AppendFollowUpUseCase {
+	return &AppendFollowUpUseCase{
+		repo:      repo,
+		cacheRepo: cacheRepo,
+	}
+}
+
+// Execute executes the append follow-up command.
+// It returns the created follow-up entry.
+func (uc *AppendFollowUpUseCase) Execute(ctx context.Context, cmd AppendFollowUpCommand) (*dto.FollowUpDTO, error) {
+	// 1. Validate input
+	if err := uc.validateCommand(cmd); err != nil {
+		return nil, err
+	}
+
+	postID, err := content.NewPostID(cmd.PostID)
+	if err != nil {
+		return nil, apperrors.NewValidationErrorWithDetails("invalid post ID", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	status, err := content.NewResolutionStatus(cmd.Status)
+	if err != nil {
+		return nil, apperrors.NewValidationErrorWithDetails("invalid resolution status", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	note, err := content.NewFollowUpNote(cmd.Note)
+	if err != nil {
+		return nil, apperrors.NewValidationErrorWithDetails("invalid follow-up note", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	// 2. Load the post
+	post, err := uc.repo.FindByID(ctx, postID)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to query post", err)
+	}
+
+	// 3. Append the follow-up (verifies the management token)
+	followUp, err := post.AppendFollowUp(cmd.ManagementToken, status, note)
+	if err != nil {
+		if errors.Is(err, content.ErrManagementTokenMismatch) {
+			return nil, apperrors.NewForbiddenError("management token does not match post")
+		}
+		return nil, apperrors.NewValidationErrorWithDetails("invalid follow-up", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	// 4. Save the post together with the new follow-up
+	if err := uc.repo.Save(ctx, post); err != nil {
+		return nil, err
+	}
+
+	// 5. Clear related cache (errors are ignored)
+	uc.invalidateCache(ctx, post)
+
+	return &dto.FollowUpDTO{
+		ID:        followUp.ID(),
+		Status:    followUp.Status().String(),
+		Note:      followUp.Note().String(),
+		CreatedAt: followUp.CreatedAt(),
+	}, nil
+}
+
+// validateCommand validates the append follow-up command.
+func (uc *AppendFollowUpUseCase) validateCommand(cmd AppendFollowUpCommand) error {
+	if cmd.PostID == "" {
+		return apperrors.NewValidationError("post ID is required")
+	}
+
+	if cmd.ManagementToken == "" {
+		return apperrors.NewValidationError("management token is required")
+	}
+
+	if cmd.Status == "" {
+		return apperrors.NewValidationError("status is required")
+	}
+
+	if cmd.Note == "" {
+		return apperrors.NewValidationError("note is required")
+	}
+
+	return nil
+}
+
+// invalidateCache clears the cached post detail, its share cards (the card shows
+// the resolution status) and the list pages it may appear on.
+// Cache invalidation failure should not fail the operation.
+func (uc *AppendFollowUpUseCase) invalidateCache(ctx context.Context, post *content.Post) {
+	_ = uc.cacheRepo.Delete(ctx, fmt.Sprintf("post:%s", post.ID().String()))
+	_ = uc.cacheRepo.DeleteByPattern(ctx, shareCardCachePrefix(post.ID().String())+"*")
+	_ = uc.cacheRepo.DeleteByPattern(ctx, fmt.Sprintf("posts:city:%s:*", post.City().Code()))
+	_ = uc.cacheRepo.DeleteByPattern(ctx, "posts:city:all:*")
+}
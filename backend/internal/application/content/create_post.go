@@ -8,6 +8,7 @@ import (
 
 	"fuck_boss/backend/internal/application/cache"
 	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/application/livefeed"
 	"fuck_boss/backend/internal/application/ratelimit"
 	"fuck_boss/backend/internal/domain/content"
 	"fuck_boss/backend/internal/domain/shared"
@@ -34,6 +35,15 @@ type CreatePostCommand struct {
 
 	// ClientIP is the client IP address for rate limiting (required).
 	ClientIP string
+
+	// AuthorID is the logged-in user creating the post (optional, empty for anonymous posts).
+	AuthorID string
+}
+
+// PostNotifier notifies the watchers of a company about a new post.
+// It is implemented by notification.Notifier.
+type PostNotifier interface {
+	NotifyWatchedCompanyPost(ctx context.Context, post *content.Post) error
 }
 
 // CreatePostUseCase handles the creation of posts.
@@ -47,6 +57,12 @@ type CreatePostUseCase struct {
 
 	// rateLimiter is the rate limiter for preventing abuse.
 	rateLimiter ratelimit.RateLimiter
+
+	// notifier notifies watchers of the company (nil disables notifications).
+	notifier PostNotifier
+
+	// publisher pushes the post to live feed subscribers (nil disables the live feed).
+	publisher livefeed.Publisher
 }
 
 // NewCreatePostUseCase creates a new CreatePostUseCase instance.
@@ -54,11 +70,15 @@ func NewCreatePostUseCase(
 	repo content.PostRepository,
 	cacheRepo cache.CacheRepository,
 	rateLimiter ratelimit.RateLimiter,
+	notifier PostNotifier,
+	publisher livefeed.Publisher,
 ) *CreatePostUseCase {
 	return &CreatePostUseCase{
 		repo:        repo,
 		cacheRepo:   cacheRepo,
 		rateLimiter: rateLimiter,
+		notifier:    notifier,
+		publisher:   publisher,
 	}
 }
 
@@ -104,12 +124,21 @@ func (uc *CreatePostUseCase) Execute(ctx context.Context, cmd CreatePostCommand)
 		})
 	}
 
-	// 4. Create Post entity
+	// 4. Create Post entity with a management token for the author
 	post, err := content.NewPost(company, city, postContent)
 	if err != nil {
 		return nil, apperrors.NewInternalErrorWithCause("failed to create post", err)
 	}
 
+	token, err := content.GenerateManagementToken()
+	if err != nil {
+		return nil, apperrors.NewInternalErrorWithCause("failed to create post", err)
+	}
+	post.AssignManagementToken(token)
+	if cmd.AuthorID != "" {
+		post.AssignAuthor(cmd.AuthorID)
+	}
+
 	// 5. Save to repository
 	err = uc.repo.Save(ctx, post)
 	if err != nil {
@@ -126,8 +155,20 @@ func (uc *CreatePostUseCase) Execute(ctx context.Context, cmd CreatePostCommand)
 		// In production, you might want to log this error
 	}
 
-	// 7. Convert to DTO and return
-	return uc.toDTO(post, cmd.OccurredAt), nil
+	// 7. Notify watchers of the company (errors are ignored; the post is already saved)
+	if uc.notifier != nil {
+		_ = uc.notifier.NotifyWatchedCompanyPost(ctx, post)
+	}
+
+	// 8. Push to live feed subscribers (errors are ignored; clients can still poll)
+	if uc.publisher != nil {
+		_ = uc.publisher.Publish(ctx, postToListDTO(post))
+	}
+
+	// 9. Convert to DTO and return (the raw token is only returned here)
+	result := uc.toDTO(post, cmd.OccurredAt)
+	result.ManagementToken = token.String()
+	return result, nil
 }
 
 // validateCommand validates the create post command.
@@ -158,20 +199,20 @@ func (uc *CreatePostUseCase) validateCommand(cmd CreatePostCommand) error {
 // buildRateLimitKey builds the rate limit key for the given IP.
 // Format: "rate_limit:post:{ip}:{hour}"
 func (uc *CreatePostUseCase) buildRateLimitKey(ip string) string {
-	now := time.Now()
-	hour := now.Format("2006-01-02-15") // Format: YYYY-MM-DD-HH
-	return fmt.Sprintf("rate_limit:post:%s:%s", ip, hour)
+	return ratelimit.ClientKey("post", ip, time.Now())
 }
 
 // toDTO converts a Post entity to PostDTO.
 func (uc *CreatePostUseCase) toDTO(post *content.Post, occurredAt *time.Time) *dto.PostDTO {
 	return &dto.PostDTO{
-		ID:         post.ID().String(),
-		Company:    post.Company().String(),
-		CityCode:   post.City().Code(),
-		CityName:   post.City().Name(),
-		Content:    post.Content().String(),
-		OccurredAt: occurredAt,
-		CreatedAt:  post.CreatedAt(),
+		ID:               post.ID().String(),
+		Company:          post.Company().String(),
+		CityCode:         post.City().Code(),
+		CityName:         post.City().Name(),
+		Content:          post.Content().String(),
+		ContentHTML:      post.Content().HTML(),
+		OccurredAt:       occurredAt,
+		CreatedAt:        post.CreatedAt(),
+		ResolutionStatus: post.Resolution().String(),
 	}
 }
@@ -0,0 +1,142 @@
+package content
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/domain/content"
+	"fuck_boss/backend/internal/domain/shared"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// ExportBatchSize is the number of posts read from the repository at a time
+// when exporting.
+const ExportBatchSize = 500
+
+// ExportPostsQuery represents the filter of a dataset export.
+// Empty fields are not applied.
+type ExportPostsQuery struct {
+	// CityCode restricts the export to a city (optional).
+	CityCode string
+
+	// Company restricts the export to a company (exact name match, optional).
+	Company string
+
+	// ResolutionStatus restricts the export to posts whose latest follow-up
+	// has this status: ONGOING, ESCALATED or RESOLVED (optional).
+	ResolutionStatus string
+
+	// From restricts the export to posts created at or after this time (optional).
+	From *time.Time
+
+	// To restricts the export to posts created strictly before this time (optional).
+	To *time.Time
+}
+
+// ExportPostsUseCase streams posts for dataset exports.
+type ExportPostsUseCase struct {
+	// repo is the Post repository.
+	repo content.PostRepository
+}
+
+// NewExportPostsUseCase creates a new ExportPostsUseCase instance.
+func NewExportPostsUseCase(repo content.PostRepository) *ExportPostsUseCase {
+	return &ExportPostsUseCase{
+		repo: repo,
+	}
+}
+
+// Execute calls write for every post matching the query, oldest first, and
+// returns the number of posts written. Posts are read in batches of
+// ExportBatchSize with keyset pagination, so the export never holds more than
+// a batch in memory and posts created meanwhile do not shift the pages.
+// It stops at the first error of write.
+func (uc *ExportPostsUseCase) Execute(ctx context.Context, query ExportPostsQuery, write func(*dto.ExportedPostDTO) error) (int, error) {
+	// 1. Validate input and build the filter
+	filter, err := exportFilter(query)
+	if err != nil {
+		return 0, err
+	}
+
+	// 2. Walk the matching posts in (created_at, id) order
+	var after *content.PostCursor
+	count := 0
+	for {
+		posts, err := uc.repo.FindByFilterAfter(ctx, filter, after, ExportBatchSize)
+		if err != nil {
+			return count, apperrors.NewDatabaseErrorWithCause("failed to query posts for export", err)
+		}
+
+		for _, post := range posts {
+			if err := write(postToExportDTO(post)); err != nil {
+				return count, err
+			}
+			count++
+		}
+
+		if len(posts) < ExportBatchSize {
+			return count, nil
+		}
+		last := posts[len(posts)-1]
+		after = &content.PostCursor{CreatedAt: last.CreatedAt(), ID: last.ID()}
+	}
+}
+
+// exportFilter validates an export query and converts it to a PostFilter.
+func exportFilter(query ExportPostsQuery) (content.PostFilter, error) {
+	var filter content.PostFilter
+
+	if cityCode := strings.TrimSpace(query.CityCode); cityCode != "" {
+		// Only the code is used to filter
+		city, err := shared.NewCity(cityCode, cityCode)
+		if err != nil {
+			return filter, apperrors.NewValidationErrorWithDetails("invalid city code", map[string]interface{}{
+				"error": err.Error(),
+			})
+		}
+		filter.City = &city
+	}
+
+	if strings.TrimSpace(query.Company) != "" {
+		name, err := content.NewCompanyName(query.Company)
+		if err != nil {
+			return filter, apperrors.NewValidationErrorWithDetails("invalid company name", map[string]interface{}{
+				"error": err.Error(),
+			})
+		}
+		filter.Companies = []content.CompanyName{name}
+	}
+
+	if query.ResolutionStatus != "" {
+		status, err := content.NewResolutionStatus(query.ResolutionStatus)
+		if err != nil {
+			return filter, apperrors.NewValidationErrorWithDetails("invalid resolution status", map[string]interface{}{
+				"error": err.Error(),
+			})
+		}
+		filter.Resolution = &status
+	}
+
+	if query.From != nil && query.To != nil && !query.From.Before(*query.To) {
+		return filter, apperrors.NewValidationError("from must be before to")
+	}
+	filter.CreatedSince = query.From
+	filter.CreatedBefore = query.To
+
+	return filter, nil
+}
+
+// postToExportDTO converts a Post entity to an ExportedPostDTO.
+func postToExportDTO(post *content.Post) *dto.ExportedPostDTO {
+	return &dto.ExportedPostDTO{
+		ID:               post.ID().String(),
+		CreatedAt:        post.CreatedAt(),
+		CityCode:         post.City().Code(),
+		CityName:         post.City().Name(),
+		Company:          post.Company().String(),
+		ResolutionStatus: post.Resolution().String(),
+		Content:          post.Content().String(),
+	}
+}
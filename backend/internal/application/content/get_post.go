@@ -115,12 +115,52 @@ func (uc *GetPostUseCase) updateCache(ctx context.Context, key string, result *d
 // toDTO converts a Post entity to PostDTO.
 func (uc *GetPostUseCase) toDTO(post *content.Post) *dto.PostDTO {
 	return &dto.PostDTO{
-		ID:         post.ID().String(),
-		Company:    post.Company().String(),
-		CityCode:   post.City().Code(),
-		CityName:   post.City().Name(),
-		Content:    post.Content().String(),
-		OccurredAt: nil, // Not stored in Post entity
-		CreatedAt:  post.CreatedAt(),
+		ID:               post.ID().String(),
+		Company:          post.Company().String(),
+		CityCode:         post.City().Code(),
+		CityName:         post.City().Name(),
+		Content:          post.Content().String(),
+		ContentHTML:      post.Content().HTML(),
+		OccurredAt:       nil, // Not stored in Post entity
+		CreatedAt:        post.CreatedAt(),
+		ResolutionStatus: post.Resolution().String(),
+		Timeline:         toFollowUpDTOs(post.FollowUps()),
+		OfficialReply:    toOfficialReplyDTO(post),
+	}
+}
+
+// toFollowUpDTOs converts the follow-up timeline of a Post to FollowUpDTOs.
+func toFollowUpDTOs(followUps []*content.FollowUp) []*dto.FollowUpDTO {
+	if len(followUps) == 0 {
+		return nil
+	}
+
+	dtos := make([]*dto.FollowUpDTO, 0, len(followUps))
+	for _, followUp := range followUps {
+		dtos = append(dtos, &dto.FollowUpDTO{
+			ID:        followUp.ID(),
+			Status:    followUp.Status().String(),
+			Note:      followUp.Note().String(),
+			CreatedAt: followUp.CreatedAt(),
+		})
+	}
+	return dtos
+}
+
+// toOfficialReplyDTO converts the official company reply of a Post to OfficialReplyDTO.
+// Returns nil if the post has no official reply.
+func toOfficialReplyDTO(post *content.Post) *dto.OfficialReplyDTO {
+	reply := post.OfficialReply()
+	if reply == nil {
+		return nil
+	}
+
+	return &dto.OfficialReplyDTO{
+		ID:             reply.ID(),
+		Company:        post.Company().String(),
+		VerifiedDomain: reply.VerifiedDomain(),
+		Content:        reply.Content().String(),
+		Badge:          reply.Badge(),
+		CreatedAt:      reply.CreatedAt(),
 	}
 }
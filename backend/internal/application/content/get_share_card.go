@@ -0,0 +1,184 @@
+package content
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+
+	"fuck_boss/backend/internal/application/cache"
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/domain/content"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+const (
+	// ShareCardWidth and ShareCardHeight are the size of share cards in pixels
+	// (the 1.91:1 ratio recommended for Open Graph images).
+	ShareCardWidth  = 1200
+	ShareCardHeight = 630
+
+	// shareCardRevision is part of the card version; bump it when the card
+	// layout changes so cached cards are rendered again.
+	shareCardRevision = "1"
+
+	// shareCardCacheTTL is how long rendered cards are cached.
+	shareCardCacheTTL = 7 * 24 * time.Hour
+)
+
+// shareCardStatusLabels are the card labels of the resolution statuses.
+var shareCardStatusLabels = map[string]string{
+	content.ResolutionStatusOngoing.String():   "进行中",
+	content.ResolutionStatusEscalated.String(): "已升级处理",
+	content.ResolutionStatusResolved.String():  "已解决",
+}
+
+// ShareCard is the text shown on the share card of a post.
+type ShareCard struct {
+	// Company is the company name.
+	Company string
+
+	// City is the city name.
+	City string
+
+	// Summary is the plain-text summary of the content.
+	Summary string
+
+	// Status is the label of the resolution status (empty if none).
+	Status string
+
+	// Date is when the post was published.
+	Date time.Time
+
+	// SiteName is the site name shown in the footer.
+	SiteName string
+}
+
+// ShareCardRenderer renders share cards.
+// Implementations are in Infrastructure Layer (e.g., pure-Go image drawing).
+type ShareCardRenderer interface {
+	// Render draws the card as a ShareCardWidth x ShareCardHeight PNG image.
+	Render(card ShareCard) ([]byte, error)
+}
+
+// ShareCardImage is a rendered share card.
+type ShareCardImage struct {
+	// Data is the PNG image.
+	Data []byte
+
+	// Version identifies the card content (see ShareCardVersion).
+	Version string
+}
+
+// ShareCardVersion returns the version of a post's share card. It changes
+// whenever the text on the card changes, so it can be used in card URLs that
+// are cached for a long time.
+func ShareCardVersion(post *dto.PostDTO) string {
+	h := sha256.New()
+	for _, part := range []string{
+		shareCardRevision,
+		post.ID,
+		post.Company,
+		post.CityName,
+		post.Content,
+		post.ResolutionStatus,
+		strconv.FormatInt(post.CreatedAt.UnixNano(), 10),
+	} {
+		// Length-prefixed, so different fields cannot produce the same input
+		fmt.Fprintf(h, "%d:%s", len(part), part)
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+// GetShareCardUseCase handles getting the share card image of a post.
+// Cards are rendered on demand and cached by post and version, so an edited
+// post gets a new card.
+type GetShareCardUseCase struct {
+	// getPostUseCase loads the (cached) post.
+	getPostUseCase *GetPostUseCase
+
+	// cacheRepo is the cache repository for rendered cards.
+	cacheRepo cache.CacheRepository
+
+	// renderer draws the cards.
+	renderer ShareCardRenderer
+
+	// siteName is the site name shown on the cards.
+	siteName string
+}
+
+// NewGetShareCardUseCase creates a new GetShareCardUseCase instance.
+func NewGetShareCardUseCase(
+	getPostUseCase *GetPostUseCase,
+	cacheRepo cache.CacheRepository,
+	renderer ShareCardRenderer,
+	siteName string,
+) *GetShareCardUseCase {
+	return &GetShareCardUseCase{
+		getPostUseCase: getPostUseCase,
+		cacheRepo:      cacheRepo,
+		renderer:       renderer,
+		siteName:       siteName,
+	}
+}
+
+// Execute returns the share card of the post.
+// Returns NOT_FOUND if the post does not exist.
+func (uc *GetShareCardUseCase) Execute(ctx context.Context, postID string) (*ShareCardImage, error) {
+	// 1. Load the post (validates the ID)
+	post, err := uc.getPostUseCase.Execute(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Try the cache
+	version := ShareCardVersion(post)
+	cacheKey := uc.buildCacheKey(post.ID, version)
+	if cached, err := uc.cacheRepo.Get(ctx, cacheKey); err == nil && cached != "" {
+		return &ShareCardImage{Data: []byte(cached), Version: version}, nil
+	}
+
+	// 3. Render the card
+	data, err := uc.renderer.Render(uc.toShareCard(post))
+	if err != nil {
+		return nil, apperrors.NewInternalErrorWithCause("failed to render share card", err)
+	}
+
+	// 4. Update the cache (errors are ignored)
+	_ = uc.cacheRepo.Set(ctx, cacheKey, string(data), shareCardCacheTTL)
+
+	return &ShareCardImage{Data: data, Version: version}, nil
+}
+
+// buildCacheKey builds the cache key of a rendered card.
+// Format: "post:{postID}:card:{version}"
+func (uc *GetShareCardUseCase) buildCacheKey(postID, version string) string {
+	return fmt.Sprintf("%s%s", shareCardCachePrefix(postID), version)
+}
+
+// toShareCard converts a post to the text shown on its card.
+func (uc *GetShareCardUseCase) toShareCard(post *dto.PostDTO) ShareCard {
+	summary := post.Summary
+	if summary == "" {
+		if c, err := content.NewContent(post.Content); err == nil {
+			summary = c.Summary()
+		}
+	}
+
+	return ShareCard{
+		Company:  post.Company,
+		City:     post.CityName,
+		Summary:  summary,
+		Status:   shareCardStatusLabels[post.ResolutionStatus],
+		Date:     post.CreatedAt,
+		SiteName: uc.siteName,
+	}
+}
+
+// shareCardCachePrefix returns the prefix of the cache keys of a post's cards,
+// for invalidating them when the post changes.
+func shareCardCachePrefix(postID string) string {
+	return fmt.Sprintf("post:%s:card:", postID)
+}
@@ -0,0 +1,41 @@
+package content
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"fuck_boss/backend/internal/application/blob"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// GetSitemapUseCase opens the sitemap files generated by RefreshSitemapUseCase.
+type GetSitemapUseCase struct {
+	// store keeps the sitemap files.
+	store blob.BlobStore
+}
+
+// NewGetSitemapUseCase creates a new GetSitemapUseCase instance.
+func NewGetSitemapUseCase(store blob.BlobStore) *GetSitemapUseCase {
+	return &GetSitemapUseCase{
+		store: store,
+	}
+}
+
+// Execute opens the sitemap file with the given name: SitemapIndexName or a
+// shard name (see SitemapShardName). The caller must close the reader.
+// Unknown names and files not generated yet are not found.
+func (uc *GetSitemapUseCase) Execute(ctx context.Context, name string) (io.ReadCloser, error) {
+	if name != SitemapIndexName && !sitemapShardPattern.MatchString(name) {
+		return nil, apperrors.NewNotFoundError("sitemap")
+	}
+
+	body, err := uc.store.Get(ctx, name)
+	if err != nil {
+		if errors.Is(err, blob.ErrNotFound) {
+			return nil, apperrors.NewNotFoundError("sitemap")
+		}
+		return nil, apperrors.NewInternalErrorWithCause("failed to open sitemap", err)
+	}
+	return body, nil
+}
@@ -0,0 +1,292 @@
+package content
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"fuck_boss/backend/internal/application/cache"
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/domain/content"
+	"fuck_boss/backend/internal/domain/shared"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+const (
+	// ImportBatchSize is the number of valid rows written at a time when importing.
+	ImportBatchSize = 1000
+
+	// MaxImportErrors is the maximum number of row errors listed in an import report.
+	MaxImportErrors = 1000
+)
+
+// importTimeLayouts are the accepted layouts of created_at, tried in order.
+// Layouts without an offset are read in ImportPostsCommand.Location.
+var importTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006/01/02 15:04:05",
+	"2006/01/02 15:04",
+	"2006-01-02",
+	"2006/01/02",
+}
+
+// ImportPostRecord is a row of a dataset to import, as read from the file.
+type ImportPostRecord struct {
+	// Line is the line number of the row in the file, for the report.
+	Line int
+
+	// ExternalID is the row's ID in the source dataset (required).
+	ExternalID string
+
+	// CreatedAt is when the post was originally published (required, see importTimeLayouts).
+	CreatedAt string
+
+	// CityCode is the city code (required).
+	CityCode string
+
+	// CityName is the city name (required).
+	CityName string
+
+	// Company is the company name (required).
+	Company string
+
+	// Content is the post content (required).
+	Content string
+
+	// OccurredAt is when the incident occurred (optional, same layouts as CreatedAt).
+	OccurredAt string
+
+	// ResolutionStatus is ONGOING, ESCALATED or RESOLVED (optional).
+	ResolutionStatus string
+
+	// Err is set when the row could not be parsed; it is reported as invalid.
+	Err error
+}
+
+// ImportPostsCommand represents the options of a dataset import.
+type ImportPostsCommand struct {
+	// Source is the name of the dataset (required, e.g. "partner-a").
+	// External IDs are unique per source.
+	Source string
+
+	// DryRun validates the rows and reports what would be imported without writing anything.
+	DryRun bool
+
+	// Location is the time zone of created_at values without an offset (default UTC).
+	Location *time.Location
+}
+
+// ImportPostsUseCase imports posts from partner datasets.
+type ImportPostsUseCase struct {
+	// importRepo stores the imported posts.
+	importRepo content.PostImportRepository
+
+	// cacheRepo is the cache repository for cache invalidation (nil skips invalidation).
+	cacheRepo cache.CacheRepository
+}
+
+// NewImportPostsUseCase creates a new ImportPostsUseCase instance.
+func NewImportPostsUseCase(importRepo content.PostImportRepository, cacheRepo cache.CacheRepository) *ImportPostsUseCase {
+	return &ImportPostsUseCase{
+		importRepo: importRepo,
+		cacheRepo:  cacheRepo,
+	}
+}
+
+// Execute reads rows with next until it returns io.EOF, validates every row
+// like a new post, and imports the valid ones in batches of ImportBatchSize.
+// Rows already imported from the source are skipped, so running an import
+// again only adds the rows that were fixed or added since.
+// Invalid rows are listed in the report and do not stop the import; errors of
+// next or the repository do (batches written before stay imported).
+func (uc *ImportPostsUseCase) Execute(ctx context.Context, cmd ImportPostsCommand, next func() (*ImportPostRecord, error)) (*dto.ImportReportDTO, error) {
+	// 1. Validate the command
+	if err := content.ValidateExternalSource(cmd.Source); err != nil {
+		return nil, apperrors.NewValidationErrorWithDetails("invalid source", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+	location := cmd.Location
+	if location == nil {
+		location = time.UTC
+	}
+
+	report := &dto.ImportReportDTO{DryRun: cmd.DryRun}
+	reject := func(record *ImportPostRecord, err error) {
+		report.Invalid++
+		if len(report.Errors) < MaxImportErrors {
+			report.Errors = append(report.Errors, &dto.ImportRowErrorDTO{
+				Line:       record.Line,
+				ExternalID: strings.TrimSpace(record.ExternalID),
+				Error:      err.Error(),
+			})
+		}
+	}
+
+	// seen maps the external IDs of valid rows to their line, to reject duplicates
+	seen := make(map[string]int)
+	cities := make(map[string]bool)
+	var batch []*content.ImportedPost
+
+	// 2. Validate the rows and import them in batches
+	for {
+		record, err := next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return report, err
+		}
+		report.Rows++
+
+		if record.Err != nil {
+			reject(record, record.Err)
+			continue
+		}
+		post, err := uc.toImportedPost(cmd.Source, record, location)
+		if err != nil {
+			reject(record, err)
+			continue
+		}
+		if line, ok := seen[post.Ref().ID()]; ok {
+			reject(record, fmt.Errorf("duplicate external ID (first on line %d)", line))
+			continue
+		}
+		seen[post.Ref().ID()] = record.Line
+
+		batch = append(batch, post)
+		if len(batch) == ImportBatchSize {
+			if err := uc.importBatch(ctx, cmd, batch, report, cities); err != nil {
+				return report, err
+			}
+			batch = batch[:0]
+		}
+	}
+	if err := uc.importBatch(ctx, cmd, batch, report, cities); err != nil {
+		return report, err
+	}
+
+	// 3. Clear the list caches of the cities that got posts (errors are ignored; caches expire)
+	if uc.cacheRepo != nil && len(cities) > 0 {
+		for city := range cities {
+			_ = uc.cacheRepo.DeleteByPattern(ctx, fmt.Sprintf("posts:city:%s:*", city))
+		}
+		_ = uc.cacheRepo.DeleteByPattern(ctx, "posts:city:all:*")
+	}
+
+	return report, nil
+}
+
+// importBatch skips the posts of a batch that were imported before and
+// imports the others, unless it is a dry run.
+func (uc *ImportPostsUseCase) importBatch(ctx context.Context, cmd ImportPostsCommand, batch []*content.ImportedPost, report *dto.ImportReportDTO, cities map[string]bool) error {
+	if len(batch) == 0 {
+		return nil
+	}
+
+	externalIDs := make([]string, len(batch))
+	for i, post := range batch {
+		externalIDs[i] = post.Ref().ID()
+	}
+	imported, err := uc.importRepo.FindImported(ctx, cmd.Source, externalIDs)
+	if err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to check imported posts", err)
+	}
+
+	posts := make([]*content.ImportedPost, 0, len(batch))
+	for _, post := range batch {
+		if imported[post.Ref().ID()] {
+			report.Skipped++
+			continue
+		}
+		posts = append(posts, post)
+	}
+
+	if cmd.DryRun {
+		report.Imported += len(posts)
+		return nil
+	}
+
+	inserted, err := uc.importRepo.Import(ctx, posts)
+	if err != nil {
+		return err
+	}
+	report.Imported += inserted
+	// Imported concurrently since FindImported
+	report.Skipped += len(posts) - inserted
+
+	if inserted > 0 {
+		for _, post := range posts {
+			cities[post.Post().City().Code()] = true
+		}
+	}
+	return nil
+}
+
+// toImportedPost validates a row through the same domain factories as
+// CreatePostUseCase and creates the ImportedPost.
+func (uc *ImportPostsUseCase) toImportedPost(source string, record *ImportPostRecord, location *time.Location) (*content.ImportedPost, error) {
+	ref, err := content.NewExternalRef(source, record.ExternalID)
+	if err != nil {
+		return nil, err
+	}
+
+	company, err := content.NewCompanyName(record.Company)
+	if err != nil {
+		return nil, fmt.Errorf("invalid company name: %w", err)
+	}
+
+	city, err := shared.NewCity(record.CityCode, record.CityName)
+	if err != nil {
+		return nil, fmt.Errorf("invalid city: %w", err)
+	}
+
+	postContent, err := content.NewContent(record.Content)
+	if err != nil {
+		return nil, fmt.Errorf("invalid content: %w", err)
+	}
+
+	createdAt, err := parseImportTime(record.CreatedAt, location)
+	if err != nil {
+		return nil, fmt.Errorf("invalid created at: %w", err)
+	}
+
+	var occurredAt *time.Time
+	if strings.TrimSpace(record.OccurredAt) != "" {
+		t, err := parseImportTime(record.OccurredAt, location)
+		if err != nil {
+			return nil, fmt.Errorf("invalid occurred at: %w", err)
+		}
+		occurredAt = &t
+	}
+
+	resolution := content.ResolutionStatusNone
+	if strings.TrimSpace(record.ResolutionStatus) != "" {
+		resolution, err = content.NewResolutionStatus(record.ResolutionStatus)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return content.NewImportedPost(ref, company, city, postContent, createdAt, occurredAt, resolution)
+}
+
+// parseImportTime parses a time with the first matching layout of importTimeLayouts.
+func parseImportTime(value string, location *time.Location) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, fmt.Errorf("value is required")
+	}
+	for _, layout := range importTimeLayouts {
+		if t, err := time.ParseInLocation(layout, value, location); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%q is not a time (want e.g. 2006-01-02 15:04:05 or RFC 3339)", value)
+}
@@ -0,0 +1,138 @@
+package content
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"fuck_boss/backend/internal/application/cache"
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/domain/content"
+	"fuck_boss/backend/internal/domain/shared"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// FeedSize is the number of posts in a syndication feed.
+const FeedSize = 50
+
+// feedCacheTTL is how long a feed is cached. City feeds are also cleared when
+// a post is created in the city.
+const feedCacheTTL = 10 * time.Minute
+
+// ListFeedPostsQuery selects the posts of a syndication feed.
+// Exactly one of CityCode, Company and Keyword must be set.
+type ListFeedPostsQuery struct {
+	// CityCode selects the posts of a city.
+	CityCode string
+
+	// Company selects the posts about a company (exact name match).
+	Company string
+
+	// Keyword selects the posts matching a search keyword (minimum 2 characters).
+	Keyword string
+}
+
+// ListFeedPostsUseCase lists the newest posts of a feed (BASIC view) with caching.
+type ListFeedPostsUseCase struct {
+	// repo is the Post repository.
+	repo content.PostRepository
+
+	// cacheRepo is the cache repository for caching feeds.
+	cacheRepo cache.CacheRepository
+}
+
+// NewListFeedPostsUseCase creates a new ListFeedPostsUseCase instance.
+func NewListFeedPostsUseCase(
+	repo content.PostRepository,
+	cacheRepo cache.CacheRepository,
+) *ListFeedPostsUseCase {
+	return &ListFeedPostsUseCase{
+		repo:      repo,
+		cacheRepo: cacheRepo,
+	}
+}
+
+// Execute returns the newest FeedSize posts of the feed, newest first.
+// Posts only carry their summary (BASIC view).
+func (uc *ListFeedPostsUseCase) Execute(ctx context.Context, query ListFeedPostsQuery) (*dto.PostsListDTO, error) {
+	// 1. Validate input
+	cityCode := strings.TrimSpace(query.CityCode)
+	company := strings.TrimSpace(query.Company)
+	keyword := strings.TrimSpace(query.Keyword)
+
+	set := 0
+	for _, v := range []string{cityCode, company, keyword} {
+		if v != "" {
+			set++
+		}
+	}
+	if set != 1 {
+		return nil, apperrors.NewValidationError("exactly one of city code, company and keyword is required")
+	}
+	if keyword != "" && len(keyword) < 2 {
+		return nil, apperrors.NewValidationError("keyword must be at least 2 characters")
+	}
+
+	var filter content.PostFilter
+	var cacheKey string
+	switch {
+	case cityCode != "":
+		// Only the code is used to filter
+		city, err := shared.NewCity(cityCode, cityCode)
+		if err != nil {
+			return nil, apperrors.NewValidationErrorWithDetails("invalid city code", map[string]interface{}{
+				"error": err.Error(),
+			})
+		}
+		filter.City = &city
+		// Under the city list prefix, so creating a post in the city clears it
+		cacheKey = "posts:city:" + city.Code() + ":feed"
+	case company != "":
+		name, err := content.NewCompanyName(company)
+		if err != nil {
+			return nil, apperrors.NewValidationErrorWithDetails("invalid company name", map[string]interface{}{
+				"error": err.Error(),
+			})
+		}
+		filter.Companies = []content.CompanyName{name}
+		cacheKey = "feed:company:" + name.String()
+	default:
+		cacheKey = "feed:search:" + strings.ToLower(keyword)
+	}
+
+	// 2. Try the cache
+	if cachedData, err := uc.cacheRepo.Get(ctx, cacheKey); err == nil && cachedData != "" {
+		var result dto.PostsListDTO
+		if err := json.Unmarshal([]byte(cachedData), &result); err == nil {
+			return &result, nil
+		}
+		// If deserialization fails, continue to query database
+	}
+
+	// 3. Query the newest summaries
+	var summaries []*content.PostSummary
+	var err error
+	if keyword != "" {
+		summaries, _, err = uc.repo.SearchSummaries(ctx, keyword, nil, 1, FeedSize)
+	} else {
+		summaries, _, err = uc.repo.FindSummariesByFilter(ctx, filter, 1, FeedSize)
+	}
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to query feed posts", err)
+	}
+
+	result := &dto.PostsListDTO{
+		Posts:    summariesToDTOs(summaries),
+		Total:    len(summaries),
+		Page:     1,
+		PageSize: FeedSize,
+	}
+
+	// 4. Update the cache (errors are ignored)
+	if data, err := json.Marshal(result); err == nil {
+		_ = uc.cacheRepo.Set(ctx, cacheKey, string(data), feedCacheTTL)
+	}
+
+	return result, nil
+}
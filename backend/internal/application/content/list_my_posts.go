@@ -0,0 +1,84 @@
+// Package content provides use cases for content management.
+package content
+
+import (
+	"context"
+
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/domain/content"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// ListMyPostsQuery represents the query parameters for listing the caller's own posts.
+type ListMyPostsQuery struct {
+	// UserID is the logged-in user (required, taken from the access token).
+	UserID string
+
+	// Page is the page number (1-based, default: 1).
+	Page int
+
+	// PageSize is the number of items per page (default: 20, max: 100).
+	PageSize int
+}
+
+// ListMyPostsUseCase lists the posts a logged-in user created.
+// Results are never cached because they are private to the user.
+type ListMyPostsUseCase struct {
+	// repo is the Post repository.
+	repo content.PostRepository
+}
+
+// NewListMyPostsUseCase creates a new ListMyPostsUseCase instance.
+func NewListMyPostsUseCase(repo content.PostRepository) *ListMyPostsUseCase {
+	return &ListMyPostsUseCase{
+		repo: repo,
+	}
+}
+
+// Execute executes the list my posts query.
+// Posts created anonymously (before logging in) are not included.
+func (uc *ListMyPostsUseCase) Execute(ctx context.Context, query ListMyPostsQuery) (*dto.PostsListDTO, error) {
+	if query.UserID == "" {
+		return nil, apperrors.NewUnauthenticatedError("login required")
+	}
+
+	page := query.Page
+	if page < 1 {
+		page = 1
+	}
+
+	pageSize := query.PageSize
+	if pageSize < 1 {
+		pageSize = 20
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	authorID := query.UserID
+	posts, total, err := uc.repo.FindByFilter(ctx, content.PostFilter{AuthorID: &authorID}, page, pageSize)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to query posts", err)
+	}
+
+	dtos := make([]*dto.PostDTO, 0, len(posts))
+	for _, post := range posts {
+		dtos = append(dtos, &dto.PostDTO{
+			ID:               post.ID().String(),
+			Company:          post.Company().String(),
+			CityCode:         post.City().Code(),
+			CityName:         post.City().Name(),
+			Content:          post.Content().String(),
+			ContentHTML:      post.Content().HTML(),
+			CreatedAt:        post.CreatedAt(),
+			ResolutionStatus: post.Resolution().String(),
+		})
+	}
+
+	return &dto.PostsListDTO{
+		Posts:    dtos,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	}, nil
+}
@@ -3,6 +3,7 @@ package content
 
 import (
 	"context"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"time"
@@ -24,6 +25,16 @@ type ListPostsQuery struct {
 
 	// PageSize is the number of items per page (default: 20).
 	PageSize int
+
+	// ResolutionStatus filters posts by the status of their latest follow-up (optional).
+	// One of ONGOING, ESCALATED, RESOLVED; empty means no filter.
+	ResolutionStatus string
+
+	// Company filters posts by company (exact name match, optional).
+	Company string
+
+	// View selects the post fields: dto.PostViewBasic or dto.PostViewFull (default).
+	View string
 }
 
 // ListPostsUseCase handles listing posts by city with caching.
@@ -65,6 +76,37 @@ func (uc *ListPostsUseCase) Execute(ctx context.Context, query ListPostsQuery) (
 		pageSize = 20
 	}
 
+	// Parse resolution status filter
+	var resolution *content.ResolutionStatus
+	if query.ResolutionStatus != "" {
+		r, err := content.NewResolutionStatus(query.ResolutionStatus)
+		if err != nil {
+			return nil, apperrors.NewValidationErrorWithDetails("invalid resolution status", map[string]interface{}{
+				"error": err.Error(),
+			})
+		}
+		resolution = &r
+	}
+
+	// Parse company filter
+	var companies []content.CompanyName
+	if query.Company != "" {
+		name, err := content.NewCompanyName(query.Company)
+		if err != nil {
+			return nil, apperrors.NewValidationErrorWithDetails("invalid company name", map[string]interface{}{
+				"error": err.Error(),
+			})
+		}
+		companies = []content.CompanyName{name}
+	}
+
+	// Parse view
+	basic, err := parseView(query.View)
+	if err != nil {
+		return nil, err
+	}
+	filter := content.PostFilter{Resolution: resolution, Companies: companies}
+
 	// Build cache key
 	var cacheKey string
 	var city *shared.City
@@ -77,10 +119,11 @@ func (uc *ListPostsUseCase) Execute(ctx context.Context, query ListPostsQuery) (
 			})
 		}
 		city = &c
-		cacheKey = uc.buildCacheKey(city.Code(), page)
+		filter.City = city
+		cacheKey = uc.buildCacheKey(city.Code(), companies, resolution, basic, page)
 	} else {
 		// All cities
-		cacheKey = uc.buildCacheKey("all", page)
+		cacheKey = uc.buildCacheKey("all", companies, resolution, basic, page)
 	}
 
 	// Try to get from cache
@@ -95,9 +138,28 @@ func (uc *ListPostsUseCase) Execute(ctx context.Context, query ListPostsQuery) (
 	}
 
 	// Cache miss or error: query repository
+	if basic {
+		// Summaries only: the repository loads just the beginning of the content
+		summaries, total, err := uc.repo.FindSummariesByFilter(ctx, filter, page, pageSize)
+		if err != nil {
+			return nil, apperrors.NewDatabaseErrorWithCause("failed to query posts", err)
+		}
+		result := &dto.PostsListDTO{
+			Posts:    summariesToDTOs(summaries),
+			Total:    total,
+			Page:     page,
+			PageSize: pageSize,
+		}
+		uc.updateCache(ctx, cacheKey, result, cityCodeOrAll(city))
+		return result, nil
+	}
+
 	var posts []*content.Post
 	var total int
-	if city != nil {
+	if resolution != nil || companies != nil {
+		// Query with resolution or company filter (optionally by city)
+		posts, total, err = uc.repo.FindByFilter(ctx, filter, page, pageSize)
+	} else if city != nil {
 		// Query by city
 		posts, total, err = uc.repo.FindByCity(ctx, *city, page, pageSize)
 	} else {
@@ -110,22 +172,41 @@ func (uc *ListPostsUseCase) Execute(ctx context.Context, query ListPostsQuery) (
 
 	// Convert to DTO
 	result := &dto.PostsListDTO{
-		Posts:    uc.toDTOs(posts),
+		Posts:    postsToDTOs(posts),
 		Total:    total,
 		Page:     page,
 		PageSize: pageSize,
 	}
 
 	// Update cache (non-blocking, errors are ignored)
-	cityCode := "all"
-	if city != nil {
-		cityCode = city.Code()
-	}
-	uc.updateCache(ctx, cacheKey, result, cityCode)
+	uc.updateCache(ctx, cacheKey, result, cityCodeOrAll(city))
 
 	return result, nil
 }
 
+// cityCodeOrAll returns the city code, or "all" if no city is selected.
+func cityCodeOrAll(city *shared.City) string {
+	if city == nil {
+		return "all"
+	}
+	return city.Code()
+}
+
+// parseView parses a list view and reports whether it is the BASIC view.
+// An empty view means the FULL view.
+func parseView(view string) (bool, error) {
+	switch view {
+	case "", dto.PostViewFull:
+		return false, nil
+	case dto.PostViewBasic:
+		return true, nil
+	default:
+		return false, apperrors.NewValidationErrorWithDetails("invalid view", map[string]interface{}{
+			"error": fmt.Sprintf("view must be %s or %s", dto.PostViewBasic, dto.PostViewFull),
+		})
+	}
+}
+
 // validateQuery validates the list posts query.
 // CityCode is optional - if empty, returns posts from all cities.
 func (uc *ListPostsUseCase) validateQuery(query ListPostsQuery) error {
@@ -133,10 +214,25 @@ func (uc *ListPostsUseCase) validateQuery(query ListPostsQuery) error {
 	return nil
 }
 
-// buildCacheKey builds the cache key for the given city and page.
+// buildCacheKey builds the cache key for the given city, filters, view and page.
 // Format: "posts:city:{cityCode}:page:{page}"
-func (uc *ListPostsUseCase) buildCacheKey(cityCode string, page int) string {
-	return fmt.Sprintf("posts:city:%s:page:%d", cityCode, page)
+// or "posts:city:{cityCode}:resolution:{status}:page:{page}" when filtered by resolution.
+// A company filter adds ":company:{name}" after the city, with the name hex-encoded
+// so that names containing ":" or glob characters can neither collide with
+// other keys nor be matched by unrelated patterns.
+// BASIC pages are cached separately with ":view:basic" before ":page".
+func (uc *ListPostsUseCase) buildCacheKey(cityCode string, companies []content.CompanyName, resolution *content.ResolutionStatus, basic bool, page int) string {
+	key := "posts:city:" + cityCode
+	for _, company := range companies {
+		key += ":company:" + hex.EncodeToString([]byte(company.String()))
+	}
+	if resolution != nil {
+		key += ":resolution:" + resolution.String()
+	}
+	if basic {
+		key += ":view:basic"
+	}
+	return fmt.Sprintf("%s:page:%d", key, page)
 }
 
 // getCityName returns the city name for the given city code.
@@ -199,24 +295,87 @@ func (uc *ListPostsUseCase) updateCache(ctx context.Context, key string, result
 	_ = uc.cacheRepo.Set(ctx, key, string(data), ttl)
 }
 
-// toDTOs converts a slice of Post entities to PostDTOs.
-func (uc *ListPostsUseCase) toDTOs(posts []*content.Post) []*dto.PostDTO {
+// postsToDTOs converts a slice of Post entities to PostDTOs.
+func postsToDTOs(posts []*content.Post) []*dto.PostDTO {
 	dtos := make([]*dto.PostDTO, 0, len(posts))
 	for _, post := range posts {
-		dtos = append(dtos, uc.toDTO(post))
+		dtos = append(dtos, postToListDTO(post))
 	}
 	return dtos
 }
 
-// toDTO converts a Post entity to PostDTO.
-func (uc *ListPostsUseCase) toDTO(post *content.Post) *dto.PostDTO {
+// postToListDTO converts a Post entity to a PostDTO of the FULL list view.
+func postToListDTO(post *content.Post) *dto.PostDTO {
 	return &dto.PostDTO{
-		ID:         post.ID().String(),
-		Company:    post.Company().String(),
-		CityCode:   post.City().Code(),
-		CityName:   post.City().Name(),
-		Content:    post.Content().String(),
-		OccurredAt: nil, // Not stored in Post entity
-		CreatedAt:  post.CreatedAt(),
+		ID:               post.ID().String(),
+		Company:          post.Company().String(),
+		CityCode:         post.City().Code(),
+		CityName:         post.City().Name(),
+		Content:          post.Content().String(),
+		ContentHTML:      post.Content().HTML(),
+		Summary:          post.Content().Summary(),
+		OccurredAt:       nil, // Not stored in Post entity
+		CreatedAt:        post.CreatedAt(),
+		ResolutionStatus: post.Resolution().String(),
+	}
+}
+
+// summariesToDTOs converts PostSummaries to PostDTOs of the BASIC view.
+func summariesToDTOs(summaries []*content.PostSummary) []*dto.PostDTO {
+	dtos := make([]*dto.PostDTO, 0, len(summaries))
+	for _, s := range summaries {
+		dtos = append(dtos, &dto.PostDTO{
+			ID:               s.ID().String(),
+			Company:          s.Company().String(),
+			CityCode:         s.City().Code(),
+			CityName:         s.City().Name(),
+			Summary:          s.Summary(),
+			CreatedAt:        s.CreatedAt(),
+			ResolutionStatus: s.Resolution().String(),
+		})
+	}
+	return dtos
+}
+
+// findPostsInOrder loads the posts with the given IDs and returns them in the
+// order of ids. Invalid and deleted IDs are skipped.
+func findPostsInOrder(ctx context.Context, repo content.PostRepository, ids []string, basic bool) ([]*dto.PostDTO, error) {
+	postIDs := make([]content.PostID, 0, len(ids))
+	for _, id := range ids {
+		if postID, err := content.NewPostID(id); err == nil {
+			postIDs = append(postIDs, postID)
+		}
+	}
+	if len(postIDs) == 0 {
+		return []*dto.PostDTO{}, nil
+	}
+
+	filter := content.PostFilter{IDs: postIDs}
+	var dtos []*dto.PostDTO
+	if basic {
+		summaries, _, err := repo.FindSummariesByFilter(ctx, filter, 1, len(postIDs))
+		if err != nil {
+			return nil, apperrors.NewDatabaseErrorWithCause("failed to query posts", err)
+		}
+		dtos = summariesToDTOs(summaries)
+	} else {
+		posts, _, err := repo.FindByFilter(ctx, filter, 1, len(postIDs))
+		if err != nil {
+			return nil, apperrors.NewDatabaseErrorWithCause("failed to query posts", err)
+		}
+		dtos = postsToDTOs(posts)
+	}
+
+	byID := make(map[string]*dto.PostDTO, len(dtos))
+	for _, d := range dtos {
+		byID[d.ID] = d
+	}
+	ordered := make([]*dto.PostDTO, 0, len(dtos))
+	for _, id := range ids {
+		if d, ok := byID[id]; ok {
+			ordered = append(ordered, d)
+			delete(byID, id)
+		}
 	}
+	return ordered, nil
 }
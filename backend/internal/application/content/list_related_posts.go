@@ -0,0 +1,145 @@
+package content
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"fuck_boss/backend/internal/application/cache"
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/domain/content"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// Related posts limits.
+const (
+	// DefaultRelatedLimit is the number of related posts returned by default.
+	DefaultRelatedLimit = 5
+
+	// MaxRelatedLimit is the maximum number of related posts returned.
+	MaxRelatedLimit = 20
+)
+
+// ListRelatedPostsQuery represents the query parameters for listing related posts.
+type ListRelatedPostsQuery struct {
+	// PostID is the ID of the post to find related posts for (required).
+	PostID string
+
+	// Limit is the maximum number of posts (default: DefaultRelatedLimit, max: MaxRelatedLimit).
+	Limit int
+
+	// View selects the post fields: dto.PostViewBasic or dto.PostViewFull (default).
+	View string
+}
+
+// ListRelatedPostsUseCase lists the posts related to a post.
+// The related posts are precomputed by RefreshRelatedUseCase. Posts that have
+// not been processed yet (new posts, or posts outside the refresh window) fall
+// back to the latest posts about the same company.
+type ListRelatedPostsUseCase struct {
+	// repo is the Post repository.
+	repo content.PostRepository
+
+	// cacheRepo is where the related post IDs are kept.
+	cacheRepo cache.CacheRepository
+}
+
+// NewListRelatedPostsUseCase creates a new ListRelatedPostsUseCase instance.
+func NewListRelatedPostsUseCase(
+	repo content.PostRepository,
+	cacheRepo cache.CacheRepository,
+) *ListRelatedPostsUseCase {
+	return &ListRelatedPostsUseCase{
+		repo:      repo,
+		cacheRepo: cacheRepo,
+	}
+}
+
+// Execute executes the list related posts query.
+func (uc *ListRelatedPostsUseCase) Execute(ctx context.Context, query ListRelatedPostsQuery) (*dto.PostsListDTO, error) {
+	// 1. Validate input and set defaults
+	postIDStr := strings.TrimSpace(query.PostID)
+	if postIDStr == "" {
+		return nil, apperrors.NewValidationError("post ID is required")
+	}
+
+	postID, err := content.NewPostID(postIDStr)
+	if err != nil {
+		return nil, apperrors.NewValidationErrorWithDetails("invalid post ID", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	limit := query.Limit
+	if limit < 1 {
+		limit = DefaultRelatedLimit
+	}
+	if limit > MaxRelatedLimit {
+		limit = MaxRelatedLimit
+	}
+
+	basic, err := parseView(query.View)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Read the precomputed related post IDs
+	var ids []string
+	cached, err := uc.cacheRepo.Get(ctx, relatedCacheKey(postID.String()))
+	if err == nil && cached != "" {
+		if err := json.Unmarshal([]byte(cached), &ids); err != nil {
+			ids = nil
+		}
+	}
+
+	// 3. Fall back to the latest posts about the same company
+	if ids == nil {
+		ids, err = uc.sameCompanyIDs(ctx, postID, limit)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(ids) > limit {
+		ids = ids[:limit]
+	}
+
+	// 4. Load the posts in order
+	posts, err := findPostsInOrder(ctx, uc.repo, ids, basic)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.PostsListDTO{
+		Posts:    posts,
+		Total:    len(posts),
+		Page:     1,
+		PageSize: limit,
+	}, nil
+}
+
+// sameCompanyIDs returns the IDs of the latest posts about the same company
+// as the post, excluding the post itself. Companies are matched by canonical
+// name, so differently written names of one company count as the same.
+func (uc *ListRelatedPostsUseCase) sameCompanyIDs(ctx context.Context, postID content.PostID, limit int) ([]string, error) {
+	post, err := uc.repo.FindByID(ctx, postID)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to query post", err)
+	}
+
+	filter := content.PostFilter{CompanyCanonical: post.Company().Canonical()}
+	summaries, _, err := uc.repo.FindSummariesByFilter(ctx, filter, 1, limit+1)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to query posts", err)
+	}
+
+	ids := make([]string, 0, len(summaries))
+	for _, summary := range summaries {
+		if !summary.ID().Equals(postID) {
+			ids = append(ids, summary.ID().String())
+		}
+	}
+	return ids, nil
+}
@@ -0,0 +1,91 @@
+package content
+
+import (
+	"context"
+	"strings"
+
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/application/ranking"
+	"fuck_boss/backend/internal/domain/content"
+)
+
+// ListTrendingPostsQuery represents the query parameters for listing trending posts.
+type ListTrendingPostsQuery struct {
+	// CityCode restricts the ranking to a city (optional, empty means all cities).
+	CityCode string
+
+	// Page is the page number (1-based, default: 1).
+	Page int
+
+	// PageSize is the number of items per page (default: 20).
+	PageSize int
+
+	// View selects the post fields: dto.PostViewBasic or dto.PostViewFull (default).
+	View string
+}
+
+// ListTrendingPostsUseCase lists posts by hot score.
+// The rankings are precomputed by RefreshTrendingUseCase; this use case only
+// reads a page of post IDs and loads the posts.
+type ListTrendingPostsUseCase struct {
+	// repo is the Post repository.
+	repo content.PostRepository
+
+	// store is where the rankings are kept.
+	store ranking.RankingStore
+}
+
+// NewListTrendingPostsUseCase creates a new ListTrendingPostsUseCase instance.
+func NewListTrendingPostsUseCase(
+	repo content.PostRepository,
+	store ranking.RankingStore,
+) *ListTrendingPostsUseCase {
+	return &ListTrendingPostsUseCase{
+		repo:  repo,
+		store: store,
+	}
+}
+
+// Execute executes the list trending posts query.
+// Total is the size of the ranking; posts deleted since the last refresh are skipped.
+func (uc *ListTrendingPostsUseCase) Execute(ctx context.Context, query ListTrendingPostsQuery) (*dto.PostsListDTO, error) {
+	// 1. Validate input and set defaults
+	page := query.Page
+	if page < 1 {
+		page = 1
+	}
+
+	pageSize := query.PageSize
+	if pageSize < 1 {
+		pageSize = 20
+	}
+
+	basic, err := parseView(query.View)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Read a page of the ranking
+	ids, total, err := uc.store.Range(ctx, trendingRankingName(strings.TrimSpace(query.CityCode)), (page-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &dto.PostsListDTO{
+		Posts:    []*dto.PostDTO{},
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	}
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	// 3. Load the posts in ranking order
+	result.Posts, err = findPostsInOrder(ctx, uc.repo, ids, basic)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
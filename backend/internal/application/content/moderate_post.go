@@ -0,0 +1,193 @@
+package content
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"fuck_boss/backend/internal/application/cache"
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/application/livefeed"
+	"fuck_boss/backend/internal/domain/content"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// ModerationAction is an operator action on a post.
+type ModerationAction string
+
+const (
+	// ModerationActionHide hides a visible post.
+	ModerationActionHide ModerationAction = "HIDE"
+	// ModerationActionRemove removes a visible or hidden post.
+	ModerationActionRemove ModerationAction = "REMOVE"
+	// ModerationActionRestore makes a hidden or removed post visible again.
+	ModerationActionRestore ModerationAction = "RESTORE"
+)
+
+// ModeratePostCommand represents the command to hide, remove or restore a post.
+type ModeratePostCommand struct {
+	// PostID is the ID of the post (required).
+	PostID string
+
+	// Action is HIDE, REMOVE or RESTORE (required, case-insensitive).
+	Action ModerationAction
+
+	// Reason explains the action (required to hide or remove, up to 500 characters).
+	Reason string
+
+	// ModeratorID is the user ID of the moderator taking the action
+	// (empty for operator actions through an API key or the command line).
+	ModeratorID string
+}
+
+// ModerationNotifier notifies the author of a post about a moderation action.
+// It is implemented by notification.Notifier.
+type ModerationNotifier interface {
+	NotifyModerationOutcome(ctx context.Context, post *content.Post, outcome, reason string) error
+}
+
+// ModeratePostUseCase hides, removes and restores posts. Moderated posts stay
+// in the database and disappear from every public query; restoring them makes
+// them visible again.
+type ModeratePostUseCase struct {
+	// repo is the Post moderation repository.
+	repo content.PostModerationRepository
+
+	// cacheRepo is the cache repository for cache invalidation.
+	cacheRepo cache.CacheRepository
+
+	// notifier notifies the post author (nil disables notifications).
+	notifier ModerationNotifier
+
+	// history is the live feed history, from which hidden and removed posts
+	// are deleted (nil skips it).
+	history livefeed.History
+}
+
+// NewModeratePostUseCase creates a new ModeratePostUseCase instance.
+func NewModeratePostUseCase(
+	repo content.PostModerationRepository,
+	cacheRepo cache.CacheRepository,
+	notifier ModerationNotifier,
+	history livefeed.History,
+) *ModeratePostUseCase {
+	return &ModeratePostUseCase{
+		repo:      repo,
+		cacheRepo: cacheRepo,
+		notifier:  notifier,
+		history:   history,
+	}
+}
+
+// Execute executes the moderate post command and returns the new moderation state.
+func (uc *ModeratePostUseCase) Execute(ctx context.Context, cmd ModeratePostCommand) (*dto.PostModerationDTO, error) {
+	// 1. Validate input
+	if cmd.PostID == "" {
+		return nil, apperrors.NewValidationError("post ID is required")
+	}
+
+	postID, err := content.NewPostID(cmd.PostID)
+	if err != nil {
+		return nil, apperrors.NewValidationErrorWithDetails("invalid post ID", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	action := ModerationAction(strings.ToUpper(strings.TrimSpace(string(cmd.Action))))
+	var reason content.ModerationReason
+	switch action {
+	case ModerationActionHide, ModerationActionRemove:
+		reason, err = content.NewModerationReason(cmd.Reason)
+		if err != nil {
+			return nil, apperrors.NewValidationErrorWithDetails("invalid moderation reason", map[string]interface{}{
+				"error": err.Error(),
+			})
+		}
+	case ModerationActionRestore:
+	default:
+		return nil, apperrors.NewValidationErrorWithDetails("invalid moderation action", map[string]interface{}{
+			"action": string(cmd.Action),
+		})
+	}
+
+	// 2. Load the post, whatever its current status
+	post, err := uc.repo.FindByIDAnyStatus(ctx, postID)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to query post", err)
+	}
+
+	// 3. Apply the action
+	switch action {
+	case ModerationActionHide:
+		err = post.Hide(reason, cmd.ModeratorID)
+	case ModerationActionRemove:
+		err = post.Remove(reason, cmd.ModeratorID)
+	case ModerationActionRestore:
+		err = post.Restore(cmd.ModeratorID)
+	}
+	if err != nil {
+		if errors.Is(err, content.ErrModerationTransition) {
+			return nil, apperrors.NewConflictError(fmt.Sprintf("cannot %s a %s post",
+				strings.ToLower(string(action)), strings.ToLower(post.Moderation().Status.String())))
+		}
+		return nil, apperrors.NewInternalErrorWithCause("failed to moderate post", err)
+	}
+
+	// 4. Save the moderation state
+	if err := uc.repo.SaveModeration(ctx, post); err != nil {
+		return nil, err
+	}
+
+	// 5. Clear the caches and the live feed history, and notify the post author
+	uc.Announce(ctx, post, action)
+
+	moderation := post.Moderation()
+	return &dto.PostModerationDTO{
+		PostID:      post.ID().String(),
+		Company:     post.Company().String(),
+		CityCode:    post.City().Code(),
+		Status:      moderation.Status.String(),
+		Reason:      moderation.Reason.String(),
+		ModeratedAt: moderation.ModeratedAt,
+		ModeratedBy: moderation.ModeratedBy,
+	}, nil
+}
+
+// Announce clears the caches that may contain a moderated post, deletes hidden
+// and removed posts from the live feed history and notifies the author. The moderation state must already be saved; use cases that save
+// it themselves (such as appeal decisions) call Announce afterwards.
+// Errors are ignored: caches expire and the action is already saved.
+func (uc *ModeratePostUseCase) Announce(ctx context.Context, post *content.Post, action ModerationAction) {
+	uc.clearCache(ctx, post)
+
+	if uc.history != nil && action != ModerationActionRestore {
+		_ = uc.history.Forget(ctx, post.ID().String())
+	}
+
+	if uc.notifier != nil {
+		moderation := post.Moderation()
+		outcome := moderation.Status.String()
+		if action == ModerationActionRestore {
+			outcome = "RESTORED"
+		}
+		_ = uc.notifier.NotifyModerationOutcome(ctx, post, outcome, moderation.Reason.String())
+	}
+}
+
+// clearCache clears the cached detail, share cards, lists, feeds, search
+// results and related posts that may contain the post. Related posts are
+// recomputed by the next RefreshRelatedUseCase run; until then they fall back
+// to the latest posts about the same company.
+func (uc *ModeratePostUseCase) clearCache(ctx context.Context, post *content.Post) {
+	_ = uc.cacheRepo.Delete(ctx, fmt.Sprintf("post:%s", post.ID().String()))
+	_ = uc.cacheRepo.DeleteByPattern(ctx, shareCardCachePrefix(post.ID().String())+"*")
+	_ = uc.cacheRepo.DeleteByPattern(ctx, fmt.Sprintf("posts:city:%s:*", post.City().Code()))
+	_ = uc.cacheRepo.DeleteByPattern(ctx, "posts:city:all:*")
+	_ = uc.cacheRepo.DeleteByPattern(ctx, "feed:*")
+	_ = uc.cacheRepo.DeleteByPattern(ctx, "search:*")
+	_ = uc.cacheRepo.DeleteByPattern(ctx, "related:*")
+}
@@ -0,0 +1,76 @@
+package content
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"fuck_boss/backend/internal/application/ranking"
+	"fuck_boss/backend/internal/domain/content"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// RecordViewCommand represents a view of a post.
+type RecordViewCommand struct {
+	// PostID is the ID of the viewed post (required).
+	PostID string
+
+	// UserID is the ID of the logged-in viewer (optional).
+	UserID string
+
+	// ClientIP identifies anonymous viewers. It should be the address resolved
+	// through the trusted proxies; a port is ignored.
+	ClientIP string
+}
+
+// RecordViewUseCase counts unique viewers of posts.
+// A viewer is the logged-in user, or the client IP for anonymous requests;
+// each viewer is counted once per post and UTC day.
+type RecordViewUseCase struct {
+	// counter is the unique view counter.
+	counter ranking.ViewCounter
+}
+
+// NewRecordViewUseCase creates a new RecordViewUseCase instance.
+func NewRecordViewUseCase(counter ranking.ViewCounter) *RecordViewUseCase {
+	return &RecordViewUseCase{
+		counter: counter,
+	}
+}
+
+// Execute records the view. Views without any viewer identity, or with a
+// client IP that is not an IP address, are ignored.
+func (uc *RecordViewUseCase) Execute(ctx context.Context, cmd RecordViewCommand) error {
+	postID, err := content.NewPostID(cmd.PostID)
+	if err != nil {
+		return apperrors.NewValidationErrorWithDetails("invalid post ID", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	var viewer string
+	switch {
+	case cmd.UserID != "":
+		viewer = "user:" + cmd.UserID
+	case viewerIP(cmd.ClientIP) != "":
+		viewer = "ip:" + viewerIP(cmd.ClientIP)
+	default:
+		return nil
+	}
+
+	return uc.counter.Add(ctx, postID.String(), viewer, time.Now())
+}
+
+// viewerIP normalizes a client IP so that each address is one viewer: the port
+// is dropped and the address written in its canonical form. It returns "" if
+// the value is not an IP address.
+func viewerIP(clientIP string) string {
+	if host, _, err := net.SplitHostPort(clientIP); err == nil {
+		clientIP = host
+	}
+	ip := net.ParseIP(clientIP)
+	if ip == nil {
+		return ""
+	}
+	return ip.String()
+}
@@ -0,0 +1,91 @@
+package content
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"fuck_boss/backend/internal/application/cache"
+	"fuck_boss/backend/internal/domain/content"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// relatedCacheKey returns the cache key of the related post IDs of a post.
+// Format: "related:{postID}"
+func relatedCacheKey(postID string) string {
+	return fmt.Sprintf("related:%s", postID)
+}
+
+// RefreshRelatedUseCase recomputes the related posts of recent posts and
+// caches them per post. It is run periodically by a background job.
+type RefreshRelatedUseCase struct {
+	// docsRepo loads the posts to compare.
+	docsRepo content.PostDocumentRepository
+
+	// cacheRepo is where the related post IDs are kept.
+	cacheRepo cache.CacheRepository
+
+	// window is how old posts may be to be compared.
+	window time.Duration
+
+	// maxPosts is the maximum number of posts compared (the most recent ones).
+	maxPosts int
+
+	// limit is the number of related posts kept per post.
+	limit int
+
+	// ttl is how long the related posts of a post are cached.
+	// It should be longer than the refresh interval, so entries do not expire
+	// between runs; posts that fall out of the window expire after it.
+	ttl time.Duration
+}
+
+// NewRefreshRelatedUseCase creates a new RefreshRelatedUseCase instance.
+func NewRefreshRelatedUseCase(
+	docsRepo content.PostDocumentRepository,
+	cacheRepo cache.CacheRepository,
+	window time.Duration,
+	maxPosts int,
+	limit int,
+	ttl time.Duration,
+) *RefreshRelatedUseCase {
+	return &RefreshRelatedUseCase{
+		docsRepo:  docsRepo,
+		cacheRepo: cacheRepo,
+		window:    window,
+		maxPosts:  maxPosts,
+		limit:     limit,
+		ttl:       ttl,
+	}
+}
+
+// Execute recomputes and caches the related posts.
+func (uc *RefreshRelatedUseCase) Execute(ctx context.Context) error {
+	// 1. Load the recent posts
+	docs, err := uc.docsRepo.FindDocumentsSince(ctx, time.Now().Add(-uc.window), uc.maxPosts)
+	if err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to query post documents", err)
+	}
+
+	// 2. Find the related posts
+	related := content.RelatedPosts(docs, uc.limit)
+
+	// 3. Cache the related post IDs of every post
+	for postID, relatedIDs := range related {
+		ids := make([]string, 0, len(relatedIDs))
+		for _, id := range relatedIDs {
+			ids = append(ids, id.String())
+		}
+
+		data, err := json.Marshal(ids)
+		if err != nil {
+			return apperrors.NewInternalErrorWithCause("failed to encode related posts", err)
+		}
+		if err := uc.cacheRepo.Set(ctx, relatedCacheKey(postID.String()), string(data), uc.ttl); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
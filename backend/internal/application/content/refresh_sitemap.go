@@ -0,0 +1,408 @@
+package content
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"regexp"
+	"sort"
+	"time"
+
+	"fuck_boss/backend/internal/application/blob"
+	"fuck_boss/backend/internal/domain/content"
+	apperrors "fuck_boss/backend/pkg/errors"
+	"fuck_boss/backend/pkg/sitemap"
+)
+
+const (
+	// SitemapIndexName is the name of the sitemap index file.
+	SitemapIndexName = "sitemap.xml"
+
+	// sitemapManifestKey is where the shard boundaries of the last run are kept.
+	sitemapManifestKey = "sitemap-manifest.json"
+
+	// sitemapBatchSize is the number of posts loaded per query.
+	sitemapBatchSize = 1000
+
+	// sitemapChangeLimit is the maximum number of changed posts looked at;
+	// with more changes all shards are regenerated.
+	sitemapChangeLimit = 10000
+
+	// sitemapChangeOverlap is how far before the last run changes are looked
+	// for again, so posts saved in transactions that committed late are not missed.
+	sitemapChangeOverlap = 5 * time.Minute
+)
+
+// sitemapShardPattern matches the names of sitemap shard files.
+var sitemapShardPattern = regexp.MustCompile(`^sitemap-[1-9][0-9]{0,5}\.xml\.gz$`)
+
+// SitemapShardName returns the name of the n-th (1-based) sitemap shard file.
+func SitemapShardName(n int) string {
+	return fmt.Sprintf("sitemap-%d.xml.gz", n)
+}
+
+// sitemapManifest is the state of the last run: the shard boundaries and when
+// changes were last looked for.
+type sitemapManifest struct {
+	// CheckedAt is when the last run started.
+	CheckedAt time.Time `json:"checkedAt"`
+
+	// Shards are the shards in order.
+	Shards []sitemapShard `json:"shards"`
+}
+
+// sitemapShard describes a sitemap shard file. A shard holds the posts after
+// the previous shard's last position up to its own last position.
+type sitemapShard struct {
+	// LastCreatedAt and LastID are the position of the shard's last post.
+	LastCreatedAt time.Time `json:"lastCreatedAt"`
+	LastID        string    `json:"lastId"`
+
+	// Count is the number of URLs in the shard.
+	Count int `json:"count"`
+
+	// LastMod is the newest modification time of the posts in the shard.
+	LastMod time.Time `json:"lastMod"`
+}
+
+// cursor returns the position of the shard's last post.
+func (s sitemapShard) cursor() (content.PostCursor, error) {
+	id, err := content.NewPostID(s.LastID)
+	if err != nil {
+		return content.PostCursor{}, err
+	}
+	return content.PostCursor{CreatedAt: s.LastCreatedAt, ID: id}, nil
+}
+
+// RefreshSitemapUseCase regenerates the sitemap index and its gzipped shards
+// of all public posts. It is run periodically by a background job.
+//
+// Posts are walked in (created_at, id) order, so new posts are appended to the
+// last shard and the boundaries of full shards stay put. Only the shards that
+// contain posts changed since the last run are regenerated.
+type RefreshSitemapUseCase struct {
+	// refsRepo walks the public posts.
+	refsRepo content.PostRefRepository
+
+	// store keeps the sitemap files and the manifest.
+	store blob.BlobStore
+
+	// baseURL is the absolute public URL of the site without a trailing slash.
+	baseURL string
+
+	// shardSize is the maximum number of URLs per shard.
+	shardSize int
+}
+
+// NewRefreshSitemapUseCase creates a new RefreshSitemapUseCase instance.
+// shardSize is capped at sitemap.MaxURLs; zero means sitemap.MaxURLs.
+func NewRefreshSitemapUseCase(
+	refsRepo content.PostRefRepository,
+	store blob.BlobStore,
+	baseURL string,
+	shardSize int,
+) *RefreshSitemapUseCase {
+	if shardSize <= 0 || shardSize > sitemap.MaxURLs {
+		shardSize = sitemap.MaxURLs
+	}
+	return &RefreshSitemapUseCase{
+		refsRepo:  refsRepo,
+		store:     store,
+		baseURL:   baseURL,
+		shardSize: shardSize,
+	}
+}
+
+// Execute regenerates the changed shards, then the index and the manifest.
+func (uc *RefreshSitemapUseCase) Execute(ctx context.Context) error {
+	started := time.Now()
+
+	// 1. Load the state of the last run (none means everything is generated)
+	manifest, err := uc.loadManifest(ctx)
+	if err != nil {
+		return err
+	}
+	previousCount := len(manifest.Shards)
+
+	// 2. Find the shards with changed posts
+	changed, err := uc.changedShards(ctx, manifest)
+	if err != nil {
+		return err
+	}
+	if previousCount > 0 && len(changed) == 0 {
+		manifest.CheckedAt = started
+		return uc.saveManifest(ctx, manifest)
+	}
+
+	// 3. Regenerate the changed closed shards. The last shard is open: it is
+	// regenerated whenever it changed and grows into new shards
+	last := previousCount - 1
+	rebuildFrom := -1
+	for i := 0; i < last; i++ {
+		if !changed[i] {
+			continue
+		}
+		overflow, err := uc.writeClosedShard(ctx, manifest, i)
+		if err != nil {
+			return err
+		}
+		if overflow {
+			// More posts than fit were added before the shard's end (e.g. late
+			// commits): move the boundaries of this and all following shards
+			rebuildFrom = i
+			break
+		}
+	}
+	if rebuildFrom < 0 && (last < 0 || changed[last]) {
+		rebuildFrom = max(last, 0)
+	}
+	if rebuildFrom >= 0 {
+		if err := uc.writeOpenShards(ctx, manifest, rebuildFrom); err != nil {
+			return err
+		}
+	}
+
+	// 4. Write the index and remove shards that are no longer listed
+	if err := uc.writeIndex(ctx, manifest); err != nil {
+		return err
+	}
+	for n := len(manifest.Shards) + 1; n <= previousCount; n++ {
+		if err := uc.store.Delete(ctx, SitemapShardName(n)); err != nil {
+			return apperrors.NewInternalErrorWithCause("failed to delete sitemap shard", err)
+		}
+	}
+
+	// 5. Save the state for the next run
+	manifest.CheckedAt = started
+	return uc.saveManifest(ctx, manifest)
+}
+
+// changedShards returns the indexes of the shards containing posts changed
+// since the last run. Changes after the last shard count for the last shard.
+// All shards are returned if there are too many changes; without a last run
+// there are no shards yet.
+func (uc *RefreshSitemapUseCase) changedShards(ctx context.Context, manifest *sitemapManifest) (map[int]bool, error) {
+	changed := make(map[int]bool)
+	if len(manifest.Shards) == 0 {
+		return changed, nil
+	}
+
+	refs, err := uc.refsRepo.FindRefsUpdatedSince(ctx, manifest.CheckedAt.Add(-sitemapChangeOverlap), sitemapChangeLimit)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to query changed posts", err)
+	}
+	if len(refs) >= sitemapChangeLimit {
+		for i := range manifest.Shards {
+			changed[i] = true
+		}
+		return changed, nil
+	}
+
+	bounds := make([]content.PostCursor, len(manifest.Shards))
+	for i, shard := range manifest.Shards {
+		if bounds[i], err = shard.cursor(); err != nil {
+			return nil, apperrors.NewInternalErrorWithCause("invalid sitemap manifest", err)
+		}
+	}
+	for _, ref := range refs {
+		cursor := ref.Cursor()
+		i := sort.Search(len(bounds), func(i int) bool { return !cursor.After(bounds[i]) })
+		if i == len(bounds) {
+			i = len(bounds) - 1
+		}
+		changed[i] = true
+	}
+	return changed, nil
+}
+
+// writeClosedShard regenerates the i-th shard, keeping its boundaries.
+// It reports an overflow, without writing, if the shard would exceed the size.
+func (uc *RefreshSitemapUseCase) writeClosedShard(ctx context.Context, manifest *sitemapManifest, i int) (bool, error) {
+	after, err := uc.shardStart(manifest, i)
+	if err != nil {
+		return false, err
+	}
+	upTo, err := manifest.Shards[i].cursor()
+	if err != nil {
+		return false, apperrors.NewInternalErrorWithCause("invalid sitemap manifest", err)
+	}
+
+	shard, data, overflow, err := uc.buildShard(ctx, after, &upTo)
+	if err != nil || overflow {
+		return overflow, err
+	}
+
+	// Keep the boundary even if posts at the end are gone
+	shard.LastCreatedAt = upTo.CreatedAt
+	shard.LastID = upTo.ID.String()
+	if err := uc.putShard(ctx, i+1, data); err != nil {
+		return false, err
+	}
+	manifest.Shards[i] = *shard
+	return false, nil
+}
+
+// writeOpenShards regenerates the shards from the i-th on, filling each one up
+// to the size, until all posts are listed.
+func (uc *RefreshSitemapUseCase) writeOpenShards(ctx context.Context, manifest *sitemapManifest, i int) error {
+	manifest.Shards = manifest.Shards[:i]
+	for {
+		after, err := uc.shardStart(manifest, len(manifest.Shards))
+		if err != nil {
+			return err
+		}
+		shard, data, _, err := uc.buildShard(ctx, after, nil)
+		if err != nil {
+			return err
+		}
+		if shard.Count == 0 {
+			// All posts are listed (without any posts the index lists no sitemaps)
+			return nil
+		}
+
+		if err := uc.putShard(ctx, len(manifest.Shards)+1, data); err != nil {
+			return err
+		}
+		manifest.Shards = append(manifest.Shards, *shard)
+		if shard.Count < uc.shardSize {
+			return nil
+		}
+	}
+}
+
+// shardStart returns the position after which the i-th shard starts (nil for the first).
+func (uc *RefreshSitemapUseCase) shardStart(manifest *sitemapManifest, i int) (*content.PostCursor, error) {
+	if i == 0 {
+		return nil, nil
+	}
+	cursor, err := manifest.Shards[i-1].cursor()
+	if err != nil {
+		return nil, apperrors.NewInternalErrorWithCause("invalid sitemap manifest", err)
+	}
+	return &cursor, nil
+}
+
+// buildShard streams the posts after the cursor into a gzipped sitemap, up to
+// the size and, if upTo is set, up to that position. It reports an overflow if
+// more posts than fit lie before upTo.
+func (uc *RefreshSitemapUseCase) buildShard(ctx context.Context, after, upTo *content.PostCursor) (*sitemapShard, []byte, bool, error) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	w, err := sitemap.NewURLSetWriter(gz)
+	if err != nil {
+		return nil, nil, false, apperrors.NewInternalErrorWithCause("failed to write sitemap", err)
+	}
+
+	shard := &sitemapShard{}
+	overflow := false
+	cursor := after
+walk:
+	for {
+		refs, err := uc.refsRepo.FindRefsAfter(ctx, cursor, sitemapBatchSize)
+		if err != nil {
+			return nil, nil, false, apperrors.NewDatabaseErrorWithCause("failed to query posts", err)
+		}
+
+		for _, ref := range refs {
+			position := ref.Cursor()
+			if upTo != nil && position.After(*upTo) {
+				break walk
+			}
+			if w.Count() == uc.shardSize {
+				overflow = upTo != nil
+				break walk
+			}
+
+			if err := w.Add(sitemap.URL{Loc: uc.baseURL + "/p/" + url.PathEscape(ref.ID().String()), LastMod: ref.UpdatedAt()}); err != nil {
+				return nil, nil, false, apperrors.NewInternalErrorWithCause("failed to write sitemap", err)
+			}
+			if ref.UpdatedAt().After(shard.LastMod) {
+				shard.LastMod = ref.UpdatedAt()
+			}
+			shard.LastCreatedAt = position.CreatedAt
+			shard.LastID = position.ID.String()
+			cursor = &position
+		}
+
+		if len(refs) < sitemapBatchSize {
+			break
+		}
+	}
+	shard.Count = w.Count()
+
+	if err := w.Close(); err != nil {
+		return nil, nil, false, apperrors.NewInternalErrorWithCause("failed to write sitemap", err)
+	}
+	if err := gz.Close(); err != nil {
+		return nil, nil, false, apperrors.NewInternalErrorWithCause("failed to compress sitemap", err)
+	}
+	return shard, buf.Bytes(), overflow, nil
+}
+
+// putShard stores the n-th shard file.
+func (uc *RefreshSitemapUseCase) putShard(ctx context.Context, n int, data []byte) error {
+	if err := uc.store.Put(ctx, SitemapShardName(n), bytes.NewReader(data)); err != nil {
+		return apperrors.NewInternalErrorWithCause("failed to store sitemap shard", err)
+	}
+	return nil
+}
+
+// writeIndex writes the sitemap index listing all shards.
+func (uc *RefreshSitemapUseCase) writeIndex(ctx context.Context, manifest *sitemapManifest) error {
+	sitemaps := make([]sitemap.Sitemap, 0, len(manifest.Shards))
+	for i, shard := range manifest.Shards {
+		sitemaps = append(sitemaps, sitemap.Sitemap{
+			Loc:     uc.baseURL + "/" + SitemapShardName(i+1),
+			LastMod: shard.LastMod,
+		})
+	}
+
+	var buf bytes.Buffer
+	if err := sitemap.WriteIndex(&buf, sitemaps); err != nil {
+		return apperrors.NewInternalErrorWithCause("failed to write sitemap index", err)
+	}
+	if err := uc.store.Put(ctx, SitemapIndexName, &buf); err != nil {
+		return apperrors.NewInternalErrorWithCause("failed to store sitemap index", err)
+	}
+	return nil
+}
+
+// loadManifest loads the state of the last run. A missing or unreadable
+// manifest yields an empty one, so all shards are regenerated.
+func (uc *RefreshSitemapUseCase) loadManifest(ctx context.Context) (*sitemapManifest, error) {
+	body, err := uc.store.Get(ctx, sitemapManifestKey)
+	if err != nil {
+		if errors.Is(err, blob.ErrNotFound) {
+			return &sitemapManifest{}, nil
+		}
+		return nil, apperrors.NewInternalErrorWithCause("failed to open sitemap manifest", err)
+	}
+	defer body.Close()
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, apperrors.NewInternalErrorWithCause("failed to read sitemap manifest", err)
+	}
+	var manifest sitemapManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return &sitemapManifest{}, nil
+	}
+	return &manifest, nil
+}
+
+// saveManifest stores the state for the next run.
+func (uc *RefreshSitemapUseCase) saveManifest(ctx context.Context, manifest *sitemapManifest) error {
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return apperrors.NewInternalErrorWithCause("failed to encode sitemap manifest", err)
+	}
+	if err := uc.store.Put(ctx, sitemapManifestKey, bytes.NewReader(data)); err != nil {
+		return apperrors.NewInternalErrorWithCause("failed to store sitemap manifest", err)
+	}
+	return nil
+}
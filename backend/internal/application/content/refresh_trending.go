@@ -0,0 +1,129 @@
+package content
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"fuck_boss/backend/internal/application/ranking"
+	"fuck_boss/backend/internal/domain/content"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// TrendingRankingAll is the name of the trending ranking across all cities.
+const TrendingRankingAll = "trending:all"
+
+// trendingRankingName returns the name of the trending ranking of a city.
+func trendingRankingName(cityCode string) string {
+	if cityCode == "" {
+		return TrendingRankingAll
+	}
+	return "trending:city:" + cityCode
+}
+
+// RefreshTrendingUseCase flushes unique view counts to the database and
+// recomputes the trending rankings. It is run periodically by a background job.
+type RefreshTrendingUseCase struct {
+	// engagementRepo is the post engagement repository.
+	engagementRepo content.EngagementRepository
+
+	// counter is the unique view counter.
+	counter ranking.ViewCounter
+
+	// store is where the rankings are kept.
+	store ranking.RankingStore
+
+	// window is how old posts may be to be ranked.
+	window time.Duration
+
+	// size is the maximum number of posts per ranking.
+	size int
+}
+
+// NewRefreshTrendingUseCase creates a new RefreshTrendingUseCase instance.
+func NewRefreshTrendingUseCase(
+	engagementRepo content.EngagementRepository,
+	counter ranking.ViewCounter,
+	store ranking.RankingStore,
+	window time.Duration,
+	size int,
+) *RefreshTrendingUseCase {
+	return &RefreshTrendingUseCase{
+		engagementRepo: engagementRepo,
+		counter:        counter,
+		store:          store,
+		window:         window,
+		size:           size,
+	}
+}
+
+// Execute flushes the view counts and replaces the trending rankings.
+func (uc *RefreshTrendingUseCase) Execute(ctx context.Context) error {
+	now := time.Now()
+
+	// 1. Flush yesterday's and today's view counts
+	// Yesterday is flushed again so views counted just before midnight are not lost.
+	for _, day := range []time.Time{now.Add(-24 * time.Hour), now} {
+		if err := uc.flushViews(ctx, day); err != nil {
+			return err
+		}
+	}
+
+	// 2. Load the engagement of recent posts
+	engagements, err := uc.engagementRepo.FindEngagementSince(ctx, now.Add(-uc.window))
+	if err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to query post engagement", err)
+	}
+
+	// 3. Score the posts, across all cities and per city
+	all := make([]ranking.Entry, 0, len(engagements))
+	byCity := make(map[string][]ranking.Entry)
+	for _, e := range engagements {
+		entry := ranking.Entry{Member: e.PostID().String(), Score: e.HotScore(now)}
+		all = append(all, entry)
+		byCity[e.CityCode()] = append(byCity[e.CityCode()], entry)
+	}
+
+	rankings := map[string][]ranking.Entry{TrendingRankingAll: uc.top(all)}
+	for cityCode, entries := range byCity {
+		rankings[trendingRankingName(cityCode)] = uc.top(entries)
+	}
+
+	// 4. Replace the rankings
+	return uc.store.ReplaceAll(ctx, rankings)
+}
+
+// flushViews saves the unique view counts of a day.
+// Counts of IDs that are not valid post IDs are dropped.
+func (uc *RefreshTrendingUseCase) flushViews(ctx context.Context, day time.Time) error {
+	counts, err := uc.counter.Counts(ctx, day)
+	if err != nil {
+		return err
+	}
+
+	views := make(map[content.PostID]int64, len(counts))
+	for id, count := range counts {
+		postID, err := content.NewPostID(id)
+		if err != nil {
+			continue
+		}
+		views[postID] = count
+	}
+
+	if err := uc.engagementRepo.SaveDailyViews(ctx, day, views); err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to save daily views", err)
+	}
+
+	return nil
+}
+
+// top returns the size highest scored entries, highest first.
+func (uc *RefreshTrendingUseCase) top(entries []ranking.Entry) []ranking.Entry {
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Score > entries[j].Score
+	})
+	if len(entries) > uc.size {
+		entries = entries[:uc.size]
+	}
+	return entries
+}
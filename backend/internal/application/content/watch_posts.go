@@ -0,0 +1,163 @@
+package content
+
+import (
+	"context"
+	"strings"
+
+	"fuck_boss/backend/internal/application/livefeed"
+	"fuck_boss/backend/internal/domain/content"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// WatchPostsQuery represents the filter of a live feed subscription.
+type WatchPostsQuery struct {
+	// CityCode restricts the feed to a city (optional, empty means all cities).
+	CityCode string
+
+	// Company restricts the feed to a company (optional).
+	// Names are compared by CompanyName.Canonical, so "某某科技有限公司"
+	// also matches posts about "某某科技".
+	Company string
+
+	// View selects the post fields: dto.PostViewBasic or dto.PostViewFull (default).
+	View string
+
+	// LastEventID resumes the feed after the given event (optional).
+	// The retained events published after it are sent before the live ones.
+	LastEventID string
+}
+
+// replayPageSize is the number of events read from the history at a time when
+// resuming a feed.
+const replayPageSize = 100
+
+// WatchPostsUseCase pushes newly published posts to a client as they are created.
+type WatchPostsUseCase struct {
+	// subscriber delivers the posts published on any server instance.
+	subscriber livefeed.Subscriber
+
+	// history replays recent events when resuming (optional, nil ignores LastEventID).
+	history livefeed.History
+}
+
+// NewWatchPostsUseCase creates a new WatchPostsUseCase instance.
+func NewWatchPostsUseCase(subscriber livefeed.Subscriber, history livefeed.History) *WatchPostsUseCase {
+	return &WatchPostsUseCase{
+		subscriber: subscriber,
+		history:    history,
+	}
+}
+
+// Execute calls send for every matching event published until ctx is done,
+// starting with the retained events after query.LastEventID if it is set.
+// It returns nil when ctx is done, the error of send if sending fails, and an
+// UNAVAILABLE error if the feed is interrupted (the client fell behind or the
+// server is shutting down), after which the client should reconnect.
+func (uc *WatchPostsUseCase) Execute(ctx context.Context, query WatchPostsQuery, send func(*livefeed.Event) error) error {
+	// 1. Validate input
+	basic, err := parseView(query.View)
+	if err != nil {
+		return err
+	}
+
+	cityCode := strings.TrimSpace(query.CityCode)
+
+	var company string
+	if strings.TrimSpace(query.Company) != "" {
+		name, err := content.NewCompanyName(query.Company)
+		if err != nil {
+			return apperrors.NewValidationErrorWithDetails("invalid company name", map[string]interface{}{
+				"error": err.Error(),
+			})
+		}
+		company = name.Canonical()
+	}
+
+	filter := func(event *livefeed.Event) *livefeed.Event {
+		post := event.Post
+		if cityCode != "" && post.CityCode != cityCode {
+			return nil
+		}
+		if company != "" && !sameCompany(post.Company, company) {
+			return nil
+		}
+		if basic {
+			// Events are shared between subscribers, so strip a copy
+			summary := *post
+			summary.Content = ""
+			summary.ContentHTML = ""
+			return &livefeed.Event{ID: event.ID, Post: &summary}
+		}
+		return event
+	}
+
+	// 2. Subscribe to the live feed (before replaying, so no event is missed)
+	events, err := uc.subscriber.Subscribe(ctx)
+	if err != nil {
+		return err
+	}
+
+	// 3. Replay the events published since the last one the client received
+	var replayed map[string]bool
+	if query.LastEventID != "" && uc.history != nil {
+		replayed = make(map[string]bool)
+		lastEventID := query.LastEventID
+		for {
+			page, err := uc.history.EventsAfter(ctx, lastEventID, replayPageSize)
+			if err != nil {
+				return err
+			}
+			for _, event := range page {
+				replayed[event.ID] = true
+				if matched := filter(event); matched != nil {
+					if err := send(matched); err != nil {
+						return err
+					}
+				}
+			}
+			if len(page) < replayPageSize {
+				break
+			}
+			lastEventID = page[len(page)-1].ID
+		}
+	}
+
+	// 4. Forward matching live events
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return apperrors.NewUnavailableError("live feed interrupted, reconnect to continue")
+			}
+
+			// Events published during the replay were already sent; the live
+			// events arrive in publishing order, so the first new one ends the overlap
+			if replayed != nil {
+				if replayed[event.ID] {
+					continue
+				}
+				replayed = nil
+			}
+
+			if matched := filter(event); matched != nil {
+				if err := send(matched); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// sameCompany reports whether a company name has the given canonical name.
+func sameCompany(name, canonical string) bool {
+	companyName, err := content.NewCompanyName(name)
+	if err != nil {
+		return false
+	}
+	return companyName.Canonical() == canonical
+}
@@ -0,0 +1,75 @@
+package dto
+
+import (
+	"time"
+)
+
+// APIKeyDTO represents an API key for privileged RPCs.
+type APIKeyDTO struct {
+	// ID is the key ID.
+	ID string
+
+	// Name describes who or what uses the key.
+	Name string
+
+	// Prefix is the start of the raw key, used to tell keys apart.
+	Prefix string
+
+	// Scopes are the granted scopes (moderate, export, admin).
+	Scopes []string
+
+	// ExpiresAt is when the key stops working (nil for no expiry).
+	ExpiresAt *time.Time
+
+	// CreatedAt is when the key was issued.
+	CreatedAt time.Time
+
+	// LastUsedAt is when the key was last accepted (nil if never used).
+	LastUsedAt *time.Time
+
+	// RevokedAt is when the key was revoked (nil while active).
+	RevokedAt *time.Time
+
+	// Key is the raw key. It is only set when the key is issued and is never
+	// serialized, so it cannot end up in caches or logs by accident.
+	Key string `json:"-"`
+}
+
+// AuditEventDTO represents one privileged call in the audit log.
+type AuditEventDTO struct {
+	// ID is the event ID.
+	ID string
+
+	// OccurredAt is when the call finished.
+	OccurredAt time.Time
+
+	// APIKeyID is the API key that made the call (empty for the admin subcommands).
+	APIKeyID string
+
+	// Actor is the API key name, or "cli".
+	Actor string
+
+	// Action is the gRPC method, HTTP endpoint or admin subcommand.
+	Action string
+
+	// Target is the ID of what the call acted on (may be empty).
+	Target string
+
+	// Outcome is "OK" or the gRPC status code name.
+	Outcome string
+
+	// ClientIP is the address the call came from.
+	ClientIP string
+
+	// Details is a JSON object with the call parameters (may be empty).
+	Details string
+}
+
+// AuditEventsListDTO represents a cursor-paginated list of audit events.
+type AuditEventsListDTO struct {
+	// Events is the list of events, newest first.
+	Events []*AuditEventDTO
+
+	// NextCursor is the cursor for the next page (empty if there are no more events).
+	NextCursor string
+}
@@ -0,0 +1,38 @@
+package dto
+
+import (
+	"time"
+)
+
+// AttachmentDTO represents the metadata of a file attached to a post.
+type AttachmentDTO struct {
+	// ID is the attachment ID.
+	ID string
+
+	// PostID is the post the attachment belongs to.
+	PostID string
+
+	// ContentType is the media type of the stored file (e.g. "image/png").
+	ContentType string
+
+	// Size is the size of the file in bytes.
+	Size int64
+
+	// Checksum is the hex-encoded SHA-256 of the file content.
+	Checksum string
+
+	// FileName is the file name offered for download.
+	FileName string
+
+	// Width is the image width in pixels (0 for documents).
+	Width int
+
+	// Height is the image height in pixels (0 for documents).
+	Height int
+
+	// HasThumbnail reports whether a thumbnail can be downloaded.
+	HasThumbnail bool
+
+	// CreatedAt is when the attachment was uploaded.
+	CreatedAt time.Time
+}
@@ -0,0 +1,81 @@
+// Package dto provides data transfer objects for application layer.
+// DTOs are used to transfer data between layers without exposing domain entities.
+package dto
+
+import (
+	"time"
+)
+
+// RepresentativeDTO represents a company representative data transfer object.
+// It is used for API responses and does not contain business logic.
+type RepresentativeDTO struct {
+	// ID is the unique identifier of the representative.
+	ID string
+
+	// Company is the company the representative speaks for.
+	Company string
+
+	// Domain is the company domain being verified.
+	Domain string
+
+	// Status is the verification status (PENDING or VERIFIED).
+	Status string
+
+	// ChallengeMethod is the challenge method (DNS_TXT or FILE).
+	ChallengeMethod string
+
+	// ChallengeRecordName is the DNS name that must hold the TXT record.
+	ChallengeRecordName string
+
+	// ChallengeRecordValue is the TXT record value (DNS_TXT method).
+	ChallengeRecordValue string
+
+	// ChallengeFileURL is the URL that must serve the challenge code (FILE method).
+	ChallengeFileURL string
+
+	// ChallengeCode is the content expected in the challenge file (FILE method).
+	ChallengeCode string
+
+	// CreatedAt is when the representative registered.
+	CreatedAt time.Time
+
+	// VerifiedAt is when the domain challenge was passed (nil while pending).
+	VerifiedAt *time.Time
+
+	// AccessToken is the raw access token.
+	// It is only populated in the response to registration and never cached.
+	AccessToken string `json:"-"`
+}
+
+// CompanyMergeDTO is the result of merging one company name into another.
+type CompanyMergeDTO struct {
+	// From is the company name merged away.
+	From string
+
+	// Into is the company name kept.
+	Into string
+
+	// Posts is the number of posts renamed.
+	Posts int
+
+	// Representatives is the number of company representatives renamed.
+	Representatives int
+
+	// Watches is the number of company watches moved.
+	Watches int
+
+	// Domains is the number of approved company domains moved.
+	Domains int
+}
+
+// CompanyDomainDTO is a company domain approved by an operator.
+type CompanyDomainDTO struct {
+	// Company is the company name.
+	Company string
+
+	// Domain is the approved domain.
+	Domain string
+
+	// ApprovedAt is when the domain was approved.
+	ApprovedAt time.Time
+}
@@ -6,6 +6,14 @@ import (
 	"time"
 )
 
+const (
+	// PostViewBasic is the list view with the summary instead of the full content.
+	PostViewBasic = "BASIC"
+
+	// PostViewFull is the list view with the full content (default).
+	PostViewFull = "FULL"
+)
+
 // PostDTO represents a Post data transfer object.
 // It is used for API responses and does not contain business logic.
 type PostDTO struct {
@@ -21,14 +29,72 @@ type PostDTO struct {
 	// CityName is the city name (e.g., "北京").
 	CityName string
 
-	// Content is the post content.
+	// Content is the post content (Markdown source).
 	Content string
 
+	// ContentHTML is the content rendered to sanitized HTML.
+	ContentHTML string
+
+	// Summary is the plain-text summary of the content.
+	// It is only populated for lists; in the BASIC view Content and ContentHTML are empty.
+	Summary string
+
 	// OccurredAt is when the incident occurred (optional).
 	OccurredAt *time.Time
 
 	// CreatedAt is when the post was created.
 	CreatedAt time.Time
+
+	// ResolutionStatus is the status of the latest follow-up (empty if none).
+	ResolutionStatus string
+
+	// Timeline is the author's follow-up timeline, oldest first.
+	// It is only populated for post details.
+	Timeline []*FollowUpDTO
+
+	// OfficialReply is the verified company reply (nil if none).
+	// It is only populated for post details.
+	OfficialReply *OfficialReplyDTO
+
+	// ManagementToken is the raw management token.
+	// It is only populated in the response to post creation and never cached.
+	ManagementToken string `json:"-"`
+}
+
+// FollowUpDTO represents a follow-up entry appended by the post author.
+type FollowUpDTO struct {
+	// ID is the unique identifier of the follow-up.
+	ID string
+
+	// Status is the resolution status tag (ONGOING, ESCALATED, RESOLVED).
+	Status string
+
+	// Note is the follow-up text.
+	Note string
+
+	// CreatedAt is when the follow-up was appended.
+	CreatedAt time.Time
+}
+
+// OfficialReplyDTO represents the official reply of a verified company representative.
+type OfficialReplyDTO struct {
+	// ID is the unique identifier of the reply.
+	ID string
+
+	// Company is the company the representative speaks for.
+	Company string
+
+	// VerifiedDomain is the company domain the representative verified.
+	VerifiedDomain string
+
+	// Content is the reply text.
+	Content string
+
+	// Badge is the badge shown with the reply (e.g., "VERIFIED_COMPANY").
+	Badge string
+
+	// CreatedAt is when the reply was attached.
+	CreatedAt time.Time
 }
 
 // PostsListDTO represents a list of posts with pagination information.
@@ -45,3 +111,88 @@ type PostsListDTO struct {
 	// PageSize is the number of items per page.
 	PageSize int
 }
+
+// ExportedPostDTO represents a post in a dataset export.
+// It only holds public fields: never the author, IP addresses or the
+// management token.
+type ExportedPostDTO struct {
+	// ID is the unique identifier of the post.
+	ID string
+
+	// CreatedAt is when the post was created.
+	CreatedAt time.Time
+
+	// CityCode is the city code (e.g., "beijing").
+	CityCode string
+
+	// CityName is the city name (e.g., "北京").
+	CityName string
+
+	// Company is the company name.
+	Company string
+
+	// ResolutionStatus is the status of the latest follow-up (empty if none).
+	ResolutionStatus string
+
+	// Content is the post content (Markdown source).
+	Content string
+}
+
+// ImportReportDTO is the result of a dataset import.
+type ImportReportDTO struct {
+	// DryRun reports whether nothing was written: Imported is then the
+	// number of posts that would have been imported.
+	DryRun bool
+
+	// Rows is the number of rows read.
+	Rows int
+
+	// Imported is the number of posts imported.
+	Imported int
+
+	// Skipped is the number of valid rows skipped because they were imported before.
+	Skipped int
+
+	// Invalid is the number of rows rejected by validation.
+	Invalid int
+
+	// Errors describes the rejected rows, in file order. The list is capped
+	// (see content.MaxImportErrors); Invalid is the total.
+	Errors []*ImportRowErrorDTO
+}
+
+// ImportRowErrorDTO describes a row rejected during an import.
+type ImportRowErrorDTO struct {
+	// Line is the line number of the row in the file.
+	Line int
+
+	// ExternalID is the external ID of the row (empty if missing).
+	ExternalID string
+
+	// Error describes what is wrong with the row.
+	Error string
+}
+
+// PostModerationDTO is the moderation state of a post after a moderation action.
+type PostModerationDTO struct {
+	// PostID is the ID of the post.
+	PostID string
+
+	// Company is the company named in the post.
+	Company string
+
+	// CityCode is the city of the post.
+	CityCode string
+
+	// Status is the moderation status (VISIBLE, HIDDEN or REMOVED).
+	Status string
+
+	// Reason is the reason of the last hide or remove action (empty once restored).
+	Reason string
+
+	// ModeratedAt is when the post was last moderated.
+	ModeratedAt time.Time
+
+	// ModeratedBy is the user ID of the moderator (empty for operator actions).
+	ModeratedBy string
+}
@@ -0,0 +1,46 @@
+// Package dto provides data transfer objects for application layer.
+// DTOs are used to transfer data between layers without exposing domain entities.
+package dto
+
+import (
+	"time"
+)
+
+// AuthSessionDTO represents the result of a successful registration, login or refresh.
+// It carries the user identity and a fresh access/refresh token pair.
+type AuthSessionDTO struct {
+	// UserID is the unique identifier of the user.
+	UserID string
+
+	// Email is the user's login email address.
+	Email string
+
+	// Username is the user's public handle (empty if not set).
+	Username string
+
+	// AccessToken is the short-lived bearer token (JWT).
+	AccessToken string `json:"-"`
+
+	// AccessTokenExpiresAt is when the access token expires.
+	AccessTokenExpiresAt time.Time
+
+	// RefreshToken is the long-lived token used to obtain new access tokens.
+	// It is rotated on every use.
+	RefreshToken string `json:"-"`
+
+	// RefreshTokenExpiresAt is when the refresh token expires.
+	RefreshTokenExpiresAt time.Time
+}
+
+// VerificationCodeDTO represents the result of sending a verification code.
+// The code itself is never returned.
+type VerificationCodeDTO struct {
+	// Channel is the channel the code was sent through (EMAIL or SMS).
+	Channel string
+
+	// ExpiresAt is when the code stops being accepted.
+	ExpiresAt time.Time
+
+	// ResendAfterSeconds is how long to wait before requesting another code.
+	ResendAfterSeconds int
+}
@@ -0,0 +1,164 @@
+package dto
+
+import (
+	"time"
+)
+
+// ModeratorDTO represents a moderator and what they may do.
+type ModeratorDTO struct {
+	// UserID is the ID of the moderator's user account.
+	UserID string
+
+	// Role is JUNIOR or SENIOR.
+	Role string
+
+	// Permissions are the permissions granted by the role.
+	Permissions []string
+
+	// CityCodes are the assigned cities (empty for all cities).
+	CityCodes []string
+
+	// AssignedAt is when the user became a moderator.
+	AssignedAt time.Time
+
+	// UpdatedAt is when the role or cities last changed.
+	UpdatedAt time.Time
+}
+
+// ModerationQueueItemDTO represents a moderated post pending review or appealed.
+type ModerationQueueItemDTO struct {
+	// PostID is the ID of the post.
+	PostID string
+
+	// Company is the company named in the post.
+	Company string
+
+	// CityCode is the city code (e.g., "beijing").
+	CityCode string
+
+	// CityName is the city name (e.g., "北京").
+	CityName string
+
+	// Content is the post content (Markdown source).
+	Content string
+
+	// CreatedAt is when the post was created.
+	CreatedAt time.Time
+
+	// Status is the moderation status (HIDDEN in the moderation queue, HIDDEN or REMOVED when appealed).
+	Status string
+
+	// Reason is the reason the post was hidden or removed.
+	Reason string
+
+	// ModeratedAt is when the post was last moderated.
+	ModeratedAt time.Time
+
+	// ModeratedBy is the user ID of the moderator who took the last action (empty for operators).
+	ModeratedBy string
+}
+
+// ModerationQueueDTO represents a paginated moderation queue.
+type ModerationQueueDTO struct {
+	// Items is the list of posts pending review, the longest-waiting first.
+	Items []*ModerationQueueItemDTO
+
+	// Total is the total number of posts pending review.
+	Total int
+
+	// Page is the current page number (1-based).
+	Page int
+
+	// PageSize is the number of items per page.
+	PageSize int
+}
+
+// UserBanDTO represents the ban of a post author.
+type UserBanDTO struct {
+	// UserID is the ID of the banned user.
+	UserID string
+
+	// Reason is the reason given for the ban.
+	Reason string
+
+	// BannedAt is when the user was banned.
+	BannedAt time.Time
+}
+
+// AppealDTO represents an author's appeal against the moderation of a post.
+type AppealDTO struct {
+	// ID is the ID of the appeal.
+	ID string
+
+	// PostID is the ID of the appealed post.
+	PostID string
+
+	// Status is PENDING, ACCEPTED or REJECTED.
+	Status string
+
+	// AppealedStatus is the moderation status appealed (HIDDEN or REMOVED).
+	AppealedStatus string
+
+	// Statement is the author's explanation.
+	Statement string
+
+	// ActedBy is the user ID of the moderator who took the appealed action
+	// (empty for operators). It is not shown to authors.
+	ActedBy string
+
+	// DecidedBy is the user ID of the moderator who decided (empty while pending).
+	// It is not shown to authors.
+	DecidedBy string
+
+	// DecisionReason is the reason given with the decision (empty while pending).
+	DecisionReason string
+
+	// FiledAt is when the appeal was filed.
+	FiledAt time.Time
+
+	// DecidedAt is when the appeal was decided (nil while pending).
+	DecidedAt *time.Time
+}
+
+// AppealStatusDTO represents what the author of a moderated post can look up:
+// the moderation of the post and the latest appeal against it.
+type AppealStatusDTO struct {
+	// PostID is the ID of the post.
+	PostID string
+
+	// Status is the moderation status of the post (VISIBLE, HIDDEN or REMOVED).
+	Status string
+
+	// Reason is the reason the post was hidden or removed (empty when visible).
+	Reason string
+
+	// ModeratedAt is when the post was last moderated (zero if never).
+	ModeratedAt time.Time
+
+	// Appeal is the latest appeal of the post (nil if never appealed).
+	Appeal *AppealDTO
+}
+
+// AppealQueueItemDTO represents a pending appeal together with the appealed post.
+type AppealQueueItemDTO struct {
+	// Appeal is the pending appeal.
+	Appeal *AppealDTO
+
+	// Post is the appealed post and its current moderation.
+	Post *ModerationQueueItemDTO
+}
+
+// AppealQueueDTO represents a paginated list of pending appeals.
+type AppealQueueDTO struct {
+	// Items is the list of pending appeals, the longest-waiting first.
+	Items []*AppealQueueItemDTO
+
+	// Total is the total number of pending appeals.
+	Total int
+
+	// Page is the current page number (1-based).
+	Page int
+
+	// PageSize is the number of items per page.
+	PageSize int
+}
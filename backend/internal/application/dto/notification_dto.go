@@ -0,0 +1,43 @@
+// Package dto provides data transfer objects for application layer.
+// DTOs are used to transfer data between layers without exposing domain entities.
+package dto
+
+import (
+	"time"
+)
+
+// NotificationDTO represents a notification in the notification center.
+type NotificationDTO struct {
+	// ID is the notification ID.
+	ID string
+
+	// Type is the event type (COMMENT_REPLY, WATCHED_COMPANY_POST, MODERATION_OUTCOME, COMPANY_REPLY).
+	Type string
+
+	// PostID is the post the event relates to.
+	PostID string
+
+	// Subject is the short title.
+	Subject string
+
+	// Excerpt is the short preview.
+	Excerpt string
+
+	// CreatedAt is when the notification was created.
+	CreatedAt time.Time
+
+	// ReadAt is when the notification was read (nil while unread).
+	ReadAt *time.Time
+}
+
+// NotificationsListDTO represents a cursor-paginated list of notifications.
+type NotificationsListDTO struct {
+	// Notifications is the list of notifications, newest first.
+	Notifications []*NotificationDTO
+
+	// NextCursor is the cursor for the next page (empty if there are no more notifications).
+	NextCursor string
+
+	// UnreadCount is the total number of unread notifications.
+	UnreadCount int
+}
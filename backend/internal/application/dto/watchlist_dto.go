@@ -0,0 +1,68 @@
+// Package dto provides data transfer objects for application layer.
+// DTOs are used to transfer data between layers without exposing domain entities.
+package dto
+
+import (
+	"time"
+)
+
+// DeviceTokenDTO represents a device token issued to an anonymous visitor.
+type DeviceTokenDTO struct {
+	// DeviceToken is the raw token. It is only returned once.
+	DeviceToken string `json:"-"`
+
+	// IssuedAt is when the token was issued.
+	IssuedAt time.Time
+}
+
+// BookmarkDTO represents a bookmarked post.
+type BookmarkDTO struct {
+	// Post is the bookmarked post.
+	Post *PostDTO
+
+	// BookmarkedAt is when the post was bookmarked.
+	BookmarkedAt time.Time
+}
+
+// BookmarksListDTO represents a paginated list of bookmarks.
+type BookmarksListDTO struct {
+	// Bookmarks is the list of bookmarks, newest first.
+	Bookmarks []*BookmarkDTO
+
+	// Total is the total number of bookmarks.
+	Total int
+
+	// Page is the current page number (1-based).
+	Page int
+
+	// PageSize is the number of items per page.
+	PageSize int
+}
+
+// WatchedCompanyDTO represents a company on the watchlist.
+type WatchedCompanyDTO struct {
+	// Company is the company name.
+	Company string
+
+	// WatchedAt is when the company was added to the watchlist.
+	WatchedAt time.Time
+}
+
+// WatchedPostsDTO represents new posts about watched companies.
+type WatchedPostsDTO struct {
+	// Posts is the list of posts, newest first.
+	Posts []*PostDTO
+
+	// Total is the total number of new posts.
+	Total int
+
+	// Page is the current page number (1-based).
+	Page int
+
+	// PageSize is the number of items per page.
+	PageSize int
+
+	// Since is the cutoff: only posts created after it are included.
+	// Clients pass it back when requesting further pages.
+	Since time.Time
+}
@@ -0,0 +1,150 @@
+// Package identity provides use cases for user registration, login and session management.
+package identity
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/application/ratelimit"
+	"fuck_boss/backend/internal/domain/identity"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// LoginCommand represents the command to log in with an account and password.
+type LoginCommand struct {
+	// Account is the email address or username (required).
+	Account string
+
+	// Password is the plaintext password (required).
+	Password string
+
+	// ClientIP is the client IP address for rate limiting (required).
+	ClientIP string
+}
+
+// LoginUseCase handles password login.
+// Unknown accounts and wrong passwords return the same error, and unknown accounts
+// still pay the cost of a password hash so that response times do not reveal which accounts exist.
+type LoginUseCase struct {
+	// userRepo is the User repository.
+	userRepo identity.UserRepository
+
+	// tokenRepo is the RefreshToken repository.
+	tokenRepo identity.RefreshTokenRepository
+
+	// hasher verifies passwords.
+	hasher identity.PasswordHasher
+
+	// tokens issues access tokens.
+	tokens TokenIssuer
+
+	// rateLimiter is the rate limiter for preventing password guessing.
+	rateLimiter ratelimit.RateLimiter
+
+	// dummyHashOnce guards dummyHash.
+	dummyHashOnce sync.Once
+
+	// dummyHash is verified against when the account does not exist.
+	dummyHash string
+}
+
+// NewLoginUseCase creates a new LoginUseCase instance.
+func NewLoginUseCase(
+	userRepo identity.UserRepository,
+	tokenRepo identity.RefreshTokenRepository,
+	hasher identity.PasswordHasher,
+	tokens TokenIssuer,
+	rateLimiter ratelimit.RateLimiter,
+) *LoginUseCase {
+	return &LoginUseCase{
+		userRepo:    userRepo,
+		tokenRepo:   tokenRepo,
+		hasher:      hasher,
+		tokens:      tokens,
+		rateLimiter: rateLimiter,
+	}
+}
+
+// Execute executes the login command.
+// It returns a new session (a new refresh token family).
+func (uc *LoginUseCase) Execute(ctx context.Context, cmd LoginCommand) (*dto.AuthSessionDTO, error) {
+	// 1. Validate input
+	if strings.TrimSpace(cmd.Account) == "" {
+		return nil, apperrors.NewValidationError("account is required")
+	}
+	if cmd.Password == "" {
+		return nil, apperrors.NewValidationError("password is required")
+	}
+	if cmd.ClientIP == "" {
+		return nil, apperrors.NewValidationError("client IP is required for rate limiting")
+	}
+
+	// 2. Check rate limit (20 attempts per hour per IP)
+	rateLimitKey := ratelimit.ClientKey("login", cmd.ClientIP, time.Now())
+	allowed, err := uc.rateLimiter.Allow(ctx, rateLimitKey, 20, time.Hour)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("rate limit check failed", err)
+	}
+	if !allowed {
+		return nil, apperrors.NewRateLimitError("rate limit exceeded: maximum 20 login attempts per hour")
+	}
+
+	// 3. Find the user by email or username
+	user, err := uc.findUser(ctx, cmd.Account)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			uc.burnPasswordCheck(cmd.Password)
+			return nil, apperrors.NewUnauthenticatedError(identity.ErrInvalidCredentials.Error())
+		}
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to query user", err)
+	}
+
+	// 4. Check the password
+	if err := user.Authenticate(cmd.Password, uc.hasher); err != nil {
+		if errors.Is(err, identity.ErrInvalidCredentials) {
+			return nil, apperrors.NewUnauthenticatedError(err.Error())
+		}
+		return nil, apperrors.NewInternalErrorWithCause("failed to verify password", err)
+	}
+
+	// 5. Banned users cannot log in (checked after the password, so the ban is not revealed to others)
+	if user.IsBanned() {
+		return nil, apperrors.NewForbiddenError(identity.ErrUserBanned.Error())
+	}
+
+	// 6. Start a session
+	return startSession(ctx, uc.tokenRepo, uc.tokens, user)
+}
+
+// findUser looks the account up as an email if it contains "@", otherwise as a username.
+// Malformed accounts are reported as not found.
+func (uc *LoginUseCase) findUser(ctx context.Context, account string) (*identity.User, error) {
+	if strings.Contains(account, "@") {
+		email, err := identity.NewEmail(account)
+		if err != nil {
+			return nil, apperrors.NewNotFoundError("user")
+		}
+		return uc.userRepo.FindByEmail(ctx, email)
+	}
+
+	username, err := identity.NewUsername(account)
+	if err != nil || username.IsZero() {
+		return nil, apperrors.NewNotFoundError("user")
+	}
+	return uc.userRepo.FindByUsername(ctx, username)
+}
+
+// burnPasswordCheck verifies the password against a throwaway hash
+// so that unknown accounts take as long as wrong passwords.
+func (uc *LoginUseCase) burnPasswordCheck(password string) {
+	uc.dummyHashOnce.Do(func() {
+		uc.dummyHash, _ = uc.hasher.Hash("dummy-password-for-timing")
+	})
+	if uc.dummyHash != "" {
+		_, _ = uc.hasher.Verify(password, uc.dummyHash)
+	}
+}
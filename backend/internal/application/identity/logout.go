@@ -0,0 +1,49 @@
+// Package identity provides use cases for user registration, login and session management.
+package identity
+
+import (
+	"context"
+	"time"
+
+	"fuck_boss/backend/internal/domain/identity"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// LogoutCommand represents the command to end a session.
+type LogoutCommand struct {
+	// RefreshToken is the raw refresh token of the session (required).
+	RefreshToken string
+}
+
+// LogoutUseCase revokes the refresh token family of a session.
+// Access tokens already issued stay valid until they expire (minutes),
+// but can no longer be refreshed.
+type LogoutUseCase struct {
+	// tokenRepo is the RefreshToken repository.
+	tokenRepo identity.RefreshTokenRepository
+}
+
+// NewLogoutUseCase creates a new LogoutUseCase instance.
+func NewLogoutUseCase(tokenRepo identity.RefreshTokenRepository) *LogoutUseCase {
+	return &LogoutUseCase{
+		tokenRepo: tokenRepo,
+	}
+}
+
+// Execute executes the logout command.
+// Logging out with an unknown or already revoked token succeeds.
+func (uc *LogoutUseCase) Execute(ctx context.Context, cmd LogoutCommand) error {
+	if cmd.RefreshToken == "" {
+		return apperrors.NewValidationError("refresh token is required")
+	}
+
+	token, err := uc.tokenRepo.FindByHash(ctx, identity.HashRefreshToken(cmd.RefreshToken))
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil
+		}
+		return apperrors.NewDatabaseErrorWithCause("failed to query refresh token", err)
+	}
+
+	return uc.tokenRepo.RevokeFamily(ctx, token.FamilyID(), time.Now())
+}
@@ -0,0 +1,113 @@
+// Package identity provides use cases for user registration, login and session management.
+package identity
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/domain/identity"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// RefreshCommand represents the command to exchange a refresh token for a new token pair.
+type RefreshCommand struct {
+	// RefreshToken is the raw refresh token (required).
+	RefreshToken string
+}
+
+// RefreshUseCase rotates refresh tokens.
+// Every refresh revokes the presented token and issues a new one in the same family.
+// If an already rotated token is presented again, the token was most likely stolen,
+// so the whole family is revoked and both the thief and the user must log in again.
+type RefreshUseCase struct {
+	// userRepo is the User repository.
+	userRepo identity.UserRepository
+
+	// tokenRepo is the RefreshToken repository.
+	tokenRepo identity.RefreshTokenRepository
+
+	// tokens issues access tokens.
+	tokens TokenIssuer
+}
+
+// NewRefreshUseCase creates a new RefreshUseCase instance.
+func NewRefreshUseCase(
+	userRepo identity.UserRepository,
+	tokenRepo identity.RefreshTokenRepository,
+	tokens TokenIssuer,
+) *RefreshUseCase {
+	return &RefreshUseCase{
+		userRepo:  userRepo,
+		tokenRepo: tokenRepo,
+		tokens:    tokens,
+	}
+}
+
+// Execute executes the refresh command.
+// It returns the session with a new access token and a new refresh token.
+func (uc *RefreshUseCase) Execute(ctx context.Context, cmd RefreshCommand) (*dto.AuthSessionDTO, error) {
+	// 1. Validate input
+	if cmd.RefreshToken == "" {
+		return nil, apperrors.NewValidationError("refresh token is required")
+	}
+
+	// 2. Find the presented token
+	current, err := uc.tokenRepo.FindByHash(ctx, identity.HashRefreshToken(cmd.RefreshToken))
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, apperrors.NewUnauthenticatedError("invalid refresh token")
+		}
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to query refresh token", err)
+	}
+
+	// 3. Rotate (reuse of a rotated token revokes the whole family)
+	now := time.Now()
+	next, raw, err := current.Rotate(now, uc.tokens.RefreshTokenTTL())
+	if err != nil {
+		switch {
+		case errors.Is(err, identity.ErrRefreshTokenRevoked):
+			return nil, uc.revokeFamily(ctx, current, now)
+		case errors.Is(err, identity.ErrRefreshTokenExpired):
+			return nil, apperrors.NewUnauthenticatedError(err.Error())
+		default:
+			return nil, apperrors.NewInternalErrorWithCause("failed to rotate refresh token", err)
+		}
+	}
+
+	if err := uc.tokenRepo.Rotate(ctx, current, next); err != nil {
+		if apperrors.IsConflictError(err) {
+			// Another request rotated the same token first
+			return nil, uc.revokeFamily(ctx, current, now)
+		}
+		return nil, err
+	}
+
+	// 4. Load the user and issue the access token
+	user, err := uc.userRepo.FindByID(ctx, next.UserID())
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, apperrors.NewUnauthenticatedError("user no longer exists")
+		}
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to query user", err)
+	}
+
+	// 5. Banned users lose the whole session
+	if user.IsBanned() {
+		if err := uc.tokenRepo.RevokeFamily(ctx, next.FamilyID(), now); err != nil {
+			return nil, err
+		}
+		return nil, apperrors.NewForbiddenError(identity.ErrUserBanned.Error())
+	}
+
+	return newSessionDTO(uc.tokens, user, next, raw)
+}
+
+// revokeFamily revokes every token of the family and returns the error for the caller.
+func (uc *RefreshUseCase) revokeFamily(ctx context.Context, token *identity.RefreshToken, now time.Time) error {
+	if err := uc.tokenRepo.RevokeFamily(ctx, token.FamilyID(), now); err != nil {
+		return err
+	}
+	return apperrors.NewUnauthenticatedError("refresh token reuse detected, please log in again")
+}
@@ -0,0 +1,198 @@
+// Package identity provides use cases for user registration, login and session management.
+package identity
+
+import (
+	"context"
+	"time"
+
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/application/ratelimit"
+	"fuck_boss/backend/internal/application/verification"
+	"fuck_boss/backend/internal/domain/identity"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// RegisterCommand represents the command to register a user account.
+type RegisterCommand struct {
+	// Email is the login email address (required).
+	Email string
+
+	// Username is the public handle (optional, 3-32 characters).
+	Username string
+
+	// Password is the plaintext password (required, 8-128 characters).
+	Password string
+
+	// VerificationCode is the code sent to Email with purpose REGISTER
+	// (required when registration requires email verification).
+	VerificationCode string
+
+	// ClientIP is the client IP address for rate limiting (required).
+	ClientIP string
+}
+
+// CodeVerifier checks one-time verification codes.
+// *verification.Service satisfies this interface.
+type CodeVerifier interface {
+	// Verify checks and consumes a submitted code.
+	Verify(ctx context.Context, cmd verification.VerifyCommand) error
+}
+
+// RegisterUseCase handles user registration.
+// A successful registration immediately starts a session.
+type RegisterUseCase struct {
+	// userRepo is the User repository.
+	userRepo identity.UserRepository
+
+	// tokenRepo is the RefreshToken repository.
+	tokenRepo identity.RefreshTokenRepository
+
+	// hasher hashes the password.
+	hasher identity.PasswordHasher
+
+	// tokens issues access tokens.
+	tokens TokenIssuer
+
+	// rateLimiter is the rate limiter for preventing abuse.
+	rateLimiter ratelimit.RateLimiter
+
+	// codes checks the email verification code (nil if verification is not required).
+	codes CodeVerifier
+}
+
+// NewRegisterUseCase creates a new RegisterUseCase instance.
+func NewRegisterUseCase(
+	userRepo identity.UserRepository,
+	tokenRepo identity.RefreshTokenRepository,
+	hasher identity.PasswordHasher,
+	tokens TokenIssuer,
+	rateLimiter ratelimit.RateLimiter,
+	codes CodeVerifier,
+) *RegisterUseCase {
+	return &RegisterUseCase{
+		userRepo:    userRepo,
+		tokenRepo:   tokenRepo,
+		hasher:      hasher,
+		tokens:      tokens,
+		rateLimiter: rateLimiter,
+		codes:       codes,
+	}
+}
+
+// Execute executes the register command.
+// It returns the new user's session.
+func (uc *RegisterUseCase) Execute(ctx context.Context, cmd RegisterCommand) (*dto.AuthSessionDTO, error) {
+	// 1. Validate input
+	if err := uc.validateCommand(cmd); err != nil {
+		return nil, err
+	}
+
+	// 2. Check rate limit (5 registrations per hour per IP)
+	rateLimitKey := ratelimit.ClientKey("register", cmd.ClientIP, time.Now())
+	allowed, err := uc.rateLimiter.Allow(ctx, rateLimitKey, 5, time.Hour)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("rate limit check failed", err)
+	}
+	if !allowed {
+		return nil, apperrors.NewRateLimitError("rate limit exceeded: maximum 5 registrations per hour")
+	}
+
+	// 3. Create domain value objects
+	email, err := identity.NewEmail(cmd.Email)
+	if err != nil {
+		return nil, apperrors.NewValidationErrorWithDetails("invalid email", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	username, err := identity.NewUsername(cmd.Username)
+	if err != nil {
+		return nil, apperrors.NewValidationErrorWithDetails("invalid username", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	password, err := identity.NewPassword(cmd.Password)
+	if err != nil {
+		return nil, apperrors.NewValidationErrorWithDetails("invalid password", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	// 4. Check uniqueness (the repository enforces it again with unique constraints)
+	if err := uc.ensureAvailable(ctx, email, username); err != nil {
+		return nil, err
+	}
+
+	// 5. Check the email verification code (consumed only once the address is available)
+	if uc.codes != nil {
+		err := uc.codes.Verify(ctx, verification.VerifyCommand{
+			Channel:   identity.VerificationChannelEmail.String(),
+			Recipient: email.String(),
+			Purpose:   identity.VerificationPurposeRegister.String(),
+			Code:      cmd.VerificationCode,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// 6. Create and save the user
+	user, err := identity.NewUser(email, username, password, uc.hasher)
+	if err != nil {
+		return nil, apperrors.NewInternalErrorWithCause("failed to create user", err)
+	}
+
+	if err := uc.userRepo.Save(ctx, user); err != nil {
+		return nil, err
+	}
+
+	// 7. Start a session
+	return startSession(ctx, uc.tokenRepo, uc.tokens, user)
+}
+
+// ensureAvailable returns a conflict error if the email or username is already taken.
+func (uc *RegisterUseCase) ensureAvailable(ctx context.Context, email identity.Email, username identity.Username) error {
+	_, err := uc.userRepo.FindByEmail(ctx, email)
+	if err == nil {
+		return apperrors.NewConflictError("email is already registered")
+	}
+	if !apperrors.IsNotFoundError(err) {
+		return apperrors.NewDatabaseErrorWithCause("failed to query user", err)
+	}
+
+	if username.IsZero() {
+		return nil
+	}
+
+	_, err = uc.userRepo.FindByUsername(ctx, username)
+	if err == nil {
+		return apperrors.NewConflictError("username is already taken")
+	}
+	if !apperrors.IsNotFoundError(err) {
+		return apperrors.NewDatabaseErrorWithCause("failed to query user", err)
+	}
+
+	return nil
+}
+
+// validateCommand validates the register command.
+func (uc *RegisterUseCase) validateCommand(cmd RegisterCommand) error {
+	if cmd.Email == "" {
+		return apperrors.NewValidationError("email is required")
+	}
+
+	if cmd.Password == "" {
+		return apperrors.NewValidationError("password is required")
+	}
+
+	if uc.codes != nil && cmd.VerificationCode == "" {
+		return apperrors.NewValidationError("verification code is required")
+	}
+
+	if cmd.ClientIP == "" {
+		return apperrors.NewValidationError("client IP is required for rate limiting")
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+// Package identity provides use cases for user registration, login and session management.
+package identity
+
+import (
+	"context"
+
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/domain/identity"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// startSession issues a refresh token in a new family, saves it, and returns the session.
+func startSession(
+	ctx context.Context,
+	tokenRepo identity.RefreshTokenRepository,
+	tokens TokenIssuer,
+	user *identity.User,
+) (*dto.AuthSessionDTO, error) {
+	refresh, raw, err := identity.IssueRefreshToken(user.ID(), tokens.RefreshTokenTTL())
+	if err != nil {
+		return nil, apperrors.NewInternalErrorWithCause("failed to issue refresh token", err)
+	}
+
+	if err := tokenRepo.Save(ctx, refresh); err != nil {
+		return nil, err
+	}
+
+	return newSessionDTO(tokens, user, refresh, raw)
+}
+
+// newSessionDTO issues an access token for the user and combines it with the refresh token.
+func newSessionDTO(tokens TokenIssuer, user *identity.User, refresh *identity.RefreshToken, rawRefresh string) (*dto.AuthSessionDTO, error) {
+	accessToken, accessExpiresAt, err := tokens.IssueAccessToken(user.ID().String())
+	if err != nil {
+		return nil, apperrors.NewInternalErrorWithCause("failed to issue access token", err)
+	}
+
+	return &dto.AuthSessionDTO{
+		UserID:                user.ID().String(),
+		Email:                 user.Email().String(),
+		Username:              user.Username().String(),
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  accessExpiresAt,
+		RefreshToken:          rawRefresh,
+		RefreshTokenExpiresAt: refresh.ExpiresAt(),
+	}, nil
+}
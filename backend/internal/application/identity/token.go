@@ -0,0 +1,19 @@
+// Package identity provides use cases for user registration, login and session management.
+package identity
+
+import "time"
+
+// TokenIssuer issues and verifies signed access tokens.
+// It is implemented by the Infrastructure Layer (JWT).
+type TokenIssuer interface {
+	// IssueAccessToken issues a short-lived access token for the user.
+	// Returns the token and its expiry time.
+	IssueAccessToken(userID string) (string, time.Time, error)
+
+	// ParseAccessToken verifies an access token and returns the user ID it was issued for.
+	// Returns an error if the token is malformed, has an invalid signature, or has expired.
+	ParseAccessToken(token string) (string, error)
+
+	// RefreshTokenTTL returns how long refresh tokens stay valid.
+	RefreshTokenTTL() time.Duration
+}
@@ -0,0 +1,52 @@
+// Package livefeed provides the interfaces for pushing newly published posts
+// to connected clients.
+// These interfaces are defined in Application Layer to follow Dependency Inversion Principle.
+package livefeed
+
+import (
+	"context"
+
+	"fuck_boss/backend/internal/application/dto"
+)
+
+// Event is a post published to the live feed.
+type Event struct {
+	// ID identifies the event. It is assigned when the post is published and
+	// is used to resume a feed (Server-Sent Events Last-Event-ID).
+	ID string
+
+	// Post is the published post (list form, without timeline).
+	Post *dto.PostDTO
+}
+
+// Publisher publishes newly published posts to all server instances.
+// Implementations are in Infrastructure Layer (e.g., Redis pub/sub).
+type Publisher interface {
+	// Publish sends a post to the subscribers of every server instance.
+	// Delivery is best effort: posts published while no instance is
+	// subscribed are lost, except for the recent events kept by History.
+	Publish(ctx context.Context, post *dto.PostDTO) error
+}
+
+// Subscriber delivers the posts published on any server instance.
+type Subscriber interface {
+	// Subscribe returns a channel that receives every event published after
+	// the call, in publishing order. The subscription ends when ctx is done.
+	// The channel is closed when the subscription ends; it is also closed early
+	// if the subscriber falls too far behind or the server is shutting down,
+	// in which case ctx is not done and the client should reconnect.
+	Subscribe(ctx context.Context) (<-chan *Event, error)
+}
+
+// History keeps the most recent events so clients can resume a feed after
+// a disconnect.
+type History interface {
+	// EventsAfter returns up to limit events published after the event with
+	// the given ID, oldest first. Events older than the retained history are
+	// skipped. It returns a VALIDATION_ERROR if the ID is malformed.
+	EventsAfter(ctx context.Context, lastEventID string, limit int) ([]*Event, error)
+
+	// Forget removes the retained events of a post, so resumed feeds no longer
+	// replay it. It is called when a post is hidden or removed.
+	Forget(ctx context.Context, postID string) error
+}
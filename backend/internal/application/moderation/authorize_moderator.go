@@ -0,0 +1,59 @@
+package moderation
+
+import (
+	"context"
+
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/domain/moderation"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// AuthorizeModeratorCommand represents the command to check a moderator's role
+// for a call.
+type AuthorizeModeratorCommand struct {
+	// UserID is the ID of the calling user (from the access token).
+	UserID string
+
+	// Permission is the permission the call requires (e.g. HIDE_POST).
+	Permission string
+}
+
+// AuthorizeModeratorUseCase checks that the calling user is a moderator whose
+// role grants a permission. It does not know the post a call acts on, so the
+// use cases check the moderator's cities again once they have loaded the post.
+type AuthorizeModeratorUseCase struct {
+	// repo is the Moderator repository.
+	repo moderation.ModeratorRepository
+}
+
+// NewAuthorizeModeratorUseCase creates a new AuthorizeModeratorUseCase instance.
+func NewAuthorizeModeratorUseCase(repo moderation.ModeratorRepository) *AuthorizeModeratorUseCase {
+	return &AuthorizeModeratorUseCase{
+		repo: repo,
+	}
+}
+
+// Execute returns the moderator if their role grants the permission.
+// A user who is not a moderator gets a FORBIDDEN error without a moderator; a
+// moderator whose role lacks the permission gets a FORBIDDEN error together with
+// the moderator, so the denied call can still be attributed in the audit log.
+func (uc *AuthorizeModeratorUseCase) Execute(ctx context.Context, cmd AuthorizeModeratorCommand) (*dto.ModeratorDTO, error) {
+	// 1. Validate input
+	permission, err := moderation.NewPermission(cmd.Permission)
+	if err != nil {
+		return nil, apperrors.NewInternalErrorWithCause("invalid permission for method", err)
+	}
+
+	// 2. Load the moderator
+	moderator, err := loadModerator(ctx, uc.repo, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Check the role
+	if !moderator.Can(permission) {
+		return toModeratorDTO(moderator), apperrors.NewForbiddenError("moderator role does not grant " + permission.String())
+	}
+
+	return toModeratorDTO(moderator), nil
+}
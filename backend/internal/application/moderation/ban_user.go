@@ -0,0 +1,172 @@
+package moderation
+
+import (
+	"context"
+	"errors"
+
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/domain/content"
+	"fuck_boss/backend/internal/domain/identity"
+	"fuck_boss/backend/internal/domain/moderation"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// BanUserCommand represents the command for a moderator to ban the author of a post.
+// Moderators never see who wrote a post, so they ban through the post.
+type BanUserCommand struct {
+	// ModeratorID is the user ID of the moderator (required).
+	ModeratorID string
+
+	// PostID is the ID of a post by the user to ban (required).
+	PostID string
+
+	// Reason explains the ban (required, up to 500 characters).
+	Reason string
+}
+
+// BanUserUseCase lets senior moderators ban the authors of posts from their
+// cities. Banned users can no longer log in or refresh their sessions; access
+// tokens they already hold keep working until they expire.
+type BanUserUseCase struct {
+	// moderatorRepo is the Moderator repository.
+	moderatorRepo moderation.ModeratorRepository
+
+	// postRepo finds the post whatever its moderation status.
+	postRepo content.PostModerationRepository
+
+	// userRepo is the User repository.
+	userRepo identity.UserRepository
+}
+
+// NewBanUserUseCase creates a new BanUserUseCase instance.
+func NewBanUserUseCase(
+	moderatorRepo moderation.ModeratorRepository,
+	postRepo content.PostModerationRepository,
+	userRepo identity.UserRepository,
+) *BanUserUseCase {
+	return &BanUserUseCase{
+		moderatorRepo: moderatorRepo,
+		postRepo:      postRepo,
+		userRepo:      userRepo,
+	}
+}
+
+// Execute executes the ban user command.
+func (uc *BanUserUseCase) Execute(ctx context.Context, cmd BanUserCommand) (*dto.UserBanDTO, error) {
+	// 1. Validate input
+	postID, err := content.NewPostID(cmd.PostID)
+	if err != nil {
+		return nil, apperrors.NewValidationErrorWithDetails("invalid post ID", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	// 2. Check the role before looking at the post
+	moderator, err := loadModerator(ctx, uc.moderatorRepo, cmd.ModeratorID)
+	if err != nil {
+		return nil, err
+	}
+	if !moderator.Can(moderation.PermissionBanUser) {
+		return nil, authorizationError(moderation.ErrPermissionDenied, moderation.PermissionBanUser, "")
+	}
+
+	// 3. Check the city of the post and find its author
+	post, err := uc.postRepo.FindByIDAnyStatus(ctx, postID)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to query post", err)
+	}
+	if err := moderator.Authorize(moderation.PermissionBanUser, post.City().Code()); err != nil {
+		return nil, authorizationError(err, moderation.PermissionBanUser, post.City().Code())
+	}
+	if post.AuthorID() == "" {
+		return nil, apperrors.NewValidationError("the post was published anonymously, its author cannot be banned")
+	}
+	if post.AuthorID() == moderator.UserID() {
+		return nil, apperrors.NewValidationError("moderators cannot ban themselves")
+	}
+
+	// 4. Moderators must lose their role before they can be banned
+	if _, err := uc.moderatorRepo.FindByUserID(ctx, post.AuthorID()); err == nil {
+		return nil, apperrors.NewForbiddenError("the author is a moderator and cannot be banned")
+	} else if !apperrors.IsNotFoundError(err) {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to query moderator", err)
+	}
+
+	// 5. Ban the author
+	authorID, err := identity.NewUserID(post.AuthorID())
+	if err != nil {
+		return nil, apperrors.NewInternalErrorWithCause("invalid post author ID", err)
+	}
+	user, err := uc.userRepo.FindByID(ctx, authorID)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to query user", err)
+	}
+
+	if err := user.Ban(cmd.Reason); err != nil {
+		if errors.Is(err, identity.ErrUserBanned) {
+			return nil, apperrors.NewConflictError("the author is already banned")
+		}
+		return nil, apperrors.NewValidationErrorWithDetails("invalid ban reason", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	if err := uc.userRepo.Save(ctx, user); err != nil {
+		return nil, err
+	}
+
+	return toUserBanDTO(user), nil
+}
+
+// UnbanUserCommand represents the command to lift the ban of a user.
+type UnbanUserCommand struct {
+	// Account is the user ID, email or username (required).
+	Account string
+}
+
+// UnbanUserUseCase lifts bans. It is an operator action of the admin
+// subcommands, so it is not limited by moderator roles.
+type UnbanUserUseCase struct {
+	// userRepo is the User repository.
+	userRepo identity.UserRepository
+}
+
+// NewUnbanUserUseCase creates a new UnbanUserUseCase instance.
+func NewUnbanUserUseCase(userRepo identity.UserRepository) *UnbanUserUseCase {
+	return &UnbanUserUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// Execute lifts the ban and returns the ID of the user.
+func (uc *UnbanUserUseCase) Execute(ctx context.Context, cmd UnbanUserCommand) (string, error) {
+	user, err := findUser(ctx, uc.userRepo, cmd.Account)
+	if err != nil {
+		return "", err
+	}
+
+	if err := user.Unban(); err != nil {
+		return "", apperrors.NewConflictError("the user is not banned")
+	}
+
+	if err := uc.userRepo.Save(ctx, user); err != nil {
+		return "", err
+	}
+
+	return user.ID().String(), nil
+}
+
+// toUserBanDTO converts the ban of a banned User to a UserBanDTO.
+func toUserBanDTO(user *identity.User) *dto.UserBanDTO {
+	return &dto.UserBanDTO{
+		UserID:   user.ID().String(),
+		Reason:   user.BanReason(),
+		BannedAt: *user.BannedAt(),
+	}
+}
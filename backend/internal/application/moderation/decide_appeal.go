@@ -0,0 +1,258 @@
+package moderation
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"fuck_boss/backend/internal/application/content"
+	"fuck_boss/backend/internal/application/dto"
+	domaincontent "fuck_boss/backend/internal/domain/content"
+	"fuck_boss/backend/internal/domain/moderation"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// AppealDecision is the decision a moderator takes on an appeal.
+type AppealDecision string
+
+const (
+	// AppealDecisionAccept accepts the appeal and restores the post.
+	AppealDecisionAccept AppealDecision = "ACCEPT"
+
+	// AppealDecisionReject rejects the appeal; the post stays hidden or removed.
+	AppealDecisionReject AppealDecision = "REJECT"
+)
+
+// ListAppealsQuery represents the query to list the pending appeals.
+type ListAppealsQuery struct {
+	// ModeratorID is the user ID of the moderator (required).
+	ModeratorID string
+
+	// CityCode limits the appeals to one of the moderator's cities (optional).
+	CityCode string
+
+	// Page is the page number (1-based, default: 1).
+	Page int
+
+	// PageSize is the number of items per page (default: 20, max: 100).
+	PageSize int
+}
+
+// ListAppealsUseCase lists the pending appeals a moderator may decide: appeals
+// of posts from their cities, except appeals of their own actions.
+type ListAppealsUseCase struct {
+	// moderatorRepo is the Moderator repository.
+	moderatorRepo moderation.ModeratorRepository
+
+	// appealRepo is the Appeal repository.
+	appealRepo moderation.AppealRepository
+
+	// postRepo finds the appealed posts whatever their moderation status.
+	postRepo domaincontent.PostModerationRepository
+}
+
+// NewListAppealsUseCase creates a new ListAppealsUseCase instance.
+func NewListAppealsUseCase(
+	moderatorRepo moderation.ModeratorRepository,
+	appealRepo moderation.AppealRepository,
+	postRepo domaincontent.PostModerationRepository,
+) *ListAppealsUseCase {
+	return &ListAppealsUseCase{
+		moderatorRepo: moderatorRepo,
+		appealRepo:    appealRepo,
+		postRepo:      postRepo,
+	}
+}
+
+// Execute executes the list appeals query.
+// Appeals are listed by the time they were filed, the longest-waiting first.
+func (uc *ListAppealsUseCase) Execute(ctx context.Context, query ListAppealsQuery) (*dto.AppealQueueDTO, error) {
+	// 1. Validate input
+	page := query.Page
+	if page < 1 {
+		page = 1
+	}
+	pageSize := query.PageSize
+	if pageSize < 1 {
+		pageSize = 20
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	// 2. Check the moderator and pick the cities
+	moderator, err := loadModerator(ctx, uc.moderatorRepo, query.ModeratorID)
+	if err != nil {
+		return nil, err
+	}
+
+	cityCodes := moderator.CityCodes()
+	if cityCode := strings.TrimSpace(query.CityCode); cityCode != "" {
+		if err := moderator.Authorize(moderation.PermissionDecideAppeal, cityCode); err != nil {
+			return nil, authorizationError(err, moderation.PermissionDecideAppeal, cityCode)
+		}
+		cityCodes = []string{cityCode}
+	} else if !moderator.Can(moderation.PermissionDecideAppeal) {
+		return nil, authorizationError(moderation.ErrPermissionDenied, moderation.PermissionDecideAppeal, "")
+	}
+
+	// 3. Find the pending appeals and their posts
+	appeals, total, err := uc.appealRepo.FindPending(ctx, moderator.UserID(), cityCodes, page, pageSize)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to query appeals", err)
+	}
+
+	items := make([]*dto.AppealQueueItemDTO, 0, len(appeals))
+	for _, appeal := range appeals {
+		post, err := uc.postRepo.FindByIDAnyStatus(ctx, appeal.PostID())
+		if err != nil {
+			return nil, apperrors.NewDatabaseErrorWithCause("failed to query appealed post", err)
+		}
+		items = append(items, &dto.AppealQueueItemDTO{
+			Appeal: toAppealDTO(appeal),
+			Post:   toModerationQueueItemDTO(post),
+		})
+	}
+
+	return &dto.AppealQueueDTO{
+		Items:    items,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	}, nil
+}
+
+// ModerationAnnouncer clears the caches that may contain a moderated post and
+// notifies its author once the moderation state is saved.
+// It is implemented by content.ModeratePostUseCase.
+type ModerationAnnouncer interface {
+	Announce(ctx context.Context, post *domaincontent.Post, action content.ModerationAction)
+}
+
+// DecideAppealCommand represents the command for a moderator to decide an appeal.
+type DecideAppealCommand struct {
+	// ModeratorID is the user ID of the moderator (required).
+	ModeratorID string
+
+	// AppealID is the ID of the appeal (required).
+	AppealID string
+
+	// Decision is ACCEPT or REJECT (required, case-insensitive).
+	Decision AppealDecision
+
+	// Reason explains the decision to the author (required, up to 500 characters).
+	Reason string
+}
+
+// DecideAppealUseCase lets moderators decide the appeals of posts from their
+// cities. Accepting an appeal restores the post in the same transaction as the
+// decision; the moderator who took the appealed action cannot decide it.
+type DecideAppealUseCase struct {
+	// moderatorRepo is the Moderator repository.
+	moderatorRepo moderation.ModeratorRepository
+
+	// appealRepo is the Appeal repository.
+	appealRepo moderation.AppealRepository
+
+	// postRepo finds the appealed post whatever its moderation status.
+	postRepo domaincontent.PostModerationRepository
+
+	// announcer clears the caches and notifies the author of restored posts.
+	announcer ModerationAnnouncer
+}
+
+// NewDecideAppealUseCase creates a new DecideAppealUseCase instance.
+func NewDecideAppealUseCase(
+	moderatorRepo moderation.ModeratorRepository,
+	appealRepo moderation.AppealRepository,
+	postRepo domaincontent.PostModerationRepository,
+	announcer ModerationAnnouncer,
+) *DecideAppealUseCase {
+	return &DecideAppealUseCase{
+		moderatorRepo: moderatorRepo,
+		appealRepo:    appealRepo,
+		postRepo:      postRepo,
+		announcer:     announcer,
+	}
+}
+
+// Execute executes the decide appeal command and returns the decided appeal.
+func (uc *DecideAppealUseCase) Execute(ctx context.Context, cmd DecideAppealCommand) (*dto.AppealDTO, error) {
+	// 1. Validate input
+	decision := AppealDecision(strings.ToUpper(strings.TrimSpace(string(cmd.Decision))))
+	if decision != AppealDecisionAccept && decision != AppealDecisionReject {
+		return nil, apperrors.NewValidationErrorWithDetails("invalid appeal decision", map[string]interface{}{
+			"decision": string(cmd.Decision),
+		})
+	}
+	if _, err := uuid.Parse(cmd.AppealID); err != nil {
+		return nil, apperrors.NewValidationError("invalid appeal ID")
+	}
+
+	// 2. Check the role before looking at the appeal
+	moderator, err := loadModerator(ctx, uc.moderatorRepo, cmd.ModeratorID)
+	if err != nil {
+		return nil, err
+	}
+	if !moderator.Can(moderation.PermissionDecideAppeal) {
+		return nil, authorizationError(moderation.ErrPermissionDenied, moderation.PermissionDecideAppeal, "")
+	}
+
+	// 3. Load the appeal and check the city of the post
+	appeal, err := uc.appealRepo.FindByID(ctx, cmd.AppealID)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to query appeal", err)
+	}
+
+	post, err := uc.postRepo.FindByIDAnyStatus(ctx, appeal.PostID())
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to query post", err)
+	}
+	if err := moderator.Authorize(moderation.PermissionDecideAppeal, post.City().Code()); err != nil {
+		return nil, authorizationError(err, moderation.PermissionDecideAppeal, post.City().Code())
+	}
+
+	// 4. Decide
+	if err := appeal.Decide(moderator.UserID(), decision == AppealDecisionAccept, cmd.Reason); err != nil {
+		switch {
+		case errors.Is(err, moderation.ErrAppealDecided):
+			return nil, apperrors.NewConflictError(err.Error())
+		case errors.Is(err, moderation.ErrOwnAction):
+			return nil, apperrors.NewForbiddenError(err.Error())
+		default:
+			return nil, apperrors.NewValidationErrorWithDetails("invalid decision reason", map[string]interface{}{
+				"error": err.Error(),
+			})
+		}
+	}
+
+	// 5. Restore the post of an accepted appeal (unless it was restored meanwhile)
+	var restored *domaincontent.Post
+	if decision == AppealDecisionAccept && !post.IsVisible() {
+		if err := post.Restore(moderator.UserID()); err != nil {
+			return nil, apperrors.NewInternalErrorWithCause("failed to restore post", err)
+		}
+		restored = post
+	}
+
+	// 6. Save the decision and the restored post together; the decision is only
+	// saved if the appeal is still pending, so concurrent decisions get a CONFLICT
+	if err := uc.appealRepo.SaveDecision(ctx, appeal, restored); err != nil {
+		return nil, err
+	}
+
+	// 7. Clear the caches and notify the author of the restored post
+	if restored != nil {
+		uc.announcer.Announce(ctx, restored, content.ModerationActionRestore)
+	}
+
+	return toAppealDTO(appeal), nil
+}
@@ -0,0 +1,180 @@
+package moderation
+
+import (
+	"context"
+	"errors"
+
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/domain/content"
+	"fuck_boss/backend/internal/domain/moderation"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// FileAppealCommand represents the command for an author to appeal the hiding
+// or removal of their post.
+type FileAppealCommand struct {
+	// PostID is the ID of the post (required).
+	PostID string
+
+	// ManagementToken is the token returned when the post was created (required).
+	ManagementToken string
+
+	// Statement explains why the post should be restored (required, up to 2000 characters).
+	Statement string
+}
+
+// FileAppealUseCase lets the holder of a post's management token appeal its
+// moderation. The appeal is queued for a moderator other than the one who acted.
+type FileAppealUseCase struct {
+	// postRepo finds the post whatever its moderation status.
+	postRepo content.PostModerationRepository
+
+	// appealRepo is the Appeal repository.
+	appealRepo moderation.AppealRepository
+}
+
+// NewFileAppealUseCase creates a new FileAppealUseCase instance.
+func NewFileAppealUseCase(
+	postRepo content.PostModerationRepository,
+	appealRepo moderation.AppealRepository,
+) *FileAppealUseCase {
+	return &FileAppealUseCase{
+		postRepo:   postRepo,
+		appealRepo: appealRepo,
+	}
+}
+
+// Execute executes the file appeal command and returns the pending appeal.
+// Each moderation action can be appealed once.
+func (uc *FileAppealUseCase) Execute(ctx context.Context, cmd FileAppealCommand) (*dto.AppealDTO, error) {
+	// 1. Validate input
+	if cmd.Statement == "" {
+		return nil, apperrors.NewValidationError("statement is required")
+	}
+
+	// 2. Load the post and verify the management token
+	post, err := findAuthorPost(ctx, uc.postRepo, cmd.PostID, cmd.ManagementToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. File the appeal (one per moderation action)
+	latest, err := uc.appealRepo.FindLatestByPostID(ctx, post.ID())
+	if err != nil {
+		if !apperrors.IsNotFoundError(err) {
+			return nil, apperrors.NewDatabaseErrorWithCause("failed to query appeal", err)
+		}
+		latest = nil
+	}
+
+	appeal, err := moderation.NewAppeal(post, cmd.Statement, latest)
+	if err != nil {
+		if errors.Is(err, moderation.ErrPostNotModerated) || errors.Is(err, moderation.ErrAlreadyAppealed) {
+			return nil, apperrors.NewConflictError(err.Error())
+		}
+		return nil, apperrors.NewValidationErrorWithDetails("invalid appeal", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	// 4. Save the appeal
+	if err := uc.appealRepo.Save(ctx, appeal); err != nil {
+		return nil, err
+	}
+
+	return toAppealDTO(appeal), nil
+}
+
+// GetAppealStatusQuery represents the query for an author to look up the
+// moderation of their post and the outcome of their appeal.
+type GetAppealStatusQuery struct {
+	// PostID is the ID of the post (required).
+	PostID string
+
+	// ManagementToken is the token returned when the post was created (required).
+	ManagementToken string
+}
+
+// GetAppealStatusUseCase shows the holder of a post's management token why the
+// post was moderated and how the latest appeal was decided. Moderator IDs are
+// part of the result but must not be shown to authors.
+type GetAppealStatusUseCase struct {
+	// postRepo finds the post whatever its moderation status.
+	postRepo content.PostModerationRepository
+
+	// appealRepo is the Appeal repository.
+	appealRepo moderation.AppealRepository
+}
+
+// NewGetAppealStatusUseCase creates a new GetAppealStatusUseCase instance.
+func NewGetAppealStatusUseCase(
+	postRepo content.PostModerationRepository,
+	appealRepo moderation.AppealRepository,
+) *GetAppealStatusUseCase {
+	return &GetAppealStatusUseCase{
+		postRepo:   postRepo,
+		appealRepo: appealRepo,
+	}
+}
+
+// Execute executes the get appeal status query.
+func (uc *GetAppealStatusUseCase) Execute(ctx context.Context, query GetAppealStatusQuery) (*dto.AppealStatusDTO, error) {
+	// 1. Load the post and verify the management token
+	post, err := findAuthorPost(ctx, uc.postRepo, query.PostID, query.ManagementToken)
+	if err != nil {
+		return nil, err
+	}
+
+	state := post.Moderation()
+	result := &dto.AppealStatusDTO{
+		PostID:      post.ID().String(),
+		Status:      state.Status.String(),
+		Reason:      state.Reason.String(),
+		ModeratedAt: state.ModeratedAt,
+	}
+
+	// 2. Find the latest appeal (posts that were never appealed have none)
+	appeal, err := uc.appealRepo.FindLatestByPostID(ctx, post.ID())
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return result, nil
+		}
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to query appeal", err)
+	}
+	result.Appeal = toAppealDTO(appeal)
+
+	return result, nil
+}
+
+// findAuthorPost loads a post whatever its moderation status and verifies that
+// the management token proves authorship.
+// Returns a VALIDATION_ERROR for missing input, NOT_FOUND, or FORBIDDEN if the
+// token does not match.
+func findAuthorPost(ctx context.Context, repo content.PostModerationRepository, postID, managementToken string) (*content.Post, error) {
+	if postID == "" {
+		return nil, apperrors.NewValidationError("post ID is required")
+	}
+	if managementToken == "" {
+		return nil, apperrors.NewValidationError("management token is required")
+	}
+
+	id, err := content.NewPostID(postID)
+	if err != nil {
+		return nil, apperrors.NewValidationErrorWithDetails("invalid post ID", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	post, err := repo.FindByIDAnyStatus(ctx, id)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to query post", err)
+	}
+
+	if !post.VerifyManagementToken(managementToken) {
+		return nil, apperrors.NewForbiddenError("management token does not match post")
+	}
+	return post, nil
+}
@@ -0,0 +1,98 @@
+package moderation
+
+import (
+	"context"
+	"strings"
+
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/domain/content"
+	"fuck_boss/backend/internal/domain/moderation"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// ListModerationQueueQuery represents the query to list the posts pending review.
+type ListModerationQueueQuery struct {
+	// ModeratorID is the user ID of the moderator (required).
+	ModeratorID string
+
+	// CityCode limits the queue to one of the moderator's cities (optional).
+	CityCode string
+
+	// Page is the page number (1-based, default: 1).
+	Page int
+
+	// PageSize is the number of items per page (default: 20, max: 100).
+	PageSize int
+}
+
+// ListModerationQueueUseCase lists hidden posts waiting for a moderator's
+// decision. Regional moderators only see posts from their own cities.
+type ListModerationQueueUseCase struct {
+	// moderatorRepo is the Moderator repository.
+	moderatorRepo moderation.ModeratorRepository
+
+	// queueRepo finds posts by moderation status.
+	queueRepo content.ModerationQueueRepository
+}
+
+// NewListModerationQueueUseCase creates a new ListModerationQueueUseCase instance.
+func NewListModerationQueueUseCase(
+	moderatorRepo moderation.ModeratorRepository,
+	queueRepo content.ModerationQueueRepository,
+) *ListModerationQueueUseCase {
+	return &ListModerationQueueUseCase{
+		moderatorRepo: moderatorRepo,
+		queueRepo:     queueRepo,
+	}
+}
+
+// Execute executes the list moderation queue query.
+// Posts are listed by the time they were hidden, the longest-waiting first.
+func (uc *ListModerationQueueUseCase) Execute(ctx context.Context, query ListModerationQueueQuery) (*dto.ModerationQueueDTO, error) {
+	// 1. Validate input
+	page := query.Page
+	if page < 1 {
+		page = 1
+	}
+	pageSize := query.PageSize
+	if pageSize < 1 {
+		pageSize = 20
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	// 2. Check the moderator and pick the cities
+	moderator, err := loadModerator(ctx, uc.moderatorRepo, query.ModeratorID)
+	if err != nil {
+		return nil, err
+	}
+
+	cityCodes := moderator.CityCodes()
+	if cityCode := strings.TrimSpace(query.CityCode); cityCode != "" {
+		if err := moderator.Authorize(moderation.PermissionViewQueue, cityCode); err != nil {
+			return nil, authorizationError(err, moderation.PermissionViewQueue, cityCode)
+		}
+		cityCodes = []string{cityCode}
+	} else if !moderator.Can(moderation.PermissionViewQueue) {
+		return nil, authorizationError(moderation.ErrPermissionDenied, moderation.PermissionViewQueue, "")
+	}
+
+	// 3. Find the hidden posts
+	posts, total, err := uc.queueRepo.FindByModerationStatus(ctx, content.ModerationStatusHidden, cityCodes, page, pageSize)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to query moderation queue", err)
+	}
+
+	items := make([]*dto.ModerationQueueItemDTO, 0, len(posts))
+	for _, post := range posts {
+		items = append(items, toModerationQueueItemDTO(post))
+	}
+
+	return &dto.ModerationQueueDTO{
+		Items:    items,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	}, nil
+}
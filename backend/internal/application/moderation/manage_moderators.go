@@ -0,0 +1,151 @@
+package moderation
+
+import (
+	"context"
+
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/domain/identity"
+	"fuck_boss/backend/internal/domain/moderation"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// AssignModeratorCommand represents the command to make a user a moderator or
+// to change a moderator's role and cities.
+type AssignModeratorCommand struct {
+	// Account is the user ID, email or username (required).
+	Account string
+
+	// Role is JUNIOR or SENIOR (required, case-insensitive).
+	Role string
+
+	// CityCodes are the assigned cities (empty for all cities).
+	CityCodes []string
+}
+
+// AssignModeratorUseCase makes registered users moderators. It is an operator
+// action of the admin subcommands.
+type AssignModeratorUseCase struct {
+	// moderatorRepo is the Moderator repository.
+	moderatorRepo moderation.ModeratorRepository
+
+	// userRepo is the User repository.
+	userRepo identity.UserRepository
+}
+
+// NewAssignModeratorUseCase creates a new AssignModeratorUseCase instance.
+func NewAssignModeratorUseCase(moderatorRepo moderation.ModeratorRepository, userRepo identity.UserRepository) *AssignModeratorUseCase {
+	return &AssignModeratorUseCase{
+		moderatorRepo: moderatorRepo,
+		userRepo:      userRepo,
+	}
+}
+
+// Execute assigns the role and cities and returns the moderator. Assigning an
+// existing moderator replaces their role and cities.
+func (uc *AssignModeratorUseCase) Execute(ctx context.Context, cmd AssignModeratorCommand) (*dto.ModeratorDTO, error) {
+	// 1. Validate input
+	role, err := moderation.NewRole(cmd.Role)
+	if err != nil {
+		return nil, apperrors.NewValidationErrorWithDetails("invalid moderator role", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	// 2. Find the user
+	user, err := findUser(ctx, uc.userRepo, cmd.Account)
+	if err != nil {
+		return nil, err
+	}
+	if user.IsBanned() {
+		return nil, apperrors.NewConflictError("a banned user cannot be a moderator")
+	}
+
+	// 3. Create or update the moderator
+	moderator, err := uc.moderatorRepo.FindByUserID(ctx, user.ID().String())
+	switch {
+	case err == nil:
+		err = moderator.Assign(role, cmd.CityCodes)
+	case apperrors.IsNotFoundError(err):
+		moderator, err = moderation.NewModerator(user.ID().String(), role, cmd.CityCodes)
+	default:
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to query moderator", err)
+	}
+	if err != nil {
+		return nil, apperrors.NewValidationErrorWithDetails("invalid moderator cities", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	if err := uc.moderatorRepo.Save(ctx, moderator); err != nil {
+		return nil, err
+	}
+
+	return toModeratorDTO(moderator), nil
+}
+
+// RemoveModeratorCommand represents the command to take the moderator role
+// away from a user.
+type RemoveModeratorCommand struct {
+	// Account is the user ID, email or username (required).
+	Account string
+}
+
+// RemoveModeratorUseCase takes the moderator role away from users. Their user
+// accounts are kept.
+type RemoveModeratorUseCase struct {
+	// moderatorRepo is the Moderator repository.
+	moderatorRepo moderation.ModeratorRepository
+
+	// userRepo is the User repository.
+	userRepo identity.UserRepository
+}
+
+// NewRemoveModeratorUseCase creates a new RemoveModeratorUseCase instance.
+func NewRemoveModeratorUseCase(moderatorRepo moderation.ModeratorRepository, userRepo identity.UserRepository) *RemoveModeratorUseCase {
+	return &RemoveModeratorUseCase{
+		moderatorRepo: moderatorRepo,
+		userRepo:      userRepo,
+	}
+}
+
+// Execute removes the moderator role and returns the ID of the user.
+// Returns a NOT_FOUND error if the user is not a moderator.
+func (uc *RemoveModeratorUseCase) Execute(ctx context.Context, cmd RemoveModeratorCommand) (string, error) {
+	user, err := findUser(ctx, uc.userRepo, cmd.Account)
+	if err != nil {
+		return "", err
+	}
+
+	if err := uc.moderatorRepo.Delete(ctx, user.ID().String()); err != nil {
+		return "", err
+	}
+
+	return user.ID().String(), nil
+}
+
+// ListModeratorsUseCase lists all moderators.
+type ListModeratorsUseCase struct {
+	// moderatorRepo is the Moderator repository.
+	moderatorRepo moderation.ModeratorRepository
+}
+
+// NewListModeratorsUseCase creates a new ListModeratorsUseCase instance.
+func NewListModeratorsUseCase(moderatorRepo moderation.ModeratorRepository) *ListModeratorsUseCase {
+	return &ListModeratorsUseCase{
+		moderatorRepo: moderatorRepo,
+	}
+}
+
+// Execute returns all moderators, the most recently assigned first.
+func (uc *ListModeratorsUseCase) Execute(ctx context.Context) ([]*dto.ModeratorDTO, error) {
+	moderators, err := uc.moderatorRepo.FindAll(ctx)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to query moderators", err)
+	}
+
+	result := make([]*dto.ModeratorDTO, 0, len(moderators))
+	for _, moderator := range moderators {
+		result = append(result, toModeratorDTO(moderator))
+	}
+	return result, nil
+}
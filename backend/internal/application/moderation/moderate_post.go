@@ -0,0 +1,117 @@
+package moderation
+
+import (
+	"context"
+	"strings"
+
+	"fuck_boss/backend/internal/application/content"
+	"fuck_boss/backend/internal/application/dto"
+	domaincontent "fuck_boss/backend/internal/domain/content"
+	"fuck_boss/backend/internal/domain/moderation"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// actionPermissions maps moderation actions to the permission they require.
+var actionPermissions = map[content.ModerationAction]moderation.Permission{
+	content.ModerationActionHide:    moderation.PermissionHidePost,
+	content.ModerationActionRemove:  moderation.PermissionRemovePost,
+	content.ModerationActionRestore: moderation.PermissionRestorePost,
+}
+
+// PostModerator applies a moderation action to a post.
+// It is implemented by content.ModeratePostUseCase.
+type PostModerator interface {
+	Execute(ctx context.Context, cmd content.ModeratePostCommand) (*dto.PostModerationDTO, error)
+}
+
+// ModeratePostCommand represents the command for a moderator to hide, remove
+// or restore a post.
+type ModeratePostCommand struct {
+	// ModeratorID is the user ID of the moderator (required).
+	ModeratorID string
+
+	// PostID is the ID of the post (required).
+	PostID string
+
+	// Action is HIDE, REMOVE or RESTORE (required, case-insensitive).
+	Action content.ModerationAction
+
+	// Reason explains the action (required to hide or remove, up to 500 characters).
+	Reason string
+}
+
+// ModeratePostUseCase lets moderators act on posts within their role and
+// cities: junior moderators may only hide posts for review, senior moderators
+// may also remove and restore them. The action itself is applied by
+// content.ModeratePostUseCase and records the moderator.
+type ModeratePostUseCase struct {
+	// moderatorRepo is the Moderator repository.
+	moderatorRepo moderation.ModeratorRepository
+
+	// postRepo finds the post whatever its moderation status, to check its city.
+	postRepo domaincontent.PostModerationRepository
+
+	// moderator applies the action.
+	moderator PostModerator
+}
+
+// NewModeratePostUseCase creates a new ModeratePostUseCase instance.
+func NewModeratePostUseCase(
+	moderatorRepo moderation.ModeratorRepository,
+	postRepo domaincontent.PostModerationRepository,
+	moderator PostModerator,
+) *ModeratePostUseCase {
+	return &ModeratePostUseCase{
+		moderatorRepo: moderatorRepo,
+		postRepo:      postRepo,
+		moderator:     moderator,
+	}
+}
+
+// Execute executes the moderate post command and returns the new moderation state.
+func (uc *ModeratePostUseCase) Execute(ctx context.Context, cmd ModeratePostCommand) (*dto.PostModerationDTO, error) {
+	// 1. Validate input
+	action := content.ModerationAction(strings.ToUpper(strings.TrimSpace(string(cmd.Action))))
+	permission, ok := actionPermissions[action]
+	if !ok {
+		return nil, apperrors.NewValidationErrorWithDetails("invalid moderation action", map[string]interface{}{
+			"action": string(cmd.Action),
+		})
+	}
+
+	postID, err := domaincontent.NewPostID(cmd.PostID)
+	if err != nil {
+		return nil, apperrors.NewValidationErrorWithDetails("invalid post ID", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	// 2. Check the role before looking at the post
+	moderator, err := loadModerator(ctx, uc.moderatorRepo, cmd.ModeratorID)
+	if err != nil {
+		return nil, err
+	}
+	if !moderator.Can(permission) {
+		return nil, authorizationError(moderation.ErrPermissionDenied, permission, "")
+	}
+
+	// 3. Check the city of the post
+	post, err := uc.postRepo.FindByIDAnyStatus(ctx, postID)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to query post", err)
+	}
+	if err := moderator.Authorize(permission, post.City().Code()); err != nil {
+		return nil, authorizationError(err, permission, post.City().Code())
+	}
+
+	// 4. Apply the action on behalf of the moderator
+	return uc.moderator.Execute(ctx, content.ModeratePostCommand{
+		PostID:      cmd.PostID,
+		Action:      action,
+		Reason:      cmd.Reason,
+		ModeratorID: moderator.UserID(),
+	})
+}
@@ -0,0 +1,134 @@
+// Package moderation provides use cases for volunteer moderators: checking their
+// role and city permissions, reviewing the moderation queue, moderating posts
+// and banning authors, deciding appeals, and managing moderator assignments.
+// Authors file appeals and look up their outcome with the post's management token.
+package moderation
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/domain/content"
+	"fuck_boss/backend/internal/domain/identity"
+	"fuck_boss/backend/internal/domain/moderation"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// loadModerator finds the moderator of the calling user.
+// Returns an UNAUTHENTICATED error without a user, and a FORBIDDEN error if the
+// user is not a moderator.
+func loadModerator(ctx context.Context, repo moderation.ModeratorRepository, userID string) (*moderation.Moderator, error) {
+	if userID == "" {
+		return nil, apperrors.NewUnauthenticatedError("login required")
+	}
+	if _, err := uuid.Parse(userID); err != nil {
+		return nil, apperrors.NewUnauthenticatedError("invalid user ID")
+	}
+
+	moderator, err := repo.FindByUserID(ctx, userID)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, apperrors.NewForbiddenError("user is not a moderator")
+		}
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to query moderator", err)
+	}
+	return moderator, nil
+}
+
+// authorizationError converts an error of Moderator.Authorize to a FORBIDDEN error.
+func authorizationError(err error, permission moderation.Permission, cityCode string) error {
+	if errors.Is(err, moderation.ErrCityNotAssigned) {
+		return apperrors.NewForbiddenError("moderator is not assigned to city " + cityCode)
+	}
+	return apperrors.NewForbiddenError("moderator role does not grant " + permission.String())
+}
+
+// findUser finds a user account by ID, email (if it contains "@") or username.
+// Malformed accounts are reported as not found.
+func findUser(ctx context.Context, repo identity.UserRepository, account string) (*identity.User, error) {
+	account = strings.TrimSpace(account)
+	if account == "" {
+		return nil, apperrors.NewValidationError("account is required")
+	}
+
+	var (
+		user *identity.User
+		err  error
+	)
+	if id, parseErr := identity.NewUserID(account); parseErr == nil {
+		user, err = repo.FindByID(ctx, id)
+	} else if strings.Contains(account, "@") {
+		email, parseErr := identity.NewEmail(account)
+		if parseErr != nil {
+			return nil, apperrors.NewNotFoundError("user")
+		}
+		user, err = repo.FindByEmail(ctx, email)
+	} else {
+		username, parseErr := identity.NewUsername(account)
+		if parseErr != nil || username.IsZero() {
+			return nil, apperrors.NewNotFoundError("user")
+		}
+		user, err = repo.FindByUsername(ctx, username)
+	}
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to query user", err)
+	}
+	return user, nil
+}
+
+// toModeratorDTO converts a Moderator to a ModeratorDTO.
+func toModeratorDTO(moderator *moderation.Moderator) *dto.ModeratorDTO {
+	permissions := make([]string, 0, len(moderation.Permissions))
+	for _, permission := range moderator.Role().Permissions() {
+		permissions = append(permissions, permission.String())
+	}
+
+	return &dto.ModeratorDTO{
+		UserID:      moderator.UserID(),
+		Role:        moderator.Role().String(),
+		Permissions: permissions,
+		CityCodes:   append([]string(nil), moderator.CityCodes()...),
+		AssignedAt:  moderator.AssignedAt(),
+		UpdatedAt:   moderator.UpdatedAt(),
+	}
+}
+
+// toModerationQueueItemDTO converts a moderated Post to a ModerationQueueItemDTO.
+func toModerationQueueItemDTO(post *content.Post) *dto.ModerationQueueItemDTO {
+	state := post.Moderation()
+	return &dto.ModerationQueueItemDTO{
+		PostID:      post.ID().String(),
+		Company:     post.Company().String(),
+		CityCode:    post.City().Code(),
+		CityName:    post.City().Name(),
+		Content:     post.Content().String(),
+		CreatedAt:   post.CreatedAt(),
+		Status:      state.Status.String(),
+		Reason:      state.Reason.String(),
+		ModeratedAt: state.ModeratedAt,
+		ModeratedBy: state.ModeratedBy,
+	}
+}
+
+// toAppealDTO converts an Appeal to an AppealDTO.
+func toAppealDTO(appeal *moderation.Appeal) *dto.AppealDTO {
+	return &dto.AppealDTO{
+		ID:             appeal.ID(),
+		PostID:         appeal.PostID().String(),
+		Status:         appeal.Status().String(),
+		AppealedStatus: appeal.AppealedStatus().String(),
+		Statement:      appeal.Statement(),
+		ActedBy:        appeal.ActedBy(),
+		DecidedBy:      appeal.DecidedBy(),
+		DecisionReason: appeal.DecisionReason(),
+		FiledAt:        appeal.FiledAt(),
+		DecidedAt:      appeal.DecidedAt(),
+	}
+}
@@ -0,0 +1,134 @@
+package notification
+
+import (
+	"context"
+
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/domain/identity"
+	"fuck_boss/backend/internal/domain/notification"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// ListNotificationsQuery represents the query parameters for listing notifications.
+type ListNotificationsQuery struct {
+	// UserID is the logged-in user (required, taken from the access token).
+	UserID string
+
+	// Cursor is the NextCursor of the previous page (optional, empty for the first page).
+	Cursor string
+
+	// PageSize is the number of items per page (default: 20, max: 100).
+	PageSize int
+
+	// UnreadOnly restricts results to unread notifications.
+	UnreadOnly bool
+
+	// Type restricts results to a single type (optional).
+	Type string
+}
+
+// ListNotificationsUseCase lists the user's notifications, newest first, with cursor paging.
+type ListNotificationsUseCase struct {
+	// repo is the Notification repository.
+	repo notification.NotificationRepository
+}
+
+// NewListNotificationsUseCase creates a new ListNotificationsUseCase instance.
+func NewListNotificationsUseCase(repo notification.NotificationRepository) *ListNotificationsUseCase {
+	return &ListNotificationsUseCase{
+		repo: repo,
+	}
+}
+
+// Execute executes the list notifications query.
+// The result includes the unread count, so clients can update the badge with one call.
+func (uc *ListNotificationsUseCase) Execute(ctx context.Context, query ListNotificationsQuery) (*dto.NotificationsListDTO, error) {
+	// 1. Validate input
+	recipientID, err := parseRecipient(query.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := query.PageSize
+	if pageSize < 1 {
+		pageSize = 20
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	filter := notification.Filter{UnreadOnly: query.UnreadOnly}
+	if query.Type != "" {
+		kind, err := notification.NewType(query.Type)
+		if err != nil {
+			return nil, apperrors.NewValidationErrorWithDetails("invalid notification type", map[string]interface{}{
+				"error": err.Error(),
+			})
+		}
+		filter.Type = &kind
+	}
+
+	var after *notification.Cursor
+	if query.Cursor != "" {
+		cursor, err := notification.ParseCursor(query.Cursor)
+		if err != nil {
+			return nil, apperrors.NewValidationErrorWithDetails("invalid cursor", map[string]interface{}{
+				"error": err.Error(),
+			})
+		}
+		after = &cursor
+	}
+
+	// 2. Query one extra notification to know whether there is a next page
+	notifications, err := uc.repo.FindByRecipient(ctx, recipientID, filter, after, pageSize+1)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to query notifications", err)
+	}
+
+	result := &dto.NotificationsListDTO{
+		Notifications: make([]*dto.NotificationDTO, 0, len(notifications)),
+	}
+	if len(notifications) > pageSize {
+		notifications = notifications[:pageSize]
+		result.NextCursor = notification.CursorAfter(notifications[pageSize-1]).String()
+	}
+	for _, n := range notifications {
+		result.Notifications = append(result.Notifications, toNotificationDTO(n))
+	}
+
+	// 3. Count unread notifications
+	result.UnreadCount, err = uc.repo.CountUnread(ctx, recipientID)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to count unread notifications", err)
+	}
+
+	return result, nil
+}
+
+// parseRecipient validates the logged-in user ID.
+// Returns an UNAUTHENTICATED error for anonymous requests.
+func parseRecipient(userID string) (identity.UserID, error) {
+	if userID == "" {
+		return identity.UserID{}, apperrors.NewUnauthenticatedError("login required")
+	}
+
+	recipientID, err := identity.NewUserID(userID)
+	if err != nil {
+		return identity.UserID{}, apperrors.NewUnauthenticatedError("invalid user")
+	}
+
+	return recipientID, nil
+}
+
+// toNotificationDTO converts a Notification entity to a NotificationDTO.
+func toNotificationDTO(n *notification.Notification) *dto.NotificationDTO {
+	return &dto.NotificationDTO{
+		ID:        n.ID(),
+		Type:      n.Type().String(),
+		PostID:    n.PostID().String(),
+		Subject:   n.Subject(),
+		Excerpt:   n.Excerpt(),
+		CreatedAt: n.CreatedAt(),
+		ReadAt:    n.ReadAt(),
+	}
+}
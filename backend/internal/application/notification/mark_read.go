@@ -0,0 +1,117 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"fuck_boss/backend/internal/domain/notification"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// CountUnreadQuery represents the query for the unread notification count.
+type CountUnreadQuery struct {
+	// UserID is the logged-in user (required, taken from the access token).
+	UserID string
+}
+
+// CountUnreadUseCase returns the number of unread notifications.
+type CountUnreadUseCase struct {
+	// repo is the Notification repository.
+	repo notification.NotificationRepository
+}
+
+// NewCountUnreadUseCase creates a new CountUnreadUseCase instance.
+func NewCountUnreadUseCase(repo notification.NotificationRepository) *CountUnreadUseCase {
+	return &CountUnreadUseCase{
+		repo: repo,
+	}
+}
+
+// Execute executes the count unread query.
+func (uc *CountUnreadUseCase) Execute(ctx context.Context, query CountUnreadQuery) (int, error) {
+	recipientID, err := parseRecipient(query.UserID)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := uc.repo.CountUnread(ctx, recipientID)
+	if err != nil {
+		return 0, apperrors.NewDatabaseErrorWithCause("failed to count unread notifications", err)
+	}
+
+	return count, nil
+}
+
+// MarkReadCommand represents the command to mark a notification as read.
+type MarkReadCommand struct {
+	// UserID is the logged-in user (required, taken from the access token).
+	UserID string
+
+	// NotificationID is the notification to mark (required).
+	NotificationID string
+}
+
+// MarkReadUseCase marks one of the user's notifications as read.
+type MarkReadUseCase struct {
+	// repo is the Notification repository.
+	repo notification.NotificationRepository
+}
+
+// NewMarkReadUseCase creates a new MarkReadUseCase instance.
+func NewMarkReadUseCase(repo notification.NotificationRepository) *MarkReadUseCase {
+	return &MarkReadUseCase{
+		repo: repo,
+	}
+}
+
+// Execute executes the mark read command.
+// Notifications of other users are reported as not found.
+func (uc *MarkReadUseCase) Execute(ctx context.Context, cmd MarkReadCommand) error {
+	recipientID, err := parseRecipient(cmd.UserID)
+	if err != nil {
+		return err
+	}
+
+	if cmd.NotificationID == "" {
+		return apperrors.NewValidationError("notification ID is required")
+	}
+	if _, err := uuid.Parse(cmd.NotificationID); err != nil {
+		return apperrors.NewValidationErrorWithDetails("invalid notification ID", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	return uc.repo.MarkRead(ctx, recipientID, cmd.NotificationID, time.Now())
+}
+
+// MarkAllReadCommand represents the command to mark all notifications as read.
+type MarkAllReadCommand struct {
+	// UserID is the logged-in user (required, taken from the access token).
+	UserID string
+}
+
+// MarkAllReadUseCase marks all of the user's notifications as read.
+type MarkAllReadUseCase struct {
+	// repo is the Notification repository.
+	repo notification.NotificationRepository
+}
+
+// NewMarkAllReadUseCase creates a new MarkAllReadUseCase instance.
+func NewMarkAllReadUseCase(repo notification.NotificationRepository) *MarkAllReadUseCase {
+	return &MarkAllReadUseCase{
+		repo: repo,
+	}
+}
+
+// Execute executes the mark all read command.
+// It returns the number of notifications that were unread.
+func (uc *MarkAllReadUseCase) Execute(ctx context.Context, cmd MarkAllReadCommand) (int, error) {
+	recipientID, err := parseRecipient(cmd.UserID)
+	if err != nil {
+		return 0, err
+	}
+
+	return uc.repo.MarkAllRead(ctx, recipientID, time.Now())
+}
@@ -0,0 +1,107 @@
+// Package notification provides use cases for the in-app notification center.
+package notification
+
+import (
+	"context"
+
+	"fuck_boss/backend/internal/domain/content"
+	"fuck_boss/backend/internal/domain/identity"
+	"fuck_boss/backend/internal/domain/notification"
+	"fuck_boss/backend/internal/domain/watchlist"
+)
+
+// Notifier fans out events to the notification centers of the users they concern.
+// It is called by the use cases that produce the events; callers treat its errors
+// as non-fatal, so a failed notification never fails the original operation.
+type Notifier struct {
+	// repo is the Notification repository.
+	repo notification.NotificationRepository
+
+	// watches is the CompanyWatch repository, used to find the watchers of a company.
+	watches watchlist.WatchRepository
+}
+
+// NewNotifier creates a new Notifier instance.
+func NewNotifier(
+	repo notification.NotificationRepository,
+	watches watchlist.WatchRepository,
+) *Notifier {
+	return &Notifier{
+		repo:    repo,
+		watches: watches,
+	}
+}
+
+// NotifyWatchedCompanyPost notifies the users watching the post's company about a new post.
+// The author of the post is not notified about their own post.
+func (n *Notifier) NotifyWatchedCompanyPost(ctx context.Context, post *content.Post) error {
+	userIDs, err := n.watches.FindUserWatchers(ctx, post.Company())
+	if err != nil {
+		return err
+	}
+
+	notifications := make([]*notification.Notification, 0, len(userIDs))
+	for _, userID := range userIDs {
+		if userID == post.AuthorID() {
+			continue
+		}
+		recipientID, err := identity.NewUserID(userID)
+		if err != nil {
+			continue
+		}
+		notifications = append(notifications, notification.NewNotification(
+			recipientID,
+			notification.TypeWatchedCompanyPost,
+			post.ID(),
+			post.Company().String(),
+			post.Content().Summary(),
+		))
+	}
+
+	return n.repo.SaveAll(ctx, notifications)
+}
+
+// NotifyCompanyReply notifies the author of a post that the company replied officially.
+// Anonymous posts have no one to notify.
+func (n *Notifier) NotifyCompanyReply(ctx context.Context, post *content.Post, reply *content.OfficialReply) error {
+	return n.notifyAuthor(ctx, post, notification.TypeCompanyReply, post.Company().String(), reply.Content().String())
+}
+
+// NotifyModerationOutcome notifies the author of a post that a moderator acted on it.
+// The outcome (e.g. HIDDEN, REMOVED, RESTORED) becomes the subject and the reason the excerpt.
+func (n *Notifier) NotifyModerationOutcome(ctx context.Context, post *content.Post, outcome, reason string) error {
+	return n.notifyAuthor(ctx, post, notification.TypeModerationOutcome, outcome, reason)
+}
+
+// NotifyCommentReply notifies the author of a comment that someone replied to it.
+// The replier is not notified about replies to their own comment.
+func (n *Notifier) NotifyCommentReply(ctx context.Context, commentAuthorID, replierID string, postID content.PostID, excerpt string) error {
+	if commentAuthorID == "" || commentAuthorID == replierID {
+		return nil
+	}
+
+	recipientID, err := identity.NewUserID(commentAuthorID)
+	if err != nil {
+		return nil
+	}
+
+	return n.repo.SaveAll(ctx, []*notification.Notification{
+		notification.NewNotification(recipientID, notification.TypeCommentReply, postID, "", excerpt),
+	})
+}
+
+// notifyAuthor stores a single notification for the author of a post.
+func (n *Notifier) notifyAuthor(ctx context.Context, post *content.Post, kind notification.Type, subject, excerpt string) error {
+	if post.AuthorID() == "" {
+		return nil
+	}
+
+	recipientID, err := identity.NewUserID(post.AuthorID())
+	if err != nil {
+		return nil
+	}
+
+	return n.repo.SaveAll(ctx, []*notification.Notification{
+		notification.NewNotification(recipientID, kind, post.ID(), subject, excerpt),
+	})
+}
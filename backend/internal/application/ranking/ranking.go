@@ -0,0 +1,43 @@
+// Package ranking provides the view counter and ranking store interfaces for application layer.
+// These interfaces are defined in Application Layer to follow Dependency Inversion Principle.
+package ranking
+
+import (
+	"context"
+	"time"
+)
+
+// ViewCounter counts unique viewers of posts per UTC day.
+// Implementations are in Infrastructure Layer (e.g., Redis HyperLogLog).
+// Counts are approximate and only kept for a few days; they are flushed to the
+// database periodically.
+type ViewCounter interface {
+	// Add records that viewer viewed the post on the day of at.
+	// Viewing the same post again on the same day does not change its count.
+	Add(ctx context.Context, postID string, viewer string, at time.Time) error
+
+	// Counts returns the unique view count of every post viewed on the day of at,
+	// keyed by post ID.
+	Counts(ctx context.Context, at time.Time) (map[string]int64, error)
+}
+
+// Entry is a member of a ranking with its score.
+type Entry struct {
+	// Member is the ranked item (e.g., a post ID).
+	Member string
+
+	// Score is the ranking score; higher scores rank first.
+	Score float64
+}
+
+// RankingStore stores precomputed rankings by name.
+// Implementations are in Infrastructure Layer (e.g., Redis sorted sets).
+type RankingStore interface {
+	// ReplaceAll replaces all rankings at once.
+	// Rankings that are not in the map are removed.
+	ReplaceAll(ctx context.Context, rankings map[string][]Entry) error
+
+	// Range returns the members of the ranking from offset (0-based), highest score first,
+	// and the total number of members. A missing ranking is empty.
+	Range(ctx context.Context, name string, offset, limit int) ([]string, int, error)
+}
@@ -4,9 +4,14 @@ package ratelimit
 
 import (
 	"context"
+	"fmt"
 	"time"
 )
 
+// ClientActions are the actions rate limited per client IP address and hour
+// with ClientKey.
+var ClientActions = []string{"post", "register", "login", "device", "verification_send", "representative"}
+
 // RateLimiter defines the interface for rate limiting operations.
 // Implementations are in Infrastructure Layer (e.g., Redis).
 type RateLimiter interface {
@@ -16,4 +21,15 @@ type RateLimiter interface {
 	// window: time window for the rate limit (e.g., 1 hour)
 	// Returns true if the request is allowed, false if rate limit exceeded, and an error if operation fails.
 	Allow(ctx context.Context, key string, limit int, window time.Duration) (bool, error)
+
+	// Reset clears the counter of a rate limit key, so the next request is allowed.
+	// Resetting a key without a counter is not an error.
+	Reset(ctx context.Context, key string) error
+}
+
+// ClientKey returns the rate limit key of an action by a client IP address
+// in the hour of at.
+// Format: "rate_limit:{action}:{ip}:{YYYY-MM-DD-HH}"
+func ClientKey(action, clientIP string, at time.Time) string {
+	return fmt.Sprintf("rate_limit:%s:%s:%s", action, clientIP, at.Format("2006-01-02-15"))
 }
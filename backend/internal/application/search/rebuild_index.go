@@ -0,0 +1,50 @@
+package search
+
+import (
+	"context"
+
+	"fuck_boss/backend/internal/application/cache"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// Index is the full-text search index of posts (the search tokens of their
+// company names and contents). Implementations are in the Infrastructure Layer.
+type Index interface {
+	// Rebuild rebuilds the index from the posts without blocking searches.
+	Rebuild(ctx context.Context) error
+}
+
+// RebuildIndexUseCase rebuilds the search index, e.g. after a bulk import or
+// when the index is bloated, and clears the cached search results.
+type RebuildIndexUseCase struct {
+	// index is the search index.
+	index Index
+
+	// cacheRepo is the cache repository for cache invalidation.
+	cacheRepo cache.CacheRepository
+}
+
+// NewRebuildIndexUseCase creates a new RebuildIndexUseCase instance.
+func NewRebuildIndexUseCase(
+	index Index,
+	cacheRepo cache.CacheRepository,
+) *RebuildIndexUseCase {
+	return &RebuildIndexUseCase{
+		index:     index,
+		cacheRepo: cacheRepo,
+	}
+}
+
+// Execute rebuilds the search index.
+func (uc *RebuildIndexUseCase) Execute(ctx context.Context) error {
+	// 1. Rebuild the index
+	if err := uc.index.Rebuild(ctx); err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to rebuild search index", err)
+	}
+
+	// 2. Clear the cached search results (errors are ignored)
+	_ = uc.cacheRepo.DeleteByPattern(ctx, "search:*")
+	_ = uc.cacheRepo.DeleteByPattern(ctx, "feed:search:*")
+
+	return nil
+}
@@ -29,6 +29,9 @@ type SearchPostsQuery struct {
 
 	// PageSize is the number of items per page (default: 20).
 	PageSize int
+
+	// View selects the post fields: dto.PostViewBasic or dto.PostViewFull (default).
+	View string
 }
 
 // SearchPostsUseCase handles searching posts with caching.
@@ -70,8 +73,13 @@ func (uc *SearchPostsUseCase) Execute(ctx context.Context, query SearchPostsQuer
 		pageSize = 20
 	}
 
+	basic, err := parseView(query.View)
+	if err != nil {
+		return nil, err
+	}
+
 	// Build cache key
-	cacheKey := uc.buildCacheKey(query.Keyword, query.CityCode, page)
+	cacheKey := uc.buildCacheKey(query.Keyword, query.CityCode, basic, page)
 
 	// Try to get from cache
 	cachedData, err := uc.cacheRepo.Get(ctx, cacheKey)
@@ -97,17 +105,30 @@ func (uc *SearchPostsUseCase) Execute(ctx context.Context, query SearchPostsQuer
 		city = &c
 	}
 
-	posts, total, err := uc.repo.Search(ctx, query.Keyword, city, page, pageSize)
-	if err != nil {
-		return nil, apperrors.NewDatabaseErrorWithCause("failed to search posts", err)
-	}
-
-	// Convert to DTO
-	result := &dto.PostsListDTO{
-		Posts:    uc.toDTOs(posts),
-		Total:    total,
-		Page:     page,
-		PageSize: pageSize,
+	var result *dto.PostsListDTO
+	if basic {
+		// Summaries only: the repository loads just the beginning of the content
+		summaries, total, err := uc.repo.SearchSummaries(ctx, query.Keyword, city, page, pageSize)
+		if err != nil {
+			return nil, apperrors.NewDatabaseErrorWithCause("failed to search posts", err)
+		}
+		result = &dto.PostsListDTO{
+			Posts:    summariesToDTOs(summaries),
+			Total:    total,
+			Page:     page,
+			PageSize: pageSize,
+		}
+	} else {
+		posts, total, err := uc.repo.Search(ctx, query.Keyword, city, page, pageSize)
+		if err != nil {
+			return nil, apperrors.NewDatabaseErrorWithCause("failed to search posts", err)
+		}
+		result = &dto.PostsListDTO{
+			Posts:    uc.toDTOs(posts),
+			Total:    total,
+			Page:     page,
+			PageSize: pageSize,
+		}
 	}
 
 	// Update cache (non-blocking, errors are ignored)
@@ -135,15 +156,34 @@ func (uc *SearchPostsUseCase) validateQuery(query SearchPostsQuery) error {
 }
 
 // buildCacheKey builds the cache key for the given search parameters.
-// Format: "search:{keyword}:city:{cityCode}:page:{page}" or "search:{keyword}:page:{page}" if no city
-func (uc *SearchPostsUseCase) buildCacheKey(keyword string, cityCode *string, page int) string {
+// Format: "search:{keyword}:city:{cityCode}:page:{page}" or "search:{keyword}:page:{page}" if no city.
+// BASIC pages are cached separately with ":view:basic" before ":page".
+func (uc *SearchPostsUseCase) buildCacheKey(keyword string, cityCode *string, basic bool, page int) string {
 	// Normalize keyword (lowercase, trim)
-	normalizedKeyword := strings.ToLower(strings.TrimSpace(keyword))
+	key := "search:" + strings.ToLower(strings.TrimSpace(keyword))
 
 	if cityCode != nil && *cityCode != "" {
-		return fmt.Sprintf("search:%s:city:%s:page:%d", normalizedKeyword, *cityCode, page)
+		key += ":city:" + *cityCode
+	}
+	if basic {
+		key += ":view:basic"
+	}
+	return fmt.Sprintf("%s:page:%d", key, page)
+}
+
+// parseView parses a list view and reports whether it is the BASIC view.
+// An empty view means the FULL view.
+func parseView(view string) (bool, error) {
+	switch view {
+	case "", dto.PostViewFull:
+		return false, nil
+	case dto.PostViewBasic:
+		return true, nil
+	default:
+		return false, apperrors.NewValidationErrorWithDetails("invalid view", map[string]interface{}{
+			"error": fmt.Sprintf("view must be %s or %s", dto.PostViewBasic, dto.PostViewFull),
+		})
 	}
-	return fmt.Sprintf("search:%s:page:%d", normalizedKeyword, page)
 }
 
 // getCityName returns the city name for the given city code.
@@ -207,12 +247,32 @@ func (uc *SearchPostsUseCase) toDTOs(posts []*content.Post) []*dto.PostDTO {
 // toDTO converts a Post entity to PostDTO.
 func (uc *SearchPostsUseCase) toDTO(post *content.Post) *dto.PostDTO {
 	return &dto.PostDTO{
-		ID:         post.ID().String(),
-		Company:    post.Company().String(),
-		CityCode:   post.City().Code(),
-		CityName:   post.City().Name(),
-		Content:    post.Content().String(),
-		OccurredAt: nil, // Not stored in Post entity
-		CreatedAt:  post.CreatedAt(),
+		ID:               post.ID().String(),
+		Company:          post.Company().String(),
+		CityCode:         post.City().Code(),
+		CityName:         post.City().Name(),
+		Content:          post.Content().String(),
+		ContentHTML:      post.Content().HTML(),
+		Summary:          post.Content().Summary(),
+		OccurredAt:       nil, // Not stored in Post entity
+		CreatedAt:        post.CreatedAt(),
+		ResolutionStatus: post.Resolution().String(),
 	}
 }
+
+// summariesToDTOs converts PostSummaries to PostDTOs of the BASIC view.
+func summariesToDTOs(summaries []*content.PostSummary) []*dto.PostDTO {
+	dtos := make([]*dto.PostDTO, 0, len(summaries))
+	for _, s := range summaries {
+		dtos = append(dtos, &dto.PostDTO{
+			ID:               s.ID().String(),
+			Company:          s.Company().String(),
+			CityCode:         s.City().Code(),
+			CityName:         s.City().Name(),
+			Summary:          s.Summary(),
+			CreatedAt:        s.CreatedAt(),
+			ResolutionStatus: s.Resolution().String(),
+		})
+	}
+	return dtos
+}
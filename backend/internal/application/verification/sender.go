@@ -0,0 +1,39 @@
+// Package verification provides the verification code service and the sender interface.
+// The Sender interface is defined in Application Layer to follow Dependency Inversion Principle.
+package verification
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrUnsupportedChannel is returned by a Sender that cannot deliver to the message's channel.
+var ErrUnsupportedChannel = errors.New("verification channel is not supported by sender")
+
+// Message is a verification code to deliver.
+type Message struct {
+	// Channel is the delivery channel ("EMAIL" or "SMS").
+	Channel string
+
+	// Recipient is the normalized email address or E.164 phone number.
+	Recipient string
+
+	// Purpose is what the code is for (e.g., "REGISTER").
+	Purpose string
+
+	// Code is the 6-digit verification code.
+	Code string
+
+	// ExpiresAt is when the code stops being accepted.
+	ExpiresAt time.Time
+}
+
+// Sender delivers verification codes.
+// Implementations are in Infrastructure Layer (e.g., SMTP, local files for development).
+type Sender interface {
+	// Send delivers the message to its recipient.
+	// Returns ErrUnsupportedChannel if the sender cannot deliver to msg.Channel,
+	// or another error if delivery failed.
+	Send(ctx context.Context, msg Message) error
+}
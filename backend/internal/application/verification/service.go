@@ -0,0 +1,306 @@
+package verification
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"fuck_boss/backend/internal/application/cache"
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/application/ratelimit"
+	"fuck_boss/backend/internal/domain/identity"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// resendInterval is the minimum time between two codes sent to the same recipient.
+const resendInterval = time.Minute
+
+// SendCommand represents the command to send a verification code.
+type SendCommand struct {
+	// Channel is the delivery channel (required, EMAIL or SMS).
+	Channel string
+
+	// Recipient is the email address or phone number (required).
+	Recipient string
+
+	// Purpose is what the code will be used for (required, e.g., REGISTER).
+	Purpose string
+
+	// ClientIP is the client IP address for rate limiting (required).
+	ClientIP string
+}
+
+// VerifyCommand represents the command to check a submitted verification code.
+type VerifyCommand struct {
+	// Channel is the delivery channel the code was sent through (required).
+	Channel string
+
+	// Recipient is the email address or phone number the code was sent to (required).
+	Recipient string
+
+	// Purpose is what the code is being used for (required).
+	Purpose string
+
+	// Code is the code submitted by the user (required).
+	Code string
+}
+
+// codeRecord is the cached state of an issued code.
+// Attempts only reports the remaining attempts to the user; the limit itself is
+// enforced by an atomic counter in the rate limiter (see attemptsKey).
+type codeRecord struct {
+	Code      string    `json:"code"`
+	Attempts  int       `json:"attempts"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// Service issues and checks one-time verification codes.
+// Codes are kept in the cache until they expire, are used, or run out of attempts;
+// sending and attempts are counted through the rate limiter.
+type Service struct {
+	// cacheRepo stores issued codes.
+	cacheRepo cache.CacheRepository
+
+	// rateLimiter throttles sending and counts attempts.
+	rateLimiter ratelimit.RateLimiter
+
+	// sender delivers codes.
+	sender Sender
+
+	// codeTTL is how long a code is accepted.
+	codeTTL time.Duration
+
+	// maxAttempts is how many wrong codes are accepted before the code is discarded.
+	maxAttempts int
+}
+
+// NewService creates a new verification code Service.
+func NewService(
+	cacheRepo cache.CacheRepository,
+	rateLimiter ratelimit.RateLimiter,
+	sender Sender,
+	codeTTL time.Duration,
+	maxAttempts int,
+) *Service {
+	return &Service{
+		cacheRepo:   cacheRepo,
+		rateLimiter: rateLimiter,
+		sender:      sender,
+		codeTTL:     codeTTL,
+		maxAttempts: maxAttempts,
+	}
+}
+
+// Send generates a new code for the recipient and delivers it.
+// A new code replaces any earlier code for the same recipient and purpose.
+func (s *Service) Send(ctx context.Context, cmd SendCommand) (*dto.VerificationCodeDTO, error) {
+	// 1. Validate input
+	if cmd.ClientIP == "" {
+		return nil, apperrors.NewValidationError("client IP is required for rate limiting")
+	}
+
+	channel, recipient, purpose, err := parseTarget(cmd.Channel, cmd.Recipient, cmd.Purpose)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Check rate limits
+	if err := s.checkSendLimits(ctx, channel, recipient, cmd.ClientIP); err != nil {
+		return nil, err
+	}
+
+	// 3. Generate and store the code
+	code, err := identity.GenerateVerificationCode()
+	if err != nil {
+		return nil, apperrors.NewInternalErrorWithCause("failed to generate verification code", err)
+	}
+
+	expiresAt := time.Now().Add(s.codeTTL)
+	key := buildCodeKey(channel, recipient, purpose)
+	if err := s.saveRecord(ctx, key, codeRecord{Code: code.String(), ExpiresAt: expiresAt}, s.codeTTL); err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to store verification code", err)
+	}
+
+	// 4. Deliver the code
+	err = s.sender.Send(ctx, Message{
+		Channel:   channel.String(),
+		Recipient: recipient,
+		Purpose:   purpose.String(),
+		Code:      code.String(),
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		_ = s.cacheRepo.Delete(ctx, key)
+		if errors.Is(err, ErrUnsupportedChannel) {
+			return nil, apperrors.NewValidationErrorWithDetails("verification channel is not available", map[string]interface{}{
+				"channel": channel.String(),
+			})
+		}
+		return nil, apperrors.NewInternalErrorWithCause("failed to send verification code", err)
+	}
+
+	return &dto.VerificationCodeDTO{
+		Channel:            channel.String(),
+		ExpiresAt:          expiresAt,
+		ResendAfterSeconds: int(resendInterval.Seconds()),
+	}, nil
+}
+
+// Verify checks a submitted code. A matching code is consumed and cannot be used again.
+// Wrong codes count against the attempt limit; once it is reached the code is discarded
+// and a new one must be requested.
+func (s *Service) Verify(ctx context.Context, cmd VerifyCommand) error {
+	// 1. Validate input
+	if cmd.Code == "" {
+		return apperrors.NewValidationError("verification code is required")
+	}
+
+	channel, recipient, purpose, err := parseTarget(cmd.Channel, cmd.Recipient, cmd.Purpose)
+	if err != nil {
+		return err
+	}
+
+	// 2. Load the issued code (cache misses and failures both mean no usable code)
+	key := buildCodeKey(channel, recipient, purpose)
+	cached, err := s.cacheRepo.Get(ctx, key)
+	if err != nil || cached == "" {
+		return apperrors.NewValidationError("verification code is invalid or expired")
+	}
+
+	var record codeRecord
+	if err := json.Unmarshal([]byte(cached), &record); err != nil {
+		_ = s.cacheRepo.Delete(ctx, key)
+		return apperrors.NewValidationError("verification code is invalid or expired")
+	}
+
+	remainingTTL := time.Until(record.ExpiresAt)
+	if remainingTTL <= 0 {
+		_ = s.cacheRepo.Delete(ctx, key)
+		return apperrors.NewValidationError("verification code is invalid or expired")
+	}
+
+	// 3. Count the attempt before comparing, so concurrent guesses cannot exceed the limit
+	allowed, err := s.rateLimiter.Allow(ctx, attemptsKey(key, record), s.maxAttempts, remainingTTL)
+	if err != nil {
+		return apperrors.NewDatabaseErrorWithCause("rate limit check failed", err)
+	}
+	if !allowed {
+		_ = s.cacheRepo.Delete(ctx, key)
+		return apperrors.NewRateLimitError("too many wrong verification codes: request a new code")
+	}
+
+	// 4. Compare
+	code, err := identity.NewVerificationCodeFromString(record.Code)
+	if err != nil || !code.Matches(cmd.Code) {
+		record.Attempts++
+		remaining := s.maxAttempts - record.Attempts
+		if remaining <= 0 {
+			_ = s.cacheRepo.Delete(ctx, key)
+		} else {
+			_ = s.saveRecord(ctx, key, record, remainingTTL)
+		}
+		return apperrors.NewValidationErrorWithDetails("verification code is invalid or expired", map[string]interface{}{
+			"remainingAttempts": remaining,
+		})
+	}
+
+	// 5. Consume the code
+	if err := s.cacheRepo.Delete(ctx, key); err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to consume verification code", err)
+	}
+
+	return nil
+}
+
+// checkSendLimits applies the send throttles:
+// one code per minute and 10 codes per day per recipient, 20 codes per hour per IP.
+func (s *Service) checkSendLimits(ctx context.Context, channel identity.VerificationChannel, recipient, clientIP string) error {
+	now := time.Now()
+
+	ipKey := ratelimit.ClientKey("verification_send", clientIP, now)
+	allowed, err := s.rateLimiter.Allow(ctx, ipKey, 20, time.Hour)
+	if err != nil {
+		return apperrors.NewDatabaseErrorWithCause("rate limit check failed", err)
+	}
+	if !allowed {
+		return apperrors.NewRateLimitError("rate limit exceeded: maximum 20 verification codes per hour")
+	}
+
+	resendKey := fmt.Sprintf("rate_limit:verification_resend:%s:%s:%s", channel.String(), recipient, now.Format("2006-01-02-15-04"))
+	allowed, err = s.rateLimiter.Allow(ctx, resendKey, 1, resendInterval)
+	if err != nil {
+		return apperrors.NewDatabaseErrorWithCause("rate limit check failed", err)
+	}
+	if !allowed {
+		return apperrors.NewRateLimitErrorWithWindow("a verification code was sent recently, please wait before requesting another", int(resendInterval.Seconds()))
+	}
+
+	dailyKey := fmt.Sprintf("rate_limit:verification_daily:%s:%s:%s", channel.String(), recipient, now.Format("2006-01-02"))
+	allowed, err = s.rateLimiter.Allow(ctx, dailyKey, 10, 24*time.Hour)
+	if err != nil {
+		return apperrors.NewDatabaseErrorWithCause("rate limit check failed", err)
+	}
+	if !allowed {
+		return apperrors.NewRateLimitError("rate limit exceeded: maximum 10 verification codes per day")
+	}
+
+	return nil
+}
+
+// saveRecord stores a code record with the given TTL.
+func (s *Service) saveRecord(ctx context.Context, key string, record codeRecord, ttl time.Duration) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	return s.cacheRepo.Set(ctx, key, string(data), ttl)
+}
+
+// parseTarget validates and normalizes the channel, recipient and purpose of a command.
+func parseTarget(rawChannel, rawRecipient, rawPurpose string) (identity.VerificationChannel, string, identity.VerificationPurpose, error) {
+	if rawChannel == "" {
+		return identity.VerificationChannel{}, "", identity.VerificationPurpose{}, apperrors.NewValidationError("verification channel is required")
+	}
+	if rawRecipient == "" {
+		return identity.VerificationChannel{}, "", identity.VerificationPurpose{}, apperrors.NewValidationError("recipient is required")
+	}
+	if rawPurpose == "" {
+		return identity.VerificationChannel{}, "", identity.VerificationPurpose{}, apperrors.NewValidationError("verification purpose is required")
+	}
+
+	channel, err := identity.NewVerificationChannel(rawChannel)
+	if err != nil {
+		return identity.VerificationChannel{}, "", identity.VerificationPurpose{}, apperrors.NewValidationErrorWithDetails("invalid verification channel", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	recipient, err := identity.NewVerificationRecipient(channel, rawRecipient)
+	if err != nil {
+		return identity.VerificationChannel{}, "", identity.VerificationPurpose{}, apperrors.NewValidationErrorWithDetails("invalid recipient", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	purpose, err := identity.NewVerificationPurpose(rawPurpose)
+	if err != nil {
+		return identity.VerificationChannel{}, "", identity.VerificationPurpose{}, apperrors.NewValidationErrorWithDetails("invalid verification purpose", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	return channel, recipient, purpose, nil
+}
+
+// attemptsKey builds the rate limit key counting the attempts at an issued code.
+// The expiry identifies the code, so a new code starts with a new counter.
+func attemptsKey(codeKey string, record codeRecord) string {
+	return fmt.Sprintf("rate_limit:%s:%d", codeKey, record.ExpiresAt.UnixNano())
+}
+
+// buildCodeKey builds the cache key of the code issued to a recipient for a purpose.
+func buildCodeKey(channel identity.VerificationChannel, recipient string, purpose identity.VerificationPurpose) string {
+	return fmt.Sprintf("verification:%s:%s:%s", purpose.String(), channel.String(), recipient)
+}
@@ -0,0 +1,135 @@
+package watchlist
+
+import (
+	"context"
+
+	"fuck_boss/backend/internal/domain/content"
+	"fuck_boss/backend/internal/domain/watchlist"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// BookmarkCommand represents the command to add or remove a bookmark.
+type BookmarkCommand struct {
+	// UserID is the logged-in user (optional, taken from the access token).
+	UserID string
+
+	// DeviceToken is the anonymous device token (required if UserID is empty).
+	DeviceToken string
+
+	// PostID is the post to bookmark (required).
+	PostID string
+}
+
+// AddBookmarkUseCase saves a post to the owner's bookmarks.
+type AddBookmarkUseCase struct {
+	// bookmarks is the Bookmark repository.
+	bookmarks watchlist.BookmarkRepository
+
+	// devices is the Device repository.
+	devices watchlist.DeviceRepository
+
+	// postRepo is the Post repository.
+	postRepo content.PostRepository
+}
+
+// NewAddBookmarkUseCase creates a new AddBookmarkUseCase instance.
+func NewAddBookmarkUseCase(
+	bookmarks watchlist.BookmarkRepository,
+	devices watchlist.DeviceRepository,
+	postRepo content.PostRepository,
+) *AddBookmarkUseCase {
+	return &AddBookmarkUseCase{
+		bookmarks: bookmarks,
+		devices:   devices,
+		postRepo:  postRepo,
+	}
+}
+
+// Execute executes the add bookmark command.
+// Bookmarking an already bookmarked post succeeds without changes.
+func (uc *AddBookmarkUseCase) Execute(ctx context.Context, cmd BookmarkCommand) error {
+	// 1. Validate input
+	postID, err := parsePostID(cmd.PostID)
+	if err != nil {
+		return err
+	}
+
+	owner, err := resolveOwner(ctx, uc.devices, cmd.UserID, cmd.DeviceToken)
+	if err != nil {
+		return err
+	}
+
+	// 2. The post must exist
+	if _, err := uc.postRepo.FindByID(ctx, postID); err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return err
+		}
+		return apperrors.NewDatabaseErrorWithCause("failed to query post", err)
+	}
+
+	// 3. Enforce the bookmark limit
+	_, total, err := uc.bookmarks.FindByOwner(ctx, owner, 1, 1)
+	if err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to query bookmarks", err)
+	}
+	if total >= watchlist.MaxBookmarks {
+		return apperrors.NewValidationErrorWithDetails("bookmark limit reached", map[string]interface{}{
+			"limit": watchlist.MaxBookmarks,
+		})
+	}
+
+	// 4. Save
+	return uc.bookmarks.Save(ctx, watchlist.NewBookmark(owner, postID))
+}
+
+// RemoveBookmarkUseCase removes a post from the owner's bookmarks.
+type RemoveBookmarkUseCase struct {
+	// bookmarks is the Bookmark repository.
+	bookmarks watchlist.BookmarkRepository
+
+	// devices is the Device repository.
+	devices watchlist.DeviceRepository
+}
+
+// NewRemoveBookmarkUseCase creates a new RemoveBookmarkUseCase instance.
+func NewRemoveBookmarkUseCase(
+	bookmarks watchlist.BookmarkRepository,
+	devices watchlist.DeviceRepository,
+) *RemoveBookmarkUseCase {
+	return &RemoveBookmarkUseCase{
+		bookmarks: bookmarks,
+		devices:   devices,
+	}
+}
+
+// Execute executes the remove bookmark command.
+// Removing a post that is not bookmarked succeeds without changes.
+func (uc *RemoveBookmarkUseCase) Execute(ctx context.Context, cmd BookmarkCommand) error {
+	postID, err := parsePostID(cmd.PostID)
+	if err != nil {
+		return err
+	}
+
+	owner, err := resolveOwner(ctx, uc.devices, cmd.UserID, cmd.DeviceToken)
+	if err != nil {
+		return err
+	}
+
+	return uc.bookmarks.Delete(ctx, owner, postID)
+}
+
+// parsePostID validates a post ID from a command.
+func parsePostID(value string) (content.PostID, error) {
+	if value == "" {
+		return content.PostID{}, apperrors.NewValidationError("post ID is required")
+	}
+
+	postID, err := content.NewPostID(value)
+	if err != nil {
+		return content.PostID{}, apperrors.NewValidationErrorWithDetails("invalid post ID", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	return postID, nil
+}
@@ -0,0 +1,73 @@
+package watchlist
+
+import (
+	"context"
+	"time"
+
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/application/ratelimit"
+	"fuck_boss/backend/internal/domain/watchlist"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// IssueDeviceTokenCommand represents the command to issue a device token.
+type IssueDeviceTokenCommand struct {
+	// ClientIP is the client IP address for rate limiting (required).
+	ClientIP string
+}
+
+// IssueDeviceTokenUseCase issues device tokens to anonymous visitors,
+// so they can keep bookmarks and a watchlist without an account.
+type IssueDeviceTokenUseCase struct {
+	// devices is the Device repository.
+	devices watchlist.DeviceRepository
+
+	// rateLimiter is the rate limiter for preventing abuse.
+	rateLimiter ratelimit.RateLimiter
+}
+
+// NewIssueDeviceTokenUseCase creates a new IssueDeviceTokenUseCase instance.
+func NewIssueDeviceTokenUseCase(
+	devices watchlist.DeviceRepository,
+	rateLimiter ratelimit.RateLimiter,
+) *IssueDeviceTokenUseCase {
+	return &IssueDeviceTokenUseCase{
+		devices:     devices,
+		rateLimiter: rateLimiter,
+	}
+}
+
+// Execute executes the issue device token command.
+// It returns the raw token; only its hash is stored.
+func (uc *IssueDeviceTokenUseCase) Execute(ctx context.Context, cmd IssueDeviceTokenCommand) (*dto.DeviceTokenDTO, error) {
+	// 1. Validate input
+	if cmd.ClientIP == "" {
+		return nil, apperrors.NewValidationError("client IP is required for rate limiting")
+	}
+
+	// 2. Check rate limit (10 device tokens per hour per IP)
+	rateLimitKey := ratelimit.ClientKey("device", cmd.ClientIP, time.Now())
+	allowed, err := uc.rateLimiter.Allow(ctx, rateLimitKey, 10, time.Hour)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("rate limit check failed", err)
+	}
+	if !allowed {
+		return nil, apperrors.NewRateLimitError("rate limit exceeded: maximum 10 device tokens per hour")
+	}
+
+	// 3. Generate and register the token
+	token, err := watchlist.GenerateDeviceToken()
+	if err != nil {
+		return nil, apperrors.NewInternalErrorWithCause("failed to issue device token", err)
+	}
+
+	issuedAt := time.Now()
+	if err := uc.devices.Register(ctx, token.Hash(), issuedAt); err != nil {
+		return nil, err
+	}
+
+	return &dto.DeviceTokenDTO{
+		DeviceToken: token.String(),
+		IssuedAt:    issuedAt,
+	}, nil
+}
@@ -0,0 +1,90 @@
+package watchlist
+
+import (
+	"context"
+
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/domain/content"
+	"fuck_boss/backend/internal/domain/watchlist"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// ListBookmarksQuery represents the query parameters for listing bookmarks.
+type ListBookmarksQuery struct {
+	// UserID is the logged-in user (optional, taken from the access token).
+	UserID string
+
+	// DeviceToken is the anonymous device token (required if UserID is empty).
+	DeviceToken string
+
+	// Page is the page number (1-based, default: 1).
+	Page int
+
+	// PageSize is the number of items per page (default: 20, max: 100).
+	PageSize int
+}
+
+// ListBookmarksUseCase lists the owner's bookmarked posts, newest bookmark first.
+// Results are never cached because they are private to the owner.
+type ListBookmarksUseCase struct {
+	// bookmarks is the Bookmark repository.
+	bookmarks watchlist.BookmarkRepository
+
+	// devices is the Device repository.
+	devices watchlist.DeviceRepository
+
+	// postRepo is the Post repository.
+	postRepo content.PostRepository
+}
+
+// NewListBookmarksUseCase creates a new ListBookmarksUseCase instance.
+func NewListBookmarksUseCase(
+	bookmarks watchlist.BookmarkRepository,
+	devices watchlist.DeviceRepository,
+	postRepo content.PostRepository,
+) *ListBookmarksUseCase {
+	return &ListBookmarksUseCase{
+		bookmarks: bookmarks,
+		devices:   devices,
+		postRepo:  postRepo,
+	}
+}
+
+// Execute executes the list bookmarks query.
+func (uc *ListBookmarksUseCase) Execute(ctx context.Context, query ListBookmarksQuery) (*dto.BookmarksListDTO, error) {
+	owner, err := resolveOwner(ctx, uc.devices, query.UserID, query.DeviceToken)
+	if err != nil {
+		return nil, err
+	}
+
+	page, pageSize := normalizePage(query.Page, query.PageSize)
+
+	bookmarks, total, err := uc.bookmarks.FindByOwner(ctx, owner, page, pageSize)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to query bookmarks", err)
+	}
+
+	// Load the bookmarked posts (at most one page; deleted posts cascade their bookmarks away)
+	items := make([]*dto.BookmarkDTO, 0, len(bookmarks))
+	for _, bookmark := range bookmarks {
+		post, err := uc.postRepo.FindByID(ctx, bookmark.PostID())
+		if err != nil {
+			if apperrors.IsNotFoundError(err) {
+				continue
+			}
+			return nil, apperrors.NewDatabaseErrorWithCause("failed to query post", err)
+		}
+
+		items = append(items, &dto.BookmarkDTO{
+			Post:         toPostDTO(post),
+			BookmarkedAt: bookmark.CreatedAt(),
+		})
+	}
+
+	return &dto.BookmarksListDTO{
+		Bookmarks: items,
+		Total:     total,
+		Page:      page,
+		PageSize:  pageSize,
+	}, nil
+}
@@ -0,0 +1,145 @@
+package watchlist
+
+import (
+	"context"
+	"time"
+
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/domain/content"
+	"fuck_boss/backend/internal/domain/watchlist"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// firstVisitWindow is how far back the feed reaches for owners who have never marked it as seen.
+const firstVisitWindow = 7 * 24 * time.Hour
+
+// ListWatchedPostsQuery represents the query parameters for new posts about watched companies.
+type ListWatchedPostsQuery struct {
+	// UserID is the logged-in user (optional, taken from the access token).
+	UserID string
+
+	// DeviceToken is the anonymous device token (required if UserID is empty).
+	DeviceToken string
+
+	// Since overrides the cutoff (optional). Clients pass back the Since of the first page
+	// when requesting further pages, so marking the feed as seen does not empty them.
+	Since *time.Time
+
+	// MarkVisited records the visit, so the next visit only shows posts created after now.
+	MarkVisited bool
+
+	// Page is the page number (1-based, default: 1).
+	Page int
+
+	// PageSize is the number of items per page (default: 20, max: 100).
+	PageSize int
+}
+
+// ListWatchedPostsUseCase lists posts about the owner's watched companies
+// created since the owner's last visit, newest first.
+type ListWatchedPostsUseCase struct {
+	// watches is the CompanyWatch repository.
+	watches watchlist.WatchRepository
+
+	// devices is the Device repository.
+	devices watchlist.DeviceRepository
+
+	// postRepo is the Post repository.
+	postRepo content.PostRepository
+}
+
+// NewListWatchedPostsUseCase creates a new ListWatchedPostsUseCase instance.
+func NewListWatchedPostsUseCase(
+	watches watchlist.WatchRepository,
+	devices watchlist.DeviceRepository,
+	postRepo content.PostRepository,
+) *ListWatchedPostsUseCase {
+	return &ListWatchedPostsUseCase{
+		watches:  watches,
+		devices:  devices,
+		postRepo: postRepo,
+	}
+}
+
+// Execute executes the list watched posts query.
+// The cutoff is query.Since, else the last visit, else 7 days ago.
+func (uc *ListWatchedPostsUseCase) Execute(ctx context.Context, query ListWatchedPostsQuery) (*dto.WatchedPostsDTO, error) {
+	// Captured before querying, so posts created during the request are shown next time
+	visitedAt := time.Now()
+
+	owner, err := resolveOwner(ctx, uc.devices, query.UserID, query.DeviceToken)
+	if err != nil {
+		return nil, err
+	}
+
+	page, pageSize := normalizePage(query.Page, query.PageSize)
+
+	// 1. Determine the cutoff
+	since, err := uc.resolveSince(ctx, owner, query.Since, visitedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &dto.WatchedPostsDTO{
+		Posts:    []*dto.PostDTO{},
+		Page:     page,
+		PageSize: pageSize,
+		Since:    since,
+	}
+
+	// 2. Load the watched companies
+	watches, err := uc.watches.FindByOwner(ctx, owner)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to query watchlist", err)
+	}
+
+	// 3. Query new posts about them
+	if len(watches) > 0 {
+		companies := make([]content.CompanyName, 0, len(watches))
+		for _, watch := range watches {
+			companies = append(companies, watch.Company())
+		}
+
+		posts, total, err := uc.postRepo.FindByFilter(ctx, content.PostFilter{
+			Companies:    companies,
+			CreatedAfter: &since,
+		}, page, pageSize)
+		if err != nil {
+			return nil, apperrors.NewDatabaseErrorWithCause("failed to query posts", err)
+		}
+
+		for _, post := range posts {
+			result.Posts = append(result.Posts, toPostDTO(post))
+		}
+		result.Total = total
+	}
+
+	// 4. Record the visit
+	if query.MarkVisited {
+		if err := uc.watches.MarkVisited(ctx, owner, visitedAt); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// resolveSince returns the feed cutoff for the owner.
+func (uc *ListWatchedPostsUseCase) resolveSince(ctx context.Context, owner watchlist.Owner, since *time.Time, now time.Time) (time.Time, error) {
+	if since != nil {
+		if since.After(now) {
+			return time.Time{}, apperrors.NewValidationError("since cannot be in the future")
+		}
+		return *since, nil
+	}
+
+	lastVisit, err := uc.watches.LastVisit(ctx, owner)
+	if err != nil {
+		return time.Time{}, apperrors.NewDatabaseErrorWithCause("failed to query last visit", err)
+	}
+	if lastVisit.IsZero() {
+		return now.Add(-firstVisitWindow), nil
+	}
+
+	return lastVisit, nil
+}
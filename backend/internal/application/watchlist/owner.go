@@ -0,0 +1,67 @@
+// Package watchlist provides use cases for bookmarks and company watchlists.
+package watchlist
+
+import (
+	"context"
+
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/domain/content"
+	"fuck_boss/backend/internal/domain/watchlist"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// resolveOwner determines who a watchlist request acts for.
+// A logged-in user takes precedence; otherwise the device token must have been issued by the server.
+// Returns an UNAUTHENTICATED error if neither is present or the device token is unknown.
+func resolveOwner(ctx context.Context, devices watchlist.DeviceRepository, userID, deviceToken string) (watchlist.Owner, error) {
+	if userID != "" {
+		owner, err := watchlist.NewUserOwner(userID)
+		if err != nil {
+			return watchlist.Owner{}, apperrors.NewUnauthenticatedError("invalid user")
+		}
+		return owner, nil
+	}
+
+	if deviceToken == "" {
+		return watchlist.Owner{}, apperrors.NewUnauthenticatedError("login or device token required")
+	}
+
+	tokenHash := watchlist.HashDeviceToken(deviceToken)
+	exists, err := devices.Exists(ctx, tokenHash)
+	if err != nil {
+		return watchlist.Owner{}, apperrors.NewDatabaseErrorWithCause("failed to query device", err)
+	}
+	if !exists {
+		return watchlist.Owner{}, apperrors.NewUnauthenticatedError("unknown device token")
+	}
+
+	return watchlist.NewDeviceOwner(tokenHash)
+}
+
+// normalizePage applies the default (20) and maximum (100) page size.
+func normalizePage(page, pageSize int) (int, int) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 20
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+	return page, pageSize
+}
+
+// toPostDTO converts a Post entity to a list item PostDTO.
+func toPostDTO(post *content.Post) *dto.PostDTO {
+	return &dto.PostDTO{
+		ID:               post.ID().String(),
+		Company:          post.Company().String(),
+		CityCode:         post.City().Code(),
+		CityName:         post.City().Name(),
+		Content:          post.Content().String(),
+		ContentHTML:      post.Content().HTML(),
+		CreatedAt:        post.CreatedAt(),
+		ResolutionStatus: post.Resolution().String(),
+	}
+}
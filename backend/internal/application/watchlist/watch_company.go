@@ -0,0 +1,180 @@
+package watchlist
+
+import (
+	"context"
+
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/domain/content"
+	"fuck_boss/backend/internal/domain/watchlist"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// WatchCommand represents the command to watch or unwatch a company.
+type WatchCommand struct {
+	// UserID is the logged-in user (optional, taken from the access token).
+	UserID string
+
+	// DeviceToken is the anonymous device token (required if UserID is empty).
+	DeviceToken string
+
+	// Company is the company name exactly as it appears in posts (required).
+	Company string
+}
+
+// OwnerQuery identifies the owner of a watchlist query.
+type OwnerQuery struct {
+	// UserID is the logged-in user (optional, taken from the access token).
+	UserID string
+
+	// DeviceToken is the anonymous device token (required if UserID is empty).
+	DeviceToken string
+}
+
+// WatchCompanyUseCase adds a company to the owner's watchlist.
+type WatchCompanyUseCase struct {
+	// watches is the CompanyWatch repository.
+	watches watchlist.WatchRepository
+
+	// devices is the Device repository.
+	devices watchlist.DeviceRepository
+}
+
+// NewWatchCompanyUseCase creates a new WatchCompanyUseCase instance.
+func NewWatchCompanyUseCase(
+	watches watchlist.WatchRepository,
+	devices watchlist.DeviceRepository,
+) *WatchCompanyUseCase {
+	return &WatchCompanyUseCase{
+		watches: watches,
+		devices: devices,
+	}
+}
+
+// Execute executes the watch company command.
+// The company does not need to have posts yet; watching it again succeeds without changes.
+func (uc *WatchCompanyUseCase) Execute(ctx context.Context, cmd WatchCommand) error {
+	// 1. Validate input
+	company, err := parseCompany(cmd.Company)
+	if err != nil {
+		return err
+	}
+
+	owner, err := resolveOwner(ctx, uc.devices, cmd.UserID, cmd.DeviceToken)
+	if err != nil {
+		return err
+	}
+
+	// 2. Enforce the watchlist limit
+	watches, err := uc.watches.FindByOwner(ctx, owner)
+	if err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to query watchlist", err)
+	}
+	for _, watch := range watches {
+		if watch.Company().Equals(company) {
+			return nil
+		}
+	}
+	if len(watches) >= watchlist.MaxWatchedCompanies {
+		return apperrors.NewValidationErrorWithDetails("watchlist limit reached", map[string]interface{}{
+			"limit": watchlist.MaxWatchedCompanies,
+		})
+	}
+
+	// 3. Save
+	return uc.watches.Save(ctx, watchlist.NewCompanyWatch(owner, company))
+}
+
+// UnwatchCompanyUseCase removes a company from the owner's watchlist.
+type UnwatchCompanyUseCase struct {
+	// watches is the CompanyWatch repository.
+	watches watchlist.WatchRepository
+
+	// devices is the Device repository.
+	devices watchlist.DeviceRepository
+}
+
+// NewUnwatchCompanyUseCase creates a new UnwatchCompanyUseCase instance.
+func NewUnwatchCompanyUseCase(
+	watches watchlist.WatchRepository,
+	devices watchlist.DeviceRepository,
+) *UnwatchCompanyUseCase {
+	return &UnwatchCompanyUseCase{
+		watches: watches,
+		devices: devices,
+	}
+}
+
+// Execute executes the unwatch company command.
+// Unwatching a company that is not watched succeeds without changes.
+func (uc *UnwatchCompanyUseCase) Execute(ctx context.Context, cmd WatchCommand) error {
+	company, err := parseCompany(cmd.Company)
+	if err != nil {
+		return err
+	}
+
+	owner, err := resolveOwner(ctx, uc.devices, cmd.UserID, cmd.DeviceToken)
+	if err != nil {
+		return err
+	}
+
+	return uc.watches.Delete(ctx, owner, company)
+}
+
+// ListWatchedCompaniesUseCase lists the companies on the owner's watchlist, oldest first.
+type ListWatchedCompaniesUseCase struct {
+	// watches is the CompanyWatch repository.
+	watches watchlist.WatchRepository
+
+	// devices is the Device repository.
+	devices watchlist.DeviceRepository
+}
+
+// NewListWatchedCompaniesUseCase creates a new ListWatchedCompaniesUseCase instance.
+func NewListWatchedCompaniesUseCase(
+	watches watchlist.WatchRepository,
+	devices watchlist.DeviceRepository,
+) *ListWatchedCompaniesUseCase {
+	return &ListWatchedCompaniesUseCase{
+		watches: watches,
+		devices: devices,
+	}
+}
+
+// Execute executes the list watched companies query.
+func (uc *ListWatchedCompaniesUseCase) Execute(ctx context.Context, query OwnerQuery) ([]*dto.WatchedCompanyDTO, error) {
+	owner, err := resolveOwner(ctx, uc.devices, query.UserID, query.DeviceToken)
+	if err != nil {
+		return nil, err
+	}
+
+	watches, err := uc.watches.FindByOwner(ctx, owner)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to query watchlist", err)
+	}
+
+	result := make([]*dto.WatchedCompanyDTO, 0, len(watches))
+	for _, watch := range watches {
+		result = append(result, &dto.WatchedCompanyDTO{
+			Company:   watch.Company().String(),
+			WatchedAt: watch.CreatedAt(),
+		})
+	}
+
+	return result, nil
+}
+
+// parseCompany validates a company name from a command.
+func parseCompany(value string) (content.CompanyName, error) {
+	if value == "" {
+		return content.CompanyName{}, apperrors.NewValidationError("company name is required")
+	}
+
+	company, err := content.NewCompanyName(value)
+	if err != nil {
+		return content.CompanyName{}, apperrors.NewValidationErrorWithDetails("invalid company name", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	return company, nil
+}
@@ -0,0 +1,301 @@
+// Package admin provides domain models for operator access: API keys with
+// scopes for privileged RPCs, and the append-only audit log of privileged calls.
+package admin
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// APIKeyPrefix starts every raw API key, so leaked keys are easy to recognize.
+	APIKeyPrefix = "fbk_"
+
+	// apiKeyBytes is the number of random bytes in a raw API key.
+	apiKeyBytes = 32
+
+	// apiKeyDisplayLength is the length of the key prefix kept in clear text
+	// ("fbk_" and 8 characters), used to tell keys apart in listings.
+	apiKeyDisplayLength = len(APIKeyPrefix) + 8
+
+	// MaxAPIKeyNameLength is the maximum length of an API key name in characters.
+	MaxAPIKeyNameLength = 100
+
+	// LastUsedResolution is how stale the last-used time may get before it is
+	// updated again, so busy keys do not cause a write on every call.
+	LastUsedResolution = time.Minute
+)
+
+var (
+	// ErrAPIKeyRevoked is returned when a revoked API key is presented.
+	ErrAPIKeyRevoked = errors.New("API key has been revoked")
+
+	// ErrAPIKeyExpired is returned when an API key is past its expiry.
+	ErrAPIKeyExpired = errors.New("API key has expired")
+
+	// ErrScopeNotGranted is returned when an API key lacks the scope a call requires.
+	ErrScopeNotGranted = errors.New("API key does not grant the required scope")
+)
+
+// Scope is a permission granted to an API key. Scopes do not imply each other:
+// a key with the admin scope cannot moderate unless it also has the moderate scope.
+type Scope string
+
+const (
+	// ScopeModerate allows hiding, removing and restoring posts.
+	ScopeModerate Scope = "moderate"
+
+	// ScopeExport allows downloading post datasets.
+	ScopeExport Scope = "export"
+
+	// ScopeAdmin allows reading the audit log.
+	ScopeAdmin Scope = "admin"
+)
+
+// Scopes lists all scopes in display order.
+var Scopes = []Scope{ScopeModerate, ScopeExport, ScopeAdmin}
+
+// NewScope creates a Scope from a string (case-insensitive).
+// Returns an error if the value is not a known scope.
+func NewScope(value string) (Scope, error) {
+	s := Scope(strings.ToLower(strings.TrimSpace(value)))
+	switch s {
+	case ScopeModerate, ScopeExport, ScopeAdmin:
+		return s, nil
+	default:
+		return "", fmt.Errorf("invalid scope: %q", value)
+	}
+}
+
+// String returns the string representation of the Scope.
+func (s Scope) String() string {
+	return string(s)
+}
+
+// APIKey is a credential for privileged RPCs, issued to an operator or a script.
+// Only the SHA-256 hash of the raw key is stored; the raw key is shown once when
+// it is issued. Keys are never deleted, only revoked, so the audit log can always
+// name the key that made a call.
+type APIKey struct {
+	// id is the unique identifier of the key (UUID).
+	id string
+
+	// name describes who or what uses the key (e.g. "alice" or "nightly export").
+	name string
+
+	// prefix is the start of the raw key, kept to tell keys apart.
+	prefix string
+
+	// keyHash is the SHA-256 hash of the raw key.
+	keyHash string
+
+	// scopes are the permissions granted to the key, in Scopes order.
+	scopes []Scope
+
+	// expiresAt is the time after which the key is no longer accepted (nil for no expiry).
+	expiresAt *time.Time
+
+	// createdAt is the time when the key was issued.
+	createdAt time.Time
+
+	// lastUsedAt is the time of the last accepted call, within LastUsedResolution (nil if never used).
+	lastUsedAt *time.Time
+
+	// revokedAt is the time when the key was revoked (nil while active).
+	revokedAt *time.Time
+}
+
+// IssueAPIKey issues a new API key with the given scopes and optional expiry.
+// It returns the key entity and the raw key value, which is only shown once.
+// Returns an error if the name is empty or too long, no scope is given, or the
+// expiry is not in the future.
+func IssueAPIKey(name string, scopes []Scope, expiresAt *time.Time) (*APIKey, string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, "", errors.New("API key name cannot be empty")
+	}
+	if utf8.RuneCountInString(name) > MaxAPIKeyNameLength {
+		return nil, "", fmt.Errorf("API key name cannot exceed %d characters", MaxAPIKeyNameLength)
+	}
+	scopes = normalizeScopes(scopes)
+	if len(scopes) == 0 {
+		return nil, "", errors.New("API key needs at least one scope")
+	}
+
+	now := time.Now()
+	if expiresAt != nil && !expiresAt.After(now) {
+		return nil, "", errors.New("API key expiry must be in the future")
+	}
+
+	raw, err := generateAPIKeyValue()
+	if err != nil {
+		return nil, "", err
+	}
+
+	return &APIKey{
+		id:        uuid.New().String(),
+		name:      name,
+		prefix:    raw[:apiKeyDisplayLength],
+		keyHash:   HashAPIKey(raw),
+		scopes:    scopes,
+		expiresAt: expiresAt,
+		createdAt: now,
+	}, raw, nil
+}
+
+// NewAPIKeyFromDB creates an APIKey from database data.
+// This is used by repositories to reconstruct APIKeys from database rows.
+func NewAPIKeyFromDB(
+	id string,
+	name string,
+	prefix string,
+	keyHash string,
+	scopes []Scope,
+	expiresAt *time.Time,
+	createdAt time.Time,
+	lastUsedAt *time.Time,
+	revokedAt *time.Time,
+) *APIKey {
+	return &APIKey{
+		id:         id,
+		name:       name,
+		prefix:     prefix,
+		keyHash:    keyHash,
+		scopes:     normalizeScopes(scopes),
+		expiresAt:  expiresAt,
+		createdAt:  createdAt,
+		lastUsedAt: lastUsedAt,
+		revokedAt:  revokedAt,
+	}
+}
+
+// ID returns the key ID.
+func (k *APIKey) ID() string {
+	return k.id
+}
+
+// Name returns the key name.
+func (k *APIKey) Name() string {
+	return k.name
+}
+
+// Prefix returns the start of the raw key.
+func (k *APIKey) Prefix() string {
+	return k.prefix
+}
+
+// KeyHash returns the SHA-256 hash of the raw key.
+func (k *APIKey) KeyHash() string {
+	return k.keyHash
+}
+
+// Scopes returns the scopes granted to the key.
+func (k *APIKey) Scopes() []Scope {
+	return append([]Scope(nil), k.scopes...)
+}
+
+// ExpiresAt returns the expiry time (nil for no expiry).
+func (k *APIKey) ExpiresAt() *time.Time {
+	return k.expiresAt
+}
+
+// CreatedAt returns the issue time.
+func (k *APIKey) CreatedAt() time.Time {
+	return k.createdAt
+}
+
+// LastUsedAt returns the time of the last accepted call (nil if never used).
+func (k *APIKey) LastUsedAt() *time.Time {
+	return k.lastUsedAt
+}
+
+// RevokedAt returns the revocation time (nil while active).
+func (k *APIKey) RevokedAt() *time.Time {
+	return k.revokedAt
+}
+
+// IsRevoked returns true if the key was revoked.
+func (k *APIKey) IsRevoked() bool {
+	return k.revokedAt != nil
+}
+
+// HasScope returns true if the key grants the scope.
+func (k *APIKey) HasScope(scope Scope) bool {
+	for _, s := range k.scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// Authorize checks that the key may make a call requiring the scope at the given time.
+// Returns ErrAPIKeyRevoked, ErrAPIKeyExpired or ErrScopeNotGranted.
+func (k *APIKey) Authorize(scope Scope, now time.Time) error {
+	if k.IsRevoked() {
+		return ErrAPIKeyRevoked
+	}
+	if k.expiresAt != nil && !now.Before(*k.expiresAt) {
+		return ErrAPIKeyExpired
+	}
+	if !k.HasScope(scope) {
+		return ErrScopeNotGranted
+	}
+	return nil
+}
+
+// Use records an accepted call at the given time. It returns true if the
+// last-used time changed by at least LastUsedResolution and should be saved.
+func (k *APIKey) Use(now time.Time) bool {
+	if k.lastUsedAt != nil && now.Sub(*k.lastUsedAt) < LastUsedResolution {
+		return false
+	}
+	k.lastUsedAt = &now
+	return true
+}
+
+// Revoke revokes the key. Revoking an already revoked key has no effect.
+func (k *APIKey) Revoke(now time.Time) {
+	if k.revokedAt == nil {
+		k.revokedAt = &now
+	}
+}
+
+// HashAPIKey returns the hex-encoded SHA-256 hash of a raw API key.
+// Raw keys have 256 bits of entropy, so a fast hash is enough and allows lookup by hash.
+func HashAPIKey(raw string) string {
+	sum := sha256.Sum256([]byte(strings.TrimSpace(raw)))
+	return hex.EncodeToString(sum[:])
+}
+
+// generateAPIKeyValue generates a raw API key: APIKeyPrefix followed by 32 random bytes (base64url).
+func generateAPIKeyValue() (string, error) {
+	buf := make([]byte, apiKeyBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate API key: %w", err)
+	}
+	return APIKeyPrefix + base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// normalizeScopes removes duplicates and sorts scopes in Scopes order.
+func normalizeScopes(scopes []Scope) []Scope {
+	var result []Scope
+	for _, scope := range Scopes {
+		for _, s := range scopes {
+			if s == scope {
+				result = append(result, scope)
+				break
+			}
+		}
+	}
+	return result
+}
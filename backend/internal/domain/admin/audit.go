@@ -0,0 +1,217 @@
+package admin
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CLIActor is the actor name of audit events recorded by the server's admin
+// subcommands, which run with database access instead of an API key.
+const CLIActor = "cli"
+
+// UserActorPrefix starts the actor name of audit events recorded for calls by
+// moderators, who authenticate as users instead of with an API key.
+const UserActorPrefix = "user:"
+
+// UserActor returns the actor name of audit events recorded for a moderator.
+func UserActor(userID string) string {
+	return UserActorPrefix + userID
+}
+
+// AuditEvent records one privileged call: who made it, what it was, what it
+// acted on and how it ended. Audit events are append-only; they are never
+// updated or deleted.
+type AuditEvent struct {
+	// id is the unique identifier of the event (UUID).
+	id string
+
+	// occurredAt is the time when the call finished.
+	occurredAt time.Time
+
+	// apiKeyID is the API key that made the call (empty for CLIActor and moderators).
+	apiKeyID string
+
+	// actor is the name of the API key, CLIActor, or UserActor of a moderator.
+	actor string
+
+	// action is the call, e.g. the full gRPC method name or the admin subcommand.
+	action string
+
+	// target is the ID of what the call acted on, e.g. a post ID (may be empty).
+	target string
+
+	// outcome is the result of the call: "OK" or the gRPC status code name.
+	outcome string
+
+	// clientIP is the address the call came from (empty for CLIActor).
+	clientIP string
+
+	// details is a JSON object with the call parameters (may be empty).
+	details string
+}
+
+// NewAuditEvent creates an AuditEvent that occurred now.
+// Returns an error if the actor, action or outcome is empty.
+func NewAuditEvent(apiKeyID, actor, action, target, outcome, clientIP, details string) (*AuditEvent, error) {
+	if strings.TrimSpace(actor) == "" {
+		return nil, errors.New("audit event actor cannot be empty")
+	}
+	if strings.TrimSpace(action) == "" {
+		return nil, errors.New("audit event action cannot be empty")
+	}
+	if strings.TrimSpace(outcome) == "" {
+		return nil, errors.New("audit event outcome cannot be empty")
+	}
+
+	return &AuditEvent{
+		id:         uuid.New().String(),
+		occurredAt: time.Now(),
+		apiKeyID:   apiKeyID,
+		actor:      actor,
+		action:     action,
+		target:     target,
+		outcome:    outcome,
+		clientIP:   clientIP,
+		details:    details,
+	}, nil
+}
+
+// NewAuditEventFromDB creates an AuditEvent from database data.
+// This is used by repositories to reconstruct AuditEvents from database rows.
+func NewAuditEventFromDB(id string, occurredAt time.Time, apiKeyID, actor, action, target, outcome, clientIP, details string) *AuditEvent {
+	return &AuditEvent{
+		id:         id,
+		occurredAt: occurredAt,
+		apiKeyID:   apiKeyID,
+		actor:      actor,
+		action:     action,
+		target:     target,
+		outcome:    outcome,
+		clientIP:   clientIP,
+		details:    details,
+	}
+}
+
+// ID returns the event ID.
+func (e *AuditEvent) ID() string {
+	return e.id
+}
+
+// OccurredAt returns the time when the call finished.
+func (e *AuditEvent) OccurredAt() time.Time {
+	return e.occurredAt
+}
+
+// APIKeyID returns the API key that made the call (empty for CLIActor and moderators).
+func (e *AuditEvent) APIKeyID() string {
+	return e.apiKeyID
+}
+
+// Actor returns the name of the API key, CLIActor, or UserActor of a moderator.
+func (e *AuditEvent) Actor() string {
+	return e.actor
+}
+
+// Action returns the call.
+func (e *AuditEvent) Action() string {
+	return e.action
+}
+
+// Target returns the ID of what the call acted on (may be empty).
+func (e *AuditEvent) Target() string {
+	return e.target
+}
+
+// Outcome returns the result of the call.
+func (e *AuditEvent) Outcome() string {
+	return e.outcome
+}
+
+// ClientIP returns the address the call came from.
+func (e *AuditEvent) ClientIP() string {
+	return e.clientIP
+}
+
+// Details returns the JSON call parameters (may be empty).
+func (e *AuditEvent) Details() string {
+	return e.details
+}
+
+// AuditFilter narrows down the events returned by AuditRepository.Find.
+// Empty fields do not filter.
+type AuditFilter struct {
+	// APIKeyID restricts results to calls made with one API key.
+	APIKeyID string
+
+	// Action restricts results to one action.
+	Action string
+
+	// Target restricts results to calls on one target.
+	Target string
+
+	// Since restricts results to events at or after this time.
+	Since *time.Time
+
+	// Until restricts results to events before this time.
+	Until *time.Time
+}
+
+// AuditCursor marks a position in the audit log (newest first).
+// It points at the last event of a page; the next page starts right after it.
+type AuditCursor struct {
+	// occurredAt is the time of the last event on the page.
+	occurredAt time.Time
+
+	// id is the ID of the last event on the page (tie-breaker for equal times).
+	id string
+}
+
+// AuditCursorAfter returns the AuditCursor pointing at the given event.
+func AuditCursorAfter(e *AuditEvent) AuditCursor {
+	return AuditCursor{occurredAt: e.occurredAt, id: e.id}
+}
+
+// ParseAuditCursor parses an opaque cursor string produced by AuditCursor.String.
+// Returns an error if the cursor is malformed.
+func ParseAuditCursor(value string) (AuditCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return AuditCursor{}, fmt.Errorf("invalid cursor: %w", err)
+	}
+
+	nanos, id, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return AuditCursor{}, fmt.Errorf("invalid cursor")
+	}
+
+	unixNano, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return AuditCursor{}, fmt.Errorf("invalid cursor: %w", err)
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return AuditCursor{}, fmt.Errorf("invalid cursor: %w", err)
+	}
+
+	return AuditCursor{occurredAt: time.Unix(0, unixNano), id: id}, nil
+}
+
+// String returns the opaque string form of the cursor.
+func (c AuditCursor) String() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(c.occurredAt.UnixNano(), 10) + ":" + c.id))
+}
+
+// OccurredAt returns the time of the event the cursor points at.
+func (c AuditCursor) OccurredAt() time.Time {
+	return c.occurredAt
+}
+
+// ID returns the ID of the event the cursor points at.
+func (c AuditCursor) ID() string {
+	return c.id
+}
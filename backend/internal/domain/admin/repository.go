@@ -0,0 +1,39 @@
+package admin
+
+import (
+	"context"
+	"time"
+)
+
+// APIKeyRepository defines the interface for APIKey persistence operations.
+// It is implemented by the Infrastructure Layer.
+type APIKeyRepository interface {
+	// Save saves an APIKey (insert, or update of the revocation state).
+	Save(ctx context.Context, key *APIKey) error
+
+	// FindByID finds an APIKey by its ID.
+	// Returns a not found error if the key does not exist.
+	FindByID(ctx context.Context, id string) (*APIKey, error)
+
+	// FindByHash finds an APIKey by the SHA-256 hash of its raw value.
+	// Returns a not found error if the key does not exist.
+	FindByHash(ctx context.Context, keyHash string) (*APIKey, error)
+
+	// FindAll returns all API keys, including revoked ones, newest first.
+	FindAll(ctx context.Context) ([]*APIKey, error)
+
+	// TouchLastUsed sets the last-used time of a key.
+	TouchLastUsed(ctx context.Context, id string, at time.Time) error
+}
+
+// AuditRepository defines the interface for the append-only audit log.
+// It is implemented by the Infrastructure Layer.
+type AuditRepository interface {
+	// Append stores a new audit event.
+	Append(ctx context.Context, event *AuditEvent) error
+
+	// Find finds audit events matching the filter, newest first.
+	// If after is non-nil, only events after that cursor are returned.
+	// At most limit events are returned.
+	Find(ctx context.Context, filter AuditFilter, after *AuditCursor, limit int) ([]*AuditEvent, error)
+}
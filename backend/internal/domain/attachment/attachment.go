@@ -0,0 +1,257 @@
+// Package attachment provides domain models for evidence attachments.
+// Attachments are files (screenshots of chats, pay slips, ...) uploaded by the author of a post
+// to back up what the post says. The file content lives in a blob store; this package only
+// models the metadata that links a stored blob to its post.
+package attachment
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"fuck_boss/backend/internal/domain/content"
+)
+
+const (
+	// MaxSize is the maximum size of an uploaded file in bytes (5 MiB).
+	MaxSize = 5 << 20
+
+	// MaxStoredSize is the maximum size of a stored file in bytes (20 MiB).
+	// Images are re-encoded before they are stored, which can grow them (e.g. WebP to PNG).
+	MaxStoredSize = 20 << 20
+
+	// MaxPerPost is the maximum number of attachments per post.
+	MaxPerPost = 6
+)
+
+// ContentType is the media type of an attachment.
+// Only a fixed set of image and document types is accepted; anything else
+// (HTML, SVG, scripts, archives) is rejected to keep downloads harmless.
+type ContentType string
+
+const (
+	// ContentTypeJPEG is a JPEG image.
+	ContentTypeJPEG ContentType = "image/jpeg"
+
+	// ContentTypePNG is a PNG image.
+	ContentTypePNG ContentType = "image/png"
+
+	// ContentTypeGIF is a GIF image.
+	ContentTypeGIF ContentType = "image/gif"
+
+	// ContentTypeWebP is a WebP image.
+	ContentTypeWebP ContentType = "image/webp"
+
+	// ContentTypePDF is a PDF document.
+	ContentTypePDF ContentType = "application/pdf"
+)
+
+// extensions maps accepted content types to file name extensions.
+var extensions = map[ContentType]string{
+	ContentTypeJPEG: ".jpg",
+	ContentTypePNG:  ".png",
+	ContentTypeGIF:  ".gif",
+	ContentTypeWebP: ".webp",
+	ContentTypePDF:  ".pdf",
+}
+
+// NewContentType creates a ContentType from a sniffed media type.
+// Parameters such as "; charset=utf-8" are not expected, since only binary types are accepted.
+// Returns an error if the type is not accepted.
+func NewContentType(value string) (ContentType, error) {
+	t := ContentType(value)
+	if _, ok := extensions[t]; !ok {
+		return "", fmt.Errorf("unsupported content type: %q", value)
+	}
+	return t, nil
+}
+
+// String returns the string representation of the ContentType.
+func (t ContentType) String() string {
+	return string(t)
+}
+
+// Extension returns the file name extension for the type (e.g. ".png").
+func (t ContentType) Extension() string {
+	return extensions[t]
+}
+
+// IsImage reports whether the type is an image (displayed inline rather than downloaded).
+func (t ContentType) IsImage() bool {
+	return strings.HasPrefix(string(t), "image/")
+}
+
+// Attachment is a file attached to a post.
+type Attachment struct {
+	// id is the unique identifier of the attachment (UUID).
+	id string
+
+	// postID is the post the attachment belongs to.
+	postID content.PostID
+
+	// contentType is the media type of the stored file.
+	contentType ContentType
+
+	// size is the size of the file in bytes.
+	size int64
+
+	// checksum is the hex-encoded SHA-256 of the file content.
+	checksum string
+
+	// width is the image width in pixels (0 for documents).
+	width int
+
+	// height is the image height in pixels (0 for documents).
+	height int
+
+	// thumbnailType is the media type of the thumbnail (empty if there is none).
+	thumbnailType ContentType
+
+	// createdAt is the time when the attachment was uploaded.
+	createdAt time.Time
+}
+
+// NewAttachment creates a new Attachment for a stored file of the given size and checksum.
+// Returns an error if the size is zero or exceeds MaxStoredSize.
+func NewAttachment(postID content.PostID, contentType ContentType, size int64, checksum string) (*Attachment, error) {
+	if size <= 0 {
+		return nil, fmt.Errorf("attachment is empty")
+	}
+	if size > MaxStoredSize {
+		return nil, fmt.Errorf("attachment exceeds %d bytes", MaxStoredSize)
+	}
+	if len(checksum) != 64 {
+		return nil, fmt.Errorf("invalid checksum")
+	}
+
+	return &Attachment{
+		id:          uuid.New().String(),
+		postID:      postID,
+		contentType: contentType,
+		size:        size,
+		checksum:    checksum,
+		createdAt:   time.Now(),
+	}, nil
+}
+
+// NewAttachmentFromDB creates an Attachment from database data.
+// This is used by repositories to reconstruct Attachments from database rows.
+func NewAttachmentFromDB(
+	id string,
+	postID content.PostID,
+	contentType ContentType,
+	size int64,
+	checksum string,
+	width int,
+	height int,
+	thumbnailType ContentType,
+	createdAt time.Time,
+) *Attachment {
+	return &Attachment{
+		id:            id,
+		postID:        postID,
+		contentType:   contentType,
+		size:          size,
+		checksum:      checksum,
+		width:         width,
+		height:        height,
+		thumbnailType: thumbnailType,
+		createdAt:     createdAt,
+	}
+}
+
+// AssignImageInfo records the dimensions of an image attachment and the type of its thumbnail.
+// Returns an error if the attachment is not an image or the dimensions are not positive.
+func (a *Attachment) AssignImageInfo(width, height int, thumbnailType ContentType) error {
+	if !a.contentType.IsImage() {
+		return fmt.Errorf("attachment is not an image")
+	}
+	if width <= 0 || height <= 0 {
+		return fmt.Errorf("invalid image dimensions: %dx%d", width, height)
+	}
+	if !thumbnailType.IsImage() {
+		return fmt.Errorf("thumbnail is not an image")
+	}
+
+	a.width = width
+	a.height = height
+	a.thumbnailType = thumbnailType
+	return nil
+}
+
+// ID returns the attachment ID.
+func (a *Attachment) ID() string {
+	return a.id
+}
+
+// PostID returns the post the attachment belongs to.
+func (a *Attachment) PostID() content.PostID {
+	return a.postID
+}
+
+// ContentType returns the media type.
+func (a *Attachment) ContentType() ContentType {
+	return a.contentType
+}
+
+// Size returns the size of the file in bytes.
+func (a *Attachment) Size() int64 {
+	return a.size
+}
+
+// Checksum returns the hex-encoded SHA-256 of the file content.
+func (a *Attachment) Checksum() string {
+	return a.checksum
+}
+
+// Width returns the image width in pixels (0 for documents).
+func (a *Attachment) Width() int {
+	return a.width
+}
+
+// Height returns the image height in pixels (0 for documents).
+func (a *Attachment) Height() int {
+	return a.height
+}
+
+// HasThumbnail reports whether a thumbnail is stored for the attachment.
+func (a *Attachment) HasThumbnail() bool {
+	return a.thumbnailType != ""
+}
+
+// ThumbnailType returns the media type of the thumbnail (empty if there is none).
+func (a *Attachment) ThumbnailType() ContentType {
+	return a.thumbnailType
+}
+
+// CreatedAt returns the upload time.
+func (a *Attachment) CreatedAt() time.Time {
+	return a.createdAt
+}
+
+// StorageKey returns the key of the file in the blob store ("posts/<post id>/<id><ext>").
+// Keys are derived from server-generated IDs only, never from client-supplied file names.
+func (a *Attachment) StorageKey() string {
+	return "posts/" + a.postID.String() + "/" + a.id + a.contentType.Extension()
+}
+
+// FileName returns the file name offered to clients downloading the attachment.
+func (a *Attachment) FileName() string {
+	return a.id + a.contentType.Extension()
+}
+
+// ThumbnailKey returns the key of the thumbnail in the blob store
+// ("posts/<post id>/<id>_thumb<ext>"), or an empty string if there is none.
+func (a *Attachment) ThumbnailKey() string {
+	if !a.HasThumbnail() {
+		return ""
+	}
+	return "posts/" + a.postID.String() + "/" + a.ThumbnailFileName()
+}
+
+// ThumbnailFileName returns the file name offered to clients downloading the thumbnail.
+func (a *Attachment) ThumbnailFileName() string {
+	return a.id + "_thumb" + a.thumbnailType.Extension()
+}
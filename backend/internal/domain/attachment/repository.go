@@ -0,0 +1,24 @@
+package attachment
+
+import (
+	"context"
+
+	"fuck_boss/backend/internal/domain/content"
+)
+
+// AttachmentRepository defines the interface for attachment metadata persistence.
+// File content is stored separately in a blob store under Attachment.StorageKey.
+type AttachmentRepository interface {
+	// Save stores the metadata of a newly uploaded attachment.
+	Save(ctx context.Context, attachment *Attachment) error
+
+	// FindByID returns the attachment with the given ID.
+	// Returns a NOT_FOUND error if it does not exist.
+	FindByID(ctx context.Context, id string) (*Attachment, error)
+
+	// FindByPost returns the attachments of a post, oldest first.
+	FindByPost(ctx context.Context, postID content.PostID) ([]*Attachment, error)
+
+	// CountByPost returns the number of attachments of a post.
+	CountByPost(ctx context.Context, postID content.PostID) (int, error)
+}
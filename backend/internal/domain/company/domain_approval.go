@@ -0,0 +1,61 @@
+package company
+
+import (
+	"errors"
+	"time"
+
+	"fuck_boss/backend/internal/domain/content"
+)
+
+// ErrDomainNotApproved is returned when a representative's domain has not been
+// approved for the company by an operator.
+var ErrDomainNotApproved = errors.New("company domain has not been approved by an operator")
+
+// DomainApproval records that an operator checked that a domain belongs to a
+// company. A domain challenge only proves control of the domain, not that the
+// domain is the company's, so representatives are verified only for approved
+// company domains.
+type DomainApproval struct {
+	// company is the company name exactly as it appears in posts.
+	company content.CompanyName
+
+	// domain is the approved company domain.
+	domain CompanyDomain
+
+	// approvedAt is the time when the operator approved the domain.
+	approvedAt time.Time
+}
+
+// NewDomainApproval approves the domain for the company.
+func NewDomainApproval(company content.CompanyName, domain CompanyDomain) *DomainApproval {
+	return &DomainApproval{
+		company:    company,
+		domain:     domain,
+		approvedAt: time.Now(),
+	}
+}
+
+// NewDomainApprovalFromDB creates a DomainApproval from database data.
+// This is used by repositories to reconstruct approvals from database rows.
+func NewDomainApprovalFromDB(company content.CompanyName, domain CompanyDomain, approvedAt time.Time) *DomainApproval {
+	return &DomainApproval{
+		company:    company,
+		domain:     domain,
+		approvedAt: approvedAt,
+	}
+}
+
+// Company returns the company name.
+func (a *DomainApproval) Company() content.CompanyName {
+	return a.company
+}
+
+// Domain returns the approved domain.
+func (a *DomainApproval) Domain() CompanyDomain {
+	return a.domain
+}
+
+// ApprovedAt returns the time when the domain was approved.
+func (a *DomainApproval) ApprovedAt() time.Time {
+	return a.approvedAt
+}
@@ -0,0 +1,69 @@
+// Package company provides domain models for company representatives.
+// It includes the Representative aggregate, domain ownership challenges, and repository interfaces.
+package company
+
+import (
+	"context"
+
+	"fuck_boss/backend/internal/domain/content"
+)
+
+// RepresentativeRepository defines the interface for Representative persistence.
+// It follows the Dependency Inversion Principle by defining the interface
+// in the Domain Layer, while implementations are in the Infrastructure Layer.
+type RepresentativeRepository interface {
+	// Save saves a Representative to the repository.
+	// If the Representative already exists (same ID), it updates the existing record.
+	// Returns an error if the operation fails.
+	Save(ctx context.Context, representative *Representative) error
+
+	// FindByID finds a Representative by its ID.
+	// Returns the Representative if found, or a NotFound error if not found.
+	FindByID(ctx context.Context, id RepresentativeID) (*Representative, error)
+}
+
+// DomainApprovalRepository defines the interface for DomainApproval persistence.
+// Approvals are managed by operators through the admin subcommands.
+type DomainApprovalRepository interface {
+	// Save stores an approval. Approving an already approved domain keeps the
+	// original approval time.
+	Save(ctx context.Context, approval *DomainApproval) error
+
+	// IsApproved reports whether the domain is approved for the company.
+	IsApproved(ctx context.Context, company content.CompanyName, domain CompanyDomain) (bool, error)
+
+	// FindAll returns all approvals, ordered by company name and domain.
+	FindAll(ctx context.Context) ([]*DomainApproval, error)
+
+	// Delete revokes the approval of the domain for the company.
+	// Returns a not found error if the domain is not approved for the company.
+	Delete(ctx context.Context, company content.CompanyName, domain CompanyDomain) error
+}
+
+// MergeResult counts the records moved from one company name to another by
+// CompanyMergeRepository.Merge.
+type MergeResult struct {
+	// Posts is the number of posts renamed.
+	Posts int
+
+	// Representatives is the number of company representatives renamed.
+	Representatives int
+
+	// Watches is the number of company watches moved, including watches
+	// dropped because the owner already watched the target company.
+	Watches int
+
+	// Domains is the number of approved company domains moved, including
+	// approvals dropped because the target company already had the domain.
+	Domains int
+}
+
+// CompanyMergeRepository merges duplicate company names (e.g. a misspelling
+// into the correct name). Companies are not stored on their own; they are the
+// company names of posts, representatives, watches and domain approvals.
+type CompanyMergeRepository interface {
+	// Merge renames the company from to into in all posts, representatives,
+	// watches and domain approvals in one transaction. Merging a company nobody
+	// references is not an error.
+	Merge(ctx context.Context, from, into content.CompanyName) (*MergeResult, error)
+}
@@ -0,0 +1,169 @@
+// Package company provides domain models for company representatives.
+// It includes the Representative aggregate, domain ownership challenges, and repository interfaces.
+package company
+
+import (
+	"crypto/subtle"
+	"errors"
+	"time"
+
+	"fuck_boss/backend/internal/domain/content"
+)
+
+// ErrAccessTokenMismatch is returned when an access token does not match the representative.
+var ErrAccessTokenMismatch = errors.New("access token does not match representative")
+
+// ErrNotVerified is returned when a pending representative tries to act for the company.
+var ErrNotVerified = errors.New("representative has not verified the company domain")
+
+// Representative represents a company representative aggregate root.
+// A representative registers for a company and a domain, then proves control
+// of the domain through a challenge before speaking for the company.
+type Representative struct {
+	// id is the unique identifier of the representative.
+	id RepresentativeID
+
+	// company is the company the representative speaks for.
+	company content.CompanyName
+
+	// challenge is the domain ownership challenge issued at registration.
+	challenge Challenge
+
+	// accessTokenHash is the SHA-256 hash of the representative's access token.
+	accessTokenHash string
+
+	// status is the verification status.
+	status RepresentativeStatus
+
+	// createdAt is the time when the representative registered.
+	createdAt time.Time
+
+	// verifiedAt is the time when the challenge was passed (nil while pending).
+	verifiedAt *time.Time
+}
+
+// NewRepresentative registers a new, pending Representative.
+// It generates the ID, the domain challenge and the access token.
+// The raw access token is returned once and never stored.
+// Returns an error if random generation fails.
+func NewRepresentative(company content.CompanyName, domain CompanyDomain, method ChallengeMethod) (*Representative, AccessToken, error) {
+	challenge, err := generateChallenge(method, domain)
+	if err != nil {
+		return nil, AccessToken{}, err
+	}
+
+	token, err := GenerateAccessToken()
+	if err != nil {
+		return nil, AccessToken{}, err
+	}
+
+	representative := &Representative{
+		id:              GenerateRepresentativeID(),
+		company:         company,
+		challenge:       challenge,
+		accessTokenHash: token.Hash(),
+		status:          RepresentativeStatusPending,
+		createdAt:       time.Now(),
+	}
+
+	return representative, token, nil
+}
+
+// NewRepresentativeFromDB creates a Representative from database data.
+// This is used by repositories to reconstruct Representatives from database rows.
+func NewRepresentativeFromDB(
+	id RepresentativeID,
+	company content.CompanyName,
+	challenge Challenge,
+	accessTokenHash string,
+	status RepresentativeStatus,
+	createdAt time.Time,
+	verifiedAt *time.Time,
+) *Representative {
+	return &Representative{
+		id:              id,
+		company:         company,
+		challenge:       challenge,
+		accessTokenHash: accessTokenHash,
+		status:          status,
+		createdAt:       createdAt,
+		verifiedAt:      verifiedAt,
+	}
+}
+
+// ID returns the Representative ID.
+func (r *Representative) ID() RepresentativeID {
+	return r.id
+}
+
+// Company returns the company the representative speaks for.
+func (r *Representative) Company() content.CompanyName {
+	return r.company
+}
+
+// Challenge returns the domain ownership challenge.
+func (r *Representative) Challenge() Challenge {
+	return r.challenge
+}
+
+// Domain returns the company domain.
+func (r *Representative) Domain() CompanyDomain {
+	return r.challenge.Domain()
+}
+
+// AccessTokenHash returns the hash of the access token.
+func (r *Representative) AccessTokenHash() string {
+	return r.accessTokenHash
+}
+
+// Status returns the verification status.
+func (r *Representative) Status() RepresentativeStatus {
+	return r.status
+}
+
+// IsVerified reports whether the representative passed the domain challenge.
+func (r *Representative) IsVerified() bool {
+	return r.status == RepresentativeStatusVerified
+}
+
+// CreatedAt returns the registration time.
+func (r *Representative) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+// VerifiedAt returns the verification time (nil while pending).
+func (r *Representative) VerifiedAt() *time.Time {
+	return r.verifiedAt
+}
+
+// Authenticate checks the raw access token against the stored hash.
+// Returns ErrAccessTokenMismatch if the token does not match.
+func (r *Representative) Authenticate(raw string) error {
+	if r.accessTokenHash == "" || raw == "" {
+		return ErrAccessTokenMismatch
+	}
+	given := HashAccessToken(raw)
+	if subtle.ConstantTimeCompare([]byte(given), []byte(r.accessTokenHash)) != 1 {
+		return ErrAccessTokenMismatch
+	}
+	return nil
+}
+
+// MarkVerified records that the domain challenge was passed.
+// Verifying an already verified representative keeps the original timestamp.
+func (r *Representative) MarkVerified() {
+	if r.IsVerified() {
+		return
+	}
+	now := time.Now()
+	r.status = RepresentativeStatusVerified
+	r.verifiedAt = &now
+}
+
+// EnsureCanSpeak returns ErrNotVerified unless the representative is verified.
+func (r *Representative) EnsureCanSpeak() error {
+	if !r.IsVerified() {
+		return ErrNotVerified
+	}
+	return nil
+}
@@ -0,0 +1,256 @@
+// Package company provides domain models for company representatives.
+// It includes the Representative aggregate, domain ownership challenges, and repository interfaces.
+package company
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// RepresentativeID represents a unique identifier for a Representative.
+// It is a value object that encapsulates the business rules for Representative IDs.
+type RepresentativeID struct {
+	// value is the UUID string representation of the Representative ID.
+	value string
+}
+
+// NewRepresentativeID creates a new RepresentativeID from a UUID string.
+// It validates that the string is a valid UUID format.
+// Returns an error if the UUID format is invalid.
+func NewRepresentativeID(value string) (RepresentativeID, error) {
+	value = strings.TrimSpace(value)
+
+	if _, err := uuid.Parse(value); err != nil {
+		return RepresentativeID{}, fmt.Errorf("invalid RepresentativeID format: %w", err)
+	}
+
+	return RepresentativeID{value: value}, nil
+}
+
+// GenerateRepresentativeID generates a new RepresentativeID with a random UUID.
+func GenerateRepresentativeID() RepresentativeID {
+	return RepresentativeID{value: uuid.New().String()}
+}
+
+// String returns the string representation of the RepresentativeID.
+func (id RepresentativeID) String() string {
+	return id.value
+}
+
+// IsZero returns true if the RepresentativeID is the zero value.
+func (id RepresentativeID) IsZero() bool {
+	return id.value == ""
+}
+
+// Equals returns true if this RepresentativeID equals the other RepresentativeID.
+func (id RepresentativeID) Equals(other RepresentativeID) bool {
+	return id.value == other.value
+}
+
+// CompanyDomain represents an internet domain owned by a company (e.g., "example.com").
+// It is a value object that encapsulates the business rules for company domains.
+type CompanyDomain struct {
+	// value is the lower-cased domain name.
+	value string
+}
+
+// domainPattern matches a fully qualified domain name with at least one dot.
+var domainPattern = regexp.MustCompile(`^([a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?\.)+[a-z]{2,63}$`)
+
+// NewCompanyDomain creates a new CompanyDomain from a string.
+// It lower-cases the input, strips a trailing dot, and validates the domain format.
+// IP addresses, schemes, paths and ports are rejected.
+// Returns an error if validation fails.
+func NewCompanyDomain(value string) (CompanyDomain, error) {
+	trimmed := strings.TrimSuffix(strings.ToLower(strings.TrimSpace(value)), ".")
+
+	if trimmed == "" {
+		return CompanyDomain{}, fmt.Errorf("company domain cannot be empty")
+	}
+	if len(trimmed) > 253 {
+		return CompanyDomain{}, fmt.Errorf("company domain must be at most 253 characters")
+	}
+	if net.ParseIP(trimmed) != nil {
+		return CompanyDomain{}, fmt.Errorf("company domain cannot be an IP address")
+	}
+	if !domainPattern.MatchString(trimmed) {
+		return CompanyDomain{}, fmt.Errorf("invalid company domain: %q", value)
+	}
+
+	return CompanyDomain{value: trimmed}, nil
+}
+
+// String returns the string representation of the CompanyDomain.
+func (d CompanyDomain) String() string {
+	return d.value
+}
+
+// IsZero returns true if the CompanyDomain is the zero value.
+func (d CompanyDomain) IsZero() bool {
+	return d.value == ""
+}
+
+// ChallengeMethod is the way a representative proves control of the company domain.
+type ChallengeMethod string
+
+const (
+	// ChallengeMethodDNSTXT proves control by publishing a DNS TXT record.
+	ChallengeMethodDNSTXT ChallengeMethod = "DNS_TXT"
+	// ChallengeMethodFile proves control by serving a well-known file over HTTPS.
+	ChallengeMethodFile ChallengeMethod = "FILE"
+)
+
+// NewChallengeMethod creates a ChallengeMethod from a string (case-insensitive).
+// Returns an error if the method is not DNS_TXT or FILE.
+func NewChallengeMethod(value string) (ChallengeMethod, error) {
+	method := ChallengeMethod(strings.ToUpper(strings.TrimSpace(value)))
+	switch method {
+	case ChallengeMethodDNSTXT, ChallengeMethodFile:
+		return method, nil
+	default:
+		return "", fmt.Errorf("invalid challenge method: %q", value)
+	}
+}
+
+// String returns the string representation of the ChallengeMethod.
+func (m ChallengeMethod) String() string {
+	return string(m)
+}
+
+// RepresentativeStatus is the verification state of a representative.
+type RepresentativeStatus string
+
+const (
+	// RepresentativeStatusPending means the domain challenge has not been passed yet.
+	RepresentativeStatusPending RepresentativeStatus = "PENDING"
+	// RepresentativeStatusVerified means the representative proved control of the domain.
+	RepresentativeStatusVerified RepresentativeStatus = "VERIFIED"
+)
+
+// String returns the string representation of the RepresentativeStatus.
+func (s RepresentativeStatus) String() string {
+	return string(s)
+}
+
+const (
+	// challengeRecordPrefix is the DNS label that holds the TXT challenge record.
+	challengeRecordPrefix = "_fuckboss-challenge"
+	// challengeValuePrefix prefixes the challenge code in the TXT record.
+	challengeValuePrefix = "fuckboss-verification="
+	// challengeFilePath is the well-known path of the file challenge.
+	challengeFilePath = "/.well-known/fuckboss-verification.txt"
+	// challengeCodeBytes is the number of random bytes in a challenge code.
+	challengeCodeBytes = 24
+)
+
+// Challenge describes what a representative must publish to prove control of a domain.
+// It is a value object; the code is random and bound to one registration.
+type Challenge struct {
+	// method is the challenge method.
+	method ChallengeMethod
+
+	// domain is the company domain being verified.
+	domain CompanyDomain
+
+	// code is the random challenge code.
+	code string
+}
+
+// NewChallenge creates a Challenge from stored data.
+func NewChallenge(method ChallengeMethod, domain CompanyDomain, code string) Challenge {
+	return Challenge{method: method, domain: domain, code: code}
+}
+
+// generateChallenge creates a Challenge with a new random code.
+func generateChallenge(method ChallengeMethod, domain CompanyDomain) (Challenge, error) {
+	code, err := randomToken(challengeCodeBytes)
+	if err != nil {
+		return Challenge{}, fmt.Errorf("failed to generate challenge code: %w", err)
+	}
+	return NewChallenge(method, domain, code), nil
+}
+
+// Method returns the challenge method.
+func (c Challenge) Method() ChallengeMethod {
+	return c.method
+}
+
+// Domain returns the company domain being verified.
+func (c Challenge) Domain() CompanyDomain {
+	return c.domain
+}
+
+// Code returns the random challenge code.
+func (c Challenge) Code() string {
+	return c.code
+}
+
+// RecordName returns the DNS name that must hold the TXT record
+// (e.g., "_fuckboss-challenge.example.com").
+func (c Challenge) RecordName() string {
+	return challengeRecordPrefix + "." + c.domain.String()
+}
+
+// RecordValue returns the TXT record value (e.g., "fuckboss-verification=abc...").
+func (c Challenge) RecordValue() string {
+	return challengeValuePrefix + c.code
+}
+
+// FileURL returns the URL that must serve the challenge code
+// (e.g., "https://example.com/.well-known/fuckboss-verification.txt").
+func (c Challenge) FileURL() string {
+	return "https://" + c.domain.String() + challengeFilePath
+}
+
+// AccessToken is the secret handed to a representative at registration.
+// Only its SHA-256 hash is persisted; the raw value authenticates later requests.
+type AccessToken struct {
+	// value is the raw token string.
+	value string
+}
+
+// accessTokenBytes is the number of random bytes in an access token.
+const accessTokenBytes = 32
+
+// GenerateAccessToken generates a new random AccessToken.
+func GenerateAccessToken() (AccessToken, error) {
+	value, err := randomToken(accessTokenBytes)
+	if err != nil {
+		return AccessToken{}, fmt.Errorf("failed to generate access token: %w", err)
+	}
+	return AccessToken{value: value}, nil
+}
+
+// String returns the raw token value.
+// It must only be shown to the representative once, right after registration.
+func (t AccessToken) String() string {
+	return t.value
+}
+
+// Hash returns the hex-encoded SHA-256 hash of the token.
+func (t AccessToken) Hash() string {
+	return HashAccessToken(t.value)
+}
+
+// HashAccessToken returns the hex-encoded SHA-256 hash of a raw token.
+func HashAccessToken(raw string) string {
+	sum := sha256.Sum256([]byte(strings.TrimSpace(raw)))
+	return hex.EncodeToString(sum[:])
+}
+
+// randomToken returns n random bytes encoded as unpadded base64url.
+func randomToken(n int) (string, error) {
+	buf := make([]byte, n)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
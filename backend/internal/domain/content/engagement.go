@@ -0,0 +1,135 @@
+package content
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// Hot score weights and decay.
+//
+// Trending was specified as views, verifications and comments, but posts have
+// neither comments nor reader verifications ("me too" confirmations). The score
+// uses the interactions that do exist instead: author follow-ups stand in for
+// comments (they are the only replies under a post), and the official reply of
+// a representative whose company domain was verified stands in for verifications.
+// Both say more about a post than a single view, so they weigh as much as
+// several unique viewers. Replace them once comments or verifications exist.
+const (
+	// ViewWeight is the score of one unique view.
+	ViewWeight = 1.0
+
+	// FollowUpWeight is the score of one author follow-up.
+	FollowUpWeight = 5.0
+
+	// OfficialReplyWeight is the score of a reply by a verified company representative.
+	OfficialReplyWeight = 10.0
+
+	// HotScoreGravity controls how fast the score decays with age.
+	HotScoreGravity = 1.5
+)
+
+// PostEngagement is a read-only projection of the interactions with a post,
+// used to rank trending posts.
+type PostEngagement struct {
+	// postID is the ID of the post.
+	postID PostID
+
+	// cityCode is the city code of the post.
+	cityCode string
+
+	// createdAt is when the post was created.
+	createdAt time.Time
+
+	// views is the number of unique views (summed over days).
+	views int64
+
+	// followUps is the number of author follow-ups.
+	followUps int
+
+	// officialReplies is the number of official company replies (0 or 1).
+	officialReplies int
+}
+
+// NewPostEngagementFromDB reconstructs a PostEngagement from database data.
+func NewPostEngagementFromDB(
+	postID PostID,
+	cityCode string,
+	createdAt time.Time,
+	views int64,
+	followUps int,
+	officialReplies int,
+) *PostEngagement {
+	return &PostEngagement{
+		postID:          postID,
+		cityCode:        cityCode,
+		createdAt:       createdAt,
+		views:           views,
+		followUps:       followUps,
+		officialReplies: officialReplies,
+	}
+}
+
+// PostID returns the post ID.
+func (e *PostEngagement) PostID() PostID {
+	return e.postID
+}
+
+// CityCode returns the city code of the post.
+func (e *PostEngagement) CityCode() string {
+	return e.cityCode
+}
+
+// CreatedAt returns when the post was created.
+func (e *PostEngagement) CreatedAt() time.Time {
+	return e.createdAt
+}
+
+// Views returns the number of unique views.
+func (e *PostEngagement) Views() int64 {
+	return e.views
+}
+
+// FollowUps returns the number of author follow-ups.
+func (e *PostEngagement) FollowUps() int {
+	return e.followUps
+}
+
+// OfficialReplies returns the number of official company replies.
+func (e *PostEngagement) OfficialReplies() int {
+	return e.officialReplies
+}
+
+// HotScore returns the time-decayed popularity of the post at now:
+//
+//	(views*ViewWeight + followUps*FollowUpWeight + officialReplies*OfficialReplyWeight) / (ageHours + 2)^HotScoreGravity
+//
+// with ViewWeight 1, FollowUpWeight 5, OfficialReplyWeight 10 and HotScoreGravity 1.5.
+// Follow-ups and official replies replace the comments and verifications of
+// the original specification (see the weights above).
+// Newer posts need fewer interactions to rank as high as older ones.
+func (e *PostEngagement) HotScore(now time.Time) float64 {
+	points := float64(e.views)*ViewWeight +
+		float64(e.followUps)*FollowUpWeight +
+		float64(e.officialReplies)*OfficialReplyWeight
+
+	ageHours := now.Sub(e.createdAt).Hours()
+	if ageHours < 0 {
+		ageHours = 0
+	}
+
+	return points / math.Pow(ageHours+2, HotScoreGravity)
+}
+
+// EngagementRepository defines the interface for post engagement persistence.
+// Implementations are in the Infrastructure Layer.
+type EngagementRepository interface {
+	// SaveDailyViews stores the unique view counts of posts on a day.
+	// Counts are absolute for the day: saving a lower count than the stored one
+	// keeps the stored count, so the same counts can be saved repeatedly.
+	// Posts that no longer exist are skipped.
+	SaveDailyViews(ctx context.Context, day time.Time, views map[PostID]int64) error
+
+	// FindEngagementSince returns the engagement of all visible posts created after since.
+	FindEngagementSince(ctx context.Context, since time.Time) ([]*PostEngagement, error)
+}
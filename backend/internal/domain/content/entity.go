@@ -3,6 +3,8 @@
 package content
 
 import (
+	"crypto/subtle"
+	"fmt"
 	"time"
 
 	"fuck_boss/backend/internal/domain/shared"
@@ -25,6 +27,71 @@ type Post struct {
 
 	// createdAt is the time when the post was created.
 	createdAt time.Time
+
+	// managementTokenHash is the SHA-256 hash of the author's management token.
+	// It is empty for posts created before management tokens existed.
+	managementTokenHash string
+
+	// authorID is the ID of the logged-in user who created the post.
+	// It is empty for anonymous posts and is never exposed publicly.
+	authorID string
+
+	// resolution is the resolution status of the latest follow-up.
+	resolution ResolutionStatus
+
+	// followUps is the timeline of follow-ups appended by the author, oldest first.
+	followUps []*FollowUp
+
+	// officialReply is the right-of-reply from a verified company representative (nil if none).
+	officialReply *OfficialReply
+
+	// moderation is the moderation state; new posts are visible.
+	moderation Moderation
+}
+
+// PostOption restores optional state when reconstructing a Post from storage.
+type PostOption func(*Post)
+
+// WithManagementTokenHash restores the stored management token hash.
+func WithManagementTokenHash(hash string) PostOption {
+	return func(p *Post) {
+		p.managementTokenHash = hash
+	}
+}
+
+// WithAuthorID restores the ID of the user who created the post.
+func WithAuthorID(authorID string) PostOption {
+	return func(p *Post) {
+		p.authorID = authorID
+	}
+}
+
+// WithResolution restores the stored resolution status.
+func WithResolution(status ResolutionStatus) PostOption {
+	return func(p *Post) {
+		p.resolution = status
+	}
+}
+
+// WithFollowUps restores the follow-up timeline (oldest first).
+func WithFollowUps(followUps []*FollowUp) PostOption {
+	return func(p *Post) {
+		p.followUps = followUps
+	}
+}
+
+// WithOfficialReply restores the official company reply (nil if none).
+func WithOfficialReply(reply *OfficialReply) PostOption {
+	return func(p *Post) {
+		p.officialReply = reply
+	}
+}
+
+// WithModeration restores the stored moderation state.
+func WithModeration(moderation Moderation) PostOption {
+	return func(p *Post) {
+		p.moderation = moderation
+	}
 }
 
 // NewPost creates a new Post aggregate root.
@@ -40,11 +107,12 @@ func NewPost(company CompanyName, city shared.City, content Content) (*Post, err
 
 	// Create Post
 	post := &Post{
-		id:        id,
-		company:   company,
-		city:      city,
-		content:   content,
-		createdAt: createdAt,
+		id:         id,
+		company:    company,
+		city:       city,
+		content:    content,
+		createdAt:  createdAt,
+		moderation: Moderation{Status: ModerationStatusVisible},
 	}
 
 	return post, nil
@@ -53,16 +121,22 @@ func NewPost(company CompanyName, city shared.City, content Content) (*Post, err
 // NewPostFromDB creates a Post aggregate root from database data.
 // This is used by repositories to reconstruct Post entities from database rows.
 // It accepts an existing ID and createdAt timestamp from the database.
+// Optional state (management token hash, follow-ups, ...) is restored through opts.
 // All value objects are validated through their factory methods.
 // Returns an error if any validation fails.
-func NewPostFromDB(id PostID, company CompanyName, city shared.City, content Content, createdAt time.Time) (*Post, error) {
+func NewPostFromDB(id PostID, company CompanyName, city shared.City, content Content, createdAt time.Time, opts ...PostOption) (*Post, error) {
 	// Create Post with provided ID and createdAt
 	post := &Post{
-		id:        id,
-		company:   company,
-		city:      city,
-		content:   content,
-		createdAt: createdAt,
+		id:         id,
+		company:    company,
+		city:       city,
+		content:    content,
+		createdAt:  createdAt,
+		moderation: Moderation{Status: ModerationStatusVisible},
+	}
+
+	for _, opt := range opts {
+		opt(post)
 	}
 
 	return post, nil
@@ -101,3 +175,89 @@ func (p *Post) Content() Content {
 func (p *Post) CreatedAt() time.Time {
 	return p.createdAt
 }
+
+// AssignManagementToken binds a management token to the post.
+// Only the token hash is kept on the aggregate.
+func (p *Post) AssignManagementToken(token ManagementToken) {
+	p.managementTokenHash = token.Hash()
+}
+
+// AssignAuthor records the logged-in user who created the post.
+// Anonymous posts never call this method.
+func (p *Post) AssignAuthor(userID string) {
+	p.authorID = userID
+}
+
+// AuthorID returns the ID of the user who created the post (empty for anonymous posts).
+func (p *Post) AuthorID() string {
+	return p.authorID
+}
+
+// ManagementTokenHash returns the hash of the management token (empty if none).
+func (p *Post) ManagementTokenHash() string {
+	return p.managementTokenHash
+}
+
+// VerifyManagementToken reports whether the raw token proves authorship of the post.
+// Posts without a management token never verify.
+func (p *Post) VerifyManagementToken(raw string) bool {
+	if p.managementTokenHash == "" || raw == "" {
+		return false
+	}
+	given := HashManagementToken(raw)
+	return subtle.ConstantTimeCompare([]byte(given), []byte(p.managementTokenHash)) == 1
+}
+
+// AppendFollowUp appends a dated follow-up to the post timeline.
+// The caller must prove authorship with the post's management token.
+// The post's resolution status becomes the status of the new entry.
+// Returns ErrManagementTokenMismatch if the token does not match.
+func (p *Post) AppendFollowUp(token string, status ResolutionStatus, note FollowUpNote) (*FollowUp, error) {
+	if !p.VerifyManagementToken(token) {
+		return nil, ErrManagementTokenMismatch
+	}
+	if status.IsZero() {
+		return nil, fmt.Errorf("follow-up status is required")
+	}
+
+	followUp := newFollowUp(status, note)
+	p.followUps = append(p.followUps, followUp)
+	p.resolution = status
+
+	return followUp, nil
+}
+
+// Resolution returns the resolution status of the latest follow-up.
+func (p *Post) Resolution() ResolutionStatus {
+	return p.resolution
+}
+
+// FollowUps returns the follow-up timeline, oldest first.
+func (p *Post) FollowUps() []*FollowUp {
+	return p.followUps
+}
+
+// AttachOfficialReply attaches the official reply of a verified company representative.
+// The representative must represent the company named in the post, and each post
+// accepts only one official reply. The original post content is left untouched.
+// Returns ErrCompanyMismatch or ErrOfficialReplyExists when a rule is violated.
+func (p *Post) AttachOfficialReply(representativeID string, company CompanyName, verifiedDomain string, content ReplyContent) (*OfficialReply, error) {
+	if !p.company.Equals(company) {
+		return nil, ErrCompanyMismatch
+	}
+	if p.officialReply != nil {
+		return nil, ErrOfficialReplyExists
+	}
+	if representativeID == "" || verifiedDomain == "" {
+		return nil, fmt.Errorf("representative ID and verified domain are required")
+	}
+
+	p.officialReply = newOfficialReply(representativeID, verifiedDomain, content)
+
+	return p.officialReply, nil
+}
+
+// OfficialReply returns the official company reply, or nil if there is none.
+func (p *Post) OfficialReply() *OfficialReply {
+	return p.officialReply
+}
@@ -0,0 +1,199 @@
+// Package content provides domain models for content management.
+// It includes value objects, entities, and repository interfaces.
+package content
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrManagementTokenMismatch is returned when a management token does not match the post.
+var ErrManagementTokenMismatch = errors.New("management token does not match post")
+
+// ResolutionStatus represents how an incident described in a post has evolved.
+// It is a value object; the zero value means the author has not posted any follow-up.
+type ResolutionStatus string
+
+const (
+	// ResolutionStatusNone means no follow-up has been posted yet.
+	ResolutionStatusNone ResolutionStatus = ""
+	// ResolutionStatusOngoing means the incident is still ongoing.
+	ResolutionStatusOngoing ResolutionStatus = "ONGOING"
+	// ResolutionStatusEscalated means the incident was escalated (e.g., a lawsuit was filed).
+	ResolutionStatusEscalated ResolutionStatus = "ESCALATED"
+	// ResolutionStatusResolved means the incident was resolved (e.g., wages were paid).
+	ResolutionStatusResolved ResolutionStatus = "RESOLVED"
+)
+
+// NewResolutionStatus creates a ResolutionStatus from a string.
+// The value is case-insensitive and must be one of ONGOING, ESCALATED or RESOLVED.
+func NewResolutionStatus(value string) (ResolutionStatus, error) {
+	status := ResolutionStatus(strings.ToUpper(strings.TrimSpace(value)))
+	switch status {
+	case ResolutionStatusOngoing, ResolutionStatusEscalated, ResolutionStatusResolved:
+		return status, nil
+	default:
+		return ResolutionStatusNone, fmt.Errorf("invalid resolution status: %q", value)
+	}
+}
+
+// String returns the string representation of the ResolutionStatus.
+func (s ResolutionStatus) String() string {
+	return string(s)
+}
+
+// IsZero returns true if no resolution status is set.
+func (s ResolutionStatus) IsZero() bool {
+	return s == ResolutionStatusNone
+}
+
+// ManagementToken is the secret handed to the author when a post is created.
+// Only its SHA-256 hash is persisted; the raw value proves authorship later.
+type ManagementToken struct {
+	// value is the raw token string.
+	value string
+}
+
+// managementTokenBytes is the number of random bytes in a management token.
+const managementTokenBytes = 32
+
+// GenerateManagementToken generates a new random ManagementToken.
+func GenerateManagementToken() (ManagementToken, error) {
+	buf := make([]byte, managementTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return ManagementToken{}, fmt.Errorf("failed to generate management token: %w", err)
+	}
+	return ManagementToken{value: base64.RawURLEncoding.EncodeToString(buf)}, nil
+}
+
+// String returns the raw token value.
+// It must only be shown to the author once, right after the post is created.
+func (t ManagementToken) String() string {
+	return t.value
+}
+
+// Hash returns the hex-encoded SHA-256 hash of the token.
+func (t ManagementToken) Hash() string {
+	return HashManagementToken(t.value)
+}
+
+// HashManagementToken returns the hex-encoded SHA-256 hash of a raw token.
+func HashManagementToken(raw string) string {
+	sum := sha256.Sum256([]byte(strings.TrimSpace(raw)))
+	return hex.EncodeToString(sum[:])
+}
+
+// FollowUpNote represents the text of a follow-up entry.
+// It is a value object that encapsulates the business rules for follow-up notes.
+type FollowUpNote struct {
+	// value is the note string.
+	value string
+}
+
+const (
+	// MinFollowUpNoteLength is the minimum length for a follow-up note.
+	MinFollowUpNoteLength = 2
+	// MaxFollowUpNoteLength is the maximum length for a follow-up note.
+	MaxFollowUpNoteLength = 2000
+)
+
+// NewFollowUpNote creates a new FollowUpNote from a string.
+// It validates that the string has length between 2 and 2000 characters.
+// Whitespace is automatically trimmed before validation.
+func NewFollowUpNote(value string) (FollowUpNote, error) {
+	trimmed := strings.TrimSpace(value)
+
+	length := len([]rune(trimmed))
+	if length < MinFollowUpNoteLength {
+		return FollowUpNote{}, fmt.Errorf("follow-up note must be at least %d characters", MinFollowUpNoteLength)
+	}
+	if length > MaxFollowUpNoteLength {
+		return FollowUpNote{}, fmt.Errorf("follow-up note must be at most %d characters", MaxFollowUpNoteLength)
+	}
+
+	return FollowUpNote{value: trimmed}, nil
+}
+
+// String returns the string representation of the FollowUpNote.
+func (n FollowUpNote) String() string {
+	return n.value
+}
+
+// FollowUp is a dated entry appended by the author to a post.
+// Follow-ups belong to the Post aggregate and are never edited once created.
+type FollowUp struct {
+	// id is the unique identifier of the follow-up.
+	id string
+
+	// status is the resolution status tagged on this entry.
+	status ResolutionStatus
+
+	// note is the follow-up text.
+	note FollowUpNote
+
+	// createdAt is the time when the follow-up was appended.
+	createdAt time.Time
+
+	// persisted reports whether the follow-up has been stored already.
+	persisted bool
+}
+
+// NewFollowUpFromDB creates a FollowUp from database data.
+func NewFollowUpFromDB(id string, status ResolutionStatus, note FollowUpNote, createdAt time.Time) *FollowUp {
+	return &FollowUp{
+		id:        id,
+		status:    status,
+		note:      note,
+		createdAt: createdAt,
+		persisted: true,
+	}
+}
+
+// ID returns the follow-up ID.
+func (f *FollowUp) ID() string {
+	return f.id
+}
+
+// Status returns the resolution status tagged on the follow-up.
+func (f *FollowUp) Status() ResolutionStatus {
+	return f.status
+}
+
+// Note returns the follow-up note.
+func (f *FollowUp) Note() FollowUpNote {
+	return f.note
+}
+
+// CreatedAt returns the time the follow-up was appended.
+func (f *FollowUp) CreatedAt() time.Time {
+	return f.createdAt
+}
+
+// IsPersisted reports whether the follow-up has been stored already.
+// Repositories use it to insert only newly appended entries.
+func (f *FollowUp) IsPersisted() bool {
+	return f.persisted
+}
+
+// MarkPersisted marks the follow-up as stored.
+func (f *FollowUp) MarkPersisted() {
+	f.persisted = true
+}
+
+// newFollowUp creates a new, not yet persisted FollowUp.
+func newFollowUp(status ResolutionStatus, note FollowUpNote) *FollowUp {
+	return &FollowUp{
+		id:        uuid.New().String(),
+		status:    status,
+		note:      note,
+		createdAt: time.Now(),
+	}
+}
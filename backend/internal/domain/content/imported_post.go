@@ -0,0 +1,139 @@
+package content
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"fuck_boss/backend/internal/domain/shared"
+)
+
+const (
+	// MaxExternalIDLength is the maximum length of an external ID (in characters).
+	MaxExternalIDLength = 100
+)
+
+// sourcePattern matches import source names: lowercase letters, digits,
+// hyphens and underscores, starting with a letter or digit.
+var sourcePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,49}$`)
+
+// ExternalRef identifies a record in a partner's dataset: the source (the
+// partner or dataset name) and the record's ID in that source. A record is
+// imported at most once per source, which makes imports idempotent.
+type ExternalRef struct {
+	// source is the name of the dataset the record comes from (e.g. "partner-a").
+	source string
+
+	// id is the record's ID in the source.
+	id string
+}
+
+// NewExternalRef creates an ExternalRef.
+// The source must be 1-50 lowercase letters, digits, hyphens or underscores;
+// the ID must be non-empty and at most MaxExternalIDLength characters.
+// Whitespace is automatically trimmed before validation.
+func NewExternalRef(source, id string) (ExternalRef, error) {
+	source = strings.TrimSpace(source)
+	if err := ValidateExternalSource(source); err != nil {
+		return ExternalRef{}, err
+	}
+
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return ExternalRef{}, fmt.Errorf("external ID cannot be empty")
+	}
+	if len([]rune(id)) > MaxExternalIDLength {
+		return ExternalRef{}, fmt.Errorf("external ID must be at most %d characters", MaxExternalIDLength)
+	}
+
+	return ExternalRef{source: source, id: id}, nil
+}
+
+// ValidateExternalSource returns an error if source is not a valid source name:
+// 1-50 lowercase letters, digits, hyphens or underscores, starting with a
+// letter or digit.
+func ValidateExternalSource(source string) error {
+	if !sourcePattern.MatchString(source) {
+		return fmt.Errorf("source must be 1-50 lowercase letters, digits, hyphens or underscores")
+	}
+	return nil
+}
+
+// Source returns the name of the dataset the record comes from.
+func (r ExternalRef) Source() string {
+	return r.source
+}
+
+// ID returns the record's ID in the source.
+func (r ExternalRef) ID() string {
+	return r.id
+}
+
+// ImportedPost is a Post taken from a partner's dataset, such as a
+// spreadsheet of historical complaints. It keeps the original publication
+// time and has no author and no management token.
+type ImportedPost struct {
+	// ref identifies the record in the partner's dataset.
+	ref ExternalRef
+
+	// post is the post created from the record.
+	post *Post
+
+	// occurredAt is when the incident occurred (nil if unknown).
+	// Post does not hold it yet, so it is kept here to be stored.
+	occurredAt *time.Time
+}
+
+// NewImportedPost creates an ImportedPost published at createdAt, with an
+// optional resolution status (ResolutionStatusNone if unknown) and an
+// optional time the incident occurred.
+// Returns an error if createdAt is zero or in the future, or if occurredAt
+// is after createdAt.
+func NewImportedPost(ref ExternalRef, company CompanyName, city shared.City, content Content, createdAt time.Time, occurredAt *time.Time, resolution ResolutionStatus) (*ImportedPost, error) {
+	if createdAt.IsZero() {
+		return nil, fmt.Errorf("created at is required")
+	}
+	if createdAt.After(time.Now()) {
+		return nil, fmt.Errorf("created at cannot be in the future")
+	}
+	if occurredAt != nil && occurredAt.After(createdAt) {
+		return nil, fmt.Errorf("occurred at cannot be after created at")
+	}
+
+	post, err := NewPostFromDB(GeneratePostID(), company, city, content, createdAt, WithResolution(resolution))
+	if err != nil {
+		return nil, err
+	}
+
+	return &ImportedPost{ref: ref, post: post, occurredAt: occurredAt}, nil
+}
+
+// Ref returns the reference to the record in the partner's dataset.
+func (p *ImportedPost) Ref() ExternalRef {
+	return p.ref
+}
+
+// Post returns the post created from the record.
+func (p *ImportedPost) Post() *Post {
+	return p.post
+}
+
+// OccurredAt returns when the incident occurred (nil if unknown).
+func (p *ImportedPost) OccurredAt() *time.Time {
+	return p.occurredAt
+}
+
+// PostImportRepository defines the interface for storing imported posts.
+// Implementations are in the Infrastructure Layer.
+type PostImportRepository interface {
+	// FindImported returns which of the external IDs of the source have
+	// already been imported.
+	FindImported(ctx context.Context, source string, externalIDs []string) (map[string]bool, error)
+
+	// Import stores the posts in one transaction, skipping those whose
+	// ExternalRef has already been imported, and returns the number of
+	// posts stored.
+	Import(ctx context.Context, posts []*ImportedPost) (int, error)
+}
@@ -0,0 +1,151 @@
+// Package content provides domain models for content management.
+// It includes value objects, entities, and repository interfaces.
+package content
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrModerationTransition is returned when a moderation action does not apply
+// to the post's current moderation status (e.g. hiding a removed post).
+var ErrModerationTransition = errors.New("moderation action does not apply to the post's current status")
+
+// ModerationStatus represents whether a post is shown publicly.
+// It is a value object; posts are visible unless an operator hid or removed them.
+type ModerationStatus string
+
+const (
+	// ModerationStatusVisible means the post is shown publicly.
+	ModerationStatusVisible ModerationStatus = "VISIBLE"
+	// ModerationStatusHidden means the post is temporarily hidden (e.g. pending review).
+	ModerationStatusHidden ModerationStatus = "HIDDEN"
+	// ModerationStatusRemoved means the post was taken down. It is kept so that it can be restored.
+	ModerationStatusRemoved ModerationStatus = "REMOVED"
+)
+
+// NewModerationStatus creates a ModerationStatus from a string.
+// The value is case-insensitive and must be one of VISIBLE, HIDDEN or REMOVED.
+func NewModerationStatus(value string) (ModerationStatus, error) {
+	status := ModerationStatus(strings.ToUpper(strings.TrimSpace(value)))
+	switch status {
+	case ModerationStatusVisible, ModerationStatusHidden, ModerationStatusRemoved:
+		return status, nil
+	default:
+		return "", fmt.Errorf("invalid moderation status: %q", value)
+	}
+}
+
+// String returns the string representation of the ModerationStatus.
+func (s ModerationStatus) String() string {
+	return string(s)
+}
+
+// IsVisible returns true if the post is shown publicly.
+func (s ModerationStatus) IsVisible() bool {
+	return s == ModerationStatusVisible
+}
+
+// ModerationReason is the reason an operator gives for hiding or removing a post.
+// It is a value object.
+type ModerationReason struct {
+	// value is the reason string.
+	value string
+}
+
+// MaxModerationReasonLength is the maximum length for a moderation reason.
+const MaxModerationReasonLength = 500
+
+// NewModerationReason creates a new ModerationReason from a string.
+// It validates that the string is not empty and at most 500 characters.
+// Whitespace is automatically trimmed before validation.
+func NewModerationReason(value string) (ModerationReason, error) {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return ModerationReason{}, fmt.Errorf("moderation reason cannot be empty")
+	}
+	if len([]rune(trimmed)) > MaxModerationReasonLength {
+		return ModerationReason{}, fmt.Errorf("moderation reason must be at most %d characters", MaxModerationReasonLength)
+	}
+	return ModerationReason{value: trimmed}, nil
+}
+
+// String returns the string representation of the ModerationReason.
+func (r ModerationReason) String() string {
+	return r.value
+}
+
+// IsZero returns true if no reason is set.
+func (r ModerationReason) IsZero() bool {
+	return r.value == ""
+}
+
+// Moderation is the moderation state of a post: its status, and the reason, time
+// and moderator of the last moderation action (zero for posts never moderated).
+type Moderation struct {
+	// Status is the moderation status.
+	Status ModerationStatus
+
+	// Reason is the reason of the last hide or remove action (zero once restored).
+	Reason ModerationReason
+
+	// ModeratedAt is the time of the last moderation action.
+	ModeratedAt time.Time
+
+	// ModeratedBy is the user ID of the moderator who took the last action
+	// (empty for operator actions through an API key or the command line).
+	ModeratedBy string
+}
+
+// Hide hides a visible post, e.g. while a complaint about it is reviewed.
+// moderatorID is the user ID of the moderator (empty for operators).
+// Returns ErrModerationTransition if the post is not visible.
+func (p *Post) Hide(reason ModerationReason, moderatorID string) error {
+	if p.moderation.Status != ModerationStatusVisible {
+		return ErrModerationTransition
+	}
+	p.moderate(ModerationStatusHidden, reason, moderatorID)
+	return nil
+}
+
+// Remove takes down a visible or hidden post. The post is kept and can be restored.
+// Returns ErrModerationTransition if the post is already removed.
+func (p *Post) Remove(reason ModerationReason, moderatorID string) error {
+	if p.moderation.Status == ModerationStatusRemoved {
+		return ErrModerationTransition
+	}
+	p.moderate(ModerationStatusRemoved, reason, moderatorID)
+	return nil
+}
+
+// Restore makes a hidden or removed post visible again and clears the reason.
+// Returns ErrModerationTransition if the post is already visible.
+func (p *Post) Restore(moderatorID string) error {
+	if p.moderation.Status == ModerationStatusVisible {
+		return ErrModerationTransition
+	}
+	p.moderate(ModerationStatusVisible, ModerationReason{}, moderatorID)
+	return nil
+}
+
+// moderate applies a moderation action.
+func (p *Post) moderate(status ModerationStatus, reason ModerationReason, moderatorID string) {
+	p.moderation = Moderation{
+		Status:      status,
+		Reason:      reason,
+		ModeratedAt: time.Now(),
+		ModeratedBy: moderatorID,
+	}
+}
+
+// Moderation returns the moderation state of the post.
+func (p *Post) Moderation() Moderation {
+	return p.moderation
+}
+
+// IsVisible returns true if the post is shown publicly.
+func (p *Post) IsVisible() bool {
+	return p.moderation.Status.IsVisible()
+}
@@ -0,0 +1,143 @@
+// Package content provides domain models for content management.
+// It includes value objects, entities, and repository interfaces.
+package content
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrOfficialReplyExists is returned when a post already has an official reply.
+var ErrOfficialReplyExists = errors.New("post already has an official reply")
+
+// ErrCompanyMismatch is returned when a representative replies to a post about another company.
+var ErrCompanyMismatch = errors.New("representative does not represent the company named in the post")
+
+// OfficialReplyBadge is the badge shown next to replies from verified company representatives.
+const OfficialReplyBadge = "VERIFIED_COMPANY"
+
+// ReplyContent represents the text of an official company reply.
+// It is a value object that encapsulates the business rules for reply text.
+type ReplyContent struct {
+	// value is the reply string.
+	value string
+}
+
+const (
+	// MinReplyContentLength is the minimum length for an official reply.
+	MinReplyContentLength = 10
+	// MaxReplyContentLength is the maximum length for an official reply.
+	MaxReplyContentLength = 5000
+)
+
+// NewReplyContent creates a new ReplyContent from a string.
+// It validates that the string has length between 10 and 5000 characters.
+// Whitespace is automatically trimmed before validation.
+func NewReplyContent(value string) (ReplyContent, error) {
+	trimmed := strings.TrimSpace(value)
+
+	length := len([]rune(trimmed))
+	if length < MinReplyContentLength {
+		return ReplyContent{}, fmt.Errorf("reply content must be at least %d characters", MinReplyContentLength)
+	}
+	if length > MaxReplyContentLength {
+		return ReplyContent{}, fmt.Errorf("reply content must be at most %d characters", MaxReplyContentLength)
+	}
+
+	return ReplyContent{value: trimmed}, nil
+}
+
+// String returns the string representation of the ReplyContent.
+func (c ReplyContent) String() string {
+	return c.value
+}
+
+// OfficialReply is the single right-of-reply a verified company representative
+// may attach to a post. It belongs to the Post aggregate, sits beside the original
+// content and never changes it.
+type OfficialReply struct {
+	// id is the unique identifier of the reply.
+	id string
+
+	// representativeID is the ID of the verified representative who replied.
+	representativeID string
+
+	// verifiedDomain is the company domain the representative proved control of.
+	verifiedDomain string
+
+	// content is the reply text.
+	content ReplyContent
+
+	// createdAt is the time when the reply was attached.
+	createdAt time.Time
+
+	// persisted reports whether the reply has been stored already.
+	persisted bool
+}
+
+// NewOfficialReplyFromDB creates an OfficialReply from database data.
+func NewOfficialReplyFromDB(id, representativeID, verifiedDomain string, content ReplyContent, createdAt time.Time) *OfficialReply {
+	return &OfficialReply{
+		id:               id,
+		representativeID: representativeID,
+		verifiedDomain:   verifiedDomain,
+		content:          content,
+		createdAt:        createdAt,
+		persisted:        true,
+	}
+}
+
+// ID returns the reply ID.
+func (r *OfficialReply) ID() string {
+	return r.id
+}
+
+// RepresentativeID returns the ID of the representative who replied.
+func (r *OfficialReply) RepresentativeID() string {
+	return r.representativeID
+}
+
+// VerifiedDomain returns the company domain the representative verified.
+func (r *OfficialReply) VerifiedDomain() string {
+	return r.verifiedDomain
+}
+
+// Content returns the reply text.
+func (r *OfficialReply) Content() ReplyContent {
+	return r.content
+}
+
+// CreatedAt returns the time the reply was attached.
+func (r *OfficialReply) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+// Badge returns the badge shown with the reply.
+func (r *OfficialReply) Badge() string {
+	return OfficialReplyBadge
+}
+
+// IsPersisted reports whether the reply has been stored already.
+func (r *OfficialReply) IsPersisted() bool {
+	return r.persisted
+}
+
+// MarkPersisted marks the reply as stored.
+func (r *OfficialReply) MarkPersisted() {
+	r.persisted = true
+}
+
+// newOfficialReply creates a new, not yet persisted OfficialReply.
+func newOfficialReply(representativeID, verifiedDomain string, content ReplyContent) *OfficialReply {
+	return &OfficialReply{
+		id:               uuid.New().String(),
+		representativeID: representativeID,
+		verifiedDomain:   verifiedDomain,
+		content:          content,
+		createdAt:        time.Now(),
+	}
+}
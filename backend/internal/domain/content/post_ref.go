@@ -0,0 +1,81 @@
+package content
+
+import (
+	"context"
+	"time"
+)
+
+// PostCursor is a position in the (created_at, id) order of posts, used for
+// keyset pagination over all posts. The order never changes for existing posts,
+// so new posts are always appended at the end.
+type PostCursor struct {
+	// CreatedAt is when the post at the position was created.
+	CreatedAt time.Time
+
+	// ID is the ID of the post at the position.
+	ID PostID
+}
+
+// After reports whether c comes after other in (created_at, id) order.
+// IDs are compared by their canonical string form, which sorts like the
+// UUID bytes.
+func (c PostCursor) After(other PostCursor) bool {
+	if !c.CreatedAt.Equal(other.CreatedAt) {
+		return c.CreatedAt.After(other.CreatedAt)
+	}
+	return c.ID.String() > other.ID.String()
+}
+
+// PostRef is a read-only projection of a publicly visible Post: just enough
+// to link to it and tell when it last changed (e.g. in a sitemap).
+type PostRef struct {
+	// id is the unique identifier of the post.
+	id PostID
+
+	// createdAt is when the post was created.
+	createdAt time.Time
+
+	// updatedAt is when the post was last changed (content, follow-ups).
+	updatedAt time.Time
+}
+
+// NewPostRefFromDB reconstructs a PostRef from database data.
+func NewPostRefFromDB(id PostID, createdAt, updatedAt time.Time) *PostRef {
+	return &PostRef{
+		id:        id,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
+
+// ID returns the post ID.
+func (r *PostRef) ID() PostID {
+	return r.id
+}
+
+// CreatedAt returns when the post was created.
+func (r *PostRef) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+// UpdatedAt returns when the post was last changed.
+func (r *PostRef) UpdatedAt() time.Time {
+	return r.updatedAt
+}
+
+// Cursor returns the position of the post in (created_at, id) order.
+func (r *PostRef) Cursor() PostCursor {
+	return PostCursor{CreatedAt: r.createdAt, ID: r.id}
+}
+
+// PostRefRepository defines the interface for walking all publicly visible posts.
+// Implementations are in the Infrastructure Layer.
+type PostRefRepository interface {
+	// FindRefsAfter returns up to limit posts after the cursor in
+	// (created_at, id) order, oldest first. A nil cursor starts at the first post.
+	FindRefsAfter(ctx context.Context, after *PostCursor, limit int) ([]*PostRef, error)
+
+	// FindRefsUpdatedSince returns up to limit posts changed after since,
+	// least recently changed first.
+	FindRefsUpdatedSince(ctx context.Context, since time.Time, limit int) ([]*PostRef, error)
+}
@@ -0,0 +1,99 @@
+package content
+
+import (
+	"time"
+
+	"fuck_boss/backend/internal/domain/shared"
+)
+
+// SummarySourceLength is the number of content characters loaded for a PostSummary.
+// The Markdown syntax in this many characters leaves far more than SummaryLength characters of plain text.
+const SummarySourceLength = 1000
+
+// PostSummary is a read-only projection of a Post for list views.
+// It carries the summary instead of the full content, so repositories only load
+// the beginning of the content (SummarySourceLength characters) per post.
+type PostSummary struct {
+	// id is the unique identifier of the post.
+	id PostID
+
+	// company is the company name.
+	company CompanyName
+
+	// city is the city where the post is published.
+	city shared.City
+
+	// summary is the plain-text summary of the content.
+	summary string
+
+	// createdAt is when the post was created.
+	createdAt time.Time
+
+	// resolution is the status of the latest follow-up (empty if none).
+	resolution ResolutionStatus
+}
+
+// NewPostSummaryFromDB reconstructs a PostSummary from database data.
+// contentPrefix is the beginning of the content; truncated reports whether the
+// stored content is longer than contentPrefix.
+func NewPostSummaryFromDB(
+	id PostID,
+	company CompanyName,
+	city shared.City,
+	contentPrefix string,
+	truncated bool,
+	createdAt time.Time,
+	resolution ResolutionStatus,
+) *PostSummary {
+	return &PostSummary{
+		id:         id,
+		company:    company,
+		city:       city,
+		summary:    summarize(contentRenderer.PlainText(contentPrefix), truncated),
+		createdAt:  createdAt,
+		resolution: resolution,
+	}
+}
+
+// ID returns the post ID.
+func (s *PostSummary) ID() PostID {
+	return s.id
+}
+
+// Company returns the company name.
+func (s *PostSummary) Company() CompanyName {
+	return s.company
+}
+
+// City returns the city.
+func (s *PostSummary) City() shared.City {
+	return s.city
+}
+
+// Summary returns the plain-text summary of the content (see Content.Summary).
+func (s *PostSummary) Summary() string {
+	return s.summary
+}
+
+// CreatedAt returns when the post was created.
+func (s *PostSummary) CreatedAt() time.Time {
+	return s.createdAt
+}
+
+// Resolution returns the status of the latest follow-up (empty if none).
+func (s *PostSummary) Resolution() ResolutionStatus {
+	return s.resolution
+}
+
+// summarize cuts plain text to SummaryLength characters.
+// "..." is appended if the text was cut or is known to continue (truncated).
+func summarize(plain string, truncated bool) string {
+	runes := []rune(plain)
+	if len(runes) > SummaryLength {
+		return string(runes[:SummaryLength]) + "..."
+	}
+	if truncated {
+		return plain + "..."
+	}
+	return plain
+}
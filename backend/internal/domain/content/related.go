@@ -0,0 +1,251 @@
+package content
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"fuck_boss/backend/pkg/textseg"
+)
+
+// Related posts tuning.
+const (
+	// MinRelatedSimilarity is the minimum cosine similarity for a post in the
+	// same city to count as related.
+	MinRelatedSimilarity = 0.1
+
+	// maxDocumentTerms is the number of highest-weighted terms kept per post.
+	// Dropping the tail keeps the vectors short without changing rankings much.
+	maxDocumentTerms = 50
+)
+
+// PostDocument is a read-only projection of a Post used to find related posts.
+type PostDocument struct {
+	// id is the unique identifier of the post.
+	id PostID
+
+	// company is the company name.
+	company CompanyName
+
+	// cityCode is the city code of the post.
+	cityCode string
+
+	// text is the plain text of the content.
+	text string
+
+	// createdAt is when the post was created.
+	createdAt time.Time
+}
+
+// NewPostDocumentFromDB reconstructs a PostDocument from database data.
+// content is the Markdown source of the post.
+func NewPostDocumentFromDB(
+	id PostID,
+	company CompanyName,
+	cityCode string,
+	content string,
+	createdAt time.Time,
+) *PostDocument {
+	return &PostDocument{
+		id:        id,
+		company:   company,
+		cityCode:  cityCode,
+		text:      contentRenderer.PlainText(content),
+		createdAt: createdAt,
+	}
+}
+
+// ID returns the post ID.
+func (d *PostDocument) ID() PostID {
+	return d.id
+}
+
+// Company returns the company name.
+func (d *PostDocument) Company() CompanyName {
+	return d.company
+}
+
+// CityCode returns the city code of the post.
+func (d *PostDocument) CityCode() string {
+	return d.cityCode
+}
+
+// Text returns the plain text of the content.
+func (d *PostDocument) Text() string {
+	return d.text
+}
+
+// CreatedAt returns when the post was created.
+func (d *PostDocument) CreatedAt() time.Time {
+	return d.createdAt
+}
+
+// PostDocumentRepository defines the interface for loading post documents.
+// Implementations are in the Infrastructure Layer.
+type PostDocumentRepository interface {
+	// FindDocumentsSince returns the most recent posts created after since,
+	// newest first, at most limit posts.
+	FindDocumentsSince(ctx context.Context, since time.Time, limit int) ([]*PostDocument, error)
+}
+
+// RelatedPosts returns up to limit related posts for every document.
+// Posts about the same company (compared by CompanyName.Canonical) come first,
+// newest first. The remaining slots are filled with posts in the same city
+// ordered by the TF-IDF cosine similarity of their text, keeping only posts
+// with a similarity of at least MinRelatedSimilarity.
+// Documents without related posts are omitted from the result.
+func RelatedPosts(docs []*PostDocument, limit int) map[PostID][]PostID {
+	result := make(map[PostID][]PostID)
+	if limit < 1 || len(docs) < 2 {
+		return result
+	}
+
+	byCompany := make(map[string][]int)
+	for i, doc := range docs {
+		key := doc.company.Canonical()
+		byCompany[key] = append(byCompany[key], i)
+	}
+	for _, group := range byCompany {
+		sort.SliceStable(group, func(a, b int) bool {
+			return docs[group[a]].createdAt.After(docs[group[b]].createdAt)
+		})
+	}
+
+	vectors := documentVectors(docs)
+
+	// Inverted index per city: term -> postings.
+	type posting struct {
+		doc    int
+		weight float64
+	}
+	index := make(map[string]map[string][]posting)
+	for i, doc := range docs {
+		cityIndex, ok := index[doc.cityCode]
+		if !ok {
+			cityIndex = make(map[string][]posting)
+			index[doc.cityCode] = cityIndex
+		}
+		for term, weight := range vectors[i] {
+			cityIndex[term] = append(cityIndex[term], posting{doc: i, weight: weight})
+		}
+	}
+
+	for i, doc := range docs {
+		related := make([]PostID, 0, limit)
+		seen := map[int]bool{i: true}
+
+		// 1. Same company, newest first
+		for _, j := range byCompany[doc.company.Canonical()] {
+			if len(related) == limit {
+				break
+			}
+			if !seen[j] {
+				seen[j] = true
+				related = append(related, docs[j].id)
+			}
+		}
+
+		// 2. Similar content in the same city
+		if len(related) < limit {
+			scores := make(map[int]float64)
+			cityIndex := index[doc.cityCode]
+			for term, weight := range vectors[i] {
+				for _, p := range cityIndex[term] {
+					if !seen[p.doc] {
+						scores[p.doc] += weight * p.weight
+					}
+				}
+			}
+
+			candidates := make([]int, 0, len(scores))
+			for j, score := range scores {
+				if score >= MinRelatedSimilarity {
+					candidates = append(candidates, j)
+				}
+			}
+			sort.Slice(candidates, func(a, b int) bool {
+				sa, sb := scores[candidates[a]], scores[candidates[b]]
+				if sa != sb {
+					return sa > sb
+				}
+				ca, cb := docs[candidates[a]].createdAt, docs[candidates[b]].createdAt
+				if !ca.Equal(cb) {
+					return ca.After(cb)
+				}
+				return docs[candidates[a]].id.String() < docs[candidates[b]].id.String()
+			})
+
+			for _, j := range candidates {
+				if len(related) == limit {
+					break
+				}
+				related = append(related, docs[j].id)
+			}
+		}
+
+		if len(related) > 0 {
+			result[doc.id] = related
+		}
+	}
+
+	return result
+}
+
+// documentVectors returns the L2-normalized TF-IDF vector of every document.
+// Term frequency is dampened logarithmically (1 + ln tf) and the inverse
+// document frequency is smoothed (ln((1 + N) / (1 + df))), so terms found in
+// every document weigh almost nothing.
+func documentVectors(docs []*PostDocument) []map[string]float64 {
+	counts := make([]map[string]int, len(docs))
+	df := make(map[string]int)
+	for i, doc := range docs {
+		tf := make(map[string]int)
+		for _, token := range textseg.Segment(doc.text) {
+			tf[token]++
+		}
+		for term := range tf {
+			df[term]++
+		}
+		counts[i] = tf
+	}
+
+	n := float64(len(docs))
+	vectors := make([]map[string]float64, len(docs))
+	for i, tf := range counts {
+		type weighted struct {
+			term   string
+			weight float64
+		}
+		terms := make([]weighted, 0, len(tf))
+		for term, count := range tf {
+			idf := math.Log((1 + n) / (1 + float64(df[term])))
+			if weight := (1 + math.Log(float64(count))) * idf; weight > 0 {
+				terms = append(terms, weighted{term: term, weight: weight})
+			}
+		}
+		sort.Slice(terms, func(a, b int) bool {
+			if terms[a].weight != terms[b].weight {
+				return terms[a].weight > terms[b].weight
+			}
+			return terms[a].term < terms[b].term
+		})
+		if len(terms) > maxDocumentTerms {
+			terms = terms[:maxDocumentTerms]
+		}
+
+		var norm float64
+		for _, t := range terms {
+			norm += t.weight * t.weight
+		}
+		norm = math.Sqrt(norm)
+
+		vector := make(map[string]float64, len(terms))
+		for _, t := range terms {
+			vector[t.term] = t.weight / norm
+		}
+		vectors[i] = vector
+	}
+
+	return vectors
+}
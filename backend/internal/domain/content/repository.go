@@ -4,20 +4,58 @@ package content
 
 import (
 	"context"
+	"time"
 
 	"fuck_boss/backend/internal/domain/shared"
 )
 
+// PostFilter narrows down the posts returned by PostRepository.FindByFilter.
+// Nil fields are not applied.
+type PostFilter struct {
+	// City restricts results to a single city.
+	City *shared.City
+
+	// Resolution restricts results to posts whose latest follow-up has this status.
+	Resolution *ResolutionStatus
+
+	// AuthorID restricts results to posts created by this user.
+	AuthorID *string
+
+	// Companies restricts results to posts about any of these companies (exact name match).
+	// A nil slice is not applied; an empty non-nil slice matches no posts.
+	Companies []CompanyName
+
+	// CompanyCanonical restricts results to posts about the company with this
+	// canonical name (see CompanyName.Canonical), however it is spelled.
+	// An empty string is not applied.
+	CompanyCanonical string
+
+	// CreatedAfter restricts results to posts created strictly after this time.
+	CreatedAfter *time.Time
+
+	// CreatedSince restricts results to posts created at or after this time.
+	CreatedSince *time.Time
+
+	// CreatedBefore restricts results to posts created strictly before this time.
+	CreatedBefore *time.Time
+
+	// IDs restricts results to posts with any of these IDs.
+	// A nil slice is not applied; an empty non-nil slice matches no posts.
+	IDs []PostID
+}
+
 // PostRepository defines the interface for Post persistence.
 // It follows the Dependency Inversion Principle by defining the interface
 // in the Domain Layer, while implementations are in the Infrastructure Layer.
 type PostRepository interface {
 	// Save saves a Post to the repository.
 	// If the Post already exists (same ID), it updates the existing record.
+	// Follow-ups that have not been persisted yet are stored along with the Post.
 	// Returns an error if the operation fails.
 	Save(ctx context.Context, post *Post) error
 
-	// FindByID finds a Post by its ID.
+	// FindByID finds a Post by its ID, including its follow-up timeline.
+	// Like all PostRepository queries it only finds visible posts (see ModerationStatus).
 	// Returns the Post if found, or an error if not found or operation fails.
 	FindByID(ctx context.Context, id PostID) (*Post, error)
 
@@ -39,4 +77,44 @@ type PostRepository interface {
 	// The page parameter is 1-based (page 1 is the first page).
 	// The pageSize parameter specifies the number of items per page.
 	Search(ctx context.Context, keyword string, city *shared.City, page, pageSize int) ([]*Post, int, error)
+
+	// FindByFilter finds Posts matching the filter with pagination.
+	// Returns a slice of Posts, total count, and an error.
+	// The page parameter is 1-based (page 1 is the first page).
+	// The pageSize parameter specifies the number of items per page.
+	FindByFilter(ctx context.Context, filter PostFilter, page, pageSize int) ([]*Post, int, error)
+
+	// FindSummariesByFilter is like FindByFilter but returns PostSummaries.
+	// Only the beginning of the content is loaded (see SummarySourceLength).
+	FindSummariesByFilter(ctx context.Context, filter PostFilter, page, pageSize int) ([]*PostSummary, int, error)
+
+	// FindByFilterAfter returns up to limit Posts matching the filter after the
+	// cursor in (created_at, id) order, oldest first. A nil cursor starts at the
+	// first post. Unlike FindByFilter it is stable while posts are added, so it
+	// can walk the whole table (e.g. for exports). Follow-ups are not loaded.
+	FindByFilterAfter(ctx context.Context, filter PostFilter, after *PostCursor, limit int) ([]*Post, error)
+
+	// SearchSummaries is like Search but returns PostSummaries.
+	// Only the beginning of the content is loaded (see SummarySourceLength).
+	SearchSummaries(ctx context.Context, keyword string, city *shared.City, page, pageSize int) ([]*PostSummary, int, error)
+}
+
+// PostModerationRepository loads and stores the moderation state of posts.
+// Unlike PostRepository it finds hidden and removed posts too.
+type PostModerationRepository interface {
+	// FindByIDAnyStatus finds a Post by its ID whatever its moderation status.
+	// Returns a NOT_FOUND error if the post does not exist.
+	FindByIDAnyStatus(ctx context.Context, id PostID) (*Post, error)
+
+	// SaveModeration stores the moderation state of a Post. Other fields are not changed.
+	SaveModeration(ctx context.Context, post *Post) error
+}
+
+// ModerationQueueRepository finds the posts moderators still have to review.
+type ModerationQueueRepository interface {
+	// FindByModerationStatus finds Posts with the moderation status, the ones
+	// moderated longest ago first. If cityCodes is not empty, only posts from
+	// those cities are returned. The page parameter is 1-based.
+	// Returns the Posts of the page and the total count.
+	FindByModerationStatus(ctx context.Context, status ModerationStatus, cityCodes []string, page, pageSize int) ([]*Post, int, error)
 }
@@ -5,8 +5,12 @@ package content
 import (
 	"fmt"
 	"strings"
+	"unicode"
 
 	"github.com/google/uuid"
+
+	"fuck_boss/backend/pkg/markdown"
+	"fuck_boss/backend/pkg/textseg"
 )
 
 // PostID represents a unique identifier for a Post.
@@ -122,8 +126,79 @@ func (cn CompanyName) Equals(other CompanyName) bool {
 	return cn.value == other.value
 }
 
+// companyLegalSuffixes are Chinese legal-form suffixes, longest first.
+var companyLegalSuffixes = []string{"股份有限公司", "有限责任公司", "有限公司", "集团", "公司"}
+
+// companyLegalWords are Latin legal-form words dropped from the end of a name.
+var companyLegalWords = map[string]bool{
+	"co": true, "ltd": true, "inc": true, "corp": true, "corporation": true,
+	"limited": true, "llc": true, "company": true,
+}
+
+// Canonical returns a normalized form of the name used to group posts about
+// the same company written differently, e.g. "ＸＸ科技（北京）有限公司" and
+// "xx科技" both become "xx科技".
+// Full-width characters are folded, the name is lowercased, parenthesized
+// parts, legal-form suffixes and punctuation are removed. If nothing is left,
+// the folded name is returned.
+func (cn CompanyName) Canonical() string {
+	normalized := textseg.Normalize(cn.value)
+
+	name := removeParenthesized(normalized)
+
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return unicode.IsSpace(r) || r == '.' || r == ','
+	})
+	for len(words) > 1 && companyLegalWords[words[len(words)-1]] {
+		words = words[:len(words)-1]
+	}
+	name = strings.Join(words, " ")
+
+	for stripped := true; stripped; {
+		stripped = false
+		for _, suffix := range companyLegalSuffixes {
+			if len(name) > len(suffix) && strings.HasSuffix(name, suffix) {
+				name = strings.TrimSuffix(name, suffix)
+				stripped = true
+				break
+			}
+		}
+	}
+
+	name = strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, name)
+
+	if name == "" {
+		return strings.TrimSpace(normalized)
+	}
+	return name
+}
+
+// removeParenthesized removes parenthesized parts, e.g. "(北京)".
+// Full-width parentheses must already be folded.
+func removeParenthesized(s string) string {
+	var b strings.Builder
+	depth := 0
+	for _, r := range s {
+		switch {
+		case r == '(':
+			depth++
+		case r == ')' && depth > 0:
+			depth--
+		case depth == 0:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
 // Content represents the content of a Post.
 // It is a value object that encapsulates the business rules for content.
+// The value is Markdown source restricted to a safe subset (see HTML).
 type Content struct {
 	// value is the content string.
 	value string
@@ -136,8 +211,27 @@ const (
 	MaxContentLength = 5000
 	// SummaryLength is the maximum length for content summary.
 	SummaryLength = 200
+
+	// AttachmentImagePrefix is the path evidence attachments are served from.
+	// Images in content may only point to attachments ("/api/attachments/<id>[/thumbnail]").
+	AttachmentImagePrefix = "/api/attachments/"
 )
 
+// contentRenderer renders content Markdown, allowing only attachment images.
+var contentRenderer = markdown.NewRenderer(isAttachmentImage)
+
+// isAttachmentImage reports whether an image URL points to an attachment or its thumbnail.
+func isAttachmentImage(destination string) bool {
+	id, ok := strings.CutPrefix(destination, AttachmentImagePrefix)
+	if !ok {
+		return false
+	}
+	id = strings.TrimSuffix(id, "/thumbnail")
+	// uuid.Parse also accepts URN and braced forms
+	_, err := uuid.Parse(id)
+	return err == nil && len(id) == 36
+}
+
 // NewContent creates a new Content from a string.
 // It validates that the string is non-empty and has length between 10 and 5000 characters.
 // Whitespace is automatically trimmed before validation.
@@ -174,14 +268,21 @@ func (c Content) Value() string {
 	return c.value
 }
 
-// Summary returns a summary of the content (first 200 characters).
-// If the content is longer than 200 characters, it appends "..." to indicate truncation.
+// HTML returns the content rendered to sanitized HTML.
+// Raw HTML is dropped, links get rel="nofollow", and only attachment images are kept.
+func (c Content) HTML() string {
+	return contentRenderer.HTML(c.value)
+}
+
+// PlainText returns the content without Markdown syntax.
+func (c Content) PlainText() string {
+	return contentRenderer.PlainText(c.value)
+}
+
+// Summary returns a summary of the content (first 200 characters of the plain text).
+// If the plain text is longer than 200 characters, it appends "..." to indicate truncation.
 func (c Content) Summary() string {
-	runes := []rune(c.value)
-	if len(runes) <= SummaryLength {
-		return c.value
-	}
-	return string(runes[:SummaryLength]) + "..."
+	return summarize(c.PlainText(), false)
 }
 
 // IsZero returns true if the Content is the zero value.
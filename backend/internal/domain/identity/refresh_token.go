@@ -0,0 +1,169 @@
+// Package identity provides domain models for user accounts and sessions.
+// It includes the User aggregate, refresh tokens, value objects, and repository interfaces.
+package identity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrRefreshTokenExpired is returned when a refresh token is past its expiry.
+var ErrRefreshTokenExpired = errors.New("refresh token has expired")
+
+// ErrRefreshTokenRevoked is returned when a revoked refresh token is presented.
+// Presenting an already rotated token indicates theft, so the whole family is revoked.
+var ErrRefreshTokenRevoked = errors.New("refresh token has been revoked")
+
+// RefreshToken represents a long-lived session credential.
+// Refresh tokens are rotated on every use: the presented token is revoked and a new
+// token in the same family is issued. All tokens issued from one login share a family,
+// which makes it possible to revoke the whole session at once.
+type RefreshToken struct {
+	// id is the unique identifier of the token.
+	id string
+
+	// userID is the owner of the token.
+	userID UserID
+
+	// familyID groups all tokens rotated from the same login.
+	familyID string
+
+	// tokenHash is the SHA-256 hash of the raw token.
+	tokenHash string
+
+	// expiresAt is the time after which the token is no longer accepted.
+	expiresAt time.Time
+
+	// createdAt is the time when the token was issued.
+	createdAt time.Time
+
+	// revokedAt is the time when the token was revoked or rotated (nil while active).
+	revokedAt *time.Time
+
+	// replacedBy is the ID of the token issued when this one was rotated.
+	replacedBy string
+}
+
+// IssueRefreshToken issues a refresh token for a new login (a new family).
+// It returns the token entity and the raw token value, which is only shown to the client.
+func IssueRefreshToken(userID UserID, ttl time.Duration) (*RefreshToken, string, error) {
+	return issueRefreshToken(userID, uuid.New().String(), ttl)
+}
+
+// issueRefreshToken issues a refresh token in the given family.
+func issueRefreshToken(userID UserID, familyID string, ttl time.Duration) (*RefreshToken, string, error) {
+	raw, err := generateRefreshTokenValue()
+	if err != nil {
+		return nil, "", err
+	}
+
+	now := time.Now()
+	return &RefreshToken{
+		id:        uuid.New().String(),
+		userID:    userID,
+		familyID:  familyID,
+		tokenHash: HashRefreshToken(raw),
+		expiresAt: now.Add(ttl),
+		createdAt: now,
+	}, raw, nil
+}
+
+// NewRefreshTokenFromDB creates a RefreshToken from database data.
+// This is used by repositories to reconstruct RefreshTokens from database rows.
+func NewRefreshTokenFromDB(
+	id string,
+	userID UserID,
+	familyID string,
+	tokenHash string,
+	expiresAt time.Time,
+	createdAt time.Time,
+	revokedAt *time.Time,
+	replacedBy string,
+) *RefreshToken {
+	return &RefreshToken{
+		id:         id,
+		userID:     userID,
+		familyID:   familyID,
+		tokenHash:  tokenHash,
+		expiresAt:  expiresAt,
+		createdAt:  createdAt,
+		revokedAt:  revokedAt,
+		replacedBy: replacedBy,
+	}
+}
+
+// ID returns the token ID.
+func (t *RefreshToken) ID() string {
+	return t.id
+}
+
+// UserID returns the owner of the token.
+func (t *RefreshToken) UserID() UserID {
+	return t.userID
+}
+
+// FamilyID returns the family the token belongs to.
+func (t *RefreshToken) FamilyID() string {
+	return t.familyID
+}
+
+// TokenHash returns the SHA-256 hash of the raw token.
+func (t *RefreshToken) TokenHash() string {
+	return t.tokenHash
+}
+
+// ExpiresAt returns the expiry time.
+func (t *RefreshToken) ExpiresAt() time.Time {
+	return t.expiresAt
+}
+
+// CreatedAt returns the issue time.
+func (t *RefreshToken) CreatedAt() time.Time {
+	return t.createdAt
+}
+
+// RevokedAt returns the revocation time (nil while active).
+func (t *RefreshToken) RevokedAt() *time.Time {
+	return t.revokedAt
+}
+
+// ReplacedBy returns the ID of the token that replaced this one (empty if not rotated).
+func (t *RefreshToken) ReplacedBy() string {
+	return t.replacedBy
+}
+
+// IsRevoked returns true if the token was revoked or rotated.
+func (t *RefreshToken) IsRevoked() bool {
+	return t.revokedAt != nil
+}
+
+// Rotate revokes this token and issues its successor in the same family.
+// Returns ErrRefreshTokenRevoked if the token was already used or revoked,
+// and ErrRefreshTokenExpired if it is past its expiry.
+func (t *RefreshToken) Rotate(now time.Time, ttl time.Duration) (*RefreshToken, string, error) {
+	if t.IsRevoked() {
+		return nil, "", ErrRefreshTokenRevoked
+	}
+	if !now.Before(t.expiresAt) {
+		return nil, "", ErrRefreshTokenExpired
+	}
+
+	next, raw, err := issueRefreshToken(t.userID, t.familyID, ttl)
+	if err != nil {
+		return nil, "", err
+	}
+
+	t.revokedAt = &now
+	t.replacedBy = next.id
+
+	return next, raw, nil
+}
+
+// Revoke revokes the token. Revoking an already revoked token has no effect.
+func (t *RefreshToken) Revoke(now time.Time) {
+	if t.revokedAt == nil {
+		t.revokedAt = &now
+	}
+}
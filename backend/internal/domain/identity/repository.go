@@ -0,0 +1,46 @@
+// Package identity provides domain models for user accounts and sessions.
+// It includes the User aggregate, refresh tokens, value objects, and repository interfaces.
+package identity
+
+import (
+	"context"
+	"time"
+)
+
+// UserRepository defines the interface for User persistence operations.
+// It is implemented by the Infrastructure Layer.
+type UserRepository interface {
+	// Save saves a User to the repository.
+	// Returns a conflict error if the email or username is already taken.
+	Save(ctx context.Context, user *User) error
+
+	// FindByID finds a User by its ID.
+	// Returns a not found error if the User does not exist.
+	FindByID(ctx context.Context, id UserID) (*User, error)
+
+	// FindByEmail finds a User by email address.
+	// Returns a not found error if the User does not exist.
+	FindByEmail(ctx context.Context, email Email) (*User, error)
+
+	// FindByUsername finds a User by username.
+	// Returns a not found error if the User does not exist.
+	FindByUsername(ctx context.Context, username Username) (*User, error)
+}
+
+// RefreshTokenRepository defines the interface for RefreshToken persistence operations.
+// It is implemented by the Infrastructure Layer.
+type RefreshTokenRepository interface {
+	// Save saves a RefreshToken (insert or update of revocation state).
+	Save(ctx context.Context, token *RefreshToken) error
+
+	// Rotate atomically saves the revoked token and inserts its successor.
+	// Returns a conflict error if the token was rotated concurrently.
+	Rotate(ctx context.Context, revoked *RefreshToken, next *RefreshToken) error
+
+	// FindByHash finds a RefreshToken by the SHA-256 hash of its raw value.
+	// Returns a not found error if the token does not exist.
+	FindByHash(ctx context.Context, tokenHash string) (*RefreshToken, error)
+
+	// RevokeFamily revokes all active tokens of a family.
+	RevokeFamily(ctx context.Context, familyID string, at time.Time) error
+}
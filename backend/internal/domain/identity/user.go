@@ -0,0 +1,182 @@
+// Package identity provides domain models for user accounts and sessions.
+// It includes the User aggregate, refresh tokens, value objects, and repository interfaces.
+package identity
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrInvalidCredentials is returned when an account/password pair does not match.
+// The same error is used for unknown accounts so that callers cannot probe which accounts exist.
+var ErrInvalidCredentials = errors.New("invalid account or password")
+
+// ErrUserBanned is returned when a banned user tries to log in or refresh a session.
+var ErrUserBanned = errors.New("account has been banned")
+
+// ErrUserNotBanned is returned when lifting the ban of a user who is not banned.
+var ErrUserNotBanned = errors.New("account is not banned")
+
+// MaxBanReasonLength is the maximum length for a ban reason.
+const MaxBanReasonLength = 500
+
+// User represents a registered user aggregate root.
+// Registration is optional: anonymous posting keeps working without a User.
+type User struct {
+	// id is the unique identifier of the user.
+	id UserID
+
+	// email is the login email address (unique).
+	email Email
+
+	// username is the optional public handle (unique when set).
+	username Username
+
+	// passwordHash is the encoded argon2id hash of the password.
+	passwordHash string
+
+	// createdAt is the time when the user registered.
+	createdAt time.Time
+
+	// updatedAt is the time when the user was last modified.
+	updatedAt time.Time
+
+	// bannedAt is the time when a moderator banned the user (nil if not banned).
+	bannedAt *time.Time
+
+	// banReason is the reason given for the ban (empty if not banned).
+	banReason string
+}
+
+// NewUser registers a new User with a hashed password.
+// The password is hashed with the given hasher; the plaintext is never stored.
+// Returns an error if hashing fails.
+func NewUser(email Email, username Username, password Password, hasher PasswordHasher) (*User, error) {
+	if email.IsZero() {
+		return nil, fmt.Errorf("email is required")
+	}
+
+	hash, err := hasher.Hash(password.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	now := time.Now()
+	return &User{
+		id:           GenerateUserID(),
+		email:        email,
+		username:     username,
+		passwordHash: hash,
+		createdAt:    now,
+		updatedAt:    now,
+	}, nil
+}
+
+// NewUserFromDB creates a User from database data.
+// This is used by repositories to reconstruct Users from database rows.
+func NewUserFromDB(id UserID, email Email, username Username, passwordHash string, createdAt, updatedAt time.Time, bannedAt *time.Time, banReason string) *User {
+	return &User{
+		id:           id,
+		email:        email,
+		username:     username,
+		passwordHash: passwordHash,
+		createdAt:    createdAt,
+		updatedAt:    updatedAt,
+		bannedAt:     bannedAt,
+		banReason:    banReason,
+	}
+}
+
+// ID returns the user ID.
+func (u *User) ID() UserID {
+	return u.id
+}
+
+// Email returns the login email address.
+func (u *User) Email() Email {
+	return u.email
+}
+
+// Username returns the public handle (zero if not set).
+func (u *User) Username() Username {
+	return u.username
+}
+
+// PasswordHash returns the encoded password hash (used by repositories).
+func (u *User) PasswordHash() string {
+	return u.passwordHash
+}
+
+// CreatedAt returns the registration time.
+func (u *User) CreatedAt() time.Time {
+	return u.createdAt
+}
+
+// UpdatedAt returns the last modification time.
+func (u *User) UpdatedAt() time.Time {
+	return u.updatedAt
+}
+
+// BannedAt returns the time of the ban (nil if not banned).
+func (u *User) BannedAt() *time.Time {
+	return u.bannedAt
+}
+
+// BanReason returns the reason given for the ban (empty if not banned).
+func (u *User) BanReason() string {
+	return u.banReason
+}
+
+// IsBanned returns true if the user is banned.
+func (u *User) IsBanned() bool {
+	return u.bannedAt != nil
+}
+
+// Ban bans the user: they can no longer log in or refresh their sessions.
+// The reason must not be empty and is at most 500 characters; whitespace is trimmed.
+// Returns ErrUserBanned if the user is already banned.
+func (u *User) Ban(reason string) error {
+	if u.IsBanned() {
+		return ErrUserBanned
+	}
+	trimmed := strings.TrimSpace(reason)
+	if trimmed == "" {
+		return fmt.Errorf("ban reason cannot be empty")
+	}
+	if len([]rune(trimmed)) > MaxBanReasonLength {
+		return fmt.Errorf("ban reason must be at most %d characters", MaxBanReasonLength)
+	}
+
+	now := time.Now()
+	u.bannedAt = &now
+	u.banReason = trimmed
+	u.updatedAt = now
+	return nil
+}
+
+// Unban lifts the ban of the user.
+// Returns ErrUserNotBanned if the user is not banned.
+func (u *User) Unban() error {
+	if !u.IsBanned() {
+		return ErrUserNotBanned
+	}
+	u.bannedAt = nil
+	u.banReason = ""
+	u.updatedAt = time.Now()
+	return nil
+}
+
+// Authenticate checks the plaintext password against the stored hash.
+// Returns ErrInvalidCredentials if the password does not match.
+func (u *User) Authenticate(password string, hasher PasswordHasher) error {
+	ok, err := hasher.Verify(password, u.passwordHash)
+	if err != nil {
+		return fmt.Errorf("failed to verify password: %w", err)
+	}
+	if !ok {
+		return ErrInvalidCredentials
+	}
+	return nil
+}
@@ -0,0 +1,237 @@
+// Package identity provides domain models for user accounts and sessions.
+// It includes the User aggregate, refresh tokens, value objects, and repository interfaces.
+package identity
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/mail"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// UserID represents a unique identifier for a User.
+// It is a value object that encapsulates the business rules for User IDs.
+type UserID struct {
+	// value is the UUID string representation of the User ID.
+	value string
+}
+
+// NewUserID creates a new UserID from a UUID string.
+// Returns an error if the UUID format is invalid.
+func NewUserID(value string) (UserID, error) {
+	value = strings.TrimSpace(value)
+	if _, err := uuid.Parse(value); err != nil {
+		return UserID{}, fmt.Errorf("invalid UserID format: %w", err)
+	}
+	return UserID{value: value}, nil
+}
+
+// GenerateUserID generates a new UserID with a random UUID.
+func GenerateUserID() UserID {
+	return UserID{value: uuid.New().String()}
+}
+
+// String returns the string representation of the UserID.
+func (id UserID) String() string {
+	return id.value
+}
+
+// IsZero returns true if the UserID is the zero value.
+func (id UserID) IsZero() bool {
+	return id.value == ""
+}
+
+// Equals returns true if this UserID equals the other UserID.
+func (id UserID) Equals(other UserID) bool {
+	return id.value == other.value
+}
+
+// MaxEmailLength is the maximum length for an email address (RFC 5321).
+const MaxEmailLength = 254
+
+// Email represents a user's email address.
+// It is stored lower-cased so that lookups are case-insensitive.
+type Email struct {
+	// value is the normalized email address.
+	value string
+}
+
+// NewEmail creates a new Email from a string.
+// It trims whitespace, lower-cases the address and rejects display names ("Name <a@b.c>").
+// Returns an error if the address is empty, too long or malformed.
+func NewEmail(value string) (Email, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value == "" {
+		return Email{}, fmt.Errorf("email cannot be empty")
+	}
+	if len(value) > MaxEmailLength {
+		return Email{}, fmt.Errorf("email must be at most %d characters", MaxEmailLength)
+	}
+
+	addr, err := mail.ParseAddress(value)
+	if err != nil || addr.Address != value || addr.Name != "" {
+		return Email{}, fmt.Errorf("invalid email format")
+	}
+	if at := strings.LastIndex(value, "@"); !strings.Contains(value[at+1:], ".") {
+		return Email{}, fmt.Errorf("invalid email format: domain must contain a dot")
+	}
+
+	return Email{value: value}, nil
+}
+
+// String returns the string representation of the Email.
+func (e Email) String() string {
+	return e.value
+}
+
+// IsZero returns true if the Email is the zero value.
+func (e Email) IsZero() bool {
+	return e.value == ""
+}
+
+// Equals returns true if this Email equals the other Email.
+func (e Email) Equals(other Email) bool {
+	return e.value == other.value
+}
+
+const (
+	// MinUsernameLength is the minimum length for a username.
+	MinUsernameLength = 3
+	// MaxUsernameLength is the maximum length for a username.
+	MaxUsernameLength = 32
+)
+
+// usernamePattern allows letters (including CJK), digits, underscores and hyphens.
+var usernamePattern = regexp.MustCompile(`^[\p{L}\p{N}_-]+$`)
+
+// Username represents an optional public handle for a user.
+// The zero value means the user has not chosen a username.
+type Username struct {
+	// value is the username string.
+	value string
+}
+
+// NewUsername creates a new Username from a string.
+// An empty string returns the zero Username (no username).
+// Returns an error if the username is too short, too long or contains invalid characters.
+func NewUsername(value string) (Username, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return Username{}, nil
+	}
+
+	length := utf8.RuneCountInString(value)
+	if length < MinUsernameLength {
+		return Username{}, fmt.Errorf("username must be at least %d characters", MinUsernameLength)
+	}
+	if length > MaxUsernameLength {
+		return Username{}, fmt.Errorf("username must be at most %d characters", MaxUsernameLength)
+	}
+	if !usernamePattern.MatchString(value) {
+		return Username{}, fmt.Errorf("username may only contain letters, digits, underscores and hyphens")
+	}
+	if strings.Contains(value, "@") {
+		return Username{}, fmt.Errorf("username cannot contain @")
+	}
+
+	return Username{value: value}, nil
+}
+
+// String returns the string representation of the Username.
+func (u Username) String() string {
+	return u.value
+}
+
+// IsZero returns true if no username is set.
+func (u Username) IsZero() bool {
+	return u.value == ""
+}
+
+// Equals returns true if this Username equals the other Username.
+func (u Username) Equals(other Username) bool {
+	return u.value == other.value
+}
+
+const (
+	// MinPasswordLength is the minimum length for a password.
+	MinPasswordLength = 8
+	// MaxPasswordLength is the maximum length for a password.
+	MaxPasswordLength = 128
+)
+
+// Password represents a plaintext password that satisfies the password policy.
+// It only lives for the duration of a request and is never persisted.
+type Password struct {
+	// value is the plaintext password.
+	value string
+}
+
+// NewPassword creates a new Password from a string.
+// Whitespace is significant and not trimmed.
+// Returns an error if the password is too short, too long, or only contains one kind of character.
+func NewPassword(value string) (Password, error) {
+	length := utf8.RuneCountInString(value)
+	if length < MinPasswordLength {
+		return Password{}, fmt.Errorf("password must be at least %d characters", MinPasswordLength)
+	}
+	if length > MaxPasswordLength {
+		return Password{}, fmt.Errorf("password must be at most %d characters", MaxPasswordLength)
+	}
+
+	hasLetter, hasOther := false, false
+	for _, r := range value {
+		if ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z') {
+			hasLetter = true
+		} else {
+			hasOther = true
+		}
+	}
+	if !hasLetter || !hasOther {
+		return Password{}, fmt.Errorf("password must contain letters and at least one digit or symbol")
+	}
+
+	return Password{value: value}, nil
+}
+
+// String returns the plaintext password.
+// It must only be passed to a PasswordHasher.
+func (p Password) String() string {
+	return p.value
+}
+
+// PasswordHasher hashes and verifies passwords.
+// It is a domain service; the implementation (argon2id) lives in the Infrastructure Layer.
+type PasswordHasher interface {
+	// Hash returns an encoded hash of the password, including its parameters and salt.
+	Hash(password string) (string, error)
+
+	// Verify reports whether the password matches the encoded hash.
+	// Returns an error only if the encoded hash is malformed.
+	Verify(password, encodedHash string) (bool, error)
+}
+
+// refreshTokenBytes is the number of random bytes in a refresh token.
+const refreshTokenBytes = 32
+
+// generateRefreshTokenValue generates a random URL-safe refresh token.
+func generateRefreshTokenValue() (string, error) {
+	buf := make([]byte, refreshTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate refresh token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// HashRefreshToken returns the hex-encoded SHA-256 hash of a raw refresh token.
+// Only the hash is persisted, so a database leak does not leak usable tokens.
+func HashRefreshToken(raw string) string {
+	sum := sha256.Sum256([]byte(strings.TrimSpace(raw)))
+	return hex.EncodeToString(sum[:])
+}
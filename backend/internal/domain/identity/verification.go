@@ -0,0 +1,182 @@
+package identity
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+// VerificationCodeLength is the number of digits in a verification code.
+const VerificationCodeLength = 6
+
+// VerificationChannel is how a verification code is delivered.
+type VerificationChannel struct {
+	// value is the channel name.
+	value string
+}
+
+var (
+	// VerificationChannelEmail delivers codes by email.
+	VerificationChannelEmail = VerificationChannel{value: "EMAIL"}
+	// VerificationChannelSMS delivers codes by text message.
+	VerificationChannelSMS = VerificationChannel{value: "SMS"}
+)
+
+// NewVerificationChannel creates a VerificationChannel from a string (case-insensitive).
+// Returns an error if the channel is not EMAIL or SMS.
+func NewVerificationChannel(value string) (VerificationChannel, error) {
+	switch strings.ToUpper(strings.TrimSpace(value)) {
+	case VerificationChannelEmail.value:
+		return VerificationChannelEmail, nil
+	case VerificationChannelSMS.value:
+		return VerificationChannelSMS, nil
+	default:
+		return VerificationChannel{}, fmt.Errorf("invalid verification channel: %q (must be EMAIL or SMS)", value)
+	}
+}
+
+// String returns the string representation of the VerificationChannel.
+func (c VerificationChannel) String() string {
+	return c.value
+}
+
+// IsZero returns true if the VerificationChannel is the zero value.
+func (c VerificationChannel) IsZero() bool {
+	return c.value == ""
+}
+
+// VerificationPurpose is what a verification code may be used for.
+// A code issued for one purpose is never accepted for another.
+type VerificationPurpose struct {
+	// value is the purpose name.
+	value string
+}
+
+// VerificationPurposeRegister proves ownership of the address used to register.
+var VerificationPurposeRegister = VerificationPurpose{value: "REGISTER"}
+
+// NewVerificationPurpose creates a VerificationPurpose from a string (case-insensitive).
+// Returns an error if the purpose is unknown.
+func NewVerificationPurpose(value string) (VerificationPurpose, error) {
+	switch strings.ToUpper(strings.TrimSpace(value)) {
+	case VerificationPurposeRegister.value:
+		return VerificationPurposeRegister, nil
+	default:
+		return VerificationPurpose{}, fmt.Errorf("invalid verification purpose: %q", value)
+	}
+}
+
+// String returns the string representation of the VerificationPurpose.
+func (p VerificationPurpose) String() string {
+	return p.value
+}
+
+// IsZero returns true if the VerificationPurpose is the zero value.
+func (p VerificationPurpose) IsZero() bool {
+	return p.value == ""
+}
+
+var (
+	// mainlandMobilePattern matches mainland China mobile numbers without country code.
+	mainlandMobilePattern = regexp.MustCompile(`^1[3-9]\d{9}$`)
+	// e164Pattern matches E.164 numbers ("+" followed by 8-15 digits).
+	e164Pattern = regexp.MustCompile(`^\+[1-9]\d{7,14}$`)
+)
+
+// PhoneNumber represents a mobile phone number in E.164 format.
+type PhoneNumber struct {
+	// value is the E.164 representation (e.g., "+8613800138000").
+	value string
+}
+
+// NewPhoneNumber creates a new PhoneNumber from a string.
+// Spaces and hyphens are ignored; 11-digit mainland China mobile numbers get the +86 prefix.
+// Returns an error if the number is not a valid mobile or E.164 number.
+func NewPhoneNumber(value string) (PhoneNumber, error) {
+	value = strings.NewReplacer(" ", "", "-", "").Replace(strings.TrimSpace(value))
+	if value == "" {
+		return PhoneNumber{}, fmt.Errorf("phone number cannot be empty")
+	}
+	if mainlandMobilePattern.MatchString(value) {
+		value = "+86" + value
+	}
+	if !e164Pattern.MatchString(value) {
+		return PhoneNumber{}, fmt.Errorf("invalid phone number format")
+	}
+	return PhoneNumber{value: value}, nil
+}
+
+// String returns the E.164 representation of the PhoneNumber.
+func (p PhoneNumber) String() string {
+	return p.value
+}
+
+// IsZero returns true if the PhoneNumber is the zero value.
+func (p PhoneNumber) IsZero() bool {
+	return p.value == ""
+}
+
+// NewVerificationRecipient normalizes the recipient address for a channel:
+// an Email for EMAIL and a PhoneNumber for SMS.
+// Returns an error if the address is not valid for the channel.
+func NewVerificationRecipient(channel VerificationChannel, value string) (string, error) {
+	switch channel {
+	case VerificationChannelEmail:
+		email, err := NewEmail(value)
+		if err != nil {
+			return "", err
+		}
+		return email.String(), nil
+	case VerificationChannelSMS:
+		phone, err := NewPhoneNumber(value)
+		if err != nil {
+			return "", err
+		}
+		return phone.String(), nil
+	default:
+		return "", fmt.Errorf("verification channel is required")
+	}
+}
+
+// VerificationCode is a one-time numeric code sent to prove ownership of an address.
+type VerificationCode struct {
+	// value is the zero-padded decimal code.
+	value string
+}
+
+// GenerateVerificationCode generates a random 6-digit code using crypto/rand.
+func GenerateVerificationCode() (VerificationCode, error) {
+	max := big.NewInt(1_000_000)
+	n, err := rand.Int(rand.Reader, max)
+	if err != nil {
+		return VerificationCode{}, fmt.Errorf("failed to generate verification code: %w", err)
+	}
+	return VerificationCode{value: fmt.Sprintf("%0*d", VerificationCodeLength, n.Int64())}, nil
+}
+
+// NewVerificationCodeFromString restores a VerificationCode, e.g. from the cache.
+// Returns an error if the value is not exactly 6 digits.
+func NewVerificationCodeFromString(value string) (VerificationCode, error) {
+	value = strings.TrimSpace(value)
+	if len(value) != VerificationCodeLength || strings.Trim(value, "0123456789") != "" {
+		return VerificationCode{}, fmt.Errorf("verification code must be %d digits", VerificationCodeLength)
+	}
+	return VerificationCode{value: value}, nil
+}
+
+// String returns the code.
+func (c VerificationCode) String() string {
+	return c.value
+}
+
+// Matches reports whether the submitted code equals this code (constant-time comparison).
+func (c VerificationCode) Matches(submitted string) bool {
+	submitted = strings.TrimSpace(submitted)
+	if c.value == "" || len(submitted) != len(c.value) {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(submitted), []byte(c.value)) == 1
+}
@@ -0,0 +1,251 @@
+package moderation
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"fuck_boss/backend/internal/domain/content"
+)
+
+var (
+	// ErrPostNotModerated is returned when appealing a post that is visible.
+	ErrPostNotModerated = errors.New("only hidden or removed posts can be appealed")
+
+	// ErrAlreadyAppealed is returned when the moderation action on a post has
+	// already been appealed.
+	ErrAlreadyAppealed = errors.New("the moderation of this post has already been appealed")
+
+	// ErrAppealDecided is returned when deciding an appeal that is no longer pending.
+	ErrAppealDecided = errors.New("appeal has already been decided")
+
+	// ErrOwnAction is returned when a moderator decides the appeal of their own action.
+	ErrOwnAction = errors.New("moderators cannot decide appeals of their own actions")
+)
+
+const (
+	// MaxAppealStatementLength is the maximum length for the statement of an appeal.
+	MaxAppealStatementLength = 2000
+
+	// MaxAppealReasonLength is the maximum length for the reason of a decision.
+	MaxAppealReasonLength = 500
+)
+
+// AppealStatus is the state of an appeal. It is a value object.
+type AppealStatus string
+
+const (
+	// AppealStatusPending means the appeal waits for a moderator's decision.
+	AppealStatusPending AppealStatus = "PENDING"
+
+	// AppealStatusAccepted means the appeal was accepted and the post restored.
+	AppealStatusAccepted AppealStatus = "ACCEPTED"
+
+	// AppealStatusRejected means the appeal was rejected; the post stays hidden or removed.
+	AppealStatusRejected AppealStatus = "REJECTED"
+)
+
+// NewAppealStatus creates an AppealStatus from a string (case-insensitive).
+// Returns an error if the value is not a known status.
+func NewAppealStatus(value string) (AppealStatus, error) {
+	status := AppealStatus(strings.ToUpper(strings.TrimSpace(value)))
+	switch status {
+	case AppealStatusPending, AppealStatusAccepted, AppealStatusRejected:
+		return status, nil
+	default:
+		return "", fmt.Errorf("invalid appeal status: %q", value)
+	}
+}
+
+// String returns the string representation of the AppealStatus.
+func (s AppealStatus) String() string {
+	return string(s)
+}
+
+// Appeal is an author's request to reverse the hiding or removal of their post.
+// It is filed by the holder of the post's management token and decided by a
+// moderator other than the one who took the appealed action.
+type Appeal struct {
+	// id is the unique identifier of the appeal (UUID).
+	id string
+
+	// postID is the ID of the appealed post.
+	postID content.PostID
+
+	// appealedStatus is the moderation status of the post when the appeal was filed.
+	appealedStatus content.ModerationStatus
+
+	// actedBy is the user ID of the moderator who took the appealed action
+	// (empty for operator actions).
+	actedBy string
+
+	// statement is the author's explanation.
+	statement string
+
+	// status is the state of the appeal.
+	status AppealStatus
+
+	// decidedBy is the user ID of the moderator who decided (empty while pending).
+	decidedBy string
+
+	// decisionReason is the reason given with the decision (empty while pending).
+	decisionReason string
+
+	// filedAt is the time when the appeal was filed.
+	filedAt time.Time
+
+	// decidedAt is the time of the decision (nil while pending).
+	decidedAt *time.Time
+}
+
+// NewAppeal files an appeal against the current moderation of a post.
+// latest is the latest appeal of the post (nil if none): each moderation action
+// can be appealed once, so a new appeal is only accepted when the latest one
+// was decided and the post has been moderated again since it was filed.
+// Returns ErrPostNotModerated for visible posts, ErrAlreadyAppealed, or an
+// error if the statement is empty or too long.
+func NewAppeal(post *content.Post, statement string, latest *Appeal) (*Appeal, error) {
+	if post.IsVisible() {
+		return nil, ErrPostNotModerated
+	}
+	if latest != nil && (latest.IsPending() || !post.Moderation().ModeratedAt.After(latest.filedAt)) {
+		return nil, ErrAlreadyAppealed
+	}
+
+	trimmed := strings.TrimSpace(statement)
+	if trimmed == "" {
+		return nil, fmt.Errorf("appeal statement cannot be empty")
+	}
+	if len([]rune(trimmed)) > MaxAppealStatementLength {
+		return nil, fmt.Errorf("appeal statement must be at most %d characters", MaxAppealStatementLength)
+	}
+
+	moderation := post.Moderation()
+	return &Appeal{
+		id:             uuid.New().String(),
+		postID:         post.ID(),
+		appealedStatus: moderation.Status,
+		actedBy:        moderation.ModeratedBy,
+		statement:      trimmed,
+		status:         AppealStatusPending,
+		filedAt:        time.Now(),
+	}, nil
+}
+
+// NewAppealFromDB creates an Appeal from database data.
+// This is used by repositories to reconstruct Appeals from database rows.
+func NewAppealFromDB(
+	id string,
+	postID content.PostID,
+	appealedStatus content.ModerationStatus,
+	actedBy string,
+	statement string,
+	status AppealStatus,
+	decidedBy string,
+	decisionReason string,
+	filedAt time.Time,
+	decidedAt *time.Time,
+) *Appeal {
+	return &Appeal{
+		id:             id,
+		postID:         postID,
+		appealedStatus: appealedStatus,
+		actedBy:        actedBy,
+		statement:      statement,
+		status:         status,
+		decidedBy:      decidedBy,
+		decisionReason: decisionReason,
+		filedAt:        filedAt,
+		decidedAt:      decidedAt,
+	}
+}
+
+// Decide accepts or rejects the appeal for the moderator with a reason.
+// Returns ErrAppealDecided if the appeal is not pending, ErrOwnAction if the
+// moderator took the appealed action, or an error if the reason is empty or too long.
+// Accepting does not restore the post; the caller restores it.
+func (a *Appeal) Decide(moderatorID string, accept bool, reason string) error {
+	if !a.IsPending() {
+		return ErrAppealDecided
+	}
+	if a.actedBy != "" && a.actedBy == moderatorID {
+		return ErrOwnAction
+	}
+
+	trimmed := strings.TrimSpace(reason)
+	if trimmed == "" {
+		return fmt.Errorf("decision reason cannot be empty")
+	}
+	if len([]rune(trimmed)) > MaxAppealReasonLength {
+		return fmt.Errorf("decision reason must be at most %d characters", MaxAppealReasonLength)
+	}
+
+	now := time.Now()
+	a.status = AppealStatusRejected
+	if accept {
+		a.status = AppealStatusAccepted
+	}
+	a.decidedBy = moderatorID
+	a.decisionReason = trimmed
+	a.decidedAt = &now
+	return nil
+}
+
+// ID returns the Appeal ID.
+func (a *Appeal) ID() string {
+	return a.id
+}
+
+// PostID returns the ID of the appealed post.
+func (a *Appeal) PostID() content.PostID {
+	return a.postID
+}
+
+// AppealedStatus returns the moderation status of the post when the appeal was filed.
+func (a *Appeal) AppealedStatus() content.ModerationStatus {
+	return a.appealedStatus
+}
+
+// ActedBy returns the user ID of the moderator who took the appealed action
+// (empty for operator actions).
+func (a *Appeal) ActedBy() string {
+	return a.actedBy
+}
+
+// Statement returns the author's explanation.
+func (a *Appeal) Statement() string {
+	return a.statement
+}
+
+// Status returns the state of the appeal.
+func (a *Appeal) Status() AppealStatus {
+	return a.status
+}
+
+// IsPending returns true if the appeal waits for a decision.
+func (a *Appeal) IsPending() bool {
+	return a.status == AppealStatusPending
+}
+
+// DecidedBy returns the user ID of the moderator who decided (empty while pending).
+func (a *Appeal) DecidedBy() string {
+	return a.decidedBy
+}
+
+// DecisionReason returns the reason given with the decision (empty while pending).
+func (a *Appeal) DecisionReason() string {
+	return a.decisionReason
+}
+
+// FiledAt returns the time when the appeal was filed.
+func (a *Appeal) FiledAt() time.Time {
+	return a.filedAt
+}
+
+// DecidedAt returns the time of the decision (nil while pending).
+func (a *Appeal) DecidedAt() *time.Time {
+	return a.decidedAt
+}
@@ -0,0 +1,172 @@
+package moderation
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"fuck_boss/backend/internal/domain/shared"
+)
+
+var (
+	// ErrPermissionDenied is returned when a moderator's role does not grant a permission.
+	ErrPermissionDenied = errors.New("moderator role does not grant this permission")
+
+	// ErrCityNotAssigned is returned when a moderator acts on a city they are not assigned to.
+	ErrCityNotAssigned = errors.New("moderator is not assigned to this city")
+)
+
+// Moderator is a registered user who reviews posts. Regional volunteers are
+// assigned to a few cities and only see and act on posts from those cities;
+// moderators without city assignments cover all cities.
+type Moderator struct {
+	// userID is the ID of the user account (UUID); it identifies the moderator.
+	userID string
+
+	// role decides the permissions of the moderator.
+	role Role
+
+	// cityCodes are the assigned cities, sorted (empty for all cities).
+	cityCodes []string
+
+	// assignedAt is the time when the user became a moderator.
+	assignedAt time.Time
+
+	// updatedAt is the time when the role or cities last changed.
+	updatedAt time.Time
+}
+
+// NewModerator makes a user a moderator with the given role and cities.
+// An empty city list assigns all cities.
+// Returns an error if the user ID is not a UUID or a city code is invalid.
+func NewModerator(userID string, role Role, cityCodes []string) (*Moderator, error) {
+	if _, err := uuid.Parse(userID); err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	codes, err := normalizeCityCodes(cityCodes)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	return &Moderator{
+		userID:     userID,
+		role:       role,
+		cityCodes:  codes,
+		assignedAt: now,
+		updatedAt:  now,
+	}, nil
+}
+
+// NewModeratorFromDB creates a Moderator from database data.
+// This is used by repositories to reconstruct Moderators from database rows.
+func NewModeratorFromDB(userID string, role Role, cityCodes []string, assignedAt, updatedAt time.Time) *Moderator {
+	return &Moderator{
+		userID:     userID,
+		role:       role,
+		cityCodes:  cityCodes,
+		assignedAt: assignedAt,
+		updatedAt:  updatedAt,
+	}
+}
+
+// Assign changes the role and cities of the moderator.
+// An empty city list assigns all cities.
+// Returns an error if a city code is invalid.
+func (m *Moderator) Assign(role Role, cityCodes []string) error {
+	codes, err := normalizeCityCodes(cityCodes)
+	if err != nil {
+		return err
+	}
+	m.role = role
+	m.cityCodes = codes
+	m.updatedAt = time.Now()
+	return nil
+}
+
+// UserID returns the ID of the moderator's user account.
+func (m *Moderator) UserID() string {
+	return m.userID
+}
+
+// Role returns the role of the moderator.
+func (m *Moderator) Role() Role {
+	return m.role
+}
+
+// CityCodes returns the assigned cities, sorted (empty for all cities).
+func (m *Moderator) CityCodes() []string {
+	return m.cityCodes
+}
+
+// AssignedAt returns the time when the user became a moderator.
+func (m *Moderator) AssignedAt() time.Time {
+	return m.assignedAt
+}
+
+// UpdatedAt returns the time when the role or cities last changed.
+func (m *Moderator) UpdatedAt() time.Time {
+	return m.updatedAt
+}
+
+// CoversAllCities returns true if the moderator is not limited to some cities.
+func (m *Moderator) CoversAllCities() bool {
+	return len(m.cityCodes) == 0
+}
+
+// CoversCity reports whether the moderator may see and act on posts from the city.
+func (m *Moderator) CoversCity(cityCode string) bool {
+	if m.CoversAllCities() {
+		return true
+	}
+	for _, code := range m.cityCodes {
+		if code == cityCode {
+			return true
+		}
+	}
+	return false
+}
+
+// Can reports whether the moderator's role grants the permission.
+func (m *Moderator) Can(permission Permission) bool {
+	return m.role.Can(permission)
+}
+
+// Authorize checks that the moderator may use the permission on a post from the city.
+// Returns ErrPermissionDenied if the role does not grant the permission, or
+// ErrCityNotAssigned if the city is not one of the moderator's cities.
+func (m *Moderator) Authorize(permission Permission, cityCode string) error {
+	if !m.Can(permission) {
+		return ErrPermissionDenied
+	}
+	if !m.CoversCity(cityCode) {
+		return ErrCityNotAssigned
+	}
+	return nil
+}
+
+// normalizeCityCodes trims, validates, deduplicates and sorts city codes.
+func normalizeCityCodes(cityCodes []string) ([]string, error) {
+	seen := make(map[string]bool, len(cityCodes))
+	codes := make([]string, 0, len(cityCodes))
+	for _, value := range cityCodes {
+		code := strings.TrimSpace(value)
+		if code == "" {
+			return nil, fmt.Errorf("city code cannot be empty")
+		}
+		if len([]rune(code)) > shared.MaxCityCodeLength {
+			return nil, fmt.Errorf("city code must be at most %d characters", shared.MaxCityCodeLength)
+		}
+		if !seen[code] {
+			seen[code] = true
+			codes = append(codes, code)
+		}
+	}
+	sort.Strings(codes)
+	return codes, nil
+}
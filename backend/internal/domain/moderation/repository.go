@@ -0,0 +1,52 @@
+package moderation
+
+import (
+	"context"
+
+	"fuck_boss/backend/internal/domain/content"
+)
+
+// ModeratorRepository defines the interface for Moderator persistence operations.
+// It is implemented by the Infrastructure Layer.
+type ModeratorRepository interface {
+	// Save saves a Moderator (insert, or update of the role and cities).
+	Save(ctx context.Context, moderator *Moderator) error
+
+	// FindByUserID finds the Moderator of a user account.
+	// Returns a not found error if the user is not a moderator.
+	FindByUserID(ctx context.Context, userID string) (*Moderator, error)
+
+	// FindAll returns all moderators, the most recently assigned first.
+	FindAll(ctx context.Context) ([]*Moderator, error)
+
+	// Delete removes the moderator role from a user account.
+	// Returns a not found error if the user is not a moderator.
+	Delete(ctx context.Context, userID string) error
+}
+
+// AppealRepository defines the interface for Appeal persistence operations.
+// It is implemented by the Infrastructure Layer.
+type AppealRepository interface {
+	// Save inserts a new Appeal.
+	// Returns a conflict error if the post already has a pending appeal.
+	Save(ctx context.Context, appeal *Appeal) error
+
+	// SaveDecision stores the decision of an Appeal that is still pending and,
+	// if restored is not nil, the moderation state of the restored post, in one
+	// transaction. Returns a conflict error if the appeal was decided meanwhile.
+	SaveDecision(ctx context.Context, appeal *Appeal, restored *content.Post) error
+
+	// FindByID finds an Appeal by its ID.
+	// Returns a not found error if the Appeal does not exist.
+	FindByID(ctx context.Context, id string) (*Appeal, error)
+
+	// FindLatestByPostID finds the most recently filed Appeal of a post.
+	// Returns a not found error if the post has never been appealed.
+	FindLatestByPostID(ctx context.Context, postID content.PostID) (*Appeal, error)
+
+	// FindPending finds pending Appeals, the longest-waiting first, with pagination.
+	// Appeals of actions taken by excludeActedBy are left out, so moderators
+	// never see appeals of their own actions. cityCodes limits the appeals to
+	// posts from these cities (empty for all cities).
+	FindPending(ctx context.Context, excludeActedBy string, cityCodes []string, page, pageSize int) ([]*Appeal, int, error)
+}
@@ -0,0 +1,124 @@
+// Package moderation provides domain models for volunteer moderators: their
+// roles, the permissions each role grants, the cities they are assigned to, and
+// the appeals authors file against their actions.
+package moderation
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Role is the rank of a moderator. It is a value object.
+type Role string
+
+const (
+	// RoleJunior is a volunteer who reviews the queue and hides posts pending review.
+	RoleJunior Role = "JUNIOR"
+
+	// RoleSenior is an experienced moderator who can also remove and restore
+	// posts, ban their authors and decide appeals.
+	RoleSenior Role = "SENIOR"
+)
+
+// Roles lists all roles from the lowest to the highest.
+var Roles = []Role{RoleJunior, RoleSenior}
+
+// NewRole creates a Role from a string (case-insensitive).
+// Returns an error if the value is not a known role.
+func NewRole(value string) (Role, error) {
+	role := Role(strings.ToUpper(strings.TrimSpace(value)))
+	switch role {
+	case RoleJunior, RoleSenior:
+		return role, nil
+	default:
+		return "", fmt.Errorf("invalid moderator role: %q", value)
+	}
+}
+
+// String returns the string representation of the Role.
+func (r Role) String() string {
+	return string(r)
+}
+
+// Permissions returns the permissions granted to the role, in Permissions order.
+func (r Role) Permissions() []Permission {
+	granted := rolePermissions[r]
+	permissions := make([]Permission, 0, len(granted))
+	for _, permission := range Permissions {
+		if granted[permission] {
+			permissions = append(permissions, permission)
+		}
+	}
+	return permissions
+}
+
+// Can reports whether the role grants the permission.
+func (r Role) Can(permission Permission) bool {
+	return rolePermissions[r][permission]
+}
+
+// Permission is an action a moderator may be allowed to take. It is a value object.
+type Permission string
+
+const (
+	// PermissionViewQueue allows listing the posts pending review.
+	PermissionViewQueue Permission = "VIEW_QUEUE"
+
+	// PermissionHidePost allows hiding a visible post pending review.
+	PermissionHidePost Permission = "HIDE_POST"
+
+	// PermissionRemovePost allows taking a post down.
+	PermissionRemovePost Permission = "REMOVE_POST"
+
+	// PermissionRestorePost allows making a hidden or removed post visible again.
+	PermissionRestorePost Permission = "RESTORE_POST"
+
+	// PermissionBanUser allows banning the author of a post.
+	PermissionBanUser Permission = "BAN_USER"
+
+	// PermissionDecideAppeal allows accepting or rejecting the appeals of authors.
+	PermissionDecideAppeal Permission = "DECIDE_APPEAL"
+)
+
+// Permissions lists all permissions in display order.
+var Permissions = []Permission{
+	PermissionViewQueue,
+	PermissionHidePost,
+	PermissionRemovePost,
+	PermissionRestorePost,
+	PermissionBanUser,
+	PermissionDecideAppeal,
+}
+
+// rolePermissions is the policy: the permissions granted to each role.
+var rolePermissions = map[Role]map[Permission]bool{
+	RoleJunior: {
+		PermissionViewQueue: true,
+		PermissionHidePost:  true,
+	},
+	RoleSenior: {
+		PermissionViewQueue:    true,
+		PermissionHidePost:     true,
+		PermissionRemovePost:   true,
+		PermissionRestorePost:  true,
+		PermissionBanUser:      true,
+		PermissionDecideAppeal: true,
+	},
+}
+
+// NewPermission creates a Permission from a string (case-insensitive).
+// Returns an error if the value is not a known permission.
+func NewPermission(value string) (Permission, error) {
+	permission := Permission(strings.ToUpper(strings.TrimSpace(value)))
+	for _, known := range Permissions {
+		if permission == known {
+			return permission, nil
+		}
+	}
+	return "", fmt.Errorf("invalid moderator permission: %q", value)
+}
+
+// String returns the string representation of the Permission.
+func (p Permission) String() string {
+	return string(p)
+}
@@ -0,0 +1,66 @@
+package notification
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Cursor marks a position in a recipient's notification list (newest first).
+// It points at the last notification of a page; the next page starts right after it.
+// Unlike page numbers, cursors stay stable while new notifications arrive.
+type Cursor struct {
+	// createdAt is the creation time of the last notification on the page.
+	createdAt time.Time
+
+	// id is the ID of the last notification on the page (tie-breaker for equal times).
+	id string
+}
+
+// CursorAfter returns the Cursor pointing at the given notification.
+func CursorAfter(n *Notification) Cursor {
+	return Cursor{createdAt: n.createdAt, id: n.id}
+}
+
+// ParseCursor parses an opaque cursor string produced by Cursor.String.
+// Returns an error if the cursor is malformed.
+func ParseCursor(value string) (Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return Cursor{}, fmt.Errorf("invalid cursor: %w", err)
+	}
+
+	nanos, id, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return Cursor{}, fmt.Errorf("invalid cursor")
+	}
+
+	unixNano, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return Cursor{}, fmt.Errorf("invalid cursor: %w", err)
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return Cursor{}, fmt.Errorf("invalid cursor: %w", err)
+	}
+
+	return Cursor{createdAt: time.Unix(0, unixNano), id: id}, nil
+}
+
+// String returns the opaque string form of the cursor.
+func (c Cursor) String() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(c.createdAt.UnixNano(), 10) + ":" + c.id))
+}
+
+// CreatedAt returns the creation time of the notification the cursor points at.
+func (c Cursor) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+// ID returns the ID of the notification the cursor points at.
+func (c Cursor) ID() string {
+	return c.id
+}
@@ -0,0 +1,173 @@
+// Package notification provides domain models for the in-app notification center.
+// Notifications are addressed to logged-in users and created when something happens
+// that concerns them: a reply to their comment, a new post about a watched company,
+// a moderation outcome on their post, or an official company reply.
+package notification
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"fuck_boss/backend/internal/domain/content"
+	"fuck_boss/backend/internal/domain/identity"
+)
+
+// MaxExcerptLength is the maximum length of a notification excerpt in characters.
+const MaxExcerptLength = 100
+
+// Type is the kind of event a notification is about.
+type Type string
+
+const (
+	// TypeCommentReply means someone replied to the recipient's comment.
+	TypeCommentReply Type = "COMMENT_REPLY"
+
+	// TypeWatchedCompanyPost means a new post was published about a company the recipient watches.
+	TypeWatchedCompanyPost Type = "WATCHED_COMPANY_POST"
+
+	// TypeModerationOutcome means a moderator acted on the recipient's post.
+	TypeModerationOutcome Type = "MODERATION_OUTCOME"
+
+	// TypeCompanyReply means a verified company representative replied to the recipient's post.
+	TypeCompanyReply Type = "COMPANY_REPLY"
+)
+
+// NewType creates a Type from a string (case-insensitive).
+// Returns an error if the value is not a known type.
+func NewType(value string) (Type, error) {
+	t := Type(strings.ToUpper(strings.TrimSpace(value)))
+	switch t {
+	case TypeCommentReply, TypeWatchedCompanyPost, TypeModerationOutcome, TypeCompanyReply:
+		return t, nil
+	default:
+		return "", fmt.Errorf("invalid notification type: %q", value)
+	}
+}
+
+// String returns the string representation of the Type.
+func (t Type) String() string {
+	return string(t)
+}
+
+// Notification is a message in a user's notification center.
+type Notification struct {
+	// id is the unique identifier of the notification (UUID).
+	id string
+
+	// recipientID is the user the notification is addressed to.
+	recipientID identity.UserID
+
+	// kind is the type of event.
+	kind Type
+
+	// postID is the post the event relates to.
+	postID content.PostID
+
+	// subject is a short title: the company name, or the outcome for moderation notifications.
+	subject string
+
+	// excerpt is a short preview of the post, reply or reason (at most MaxExcerptLength characters).
+	excerpt string
+
+	// createdAt is the time when the notification was created.
+	createdAt time.Time
+
+	// readAt is the time when the recipient read the notification (nil while unread).
+	readAt *time.Time
+}
+
+// NewNotification creates a new unread Notification.
+// The excerpt is truncated to MaxExcerptLength characters.
+func NewNotification(recipientID identity.UserID, kind Type, postID content.PostID, subject, excerpt string) *Notification {
+	return &Notification{
+		id:          uuid.New().String(),
+		recipientID: recipientID,
+		kind:        kind,
+		postID:      postID,
+		subject:     subject,
+		excerpt:     truncateExcerpt(excerpt),
+		createdAt:   time.Now(),
+	}
+}
+
+// NewNotificationFromDB creates a Notification from database data.
+// This is used by repositories to reconstruct Notifications from database rows.
+func NewNotificationFromDB(
+	id string,
+	recipientID identity.UserID,
+	kind Type,
+	postID content.PostID,
+	subject string,
+	excerpt string,
+	createdAt time.Time,
+	readAt *time.Time,
+) *Notification {
+	return &Notification{
+		id:          id,
+		recipientID: recipientID,
+		kind:        kind,
+		postID:      postID,
+		subject:     subject,
+		excerpt:     excerpt,
+		createdAt:   createdAt,
+		readAt:      readAt,
+	}
+}
+
+// ID returns the notification ID.
+func (n *Notification) ID() string {
+	return n.id
+}
+
+// RecipientID returns the user the notification is addressed to.
+func (n *Notification) RecipientID() identity.UserID {
+	return n.recipientID
+}
+
+// Type returns the type of event.
+func (n *Notification) Type() Type {
+	return n.kind
+}
+
+// PostID returns the post the event relates to.
+func (n *Notification) PostID() content.PostID {
+	return n.postID
+}
+
+// Subject returns the short title.
+func (n *Notification) Subject() string {
+	return n.subject
+}
+
+// Excerpt returns the short preview.
+func (n *Notification) Excerpt() string {
+	return n.excerpt
+}
+
+// CreatedAt returns the creation time.
+func (n *Notification) CreatedAt() time.Time {
+	return n.createdAt
+}
+
+// ReadAt returns when the notification was read (nil while unread).
+func (n *Notification) ReadAt() *time.Time {
+	return n.readAt
+}
+
+// IsRead returns true if the recipient has read the notification.
+func (n *Notification) IsRead() bool {
+	return n.readAt != nil
+}
+
+// truncateExcerpt shortens an excerpt to MaxExcerptLength characters, appending "..." when cut.
+func truncateExcerpt(value string) string {
+	value = strings.TrimSpace(value)
+	runes := []rune(value)
+	if len(runes) <= MaxExcerptLength {
+		return value
+	}
+	return string(runes[:MaxExcerptLength]) + "..."
+}
@@ -0,0 +1,41 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"fuck_boss/backend/internal/domain/identity"
+)
+
+// Filter narrows down the notifications returned by NotificationRepository.FindByRecipient.
+type Filter struct {
+	// UnreadOnly restricts results to unread notifications.
+	UnreadOnly bool
+
+	// Type restricts results to a single type (nil means all types).
+	Type *Type
+}
+
+// NotificationRepository defines the interface for Notification persistence.
+type NotificationRepository interface {
+	// SaveAll stores new notifications in a single batch.
+	// Returns an error if the operation fails.
+	SaveAll(ctx context.Context, notifications []*Notification) error
+
+	// FindByRecipient finds the recipient's notifications, newest first.
+	// If after is non-nil, only notifications after that cursor are returned.
+	// At most limit notifications are returned.
+	FindByRecipient(ctx context.Context, recipientID identity.UserID, filter Filter, after *Cursor, limit int) ([]*Notification, error)
+
+	// CountUnread counts the recipient's unread notifications.
+	CountUnread(ctx context.Context, recipientID identity.UserID) (int, error)
+
+	// MarkRead marks one of the recipient's notifications as read.
+	// Marking an already read notification keeps its original read time.
+	// Returns a NOT_FOUND error if the notification does not exist or belongs to someone else.
+	MarkRead(ctx context.Context, recipientID identity.UserID, id string, at time.Time) error
+
+	// MarkAllRead marks all of the recipient's unread notifications as read.
+	// Returns the number of notifications updated.
+	MarkAllRead(ctx context.Context, recipientID identity.UserID, at time.Time) (int, error)
+}
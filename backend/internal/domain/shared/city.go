@@ -7,6 +7,14 @@ import (
 	"strings"
 )
 
+const (
+	// MaxCityCodeLength is the maximum length of a city code (in characters).
+	MaxCityCodeLength = 50
+
+	// MaxCityNameLength is the maximum length of a city name (in characters).
+	MaxCityNameLength = 50
+)
+
 // City represents a city.
 // It is a value object that encapsulates the business rules for cities.
 type City struct {
@@ -18,7 +26,8 @@ type City struct {
 }
 
 // NewCity creates a new City from code and name.
-// It validates that both code and name are non-empty.
+// It validates that both code and name are non-empty and at most
+// MaxCityCodeLength and MaxCityNameLength characters long.
 // Whitespace is automatically trimmed before validation.
 // Returns an error if validation fails.
 func NewCity(code, name string) (City, error) {
@@ -36,6 +45,14 @@ func NewCity(code, name string) (City, error) {
 		return City{}, fmt.Errorf("city name cannot be empty")
 	}
 
+	// Validate length
+	if len([]rune(trimmedCode)) > MaxCityCodeLength {
+		return City{}, fmt.Errorf("city code must be at most %d characters", MaxCityCodeLength)
+	}
+	if len([]rune(trimmedName)) > MaxCityNameLength {
+		return City{}, fmt.Errorf("city name must be at most %d characters", MaxCityNameLength)
+	}
+
 	return City{
 		code: trimmedCode,
 		name: trimmedName,
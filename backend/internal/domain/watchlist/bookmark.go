@@ -0,0 +1,52 @@
+package watchlist
+
+import (
+	"time"
+
+	"fuck_boss/backend/internal/domain/content"
+)
+
+// Bookmark is a post saved by an owner.
+type Bookmark struct {
+	// owner is who saved the post.
+	owner Owner
+
+	// postID is the saved post.
+	postID content.PostID
+
+	// createdAt is when the post was saved.
+	createdAt time.Time
+}
+
+// NewBookmark creates a new Bookmark with createdAt set to the current time.
+func NewBookmark(owner Owner, postID content.PostID) *Bookmark {
+	return &Bookmark{
+		owner:     owner,
+		postID:    postID,
+		createdAt: time.Now(),
+	}
+}
+
+// NewBookmarkFromDB reconstructs a Bookmark from database data.
+func NewBookmarkFromDB(owner Owner, postID content.PostID, createdAt time.Time) *Bookmark {
+	return &Bookmark{
+		owner:     owner,
+		postID:    postID,
+		createdAt: createdAt,
+	}
+}
+
+// Owner returns who saved the post.
+func (b *Bookmark) Owner() Owner {
+	return b.owner
+}
+
+// PostID returns the saved post ID.
+func (b *Bookmark) PostID() content.PostID {
+	return b.postID
+}
+
+// CreatedAt returns when the post was saved.
+func (b *Bookmark) CreatedAt() time.Time {
+	return b.createdAt
+}
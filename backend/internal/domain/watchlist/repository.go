@@ -0,0 +1,62 @@
+package watchlist
+
+import (
+	"context"
+	"time"
+
+	"fuck_boss/backend/internal/domain/content"
+)
+
+// DeviceRepository defines the interface for anonymous device persistence.
+// Only device token hashes are stored.
+type DeviceRepository interface {
+	// Register stores a newly issued device token hash.
+	// Returns an error if the operation fails.
+	Register(ctx context.Context, tokenHash string, issuedAt time.Time) error
+
+	// Exists reports whether the device token hash was issued by the server.
+	Exists(ctx context.Context, tokenHash string) (bool, error)
+}
+
+// BookmarkRepository defines the interface for Bookmark persistence.
+type BookmarkRepository interface {
+	// Save saves a Bookmark. Saving an existing bookmark is a no-op.
+	// Returns an error if the operation fails.
+	Save(ctx context.Context, bookmark *Bookmark) error
+
+	// Delete removes the owner's bookmark of a post. Deleting a missing bookmark is a no-op.
+	// Returns an error if the operation fails.
+	Delete(ctx context.Context, owner Owner, postID content.PostID) error
+
+	// FindByOwner finds the owner's bookmarks, newest first, with pagination.
+	// Returns a slice of Bookmarks, total count, and an error.
+	// The page parameter is 1-based (page 1 is the first page).
+	FindByOwner(ctx context.Context, owner Owner, page, pageSize int) ([]*Bookmark, int, error)
+}
+
+// WatchRepository defines the interface for CompanyWatch persistence and visit tracking.
+type WatchRepository interface {
+	// Save saves a CompanyWatch. Saving an existing watch is a no-op.
+	// Returns an error if the operation fails.
+	Save(ctx context.Context, watch *CompanyWatch) error
+
+	// Delete removes the owner's watch of a company. Deleting a missing watch is a no-op.
+	// Returns an error if the operation fails.
+	Delete(ctx context.Context, owner Owner, company content.CompanyName) error
+
+	// FindByOwner finds all companies watched by the owner, oldest first
+	// (at most MaxWatchedCompanies).
+	FindByOwner(ctx context.Context, owner Owner) ([]*CompanyWatch, error)
+
+	// FindUserWatchers finds the IDs of logged-in users watching the company.
+	// Anonymous devices are not included.
+	FindUserWatchers(ctx context.Context, company content.CompanyName) ([]string, error)
+
+	// LastVisit returns when the owner last marked the watched-company feed as seen.
+	// Returns the zero time if the owner has never done so.
+	LastVisit(ctx context.Context, owner Owner) (time.Time, error)
+
+	// MarkVisited records that the owner has seen the watched-company feed up to at.
+	// Returns an error if the operation fails.
+	MarkVisited(ctx context.Context, owner Owner, at time.Time) error
+}
@@ -0,0 +1,142 @@
+// Package watchlist provides domain models for bookmarks and company watchlists.
+// Both belong to an owner: a logged-in user or an anonymous device.
+package watchlist
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxBookmarks is the maximum number of bookmarks per owner.
+	MaxBookmarks = 500
+
+	// MaxWatchedCompanies is the maximum number of watched companies per owner.
+	MaxWatchedCompanies = 50
+)
+
+// ownerKind distinguishes user owners from device owners.
+type ownerKind string
+
+const (
+	ownerKindUser   ownerKind = "user"
+	ownerKindDevice ownerKind = "device"
+)
+
+// Owner identifies who bookmarks and watches belong to.
+// It is either a logged-in user or an anonymous device holding a server-issued token.
+type Owner struct {
+	// kind is user or device.
+	kind ownerKind
+
+	// id is the user ID or the SHA-256 hash of the device token.
+	id string
+}
+
+// NewUserOwner creates an Owner for a logged-in user.
+// Returns an error if the user ID is not a UUID.
+func NewUserOwner(userID string) (Owner, error) {
+	userID = strings.TrimSpace(userID)
+	if _, err := uuid.Parse(userID); err != nil {
+		return Owner{}, fmt.Errorf("invalid user ID format: %w", err)
+	}
+	return Owner{kind: ownerKindUser, id: userID}, nil
+}
+
+// NewDeviceOwner creates an Owner for an anonymous device from its token hash.
+// Returns an error if the hash is not a hex-encoded SHA-256 hash.
+func NewDeviceOwner(tokenHash string) (Owner, error) {
+	if len(tokenHash) != sha256.Size*2 {
+		return Owner{}, fmt.Errorf("invalid device token hash")
+	}
+	if _, err := hex.DecodeString(tokenHash); err != nil {
+		return Owner{}, fmt.Errorf("invalid device token hash: %w", err)
+	}
+	return Owner{kind: ownerKindDevice, id: tokenHash}, nil
+}
+
+// NewOwnerFromKey restores an Owner from its storage key (see Key).
+// Returns an error if the key is malformed.
+func NewOwnerFromKey(key string) (Owner, error) {
+	kind, id, ok := strings.Cut(key, ":")
+	if !ok {
+		return Owner{}, fmt.Errorf("invalid owner key: %q", key)
+	}
+	switch ownerKind(kind) {
+	case ownerKindUser:
+		return NewUserOwner(id)
+	case ownerKindDevice:
+		return NewDeviceOwner(id)
+	default:
+		return Owner{}, fmt.Errorf("invalid owner key: %q", key)
+	}
+}
+
+// Key returns the storage key of the owner ("user:<id>" or "device:<token hash>").
+func (o Owner) Key() string {
+	return string(o.kind) + ":" + o.id
+}
+
+// IsUser returns true if the owner is a logged-in user.
+func (o Owner) IsUser() bool {
+	return o.kind == ownerKindUser
+}
+
+// UserID returns the user ID of a user owner (empty for device owners).
+func (o Owner) UserID() string {
+	if o.kind != ownerKindUser {
+		return ""
+	}
+	return o.id
+}
+
+// IsZero returns true if the Owner is the zero value.
+func (o Owner) IsZero() bool {
+	return o.id == ""
+}
+
+// Equals returns true if this Owner equals the other Owner.
+func (o Owner) Equals(other Owner) bool {
+	return o.kind == other.kind && o.id == other.id
+}
+
+// deviceTokenBytes is the number of random bytes in a device token.
+const deviceTokenBytes = 32
+
+// DeviceToken is an opaque token issued to an anonymous visitor.
+// Only its hash is stored; the raw token is returned to the client once.
+type DeviceToken struct {
+	// value is the raw token string.
+	value string
+}
+
+// GenerateDeviceToken generates a new random DeviceToken.
+func GenerateDeviceToken() (DeviceToken, error) {
+	buf := make([]byte, deviceTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return DeviceToken{}, fmt.Errorf("failed to generate device token: %w", err)
+	}
+	return DeviceToken{value: base64.RawURLEncoding.EncodeToString(buf)}, nil
+}
+
+// String returns the raw token value.
+func (t DeviceToken) String() string {
+	return t.value
+}
+
+// Hash returns the hex-encoded SHA-256 hash of the token.
+func (t DeviceToken) Hash() string {
+	return HashDeviceToken(t.value)
+}
+
+// HashDeviceToken returns the hex-encoded SHA-256 hash of a raw device token.
+func HashDeviceToken(raw string) string {
+	sum := sha256.Sum256([]byte(strings.TrimSpace(raw)))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,52 @@
+package watchlist
+
+import (
+	"time"
+
+	"fuck_boss/backend/internal/domain/content"
+)
+
+// CompanyWatch is a company followed by an owner.
+type CompanyWatch struct {
+	// owner is who follows the company.
+	owner Owner
+
+	// company is the followed company name, exactly as it appears in posts.
+	company content.CompanyName
+
+	// createdAt is when the owner started following the company.
+	createdAt time.Time
+}
+
+// NewCompanyWatch creates a new CompanyWatch with createdAt set to the current time.
+func NewCompanyWatch(owner Owner, company content.CompanyName) *CompanyWatch {
+	return &CompanyWatch{
+		owner:     owner,
+		company:   company,
+		createdAt: time.Now(),
+	}
+}
+
+// NewCompanyWatchFromDB reconstructs a CompanyWatch from database data.
+func NewCompanyWatchFromDB(owner Owner, company content.CompanyName, createdAt time.Time) *CompanyWatch {
+	return &CompanyWatch{
+		owner:     owner,
+		company:   company,
+		createdAt: createdAt,
+	}
+}
+
+// Owner returns who follows the company.
+func (w *CompanyWatch) Owner() Owner {
+	return w.owner
+}
+
+// Company returns the followed company name.
+func (w *CompanyWatch) Company() content.CompanyName {
+	return w.company
+}
+
+// CreatedAt returns when the owner started following the company.
+func (w *CompanyWatch) CreatedAt() time.Time {
+	return w.createdAt
+}
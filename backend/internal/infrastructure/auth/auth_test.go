@@ -0,0 +1,124 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// testArgon2Params are cheap parameters so that tests run fast.
+var testArgon2Params = Argon2Params{Memory: 1024, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32}
+
+func TestArgon2Hasher_HashAndVerify(t *testing.T) {
+	hasher := NewArgon2Hasher(testArgon2Params)
+
+	hash, err := hasher.Hash("hunter2hunter2")
+	if err != nil {
+		t.Fatalf("Hash() error = %v, want nil", err)
+	}
+	if !strings.HasPrefix(hash, "$argon2id$v=19$m=1024,t=1,p=1$") {
+		t.Errorf("Hash() = %q, want PHC argon2id format", hash)
+	}
+
+	// Salted: the same password hashes differently
+	other, _ := hasher.Hash("hunter2hunter2")
+	if other == hash {
+		t.Error("Hash() returned identical hashes for the same password")
+	}
+
+	ok, err := hasher.Verify("hunter2hunter2", hash)
+	if err != nil || !ok {
+		t.Errorf("Verify() with correct password = %v, %v, want true, nil", ok, err)
+	}
+	ok, err = hasher.Verify("wrong-password1", hash)
+	if err != nil || ok {
+		t.Errorf("Verify() with wrong password = %v, %v, want false, nil", ok, err)
+	}
+
+	// Hashes created with other parameters still verify
+	ok, err = NewArgon2Hasher(DefaultArgon2Params()).Verify("hunter2hunter2", hash)
+	if err != nil || !ok {
+		t.Errorf("Verify() with different hasher params = %v, %v, want true, nil", ok, err)
+	}
+
+	if _, err := hasher.Verify("hunter2hunter2", "$2a$10$notargon"); err == nil {
+		t.Error("Verify() with malformed hash error = nil, want error")
+	}
+}
+
+func TestJWTIssuer_RoundTrip(t *testing.T) {
+	issuer, err := NewJWTIssuer([]byte(strings.Repeat("s", 32)), "fuck_boss", time.Minute, time.Hour)
+	if err != nil {
+		t.Fatalf("NewJWTIssuer() error = %v, want nil", err)
+	}
+
+	token, expiresAt, err := issuer.IssueAccessToken("user-1")
+	if err != nil {
+		t.Fatalf("IssueAccessToken() error = %v, want nil", err)
+	}
+	if time.Until(expiresAt) > time.Minute {
+		t.Errorf("IssueAccessToken() expiresAt = %v, want within 1 minute", expiresAt)
+	}
+
+	userID, err := issuer.ParseAccessToken(token)
+	if err != nil || userID != "user-1" {
+		t.Errorf("ParseAccessToken() = %q, %v, want user-1, nil", userID, err)
+	}
+}
+
+func TestJWTIssuer_ParseAccessToken_Rejects(t *testing.T) {
+	secret := []byte(strings.Repeat("s", 32))
+	issuer, _ := NewJWTIssuer(secret, "fuck_boss", time.Minute, time.Hour)
+	otherSecret, _ := NewJWTIssuer([]byte(strings.Repeat("x", 32)), "fuck_boss", time.Minute, time.Hour)
+	otherIssuer, _ := NewJWTIssuer(secret, "someone-else", time.Minute, time.Hour)
+
+	signed := func(method jwt.SigningMethod, key interface{}, claims jwt.RegisteredClaims) string {
+		token, err := jwt.NewWithClaims(method, claims).SignedString(key)
+		if err != nil {
+			t.Fatalf("SignedString() error = %v", err)
+		}
+		return token
+	}
+
+	wrongSecret, _, _ := otherSecret.IssueAccessToken("user-1")
+	wrongIssuer, _, _ := otherIssuer.IssueAccessToken("user-1")
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "garbage", token: "not-a-jwt"},
+		{name: "wrong secret", token: wrongSecret},
+		{name: "wrong issuer", token: wrongIssuer},
+		{name: "expired", token: signed(jwt.SigningMethodHS256, secret, jwt.RegisteredClaims{
+			Subject:   "user-1",
+			Issuer:    "fuck_boss",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Hour)),
+		})},
+		{name: "missing expiry", token: signed(jwt.SigningMethodHS256, secret, jwt.RegisteredClaims{
+			Subject: "user-1",
+			Issuer:  "fuck_boss",
+		})},
+		{name: "alg none", token: signed(jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, jwt.RegisteredClaims{
+			Subject:   "user-1",
+			Issuer:    "fuck_boss",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if userID, err := issuer.ParseAccessToken(tt.token); err == nil {
+				t.Errorf("ParseAccessToken() = %q, nil, want error", userID)
+			}
+		})
+	}
+}
+
+func TestNewJWTIssuer_ShortSecret(t *testing.T) {
+	if _, err := NewJWTIssuer([]byte("short"), "fuck_boss", time.Minute, time.Hour); err == nil {
+		t.Error("NewJWTIssuer() with short secret error = nil, want error")
+	}
+}
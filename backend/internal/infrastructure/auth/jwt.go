@@ -0,0 +1,102 @@
+// Package auth provides password hashing and access token implementations.
+// It implements identity.PasswordHasher (argon2id) and identity.TokenIssuer (JWT).
+package auth
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// MinJWTSecretLength is the minimum length of the HMAC signing secret in bytes.
+const MinJWTSecretLength = 32
+
+// clockSkew is the leeway allowed when validating token times.
+const clockSkew = 30 * time.Second
+
+// JWTIssuer issues HS256-signed JWT access tokens.
+// Access tokens are stateless and short-lived; revocation is handled by
+// refusing to rotate the refresh token they were issued with.
+type JWTIssuer struct {
+	// secret is the HMAC signing key.
+	secret []byte
+
+	// issuer is the "iss" claim.
+	issuer string
+
+	// accessTTL is the lifetime of access tokens.
+	accessTTL time.Duration
+
+	// refreshTTL is the lifetime of refresh tokens.
+	refreshTTL time.Duration
+}
+
+// NewJWTIssuer creates a new JWTIssuer.
+// Returns an error if the secret is shorter than MinJWTSecretLength bytes or a TTL is not positive.
+func NewJWTIssuer(secret []byte, issuer string, accessTTL, refreshTTL time.Duration) (*JWTIssuer, error) {
+	if len(secret) < MinJWTSecretLength {
+		return nil, fmt.Errorf("jwt secret must be at least %d bytes", MinJWTSecretLength)
+	}
+	if accessTTL <= 0 || refreshTTL <= 0 {
+		return nil, fmt.Errorf("token TTLs must be positive")
+	}
+
+	return &JWTIssuer{
+		secret:     secret,
+		issuer:     issuer,
+		accessTTL:  accessTTL,
+		refreshTTL: refreshTTL,
+	}, nil
+}
+
+// IssueAccessToken issues an access token whose subject is the user ID.
+func (i *JWTIssuer) IssueAccessToken(userID string) (string, time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(i.accessTTL)
+
+	claims := jwt.RegisteredClaims{
+		ID:        uuid.New().String(),
+		Subject:   userID,
+		Issuer:    i.issuer,
+		IssuedAt:  jwt.NewNumericDate(now),
+		NotBefore: jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(i.secret)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to sign access token: %w", err)
+	}
+
+	return token, expiresAt, nil
+}
+
+// ParseAccessToken verifies the signature, issuer and expiry of an access token
+// and returns its subject (the user ID). Only HS256 is accepted.
+func (i *JWTIssuer) ParseAccessToken(token string) (string, error) {
+	claims := &jwt.RegisteredClaims{}
+	_, err := jwt.ParseWithClaims(token, claims,
+		func(*jwt.Token) (interface{}, error) {
+			return i.secret, nil
+		},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(i.issuer),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(clockSkew),
+	)
+	if err != nil {
+		return "", fmt.Errorf("invalid access token: %w", err)
+	}
+	if claims.Subject == "" {
+		return "", fmt.Errorf("invalid access token: missing subject")
+	}
+
+	return claims.Subject, nil
+}
+
+// RefreshTokenTTL returns how long refresh tokens stay valid.
+func (i *JWTIssuer) RefreshTokenTTL() time.Duration {
+	return i.refreshTTL
+}
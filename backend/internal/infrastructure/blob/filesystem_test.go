@@ -0,0 +1,68 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"fuck_boss/backend/internal/application/blob"
+)
+
+func TestFileBlobStore_PutGetDelete(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewFileBlobStore(filepath.Join(t.TempDir(), "blobs"))
+	if err != nil {
+		t.Fatalf("NewFileBlobStore() error = %v", err)
+	}
+
+	if err := store.Put(ctx, "posts/p1/a.png", strings.NewReader("first")); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	// Put replaces an existing blob
+	if err := store.Put(ctx, "posts/p1/a.png", strings.NewReader("second")); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+
+	r, err := store.Get(ctx, "posts/p1/a.png")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	data, _ := io.ReadAll(r)
+	r.Close()
+	if string(data) != "second" {
+		t.Errorf("Get() = %q, want %q", data, "second")
+	}
+
+	// No temporary files are left behind
+	entries, _ := os.ReadDir(filepath.Join(store.root, "posts", "p1"))
+	if len(entries) != 1 {
+		t.Errorf("directory has %d entries, want 1", len(entries))
+	}
+
+	if err := store.Delete(ctx, "posts/p1/a.png"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if err := store.Delete(ctx, "posts/p1/a.png"); err != nil {
+		t.Errorf("Delete() of missing blob error = %v, want nil", err)
+	}
+	if _, err := store.Get(ctx, "posts/p1/a.png"); !errors.Is(err, blob.ErrNotFound) {
+		t.Errorf("Get() after Delete error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestFileBlobStore_InvalidKey(t *testing.T) {
+	store, err := NewFileBlobStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFileBlobStore() error = %v", err)
+	}
+
+	for _, key := range []string{"", ".", "..", "../escape", "posts/../../escape", "/etc/passwd", "posts//a", "posts\\a"} {
+		if err := store.Put(context.Background(), key, strings.NewReader("x")); err == nil {
+			t.Errorf("Put(%q) error = nil, want error", key)
+		}
+	}
+}
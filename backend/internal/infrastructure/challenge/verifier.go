@@ -0,0 +1,155 @@
+// Package challenge provides DNS and HTTPS implementations of the domain ownership verifier.
+// It implements the challenge.Verifier interface defined in Application Layer.
+package challenge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+	"time"
+
+	"fuck_boss/backend/internal/domain/company"
+)
+
+// maxChallengeFileSize is the maximum number of bytes read from a challenge file.
+const maxChallengeFileSize = 1024
+
+// maxRedirects is the maximum number of redirects followed when fetching a challenge file.
+const maxRedirects = 3
+
+// TXTResolver looks up DNS TXT records.
+// *net.Resolver satisfies this interface; tests can provide a fake.
+type TXTResolver interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+}
+
+// Verifier is the DNS/HTTPS implementation of challenge.Verifier.
+// DNS_TXT challenges are checked with a TXT lookup, FILE challenges with an HTTPS GET.
+// It proves control of the domain only; whether the domain is the company's is
+// an operator approval checked by the use cases.
+type Verifier struct {
+	// resolver looks up TXT records.
+	resolver TXTResolver
+
+	// client fetches challenge files.
+	client *http.Client
+}
+
+// NewVerifier creates a new Verifier with the given resolver and HTTP client.
+func NewVerifier(resolver TXTResolver, client *http.Client) *Verifier {
+	return &Verifier{
+		resolver: resolver,
+		client:   client,
+	}
+}
+
+// NewDefaultVerifier creates a Verifier using the system resolver and an HTTP client
+// that only connects to public addresses and follows at most 3 HTTPS redirects.
+func NewDefaultVerifier(timeout time.Duration) *Verifier {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: rejectPrivateAddress,
+	}
+
+	client := &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			Proxy:               nil,
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxRedirects {
+				return fmt.Errorf("stopped after %d redirects", maxRedirects)
+			}
+			if req.URL.Scheme != "https" {
+				return fmt.Errorf("refusing non-HTTPS redirect to %s", req.URL.Redacted())
+			}
+			return nil
+		},
+	}
+
+	return NewVerifier(net.DefaultResolver, client)
+}
+
+// Verify reports whether the challenge is published on the company domain.
+// Missing records, unreachable hosts and mismatching content all return false without an error;
+// an error is only returned for an unknown method or a failing DNS server.
+func (v *Verifier) Verify(ctx context.Context, challenge company.Challenge) (bool, error) {
+	switch challenge.Method() {
+	case company.ChallengeMethodDNSTXT:
+		return v.verifyTXT(ctx, challenge)
+	case company.ChallengeMethodFile:
+		return v.verifyFile(ctx, challenge), nil
+	default:
+		return false, fmt.Errorf("unsupported challenge method: %q", challenge.Method())
+	}
+}
+
+// verifyTXT checks that one of the TXT records equals the expected value.
+func (v *Verifier) verifyTXT(ctx context.Context, challenge company.Challenge) (bool, error) {
+	records, err := v.resolver.LookupTXT(ctx, challenge.RecordName())
+	if err != nil {
+		var dnsErr *net.DNSError
+		if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to look up TXT records for %s: %w", challenge.RecordName(), err)
+	}
+
+	expected := challenge.RecordValue()
+	for _, record := range records {
+		if strings.TrimSpace(record) == expected {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// verifyFile checks that the well-known file contains exactly the challenge code.
+func (v *Verifier) verifyFile(ctx context.Context, challenge company.Challenge) bool {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, challenge.FileURL(), nil)
+	if err != nil {
+		return false
+	}
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return false
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return false
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxChallengeFileSize))
+	if err != nil {
+		return false
+	}
+
+	return strings.TrimSpace(string(body)) == challenge.Code()
+}
+
+// rejectPrivateAddress refuses connections to loopback, private, link-local and
+// unspecified addresses so that a registered domain cannot point the verifier
+// at internal services.
+func rejectPrivateAddress(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return fmt.Errorf("invalid address %q", address)
+	}
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsUnspecified() {
+		return fmt.Errorf("refusing to connect to non-public address %s", ip)
+	}
+	return nil
+}
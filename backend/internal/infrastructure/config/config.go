@@ -4,6 +4,8 @@ package config
 
 import (
 	"fmt"
+	"net"
+	"net/url"
 	"strings"
 
 	"github.com/spf13/viper"
@@ -12,98 +14,264 @@ import (
 // Config represents the application configuration.
 type Config struct {
 	// Database contains PostgreSQL database configuration.
-	Database DatabaseConfig
+	Database DatabaseConfig `mapstructure:"database"`
 
 	// Redis contains Redis cache configuration.
-	Redis RedisConfig
+	Redis RedisConfig `mapstructure:"redis"`
 
 	// GRPC contains gRPC server configuration.
-	GRPC GRPCConfig
+	GRPC GRPCConfig `mapstructure:"grpc"`
 
 	// Log contains logging configuration.
-	Log LogConfig
+	Log LogConfig `mapstructure:"log"`
+
+	// Auth contains user authentication configuration.
+	Auth AuthConfig `mapstructure:"auth"`
+
+	// Verification contains verification code (验证码) configuration.
+	Verification VerificationConfig `mapstructure:"verification"`
+
+	// Attachment contains evidence attachment storage configuration.
+	Attachment AttachmentConfig `mapstructure:"attachment"`
+
+	// Trending contains view counting and trending ranking configuration.
+	Trending TrendingConfig `mapstructure:"trending"`
+
+	// Related contains related posts configuration.
+	Related RelatedConfig `mapstructure:"related"`
+
+	// LiveFeed contains live feed (WatchPosts and Server-Sent Events) configuration.
+	LiveFeed LiveFeedConfig `mapstructure:"live_feed"`
+
+	// Site contains the public site settings used in feeds and pages.
+	Site SiteConfig `mapstructure:"site"`
+
+	// Sitemap contains sitemap generation configuration.
+	Sitemap SitemapConfig `mapstructure:"sitemap"`
 }
 
 // DatabaseConfig contains PostgreSQL database connection settings.
 type DatabaseConfig struct {
 	// Host is the database host address.
-	Host string
+	Host string `mapstructure:"host"`
 
 	// Port is the database port number.
-	Port int
+	Port int `mapstructure:"port"`
 
 	// User is the database username.
-	User string
+	User string `mapstructure:"user"`
 
 	// Password is the database password.
-	Password string
+	Password string `mapstructure:"password"`
 
 	// DBName is the database name.
-	DBName string
+	DBName string `mapstructure:"dbname"`
 
 	// SSLMode is the SSL mode for database connection (disable, require, verify-ca, verify-full).
-	SSLMode string
+	SSLMode string `mapstructure:"sslmode"`
 
 	// MaxOpenConns is the maximum number of open connections to the database.
-	MaxOpenConns int
+	MaxOpenConns int `mapstructure:"max_open_conns"`
 
 	// MaxIdleConns is the maximum number of idle connections in the pool.
-	MaxIdleConns int
+	MaxIdleConns int `mapstructure:"max_idle_conns"`
 
 	// ConnMaxLifetime is the maximum amount of time a connection may be reused (in seconds).
-	ConnMaxLifetime int
+	ConnMaxLifetime int `mapstructure:"conn_max_lifetime"`
 }
 
 // RedisConfig contains Redis cache connection settings.
 type RedisConfig struct {
 	// Host is the Redis host address.
-	Host string
+	Host string `mapstructure:"host"`
 
 	// Port is the Redis port number.
-	Port int
+	Port int `mapstructure:"port"`
 
 	// Password is the Redis password (empty if no password).
-	Password string
+	Password string `mapstructure:"password"`
 
 	// DB is the Redis database number (0-15).
-	DB int
+	DB int `mapstructure:"db"`
 
 	// MaxRetries is the maximum number of retries before giving up.
-	MaxRetries int
+	MaxRetries int `mapstructure:"max_retries"`
 
 	// PoolSize is the maximum number of socket connections.
-	PoolSize int
+	PoolSize int `mapstructure:"pool_size"`
 
 	// MinIdleConns is the minimum number of idle connections.
-	MinIdleConns int
+	MinIdleConns int `mapstructure:"min_idle_conns"`
 }
 
 // GRPCConfig contains gRPC server configuration.
 type GRPCConfig struct {
 	// Port is the gRPC server port number.
-	Port int
+	Port int `mapstructure:"port"`
 
 	// MaxRecvMsgSize is the maximum message size the server can receive (in bytes).
-	MaxRecvMsgSize int
+	MaxRecvMsgSize int `mapstructure:"max_recv_msg_size"`
 
 	// MaxSendMsgSize is the maximum message size the server can send (in bytes).
-	MaxSendMsgSize int
+	MaxSendMsgSize int `mapstructure:"max_send_msg_size"`
+
+	// TrustedProxies are the reverse proxies (CIDRs or single IPs) whose
+	// X-Forwarded-For and X-Real-IP headers are believed when resolving the
+	// client address. Empty trusts no proxy and always uses the peer address.
+	TrustedProxies []string `mapstructure:"trusted_proxies"`
 }
 
 // LogConfig contains logging configuration.
 type LogConfig struct {
 	// Level is the log level (debug, info, warn, error).
-	Level string
+	Level string `mapstructure:"level"`
 
 	// Format is the log format (json, text, console).
 	// console is an alias for text (human-readable format).
-	Format string
+	Format string `mapstructure:"format"`
 
 	// OutputPaths is a list of paths to write logging output to.
-	OutputPaths []string
+	OutputPaths []string `mapstructure:"output_paths"`
 
 	// ErrorOutputPaths is a list of paths to write error level logs to.
-	ErrorOutputPaths []string
+	ErrorOutputPaths []string `mapstructure:"error_output_paths"`
+}
+
+// AuthConfig contains user authentication settings.
+type AuthConfig struct {
+	// JWTSecret is the HMAC secret used to sign access tokens (at least 32 bytes).
+	// If empty, the server generates a random secret at startup, which invalidates
+	// all access tokens on restart and must not be used with multiple instances.
+	JWTSecret string `mapstructure:"jwt_secret"`
+
+	// Issuer is the "iss" claim of issued access tokens.
+	Issuer string `mapstructure:"issuer"`
+
+	// AccessTokenTTL is the lifetime of access tokens (in seconds).
+	AccessTokenTTL int `mapstructure:"access_token_ttl"`
+
+	// RefreshTokenTTL is the lifetime of refresh tokens (in seconds).
+	RefreshTokenTTL int `mapstructure:"refresh_token_ttl"`
+}
+
+// VerificationConfig contains verification code settings.
+type VerificationConfig struct {
+	// Sender is how codes are delivered: "file" writes them to FileDir (development only),
+	// "smtp" emails them through the SMTP server.
+	Sender string `mapstructure:"sender"`
+
+	// FileDir is the directory the file sender writes codes to.
+	FileDir string `mapstructure:"file_dir"`
+
+	// CodeTTL is how long a code is accepted (in seconds).
+	CodeTTL int `mapstructure:"code_ttl"`
+
+	// MaxAttempts is the number of wrong codes accepted before a code is discarded.
+	MaxAttempts int `mapstructure:"max_attempts"`
+
+	// RequireOnRegister requires an email verification code to register.
+	RequireOnRegister bool `mapstructure:"require_on_register"`
+
+	// SMTP contains the SMTP server settings used by the smtp sender.
+	SMTP SMTPConfig `mapstructure:"smtp"`
+}
+
+// SMTPConfig contains SMTP server settings.
+type SMTPConfig struct {
+	// Host is the SMTP server host.
+	Host string `mapstructure:"host"`
+
+	// Port is the SMTP server port (587 for STARTTLS submission).
+	Port int `mapstructure:"port"`
+
+	// Username is the SMTP username (empty disables authentication).
+	Username string `mapstructure:"username"`
+
+	// Password is the SMTP password.
+	Password string `mapstructure:"password"`
+
+	// From is the sender address (e.g., "Fuck Boss <noreply@example.com>").
+	From string `mapstructure:"from"`
+}
+
+// AttachmentConfig contains evidence attachment storage settings.
+type AttachmentConfig struct {
+	// Store is where attachment files are kept: "filesystem" stores them below Dir.
+	Store string `mapstructure:"store"`
+
+	// Dir is the directory the filesystem store writes to.
+	// It must be shared by all server instances.
+	Dir string `mapstructure:"dir"`
+}
+
+// TrendingConfig contains view counting and trending ranking settings.
+type TrendingConfig struct {
+	// RefreshInterval is how often view counts are flushed and rankings recomputed (in seconds).
+	RefreshInterval int `mapstructure:"refresh_interval"`
+
+	// Window is how old posts may be to be ranked (in hours).
+	Window int `mapstructure:"window"`
+
+	// Size is the maximum number of posts per ranking.
+	Size int `mapstructure:"size"`
+}
+
+// RelatedConfig contains related posts settings.
+type RelatedConfig struct {
+	// RefreshInterval is how often related posts are recomputed (in seconds).
+	RefreshInterval int `mapstructure:"refresh_interval"`
+
+	// Window is how old posts may be to be compared (in hours).
+	Window int `mapstructure:"window"`
+
+	// MaxPosts is the maximum number of posts compared per run (the most recent ones).
+	MaxPosts int `mapstructure:"max_posts"`
+
+	// Limit is the number of related posts kept per post.
+	Limit int `mapstructure:"limit"`
+}
+
+// LiveFeedConfig contains live feed settings.
+type LiveFeedConfig struct {
+	// HistorySize is the approximate number of recent events kept for Last-Event-ID resume.
+	HistorySize int `mapstructure:"history_size"`
+
+	// HeartbeatInterval is how often an idle Server-Sent Events connection gets a comment (in seconds).
+	HeartbeatInterval int `mapstructure:"heartbeat_interval"`
+
+	// MaxConnections is the maximum number of Server-Sent Events connections per server instance.
+	MaxConnections int `mapstructure:"max_connections"`
+
+	// MaxConnectionsPerIP is the maximum number of Server-Sent Events connections per client IP.
+	MaxConnectionsPerIP int `mapstructure:"max_connections_per_ip"`
+
+	// MaxConnectionDuration is how long a Server-Sent Events connection is kept open (in seconds).
+	// Clients reconnect with Last-Event-ID afterwards.
+	MaxConnectionDuration int `mapstructure:"max_connection_duration"`
+}
+
+// SiteConfig contains the public site settings.
+type SiteConfig struct {
+	// BaseURL is the absolute public URL of the site, without a trailing slash
+	// (e.g. "https://example.com"). Links in feeds and pages are built from it.
+	BaseURL string `mapstructure:"base_url"`
+
+	// Name is the site name shown in feed and page titles.
+	Name string `mapstructure:"name"`
+
+	// SPAIndex is the path of the built frontend index.html (optional).
+	// Its scripts and stylesheets are added to server-rendered pages, so the
+	// SPA takes over the page in the browser. Empty serves plain pages.
+	SPAIndex string `mapstructure:"spa_index"`
+}
+
+// SitemapConfig contains sitemap generation settings.
+type SitemapConfig struct {
+	// Dir is the directory the sitemap files are written to.
+	Dir string `mapstructure:"dir"`
+
+	// RefreshInterval is how often changed sitemap files are regenerated (in seconds).
+	RefreshInterval int `mapstructure:"refresh_interval"`
 }
 
 // LoadConfig loads configuration from file and environment variables.
@@ -228,6 +396,101 @@ func applyDefaults(cfg *Config) {
 	if len(cfg.Log.ErrorOutputPaths) == 0 {
 		cfg.Log.ErrorOutputPaths = []string{"stderr"}
 	}
+
+	// Auth defaults
+	if cfg.Auth.Issuer == "" {
+		cfg.Auth.Issuer = "fuck_boss"
+	}
+	if cfg.Auth.AccessTokenTTL == 0 {
+		cfg.Auth.AccessTokenTTL = 900 // 15 minutes
+	}
+	if cfg.Auth.RefreshTokenTTL == 0 {
+		cfg.Auth.RefreshTokenTTL = 2592000 // 30 days
+	}
+
+	// Verification defaults
+	if cfg.Verification.Sender == "" {
+		cfg.Verification.Sender = "file"
+	}
+	if cfg.Verification.FileDir == "" {
+		cfg.Verification.FileDir = "./data/verification_codes"
+	}
+	if cfg.Verification.CodeTTL == 0 {
+		cfg.Verification.CodeTTL = 600 // 10 minutes
+	}
+	if cfg.Verification.MaxAttempts == 0 {
+		cfg.Verification.MaxAttempts = 5
+	}
+	if cfg.Verification.SMTP.Port == 0 {
+		cfg.Verification.SMTP.Port = 587
+	}
+
+	// Attachment defaults
+	if cfg.Attachment.Store == "" {
+		cfg.Attachment.Store = "filesystem"
+	}
+	if cfg.Attachment.Dir == "" {
+		cfg.Attachment.Dir = "./data/attachments"
+	}
+
+	// Trending defaults
+	if cfg.Trending.RefreshInterval == 0 {
+		cfg.Trending.RefreshInterval = 300 // 5 minutes
+	}
+	if cfg.Trending.Window == 0 {
+		cfg.Trending.Window = 168 // 7 days
+	}
+	if cfg.Trending.Size == 0 {
+		cfg.Trending.Size = 200
+	}
+
+	// Related defaults
+	if cfg.Related.RefreshInterval == 0 {
+		cfg.Related.RefreshInterval = 3600 // 1 hour
+	}
+	if cfg.Related.Window == 0 {
+		cfg.Related.Window = 2160 // 90 days
+	}
+	if cfg.Related.MaxPosts == 0 {
+		cfg.Related.MaxPosts = 5000
+	}
+	if cfg.Related.Limit == 0 {
+		cfg.Related.Limit = 10
+	}
+
+	// Live feed defaults
+	if cfg.LiveFeed.HistorySize == 0 {
+		cfg.LiveFeed.HistorySize = 1000
+	}
+	if cfg.LiveFeed.HeartbeatInterval == 0 {
+		cfg.LiveFeed.HeartbeatInterval = 15
+	}
+	if cfg.LiveFeed.MaxConnections == 0 {
+		cfg.LiveFeed.MaxConnections = 1000
+	}
+	if cfg.LiveFeed.MaxConnectionsPerIP == 0 {
+		cfg.LiveFeed.MaxConnectionsPerIP = 5
+	}
+	if cfg.LiveFeed.MaxConnectionDuration == 0 {
+		cfg.LiveFeed.MaxConnectionDuration = 1800 // 30 minutes
+	}
+
+	// Site defaults
+	if cfg.Site.BaseURL == "" {
+		cfg.Site.BaseURL = "http://localhost:50051"
+	}
+	cfg.Site.BaseURL = strings.TrimRight(cfg.Site.BaseURL, "/")
+	if cfg.Site.Name == "" {
+		cfg.Site.Name = "全国公司曝光平台"
+	}
+
+	// Sitemap defaults
+	if cfg.Sitemap.Dir == "" {
+		cfg.Sitemap.Dir = "./data/sitemaps"
+	}
+	if cfg.Sitemap.RefreshInterval == 0 {
+		cfg.Sitemap.RefreshInterval = 3600 // 1 hour
+	}
 }
 
 // setDefaults sets default configuration values.
@@ -256,12 +519,62 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("grpc.port", 50051)
 	v.SetDefault("grpc.max_recv_msg_size", 4194304) // 4MB
 	v.SetDefault("grpc.max_send_msg_size", 4194304) // 4MB
+	v.SetDefault("grpc.trusted_proxies", []string{})
 
 	// Log defaults
 	v.SetDefault("log.level", "info")
 	v.SetDefault("log.format", "json")
 	v.SetDefault("log.output_paths", []string{"stdout"})
 	v.SetDefault("log.error_output_paths", []string{"stderr"})
+
+	// Auth defaults
+	v.SetDefault("auth.jwt_secret", "")
+	v.SetDefault("auth.issuer", "fuck_boss")
+	v.SetDefault("auth.access_token_ttl", 900)      // 15 minutes
+	v.SetDefault("auth.refresh_token_ttl", 2592000) // 30 days
+
+	// Verification defaults
+	v.SetDefault("verification.sender", "file")
+	v.SetDefault("verification.file_dir", "./data/verification_codes")
+	v.SetDefault("verification.code_ttl", 600) // 10 minutes
+	v.SetDefault("verification.max_attempts", 5)
+	v.SetDefault("verification.require_on_register", false)
+	v.SetDefault("verification.smtp.host", "")
+	v.SetDefault("verification.smtp.port", 587)
+	v.SetDefault("verification.smtp.username", "")
+	v.SetDefault("verification.smtp.password", "")
+	v.SetDefault("verification.smtp.from", "")
+
+	// Attachment defaults
+	v.SetDefault("attachment.store", "filesystem")
+	v.SetDefault("attachment.dir", "./data/attachments")
+
+	// Trending defaults
+	v.SetDefault("trending.refresh_interval", 300) // 5 minutes
+	v.SetDefault("trending.window", 168)           // 7 days
+	v.SetDefault("trending.size", 200)
+
+	// Related defaults
+	v.SetDefault("related.refresh_interval", 3600) // 1 hour
+	v.SetDefault("related.window", 2160)           // 90 days
+	v.SetDefault("related.max_posts", 5000)
+	v.SetDefault("related.limit", 10)
+
+	// Live feed defaults
+	v.SetDefault("live_feed.history_size", 1000)
+	v.SetDefault("live_feed.heartbeat_interval", 15)
+	v.SetDefault("live_feed.max_connections", 1000)
+	v.SetDefault("live_feed.max_connections_per_ip", 5)
+	v.SetDefault("live_feed.max_connection_duration", 1800) // 30 minutes
+
+	// Site defaults
+	v.SetDefault("site.base_url", "http://localhost:50051")
+	v.SetDefault("site.name", "全国公司曝光平台")
+	v.SetDefault("site.spa_index", "")
+
+	// Sitemap defaults
+	v.SetDefault("sitemap.dir", "./data/sitemaps")
+	v.SetDefault("sitemap.refresh_interval", 3600) // 1 hour
 }
 
 // validateConfig validates the configuration and returns an error if validation fails.
@@ -316,6 +629,11 @@ func validateConfig(cfg *Config) error {
 	if cfg.GRPC.MaxSendMsgSize <= 0 {
 		return fmt.Errorf("grpc.max_send_msg_size must be greater than 0")
 	}
+	for _, proxy := range cfg.GRPC.TrustedProxies {
+		if _, _, err := net.ParseCIDR(proxy); err != nil && net.ParseIP(proxy) == nil {
+			return fmt.Errorf("grpc.trusted_proxies entry %q must be a CIDR or an IP address", proxy)
+		}
+	}
 
 	// Validate log configuration
 	validLevels := map[string]bool{
@@ -337,6 +655,104 @@ func validateConfig(cfg *Config) error {
 		return fmt.Errorf("log.format must be one of: json, text, console")
 	}
 
+	// Validate auth configuration
+	if cfg.Auth.JWTSecret != "" && len(cfg.Auth.JWTSecret) < 32 {
+		return fmt.Errorf("auth.jwt_secret must be at least 32 bytes")
+	}
+	if cfg.Auth.AccessTokenTTL < 0 {
+		return fmt.Errorf("auth.access_token_ttl must be non-negative")
+	}
+	if cfg.Auth.RefreshTokenTTL < 0 {
+		return fmt.Errorf("auth.refresh_token_ttl must be non-negative")
+	}
+	if cfg.Auth.RefreshTokenTTL > 0 && cfg.Auth.RefreshTokenTTL <= cfg.Auth.AccessTokenTTL {
+		return fmt.Errorf("auth.refresh_token_ttl must be greater than auth.access_token_ttl")
+	}
+
+	// Validate verification configuration
+	switch strings.ToLower(cfg.Verification.Sender) {
+	case "", "file":
+	case "smtp":
+		if cfg.Verification.SMTP.Host == "" {
+			return fmt.Errorf("verification.smtp.host is required when verification.sender is smtp")
+		}
+		if cfg.Verification.SMTP.From == "" {
+			return fmt.Errorf("verification.smtp.from is required when verification.sender is smtp")
+		}
+		if cfg.Verification.SMTP.Port <= 0 || cfg.Verification.SMTP.Port > 65535 {
+			return fmt.Errorf("verification.smtp.port must be between 1 and 65535")
+		}
+	default:
+		return fmt.Errorf("verification.sender must be one of: file, smtp")
+	}
+	if cfg.Verification.CodeTTL < 0 {
+		return fmt.Errorf("verification.code_ttl must be non-negative")
+	}
+	if cfg.Verification.MaxAttempts < 0 {
+		return fmt.Errorf("verification.max_attempts must be non-negative")
+	}
+
+	// Validate attachment configuration
+	switch strings.ToLower(cfg.Attachment.Store) {
+	case "", "filesystem":
+	default:
+		return fmt.Errorf("attachment.store must be one of: filesystem")
+	}
+
+	// Validate trending configuration
+	if cfg.Trending.RefreshInterval < 0 {
+		return fmt.Errorf("trending.refresh_interval must be non-negative")
+	}
+	if cfg.Trending.Window < 0 {
+		return fmt.Errorf("trending.window must be non-negative")
+	}
+	if cfg.Trending.Size < 0 {
+		return fmt.Errorf("trending.size must be non-negative")
+	}
+
+	// Validate related configuration
+	if cfg.Related.RefreshInterval < 0 {
+		return fmt.Errorf("related.refresh_interval must be non-negative")
+	}
+	if cfg.Related.Window < 0 {
+		return fmt.Errorf("related.window must be non-negative")
+	}
+	if cfg.Related.MaxPosts < 0 {
+		return fmt.Errorf("related.max_posts must be non-negative")
+	}
+	if cfg.Related.Limit < 0 {
+		return fmt.Errorf("related.limit must be non-negative")
+	}
+
+	// Validate live feed configuration
+	if cfg.LiveFeed.HistorySize < 0 {
+		return fmt.Errorf("live_feed.history_size must be non-negative")
+	}
+	if cfg.LiveFeed.HeartbeatInterval < 0 {
+		return fmt.Errorf("live_feed.heartbeat_interval must be non-negative")
+	}
+	if cfg.LiveFeed.MaxConnections < 0 {
+		return fmt.Errorf("live_feed.max_connections must be non-negative")
+	}
+	if cfg.LiveFeed.MaxConnectionsPerIP < 0 {
+		return fmt.Errorf("live_feed.max_connections_per_ip must be non-negative")
+	}
+	if cfg.LiveFeed.MaxConnectionDuration < 0 {
+		return fmt.Errorf("live_feed.max_connection_duration must be non-negative")
+	}
+
+	// Validate site configuration
+	if cfg.Site.BaseURL != "" {
+		if u, err := url.Parse(cfg.Site.BaseURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("site.base_url must be an absolute http or https URL")
+		}
+	}
+
+	// Validate sitemap configuration
+	if cfg.Sitemap.RefreshInterval < 0 {
+		return fmt.Errorf("sitemap.refresh_interval must be non-negative")
+	}
+
 	return nil
 }
 
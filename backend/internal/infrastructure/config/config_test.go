@@ -95,6 +95,25 @@ func TestLoadConfig_WithDefaults(t *testing.T) {
 	if cfg.Log.Level != "info" {
 		t.Errorf("Log.Level = %v, want info", cfg.Log.Level)
 	}
+	if cfg.Attachment.Dir != "./data/attachments" {
+		t.Errorf("Attachment.Dir = %v, want ./data/attachments", cfg.Attachment.Dir)
+	}
+	if cfg.Trending.RefreshInterval != 300 || cfg.Trending.Window != 168 || cfg.Trending.Size != 200 {
+		t.Errorf("Trending = %+v, want {300 168 200}", cfg.Trending)
+	}
+	if cfg.Related.RefreshInterval != 3600 || cfg.Related.Window != 2160 || cfg.Related.MaxPosts != 5000 || cfg.Related.Limit != 10 {
+		t.Errorf("Related = %+v, want {3600 2160 5000 10}", cfg.Related)
+	}
+	if cfg.LiveFeed.HistorySize != 1000 || cfg.LiveFeed.HeartbeatInterval != 15 || cfg.LiveFeed.MaxConnections != 1000 ||
+		cfg.LiveFeed.MaxConnectionsPerIP != 5 || cfg.LiveFeed.MaxConnectionDuration != 1800 {
+		t.Errorf("LiveFeed = %+v, want {1000 15 1000 5 1800}", cfg.LiveFeed)
+	}
+	if cfg.Site.BaseURL != "http://localhost:50051" || cfg.Site.Name != "全国公司曝光平台" {
+		t.Errorf("Site = %+v, want {http://localhost:50051 全国公司曝光平台}", cfg.Site)
+	}
+	if cfg.Sitemap.Dir != "./data/sitemaps" || cfg.Sitemap.RefreshInterval != 3600 {
+		t.Errorf("Sitemap = %+v, want {./data/sitemaps 3600}", cfg.Sitemap)
+	}
 }
 
 func TestLoadConfig_WithEnvVars(t *testing.T) {
@@ -164,6 +183,36 @@ func TestValidateConfig(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "relative site base url",
+			cfg: &Config{
+				Database: DatabaseConfig{
+					Host:         "localhost",
+					Port:         5432,
+					User:         "postgres",
+					DBName:       "testdb",
+					MaxOpenConns: 100,
+				},
+				Redis: RedisConfig{
+					Host:     "localhost",
+					Port:     6379,
+					PoolSize: 50,
+				},
+				GRPC: GRPCConfig{
+					Port:           50051,
+					MaxRecvMsgSize: 4194304,
+					MaxSendMsgSize: 4194304,
+				},
+				Log: LogConfig{
+					Level:  "info",
+					Format: "json",
+				},
+				Site: SiteConfig{
+					BaseURL: "example.com",
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "missing database host",
 			cfg: &Config{
@@ -238,6 +287,100 @@ func TestValidateConfig(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "short jwt secret",
+			cfg: &Config{
+				Database: DatabaseConfig{
+					Host:         "localhost",
+					Port:         5432,
+					User:         "postgres",
+					DBName:       "testdb",
+					MaxOpenConns: 100,
+				},
+				Redis: RedisConfig{
+					Host:     "localhost",
+					Port:     6379,
+					PoolSize: 50,
+				},
+				GRPC: GRPCConfig{
+					Port:           50051,
+					MaxRecvMsgSize: 4194304,
+					MaxSendMsgSize: 4194304,
+				},
+				Log: LogConfig{
+					Level:  "info",
+					Format: "json",
+				},
+				Auth: AuthConfig{
+					JWTSecret: "too-short",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "smtp sender without host",
+			cfg: &Config{
+				Database: DatabaseConfig{
+					Host:         "localhost",
+					Port:         5432,
+					User:         "postgres",
+					DBName:       "testdb",
+					MaxOpenConns: 100,
+				},
+				Redis: RedisConfig{
+					Host:     "localhost",
+					Port:     6379,
+					PoolSize: 50,
+				},
+				GRPC: GRPCConfig{
+					Port:           50051,
+					MaxRecvMsgSize: 4194304,
+					MaxSendMsgSize: 4194304,
+				},
+				Log: LogConfig{
+					Level:  "info",
+					Format: "json",
+				},
+				Verification: VerificationConfig{
+					Sender: "smtp",
+					SMTP: SMTPConfig{
+						Port: 587,
+						From: "noreply@example.com",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown attachment store",
+			cfg: &Config{
+				Database: DatabaseConfig{
+					Host:         "localhost",
+					Port:         5432,
+					User:         "postgres",
+					DBName:       "testdb",
+					MaxOpenConns: 100,
+				},
+				Redis: RedisConfig{
+					Host:     "localhost",
+					Port:     6379,
+					PoolSize: 50,
+				},
+				GRPC: GRPCConfig{
+					Port:           50051,
+					MaxRecvMsgSize: 4194304,
+					MaxSendMsgSize: 4194304,
+				},
+				Log: LogConfig{
+					Level:  "info",
+					Format: "json",
+				},
+				Attachment: AttachmentConfig{
+					Store: "s3",
+				},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -281,3 +424,36 @@ func TestRedisConfig_GetAddr(t *testing.T) {
 		t.Errorf("GetAddr() = %v, want %v", addr, expected)
 	}
 }
+
+func TestConfig_Settings(t *testing.T) {
+	cfg := &Config{}
+	applyDefaults(cfg)
+	cfg.Database.Password = "dbpass"
+	cfg.Auth.JWTSecret = "a-secret-that-is-at-least-32-bytes-long"
+	cfg.LiveFeed.MaxConnections = 500
+
+	settings := cfg.Settings()
+
+	database := settings["database"].(map[string]interface{})
+	if database["password"] != redacted {
+		t.Errorf("database.password = %v, want %q", database["password"], redacted)
+	}
+	if database["max_open_conns"] != 100 {
+		t.Errorf("database.max_open_conns = %v, want 100", database["max_open_conns"])
+	}
+	if auth := settings["auth"].(map[string]interface{}); auth["jwt_secret"] != redacted {
+		t.Errorf("auth.jwt_secret = %v, want %q", auth["jwt_secret"], redacted)
+	}
+	if liveFeed := settings["live_feed"].(map[string]interface{}); liveFeed["max_connections"] != 500 {
+		t.Errorf("live_feed.max_connections = %v, want 500", liveFeed["max_connections"])
+	}
+
+	// Empty secrets show that they are not set
+	smtp := settings["verification"].(map[string]interface{})["smtp"].(map[string]interface{})
+	if smtp["password"] != "" {
+		t.Errorf("verification.smtp.password = %v, want empty", smtp["password"])
+	}
+	if redis := settings["redis"].(map[string]interface{}); redis["password"] != "" {
+		t.Errorf("redis.password = %v, want empty", redis["password"])
+	}
+}
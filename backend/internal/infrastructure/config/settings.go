@@ -0,0 +1,48 @@
+package config
+
+import (
+	"reflect"
+)
+
+// redacted replaces the values of secret settings in Settings.
+const redacted = "******"
+
+// secretSettings are the keys of the settings that Settings never shows.
+var secretSettings = map[string]bool{
+	"database.password":          true,
+	"redis.password":             true,
+	"auth.jwt_secret":            true,
+	"verification.smtp.password": true,
+}
+
+// Settings returns the configuration as nested maps keyed like the config
+// file (e.g. settings["database"]["max_open_conns"]), for printing the
+// effective configuration. Secrets that are set are replaced with "******",
+// so it is safe to log or paste the result.
+func (c *Config) Settings() map[string]interface{} {
+	return settingsOf(reflect.ValueOf(*c), "")
+}
+
+// settingsOf converts a config struct to a map, using the mapstructure tags as keys.
+func settingsOf(v reflect.Value, prefix string) map[string]interface{} {
+	settings := make(map[string]interface{}, v.NumField())
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		key := field.Tag.Get("mapstructure")
+		if key == "" {
+			key = field.Name
+		}
+		path := prefix + key
+
+		value := v.Field(i)
+		switch {
+		case value.Kind() == reflect.Struct:
+			settings[key] = settingsOf(value, path+".")
+		case secretSettings[path] && !value.IsZero():
+			settings[key] = redacted
+		default:
+			settings[key] = value.Interface()
+		}
+	}
+	return settings
+}
@@ -0,0 +1,102 @@
+// Package dataset writes post datasets for researchers and journalists:
+// CSV, JSON Lines and Parquet files with a stable schema, and a manifest with
+// the SHA-256 checksum of every file. It also reads the CSV and JSON Lines
+// files of partner datasets to import.
+package dataset
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"fuck_boss/backend/internal/application/dto"
+)
+
+// SchemaVersion is the version of the post schema. It only changes when
+// columns are renamed, removed or change type; new columns are appended.
+const SchemaVersion = 1
+
+// Format is a dataset file format.
+type Format string
+
+const (
+	// FormatCSV is RFC 4180 CSV with a header row.
+	FormatCSV Format = "csv"
+
+	// FormatJSONL is JSON Lines: one JSON object per line.
+	FormatJSONL Format = "jsonl"
+
+	// FormatParquet is Apache Parquet.
+	FormatParquet Format = "parquet"
+)
+
+// ParseFormat parses a format name (case-insensitive).
+func ParseFormat(value string) (Format, error) {
+	format := Format(strings.ToLower(strings.TrimSpace(value)))
+	switch format {
+	case FormatCSV, FormatJSONL, FormatParquet:
+		return format, nil
+	default:
+		return "", fmt.Errorf("unknown dataset format %q (want csv, jsonl or parquet)", value)
+	}
+}
+
+// Column describes a column of the post schema.
+type Column struct {
+	// Name is the column name (CSV header, JSON key, Parquet column).
+	Name string `json:"name"`
+
+	// Type is "string" or "timestamp" (RFC 3339 in UTC with millisecond
+	// precision in CSV and JSON Lines, TIMESTAMP(MILLIS) in Parquet).
+	Type string `json:"type"`
+
+	// Nullable reports whether the column may be empty: null in JSON Lines
+	// and Parquet, an empty string in CSV.
+	Nullable bool `json:"nullable"`
+}
+
+// PostColumns is the post schema, in column order. The columns are the public
+// fields of a post only: authors, IP addresses and management tokens are
+// never exported.
+var PostColumns = []Column{
+	{Name: "id", Type: "string"},
+	{Name: "created_at", Type: "timestamp"},
+	{Name: "city_code", Type: "string"},
+	{Name: "city_name", Type: "string"},
+	{Name: "company", Type: "string"},
+	{Name: "resolution_status", Type: "string", Nullable: true},
+	{Name: "content", Type: "string"},
+}
+
+// timeLayout formats timestamps in CSV and JSON Lines.
+const timeLayout = "2006-01-02T15:04:05.000Z07:00"
+
+// PostWriter writes posts to a dataset file.
+type PostWriter interface {
+	// Write writes a post.
+	Write(post *dto.ExportedPostDTO) error
+
+	// Close flushes buffered data and writes the end of the file, if the
+	// format has one. It does not close the underlying writer.
+	Close() error
+}
+
+// NewPostWriter returns a PostWriter writing the format to w.
+func NewPostWriter(format Format, w io.Writer) (PostWriter, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVWriter(w)
+	case FormatJSONL:
+		return newJSONLWriter(w), nil
+	case FormatParquet:
+		return newParquetWriter(w)
+	default:
+		return nil, fmt.Errorf("unknown dataset format %q", format)
+	}
+}
+
+// formatTime formats a timestamp for the text formats.
+func formatTime(t time.Time) string {
+	return t.UTC().Truncate(time.Millisecond).Format(timeLayout)
+}
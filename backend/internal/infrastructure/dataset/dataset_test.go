@@ -0,0 +1,194 @@
+package dataset
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"fuck_boss/backend/internal/application/dto"
+)
+
+func testPosts() []*dto.ExportedPostDTO {
+	return []*dto.ExportedPostDTO{
+		{
+			ID:               "550e8400-e29b-41d4-a716-446655440000",
+			CreatedAt:        time.Date(2026, 3, 1, 18, 30, 0, 123456789, time.FixedZone("CST", 8*3600)),
+			CityCode:         "beijing",
+			CityName:         "北京",
+			Company:          "测试公司",
+			ResolutionStatus: "RESOLVED",
+			Content:          "第一行，含逗号\n第二行 \"引号\" <b>",
+		},
+		{
+			ID:        "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+			CreatedAt: time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC),
+			CityCode:  "shanghai",
+			CityName:  "上海",
+			Company:   "另一家公司",
+			Content:   "没有后续进展",
+		},
+	}
+}
+
+func writeAll(t *testing.T, format Format) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	w, err := NewPostWriter(format, &buf)
+	if err != nil {
+		t.Fatalf("NewPostWriter() error = %v", err)
+	}
+	for _, post := range testPosts() {
+		if err := w.Write(post); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestCSVWriter(t *testing.T) {
+	records, err := csv.NewReader(bytes.NewReader(writeAll(t, FormatCSV))).ReadAll()
+	if err != nil {
+		t.Fatalf("invalid CSV: %v", err)
+	}
+
+	want := [][]string{
+		{"id", "created_at", "city_code", "city_name", "company", "resolution_status", "content"},
+		{"550e8400-e29b-41d4-a716-446655440000", "2026-03-01T10:30:00.123Z", "beijing", "北京", "测试公司", "RESOLVED", "第一行，含逗号\n第二行 \"引号\" <b>"},
+		{"6ba7b810-9dad-11d1-80b4-00c04fd430c8", "2026-03-02T00:00:00.000Z", "shanghai", "上海", "另一家公司", "", "没有后续进展"},
+	}
+	if len(records) != len(want) {
+		t.Fatalf("got %d records, want %d", len(records), len(want))
+	}
+	for i := range want {
+		if strings.Join(records[i], "|") != strings.Join(want[i], "|") {
+			t.Errorf("record %d = %q, want %q", i, records[i], want[i])
+		}
+	}
+}
+
+func TestJSONLWriter(t *testing.T) {
+	lines := strings.Split(strings.TrimSuffix(string(writeAll(t, FormatJSONL)), "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d lines, want 2", len(lines))
+	}
+
+	wantFirst := `{"id":"550e8400-e29b-41d4-a716-446655440000","created_at":"2026-03-01T10:30:00.123Z","city_code":"beijing","city_name":"北京","company":"测试公司","resolution_status":"RESOLVED","content":"第一行，含逗号\n第二行 \"引号\" <b>"}`
+	if lines[0] != wantFirst {
+		t.Errorf("line 1 = %s\nwant %s", lines[0], wantFirst)
+	}
+
+	var second map[string]interface{}
+	if err := json.Unmarshal([]byte(lines[1]), &second); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if v, ok := second["resolution_status"]; !ok || v != nil {
+		t.Errorf("resolution_status = %v, want null", v)
+	}
+}
+
+func TestParquetWriter(t *testing.T) {
+	data := writeAll(t, FormatParquet)
+	if !bytes.HasPrefix(data, []byte("PAR1")) || !bytes.HasSuffix(data, []byte("PAR1")) {
+		t.Fatal("not a Parquet file")
+	}
+	for _, c := range PostColumns {
+		if !bytes.Contains(data, []byte(c.Name)) {
+			t.Errorf("column %s missing from the schema", c.Name)
+		}
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	for _, value := range []string{"csv", "JSONL", " parquet "} {
+		if _, err := ParseFormat(value); err != nil {
+			t.Errorf("ParseFormat(%q) error = %v", value, err)
+		}
+	}
+	if _, err := ParseFormat("xlsx"); err == nil {
+		t.Error("ParseFormat(xlsx) error = nil, want error")
+	}
+}
+
+func TestFile_Commit(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "posts.csv")
+
+	f, err := CreateFile(path, FormatCSV)
+	if err != nil {
+		t.Fatalf("CreateFile() error = %v", err)
+	}
+	for _, post := range testPosts() {
+		if err := f.Write(post); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("file exists before Commit")
+	}
+
+	entry, err := f.Commit()
+	if err != nil {
+		t.Fatalf("Commit() error = %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read file: %v", err)
+	}
+	sum := sha256.Sum256(data)
+	if entry.Name != "posts.csv" || entry.Rows != 2 || entry.Bytes != int64(len(data)) || entry.SHA256 != hex.EncodeToString(sum[:]) {
+		t.Errorf("entry = %+v, want posts.csv, 2 rows, %d bytes, %x", entry, len(data), sum)
+	}
+
+	if entries, _ := os.ReadDir(dir); len(entries) != 1 {
+		t.Errorf("directory has %d files, want only the dataset", len(entries))
+	}
+}
+
+func TestFile_Abort(t *testing.T) {
+	dir := t.TempDir()
+
+	f, err := CreateFile(filepath.Join(dir, "posts.jsonl"), FormatJSONL)
+	if err != nil {
+		t.Fatalf("CreateFile() error = %v", err)
+	}
+	f.Abort()
+
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("directory has %d files after Abort, want none", len(entries))
+	}
+}
+
+func TestWriteManifest(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "posts.parquet")
+	entry := &FileEntry{Name: "posts.parquet", Rows: 2, Bytes: 10, SHA256: "abc"}
+	manifest := NewPostManifest(FormatParquet, map[string]string{"city": "beijing"}, entry)
+
+	if err := WriteManifest(ManifestPath(path), manifest); err != nil {
+		t.Fatalf("WriteManifest() error = %v", err)
+	}
+
+	data, err := os.ReadFile(path + ".manifest.json")
+	if err != nil {
+		t.Fatalf("failed to read manifest: %v", err)
+	}
+	var decoded Manifest
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("invalid manifest: %v", err)
+	}
+	if decoded.SchemaVersion != SchemaVersion || decoded.Dataset != "posts" || decoded.Format != FormatParquet ||
+		decoded.Filters["city"] != "beijing" || len(decoded.Columns) != len(PostColumns) || *decoded.Files[0] != *entry {
+		t.Errorf("manifest = %s", data)
+	}
+}
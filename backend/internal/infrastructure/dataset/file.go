@@ -0,0 +1,105 @@
+package dataset
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+
+	"fuck_boss/backend/internal/application/dto"
+)
+
+// File writes a dataset file atomically: posts are written to a temporary
+// file in the same directory, which Commit renames to the final path. The
+// SHA-256 checksum and the size are computed while writing.
+type File struct {
+	path string
+	tmp  *os.File
+	buf  *bufio.Writer
+	hash hash.Hash
+	size int64
+	rows int
+
+	writer PostWriter
+}
+
+// CreateFile starts writing a dataset file in the format to path.
+// Call Commit when all posts are written, or Abort to discard the file.
+func CreateFile(path string, format Format) (*File, error) {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dataset file: %w", err)
+	}
+
+	f := &File{path: path, tmp: tmp, hash: sha256.New()}
+	f.buf = bufio.NewWriterSize(io.MultiWriter(tmp, f.hash, (*sizeCounter)(&f.size)), 64*1024)
+	f.writer, err = NewPostWriter(format, f.buf)
+	if err != nil {
+		f.Abort()
+		return nil, err
+	}
+	return f, nil
+}
+
+// Write writes a post.
+func (f *File) Write(post *dto.ExportedPostDTO) error {
+	if err := f.writer.Write(post); err != nil {
+		return fmt.Errorf("failed to write dataset file: %w", err)
+	}
+	f.rows++
+	return nil
+}
+
+// Commit finishes the file, moves it to its path and returns its manifest entry.
+func (f *File) Commit() (*FileEntry, error) {
+	if err := f.writer.Close(); err != nil {
+		f.Abort()
+		return nil, fmt.Errorf("failed to write dataset file: %w", err)
+	}
+	if err := f.buf.Flush(); err != nil {
+		f.Abort()
+		return nil, fmt.Errorf("failed to write dataset file: %w", err)
+	}
+	// CreateTemp creates the file readable by the owner only
+	if err := f.tmp.Chmod(0o644); err != nil {
+		f.Abort()
+		return nil, fmt.Errorf("failed to write dataset file: %w", err)
+	}
+	if err := f.tmp.Sync(); err != nil {
+		f.Abort()
+		return nil, fmt.Errorf("failed to write dataset file: %w", err)
+	}
+	if err := f.tmp.Close(); err != nil {
+		os.Remove(f.tmp.Name())
+		return nil, fmt.Errorf("failed to write dataset file: %w", err)
+	}
+	if err := os.Rename(f.tmp.Name(), f.path); err != nil {
+		os.Remove(f.tmp.Name())
+		return nil, fmt.Errorf("failed to write dataset file: %w", err)
+	}
+
+	return &FileEntry{
+		Name:   filepath.Base(f.path),
+		Rows:   f.rows,
+		Bytes:  f.size,
+		SHA256: hex.EncodeToString(f.hash.Sum(nil)),
+	}, nil
+}
+
+// Abort discards the file.
+func (f *File) Abort() {
+	f.tmp.Close()
+	os.Remove(f.tmp.Name())
+}
+
+// sizeCounter counts the bytes written to it.
+type sizeCounter int64
+
+func (c *sizeCounter) Write(p []byte) (int, error) {
+	*c += sizeCounter(len(p))
+	return len(p), nil
+}
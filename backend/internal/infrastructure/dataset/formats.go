@@ -0,0 +1,130 @@
+package dataset
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/pkg/parquet"
+)
+
+// csvWriter writes posts as CSV with a header row.
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func newCSVWriter(w io.Writer) (*csvWriter, error) {
+	cw := csv.NewWriter(w)
+	header := make([]string, len(PostColumns))
+	for i, c := range PostColumns {
+		header[i] = c.Name
+	}
+	if err := cw.Write(header); err != nil {
+		return nil, err
+	}
+	return &csvWriter{w: cw}, nil
+}
+
+func (w *csvWriter) Write(post *dto.ExportedPostDTO) error {
+	return w.w.Write([]string{
+		post.ID,
+		formatTime(post.CreatedAt),
+		post.CityCode,
+		post.CityName,
+		post.Company,
+		post.ResolutionStatus,
+		post.Content,
+	})
+}
+
+func (w *csvWriter) Close() error {
+	w.w.Flush()
+	return w.w.Error()
+}
+
+// jsonlRecord is a post in JSON Lines. The field order is the column order.
+type jsonlRecord struct {
+	ID               string  `json:"id"`
+	CreatedAt        string  `json:"created_at"`
+	CityCode         string  `json:"city_code"`
+	CityName         string  `json:"city_name"`
+	Company          string  `json:"company"`
+	ResolutionStatus *string `json:"resolution_status"`
+	Content          string  `json:"content"`
+}
+
+// jsonlWriter writes posts as JSON Lines.
+type jsonlWriter struct {
+	buf *bufio.Writer
+	enc *json.Encoder
+}
+
+func newJSONLWriter(w io.Writer) *jsonlWriter {
+	buf := bufio.NewWriter(w)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	return &jsonlWriter{buf: buf, enc: enc}
+}
+
+func (w *jsonlWriter) Write(post *dto.ExportedPostDTO) error {
+	record := jsonlRecord{
+		ID:        post.ID,
+		CreatedAt: formatTime(post.CreatedAt),
+		CityCode:  post.CityCode,
+		CityName:  post.CityName,
+		Company:   post.Company,
+		Content:   post.Content,
+	}
+	if post.ResolutionStatus != "" {
+		record.ResolutionStatus = &post.ResolutionStatus
+	}
+	// Encode terminates each value with a newline
+	return w.enc.Encode(record)
+}
+
+func (w *jsonlWriter) Close() error {
+	return w.buf.Flush()
+}
+
+// parquetWriter writes posts as Parquet.
+type parquetWriter struct {
+	w *parquet.Writer
+}
+
+func newParquetWriter(w io.Writer) (*parquetWriter, error) {
+	columns := make([]parquet.Column, len(PostColumns))
+	for i, c := range PostColumns {
+		columns[i] = parquet.Column{Name: c.Name, Type: parquet.String, Optional: c.Nullable}
+		if c.Type == "timestamp" {
+			columns[i].Type = parquet.Timestamp
+		}
+	}
+
+	pw, err := parquet.NewWriter(w, columns, parquet.DefaultRowGroupSize)
+	if err != nil {
+		return nil, err
+	}
+	return &parquetWriter{w: pw}, nil
+}
+
+func (w *parquetWriter) Write(post *dto.ExportedPostDTO) error {
+	var status interface{}
+	if post.ResolutionStatus != "" {
+		status = post.ResolutionStatus
+	}
+	return w.w.Write([]interface{}{
+		post.ID,
+		post.CreatedAt,
+		post.CityCode,
+		post.CityName,
+		post.Company,
+		status,
+		post.Content,
+	})
+}
+
+func (w *parquetWriter) Close() error {
+	return w.w.Close()
+}
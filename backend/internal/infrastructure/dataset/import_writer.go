@@ -0,0 +1,53 @@
+package dataset
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+
+	"fuck_boss/backend/internal/application/content"
+)
+
+// ImportWriter writes rows as JSON Lines in the import format (see
+// ImportColumns), so they can be imported with the import subcommand.
+type ImportWriter struct {
+	buf *bufio.Writer
+	enc *json.Encoder
+}
+
+// NewImportWriter returns an ImportWriter writing to w.
+func NewImportWriter(w io.Writer) *ImportWriter {
+	buf := bufio.NewWriter(w)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	return &ImportWriter{buf: buf, enc: enc}
+}
+
+// Write writes a row. Line and Err are not written; empty optional
+// columns are null.
+func (w *ImportWriter) Write(record *content.ImportPostRecord) error {
+	externalID, err := json.Marshal(record.ExternalID)
+	if err != nil {
+		return err
+	}
+	row := jsonlImportRecord{
+		ExternalID: externalID,
+		CreatedAt:  record.CreatedAt,
+		CityCode:   record.CityCode,
+		CityName:   record.CityName,
+		Company:    record.Company,
+		Content:    record.Content,
+	}
+	if record.OccurredAt != "" {
+		row.OccurredAt = &record.OccurredAt
+	}
+	if record.ResolutionStatus != "" {
+		row.ResolutionStatus = &record.ResolutionStatus
+	}
+	return w.enc.Encode(row)
+}
+
+// Close flushes buffered data. It does not close the underlying writer.
+func (w *ImportWriter) Close() error {
+	return w.buf.Flush()
+}
@@ -0,0 +1,97 @@
+package dataset
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// ManifestSuffix is appended to the path of a dataset file to get the path
+// of its manifest.
+const ManifestSuffix = ".manifest.json"
+
+// Manifest describes an exported dataset: what was exported, the schema, and
+// the checksum of every file, so recipients can verify what they received.
+type Manifest struct {
+	// SchemaVersion is the version of the schema (see SchemaVersion).
+	SchemaVersion int `json:"schema_version"`
+
+	// Dataset is the name of the dataset ("posts").
+	Dataset string `json:"dataset"`
+
+	// Format is the file format.
+	Format Format `json:"format"`
+
+	// CreatedAt is when the export finished.
+	CreatedAt time.Time `json:"created_at"`
+
+	// Filters are the filters the export was made with (omitted when none).
+	Filters map[string]string `json:"filters,omitempty"`
+
+	// Columns is the schema.
+	Columns []Column `json:"columns"`
+
+	// Files are the dataset files.
+	Files []*FileEntry `json:"files"`
+}
+
+// FileEntry describes a dataset file in a manifest.
+type FileEntry struct {
+	// Name is the file name, relative to the manifest.
+	Name string `json:"name"`
+
+	// Rows is the number of posts in the file.
+	Rows int `json:"rows"`
+
+	// Bytes is the file size.
+	Bytes int64 `json:"bytes"`
+
+	// SHA256 is the hex-encoded SHA-256 checksum of the file.
+	SHA256 string `json:"sha256"`
+}
+
+// NewPostManifest returns the manifest of a post dataset made of files.
+func NewPostManifest(format Format, filters map[string]string, files ...*FileEntry) *Manifest {
+	return &Manifest{
+		SchemaVersion: SchemaVersion,
+		Dataset:       "posts",
+		Format:        format,
+		CreatedAt:     time.Now().UTC().Truncate(time.Second),
+		Filters:       filters,
+		Columns:       PostColumns,
+		Files:         files,
+	}
+}
+
+// ManifestPath returns the path of the manifest of a dataset file.
+func ManifestPath(path string) string {
+	return path + ManifestSuffix
+}
+
+// WriteManifest writes a manifest as indented JSON to path, atomically.
+func WriteManifest(path string, manifest *Manifest) error {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode manifest: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return fmt.Errorf("failed to create manifest: %w", err)
+	}
+	defer os.Remove(tmp.Name()) // no-op after a successful rename
+
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write manifest: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write manifest: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to write manifest: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,216 @@
+package dataset
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"fuck_boss/backend/internal/application/content"
+)
+
+// ImportColumns are the columns of a dataset to import, in the order used by
+// templates. Only the nullable columns are optional; other columns are ignored.
+var ImportColumns = []Column{
+	{Name: "external_id", Type: "string"},
+	{Name: "created_at", Type: "timestamp"},
+	{Name: "city_code", Type: "string"},
+	{Name: "city_name", Type: "string"},
+	{Name: "company", Type: "string"},
+	{Name: "content", Type: "string"},
+	{Name: "occurred_at", Type: "timestamp", Nullable: true},
+	{Name: "resolution_status", Type: "string", Nullable: true},
+}
+
+// maxJSONLLine is the maximum length of a line in JSON Lines (in bytes).
+const maxJSONLLine = 1 << 20
+
+// PostReader reads the rows of a dataset to import.
+type PostReader interface {
+	// Read returns the next row, or io.EOF at the end of the file. Rows that
+	// cannot be parsed are returned with Err set; other errors are fatal.
+	Read() (*content.ImportPostRecord, error)
+}
+
+// NewPostReader returns a PostReader reading the format from r.
+// Only the text formats can be imported.
+func NewPostReader(format Format, r io.Reader) (PostReader, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVReader(r)
+	case FormatJSONL:
+		return newJSONLReader(r), nil
+	case FormatParquet:
+		return nil, fmt.Errorf("importing parquet is not supported (use csv or jsonl)")
+	default:
+		return nil, fmt.Errorf("unknown dataset format %q", format)
+	}
+}
+
+// csvReader reads rows from CSV with a header row.
+type csvReader struct {
+	r *csv.Reader
+
+	// index maps the import column names to their position in a row.
+	index map[string]int
+}
+
+func newCSVReader(r io.Reader) (*csvReader, error) {
+	// Spreadsheet programs often save UTF-8 with a byte order mark
+	br := bufio.NewReader(r)
+	if bom, err := br.Peek(3); err == nil && bytes.Equal(bom, []byte("\xef\xbb\xbf")) {
+		br.Discard(3)
+	}
+
+	cr := csv.NewReader(br)
+	cr.FieldsPerRecord = -1
+	header, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("missing header row")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header row: %w", err)
+	}
+
+	index := make(map[string]int)
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if _, ok := index[name]; !ok {
+			index[name] = i
+		}
+	}
+	var missing []string
+	for _, c := range ImportColumns {
+		if _, ok := index[c.Name]; !ok && !c.Nullable {
+			missing = append(missing, c.Name)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("missing columns: %s", strings.Join(missing, ", "))
+	}
+
+	return &csvReader{r: cr, index: index}, nil
+}
+
+func (r *csvReader) Read() (*content.ImportPostRecord, error) {
+	row, err := r.r.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, io.EOF
+	}
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return &content.ImportPostRecord{Line: parseErr.StartLine, Err: parseErr.Err}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	line, _ := r.r.FieldPos(0)
+	field := func(name string) string {
+		if i, ok := r.index[name]; ok && i < len(row) {
+			return row[i]
+		}
+		return ""
+	}
+	return &content.ImportPostRecord{
+		Line:             line,
+		ExternalID:       field("external_id"),
+		CreatedAt:        field("created_at"),
+		CityCode:         field("city_code"),
+		CityName:         field("city_name"),
+		Company:          field("company"),
+		Content:          field("content"),
+		OccurredAt:       field("occurred_at"),
+		ResolutionStatus: field("resolution_status"),
+	}, nil
+}
+
+// jsonlImportRecord is a row in JSON Lines. external_id may be a string or a number.
+type jsonlImportRecord struct {
+	ExternalID       json.RawMessage `json:"external_id"`
+	CreatedAt        string          `json:"created_at"`
+	CityCode         string          `json:"city_code"`
+	CityName         string          `json:"city_name"`
+	Company          string          `json:"company"`
+	Content          string          `json:"content"`
+	OccurredAt       *string         `json:"occurred_at"`
+	ResolutionStatus *string         `json:"resolution_status"`
+}
+
+// jsonlReader reads rows from JSON Lines, skipping blank lines.
+type jsonlReader struct {
+	s    *bufio.Scanner
+	line int
+}
+
+func newJSONLReader(r io.Reader) *jsonlReader {
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 64*1024), maxJSONLLine)
+	return &jsonlReader{s: s}
+}
+
+func (r *jsonlReader) Read() (*content.ImportPostRecord, error) {
+	for r.s.Scan() {
+		r.line++
+		data := bytes.TrimSpace(r.s.Bytes())
+		if r.line == 1 {
+			data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+		}
+		if len(data) == 0 {
+			continue
+		}
+
+		var row jsonlImportRecord
+		if err := json.Unmarshal(data, &row); err != nil {
+			return &content.ImportPostRecord{Line: r.line, Err: fmt.Errorf("invalid JSON: %w", err)}, nil
+		}
+		externalID, err := jsonlExternalID(row.ExternalID)
+		if err != nil {
+			return &content.ImportPostRecord{Line: r.line, Err: err}, nil
+		}
+		record := &content.ImportPostRecord{
+			Line:       r.line,
+			ExternalID: externalID,
+			CreatedAt:  row.CreatedAt,
+			CityCode:   row.CityCode,
+			CityName:   row.CityName,
+			Company:    row.Company,
+			Content:    row.Content,
+		}
+		if row.OccurredAt != nil {
+			record.OccurredAt = *row.OccurredAt
+		}
+		if row.ResolutionStatus != nil {
+			record.ResolutionStatus = *row.ResolutionStatus
+		}
+		return record, nil
+	}
+
+	if err := r.s.Err(); err != nil {
+		if errors.Is(err, bufio.ErrTooLong) {
+			return nil, fmt.Errorf("line %d is longer than %d bytes", r.line+1, maxJSONLLine)
+		}
+		return nil, err
+	}
+	return nil, io.EOF
+}
+
+// jsonlExternalID returns an external ID given as a JSON string or number.
+func jsonlExternalID(raw json.RawMessage) (string, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return "", nil
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s, nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(raw, &n); err == nil {
+		return n.String(), nil
+	}
+	return "", fmt.Errorf("external_id must be a string or a number")
+}
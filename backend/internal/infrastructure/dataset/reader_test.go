@@ -0,0 +1,131 @@
+package dataset
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"fuck_boss/backend/internal/application/content"
+)
+
+func readAll(t *testing.T, format Format, data string) []*content.ImportPostRecord {
+	t.Helper()
+
+	r, err := NewPostReader(format, strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("NewPostReader() error = %v", err)
+	}
+	var records []*content.ImportPostRecord
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			return records
+		}
+		if err != nil {
+			t.Fatalf("Read() error = %v", err)
+		}
+		records = append(records, record)
+	}
+}
+
+func TestCSVReader(t *testing.T) {
+	data := "\xef\xbb\xbfCompany,external_id,created_at,city_code,city_name,content,notes\n" +
+		"测试公司,1,2020-05-01 09:00,beijing,北京,\"第一行\n第二行\",x\n" +
+		"另一家公司,2,2020-05-02,shanghai\n" +
+		"坏行,3,\"unterminated\n"
+
+	records := readAll(t, FormatCSV, data)
+	if len(records) != 3 {
+		t.Fatalf("got %d records, want 3", len(records))
+	}
+
+	first := records[0]
+	if first.Line != 2 || first.ExternalID != "1" || first.Company != "测试公司" || first.Content != "第一行\n第二行" {
+		t.Errorf("first record = %+v", first)
+	}
+	if first.ResolutionStatus != "" || first.Err != nil {
+		t.Errorf("first record = %+v, want no resolution status and no error", first)
+	}
+
+	// Short rows are read with empty fields and fail validation later
+	second := records[1]
+	if second.Line != 4 || second.CityCode != "shanghai" || second.CityName != "" || second.Err != nil {
+		t.Errorf("second record = %+v", second)
+	}
+
+	if records[2].Line != 5 || records[2].Err == nil {
+		t.Errorf("third record = %+v, want a parse error on line 5", records[2])
+	}
+}
+
+func TestCSVReader_MissingColumns(t *testing.T) {
+	for _, data := range []string{"", "external_id,created_at,company\n"} {
+		if _, err := NewPostReader(FormatCSV, strings.NewReader(data)); err == nil {
+			t.Errorf("NewPostReader(%q) error = nil, want error", data)
+		}
+	}
+}
+
+func TestJSONLReader(t *testing.T) {
+	data := `{"external_id":"a1","created_at":"2020-05-01T09:00:00Z","city_code":"beijing","city_name":"北京","company":"测试公司","content":"内容","occurred_at":"2020-04-01","resolution_status":"RESOLVED"}` + "\n" +
+		"\n" +
+		`{"external_id":42,"city_code":"shanghai","resolution_status":null}` + "\n" +
+		`{"external_id":"a3",` + "\n" +
+		`{"external_id":true}`
+
+	records := readAll(t, FormatJSONL, data)
+	if len(records) != 4 {
+		t.Fatalf("got %d records, want 4", len(records))
+	}
+
+	first := records[0]
+	if first.Line != 1 || first.ExternalID != "a1" || first.CityName != "北京" || first.OccurredAt != "2020-04-01" || first.ResolutionStatus != "RESOLVED" || first.Err != nil {
+		t.Errorf("first record = %+v", first)
+	}
+	if records[1].Line != 3 || records[1].ExternalID != "42" || records[1].Err != nil {
+		t.Errorf("second record = %+v", records[1])
+	}
+	if records[2].Line != 4 || records[2].Err == nil {
+		t.Errorf("third record = %+v, want an error on line 4", records[2])
+	}
+	if records[3].Line != 5 || records[3].Err == nil {
+		t.Errorf("fourth record = %+v, want an error on line 5", records[3])
+	}
+}
+
+func TestNewPostReader_Parquet(t *testing.T) {
+	if _, err := NewPostReader(FormatParquet, strings.NewReader("")); err == nil {
+		t.Error("NewPostReader(parquet) error = nil, want error")
+	}
+}
+
+func TestImportWriter(t *testing.T) {
+	want := []*content.ImportPostRecord{
+		{Line: 1, ExternalID: "1-1", CreatedAt: "2025-06-01T12:00:00Z", CityCode: "beijing", CityName: "北京",
+			Company: "测试公司", Content: "内容 <b>", OccurredAt: "2025-05-01", ResolutionStatus: "RESOLVED"},
+		{Line: 2, ExternalID: "1-2", CreatedAt: "2025-06-02T12:00:00Z", CityCode: "shanghai", CityName: "上海",
+			Company: "另一家公司", Content: "内容"},
+	}
+
+	var buf strings.Builder
+	w := NewImportWriter(&buf)
+	for _, record := range want {
+		if err := w.Write(record); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	got := readAll(t, FormatJSONL, buf.String())
+	if len(got) != len(want) {
+		t.Fatalf("read %d records, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if *got[i] != *want[i] {
+			t.Errorf("record %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
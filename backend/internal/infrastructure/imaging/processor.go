@@ -0,0 +1,271 @@
+// Package imaging provides the pure-Go image sanitization used for attachments
+// and the share card rendering of posts.
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+
+	xdraw "golang.org/x/image/draw"
+	_ "golang.org/x/image/webp" // registers the WebP decoder
+
+	"fuck_boss/backend/internal/application/attachment"
+)
+
+const (
+	// MaxPixels is the largest image (width * height) that is decoded.
+	// It bounds the memory used per upload (about 100MB as RGBA).
+	MaxPixels = 25_000_000
+
+	// MaxGIFPixels is the largest total area of all frames of an animated GIF.
+	MaxGIFPixels = 50_000_000
+
+	// ThumbnailSize is the maximum width and height of thumbnails.
+	ThumbnailSize = 320
+
+	// jpegQuality is the quality JPEG images and thumbnails are re-encoded with.
+	jpegQuality = 90
+)
+
+// ErrTooLarge is returned when an image has more pixels than allowed.
+var ErrTooLarge = errors.New("image is too large")
+
+// Processor is the pure-Go implementation of attachment.ImageProcessor.
+// Images are fully decoded and re-encoded, so nothing but pixels survives:
+// EXIF (including GPS and device data), XMP, ICC profiles and comments are dropped.
+// JPEG stays JPEG and GIF stays GIF (animations are kept); PNG and WebP become PNG.
+type Processor struct{}
+
+// NewProcessor creates a new Processor instance.
+func NewProcessor() *Processor {
+	return &Processor{}
+}
+
+// Process implements attachment.ImageProcessor.
+func (p *Processor) Process(data []byte, regions []attachment.Region) (*attachment.ProcessedImage, error) {
+	// Check the dimensions before decoding any pixels
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+	if int64(cfg.Width)*int64(cfg.Height) > MaxPixels {
+		return nil, fmt.Errorf("%w: %dx%d pixels", ErrTooLarge, cfg.Width, cfg.Height)
+	}
+
+	switch format {
+	case "gif":
+		return p.processGIF(data, regions)
+	case "jpeg", "png", "webp":
+		return p.processStill(data, format, regions)
+	default:
+		return nil, fmt.Errorf("unsupported image format: %s", format)
+	}
+}
+
+// processStill sanitizes a JPEG, PNG or WebP image.
+func (p *Processor) processStill(data []byte, format string, regions []attachment.Region) (*attachment.ProcessedImage, error) {
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+
+	// The orientation tag is dropped with the rest of the metadata, so it is applied to the pixels
+	if format == "jpeg" {
+		if orientation := jpegOrientation(data); orientation > 1 {
+			img = applyOrientation(toRGBA(img), orientation)
+		}
+	}
+
+	if len(regions) > 0 {
+		rgba := toRGBA(img)
+		for _, r := range regions {
+			blurRegion(rgba, regionRect(r, rgba.Bounds()))
+		}
+		img = rgba
+	}
+
+	encode, contentType := encodePNG, "image/png"
+	if format == "jpeg" {
+		encode, contentType = encodeJPEG, "image/jpeg"
+	}
+
+	sanitized, err := encode(img)
+	if err != nil {
+		return nil, err
+	}
+	thumbnail, err := encode(thumbnail(img))
+	if err != nil {
+		return nil, err
+	}
+
+	return &attachment.ProcessedImage{
+		Data:                 sanitized,
+		ContentType:          contentType,
+		Width:                img.Bounds().Dx(),
+		Height:               img.Bounds().Dy(),
+		Thumbnail:            thumbnail,
+		ThumbnailContentType: contentType,
+	}, nil
+}
+
+// processGIF sanitizes a (possibly animated) GIF image.
+// The thumbnail is a PNG of the first frame.
+func (p *Processor) processGIF(data []byte, regions []attachment.Region) (*attachment.ProcessedImage, error) {
+	// Many large frames compress well, so the total area is checked before decoding
+	area, err := gifFrameArea(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+	if area > MaxGIFPixels {
+		return nil, fmt.Errorf("%w: %d pixels in all frames", ErrTooLarge, area)
+	}
+
+	g, err := gif.DecodeAll(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+	if len(g.Image) == 0 {
+		return nil, errors.New("failed to decode image: no frames")
+	}
+
+	canvas := image.Rect(0, 0, g.Config.Width, g.Config.Height)
+	for _, frame := range g.Image {
+		for _, r := range regions {
+			rect := regionRect(r, canvas).Intersect(frame.Bounds())
+			if rect.Empty() {
+				continue
+			}
+			// Blur a true-color copy and map it back onto the frame's palette
+			tmp := image.NewRGBA(rect)
+			draw.Draw(tmp, rect, frame, rect.Min, draw.Src)
+			blurRegion(tmp, rect)
+			draw.Draw(frame, rect, tmp, rect.Min, draw.Src)
+		}
+	}
+
+	// Only the frames and their timing are written back; comments and application extensions
+	// (except the loop count) are dropped by the encoder
+	var buf bytes.Buffer
+	if err := gif.EncodeAll(&buf, g); err != nil {
+		return nil, fmt.Errorf("failed to encode image: %w", err)
+	}
+
+	first := image.NewRGBA(canvas)
+	draw.Draw(first, g.Image[0].Bounds(), g.Image[0], g.Image[0].Bounds().Min, draw.Over)
+	thumb, err := encodePNG(thumbnail(first))
+	if err != nil {
+		return nil, err
+	}
+
+	return &attachment.ProcessedImage{
+		Data:                 buf.Bytes(),
+		ContentType:          "image/gif",
+		Width:                canvas.Dx(),
+		Height:               canvas.Dy(),
+		Thumbnail:            thumb,
+		ThumbnailContentType: "image/png",
+	}, nil
+}
+
+// toRGBA returns img as an *image.RGBA with its origin at (0, 0), converting it if needed.
+func toRGBA(img image.Image) *image.RGBA {
+	if rgba, ok := img.(*image.RGBA); ok && rgba.Bounds().Min == (image.Point{}) {
+		return rgba
+	}
+	b := img.Bounds()
+	rgba := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(rgba, rgba.Bounds(), img, b.Min, draw.Src)
+	return rgba
+}
+
+// regionRect converts a region to a rectangle clipped to bounds.
+// Regions are relative to the top-left corner of the image.
+func regionRect(r attachment.Region, bounds image.Rectangle) image.Rectangle {
+	if r.X >= bounds.Dx() || r.Y >= bounds.Dy() {
+		return image.Rectangle{}
+	}
+	w := min(r.Width, bounds.Dx()-r.X)
+	h := min(r.Height, bounds.Dy()-r.Y)
+	return image.Rect(r.X, r.Y, r.X+w, r.Y+h).Add(bounds.Min).Intersect(bounds)
+}
+
+// blurRegion pixelates rect in place.
+// Every block is replaced by its average color; unlike a gaussian blur, this cannot be
+// undone by deconvolution, and the large blocks leave text and faces unreadable.
+func blurRegion(img *image.RGBA, rect image.Rectangle) {
+	rect = rect.Intersect(img.Bounds())
+	if rect.Empty() {
+		return
+	}
+	block := max(8, min(rect.Dx(), rect.Dy())/4)
+
+	for by := rect.Min.Y; by < rect.Max.Y; by += block {
+		for bx := rect.Min.X; bx < rect.Max.X; bx += block {
+			cell := image.Rect(bx, by, bx+block, by+block).Intersect(rect)
+
+			var sum [4]int
+			for y := cell.Min.Y; y < cell.Max.Y; y++ {
+				row := img.Pix[img.PixOffset(cell.Min.X, y):img.PixOffset(cell.Max.X, y)]
+				for i := 0; i < len(row); i += 4 {
+					sum[0] += int(row[i])
+					sum[1] += int(row[i+1])
+					sum[2] += int(row[i+2])
+					sum[3] += int(row[i+3])
+				}
+			}
+
+			n := cell.Dx() * cell.Dy()
+			avg := [4]uint8{uint8(sum[0] / n), uint8(sum[1] / n), uint8(sum[2] / n), uint8(sum[3] / n)}
+			for y := cell.Min.Y; y < cell.Max.Y; y++ {
+				row := img.Pix[img.PixOffset(cell.Min.X, y):img.PixOffset(cell.Max.X, y)]
+				for i := 0; i < len(row); i += 4 {
+					copy(row[i:i+4], avg[:])
+				}
+			}
+		}
+	}
+}
+
+// thumbnail scales img down to fit into ThumbnailSize x ThumbnailSize, keeping the aspect ratio.
+// Smaller images are returned unchanged.
+func thumbnail(img image.Image) image.Image {
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w <= ThumbnailSize && h <= ThumbnailSize {
+		return img
+	}
+
+	if w >= h {
+		w, h = ThumbnailSize, max(1, h*ThumbnailSize/w)
+	} else {
+		w, h = max(1, w*ThumbnailSize/h), ThumbnailSize
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	xdraw.CatmullRom.Scale(dst, dst.Bounds(), img, b, xdraw.Src, nil)
+	return dst
+}
+
+// encodeJPEG encodes img as a JPEG without any metadata segments.
+func encodeJPEG(img image.Image) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality}); err != nil {
+		return nil, fmt.Errorf("failed to encode image: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// encodePNG encodes img as a PNG without any ancillary chunks.
+func encodePNG(img image.Image) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("failed to encode image: %w", err)
+	}
+	return buf.Bytes(), nil
+}
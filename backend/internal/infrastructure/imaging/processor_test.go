@@ -0,0 +1,194 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"fuck_boss/backend/internal/application/attachment"
+)
+
+// testImage returns a w x h image with a red top-left quadrant on blue.
+func testImage(w, h int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			c := color.RGBA{B: 255, A: 255}
+			if x < w/2 && y < h/2 {
+				c = color.RGBA{R: 255, A: 255}
+			}
+			img.SetRGBA(x, y, c)
+		}
+	}
+	return img
+}
+
+// exifSegment builds an APP1 Exif segment with the given orientation and a GPS marker string.
+func exifSegment(orientation uint16) []byte {
+	var tiff bytes.Buffer
+	tiff.WriteString("MM")
+	binary.Write(&tiff, binary.BigEndian, uint16(42))
+	binary.Write(&tiff, binary.BigEndian, uint32(8))
+	binary.Write(&tiff, binary.BigEndian, uint16(1))
+	binary.Write(&tiff, binary.BigEndian, []uint16{orientationTag, 3})
+	binary.Write(&tiff, binary.BigEndian, uint32(1))
+	binary.Write(&tiff, binary.BigEndian, []uint16{orientation, 0})
+	binary.Write(&tiff, binary.BigEndian, uint32(0))
+	tiff.WriteString("GPS 39.9042N 116.4074E")
+
+	payload := append([]byte("Exif\x00\x00"), tiff.Bytes()...)
+	segment := []byte{0xFF, 0xE1, 0, 0}
+	binary.BigEndian.PutUint16(segment[2:], uint16(len(payload)+2))
+	return append(segment, payload...)
+}
+
+func encodeTestJPEG(t *testing.T, img image.Image, orientation uint16) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 100}); err != nil {
+		t.Fatalf("jpeg.Encode() error = %v", err)
+	}
+	data := buf.Bytes()
+	// Insert the Exif segment right after SOI
+	return append(append(append([]byte{}, data[:2]...), exifSegment(orientation)...), data[2:]...)
+}
+
+func encodeTestPNG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatalf("png.Encode() error = %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestProcessor_JPEG_StripsMetadataAndAppliesOrientation(t *testing.T) {
+	data := encodeTestJPEG(t, testImage(40, 20), 6)
+	if jpegOrientation(data) != 6 {
+		t.Fatalf("jpegOrientation() = %d, want 6", jpegOrientation(data))
+	}
+
+	result, err := NewProcessor().Process(data, nil)
+	if err != nil {
+		t.Fatalf("Process() error = %v", err)
+	}
+
+	if result.ContentType != "image/jpeg" || result.ThumbnailContentType != "image/jpeg" {
+		t.Errorf("content types = %s, %s, want image/jpeg", result.ContentType, result.ThumbnailContentType)
+	}
+	if bytes.Contains(result.Data, []byte("Exif")) || bytes.Contains(result.Data, []byte("GPS")) {
+		t.Error("sanitized image still contains EXIF data")
+	}
+	// Rotated 90 degrees clockwise: 40x20 becomes 20x40
+	if result.Width != 20 || result.Height != 40 {
+		t.Errorf("dimensions = %dx%d, want 20x40", result.Width, result.Height)
+	}
+
+	img, err := jpeg.Decode(bytes.NewReader(result.Data))
+	if err != nil {
+		t.Fatalf("jpeg.Decode() error = %v", err)
+	}
+	// The red top-left quadrant ends up in the top-right corner
+	if r, _, b, _ := img.At(15, 5).RGBA(); r < b {
+		t.Errorf("top-right pixel is not red: r=%d b=%d", r>>8, b>>8)
+	}
+	if r, _, b, _ := img.At(5, 5).RGBA(); r > b {
+		t.Errorf("top-left pixel is not blue: r=%d b=%d", r>>8, b>>8)
+	}
+}
+
+func TestProcessor_Thumbnail(t *testing.T) {
+	result, err := NewProcessor().Process(encodeTestPNG(t, testImage(1000, 500)), nil)
+	if err != nil {
+		t.Fatalf("Process() error = %v", err)
+	}
+
+	cfg, err := png.DecodeConfig(bytes.NewReader(result.Thumbnail))
+	if err != nil {
+		t.Fatalf("png.DecodeConfig() error = %v", err)
+	}
+	if cfg.Width != ThumbnailSize || cfg.Height != ThumbnailSize/2 {
+		t.Errorf("thumbnail = %dx%d, want %dx%d", cfg.Width, cfg.Height, ThumbnailSize, ThumbnailSize/2)
+	}
+	if result.Width != 1000 || result.Height != 500 {
+		t.Errorf("dimensions = %dx%d, want 1000x500", result.Width, result.Height)
+	}
+}
+
+func TestProcessor_BlursRegions(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 64, 64))
+	for y := 0; y < 64; y++ {
+		for x := 0; x < 64; x++ {
+			src.SetRGBA(x, y, color.RGBA{R: uint8((x + y) % 2 * 255), A: 255})
+		}
+	}
+
+	result, err := NewProcessor().Process(encodeTestPNG(t, src), []attachment.Region{{X: 0, Y: 0, Width: 32, Height: 32}})
+	if err != nil {
+		t.Fatalf("Process() error = %v", err)
+	}
+
+	img, err := png.Decode(bytes.NewReader(result.Data))
+	if err != nil {
+		t.Fatalf("png.Decode() error = %v", err)
+	}
+	// Inside the region the checkerboard is averaged away
+	if a, b := img.At(0, 0), img.At(1, 0); a != b {
+		t.Errorf("region is not blurred: %v != %v", a, b)
+	}
+	// Outside the region the pixels are untouched
+	if a, b := img.At(40, 40), img.At(41, 40); a == b {
+		t.Errorf("pixels outside the region changed: %v == %v", a, b)
+	}
+}
+
+func TestProcessor_GIF(t *testing.T) {
+	palette := color.Palette{color.Black, color.White}
+	g := &gif.GIF{
+		Image: []*image.Paletted{image.NewPaletted(image.Rect(0, 0, 10, 10), palette), image.NewPaletted(image.Rect(2, 2, 6, 6), palette)},
+		Delay: []int{10, 10},
+	}
+	var buf bytes.Buffer
+	if err := gif.EncodeAll(&buf, g); err != nil {
+		t.Fatalf("gif.EncodeAll() error = %v", err)
+	}
+
+	area, err := gifFrameArea(buf.Bytes())
+	if err != nil || area != 116 {
+		t.Errorf("gifFrameArea() = %d, %v, want 116", area, err)
+	}
+
+	result, err := NewProcessor().Process(buf.Bytes(), []attachment.Region{{X: 0, Y: 0, Width: 4, Height: 4}})
+	if err != nil {
+		t.Fatalf("Process() error = %v", err)
+	}
+	if result.ContentType != "image/gif" || result.ThumbnailContentType != "image/png" {
+		t.Errorf("content types = %s, %s", result.ContentType, result.ThumbnailContentType)
+	}
+
+	out, err := gif.DecodeAll(bytes.NewReader(result.Data))
+	if err != nil {
+		t.Fatalf("gif.DecodeAll() error = %v", err)
+	}
+	if len(out.Image) != 2 {
+		t.Errorf("frames = %d, want 2", len(out.Image))
+	}
+}
+
+func TestProcessor_Rejects(t *testing.T) {
+	// A GIF header claiming 10000x10000 pixels
+	huge := []byte("GIF89a\x10\x27\x10\x27\x00\x00\x00")
+
+	if _, err := NewProcessor().Process(huge, nil); !errors.Is(err, ErrTooLarge) {
+		t.Errorf("Process(huge) error = %v, want ErrTooLarge", err)
+	}
+	if _, err := NewProcessor().Process([]byte("\x89PNG\r\n\x1a\ngarbage"), nil); err == nil {
+		t.Error("Process(corrupt) error = nil, want error")
+	}
+}
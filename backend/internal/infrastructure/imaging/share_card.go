@@ -0,0 +1,354 @@
+package imaging
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+	"unicode"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+
+	"fuck_boss/backend/internal/application/content"
+)
+
+// fontFiles holds the CJK font subsets generated by scripts/subset_font.sh.
+// Font files with "Bold" in their name are preferred for the company name.
+//
+//go:embed fonts
+var fontFiles embed.FS
+
+// Share card layout, in pixels.
+const (
+	cardPadding       = 72
+	cardAccentWidth   = 16
+	cardMetaSize      = 30
+	cardCompanySize   = 60
+	cardCompanyLines  = 2
+	cardSummarySize   = 34
+	cardSummaryLines  = 4
+	cardFooterSize    = 26
+	cardFooterHeight  = 96
+	cardBadgePaddingX = 18
+	cardBadgePaddingY = 10
+)
+
+// Share card colors.
+var (
+	cardBackground = color.RGBA{R: 0xfa, G: 0xfa, B: 0xf7, A: 0xff}
+	cardAccent     = color.RGBA{R: 0xd9, G: 0x48, B: 0x0f, A: 0xff}
+	cardTitle      = color.RGBA{R: 0x11, G: 0x11, B: 0x11, A: 0xff}
+	cardText       = color.RGBA{R: 0x33, G: 0x33, B: 0x33, A: 0xff}
+	cardMuted      = color.RGBA{R: 0x88, G: 0x88, B: 0x88, A: 0xff}
+	cardRule       = color.RGBA{R: 0xe5, G: 0xe5, B: 0xe0, A: 0xff}
+)
+
+// ShareCardRenderer is the pure-Go implementation of content.ShareCardRenderer.
+// Each character is drawn with the first font that has a glyph for it: the
+// embedded CJK subsets first, then the Go fonts (Latin only). Characters that
+// no font covers are drawn as the Go fonts' missing-glyph box.
+type ShareCardRenderer struct {
+	// regular and bold are the fonts in order of preference.
+	regular []*opentype.Font
+	bold    []*opentype.Font
+}
+
+// NewShareCardRenderer creates a new ShareCardRenderer with the embedded fonts.
+func NewShareCardRenderer() (*ShareCardRenderer, error) {
+	entries, err := fs.ReadDir(fontFiles, "fonts")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list embedded fonts: %w", err)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name() < entries[j].Name() })
+
+	r := &ShareCardRenderer{}
+	var others []*opentype.Font
+	for _, entry := range entries {
+		ext := strings.ToLower(path.Ext(entry.Name()))
+		if entry.IsDir() || (ext != ".ttf" && ext != ".otf") {
+			continue
+		}
+		data, err := fontFiles.ReadFile("fonts/" + entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read font %s: %w", entry.Name(), err)
+		}
+		f, err := opentype.Parse(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse font %s: %w", entry.Name(), err)
+		}
+		if strings.Contains(entry.Name(), "Bold") {
+			r.bold = append(r.bold, f)
+		} else {
+			others = append(others, f)
+		}
+	}
+
+	goRegular, err := opentype.Parse(goregular.TTF)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Go font: %w", err)
+	}
+	goBold, err := opentype.Parse(gobold.TTF)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Go font: %w", err)
+	}
+
+	// A regular CJK font still beats a bold font without CJK glyphs
+	r.bold = append(append(r.bold, others...), goBold)
+	r.regular = append(others, goRegular)
+	if len(r.regular) == 1 {
+		// Only bold subsets were embedded
+		r.regular = append(append([]*opentype.Font(nil), r.bold[:len(r.bold)-1]...), goRegular)
+	}
+	return r, nil
+}
+
+// Covers reports whether one of the fonts has a glyph for every character of text.
+func (r *ShareCardRenderer) Covers(text string) bool {
+	style, err := newTextStyle(r.regular, cardMetaSize, 1, cardText)
+	if err != nil {
+		return false
+	}
+	for _, c := range text {
+		if _, ok := style.glyphFace(c); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Render implements content.ShareCardRenderer.
+func (r *ShareCardRenderer) Render(card content.ShareCard) ([]byte, error) {
+	meta, err := newTextStyle(r.regular, cardMetaSize, 1.4, cardMuted)
+	if err != nil {
+		return nil, err
+	}
+	badge, err := newTextStyle(r.bold, cardMetaSize, 1.4, cardBackground)
+	if err != nil {
+		return nil, err
+	}
+	title, err := newTextStyle(r.bold, cardCompanySize, 1.2, cardTitle)
+	if err != nil {
+		return nil, err
+	}
+	body, err := newTextStyle(r.regular, cardSummarySize, 1.5, cardText)
+	if err != nil {
+		return nil, err
+	}
+	footer, err := newTextStyle(r.regular, cardFooterSize, 1.4, cardMuted)
+	if err != nil {
+		return nil, err
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, content.ShareCardWidth, content.ShareCardHeight))
+	draw.Draw(img, img.Bounds(), image.NewUniform(cardBackground), image.Point{}, draw.Src)
+	draw.Draw(img, image.Rect(0, 0, cardAccentWidth, content.ShareCardHeight), image.NewUniform(cardAccent), image.Point{}, draw.Src)
+
+	left := cardPadding
+	right := content.ShareCardWidth - cardPadding
+	y := cardPadding + meta.ascent()
+
+	// City and date, with the resolution status as a badge on the right
+	metaText := card.City
+	if !card.Date.IsZero() {
+		metaText += " · " + card.Date.Format("2006-01-02")
+	}
+	metaRight := right
+	if card.Status != "" {
+		width := badge.measure(card.Status).Ceil() + 2*cardBadgePaddingX
+		box := image.Rect(right-width, y-meta.ascent()-cardBadgePaddingY, right, y+meta.descent()+cardBadgePaddingY)
+		draw.Draw(img, box, image.NewUniform(cardAccent), image.Point{}, draw.Src)
+		badge.draw(img, box.Min.X+cardBadgePaddingX, y, card.Status)
+		metaRight = box.Min.X - cardBadgePaddingX
+	}
+	meta.draw(img, left, y, truncateLine(meta, metaText, metaRight-left))
+
+	// Company name
+	y += meta.descent() + cardPadding/3
+	titleLines := wrapText(title, card.Company, right-left, cardCompanyLines)
+	for _, line := range titleLines {
+		y += title.lineHeight()
+		title.draw(img, left, y-title.descent(), line)
+	}
+
+	// Summary, in the lines left above the footer
+	y += cardPadding / 4
+	footerTop := content.ShareCardHeight - cardFooterHeight
+	summaryLines := min(cardSummaryLines, (footerTop-y)/body.lineHeight())
+	for _, line := range wrapText(body, card.Summary, right-left, summaryLines) {
+		y += body.lineHeight()
+		body.draw(img, left, y-body.descent(), line)
+	}
+
+	// Footer
+	draw.Draw(img, image.Rect(left, footerTop, right, footerTop+2), image.NewUniform(cardRule), image.Point{}, draw.Src)
+	footer.draw(img, left, footerTop+(cardFooterHeight+footer.ascent()-footer.descent())/2, card.SiteName)
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("failed to encode share card: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// textStyle draws text at one size and color with a font fallback chain.
+type textStyle struct {
+	faces       []font.Face
+	lineSpacing float64
+	color       color.Color
+}
+
+// newTextStyle creates the faces of the fonts at the given size (in pixels).
+// lineSpacing is the distance between wrapped lines relative to the font height.
+// Faces are not safe for concurrent use, so every render creates its own.
+func newTextStyle(fonts []*opentype.Font, size, lineSpacing float64, c color.Color) (*textStyle, error) {
+	style := &textStyle{lineSpacing: lineSpacing, color: c}
+	for _, f := range fonts {
+		face, err := opentype.NewFace(f, &opentype.FaceOptions{Size: size, DPI: 72, Hinting: font.HintingFull})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create font face: %w", err)
+		}
+		style.faces = append(style.faces, face)
+	}
+	return style, nil
+}
+
+// glyphFace returns the first face with a glyph for c, or the last face
+// (drawing its missing-glyph box) and false.
+func (s *textStyle) glyphFace(c rune) (font.Face, bool) {
+	for _, face := range s.faces {
+		if _, ok := face.GlyphAdvance(c); ok {
+			return face, true
+		}
+	}
+	return s.faces[len(s.faces)-1], false
+}
+
+// ascent returns the height above the baseline of the first (preferred) face.
+func (s *textStyle) ascent() int {
+	return s.faces[0].Metrics().Ascent.Ceil()
+}
+
+// descent returns the depth below the baseline of the first (preferred) face.
+func (s *textStyle) descent() int {
+	return s.faces[0].Metrics().Descent.Ceil()
+}
+
+// lineHeight returns the distance between baselines of wrapped lines.
+func (s *textStyle) lineHeight() int {
+	return int(float64(s.ascent()+s.descent()) * s.lineSpacing)
+}
+
+// advance returns the advance width of c.
+func (s *textStyle) advance(c rune) fixed.Int26_6 {
+	face, _ := s.glyphFace(c)
+	adv, _ := face.GlyphAdvance(c)
+	return adv
+}
+
+// measure returns the width of text.
+func (s *textStyle) measure(text string) fixed.Int26_6 {
+	var width fixed.Int26_6
+	for _, c := range text {
+		width += s.advance(c)
+	}
+	return width
+}
+
+// draw draws a line of text with its baseline at y.
+func (s *textStyle) draw(dst draw.Image, x, y int, text string) {
+	d := &font.Drawer{Dst: dst, Src: image.NewUniform(s.color), Dot: fixed.P(x, y)}
+	for _, c := range text {
+		d.Face, _ = s.glyphFace(c)
+		d.DrawString(string(c))
+	}
+}
+
+// wrapText breaks text into at most maxLines lines of at most width pixels.
+// CJK text breaks between any characters; other text breaks at spaces when
+// possible. The last line ends with an ellipsis if text is cut off.
+func wrapText(s *textStyle, text string, width, maxLines int) []string {
+	if maxLines <= 0 {
+		return nil
+	}
+	text = strings.Join(strings.Fields(text), " ")
+	limit := fixed.I(width)
+
+	var lines []string
+	var line []rune
+	var lineWidth fixed.Int26_6
+	for _, c := range text {
+		adv := s.advance(c)
+		if lineWidth+adv > limit && len(line) > 0 {
+			if len(lines) == maxLines-1 {
+				return append(lines, truncateLine(s, string(line)+string(c)+"…", width))
+			}
+			next := breakLine(line, c)
+			lines = append(lines, strings.TrimRight(string(line[:len(line)-len(next)]), " "))
+			line = next
+			lineWidth = s.measure(string(line))
+		}
+		if len(line) == 0 && c == ' ' {
+			continue
+		}
+		line = append(line, c)
+		lineWidth += adv
+	}
+	if len(line) > 0 {
+		lines = append(lines, string(line))
+	}
+	return lines
+}
+
+// breakLine returns the end of line that moves to the next line when c does
+// not fit: the last word if c continues a word, otherwise nothing.
+func breakLine(line []rune, c rune) []rune {
+	if !isWordRune(c) || !isWordRune(line[len(line)-1]) {
+		return nil
+	}
+	for i := len(line) - 1; i > 0; i-- {
+		if line[i] == ' ' {
+			return append([]rune(nil), line[i+1:]...)
+		}
+		if !isWordRune(line[i]) {
+			break
+		}
+	}
+	return nil
+}
+
+// isWordRune reports whether c is part of a word that should not be broken.
+func isWordRune(c rune) bool {
+	return c < unicode.MaxASCII && (unicode.IsLetter(c) || unicode.IsDigit(c))
+}
+
+// truncateLine shortens text to width pixels, ending with an ellipsis if it
+// was cut off.
+func truncateLine(s *textStyle, text string, width int) string {
+	limit := fixed.I(width)
+	if s.measure(text) <= limit {
+		return text
+	}
+
+	runes := []rune(strings.TrimSuffix(text, "…"))
+	ellipsis := s.advance('…')
+	var used fixed.Int26_6
+	for i, c := range runes {
+		adv := s.advance(c)
+		if used+adv+ellipsis > limit {
+			return strings.TrimRight(string(runes[:i]), " ") + "…"
+		}
+		used += adv
+	}
+	return string(runes) + "…"
+}
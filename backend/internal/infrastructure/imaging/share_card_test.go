@@ -0,0 +1,88 @@
+package imaging
+
+import (
+	"bytes"
+	"image/png"
+	"strings"
+	"testing"
+	"time"
+
+	"fuck_boss/backend/internal/application/content"
+)
+
+func TestShareCardRenderer_Render(t *testing.T) {
+	r, err := NewShareCardRenderer()
+	if err != nil {
+		t.Fatalf("NewShareCardRenderer() error = %v", err)
+	}
+
+	data, err := r.Render(content.ShareCard{
+		Company:  "某某科技有限公司 Example Technology Co., Ltd.",
+		City:     "北京",
+		Summary:  strings.Repeat("连续三个月拖欠工资，多次沟通无果。", 20),
+		Status:   "已解决",
+		Date:     time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC),
+		SiteName: "Fuck Boss",
+	})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Render() did not return a PNG: %v", err)
+	}
+	if b := img.Bounds(); b.Dx() != content.ShareCardWidth || b.Dy() != content.ShareCardHeight {
+		t.Errorf("Render() size = %dx%d, want %dx%d", b.Dx(), b.Dy(), content.ShareCardWidth, content.ShareCardHeight)
+	}
+
+	// The accent bar is drawn at the left edge
+	if got := cardAccent; img.At(0, content.ShareCardHeight/2) != got {
+		t.Errorf("Render() left edge = %v, want accent %v", img.At(0, content.ShareCardHeight/2), got)
+	}
+}
+
+func TestShareCardRenderer_Covers(t *testing.T) {
+	r, err := NewShareCardRenderer()
+	if err != nil {
+		t.Fatalf("NewShareCardRenderer() error = %v", err)
+	}
+
+	if !r.Covers("Example 2026") {
+		t.Error("Covers() = false for ASCII text, want true")
+	}
+	if !r.Covers("北京公司") {
+		t.Error("Covers() = false for Chinese text, want true (is a CJK font missing from fonts/?)")
+	}
+	if r.Covers("\U0010FFFD") {
+		t.Error("Covers() = true for a private use character, want false")
+	}
+}
+
+func TestWrapText(t *testing.T) {
+	r, err := NewShareCardRenderer()
+	if err != nil {
+		t.Fatalf("NewShareCardRenderer() error = %v", err)
+	}
+	style, err := newTextStyle(r.regular, 20, 1, cardText)
+	if err != nil {
+		t.Fatalf("newTextStyle() error = %v", err)
+	}
+
+	width := style.measure("hello world").Ceil() + 1
+	lines := wrapText(style, "hello world hello  world", width, 3)
+	if len(lines) != 2 || lines[0] != "hello world" || lines[1] != "hello world" {
+		t.Errorf("wrapText() = %q, want two \"hello world\" lines", lines)
+	}
+
+	// Words are not broken; the last allowed line is truncated with an ellipsis
+	lines = wrapText(style, "hello world hello world hello world", width, 2)
+	if len(lines) != 2 || lines[0] != "hello world" || !strings.HasSuffix(lines[1], "…") {
+		t.Errorf("wrapText() = %q, want the second line truncated", lines)
+	}
+	for _, line := range lines {
+		if style.measure(line).Ceil() > width {
+			t.Errorf("wrapText() line %q is wider than %d", line, width)
+		}
+	}
+}
@@ -197,6 +197,11 @@ func extractContextFields(ctx context.Context) []zap.Field {
 		fields = append(fields, zap.String("user_id", userID))
 	}
 
+	// Extract API key ID if available
+	if apiKeyID, ok := ctx.Value(APIKeyIDKey).(string); ok && apiKeyID != "" {
+		fields = append(fields, zap.String("api_key_id", apiKeyID))
+	}
+
 	return fields
 }
 
@@ -209,6 +214,12 @@ const TraceIDKey = "trace_id"
 // UserIDKey is the context key for user ID.
 const UserIDKey = "user_id"
 
+// APIKeyIDKey is the context key for the API key ID of privileged calls.
+const APIKeyIDKey = "api_key_id"
+
+// ClientIPKey is the context key for the resolved client address.
+const ClientIPKey = "client_ip"
+
 // WithRequestID adds request ID to context.
 func WithRequestID(ctx context.Context, requestID string) context.Context {
 	return context.WithValue(ctx, RequestIDKey, requestID)
@@ -223,3 +234,31 @@ func WithTraceID(ctx context.Context, traceID string) context.Context {
 func WithUserID(ctx context.Context, userID string) context.Context {
 	return context.WithValue(ctx, UserIDKey, userID)
 }
+
+// UserIDFromContext returns the user ID stored in context (empty if anonymous).
+func UserIDFromContext(ctx context.Context) string {
+	userID, _ := ctx.Value(UserIDKey).(string)
+	return userID
+}
+
+// WithAPIKeyID adds the ID of the API key that authenticated the call to context.
+func WithAPIKeyID(ctx context.Context, apiKeyID string) context.Context {
+	return context.WithValue(ctx, APIKeyIDKey, apiKeyID)
+}
+
+// APIKeyIDFromContext returns the API key ID stored in context (empty if none).
+func APIKeyIDFromContext(ctx context.Context) string {
+	apiKeyID, _ := ctx.Value(APIKeyIDKey).(string)
+	return apiKeyID
+}
+
+// WithClientIP adds the resolved client address to context.
+func WithClientIP(ctx context.Context, clientIP string) context.Context {
+	return context.WithValue(ctx, ClientIPKey, clientIP)
+}
+
+// ClientIPFromContext returns the client address stored in context (empty if unresolved).
+func ClientIPFromContext(ctx context.Context) string {
+	clientIP, _ := ctx.Value(ClientIPKey).(string)
+	return clientIP
+}
@@ -255,6 +255,11 @@ func TestExtractContextFields(t *testing.T) {
 			ctx:  WithUserID(context.Background(), "user-789"),
 			want: 1,
 		},
+		{
+			name: "with API key ID",
+			ctx:  WithAPIKeyID(context.Background(), "key-123"),
+			want: 1,
+		},
 		{
 			name: "with all context values",
 			ctx:  WithUserID(WithTraceID(WithRequestID(context.Background(), "req-123"), "trace-456"), "user-789"),
@@ -290,4 +295,21 @@ func TestContextHelpers(t *testing.T) {
 	if userID, ok := ctx.Value(UserIDKey).(string); !ok || userID != "user-789" {
 		t.Errorf("WithUserID() failed, got %v", userID)
 	}
+
+	// Test UserIDFromContext
+	if userID := UserIDFromContext(ctx); userID != "user-789" {
+		t.Errorf("UserIDFromContext() = %v, want user-789", userID)
+	}
+	if userID := UserIDFromContext(context.Background()); userID != "" {
+		t.Errorf("UserIDFromContext() on empty context = %v, want empty", userID)
+	}
+
+	// Test WithAPIKeyID and APIKeyIDFromContext
+	ctx = WithAPIKeyID(context.Background(), "key-123")
+	if apiKeyID := APIKeyIDFromContext(ctx); apiKeyID != "key-123" {
+		t.Errorf("APIKeyIDFromContext() = %v, want key-123", apiKeyID)
+	}
+	if apiKeyID := APIKeyIDFromContext(context.Background()); apiKeyID != "" {
+		t.Errorf("APIKeyIDFromContext() on empty context = %v, want empty", apiKeyID)
+	}
 }
@@ -0,0 +1,155 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+
+	"fuck_boss/backend/internal/domain/admin"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// APIKeyRepository is the PostgreSQL implementation of admin.APIKeyRepository.
+type APIKeyRepository struct {
+	// db is the database connection.
+	db *sql.DB
+}
+
+// NewAPIKeyRepository creates a new APIKeyRepository instance.
+func NewAPIKeyRepository(db *sql.DB) *APIKeyRepository {
+	return &APIKeyRepository{
+		db: db,
+	}
+}
+
+// apiKeyColumns are the columns scanned by scanAPIKey.
+const apiKeyColumns = `id, name, key_prefix, key_hash, scopes, expires_at, created_at, last_used_at, revoked_at`
+
+// Save saves an APIKey to the database.
+// If the key already exists (same ID), only its revocation state is updated.
+func (r *APIKeyRepository) Save(ctx context.Context, key *admin.APIKey) error {
+	scopes := make([]string, 0, len(key.Scopes()))
+	for _, scope := range key.Scopes() {
+		scopes = append(scopes, scope.String())
+	}
+
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO api_keys (id, name, key_prefix, key_hash, scopes, expires_at, created_at, last_used_at, revoked_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (id) DO UPDATE SET
+			revoked_at = EXCLUDED.revoked_at
+	`,
+		key.ID(),
+		key.Name(),
+		key.Prefix(),
+		key.KeyHash(),
+		pq.Array(scopes),
+		key.ExpiresAt(),
+		key.CreatedAt(),
+		key.LastUsedAt(),
+		key.RevokedAt(),
+	)
+	if err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to save API key", err)
+	}
+	return nil
+}
+
+// FindByID finds an APIKey by its ID.
+func (r *APIKeyRepository) FindByID(ctx context.Context, id string) (*admin.APIKey, error) {
+	row := r.db.QueryRowContext(ctx, `SELECT `+apiKeyColumns+` FROM api_keys WHERE id = $1`, id)
+	return scanAPIKey(row)
+}
+
+// FindByHash finds an APIKey by the SHA-256 hash of its raw value.
+func (r *APIKeyRepository) FindByHash(ctx context.Context, keyHash string) (*admin.APIKey, error) {
+	row := r.db.QueryRowContext(ctx, `SELECT `+apiKeyColumns+` FROM api_keys WHERE key_hash = $1`, keyHash)
+	return scanAPIKey(row)
+}
+
+// FindAll returns all API keys, newest first.
+func (r *APIKeyRepository) FindAll(ctx context.Context) ([]*admin.APIKey, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT `+apiKeyColumns+` FROM api_keys ORDER BY created_at DESC, id`)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to find API keys", err)
+	}
+	defer rows.Close()
+
+	var keys []*admin.APIKey
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to iterate API keys", err)
+	}
+
+	return keys, nil
+}
+
+// TouchLastUsed sets the last-used time of a key. An older time never
+// overwrites a newer one, so concurrent calls cannot move it backwards.
+func (r *APIKeyRepository) TouchLastUsed(ctx context.Context, id string, at time.Time) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE api_keys
+		SET last_used_at = $2
+		WHERE id = $1 AND (last_used_at IS NULL OR last_used_at < $2)
+	`, id, at)
+	if err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to update API key last used time", err)
+	}
+	return nil
+}
+
+// rowScanner is implemented by both *sql.Row and *sql.Rows.
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanAPIKey scans one api_keys row (apiKeyColumns).
+func scanAPIKey(row rowScanner) (*admin.APIKey, error) {
+	var (
+		id         string
+		name       string
+		prefix     string
+		keyHash    string
+		scopes     []string
+		expiresAt  sql.NullTime
+		createdAt  time.Time
+		lastUsedAt sql.NullTime
+		revokedAt  sql.NullTime
+	)
+
+	err := row.Scan(&id, &name, &prefix, &keyHash, pq.Array(&scopes), &expiresAt, &createdAt, &lastUsedAt, &revokedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, apperrors.NewNotFoundError("API key")
+		}
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to find API key", err)
+	}
+
+	scopeVOs := make([]admin.Scope, 0, len(scopes))
+	for _, value := range scopes {
+		scope, err := admin.NewScope(value)
+		if err != nil {
+			return nil, apperrors.NewDatabaseErrorWithCause("invalid API key scope in database", err)
+		}
+		scopeVOs = append(scopeVOs, scope)
+	}
+
+	return admin.NewAPIKeyFromDB(id, name, prefix, keyHash, scopeVOs,
+		nullTimePtr(expiresAt), createdAt, nullTimePtr(lastUsedAt), nullTimePtr(revokedAt)), nil
+}
+
+// nullTimePtr converts a sql.NullTime to a *time.Time (nil if NULL).
+func nullTimePtr(value sql.NullTime) *time.Time {
+	if !value.Valid {
+		return nil
+	}
+	return &value.Time
+}
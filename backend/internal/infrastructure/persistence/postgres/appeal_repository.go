@@ -0,0 +1,214 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	"fuck_boss/backend/internal/domain/content"
+	"fuck_boss/backend/internal/domain/moderation"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// AppealRepository is the PostgreSQL implementation of moderation.AppealRepository.
+type AppealRepository struct {
+	// db is the database connection.
+	db *sql.DB
+}
+
+// NewAppealRepository creates a new AppealRepository instance.
+func NewAppealRepository(db *sql.DB) *AppealRepository {
+	return &AppealRepository{
+		db: db,
+	}
+}
+
+// appealColumns are the columns scanned by scanAppeal.
+const appealColumns = `a.id, a.post_id, a.appealed_status, a.acted_by, a.statement, a.status,
+	a.decided_by, a.decision_reason, a.filed_at, a.decided_at`
+
+// Save inserts a new Appeal. Decisions are stored with SaveDecision.
+// Returns a CONFLICT error if the post already has a pending appeal.
+func (r *AppealRepository) Save(ctx context.Context, appeal *moderation.Appeal) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO appeals (id, post_id, appealed_status, acted_by, statement, status,
+			decided_by, decision_reason, filed_at, decided_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`,
+		appeal.ID(),
+		appeal.PostID().String(),
+		appeal.AppealedStatus().String(),
+		nullString(appeal.ActedBy()),
+		appeal.Statement(),
+		appeal.Status().String(),
+		nullString(appeal.DecidedBy()),
+		nullString(appeal.DecisionReason()),
+		appeal.FiledAt(),
+		appeal.DecidedAt(),
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return apperrors.NewConflictError("the post already has a pending appeal")
+		}
+		return apperrors.NewDatabaseErrorWithCause("failed to save appeal", err)
+	}
+	return nil
+}
+
+// SaveDecision stores the decision of an Appeal and, if restored is not nil,
+// the moderation state of the restored post in one transaction.
+// The decision is only stored if the appeal is still pending, so two concurrent
+// decisions cannot both succeed; the loser gets a conflict error and the post
+// is left alone.
+func (r *AppealRepository) SaveDecision(ctx context.Context, appeal *moderation.Appeal, restored *content.Post) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to begin transaction", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE appeals
+		SET status = $2, decided_by = $3, decision_reason = $4, decided_at = $5
+		WHERE id = $1 AND status = 'PENDING'
+	`, appeal.ID(), appeal.Status().String(), nullString(appeal.DecidedBy()),
+		nullString(appeal.DecisionReason()), appeal.DecidedAt())
+	if err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to save appeal decision", err)
+	}
+	if affected, err := result.RowsAffected(); err == nil && affected == 0 {
+		return apperrors.NewConflictError("appeal has already been decided")
+	}
+
+	if restored != nil {
+		if err := savePostModeration(ctx, tx, restored); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to commit appeal decision", err)
+	}
+
+	return nil
+}
+
+// FindByID finds an Appeal by its ID.
+func (r *AppealRepository) FindByID(ctx context.Context, id string) (*moderation.Appeal, error) {
+	row := r.db.QueryRowContext(ctx, `SELECT `+appealColumns+` FROM appeals a WHERE a.id = $1`, id)
+	return scanAppeal(row)
+}
+
+// FindLatestByPostID finds the most recently filed Appeal of a post.
+func (r *AppealRepository) FindLatestByPostID(ctx context.Context, postID content.PostID) (*moderation.Appeal, error) {
+	row := r.db.QueryRowContext(ctx, `
+		SELECT `+appealColumns+`
+		FROM appeals a
+		WHERE a.post_id = $1
+		ORDER BY a.filed_at DESC, a.id DESC
+		LIMIT 1
+	`, postID.String())
+	return scanAppeal(row)
+}
+
+// FindPending finds pending Appeals, the longest-waiting first, leaving out
+// appeals of actions taken by excludeActedBy and, if cityCodes is not empty,
+// appeals of posts from other cities.
+func (r *AppealRepository) FindPending(ctx context.Context, excludeActedBy string, cityCodes []string, page, pageSize int) ([]*moderation.Appeal, int, error) {
+	// Validate pagination parameters
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	offset := (page - 1) * pageSize
+
+	where := "a.status = 'PENDING' AND (a.acted_by IS NULL OR a.acted_by <> $1)"
+	args := []interface{}{excludeActedBy}
+	if len(cityCodes) > 0 {
+		where += " AND p.city_code = ANY($2)"
+		args = append(args, pq.Array(cityCodes))
+	}
+	from := "appeals a JOIN posts p ON p.id = a.post_id"
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM %s
+		WHERE %s
+		ORDER BY a.filed_at ASC, a.id ASC
+		LIMIT $%d OFFSET $%d
+	`, appealColumns, from, where, len(args)+1, len(args)+2)
+
+	rows, err := r.db.QueryContext(ctx, query, append(args, pageSize, offset)...)
+	if err != nil {
+		return nil, 0, apperrors.NewDatabaseErrorWithCause("failed to find pending appeals", err)
+	}
+	defer rows.Close()
+
+	var appeals []*moderation.Appeal
+	for rows.Next() {
+		appeal, err := scanAppeal(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		appeals = append(appeals, appeal)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, apperrors.NewDatabaseErrorWithCause("failed to iterate appeals", err)
+	}
+
+	// Query for total count
+	var total int
+	err = r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM `+from+` WHERE `+where, args...).Scan(&total)
+	if err != nil {
+		return nil, 0, apperrors.NewDatabaseErrorWithCause("failed to count appeals", err)
+	}
+
+	return appeals, total, nil
+}
+
+// scanAppeal scans one appeals row (appealColumns).
+func scanAppeal(row rowScanner) (*moderation.Appeal, error) {
+	var (
+		id             string
+		postID         string
+		appealedStatus string
+		actedBy        sql.NullString
+		statement      string
+		status         string
+		decidedBy      sql.NullString
+		decisionReason sql.NullString
+		filedAt        time.Time
+		decidedAt      sql.NullTime
+	)
+
+	err := row.Scan(&id, &postID, &appealedStatus, &actedBy, &statement, &status,
+		&decidedBy, &decisionReason, &filedAt, &decidedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, apperrors.NewNotFoundError("appeal")
+		}
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to find appeal", err)
+	}
+
+	postIDVO, err := content.NewPostID(postID)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("invalid post ID in database", err)
+	}
+	appealedStatusVO, err := content.NewModerationStatus(appealedStatus)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("invalid appealed status in database", err)
+	}
+	statusVO, err := moderation.NewAppealStatus(status)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("invalid appeal status in database", err)
+	}
+
+	return moderation.NewAppealFromDB(id, postIDVO, appealedStatusVO, actedBy.String, statement, statusVO,
+		decidedBy.String, decisionReason.String, filedAt, nullTimePtr(decidedAt)), nil
+}
@@ -0,0 +1,161 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"fuck_boss/backend/internal/domain/attachment"
+	"fuck_boss/backend/internal/domain/content"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// AttachmentRepository is the PostgreSQL implementation of attachment.AttachmentRepository.
+type AttachmentRepository struct {
+	// db is the database connection.
+	db *sql.DB
+}
+
+// NewAttachmentRepository creates a new AttachmentRepository instance.
+func NewAttachmentRepository(db *sql.DB) *AttachmentRepository {
+	return &AttachmentRepository{
+		db: db,
+	}
+}
+
+// Save stores the metadata of a newly uploaded attachment.
+func (r *AttachmentRepository) Save(ctx context.Context, a *attachment.Attachment) error {
+	query := `
+		INSERT INTO attachments (id, post_id, content_type, size_bytes, checksum, storage_key,
+			width, height, thumbnail_content_type, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		a.ID(),
+		a.PostID().String(),
+		a.ContentType().String(),
+		a.Size(),
+		a.Checksum(),
+		a.StorageKey(),
+		a.Width(),
+		a.Height(),
+		a.ThumbnailType().String(),
+		a.CreatedAt(),
+	)
+	if err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to save attachment", err)
+	}
+
+	return nil
+}
+
+// FindByID returns the attachment with the given ID.
+func (r *AttachmentRepository) FindByID(ctx context.Context, id string) (*attachment.Attachment, error) {
+	query := `
+		SELECT id, post_id, content_type, size_bytes, checksum, width, height, thumbnail_content_type, created_at
+		FROM attachments
+		WHERE id = $1
+	`
+
+	a, err := scanAttachment(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewNotFoundError("attachment")
+		}
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// FindByPost returns the attachments of a post, oldest first.
+func (r *AttachmentRepository) FindByPost(ctx context.Context, postID content.PostID) ([]*attachment.Attachment, error) {
+	query := `
+		SELECT id, post_id, content_type, size_bytes, checksum, width, height, thumbnail_content_type, created_at
+		FROM attachments
+		WHERE post_id = $1
+		ORDER BY created_at ASC, id ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, postID.String())
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to find attachments", err)
+	}
+	defer rows.Close()
+
+	var attachments []*attachment.Attachment
+	for rows.Next() {
+		a, err := scanAttachment(rows)
+		if err != nil {
+			return nil, err
+		}
+		attachments = append(attachments, a)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to iterate attachments", err)
+	}
+
+	return attachments, nil
+}
+
+// CountByPost returns the number of attachments of a post.
+func (r *AttachmentRepository) CountByPost(ctx context.Context, postID content.PostID) (int, error) {
+	var count int
+	err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM attachments WHERE post_id = $1`, postID.String()).Scan(&count)
+	if err != nil {
+		return 0, apperrors.NewDatabaseErrorWithCause("failed to count attachments", err)
+	}
+
+	return count, nil
+}
+
+// attachmentScanner is implemented by *sql.Row and *sql.Rows.
+type attachmentScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanAttachment reads one attachment row.
+// sql.ErrNoRows is returned unchanged so callers can map it to NOT_FOUND.
+func scanAttachment(row attachmentScanner) (*attachment.Attachment, error) {
+	var (
+		id            string
+		postID        string
+		contentType   string
+		size          int64
+		checksum      string
+		width         int
+		height        int
+		thumbnailType string
+		createdAt     time.Time
+	)
+
+	if err := row.Scan(&id, &postID, &contentType, &size, &checksum, &width, &height, &thumbnailType, &createdAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to scan attachment", err)
+	}
+
+	postIDVO, err := content.NewPostID(postID)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("invalid post ID in database", err)
+	}
+
+	contentTypeVO, err := attachment.NewContentType(contentType)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("invalid content type in database", err)
+	}
+
+	// Documents (and images stored before thumbnails existed) have no thumbnail
+	var thumbnailTypeVO attachment.ContentType
+	if thumbnailType != "" {
+		if thumbnailTypeVO, err = attachment.NewContentType(thumbnailType); err != nil {
+			return nil, apperrors.NewDatabaseErrorWithCause("invalid thumbnail content type in database", err)
+		}
+	}
+
+	return attachment.NewAttachmentFromDB(id, postIDVO, contentTypeVO, size, checksum, width, height, thumbnailTypeVO, createdAt), nil
+}
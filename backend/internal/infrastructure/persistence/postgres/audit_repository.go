@@ -0,0 +1,131 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"fuck_boss/backend/internal/domain/admin"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// AuditRepository is the PostgreSQL implementation of admin.AuditRepository.
+// The audit_log table rejects updates and deletes (see migration 000013), so
+// this repository only inserts and reads.
+type AuditRepository struct {
+	// db is the database connection.
+	db *sql.DB
+}
+
+// NewAuditRepository creates a new AuditRepository instance.
+func NewAuditRepository(db *sql.DB) *AuditRepository {
+	return &AuditRepository{
+		db: db,
+	}
+}
+
+// Append stores a new audit event.
+func (r *AuditRepository) Append(ctx context.Context, event *admin.AuditEvent) error {
+	var details sql.NullString
+	if event.Details() != "" {
+		details = sql.NullString{String: event.Details(), Valid: true}
+	}
+
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO audit_log (id, occurred_at, api_key_id, actor, action, target, outcome, client_ip, details)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9::jsonb)
+	`,
+		event.ID(),
+		event.OccurredAt(),
+		nullString(event.APIKeyID()),
+		event.Actor(),
+		event.Action(),
+		nullString(event.Target()),
+		event.Outcome(),
+		nullString(event.ClientIP()),
+		details,
+	)
+	if err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to append audit event", err)
+	}
+	return nil
+}
+
+// Find finds audit events matching the filter, newest first.
+func (r *AuditRepository) Find(ctx context.Context, filter admin.AuditFilter, after *admin.AuditCursor, limit int) ([]*admin.AuditEvent, error) {
+	if limit < 1 {
+		limit = 50
+	}
+
+	conditions := []string{"TRUE"}
+	var args []interface{}
+
+	if filter.APIKeyID != "" {
+		args = append(args, filter.APIKeyID)
+		conditions = append(conditions, fmt.Sprintf("api_key_id = $%d", len(args)))
+	}
+	if filter.Action != "" {
+		args = append(args, filter.Action)
+		conditions = append(conditions, fmt.Sprintf("action = $%d", len(args)))
+	}
+	if filter.Target != "" {
+		args = append(args, filter.Target)
+		conditions = append(conditions, fmt.Sprintf("target = $%d", len(args)))
+	}
+	if filter.Since != nil {
+		args = append(args, *filter.Since)
+		conditions = append(conditions, fmt.Sprintf("occurred_at >= $%d", len(args)))
+	}
+	if filter.Until != nil {
+		args = append(args, *filter.Until)
+		conditions = append(conditions, fmt.Sprintf("occurred_at < $%d", len(args)))
+	}
+	if after != nil {
+		args = append(args, after.OccurredAt(), after.ID())
+		conditions = append(conditions, fmt.Sprintf("(occurred_at, id) < ($%d, $%d)", len(args)-1, len(args)))
+	}
+
+	args = append(args, limit)
+	query := fmt.Sprintf(`
+		SELECT id, occurred_at, api_key_id, actor, action, target, outcome, client_ip, details
+		FROM audit_log
+		WHERE %s
+		ORDER BY occurred_at DESC, id DESC
+		LIMIT $%d
+	`, strings.Join(conditions, " AND "), len(args))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to find audit events", err)
+	}
+	defer rows.Close()
+
+	var events []*admin.AuditEvent
+	for rows.Next() {
+		var (
+			id         string
+			occurredAt time.Time
+			apiKeyID   sql.NullString
+			actor      string
+			action     string
+			target     sql.NullString
+			outcome    string
+			clientIP   sql.NullString
+			details    sql.NullString
+		)
+
+		if err := rows.Scan(&id, &occurredAt, &apiKeyID, &actor, &action, &target, &outcome, &clientIP, &details); err != nil {
+			return nil, apperrors.NewDatabaseErrorWithCause("failed to scan audit event", err)
+		}
+
+		events = append(events, admin.NewAuditEventFromDB(id, occurredAt, apiKeyID.String, actor, action,
+			target.String, outcome, clientIP.String, details.String))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to iterate audit events", err)
+	}
+
+	return events, nil
+}
@@ -0,0 +1,107 @@
+// Package postgres provides PostgreSQL implementation of domain repositories.
+// It implements the PostRepository interface defined in the Domain Layer.
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"fuck_boss/backend/internal/domain/company"
+	"fuck_boss/backend/internal/domain/content"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// CompanyMergeRepository is the PostgreSQL implementation of company.CompanyMergeRepository.
+type CompanyMergeRepository struct {
+	// db is the database connection.
+	db *sql.DB
+}
+
+// NewCompanyMergeRepository creates a new CompanyMergeRepository instance.
+func NewCompanyMergeRepository(db *sql.DB) *CompanyMergeRepository {
+	return &CompanyMergeRepository{
+		db: db,
+	}
+}
+
+// Merge renames the company from to into in posts, company_representatives,
+// company_watches and company_domains in one transaction. Renamed posts get a
+// new updated_at, so the sitemap picks up the change.
+func (r *CompanyMergeRepository) Merge(ctx context.Context, from, into content.CompanyName) (*company.MergeResult, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to begin transaction", err)
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+	result := &company.MergeResult{}
+
+	result.Posts, err = execCount(ctx, tx, `
+		UPDATE posts SET company_name = $2, company_canonical = $4, updated_at = $3 WHERE company_name = $1
+	`, from.String(), into.String(), now, into.Canonical())
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to merge posts", err)
+	}
+
+	result.Representatives, err = execCount(ctx, tx, `
+		UPDATE company_representatives SET company_name = $2, updated_at = $3 WHERE company_name = $1
+	`, from.String(), into.String(), now)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to merge representatives", err)
+	}
+
+	// Owners watching both companies keep their watch of the target
+	dropped, err := execCount(ctx, tx, `
+		DELETE FROM company_watches w
+		WHERE w.company_name = $1
+			AND EXISTS (SELECT 1 FROM company_watches t WHERE t.owner_key = w.owner_key AND t.company_name = $2)
+	`, from.String(), into.String())
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to merge watches", err)
+	}
+	moved, err := execCount(ctx, tx, `
+		UPDATE company_watches SET company_name = $2 WHERE company_name = $1
+	`, from.String(), into.String())
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to merge watches", err)
+	}
+	result.Watches = dropped + moved
+
+	// Domains already approved for the target are kept once
+	dropped, err = execCount(ctx, tx, `
+		DELETE FROM company_domains d
+		WHERE d.company_name = $1
+			AND EXISTS (SELECT 1 FROM company_domains t WHERE t.company_name = $2 AND t.domain = d.domain)
+	`, from.String(), into.String())
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to merge company domains", err)
+	}
+	moved, err = execCount(ctx, tx, `
+		UPDATE company_domains SET company_name = $2 WHERE company_name = $1
+	`, from.String(), into.String())
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to merge company domains", err)
+	}
+	result.Domains = dropped + moved
+
+	if err := tx.Commit(); err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to commit company merge", err)
+	}
+
+	return result, nil
+}
+
+// execCount executes a statement in the transaction and returns the number of affected rows.
+func execCount(ctx context.Context, tx *sql.Tx, query string, args ...interface{}) (int, error) {
+	res, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(affected), nil
+}
@@ -0,0 +1,102 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"fuck_boss/backend/internal/domain/company"
+	"fuck_boss/backend/internal/domain/content"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// DomainApprovalRepository is the PostgreSQL implementation of company.DomainApprovalRepository.
+type DomainApprovalRepository struct {
+	// db is the database connection.
+	db *sql.DB
+}
+
+// NewDomainApprovalRepository creates a new DomainApprovalRepository instance.
+func NewDomainApprovalRepository(db *sql.DB) *DomainApprovalRepository {
+	return &DomainApprovalRepository{
+		db: db,
+	}
+}
+
+// Save saves a DomainApproval to the database.
+// Approving an already approved domain keeps the original approval time.
+func (r *DomainApprovalRepository) Save(ctx context.Context, approval *company.DomainApproval) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO company_domains (company_name, domain, approved_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (company_name, domain) DO NOTHING
+	`, approval.Company().String(), approval.Domain().String(), approval.ApprovedAt())
+	if err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to save company domain", err)
+	}
+	return nil
+}
+
+// IsApproved reports whether the domain is approved for the company.
+func (r *DomainApprovalRepository) IsApproved(ctx context.Context, companyName content.CompanyName, domain company.CompanyDomain) (bool, error) {
+	var approved bool
+	err := r.db.QueryRowContext(ctx, `
+		SELECT EXISTS (SELECT 1 FROM company_domains WHERE company_name = $1 AND domain = $2)
+	`, companyName.String(), domain.String()).Scan(&approved)
+	if err != nil {
+		return false, apperrors.NewDatabaseErrorWithCause("failed to query company domain", err)
+	}
+	return approved, nil
+}
+
+// FindAll returns all approvals, ordered by company name and domain.
+func (r *DomainApprovalRepository) FindAll(ctx context.Context) ([]*company.DomainApproval, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT company_name, domain, approved_at FROM company_domains ORDER BY company_name, domain
+	`)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to list company domains", err)
+	}
+	defer rows.Close()
+
+	var approvals []*company.DomainApproval
+	for rows.Next() {
+		var (
+			companyName string
+			domain      string
+			approvedAt  time.Time
+		)
+		if err := rows.Scan(&companyName, &domain, &approvedAt); err != nil {
+			return nil, apperrors.NewDatabaseErrorWithCause("failed to scan company domain", err)
+		}
+
+		companyVO, err := content.NewCompanyName(companyName)
+		if err != nil {
+			return nil, apperrors.NewDatabaseErrorWithCause("invalid company name in database", err)
+		}
+		domainVO, err := company.NewCompanyDomain(domain)
+		if err != nil {
+			return nil, apperrors.NewDatabaseErrorWithCause("invalid company domain in database", err)
+		}
+		approvals = append(approvals, company.NewDomainApprovalFromDB(companyVO, domainVO, approvedAt))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to iterate company domains", err)
+	}
+
+	return approvals, nil
+}
+
+// Delete revokes the approval of the domain for the company.
+func (r *DomainApprovalRepository) Delete(ctx context.Context, companyName content.CompanyName, domain company.CompanyDomain) error {
+	result, err := r.db.ExecContext(ctx, `
+		DELETE FROM company_domains WHERE company_name = $1 AND domain = $2
+	`, companyName.String(), domain.String())
+	if err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to delete company domain", err)
+	}
+	if affected, err := result.RowsAffected(); err == nil && affected == 0 {
+		return apperrors.NewNotFoundError("company domain")
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"fuck_boss/backend/internal/domain/content"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// EngagementRepository is the PostgreSQL implementation of content.EngagementRepository.
+type EngagementRepository struct {
+	// db is the database connection.
+	db *sql.DB
+}
+
+// NewEngagementRepository creates a new EngagementRepository instance.
+func NewEngagementRepository(db *sql.DB) *EngagementRepository {
+	return &EngagementRepository{
+		db: db,
+	}
+}
+
+// SaveDailyViews stores the unique view counts of posts on a day in one transaction.
+// Views of posts that no longer exist are skipped.
+func (r *EngagementRepository) SaveDailyViews(ctx context.Context, day time.Time, views map[content.PostID]int64) error {
+	if len(views) == 0 {
+		return nil
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to begin transaction", err)
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.PrepareContext(ctx, `
+		INSERT INTO post_daily_views (post_id, day, views)
+		SELECT $1, $2, $3
+		WHERE EXISTS (SELECT 1 FROM posts WHERE id = $1)
+		ON CONFLICT (post_id, day) DO UPDATE SET views = GREATEST(post_daily_views.views, EXCLUDED.views)
+	`)
+	if err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to prepare daily views upsert", err)
+	}
+	defer stmt.Close()
+
+	date := day.UTC().Format("2006-01-02")
+	for postID, count := range views {
+		if _, err := stmt.ExecContext(ctx, postID.String(), date, count); err != nil {
+			return apperrors.NewDatabaseErrorWithCause("failed to save daily views", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to commit daily views", err)
+	}
+
+	return nil
+}
+
+// FindEngagementSince returns the views, follow-ups and official replies of all
+// visible posts created after since.
+func (r *EngagementRepository) FindEngagementSince(ctx context.Context, since time.Time) ([]*content.PostEngagement, error) {
+	query := `
+		SELECT p.id, p.city_code, p.created_at,
+			COALESCE((SELECT SUM(v.views) FROM post_daily_views v WHERE v.post_id = p.id), 0),
+			(SELECT COUNT(*) FROM post_follow_ups f WHERE f.post_id = p.id),
+			(SELECT COUNT(*) FROM post_official_replies o WHERE o.post_id = p.id)
+		FROM posts p
+		WHERE p.created_at > $1 AND p.moderation_status = 'VISIBLE'
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, since)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to find post engagement", err)
+	}
+	defer rows.Close()
+
+	var engagements []*content.PostEngagement
+	for rows.Next() {
+		var (
+			id              string
+			cityCode        string
+			createdAt       time.Time
+			views           int64
+			followUps       int
+			officialReplies int
+		)
+
+		if err := rows.Scan(&id, &cityCode, &createdAt, &views, &followUps, &officialReplies); err != nil {
+			return nil, apperrors.NewDatabaseErrorWithCause("failed to scan post engagement", err)
+		}
+
+		postID, err := content.NewPostID(id)
+		if err != nil {
+			return nil, apperrors.NewDatabaseErrorWithCause("invalid post ID in database", err)
+		}
+
+		engagements = append(engagements, content.NewPostEngagementFromDB(postID, cityCode, createdAt, views, followUps, officialReplies))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to iterate post engagement", err)
+	}
+
+	return engagements, nil
+}
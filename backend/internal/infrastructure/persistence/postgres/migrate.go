@@ -0,0 +1,85 @@
+// Package postgres provides PostgreSQL implementation of domain repositories.
+// It implements the PostRepository interface defined in the Domain Layer.
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"sort"
+
+	"fuck_boss/backend/internal/domain/content"
+)
+
+// migrationFiles holds the embedded up migrations.
+//
+//go:embed migrations/*.up.sql
+var migrationFiles embed.FS
+
+// Migrate applies all embedded up migrations in version order.
+// Every migration is written to be idempotent (IF NOT EXISTS), so Migrate is
+// safe to run on each startup. The same files can be applied with golang-migrate.
+// Afterwards the canonical company names the SQL migrations cannot compute are
+// filled in (see backfillCompanyCanonical).
+func Migrate(ctx context.Context, db *sql.DB) error {
+	names, err := fs.Glob(migrationFiles, "migrations/*.up.sql")
+	if err != nil {
+		return fmt.Errorf("failed to list migrations: %w", err)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		script, err := migrationFiles.ReadFile(name)
+		if err != nil {
+			return fmt.Errorf("failed to read migration %s: %w", name, err)
+		}
+		if _, err := db.ExecContext(ctx, string(script)); err != nil {
+			return fmt.Errorf("failed to apply migration %s: %w", name, err)
+		}
+	}
+
+	return backfillCompanyCanonical(ctx, db)
+}
+
+// backfillCompanyCanonical sets posts.company_canonical for posts stored
+// before the column existed. The canonical form is computed in Go
+// (CompanyName.Canonical), so it is filled in per distinct company name.
+// Names that are not valid company names are left NULL.
+func backfillCompanyCanonical(ctx context.Context, db *sql.DB) error {
+	rows, err := db.QueryContext(ctx, `
+		SELECT DISTINCT company_name FROM posts WHERE company_canonical IS NULL
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to find posts without canonical company: %w", err)
+	}
+	var names []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan company name: %w", err)
+		}
+		names = append(names, name)
+	}
+	if err := rows.Err(); err != nil {
+		rows.Close()
+		return fmt.Errorf("failed to find posts without canonical company: %w", err)
+	}
+	rows.Close()
+
+	for _, name := range names {
+		company, err := content.NewCompanyName(name)
+		if err != nil {
+			continue
+		}
+		if _, err := db.ExecContext(ctx, `
+			UPDATE posts SET company_canonical = $2 WHERE company_name = $1 AND company_canonical IS NULL
+		`, name, company.Canonical()); err != nil {
+			return fmt.Errorf("failed to backfill canonical company %q: %w", name, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,10 @@
+-- Migration: Remove author follow-ups from posts
+-- Version: 000002
+-- Description: Rollback migration - drop post_follow_ups table and follow-up columns on posts
+
+DROP INDEX IF EXISTS idx_post_follow_ups_post_id;
+DROP TABLE IF EXISTS post_follow_ups;
+
+DROP INDEX IF EXISTS idx_posts_resolution_status;
+ALTER TABLE posts DROP COLUMN IF EXISTS resolution_status;
+ALTER TABLE posts DROP COLUMN IF EXISTS management_token_hash;
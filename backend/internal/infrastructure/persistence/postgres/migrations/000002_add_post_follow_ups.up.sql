@@ -0,0 +1,34 @@
+-- Migration: Add author follow-ups to posts
+-- Version: 000002
+-- Description: Store the management token hash and resolution status on posts,
+-- and create post_follow_ups table for the author's dated follow-up timeline
+
+-- Add management token hash and resolution status to posts
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS management_token_hash VARCHAR(64);
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS resolution_status VARCHAR(20);
+
+-- Index for resolution status (used in ListPosts filtering)
+CREATE INDEX IF NOT EXISTS idx_posts_resolution_status ON posts(resolution_status);
+
+-- Create post_follow_ups table
+CREATE TABLE IF NOT EXISTS post_follow_ups (
+    id UUID PRIMARY KEY,
+    post_id UUID NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL,
+    note TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Index for loading a post's timeline in order
+CREATE INDEX IF NOT EXISTS idx_post_follow_ups_post_id ON post_follow_ups(post_id, created_at);
+
+COMMENT ON TABLE post_follow_ups IS 'Stores dated follow-up entries appended by post authors';
+
+COMMENT ON COLUMN posts.management_token_hash IS 'SHA-256 hash of the author management token';
+COMMENT ON COLUMN posts.resolution_status IS 'Status of the latest follow-up (ONGOING, ESCALATED, RESOLVED)';
+
+COMMENT ON COLUMN post_follow_ups.id IS 'Unique identifier (UUID)';
+COMMENT ON COLUMN post_follow_ups.post_id IS 'Post the follow-up belongs to';
+COMMENT ON COLUMN post_follow_ups.status IS 'Resolution status tag (ONGOING, ESCALATED, RESOLVED)';
+COMMENT ON COLUMN post_follow_ups.note IS 'Follow-up text (2-2000 characters)';
+COMMENT ON COLUMN post_follow_ups.created_at IS 'When the follow-up was appended';
@@ -0,0 +1,8 @@
+-- Migration: Remove company representatives and official replies
+-- Version: 000003
+-- Description: Rollback migration - drop post_official_replies and company_representatives tables
+
+DROP TABLE IF EXISTS post_official_replies;
+
+DROP INDEX IF EXISTS idx_company_representatives_company_name;
+DROP TABLE IF EXISTS company_representatives;
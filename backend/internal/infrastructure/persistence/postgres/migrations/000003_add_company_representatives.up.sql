@@ -0,0 +1,48 @@
+-- Migration: Add company representatives and official replies
+-- Version: 000003
+-- Description: Create company_representatives table for domain-verified company representatives,
+-- and post_official_replies table for the one official reply a company may attach to a post
+
+-- Create company_representatives table
+CREATE TABLE IF NOT EXISTS company_representatives (
+    id UUID PRIMARY KEY,
+    company_name VARCHAR(100) NOT NULL,
+    domain VARCHAR(253) NOT NULL,
+    challenge_method VARCHAR(20) NOT NULL,
+    challenge_code VARCHAR(64) NOT NULL,
+    access_token_hash VARCHAR(64) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    verified_at TIMESTAMP
+);
+
+-- Index for company name (used to look up a company's representatives)
+CREATE INDEX IF NOT EXISTS idx_company_representatives_company_name ON company_representatives(company_name);
+
+-- Create post_official_replies table (at most one reply per post)
+CREATE TABLE IF NOT EXISTS post_official_replies (
+    post_id UUID PRIMARY KEY REFERENCES posts(id) ON DELETE CASCADE,
+    id UUID NOT NULL UNIQUE,
+    representative_id UUID NOT NULL REFERENCES company_representatives(id),
+    verified_domain VARCHAR(253) NOT NULL,
+    content TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON TABLE company_representatives IS 'Stores company representatives and their domain ownership challenges';
+COMMENT ON TABLE post_official_replies IS 'Stores the official reply of a verified company representative to a post';
+
+COMMENT ON COLUMN company_representatives.id IS 'Unique identifier (UUID)';
+COMMENT ON COLUMN company_representatives.company_name IS 'Company name as it appears in posts';
+COMMENT ON COLUMN company_representatives.domain IS 'Company domain being verified';
+COMMENT ON COLUMN company_representatives.challenge_method IS 'Challenge method (DNS_TXT, FILE)';
+COMMENT ON COLUMN company_representatives.challenge_code IS 'Random challenge code to publish on the domain';
+COMMENT ON COLUMN company_representatives.access_token_hash IS 'SHA-256 hash of the representative access token';
+COMMENT ON COLUMN company_representatives.status IS 'Verification status (PENDING, VERIFIED)';
+COMMENT ON COLUMN company_representatives.verified_at IS 'When the domain challenge was passed';
+
+COMMENT ON COLUMN post_official_replies.post_id IS 'Post the reply belongs to (one reply per post)';
+COMMENT ON COLUMN post_official_replies.representative_id IS 'Verified representative who replied';
+COMMENT ON COLUMN post_official_replies.verified_domain IS 'Company domain the representative verified';
+COMMENT ON COLUMN post_official_replies.content IS 'Reply text (10-5000 characters)';
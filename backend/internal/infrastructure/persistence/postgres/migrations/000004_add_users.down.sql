@@ -0,0 +1,9 @@
+-- Migration: Remove user accounts and sessions
+-- Version: 000004
+-- Description: Drop author_id from posts, and drop refresh_tokens and users tables
+
+DROP INDEX IF EXISTS idx_posts_author_id_created_at;
+ALTER TABLE posts DROP COLUMN IF EXISTS author_id;
+
+DROP TABLE IF EXISTS refresh_tokens;
+DROP TABLE IF EXISTS users;
@@ -0,0 +1,51 @@
+-- Migration: Add user accounts and sessions
+-- Version: 000004
+-- Description: Create users and refresh_tokens tables, and link posts to the logged-in user who created them
+
+-- Create users table
+CREATE TABLE IF NOT EXISTS users (
+    id UUID PRIMARY KEY,
+    email VARCHAR(254) NOT NULL UNIQUE,
+    username VARCHAR(32) UNIQUE,
+    password_hash VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Create refresh_tokens table (rotated on every use, grouped by login family)
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    family_id UUID NOT NULL,
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    revoked_at TIMESTAMP,
+    replaced_by UUID
+);
+
+-- Index for revoking a whole family
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_family_id ON refresh_tokens(family_id);
+
+-- Index for listing a user's sessions
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_user_id ON refresh_tokens(user_id);
+
+-- Link posts to the logged-in author (NULL for anonymous posts)
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS author_id UUID REFERENCES users(id) ON DELETE SET NULL;
+
+-- Index for "my posts"
+CREATE INDEX IF NOT EXISTS idx_posts_author_id_created_at ON posts(author_id, created_at DESC) WHERE author_id IS NOT NULL;
+
+COMMENT ON TABLE users IS 'Stores registered user accounts';
+COMMENT ON TABLE refresh_tokens IS 'Stores refresh tokens (hashed) with rotation and revocation state';
+
+COMMENT ON COLUMN users.email IS 'Login email address (lower-cased, unique)';
+COMMENT ON COLUMN users.username IS 'Optional public handle (unique when set)';
+COMMENT ON COLUMN users.password_hash IS 'argon2id hash in PHC string format';
+
+COMMENT ON COLUMN refresh_tokens.family_id IS 'All tokens rotated from the same login share a family';
+COMMENT ON COLUMN refresh_tokens.token_hash IS 'SHA-256 hash of the raw refresh token';
+COMMENT ON COLUMN refresh_tokens.revoked_at IS 'When the token was rotated or revoked (NULL while active)';
+COMMENT ON COLUMN refresh_tokens.replaced_by IS 'Token issued when this token was rotated';
+
+COMMENT ON COLUMN posts.author_id IS 'Logged-in user who created the post (NULL for anonymous posts, never exposed publicly)';
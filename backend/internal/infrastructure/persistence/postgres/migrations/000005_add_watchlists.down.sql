@@ -0,0 +1,9 @@
+-- Migration: Remove bookmarks and company watchlists
+-- Version: 000005
+-- Description: Drop devices, bookmarks, company_watches and watch_visits tables
+
+DROP INDEX IF EXISTS idx_posts_company_name_created_at;
+DROP TABLE IF EXISTS watch_visits;
+DROP TABLE IF EXISTS company_watches;
+DROP TABLE IF EXISTS bookmarks;
+DROP TABLE IF EXISTS devices;
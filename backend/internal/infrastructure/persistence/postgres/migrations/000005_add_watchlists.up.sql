@@ -0,0 +1,47 @@
+-- Migration: Add bookmarks and company watchlists
+-- Version: 000005
+-- Description: Create devices, bookmarks, company_watches and watch_visits tables
+
+-- Create devices table (anonymous visitors holding a server-issued device token)
+CREATE TABLE IF NOT EXISTS devices (
+    token_hash VARCHAR(64) PRIMARY KEY,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Create bookmarks table
+CREATE TABLE IF NOT EXISTS bookmarks (
+    owner_key VARCHAR(80) NOT NULL,
+    post_id UUID NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (owner_key, post_id)
+);
+
+-- Index for listing an owner's bookmarks, newest first
+CREATE INDEX IF NOT EXISTS idx_bookmarks_owner_key_created_at ON bookmarks(owner_key, created_at DESC);
+
+-- Create company_watches table
+CREATE TABLE IF NOT EXISTS company_watches (
+    owner_key VARCHAR(80) NOT NULL,
+    company_name VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (owner_key, company_name)
+);
+
+-- Create watch_visits table (when each owner last saw the watched-company feed)
+CREATE TABLE IF NOT EXISTS watch_visits (
+    owner_key VARCHAR(80) PRIMARY KEY,
+    last_visited_at TIMESTAMP NOT NULL
+);
+
+-- Index for the watched-company feed (posts about given companies after a time)
+CREATE INDEX IF NOT EXISTS idx_posts_company_name_created_at ON posts(company_name, created_at DESC);
+
+COMMENT ON TABLE devices IS 'Stores hashed device tokens issued to anonymous visitors';
+COMMENT ON TABLE bookmarks IS 'Stores posts saved by users or anonymous devices';
+COMMENT ON TABLE company_watches IS 'Stores companies followed by users or anonymous devices';
+COMMENT ON TABLE watch_visits IS 'Stores when each owner last saw new posts about watched companies';
+
+COMMENT ON COLUMN devices.token_hash IS 'SHA-256 hash of the raw device token';
+COMMENT ON COLUMN bookmarks.owner_key IS 'Owner key: user:<user id> or device:<device token hash>';
+COMMENT ON COLUMN company_watches.owner_key IS 'Owner key: user:<user id> or device:<device token hash>';
+COMMENT ON COLUMN company_watches.company_name IS 'Company name exactly as it appears in posts';
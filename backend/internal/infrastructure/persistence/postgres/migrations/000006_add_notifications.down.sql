@@ -0,0 +1,6 @@
+-- Migration: Remove notifications
+-- Version: 000006
+-- Description: Drop notifications table
+
+DROP INDEX IF EXISTS idx_company_watches_company_name;
+DROP TABLE IF EXISTS notifications;
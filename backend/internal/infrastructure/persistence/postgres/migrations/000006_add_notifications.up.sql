@@ -0,0 +1,32 @@
+-- Migration: Add notifications
+-- Version: 000006
+-- Description: Create notifications table for the in-app notification center
+
+-- Create notifications table
+CREATE TABLE IF NOT EXISTS notifications (
+    id UUID PRIMARY KEY,
+    recipient_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    type VARCHAR(30) NOT NULL,
+    post_id UUID NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+    subject VARCHAR(100) NOT NULL,
+    excerpt VARCHAR(120) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    read_at TIMESTAMP,
+    CONSTRAINT chk_notifications_type CHECK (type IN ('COMMENT_REPLY', 'WATCHED_COMPANY_POST', 'MODERATION_OUTCOME', 'COMPANY_REPLY'))
+);
+
+-- Index for listing a recipient's notifications, newest first (cursor paging)
+CREATE INDEX IF NOT EXISTS idx_notifications_recipient_created_at ON notifications(recipient_id, created_at DESC, id DESC);
+
+-- Partial index for counting unread notifications
+CREATE INDEX IF NOT EXISTS idx_notifications_recipient_unread ON notifications(recipient_id) WHERE read_at IS NULL;
+
+-- Index for finding the users watching a company when a post is published
+CREATE INDEX IF NOT EXISTS idx_company_watches_company_name ON company_watches(company_name);
+
+COMMENT ON TABLE notifications IS 'Stores in-app notifications addressed to users';
+
+COMMENT ON COLUMN notifications.type IS 'Event type: COMMENT_REPLY, WATCHED_COMPANY_POST, MODERATION_OUTCOME or COMPANY_REPLY';
+COMMENT ON COLUMN notifications.subject IS 'Short title: company name, or the outcome for moderation notifications';
+COMMENT ON COLUMN notifications.excerpt IS 'Short preview of the post, reply or moderation reason';
+COMMENT ON COLUMN notifications.read_at IS 'When the recipient read the notification (NULL while unread)';
@@ -0,0 +1,5 @@
+-- Migration: Remove attachments
+-- Version: 000007
+-- Description: Drop attachments table
+
+DROP TABLE IF EXISTS attachments;
@@ -0,0 +1,24 @@
+-- Migration: Add attachments
+-- Version: 000007
+-- Description: Create attachments table for evidence files attached to posts
+
+-- Create attachments table (file content is stored in the blob store)
+CREATE TABLE IF NOT EXISTS attachments (
+    id UUID PRIMARY KEY,
+    post_id UUID NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+    content_type VARCHAR(50) NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    checksum CHAR(64) NOT NULL,
+    storage_key VARCHAR(255) NOT NULL UNIQUE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_attachments_size_bytes CHECK (size_bytes > 0)
+);
+
+-- Index for listing and counting a post's attachments
+CREATE INDEX IF NOT EXISTS idx_attachments_post_id_created_at ON attachments(post_id, created_at);
+
+COMMENT ON TABLE attachments IS 'Stores metadata of evidence files attached to posts';
+
+COMMENT ON COLUMN attachments.content_type IS 'Media type sniffed from the file content';
+COMMENT ON COLUMN attachments.checksum IS 'Hex-encoded SHA-256 of the file content';
+COMMENT ON COLUMN attachments.storage_key IS 'Key of the file in the blob store';
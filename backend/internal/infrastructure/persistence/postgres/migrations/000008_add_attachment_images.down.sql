@@ -0,0 +1,7 @@
+-- Migration: Remove attachment image info
+-- Version: 000008
+-- Description: Drop image columns from attachments table
+
+ALTER TABLE attachments DROP COLUMN IF EXISTS thumbnail_content_type;
+ALTER TABLE attachments DROP COLUMN IF EXISTS height;
+ALTER TABLE attachments DROP COLUMN IF EXISTS width;
@@ -0,0 +1,11 @@
+-- Migration: Add attachment image info
+-- Version: 000008
+-- Description: Add dimensions and thumbnail type of sanitized image attachments
+
+ALTER TABLE attachments ADD COLUMN IF NOT EXISTS width INT NOT NULL DEFAULT 0;
+ALTER TABLE attachments ADD COLUMN IF NOT EXISTS height INT NOT NULL DEFAULT 0;
+ALTER TABLE attachments ADD COLUMN IF NOT EXISTS thumbnail_content_type VARCHAR(50) NOT NULL DEFAULT '';
+
+COMMENT ON COLUMN attachments.width IS 'Image width in pixels (0 for documents)';
+COMMENT ON COLUMN attachments.height IS 'Image height in pixels (0 for documents)';
+COMMENT ON COLUMN attachments.thumbnail_content_type IS 'Media type of the thumbnail stored next to the file (empty if none)';
@@ -0,0 +1,5 @@
+-- Migration: Remove post daily views
+-- Version: 000009
+-- Description: Drop post_daily_views table
+
+DROP TABLE IF EXISTS post_daily_views;
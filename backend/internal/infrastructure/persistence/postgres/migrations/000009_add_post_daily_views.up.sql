@@ -0,0 +1,17 @@
+-- Migration: Add post daily views
+-- Version: 000009
+-- Description: Create post_daily_views table for unique view counts flushed from Redis
+
+-- Create post_daily_views table (one row per post and day)
+CREATE TABLE IF NOT EXISTS post_daily_views (
+    post_id UUID NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    views BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (post_id, day),
+    CONSTRAINT chk_post_daily_views_views CHECK (views >= 0)
+);
+
+COMMENT ON TABLE post_daily_views IS 'Stores unique post views per UTC day, counted with Redis HyperLogLog';
+
+COMMENT ON COLUMN post_daily_views.day IS 'UTC day the views were counted on';
+COMMENT ON COLUMN post_daily_views.views IS 'Approximate number of unique viewers on that day';
@@ -0,0 +1,6 @@
+-- Migration: Remove post keyset indexes
+-- Version: 000010
+-- Description: Drop the indexes used to walk all posts
+
+DROP INDEX IF EXISTS idx_posts_updated_at;
+DROP INDEX IF EXISTS idx_posts_created_at_id;
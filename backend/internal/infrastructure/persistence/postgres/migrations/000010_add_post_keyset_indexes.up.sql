@@ -0,0 +1,9 @@
+-- Migration: Add post keyset indexes
+-- Version: 000010
+-- Description: Index posts for walking all posts in (created_at, id) order and finding recently changed posts (sitemaps)
+
+-- Index for keyset pagination over all posts (used in FindRefsAfter)
+CREATE INDEX IF NOT EXISTS idx_posts_created_at_id ON posts(created_at, id);
+
+-- Index for recently changed posts (used in FindRefsUpdatedSince)
+CREATE INDEX IF NOT EXISTS idx_posts_updated_at ON posts(updated_at);
@@ -0,0 +1,7 @@
+-- Migration: Remove external references from posts
+-- Version: 000011
+-- Description: Drop the columns recording where imported posts come from
+
+DROP INDEX IF EXISTS idx_posts_external_ref;
+ALTER TABLE posts DROP COLUMN IF EXISTS external_id;
+ALTER TABLE posts DROP COLUMN IF EXISTS external_source;
@@ -0,0 +1,14 @@
+-- Migration: Add external references to posts
+-- Version: 000011
+-- Description: Record where imported posts come from so that importing a dataset twice does not duplicate posts
+
+-- Source dataset and record ID of imported posts (NULL for posts created on the site)
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS external_source VARCHAR(50);
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS external_id VARCHAR(100);
+
+-- A record is imported at most once per source (used in Import with ON CONFLICT)
+CREATE UNIQUE INDEX IF NOT EXISTS idx_posts_external_ref ON posts(external_source, external_id)
+    WHERE external_id IS NOT NULL;
+
+COMMENT ON COLUMN posts.external_source IS 'Dataset an imported post comes from (e.g. partner-a)';
+COMMENT ON COLUMN posts.external_id IS 'ID of the record in the source dataset';
@@ -0,0 +1,9 @@
+-- Migration: Remove moderation state from posts
+-- Version: 000012
+-- Description: Drop the post moderation columns
+
+DROP INDEX IF EXISTS idx_posts_moderated;
+ALTER TABLE posts DROP CONSTRAINT IF EXISTS chk_posts_moderation_status;
+ALTER TABLE posts DROP COLUMN IF EXISTS moderated_at;
+ALTER TABLE posts DROP COLUMN IF EXISTS moderation_reason;
+ALTER TABLE posts DROP COLUMN IF EXISTS moderation_status;
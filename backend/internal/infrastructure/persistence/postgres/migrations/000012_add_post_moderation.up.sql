@@ -0,0 +1,27 @@
+-- Migration: Add moderation state to posts
+-- Version: 000012
+-- Description: Let operators hide, remove and restore posts without deleting them
+
+-- VISIBLE, HIDDEN or REMOVED; only visible posts are shown publicly
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS moderation_status VARCHAR(20) NOT NULL DEFAULT 'VISIBLE';
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS moderation_reason TEXT;
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS moderated_at TIMESTAMP;
+
+-- Added only once: migrations run on every startup, and adding the constraint
+-- locks posts and checks every row
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'chk_posts_moderation_status') THEN
+        ALTER TABLE posts ADD CONSTRAINT chk_posts_moderation_status
+            CHECK (moderation_status IN ('VISIBLE', 'HIDDEN', 'REMOVED'));
+    END IF;
+END
+$$;
+
+-- Moderated posts are rare, so operators can list them cheaply
+CREATE INDEX IF NOT EXISTS idx_posts_moderated ON posts(moderated_at DESC)
+    WHERE moderation_status <> 'VISIBLE';
+
+COMMENT ON COLUMN posts.moderation_status IS 'Moderation status: VISIBLE, HIDDEN or REMOVED';
+COMMENT ON COLUMN posts.moderation_reason IS 'Reason of the last hide or remove action';
+COMMENT ON COLUMN posts.moderated_at IS 'Time of the last moderation action';
@@ -0,0 +1,7 @@
+-- Migration: Remove API keys and the audit log
+-- Version: 000013
+-- Description: Drop the audit_log and api_keys tables
+
+DROP TABLE IF EXISTS audit_log;
+DROP FUNCTION IF EXISTS audit_log_append_only();
+DROP TABLE IF EXISTS api_keys;
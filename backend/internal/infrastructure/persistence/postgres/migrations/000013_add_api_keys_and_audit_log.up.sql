@@ -0,0 +1,69 @@
+-- Migration: Add API keys and the audit log
+-- Version: 000013
+-- Description: Authenticate privileged RPCs with scoped API keys and record every privileged call
+
+-- API keys are never deleted, only revoked, so audit events can always name them
+CREATE TABLE IF NOT EXISTS api_keys (
+    id UUID PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    key_prefix VARCHAR(16) NOT NULL,
+    key_hash CHAR(64) NOT NULL,
+    scopes TEXT[] NOT NULL,
+    expires_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    last_used_at TIMESTAMP,
+    revoked_at TIMESTAMP,
+    CONSTRAINT chk_api_keys_scopes CHECK (cardinality(scopes) > 0 AND scopes <@ ARRAY['moderate', 'export', 'admin']::TEXT[])
+);
+
+-- Keys are looked up by hash on every privileged call
+CREATE UNIQUE INDEX IF NOT EXISTS idx_api_keys_key_hash ON api_keys(key_hash);
+
+-- Append-only record of privileged calls
+CREATE TABLE IF NOT EXISTS audit_log (
+    id UUID PRIMARY KEY,
+    occurred_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    api_key_id UUID REFERENCES api_keys(id),
+    actor VARCHAR(100) NOT NULL,
+    action VARCHAR(200) NOT NULL,
+    target TEXT,
+    outcome VARCHAR(50) NOT NULL,
+    client_ip TEXT,
+    details JSONB
+);
+
+-- Indexes for listing the log, newest first (cursor paging), by key and by target
+CREATE INDEX IF NOT EXISTS idx_audit_log_occurred_at ON audit_log(occurred_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_log_api_key ON audit_log(api_key_id, occurred_at DESC)
+    WHERE api_key_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_audit_log_target ON audit_log(target, occurred_at DESC)
+    WHERE target IS NOT NULL;
+
+-- Reject updates, deletes and truncation, so the log cannot be rewritten through the application
+CREATE OR REPLACE FUNCTION audit_log_append_only() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_audit_log_append_only ON audit_log;
+CREATE TRIGGER trg_audit_log_append_only
+    BEFORE UPDATE OR DELETE ON audit_log
+    FOR EACH ROW EXECUTE FUNCTION audit_log_append_only();
+
+DROP TRIGGER IF EXISTS trg_audit_log_no_truncate ON audit_log;
+CREATE TRIGGER trg_audit_log_no_truncate
+    BEFORE TRUNCATE ON audit_log
+    FOR EACH STATEMENT EXECUTE FUNCTION audit_log_append_only();
+
+COMMENT ON TABLE api_keys IS 'API keys for privileged RPCs; only the SHA-256 hash of the key is stored';
+COMMENT ON COLUMN api_keys.key_prefix IS 'Start of the raw key, used to tell keys apart';
+COMMENT ON COLUMN api_keys.scopes IS 'Granted scopes: moderate, export, admin';
+COMMENT ON COLUMN api_keys.last_used_at IS 'Time of the last accepted call (updated at most once a minute)';
+
+COMMENT ON TABLE audit_log IS 'Append-only record of privileged calls';
+COMMENT ON COLUMN audit_log.actor IS 'API key name, or cli for the admin subcommands';
+COMMENT ON COLUMN audit_log.action IS 'gRPC method, HTTP endpoint or admin subcommand';
+COMMENT ON COLUMN audit_log.target IS 'ID of what the call acted on, e.g. a post ID';
+COMMENT ON COLUMN audit_log.outcome IS 'OK or the gRPC status code name';
+COMMENT ON COLUMN audit_log.details IS 'Call parameters';
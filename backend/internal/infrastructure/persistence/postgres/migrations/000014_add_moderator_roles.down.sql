@@ -0,0 +1,8 @@
+-- Migration: Remove moderator roles and user bans
+-- Version: 000014
+-- Description: Drop the moderators table and the ban and moderator columns
+
+ALTER TABLE users DROP COLUMN IF EXISTS ban_reason;
+ALTER TABLE users DROP COLUMN IF EXISTS banned_at;
+ALTER TABLE posts DROP COLUMN IF EXISTS moderated_by;
+DROP TABLE IF EXISTS moderators;
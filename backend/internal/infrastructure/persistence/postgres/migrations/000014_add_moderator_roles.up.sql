@@ -0,0 +1,28 @@
+-- Migration: Add moderator roles and user bans
+-- Version: 000014
+-- Description: Let registered users moderate posts with a role and city assignments, and ban post authors
+
+-- Volunteer moderators; NULL city_codes means all cities
+CREATE TABLE IF NOT EXISTS moderators (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    role VARCHAR(20) NOT NULL,
+    city_codes TEXT[],
+    assigned_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_moderators_role CHECK (role IN ('JUNIOR', 'SENIOR')),
+    CONSTRAINT chk_moderators_city_codes CHECK (city_codes IS NULL OR cardinality(city_codes) > 0)
+);
+
+-- Moderator who took the last moderation action (NULL for operators)
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS moderated_by UUID REFERENCES users(id) ON DELETE SET NULL;
+
+-- Banned users can no longer log in or refresh their sessions
+ALTER TABLE users ADD COLUMN IF NOT EXISTS banned_at TIMESTAMP;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS ban_reason TEXT;
+
+COMMENT ON TABLE moderators IS 'Registered users who review posts';
+COMMENT ON COLUMN moderators.role IS 'JUNIOR (view the queue, hide posts) or SENIOR (also remove, restore and ban)';
+COMMENT ON COLUMN moderators.city_codes IS 'Assigned cities (NULL for all cities)';
+COMMENT ON COLUMN posts.moderated_by IS 'Moderator who took the last moderation action (NULL for operators)';
+COMMENT ON COLUMN users.banned_at IS 'Time when a moderator banned the user (NULL if not banned)';
+COMMENT ON COLUMN users.ban_reason IS 'Reason given for the ban';
@@ -0,0 +1,5 @@
+-- Migration: Remove appeals
+-- Version: 000015
+-- Description: Drop the appeals table
+
+DROP TABLE IF EXISTS appeals;
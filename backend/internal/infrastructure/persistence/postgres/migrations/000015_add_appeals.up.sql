@@ -0,0 +1,34 @@
+-- Migration: Add appeals
+-- Version: 000015
+-- Description: Let authors appeal the hiding or removal of their posts with the management token
+
+-- Appeals of moderated posts; each is decided by a moderator other than acted_by
+CREATE TABLE IF NOT EXISTS appeals (
+    id UUID PRIMARY KEY,
+    post_id UUID NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+    appealed_status VARCHAR(20) NOT NULL,
+    acted_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    statement TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING',
+    decided_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    decision_reason TEXT,
+    filed_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    decided_at TIMESTAMP,
+    CONSTRAINT chk_appeals_appealed_status CHECK (appealed_status IN ('HIDDEN', 'REMOVED')),
+    CONSTRAINT chk_appeals_status CHECK (status IN ('PENDING', 'ACCEPTED', 'REJECTED'))
+);
+
+-- At most one pending appeal per post
+CREATE UNIQUE INDEX IF NOT EXISTS idx_appeals_pending_post ON appeals(post_id) WHERE status = 'PENDING';
+
+-- Latest appeal of a post (status lookup)
+CREATE INDEX IF NOT EXISTS idx_appeals_post_filed ON appeals(post_id, filed_at DESC);
+
+-- Pending appeals, the longest-waiting first (moderator queue)
+CREATE INDEX IF NOT EXISTS idx_appeals_pending_filed ON appeals(filed_at, id) WHERE status = 'PENDING';
+
+COMMENT ON TABLE appeals IS 'Author appeals against the hiding or removal of posts';
+COMMENT ON COLUMN appeals.appealed_status IS 'Moderation status of the post when the appeal was filed';
+COMMENT ON COLUMN appeals.acted_by IS 'Moderator who took the appealed action (NULL for operators); cannot decide the appeal';
+COMMENT ON COLUMN appeals.status IS 'PENDING, ACCEPTED (post restored) or REJECTED';
+COMMENT ON COLUMN appeals.decided_by IS 'Moderator who decided the appeal';
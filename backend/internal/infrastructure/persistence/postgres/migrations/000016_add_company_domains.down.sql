@@ -0,0 +1,5 @@
+-- Migration: Remove company domain approvals
+-- Version: 000016
+-- Description: Drop the company_domains table
+
+DROP TABLE IF EXISTS company_domains;
@@ -0,0 +1,15 @@
+-- Migration: Add company domain approvals
+-- Version: 000016
+-- Description: Record which domains operators have checked to belong to a company; representatives
+-- are only verified (and may only reply) for an approved company domain
+
+CREATE TABLE IF NOT EXISTS company_domains (
+    company_name VARCHAR(100) NOT NULL,
+    domain VARCHAR(253) NOT NULL,
+    approved_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (company_name, domain)
+);
+
+COMMENT ON TABLE company_domains IS 'Company domains approved by operators for representative verification';
+COMMENT ON COLUMN company_domains.company_name IS 'Company name exactly as it appears in posts';
+COMMENT ON COLUMN company_domains.domain IS 'Domain an operator checked to belong to the company (lower case)';
@@ -0,0 +1,7 @@
+-- Migration: Remove canonical company names from posts
+-- Version: 000017
+-- Description: Drop the company_canonical column and its index
+
+DROP INDEX IF EXISTS idx_posts_company_canonical;
+
+ALTER TABLE posts DROP COLUMN IF EXISTS company_canonical;
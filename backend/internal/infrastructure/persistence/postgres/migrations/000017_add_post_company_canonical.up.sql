@@ -0,0 +1,11 @@
+-- Migration: Add canonical company names to posts
+-- Version: 000017
+-- Description: Store the canonical company name (see CompanyName.Canonical) of each post, so posts
+-- about a company written differently can be found together; existing rows are filled in by
+-- postgres.Migrate
+
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS company_canonical VARCHAR(100);
+
+CREATE INDEX IF NOT EXISTS idx_posts_company_canonical ON posts(company_canonical, created_at DESC);
+
+COMMENT ON COLUMN posts.company_canonical IS 'Canonical company name computed by the application (NULL until backfilled)';
@@ -0,0 +1,124 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+
+	"fuck_boss/backend/internal/domain/moderation"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// ModeratorRepository is the PostgreSQL implementation of moderation.ModeratorRepository.
+type ModeratorRepository struct {
+	// db is the database connection.
+	db *sql.DB
+}
+
+// NewModeratorRepository creates a new ModeratorRepository instance.
+func NewModeratorRepository(db *sql.DB) *ModeratorRepository {
+	return &ModeratorRepository{
+		db: db,
+	}
+}
+
+// moderatorColumns are the columns scanned by scanModerator.
+const moderatorColumns = `user_id, role, city_codes, assigned_at, updated_at`
+
+// Save saves a Moderator to the database.
+// If the user is already a moderator, the role and cities are updated.
+// Moderators covering all cities are stored with NULL city_codes.
+func (r *ModeratorRepository) Save(ctx context.Context, moderator *moderation.Moderator) error {
+	var cityCodes interface{}
+	if !moderator.CoversAllCities() {
+		cityCodes = pq.Array(moderator.CityCodes())
+	}
+
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO moderators (user_id, role, city_codes, assigned_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id) DO UPDATE SET
+			role = EXCLUDED.role,
+			city_codes = EXCLUDED.city_codes,
+			updated_at = EXCLUDED.updated_at
+	`,
+		moderator.UserID(),
+		moderator.Role().String(),
+		cityCodes,
+		moderator.AssignedAt(),
+		moderator.UpdatedAt(),
+	)
+	if err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to save moderator", err)
+	}
+	return nil
+}
+
+// FindByUserID finds the Moderator of a user account.
+func (r *ModeratorRepository) FindByUserID(ctx context.Context, userID string) (*moderation.Moderator, error) {
+	row := r.db.QueryRowContext(ctx, `SELECT `+moderatorColumns+` FROM moderators WHERE user_id = $1`, userID)
+	return scanModerator(row)
+}
+
+// FindAll returns all moderators, the most recently assigned first.
+func (r *ModeratorRepository) FindAll(ctx context.Context) ([]*moderation.Moderator, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT `+moderatorColumns+` FROM moderators ORDER BY assigned_at DESC, user_id`)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to list moderators", err)
+	}
+	defer rows.Close()
+
+	var moderators []*moderation.Moderator
+	for rows.Next() {
+		moderator, err := scanModerator(rows)
+		if err != nil {
+			return nil, err
+		}
+		moderators = append(moderators, moderator)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to iterate moderators", err)
+	}
+
+	return moderators, nil
+}
+
+// Delete removes the moderator role from a user account.
+func (r *ModeratorRepository) Delete(ctx context.Context, userID string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM moderators WHERE user_id = $1`, userID)
+	if err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to delete moderator", err)
+	}
+	if affected, err := result.RowsAffected(); err == nil && affected == 0 {
+		return apperrors.NewNotFoundError("moderator")
+	}
+	return nil
+}
+
+// scanModerator scans one moderators row (moderatorColumns).
+func scanModerator(row rowScanner) (*moderation.Moderator, error) {
+	var (
+		userID     string
+		role       string
+		cityCodes  []string
+		assignedAt time.Time
+		updatedAt  time.Time
+	)
+
+	err := row.Scan(&userID, &role, pq.Array(&cityCodes), &assignedAt, &updatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, apperrors.NewNotFoundError("moderator")
+		}
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to find moderator", err)
+	}
+
+	roleVO, err := moderation.NewRole(role)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("invalid moderator role in database", err)
+	}
+
+	return moderation.NewModeratorFromDB(userID, roleVO, cityCodes, assignedAt, updatedAt), nil
+}
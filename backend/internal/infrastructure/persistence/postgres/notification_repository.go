@@ -0,0 +1,213 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"fuck_boss/backend/internal/domain/content"
+	"fuck_boss/backend/internal/domain/identity"
+	"fuck_boss/backend/internal/domain/notification"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// NotificationRepository is the PostgreSQL implementation of notification.NotificationRepository.
+type NotificationRepository struct {
+	// db is the database connection.
+	db *sql.DB
+}
+
+// NewNotificationRepository creates a new NotificationRepository instance.
+func NewNotificationRepository(db *sql.DB) *NotificationRepository {
+	return &NotificationRepository{
+		db: db,
+	}
+}
+
+// SaveAll stores new notifications in one transaction.
+// Notifications addressed to users that no longer exist are skipped.
+func (r *NotificationRepository) SaveAll(ctx context.Context, notifications []*notification.Notification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to begin transaction", err)
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.PrepareContext(ctx, `
+		INSERT INTO notifications (id, recipient_id, type, post_id, subject, excerpt, created_at)
+		SELECT $1, $2, $3, $4, $5, $6, $7
+		WHERE EXISTS (SELECT 1 FROM users WHERE id = $2)
+		ON CONFLICT (id) DO NOTHING
+	`)
+	if err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to prepare notification insert", err)
+	}
+	defer stmt.Close()
+
+	for _, n := range notifications {
+		_, err := stmt.ExecContext(ctx,
+			n.ID(),
+			n.RecipientID().String(),
+			n.Type().String(),
+			n.PostID().String(),
+			n.Subject(),
+			n.Excerpt(),
+			n.CreatedAt(),
+		)
+		if err != nil {
+			return apperrors.NewDatabaseErrorWithCause("failed to save notification", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to commit notifications", err)
+	}
+
+	return nil
+}
+
+// FindByRecipient finds the recipient's notifications, newest first, after the cursor.
+func (r *NotificationRepository) FindByRecipient(
+	ctx context.Context,
+	recipientID identity.UserID,
+	filter notification.Filter,
+	after *notification.Cursor,
+	limit int,
+) ([]*notification.Notification, error) {
+	if limit < 1 {
+		limit = 20
+	}
+
+	conditions := []string{"recipient_id = $1"}
+	args := []interface{}{recipientID.String()}
+
+	if filter.UnreadOnly {
+		conditions = append(conditions, "read_at IS NULL")
+	}
+	if filter.Type != nil {
+		args = append(args, filter.Type.String())
+		conditions = append(conditions, fmt.Sprintf("type = $%d", len(args)))
+	}
+	if after != nil {
+		args = append(args, after.CreatedAt(), after.ID())
+		conditions = append(conditions, fmt.Sprintf("(created_at, id) < ($%d, $%d)", len(args)-1, len(args)))
+	}
+
+	args = append(args, limit)
+	query := fmt.Sprintf(`
+		SELECT id, type, post_id, subject, excerpt, created_at, read_at
+		FROM notifications
+		WHERE %s
+		ORDER BY created_at DESC, id DESC
+		LIMIT $%d
+	`, strings.Join(conditions, " AND "), len(args))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to find notifications", err)
+	}
+	defer rows.Close()
+
+	var notifications []*notification.Notification
+	for rows.Next() {
+		var (
+			id        string
+			kind      string
+			postID    string
+			subject   string
+			excerpt   string
+			createdAt time.Time
+			readAt    sql.NullTime
+		)
+
+		if err := rows.Scan(&id, &kind, &postID, &subject, &excerpt, &createdAt, &readAt); err != nil {
+			return nil, apperrors.NewDatabaseErrorWithCause("failed to scan notification", err)
+		}
+
+		typeVO, err := notification.NewType(kind)
+		if err != nil {
+			return nil, apperrors.NewDatabaseErrorWithCause("invalid notification type in database", err)
+		}
+
+		postIDVO, err := content.NewPostID(postID)
+		if err != nil {
+			return nil, apperrors.NewDatabaseErrorWithCause("invalid post ID in database", err)
+		}
+
+		var readAtPtr *time.Time
+		if readAt.Valid {
+			readAtPtr = &readAt.Time
+		}
+
+		notifications = append(notifications, notification.NewNotificationFromDB(
+			id, recipientID, typeVO, postIDVO, subject, excerpt, createdAt, readAtPtr,
+		))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to iterate notifications", err)
+	}
+
+	return notifications, nil
+}
+
+// CountUnread counts the recipient's unread notifications.
+func (r *NotificationRepository) CountUnread(ctx context.Context, recipientID identity.UserID) (int, error) {
+	var count int
+	err := r.db.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM notifications WHERE recipient_id = $1 AND read_at IS NULL`,
+		recipientID.String(),
+	).Scan(&count)
+	if err != nil {
+		return 0, apperrors.NewDatabaseErrorWithCause("failed to count unread notifications", err)
+	}
+
+	return count, nil
+}
+
+// MarkRead marks one of the recipient's notifications as read.
+func (r *NotificationRepository) MarkRead(ctx context.Context, recipientID identity.UserID, id string, at time.Time) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE notifications
+		SET read_at = COALESCE(read_at, $3)
+		WHERE id = $1 AND recipient_id = $2
+	`, id, recipientID.String(), at)
+	if err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to mark notification as read", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to mark notification as read", err)
+	}
+	if affected == 0 {
+		return apperrors.NewNotFoundError("notification not found")
+	}
+
+	return nil
+}
+
+// MarkAllRead marks all of the recipient's unread notifications as read.
+func (r *NotificationRepository) MarkAllRead(ctx context.Context, recipientID identity.UserID, at time.Time) (int, error) {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE notifications
+		SET read_at = $2
+		WHERE recipient_id = $1 AND read_at IS NULL
+	`, recipientID.String(), at)
+	if err != nil {
+		return 0, apperrors.NewDatabaseErrorWithCause("failed to mark notifications as read", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, apperrors.NewDatabaseErrorWithCause("failed to mark notifications as read", err)
+	}
+
+	return int(affected), nil
+}
@@ -0,0 +1,152 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+
+	"fuck_boss/backend/internal/domain/content"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// PostImportRepository is the PostgreSQL implementation of content.PostImportRepository.
+type PostImportRepository struct {
+	// db is the database connection.
+	db *sql.DB
+}
+
+// NewPostImportRepository creates a new PostImportRepository instance.
+func NewPostImportRepository(db *sql.DB) *PostImportRepository {
+	return &PostImportRepository{
+		db: db,
+	}
+}
+
+// FindImported returns which of the external IDs of the source have already been imported.
+func (r *PostImportRepository) FindImported(ctx context.Context, source string, externalIDs []string) (map[string]bool, error) {
+	imported := make(map[string]bool)
+	if len(externalIDs) == 0 {
+		return imported, nil
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT external_id
+		FROM posts
+		WHERE external_source = $1 AND external_id = ANY($2)
+	`, source, pq.Array(externalIDs))
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to find imported posts", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var externalID string
+		if err := rows.Scan(&externalID); err != nil {
+			return nil, apperrors.NewDatabaseErrorWithCause("failed to scan imported post", err)
+		}
+		imported[externalID] = true
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to iterate imported posts", err)
+	}
+
+	return imported, nil
+}
+
+// Import stores the posts in one transaction and returns the number of posts stored.
+// The posts are loaded with COPY into a temporary table and then inserted,
+// skipping those whose external reference has already been imported (also by
+// a concurrent import).
+func (r *PostImportRepository) Import(ctx context.Context, posts []*content.ImportedPost) (int, error) {
+	if len(posts) == 0 {
+		return 0, nil
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, apperrors.NewDatabaseErrorWithCause("failed to begin transaction", err)
+	}
+	defer tx.Rollback()
+
+	// COPY cannot skip conflicting rows, so load a staging table first
+	_, err = tx.ExecContext(ctx, `
+		CREATE TEMPORARY TABLE import_posts (
+			id UUID NOT NULL,
+			company_name VARCHAR(100) NOT NULL,
+			company_canonical VARCHAR(100) NOT NULL,
+			city_code VARCHAR(50) NOT NULL,
+			city_name VARCHAR(50) NOT NULL,
+			content TEXT NOT NULL,
+			created_at TIMESTAMP NOT NULL,
+			occurred_at TIMESTAMP,
+			resolution_status VARCHAR(20),
+			external_source VARCHAR(50) NOT NULL,
+			external_id VARCHAR(100) NOT NULL
+		) ON COMMIT DROP
+	`)
+	if err != nil {
+		return 0, apperrors.NewDatabaseErrorWithCause("failed to create import table", err)
+	}
+
+	stmt, err := tx.PrepareContext(ctx, pq.CopyIn("import_posts",
+		"id", "company_name", "company_canonical", "city_code", "city_name", "content", "created_at",
+		"occurred_at", "resolution_status", "external_source", "external_id",
+	))
+	if err != nil {
+		return 0, apperrors.NewDatabaseErrorWithCause("failed to start copy", err)
+	}
+
+	for _, imported := range posts {
+		post := imported.Post()
+		var occurredAt sql.NullTime
+		if imported.OccurredAt() != nil {
+			occurredAt = sql.NullTime{Time: *imported.OccurredAt(), Valid: true}
+		}
+		_, err := stmt.ExecContext(ctx,
+			post.ID().String(), post.Company().String(), post.Company().Canonical(),
+			post.City().Code(), post.City().Name(),
+			post.Content().String(), post.CreatedAt(), occurredAt, nullString(post.Resolution().String()),
+			imported.Ref().Source(), imported.Ref().ID(),
+		)
+		if err != nil {
+			stmt.Close()
+			return 0, apperrors.NewDatabaseErrorWithCause("failed to copy imported post", err)
+		}
+	}
+
+	// An Exec without arguments flushes the copied rows
+	if _, err := stmt.ExecContext(ctx); err != nil {
+		stmt.Close()
+		return 0, apperrors.NewDatabaseErrorWithCause("failed to copy imported posts", err)
+	}
+	if err := stmt.Close(); err != nil {
+		return 0, apperrors.NewDatabaseErrorWithCause("failed to copy imported posts", err)
+	}
+
+	// updated_at is the import time, so sitemaps pick up the posts
+	result, err := tx.ExecContext(ctx, `
+		INSERT INTO posts (id, company_name, company_canonical, city_code, city_name, content, created_at, updated_at,
+			occurred_at, resolution_status, external_source, external_id)
+		SELECT id, company_name, company_canonical, city_code, city_name, content, created_at, $1,
+			occurred_at, resolution_status, external_source, external_id
+		FROM import_posts
+		ON CONFLICT (external_source, external_id) WHERE external_id IS NOT NULL DO NOTHING
+	`, time.Now())
+	if err != nil {
+		return 0, apperrors.NewDatabaseErrorWithCause("failed to insert imported posts", err)
+	}
+
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return 0, apperrors.NewDatabaseErrorWithCause("failed to insert imported posts", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, apperrors.NewDatabaseErrorWithCause("failed to commit imported posts", err)
+	}
+
+	return int(inserted), nil
+}
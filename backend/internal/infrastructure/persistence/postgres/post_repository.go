@@ -5,13 +5,20 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"strings"
 	"time"
 
+	"github.com/lib/pq"
+
 	"fuck_boss/backend/internal/domain/content"
 	"fuck_boss/backend/internal/domain/shared"
 	apperrors "fuck_boss/backend/pkg/errors"
 )
 
+// visibleCondition restricts queries to posts shown publicly (not hidden or removed).
+const visibleCondition = "moderation_status = 'VISIBLE'"
+
 // PostRepository is the PostgreSQL implementation of content.PostRepository.
 type PostRepository struct {
 	// db is the database connection.
@@ -27,17 +34,23 @@ func NewPostRepository(db *sql.DB) *PostRepository {
 
 // Save saves a Post to the database.
 // If the Post already exists (same ID), it updates the existing record.
+// Follow-ups that have not been persisted yet are inserted in the same transaction.
 // Returns an error if the operation fails.
 func (r *PostRepository) Save(ctx context.Context, post *content.Post) error {
 	query := `
-		INSERT INTO posts (id, company_name, city_code, city_name, content, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		INSERT INTO posts (id, company_name, city_code, city_name, content, created_at, updated_at,
+			management_token_hash, resolution_status, author_id, company_canonical)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 		ON CONFLICT (id) DO UPDATE SET
 			company_name = EXCLUDED.company_name,
+			company_canonical = EXCLUDED.company_canonical,
 			city_code = EXCLUDED.city_code,
 			city_name = EXCLUDED.city_name,
 			content = EXCLUDED.content,
-			updated_at = EXCLUDED.updated_at
+			updated_at = EXCLUDED.updated_at,
+			management_token_hash = COALESCE(posts.management_token_hash, EXCLUDED.management_token_hash),
+			resolution_status = EXCLUDED.resolution_status,
+			author_id = COALESCE(posts.author_id, EXCLUDED.author_id)
 	`
 
 	id := post.ID().String()
@@ -48,36 +61,113 @@ func (r *PostRepository) Save(ctx context.Context, post *content.Post) error {
 	createdAt := post.CreatedAt()
 	updatedAt := time.Now()
 
-	_, err := r.db.ExecContext(ctx, query,
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to begin transaction", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, query,
 		id, companyName, cityCode, cityName, postContent, createdAt, updatedAt,
+		nullString(post.ManagementTokenHash()), nullString(post.Resolution().String()),
+		nullString(post.AuthorID()), post.Company().Canonical(),
 	)
 	if err != nil {
 		return apperrors.NewDatabaseErrorWithCause("failed to save post", err)
 	}
 
+	// Insert newly appended follow-ups
+	var inserted []*content.FollowUp
+	for _, followUp := range post.FollowUps() {
+		if followUp.IsPersisted() {
+			continue
+		}
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO post_follow_ups (id, post_id, status, note, created_at)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (id) DO NOTHING
+		`, followUp.ID(), id, followUp.Status().String(), followUp.Note().String(), followUp.CreatedAt())
+		if err != nil {
+			return apperrors.NewDatabaseErrorWithCause("failed to save follow-up", err)
+		}
+		inserted = append(inserted, followUp)
+	}
+
+	// Insert a newly attached official reply (one per post, enforced by the primary key)
+	reply := post.OfficialReply()
+	if reply != nil && !reply.IsPersisted() {
+		result, err := tx.ExecContext(ctx, `
+			INSERT INTO post_official_replies (post_id, id, representative_id, verified_domain, content, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			ON CONFLICT (post_id) DO NOTHING
+		`, id, reply.ID(), reply.RepresentativeID(), reply.VerifiedDomain(), reply.Content().String(), reply.CreatedAt())
+		if err != nil {
+			return apperrors.NewDatabaseErrorWithCause("failed to save official reply", err)
+		}
+		if affected, err := result.RowsAffected(); err == nil && affected == 0 {
+			return apperrors.NewConflictError(content.ErrOfficialReplyExists.Error())
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to commit post", err)
+	}
+
+	for _, followUp := range inserted {
+		followUp.MarkPersisted()
+	}
+	if reply != nil {
+		reply.MarkPersisted()
+	}
+
 	return nil
 }
 
-// FindByID finds a Post by its ID.
+// FindByID finds a visible Post by its ID.
+// Hidden and removed posts are not found.
 // Returns the Post if found, or an error if not found or operation fails.
 func (r *PostRepository) FindByID(ctx context.Context, id content.PostID) (*content.Post, error) {
+	return r.findByID(ctx, id, true)
+}
+
+// FindByIDAnyStatus finds a Post by its ID whatever its moderation status.
+// It implements content.PostModerationRepository.
+func (r *PostRepository) FindByIDAnyStatus(ctx context.Context, id content.PostID) (*content.Post, error) {
+	return r.findByID(ctx, id, false)
+}
+
+// findByID finds a Post by its ID with its follow-ups, official reply and
+// moderation state. If visibleOnly is set, hidden and removed posts are not found.
+func (r *PostRepository) findByID(ctx context.Context, id content.PostID, visibleOnly bool) (*content.Post, error) {
 	query := `
-		SELECT id, company_name, city_code, city_name, content, created_at
+		SELECT id, company_name, city_code, city_name, content, created_at, management_token_hash, resolution_status,
+			author_id, moderation_status, moderation_reason, moderated_at, moderated_by
 		FROM posts
 		WHERE id = $1
 	`
+	if visibleOnly {
+		query += " AND " + visibleCondition
+	}
 
 	var (
-		dbID        string
-		companyName string
-		cityCode    string
-		cityName    string
-		postContent string
-		createdAt   time.Time
+		dbID             string
+		companyName      string
+		cityCode         string
+		cityName         string
+		postContent      string
+		createdAt        time.Time
+		tokenHash        sql.NullString
+		resolution       sql.NullString
+		authorID         sql.NullString
+		moderationStatus string
+		moderationReason sql.NullString
+		moderatedAt      sql.NullTime
+		moderatedBy      sql.NullString
 	)
 
 	err := r.db.QueryRowContext(ctx, query, id.String()).Scan(
-		&dbID, &companyName, &cityCode, &cityName, &postContent, &createdAt,
+		&dbID, &companyName, &cityCode, &cityName, &postContent, &createdAt, &tokenHash, &resolution, &authorID,
+		&moderationStatus, &moderationReason, &moderatedAt, &moderatedBy,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -86,7 +176,167 @@ func (r *PostRepository) FindByID(ctx context.Context, id content.PostID) (*cont
 		return nil, apperrors.NewDatabaseErrorWithCause("failed to find post by id", err)
 	}
 
-	return r.scanPost(dbID, companyName, cityCode, cityName, postContent, createdAt)
+	moderation, err := scanModeration(moderationStatus, moderationReason, moderatedAt, moderatedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	followUps, err := r.findFollowUps(ctx, dbID)
+	if err != nil {
+		return nil, err
+	}
+
+	officialReply, err := r.findOfficialReply(ctx, dbID)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.scanPost(dbID, companyName, cityCode, cityName, postContent, createdAt, tokenHash, resolution,
+		content.WithAuthorID(authorID.String),
+		content.WithFollowUps(followUps),
+		content.WithOfficialReply(officialReply),
+		content.WithModeration(moderation),
+	)
+}
+
+// SaveModeration stores the moderation state of a Post. Only the moderation
+// columns and updated_at change, so the sitemap picks up the change.
+// It implements content.PostModerationRepository.
+func (r *PostRepository) SaveModeration(ctx context.Context, post *content.Post) error {
+	return savePostModeration(ctx, r.db, post)
+}
+
+// savePostModeration stores the moderation state of a Post using the given
+// connection or transaction.
+func savePostModeration(ctx context.Context, db execer, post *content.Post) error {
+	moderation := post.Moderation()
+
+	var moderatedAt sql.NullTime
+	if !moderation.ModeratedAt.IsZero() {
+		moderatedAt = sql.NullTime{Time: moderation.ModeratedAt, Valid: true}
+	}
+
+	result, err := db.ExecContext(ctx, `
+		UPDATE posts
+		SET moderation_status = $2, moderation_reason = $3, moderated_at = $4, moderated_by = $5, updated_at = $6
+		WHERE id = $1
+	`, post.ID().String(), moderation.Status.String(), nullString(moderation.Reason.String()), moderatedAt,
+		nullString(moderation.ModeratedBy), time.Now())
+	if err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to save post moderation", err)
+	}
+	if affected, err := result.RowsAffected(); err == nil && affected == 0 {
+		return apperrors.NewNotFoundError("post")
+	}
+
+	return nil
+}
+
+// scanModeration reconstructs the moderation state of a Post from database row data.
+func scanModeration(status string, reason sql.NullString, moderatedAt sql.NullTime, moderatedBy sql.NullString) (content.Moderation, error) {
+	moderationStatus, err := content.NewModerationStatus(status)
+	if err != nil {
+		return content.Moderation{}, apperrors.NewDatabaseErrorWithCause("invalid moderation status in database", err)
+	}
+
+	moderation := content.Moderation{Status: moderationStatus, ModeratedAt: moderatedAt.Time, ModeratedBy: moderatedBy.String}
+	if reason.Valid {
+		moderation.Reason, err = content.NewModerationReason(reason.String)
+		if err != nil {
+			return content.Moderation{}, apperrors.NewDatabaseErrorWithCause("invalid moderation reason in database", err)
+		}
+	}
+
+	return moderation, nil
+}
+
+// FindByModerationStatus finds Posts with the moderation status, the ones
+// moderated longest ago first, optionally limited to some cities.
+// It implements content.ModerationQueueRepository.
+func (r *PostRepository) FindByModerationStatus(ctx context.Context, status content.ModerationStatus, cityCodes []string, page, pageSize int) ([]*content.Post, int, error) {
+	// Validate pagination parameters
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	offset := (page - 1) * pageSize
+
+	where := "moderation_status = $1"
+	args := []interface{}{status.String()}
+	if len(cityCodes) > 0 {
+		where += " AND city_code = ANY($2)"
+		args = append(args, pq.Array(cityCodes))
+	}
+
+	query := fmt.Sprintf(`
+		SELECT id, company_name, city_code, city_name, content, created_at, management_token_hash, resolution_status,
+			author_id, moderation_status, moderation_reason, moderated_at, moderated_by
+		FROM posts
+		WHERE %s
+		ORDER BY moderated_at ASC, id ASC
+		LIMIT $%d OFFSET $%d
+	`, where, len(args)+1, len(args)+2)
+
+	rows, err := r.db.QueryContext(ctx, query, append(args, pageSize, offset)...)
+	if err != nil {
+		return nil, 0, apperrors.NewDatabaseErrorWithCause("failed to find posts by moderation status", err)
+	}
+	defer rows.Close()
+
+	var posts []*content.Post
+	for rows.Next() {
+		var (
+			dbID             string
+			companyName      string
+			cityCode         string
+			cityName         string
+			postContent      string
+			createdAt        time.Time
+			tokenHash        sql.NullString
+			resolution       sql.NullString
+			authorID         sql.NullString
+			moderationStatus string
+			moderationReason sql.NullString
+			moderatedAt      sql.NullTime
+			moderatedBy      sql.NullString
+		)
+
+		if err := rows.Scan(&dbID, &companyName, &cityCode, &cityName, &postContent, &createdAt, &tokenHash, &resolution,
+			&authorID, &moderationStatus, &moderationReason, &moderatedAt, &moderatedBy); err != nil {
+			return nil, 0, apperrors.NewDatabaseErrorWithCause("failed to scan post", err)
+		}
+
+		moderation, err := scanModeration(moderationStatus, moderationReason, moderatedAt, moderatedBy)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		post, err := r.scanPost(dbID, companyName, cityCode, cityName, postContent, createdAt, tokenHash, resolution,
+			content.WithAuthorID(authorID.String),
+			content.WithModeration(moderation),
+		)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		posts = append(posts, post)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, apperrors.NewDatabaseErrorWithCause("failed to iterate posts", err)
+	}
+
+	// Query for total count
+	var total int
+	err = r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM posts WHERE `+where, args...).Scan(&total)
+	if err != nil {
+		return nil, 0, apperrors.NewDatabaseErrorWithCause("failed to count posts", err)
+	}
+
+	return posts, total, nil
 }
 
 // FindByCity finds Posts by city with pagination.
@@ -106,9 +356,9 @@ func (r *PostRepository) FindByCity(ctx context.Context, city shared.City, page,
 
 	// Query for posts
 	query := `
-		SELECT id, company_name, city_code, city_name, content, created_at
+		SELECT id, company_name, city_code, city_name, content, created_at, management_token_hash, resolution_status
 		FROM posts
-		WHERE city_code = $1
+		WHERE city_code = $1 AND moderation_status = 'VISIBLE'
 		ORDER BY created_at DESC
 		LIMIT $2 OFFSET $3
 	`
@@ -128,13 +378,15 @@ func (r *PostRepository) FindByCity(ctx context.Context, city shared.City, page,
 			cityName    string
 			postContent string
 			createdAt   time.Time
+			tokenHash   sql.NullString
+			resolution  sql.NullString
 		)
 
-		if err := rows.Scan(&dbID, &companyName, &cityCode, &cityName, &postContent, &createdAt); err != nil {
+		if err := rows.Scan(&dbID, &companyName, &cityCode, &cityName, &postContent, &createdAt, &tokenHash, &resolution); err != nil {
 			return nil, 0, apperrors.NewDatabaseErrorWithCause("failed to scan post", err)
 		}
 
-		post, err := r.scanPost(dbID, companyName, cityCode, cityName, postContent, createdAt)
+		post, err := r.scanPost(dbID, companyName, cityCode, cityName, postContent, createdAt, tokenHash, resolution)
 		if err != nil {
 			return nil, 0, err
 		}
@@ -147,7 +399,7 @@ func (r *PostRepository) FindByCity(ctx context.Context, city shared.City, page,
 	}
 
 	// Query for total count
-	countQuery := `SELECT COUNT(*) FROM posts WHERE city_code = $1`
+	countQuery := `SELECT COUNT(*) FROM posts WHERE city_code = $1 AND moderation_status = 'VISIBLE'`
 	var total int
 	err = r.db.QueryRowContext(ctx, countQuery, city.Code()).Scan(&total)
 	if err != nil {
@@ -174,8 +426,9 @@ func (r *PostRepository) FindAll(ctx context.Context, page, pageSize int) ([]*co
 
 	// Query for all posts
 	query := `
-		SELECT id, company_name, city_code, city_name, content, created_at
+		SELECT id, company_name, city_code, city_name, content, created_at, management_token_hash, resolution_status
 		FROM posts
+		WHERE moderation_status = 'VISIBLE'
 		ORDER BY created_at DESC
 		LIMIT $1 OFFSET $2
 	`
@@ -195,13 +448,15 @@ func (r *PostRepository) FindAll(ctx context.Context, page, pageSize int) ([]*co
 			cityName    string
 			postContent string
 			createdAt   time.Time
+			tokenHash   sql.NullString
+			resolution  sql.NullString
 		)
 
-		if err := rows.Scan(&dbID, &companyName, &cityCode, &cityName, &postContent, &createdAt); err != nil {
+		if err := rows.Scan(&dbID, &companyName, &cityCode, &cityName, &postContent, &createdAt, &tokenHash, &resolution); err != nil {
 			return nil, 0, apperrors.NewDatabaseErrorWithCause("failed to scan post", err)
 		}
 
-		post, err := r.scanPost(dbID, companyName, cityCode, cityName, postContent, createdAt)
+		post, err := r.scanPost(dbID, companyName, cityCode, cityName, postContent, createdAt, tokenHash, resolution)
 		if err != nil {
 			return nil, 0, err
 		}
@@ -214,7 +469,7 @@ func (r *PostRepository) FindAll(ctx context.Context, page, pageSize int) ([]*co
 	}
 
 	// Query for total count
-	countQuery := `SELECT COUNT(*) FROM posts`
+	countQuery := `SELECT COUNT(*) FROM posts WHERE moderation_status = 'VISIBLE'`
 	var total int
 	err = r.db.QueryRowContext(ctx, countQuery).Scan(&total)
 	if err != nil {
@@ -253,9 +508,9 @@ func (r *PostRepository) Search(ctx context.Context, keyword string, city *share
 	if city != nil {
 		// Search with city filter
 		query = `
-			SELECT id, company_name, city_code, city_name, content, created_at
+			SELECT id, company_name, city_code, city_name, content, created_at, management_token_hash, resolution_status
 			FROM posts
-			WHERE city_code = $1
+			WHERE city_code = $1 AND moderation_status = 'VISIBLE'
 				AND to_tsvector('simple', company_name || ' ' || content) @@ plainto_tsquery('simple', $2)
 			ORDER BY created_at DESC
 			LIMIT $3 OFFSET $4
@@ -264,7 +519,7 @@ func (r *PostRepository) Search(ctx context.Context, keyword string, city *share
 		countQuery = `
 			SELECT COUNT(*)
 			FROM posts
-			WHERE city_code = $1
+			WHERE city_code = $1 AND moderation_status = 'VISIBLE'
 				AND to_tsvector('simple', company_name || ' ' || content) @@ plainto_tsquery('simple', $2)
 		`
 
@@ -272,9 +527,10 @@ func (r *PostRepository) Search(ctx context.Context, keyword string, city *share
 	} else {
 		// Search across all cities
 		query = `
-			SELECT id, company_name, city_code, city_name, content, created_at
+			SELECT id, company_name, city_code, city_name, content, created_at, management_token_hash, resolution_status
 			FROM posts
 			WHERE to_tsvector('simple', company_name || ' ' || content) @@ plainto_tsquery('simple', $1)
+				AND moderation_status = 'VISIBLE'
 			ORDER BY created_at DESC
 			LIMIT $2 OFFSET $3
 		`
@@ -283,6 +539,7 @@ func (r *PostRepository) Search(ctx context.Context, keyword string, city *share
 			SELECT COUNT(*)
 			FROM posts
 			WHERE to_tsvector('simple', company_name || ' ' || content) @@ plainto_tsquery('simple', $1)
+				AND moderation_status = 'VISIBLE'
 		`
 
 		args = []interface{}{keyword, pageSize, offset}
@@ -303,13 +560,15 @@ func (r *PostRepository) Search(ctx context.Context, keyword string, city *share
 			cityName    string
 			postContent string
 			createdAt   time.Time
+			tokenHash   sql.NullString
+			resolution  sql.NullString
 		)
 
-		if err := rows.Scan(&dbID, &companyName, &cityCode, &cityName, &postContent, &createdAt); err != nil {
+		if err := rows.Scan(&dbID, &companyName, &cityCode, &cityName, &postContent, &createdAt, &tokenHash, &resolution); err != nil {
 			return nil, 0, apperrors.NewDatabaseErrorWithCause("failed to scan post", err)
 		}
 
-		post, err := r.scanPost(dbID, companyName, cityCode, cityName, postContent, createdAt)
+		post, err := r.scanPost(dbID, companyName, cityCode, cityName, postContent, createdAt, tokenHash, resolution)
 		if err != nil {
 			return nil, 0, err
 		}
@@ -339,7 +598,12 @@ func (r *PostRepository) Search(ctx context.Context, keyword string, city *share
 }
 
 // scanPost reconstructs a Post entity from database row data.
-func (r *PostRepository) scanPost(dbID, companyName, cityCode, cityName, postContent string, createdAt time.Time) (*content.Post, error) {
+func (r *PostRepository) scanPost(
+	dbID, companyName, cityCode, cityName, postContent string,
+	createdAt time.Time,
+	tokenHash, resolution sql.NullString,
+	extra ...content.PostOption,
+) (*content.Post, error) {
 	// Reconstruct value objects
 	postID, err := content.NewPostID(dbID)
 	if err != nil {
@@ -362,10 +626,506 @@ func (r *PostRepository) scanPost(dbID, companyName, cityCode, cityName, postCon
 	}
 
 	// Create Post from database data using NewPostFromDB
-	post, err := content.NewPostFromDB(postID, company, city, contentVO, createdAt)
+	opts := append([]content.PostOption{
+		content.WithManagementTokenHash(tokenHash.String),
+		content.WithResolution(content.ResolutionStatus(resolution.String)),
+	}, extra...)
+	post, err := content.NewPostFromDB(postID, company, city, contentVO, createdAt, opts...)
 	if err != nil {
 		return nil, apperrors.NewInternalErrorWithCause("failed to reconstruct post", err)
 	}
 
 	return post, nil
 }
+
+// FindByFilter finds Posts matching the filter with pagination.
+// Returns a slice of Posts, total count, and an error.
+// The page parameter is 1-based (page 1 is the first page).
+// The pageSize parameter specifies the number of items per page.
+func (r *PostRepository) FindByFilter(ctx context.Context, filter content.PostFilter, page, pageSize int) ([]*content.Post, int, error) {
+	// Validate pagination parameters
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	offset := (page - 1) * pageSize
+
+	// Build WHERE clause from the filter
+	where, args := buildFilterClause(filter)
+
+	query := fmt.Sprintf(`
+		SELECT id, company_name, city_code, city_name, content, created_at, management_token_hash, resolution_status
+		FROM posts
+		%s
+		ORDER BY created_at DESC
+		LIMIT $%d OFFSET $%d
+	`, where, len(args)+1, len(args)+2)
+
+	rows, err := r.db.QueryContext(ctx, query, append(args, pageSize, offset)...)
+	if err != nil {
+		return nil, 0, apperrors.NewDatabaseErrorWithCause("failed to find posts by filter", err)
+	}
+	defer rows.Close()
+
+	var posts []*content.Post
+	for rows.Next() {
+		var (
+			dbID        string
+			companyName string
+			cityCode    string
+			cityName    string
+			postContent string
+			createdAt   time.Time
+			tokenHash   sql.NullString
+			resolution  sql.NullString
+		)
+
+		if err := rows.Scan(&dbID, &companyName, &cityCode, &cityName, &postContent, &createdAt, &tokenHash, &resolution); err != nil {
+			return nil, 0, apperrors.NewDatabaseErrorWithCause("failed to scan post", err)
+		}
+
+		post, err := r.scanPost(dbID, companyName, cityCode, cityName, postContent, createdAt, tokenHash, resolution)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		posts = append(posts, post)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, apperrors.NewDatabaseErrorWithCause("failed to iterate posts", err)
+	}
+
+	// Query for total count
+	var total int
+	err = r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM posts "+where, args...).Scan(&total)
+	if err != nil {
+		return nil, 0, apperrors.NewDatabaseErrorWithCause("failed to count posts", err)
+	}
+
+	return posts, total, nil
+}
+
+// FindByFilterAfter returns up to limit Posts matching the filter after the
+// cursor in (created_at, id) order, oldest first. A nil cursor starts at the
+// first post. Follow-ups are not loaded.
+func (r *PostRepository) FindByFilterAfter(ctx context.Context, filter content.PostFilter, after *content.PostCursor, limit int) ([]*content.Post, error) {
+	if limit < 1 {
+		limit = 100
+	}
+
+	where, args := buildFilterClause(filter)
+	if after != nil {
+		args = append(args, after.CreatedAt, after.ID.String())
+		where += fmt.Sprintf(" AND (created_at, id) > ($%d, $%d)", len(args)-1, len(args))
+	}
+
+	query := fmt.Sprintf(`
+		SELECT id, company_name, city_code, city_name, content, created_at, management_token_hash, resolution_status
+		FROM posts
+		%s
+		ORDER BY created_at, id
+		LIMIT $%d
+	`, where, len(args)+1)
+
+	rows, err := r.db.QueryContext(ctx, query, append(args, limit)...)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to find posts by filter", err)
+	}
+	defer rows.Close()
+
+	var posts []*content.Post
+	for rows.Next() {
+		var (
+			dbID        string
+			companyName string
+			cityCode    string
+			cityName    string
+			postContent string
+			createdAt   time.Time
+			tokenHash   sql.NullString
+			resolution  sql.NullString
+		)
+
+		if err := rows.Scan(&dbID, &companyName, &cityCode, &cityName, &postContent, &createdAt, &tokenHash, &resolution); err != nil {
+			return nil, apperrors.NewDatabaseErrorWithCause("failed to scan post", err)
+		}
+
+		post, err := r.scanPost(dbID, companyName, cityCode, cityName, postContent, createdAt, tokenHash, resolution)
+		if err != nil {
+			return nil, err
+		}
+
+		posts = append(posts, post)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to iterate posts", err)
+	}
+
+	return posts, nil
+}
+
+// FindSummariesByFilter finds PostSummaries matching the filter with pagination.
+// Only the first content.SummarySourceLength characters of the content are loaded.
+func (r *PostRepository) FindSummariesByFilter(ctx context.Context, filter content.PostFilter, page, pageSize int) ([]*content.PostSummary, int, error) {
+	where, args := buildFilterClause(filter)
+	return r.findSummaries(ctx, where, args, page, pageSize)
+}
+
+// SearchSummaries searches PostSummaries by keyword with optional city filter and pagination.
+// Only the first content.SummarySourceLength characters of the content are loaded.
+func (r *PostRepository) SearchSummaries(ctx context.Context, keyword string, city *shared.City, page, pageSize int) ([]*content.PostSummary, int, error) {
+	args := []interface{}{keyword}
+	where := "WHERE to_tsvector('simple', company_name || ' ' || content) @@ plainto_tsquery('simple', $1) AND " + visibleCondition
+	if city != nil {
+		args = append(args, city.Code())
+		where += " AND city_code = $2"
+	}
+	return r.findSummaries(ctx, where, args, page, pageSize)
+}
+
+// findSummaries runs a PostSummary query with the given WHERE clause and pagination.
+func (r *PostRepository) findSummaries(ctx context.Context, where string, args []interface{}, page, pageSize int) ([]*content.PostSummary, int, error) {
+	// Validate pagination parameters
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	offset := (page - 1) * pageSize
+
+	n := len(args)
+	query := fmt.Sprintf(`
+		SELECT id, company_name, city_code, city_name,
+			LEFT(content, $%d), char_length(content) > $%d,
+			created_at, resolution_status
+		FROM posts
+		%s
+		ORDER BY created_at DESC
+		LIMIT $%d OFFSET $%d
+	`, n+1, n+1, where, n+2, n+3)
+
+	rows, err := r.db.QueryContext(ctx, query, append(args, content.SummarySourceLength, pageSize, offset)...)
+	if err != nil {
+		return nil, 0, apperrors.NewDatabaseErrorWithCause("failed to find post summaries", err)
+	}
+	defer rows.Close()
+
+	var summaries []*content.PostSummary
+	for rows.Next() {
+		var (
+			dbID          string
+			companyName   string
+			cityCode      string
+			cityName      string
+			contentPrefix string
+			truncated     bool
+			createdAt     time.Time
+			resolution    sql.NullString
+		)
+
+		if err := rows.Scan(&dbID, &companyName, &cityCode, &cityName, &contentPrefix, &truncated, &createdAt, &resolution); err != nil {
+			return nil, 0, apperrors.NewDatabaseErrorWithCause("failed to scan post summary", err)
+		}
+
+		postID, err := content.NewPostID(dbID)
+		if err != nil {
+			return nil, 0, apperrors.NewDatabaseErrorWithCause("invalid post id in database", err)
+		}
+		company, err := content.NewCompanyName(companyName)
+		if err != nil {
+			return nil, 0, apperrors.NewDatabaseErrorWithCause("invalid company name in database", err)
+		}
+		city, err := shared.NewCity(cityCode, cityName)
+		if err != nil {
+			return nil, 0, apperrors.NewDatabaseErrorWithCause("invalid city in database", err)
+		}
+
+		summaries = append(summaries, content.NewPostSummaryFromDB(
+			postID, company, city, contentPrefix, truncated, createdAt, content.ResolutionStatus(resolution.String),
+		))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, apperrors.NewDatabaseErrorWithCause("failed to iterate post summaries", err)
+	}
+
+	// Query for total count
+	var total int
+	err = r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM posts "+where, args...).Scan(&total)
+	if err != nil {
+		return nil, 0, apperrors.NewDatabaseErrorWithCause("failed to count posts", err)
+	}
+
+	return summaries, total, nil
+}
+
+// FindDocumentsSince returns the most recent posts created after since as
+// PostDocuments, newest first, at most limit posts.
+// It implements content.PostDocumentRepository.
+func (r *PostRepository) FindDocumentsSince(ctx context.Context, since time.Time, limit int) ([]*content.PostDocument, error) {
+	query := `
+		SELECT id, company_name, city_code, content, created_at
+		FROM posts
+		WHERE created_at > $1 AND moderation_status = 'VISIBLE'
+		ORDER BY created_at DESC
+		LIMIT $2
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, since, limit)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to find post documents", err)
+	}
+	defer rows.Close()
+
+	var docs []*content.PostDocument
+	for rows.Next() {
+		var (
+			dbID        string
+			companyName string
+			cityCode    string
+			body        string
+			createdAt   time.Time
+		)
+
+		if err := rows.Scan(&dbID, &companyName, &cityCode, &body, &createdAt); err != nil {
+			return nil, apperrors.NewDatabaseErrorWithCause("failed to scan post document", err)
+		}
+
+		postID, err := content.NewPostID(dbID)
+		if err != nil {
+			return nil, apperrors.NewDatabaseErrorWithCause("invalid post id in database", err)
+		}
+		company, err := content.NewCompanyName(companyName)
+		if err != nil {
+			return nil, apperrors.NewDatabaseErrorWithCause("invalid company name in database", err)
+		}
+
+		docs = append(docs, content.NewPostDocumentFromDB(postID, company, cityCode, body, createdAt))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to iterate post documents", err)
+	}
+
+	return docs, nil
+}
+
+// FindRefsAfter returns up to limit posts after the cursor in (created_at, id)
+// order, oldest first. A nil cursor starts at the first post.
+// It implements content.PostRefRepository.
+func (r *PostRepository) FindRefsAfter(ctx context.Context, after *content.PostCursor, limit int) ([]*content.PostRef, error) {
+	if after == nil {
+		query := `
+			SELECT id, created_at, updated_at
+			FROM posts
+			WHERE moderation_status = 'VISIBLE'
+			ORDER BY created_at, id
+			LIMIT $1
+		`
+		return r.queryRefs(ctx, query, limit)
+	}
+
+	query := `
+		SELECT id, created_at, updated_at
+		FROM posts
+		WHERE (created_at, id) > ($1, $2) AND moderation_status = 'VISIBLE'
+		ORDER BY created_at, id
+		LIMIT $3
+	`
+	return r.queryRefs(ctx, query, after.CreatedAt, after.ID.String(), limit)
+}
+
+// FindRefsUpdatedSince returns up to limit posts changed after since, least
+// recently changed first.
+// It implements content.PostRefRepository.
+func (r *PostRepository) FindRefsUpdatedSince(ctx context.Context, since time.Time, limit int) ([]*content.PostRef, error) {
+	query := `
+		SELECT id, created_at, updated_at
+		FROM posts
+		WHERE updated_at > $1
+		ORDER BY updated_at
+		LIMIT $2
+	`
+	return r.queryRefs(ctx, query, since, limit)
+}
+
+// queryRefs runs a query selecting id, created_at and updated_at and scans PostRefs.
+func (r *PostRepository) queryRefs(ctx context.Context, query string, args ...interface{}) ([]*content.PostRef, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to find post refs", err)
+	}
+	defer rows.Close()
+
+	var refs []*content.PostRef
+	for rows.Next() {
+		var (
+			dbID      string
+			createdAt time.Time
+			updatedAt time.Time
+		)
+
+		if err := rows.Scan(&dbID, &createdAt, &updatedAt); err != nil {
+			return nil, apperrors.NewDatabaseErrorWithCause("failed to scan post ref", err)
+		}
+
+		postID, err := content.NewPostID(dbID)
+		if err != nil {
+			return nil, apperrors.NewDatabaseErrorWithCause("invalid post id in database", err)
+		}
+
+		refs = append(refs, content.NewPostRefFromDB(postID, createdAt, updatedAt))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to iterate post refs", err)
+	}
+
+	return refs, nil
+}
+
+// buildFilterClause builds a WHERE clause and its positional arguments from a PostFilter.
+// Only visible posts match, so the clause is never empty.
+func buildFilterClause(filter content.PostFilter) (string, []interface{}) {
+	conditions := []string{visibleCondition}
+	var args []interface{}
+
+	if filter.City != nil {
+		args = append(args, filter.City.Code())
+		conditions = append(conditions, fmt.Sprintf("city_code = $%d", len(args)))
+	}
+	if filter.Resolution != nil {
+		args = append(args, filter.Resolution.String())
+		conditions = append(conditions, fmt.Sprintf("resolution_status = $%d", len(args)))
+	}
+	if filter.AuthorID != nil {
+		args = append(args, *filter.AuthorID)
+		conditions = append(conditions, fmt.Sprintf("author_id = $%d", len(args)))
+	}
+	if filter.Companies != nil {
+		names := make([]string, 0, len(filter.Companies))
+		for _, company := range filter.Companies {
+			names = append(names, company.String())
+		}
+		args = append(args, pq.Array(names))
+		conditions = append(conditions, fmt.Sprintf("company_name = ANY($%d)", len(args)))
+	}
+	if filter.CompanyCanonical != "" {
+		args = append(args, filter.CompanyCanonical)
+		conditions = append(conditions, fmt.Sprintf("company_canonical = $%d", len(args)))
+	}
+	if filter.CreatedAfter != nil {
+		args = append(args, *filter.CreatedAfter)
+		conditions = append(conditions, fmt.Sprintf("created_at > $%d", len(args)))
+	}
+	if filter.CreatedSince != nil {
+		args = append(args, *filter.CreatedSince)
+		conditions = append(conditions, fmt.Sprintf("created_at >= $%d", len(args)))
+	}
+	if filter.CreatedBefore != nil {
+		args = append(args, *filter.CreatedBefore)
+		conditions = append(conditions, fmt.Sprintf("created_at < $%d", len(args)))
+	}
+	if filter.IDs != nil {
+		ids := make([]string, 0, len(filter.IDs))
+		for _, id := range filter.IDs {
+			ids = append(ids, id.String())
+		}
+		args = append(args, pq.Array(ids))
+		conditions = append(conditions, fmt.Sprintf("id = ANY($%d::uuid[])", len(args)))
+	}
+
+	return "WHERE " + strings.Join(conditions, " AND "), args
+}
+
+// findFollowUps loads the follow-up timeline of a post, oldest first.
+func (r *PostRepository) findFollowUps(ctx context.Context, postID string) ([]*content.FollowUp, error) {
+	query := `
+		SELECT id, status, note, created_at
+		FROM post_follow_ups
+		WHERE post_id = $1
+		ORDER BY created_at ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, postID)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to find follow-ups", err)
+	}
+	defer rows.Close()
+
+	var followUps []*content.FollowUp
+	for rows.Next() {
+		var (
+			id        string
+			status    string
+			note      string
+			createdAt time.Time
+		)
+
+		if err := rows.Scan(&id, &status, &note, &createdAt); err != nil {
+			return nil, apperrors.NewDatabaseErrorWithCause("failed to scan follow-up", err)
+		}
+
+		resolution, err := content.NewResolutionStatus(status)
+		if err != nil {
+			return nil, apperrors.NewDatabaseErrorWithCause("invalid follow-up status in database", err)
+		}
+
+		noteVO, err := content.NewFollowUpNote(note)
+		if err != nil {
+			return nil, apperrors.NewDatabaseErrorWithCause("invalid follow-up note in database", err)
+		}
+
+		followUps = append(followUps, content.NewFollowUpFromDB(id, resolution, noteVO, createdAt))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to iterate follow-ups", err)
+	}
+
+	return followUps, nil
+}
+
+// nullString converts an empty string to a SQL NULL.
+func nullString(value string) sql.NullString {
+	return sql.NullString{String: value, Valid: value != ""}
+}
+
+// findOfficialReply loads the official company reply of a post (nil if none).
+func (r *PostRepository) findOfficialReply(ctx context.Context, postID string) (*content.OfficialReply, error) {
+	query := `
+		SELECT id, representative_id, verified_domain, content, created_at
+		FROM post_official_replies
+		WHERE post_id = $1
+	`
+
+	var (
+		id               string
+		representativeID string
+		verifiedDomain   string
+		replyContent     string
+		createdAt        time.Time
+	)
+
+	err := r.db.QueryRowContext(ctx, query, postID).Scan(&id, &representativeID, &verifiedDomain, &replyContent, &createdAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to find official reply", err)
+	}
+
+	contentVO, err := content.NewReplyContent(replyContent)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("invalid official reply in database", err)
+	}
+
+	return content.NewOfficialReplyFromDB(id, representativeID, verifiedDomain, contentVO, createdAt), nil
+}
@@ -0,0 +1,149 @@
+// Package postgres provides PostgreSQL implementation of domain repositories.
+// It implements the PostRepository interface defined in the Domain Layer.
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"fuck_boss/backend/internal/domain/identity"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// RefreshTokenRepository is the PostgreSQL implementation of identity.RefreshTokenRepository.
+type RefreshTokenRepository struct {
+	// db is the database connection.
+	db *sql.DB
+}
+
+// NewRefreshTokenRepository creates a new RefreshTokenRepository instance.
+func NewRefreshTokenRepository(db *sql.DB) *RefreshTokenRepository {
+	return &RefreshTokenRepository{
+		db: db,
+	}
+}
+
+// Save saves a RefreshToken to the database.
+// If the token already exists (same ID), only its revocation state is updated.
+func (r *RefreshTokenRepository) Save(ctx context.Context, token *identity.RefreshToken) error {
+	if err := saveRefreshToken(ctx, r.db, token); err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to save refresh token", err)
+	}
+	return nil
+}
+
+// Rotate revokes the old token and inserts its successor in one transaction.
+// The old token is only revoked if it is still active, so two concurrent
+// rotations of the same token cannot both succeed; the loser gets a conflict error.
+func (r *RefreshTokenRepository) Rotate(ctx context.Context, revoked *identity.RefreshToken, next *identity.RefreshToken) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to begin transaction", err)
+	}
+	defer tx.Rollback()
+
+	// Insert the successor first so that replaced_by points to an existing row
+	if err := saveRefreshToken(ctx, tx, next); err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to save refresh token", err)
+	}
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE refresh_tokens
+		SET revoked_at = $2, replaced_by = $3
+		WHERE id = $1 AND revoked_at IS NULL
+	`, revoked.ID(), revoked.RevokedAt(), nullString(revoked.ReplacedBy()))
+	if err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to revoke refresh token", err)
+	}
+	if affected, err := result.RowsAffected(); err == nil && affected == 0 {
+		return apperrors.NewConflictError("refresh token was already rotated")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to commit refresh token rotation", err)
+	}
+
+	return nil
+}
+
+// FindByHash finds a RefreshToken by the SHA-256 hash of its raw value.
+func (r *RefreshTokenRepository) FindByHash(ctx context.Context, tokenHash string) (*identity.RefreshToken, error) {
+	query := `
+		SELECT id, user_id, family_id, token_hash, expires_at, created_at, revoked_at, replaced_by
+		FROM refresh_tokens
+		WHERE token_hash = $1
+	`
+
+	var (
+		id         string
+		userID     string
+		familyID   string
+		hash       string
+		expiresAt  time.Time
+		createdAt  time.Time
+		revokedAt  sql.NullTime
+		replacedBy sql.NullString
+	)
+
+	err := r.db.QueryRowContext(ctx, query, tokenHash).Scan(
+		&id, &userID, &familyID, &hash, &expiresAt, &createdAt, &revokedAt, &replacedBy,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, apperrors.NewNotFoundError("refresh token")
+		}
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to find refresh token", err)
+	}
+
+	userIDVO, err := identity.NewUserID(userID)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("invalid user id in database", err)
+	}
+
+	var revokedAtPtr *time.Time
+	if revokedAt.Valid {
+		revokedAtPtr = &revokedAt.Time
+	}
+
+	return identity.NewRefreshTokenFromDB(id, userIDVO, familyID, hash, expiresAt, createdAt, revokedAtPtr, replacedBy.String), nil
+}
+
+// RevokeFamily revokes all active tokens of a family.
+func (r *RefreshTokenRepository) RevokeFamily(ctx context.Context, familyID string, at time.Time) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE refresh_tokens
+		SET revoked_at = $2
+		WHERE family_id = $1 AND revoked_at IS NULL
+	`, familyID, at)
+	if err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to revoke refresh token family", err)
+	}
+	return nil
+}
+
+// execer is implemented by both *sql.DB and *sql.Tx.
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// saveRefreshToken upserts a refresh token using the given connection or transaction.
+func saveRefreshToken(ctx context.Context, db execer, token *identity.RefreshToken) error {
+	_, err := db.ExecContext(ctx, `
+		INSERT INTO refresh_tokens (id, user_id, family_id, token_hash, expires_at, created_at, revoked_at, replaced_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (id) DO UPDATE SET
+			revoked_at = EXCLUDED.revoked_at,
+			replaced_by = EXCLUDED.replaced_by
+	`,
+		token.ID(),
+		token.UserID().String(),
+		token.FamilyID(),
+		token.TokenHash(),
+		token.ExpiresAt(),
+		token.CreatedAt(),
+		token.RevokedAt(),
+		nullString(token.ReplacedBy()),
+	)
+	return err
+}
@@ -0,0 +1,130 @@
+// Package postgres provides PostgreSQL implementation of domain repositories.
+// It implements the PostRepository interface defined in the Domain Layer.
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"fuck_boss/backend/internal/domain/company"
+	"fuck_boss/backend/internal/domain/content"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// RepresentativeRepository is the PostgreSQL implementation of company.RepresentativeRepository.
+type RepresentativeRepository struct {
+	// db is the database connection.
+	db *sql.DB
+}
+
+// NewRepresentativeRepository creates a new RepresentativeRepository instance.
+func NewRepresentativeRepository(db *sql.DB) *RepresentativeRepository {
+	return &RepresentativeRepository{
+		db: db,
+	}
+}
+
+// Save saves a Representative to the database.
+// If the Representative already exists (same ID), only its status and verification time are updated;
+// the company, domain, challenge and access token never change after registration.
+// Returns an error if the operation fails.
+func (r *RepresentativeRepository) Save(ctx context.Context, representative *company.Representative) error {
+	query := `
+		INSERT INTO company_representatives (id, company_name, domain, challenge_method, challenge_code,
+			access_token_hash, status, created_at, updated_at, verified_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (id) DO UPDATE SET
+			status = EXCLUDED.status,
+			updated_at = EXCLUDED.updated_at,
+			verified_at = EXCLUDED.verified_at
+	`
+
+	challenge := representative.Challenge()
+	_, err := r.db.ExecContext(ctx, query,
+		representative.ID().String(),
+		representative.Company().String(),
+		challenge.Domain().String(),
+		challenge.Method().String(),
+		challenge.Code(),
+		representative.AccessTokenHash(),
+		representative.Status().String(),
+		representative.CreatedAt(),
+		time.Now(),
+		representative.VerifiedAt(),
+	)
+	if err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to save representative", err)
+	}
+
+	return nil
+}
+
+// FindByID finds a Representative by its ID.
+// Returns the Representative if found, or an error if not found or operation fails.
+func (r *RepresentativeRepository) FindByID(ctx context.Context, id company.RepresentativeID) (*company.Representative, error) {
+	query := `
+		SELECT id, company_name, domain, challenge_method, challenge_code, access_token_hash,
+			status, created_at, verified_at
+		FROM company_representatives
+		WHERE id = $1
+	`
+
+	var (
+		dbID            string
+		companyName     string
+		domain          string
+		method          string
+		code            string
+		accessTokenHash string
+		status          string
+		createdAt       time.Time
+		verifiedAt      sql.NullTime
+	)
+
+	err := r.db.QueryRowContext(ctx, query, id.String()).Scan(
+		&dbID, &companyName, &domain, &method, &code, &accessTokenHash, &status, &createdAt, &verifiedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, apperrors.NewNotFoundError("representative")
+		}
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to find representative by id", err)
+	}
+
+	// Reconstruct value objects
+	representativeID, err := company.NewRepresentativeID(dbID)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("invalid representative id in database", err)
+	}
+
+	companyVO, err := content.NewCompanyName(companyName)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("invalid company name in database", err)
+	}
+
+	domainVO, err := company.NewCompanyDomain(domain)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("invalid company domain in database", err)
+	}
+
+	methodVO, err := company.NewChallengeMethod(method)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("invalid challenge method in database", err)
+	}
+
+	var verifiedAtPtr *time.Time
+	if verifiedAt.Valid {
+		verifiedAtPtr = &verifiedAt.Time
+	}
+
+	return company.NewRepresentativeFromDB(
+		representativeID,
+		companyVO,
+		company.NewChallenge(methodVO, domainVO, code),
+		accessTokenHash,
+		company.RepresentativeStatus(status),
+		createdAt,
+		verifiedAtPtr,
+	), nil
+}
@@ -0,0 +1,34 @@
+// Package postgres provides PostgreSQL implementation of domain repositories.
+// It implements the PostRepository interface defined in the Domain Layer.
+package postgres
+
+import (
+	"context"
+	"database/sql"
+)
+
+// SearchIndex is the PostgreSQL implementation of search.Index.
+// The search tokens of posts are not stored in a column: they are the GIN
+// expression index idx_posts_search on to_tsvector('simple', company_name || ' ' || content).
+type SearchIndex struct {
+	// db is the database connection.
+	db *sql.DB
+}
+
+// NewSearchIndex creates a new SearchIndex instance.
+func NewSearchIndex(db *sql.DB) *SearchIndex {
+	return &SearchIndex{
+		db: db,
+	}
+}
+
+// Rebuild rebuilds idx_posts_search concurrently, so searches and new posts
+// are not blocked, and refreshes the planner statistics of posts.
+// It cannot run inside a transaction.
+func (s *SearchIndex) Rebuild(ctx context.Context) error {
+	if _, err := s.db.ExecContext(ctx, "REINDEX INDEX CONCURRENTLY idx_posts_search"); err != nil {
+		return err
+	}
+	_, err := s.db.ExecContext(ctx, "ANALYZE posts")
+	return err
+}
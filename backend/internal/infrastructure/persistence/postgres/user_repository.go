@@ -0,0 +1,134 @@
+// Package postgres provides PostgreSQL implementation of domain repositories.
+// It implements the PostRepository interface defined in the Domain Layer.
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/lib/pq"
+
+	"fuck_boss/backend/internal/domain/identity"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// uniqueViolation is the PostgreSQL error code for unique constraint violations.
+const uniqueViolation = "23505"
+
+// UserRepository is the PostgreSQL implementation of identity.UserRepository.
+type UserRepository struct {
+	// db is the database connection.
+	db *sql.DB
+}
+
+// NewUserRepository creates a new UserRepository instance.
+func NewUserRepository(db *sql.DB) *UserRepository {
+	return &UserRepository{
+		db: db,
+	}
+}
+
+// Save saves a User to the database.
+// If the User already exists (same ID), its username, password hash, ban and update time are updated.
+// Returns a conflict error if the email or username is already taken by another user.
+func (r *UserRepository) Save(ctx context.Context, user *identity.User) error {
+	query := `
+		INSERT INTO users (id, email, username, password_hash, created_at, updated_at, banned_at, ban_reason)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (id) DO UPDATE SET
+			username = EXCLUDED.username,
+			password_hash = EXCLUDED.password_hash,
+			updated_at = EXCLUDED.updated_at,
+			banned_at = EXCLUDED.banned_at,
+			ban_reason = EXCLUDED.ban_reason
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		user.ID().String(),
+		user.Email().String(),
+		nullString(user.Username().String()),
+		user.PasswordHash(),
+		user.CreatedAt(),
+		time.Now(),
+		user.BannedAt(),
+		nullString(user.BanReason()),
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return apperrors.NewConflictError("email or username is already taken")
+		}
+		return apperrors.NewDatabaseErrorWithCause("failed to save user", err)
+	}
+
+	return nil
+}
+
+// FindByID finds a User by its ID.
+func (r *UserRepository) FindByID(ctx context.Context, id identity.UserID) (*identity.User, error) {
+	return r.findOne(ctx, "id = $1", id.String())
+}
+
+// FindByEmail finds a User by email address.
+func (r *UserRepository) FindByEmail(ctx context.Context, email identity.Email) (*identity.User, error) {
+	return r.findOne(ctx, "email = $1", email.String())
+}
+
+// FindByUsername finds a User by username.
+func (r *UserRepository) FindByUsername(ctx context.Context, username identity.Username) (*identity.User, error) {
+	return r.findOne(ctx, "username = $1", username.String())
+}
+
+// findOne finds a single User matching the condition.
+func (r *UserRepository) findOne(ctx context.Context, condition string, arg interface{}) (*identity.User, error) {
+	query := `
+		SELECT id, email, username, password_hash, created_at, updated_at, banned_at, ban_reason
+		FROM users
+		WHERE ` + condition
+
+	var (
+		dbID         string
+		email        string
+		username     sql.NullString
+		passwordHash string
+		createdAt    time.Time
+		updatedAt    time.Time
+		bannedAt     sql.NullTime
+		banReason    sql.NullString
+	)
+
+	err := r.db.QueryRowContext(ctx, query, arg).Scan(&dbID, &email, &username, &passwordHash, &createdAt, &updatedAt,
+		&bannedAt, &banReason)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, apperrors.NewNotFoundError("user")
+		}
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to find user", err)
+	}
+
+	// Reconstruct value objects
+	userID, err := identity.NewUserID(dbID)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("invalid user id in database", err)
+	}
+
+	emailVO, err := identity.NewEmail(email)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("invalid email in database", err)
+	}
+
+	usernameVO, err := identity.NewUsername(username.String)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("invalid username in database", err)
+	}
+
+	return identity.NewUserFromDB(userID, emailVO, usernameVO, passwordHash, createdAt, updatedAt,
+		nullTimePtr(bannedAt), banReason.String), nil
+}
+
+// isUniqueViolation reports whether err is a PostgreSQL unique constraint violation.
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == uniqueViolation
+}
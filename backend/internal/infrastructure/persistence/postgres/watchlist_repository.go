@@ -0,0 +1,303 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"fuck_boss/backend/internal/domain/content"
+	"fuck_boss/backend/internal/domain/watchlist"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// DeviceRepository is the PostgreSQL implementation of watchlist.DeviceRepository.
+type DeviceRepository struct {
+	// db is the database connection.
+	db *sql.DB
+}
+
+// NewDeviceRepository creates a new DeviceRepository instance.
+func NewDeviceRepository(db *sql.DB) *DeviceRepository {
+	return &DeviceRepository{
+		db: db,
+	}
+}
+
+// Register stores a newly issued device token hash.
+func (r *DeviceRepository) Register(ctx context.Context, tokenHash string, issuedAt time.Time) error {
+	query := `
+		INSERT INTO devices (token_hash, created_at)
+		VALUES ($1, $2)
+		ON CONFLICT (token_hash) DO NOTHING
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, tokenHash, issuedAt); err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to register device", err)
+	}
+
+	return nil
+}
+
+// Exists reports whether the device token hash was issued by the server.
+func (r *DeviceRepository) Exists(ctx context.Context, tokenHash string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM devices WHERE token_hash = $1)`, tokenHash).Scan(&exists)
+	if err != nil {
+		return false, apperrors.NewDatabaseErrorWithCause("failed to query device", err)
+	}
+
+	return exists, nil
+}
+
+// BookmarkRepository is the PostgreSQL implementation of watchlist.BookmarkRepository.
+type BookmarkRepository struct {
+	// db is the database connection.
+	db *sql.DB
+}
+
+// NewBookmarkRepository creates a new BookmarkRepository instance.
+func NewBookmarkRepository(db *sql.DB) *BookmarkRepository {
+	return &BookmarkRepository{
+		db: db,
+	}
+}
+
+// Save saves a Bookmark. Saving an existing bookmark keeps its original time.
+func (r *BookmarkRepository) Save(ctx context.Context, bookmark *watchlist.Bookmark) error {
+	query := `
+		INSERT INTO bookmarks (owner_key, post_id, created_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (owner_key, post_id) DO NOTHING
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		bookmark.Owner().Key(),
+		bookmark.PostID().String(),
+		bookmark.CreatedAt(),
+	)
+	if err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to save bookmark", err)
+	}
+
+	return nil
+}
+
+// Delete removes the owner's bookmark of a post.
+func (r *BookmarkRepository) Delete(ctx context.Context, owner watchlist.Owner, postID content.PostID) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM bookmarks WHERE owner_key = $1 AND post_id = $2`, owner.Key(), postID.String())
+	if err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to delete bookmark", err)
+	}
+
+	return nil
+}
+
+// FindByOwner finds the owner's bookmarks, newest first, with pagination.
+func (r *BookmarkRepository) FindByOwner(ctx context.Context, owner watchlist.Owner, page, pageSize int) ([]*watchlist.Bookmark, int, error) {
+	// Validate pagination parameters
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	offset := (page - 1) * pageSize
+
+	query := `
+		SELECT post_id, created_at
+		FROM bookmarks
+		WHERE owner_key = $1
+		ORDER BY created_at DESC, post_id
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, owner.Key(), pageSize, offset)
+	if err != nil {
+		return nil, 0, apperrors.NewDatabaseErrorWithCause("failed to find bookmarks", err)
+	}
+	defer rows.Close()
+
+	var bookmarks []*watchlist.Bookmark
+	for rows.Next() {
+		var (
+			postID    string
+			createdAt time.Time
+		)
+
+		if err := rows.Scan(&postID, &createdAt); err != nil {
+			return nil, 0, apperrors.NewDatabaseErrorWithCause("failed to scan bookmark", err)
+		}
+
+		postIDVO, err := content.NewPostID(postID)
+		if err != nil {
+			return nil, 0, apperrors.NewDatabaseErrorWithCause("invalid post ID in database", err)
+		}
+
+		bookmarks = append(bookmarks, watchlist.NewBookmarkFromDB(owner, postIDVO, createdAt))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, apperrors.NewDatabaseErrorWithCause("failed to iterate bookmarks", err)
+	}
+
+	// Query for total count
+	var total int
+	err = r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM bookmarks WHERE owner_key = $1`, owner.Key()).Scan(&total)
+	if err != nil {
+		return nil, 0, apperrors.NewDatabaseErrorWithCause("failed to count bookmarks", err)
+	}
+
+	return bookmarks, total, nil
+}
+
+// WatchRepository is the PostgreSQL implementation of watchlist.WatchRepository.
+type WatchRepository struct {
+	// db is the database connection.
+	db *sql.DB
+}
+
+// NewWatchRepository creates a new WatchRepository instance.
+func NewWatchRepository(db *sql.DB) *WatchRepository {
+	return &WatchRepository{
+		db: db,
+	}
+}
+
+// Save saves a CompanyWatch. Saving an existing watch keeps its original time.
+func (r *WatchRepository) Save(ctx context.Context, watch *watchlist.CompanyWatch) error {
+	query := `
+		INSERT INTO company_watches (owner_key, company_name, created_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (owner_key, company_name) DO NOTHING
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		watch.Owner().Key(),
+		watch.Company().String(),
+		watch.CreatedAt(),
+	)
+	if err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to save company watch", err)
+	}
+
+	return nil
+}
+
+// Delete removes the owner's watch of a company.
+func (r *WatchRepository) Delete(ctx context.Context, owner watchlist.Owner, company content.CompanyName) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM company_watches WHERE owner_key = $1 AND company_name = $2`, owner.Key(), company.String())
+	if err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to delete company watch", err)
+	}
+
+	return nil
+}
+
+// FindByOwner finds all companies watched by the owner, oldest first.
+func (r *WatchRepository) FindByOwner(ctx context.Context, owner watchlist.Owner) ([]*watchlist.CompanyWatch, error) {
+	query := `
+		SELECT company_name, created_at
+		FROM company_watches
+		WHERE owner_key = $1
+		ORDER BY created_at ASC, company_name
+		LIMIT $2
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, owner.Key(), watchlist.MaxWatchedCompanies)
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to find company watches", err)
+	}
+	defer rows.Close()
+
+	var watches []*watchlist.CompanyWatch
+	for rows.Next() {
+		var (
+			companyName string
+			createdAt   time.Time
+		)
+
+		if err := rows.Scan(&companyName, &createdAt); err != nil {
+			return nil, apperrors.NewDatabaseErrorWithCause("failed to scan company watch", err)
+		}
+
+		company, err := content.NewCompanyName(companyName)
+		if err != nil {
+			return nil, apperrors.NewDatabaseErrorWithCause("invalid company name in database", err)
+		}
+
+		watches = append(watches, watchlist.NewCompanyWatchFromDB(owner, company, createdAt))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to iterate company watches", err)
+	}
+
+	return watches, nil
+}
+
+// FindUserWatchers finds the IDs of logged-in users watching the company.
+func (r *WatchRepository) FindUserWatchers(ctx context.Context, company content.CompanyName) ([]string, error) {
+	query := `
+		SELECT owner_key
+		FROM company_watches
+		WHERE company_name = $1 AND owner_key LIKE 'user:%'
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, company.String())
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to find company watchers", err)
+	}
+	defer rows.Close()
+
+	var userIDs []string
+	for rows.Next() {
+		var ownerKey string
+		if err := rows.Scan(&ownerKey); err != nil {
+			return nil, apperrors.NewDatabaseErrorWithCause("failed to scan company watcher", err)
+		}
+
+		owner, err := watchlist.NewOwnerFromKey(ownerKey)
+		if err != nil || !owner.IsUser() {
+			continue
+		}
+		userIDs = append(userIDs, owner.UserID())
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to iterate company watchers", err)
+	}
+
+	return userIDs, nil
+}
+
+// LastVisit returns when the owner last marked the watched-company feed as seen.
+func (r *WatchRepository) LastVisit(ctx context.Context, owner watchlist.Owner) (time.Time, error) {
+	var lastVisitedAt time.Time
+	err := r.db.QueryRowContext(ctx, `SELECT last_visited_at FROM watch_visits WHERE owner_key = $1`, owner.Key()).Scan(&lastVisitedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return time.Time{}, nil
+		}
+		return time.Time{}, apperrors.NewDatabaseErrorWithCause("failed to query last visit", err)
+	}
+
+	return lastVisitedAt, nil
+}
+
+// MarkVisited records that the owner has seen the watched-company feed up to at.
+// The stored time never moves backwards.
+func (r *WatchRepository) MarkVisited(ctx context.Context, owner watchlist.Owner, at time.Time) error {
+	query := `
+		INSERT INTO watch_visits (owner_key, last_visited_at)
+		VALUES ($1, $2)
+		ON CONFLICT (owner_key) DO UPDATE SET
+			last_visited_at = GREATEST(watch_visits.last_visited_at, EXCLUDED.last_visited_at)
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, owner.Key(), at); err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to mark visit", err)
+	}
+
+	return nil
+}
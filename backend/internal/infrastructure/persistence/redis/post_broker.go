@@ -0,0 +1,262 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"sync"
+
+	"github.com/redis/go-redis/v9"
+
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/application/livefeed"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// livePostsChannel is the Redis pub/sub channel of newly published posts.
+const livePostsChannel = "live:posts"
+
+// livePostsStream is the capped Redis stream of recent post events, used to
+// resume a feed. Its entry IDs are the event IDs.
+const livePostsStream = "live:posts:events"
+
+// eventIDPattern matches Redis stream entry IDs ("<milliseconds>-<sequence>").
+var eventIDPattern = regexp.MustCompile(`^[0-9]+-[0-9]+$`)
+
+// publishScript appends a post to the stream and publishes it with its entry ID
+// in one step, so pub/sub messages are in the same order as the stream.
+// KEYS[1] is the stream, KEYS[2] the channel; ARGV[1] is the stream length,
+// ARGV[2] the JSON-encoded post.
+var publishScript = redis.NewScript(`
+local id = redis.call("XADD", KEYS[1], "MAXLEN", "~", ARGV[1], "*", "post", ARGV[2])
+redis.call("PUBLISH", KEYS[2], '{"id":"' .. id .. '","post":' .. ARGV[2] .. '}')
+return id
+`)
+
+// forgetScript deletes the stream entries of a post and returns their number.
+// The stream is capped, so scanning it is cheap.
+// KEYS[1] is the stream; ARGV[1] is the post ID.
+var forgetScript = redis.NewScript(`
+local deleted = 0
+for _, entry in ipairs(redis.call("XRANGE", KEYS[1], "-", "+")) do
+  local fields = entry[2]
+  for i = 1, #fields, 2 do
+    if fields[i] == "post" then
+      local ok, post = pcall(cjson.decode, fields[i + 1])
+      if ok and type(post) == "table" and post.ID == ARGV[1] then
+        deleted = deleted + redis.call("XDEL", KEYS[1], entry[1])
+      end
+    end
+  end
+end
+return deleted
+`)
+
+// liveEvent is the pub/sub message of a post event.
+type liveEvent struct {
+	ID   string       `json:"id"`
+	Post *dto.PostDTO `json:"post"`
+}
+
+// subscriberBuffer is the number of events buffered per local subscriber.
+// A subscriber that falls further behind is dropped (its channel is closed)
+// instead of slowing down the others.
+const subscriberBuffer = 64
+
+// PostBroker is the Redis implementation of livefeed.Publisher, livefeed.Subscriber
+// and livefeed.History.
+// Posts are published to a pub/sub channel, so every server instance receives
+// every post. Each instance holds a single Redis subscription while it has
+// local subscribers and fans the posts out to them in memory. The most recent
+// posts are also kept in a capped stream for resuming.
+type PostBroker struct {
+	// client is the Redis client.
+	client *redis.Client
+
+	// historySize is the approximate number of events kept in the stream.
+	historySize int64
+
+	// mu guards the fields below.
+	mu sync.Mutex
+
+	// pubsub is the Redis subscription (nil while there are no local subscribers).
+	pubsub *redis.PubSub
+
+	// subscribers are the channels of the local subscribers.
+	subscribers map[chan *livefeed.Event]struct{}
+
+	// closed is set by Close; no new subscriptions are accepted afterwards.
+	closed bool
+}
+
+// NewPostBroker creates a new PostBroker instance that keeps about historySize
+// recent events.
+func NewPostBroker(client *redis.Client, historySize int64) *PostBroker {
+	return &PostBroker{
+		client:      client,
+		historySize: historySize,
+		subscribers: make(map[chan *livefeed.Event]struct{}),
+	}
+}
+
+// Publish appends a post to the history and sends it to the subscribers of
+// every server instance.
+func (b *PostBroker) Publish(ctx context.Context, post *dto.PostDTO) error {
+	data, err := json.Marshal(post)
+	if err != nil {
+		return apperrors.NewInternalErrorWithCause("failed to encode post", err)
+	}
+
+	keys := []string{livePostsStream, livePostsChannel}
+	if err := publishScript.Run(ctx, b.client, keys, b.historySize, data).Err(); err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to publish post", err)
+	}
+
+	return nil
+}
+
+// EventsAfter returns up to limit retained events published after lastEventID, oldest first.
+func (b *PostBroker) EventsAfter(ctx context.Context, lastEventID string, limit int) ([]*livefeed.Event, error) {
+	if !eventIDPattern.MatchString(lastEventID) {
+		return nil, apperrors.NewValidationError("invalid last event ID")
+	}
+	if limit <= 0 {
+		return nil, apperrors.NewValidationError("limit must be positive")
+	}
+
+	// "(" makes the start of the range exclusive
+	messages, err := b.client.XRangeN(ctx, livePostsStream, "("+lastEventID, "+", int64(limit)).Result()
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to read live feed history", err)
+	}
+
+	events := make([]*livefeed.Event, 0, len(messages))
+	for _, msg := range messages {
+		data, ok := msg.Values["post"].(string)
+		if !ok {
+			continue
+		}
+		var post dto.PostDTO
+		if err := json.Unmarshal([]byte(data), &post); err != nil {
+			continue
+		}
+		events = append(events, &livefeed.Event{ID: msg.ID, Post: &post})
+	}
+
+	return events, nil
+}
+
+// Forget deletes the retained events of a post from the stream, so clients
+// resuming with Last-Event-ID no longer receive it.
+func (b *PostBroker) Forget(ctx context.Context, postID string) error {
+	if err := forgetScript.Run(ctx, b.client, []string{livePostsStream}, postID).Err(); err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to forget live feed events", err)
+	}
+	return nil
+}
+
+// Subscribe returns a channel that receives every event published after the call.
+// The channel is closed when ctx is done, when the subscriber falls more than
+// subscriberBuffer events behind, or when the broker is closed.
+// The events are shared between subscribers and must not be modified.
+func (b *PostBroker) Subscribe(ctx context.Context) (<-chan *livefeed.Event, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return nil, apperrors.NewUnavailableError("live feed is shutting down")
+	}
+
+	// The first local subscriber starts the Redis subscription
+	if b.pubsub == nil {
+		pubsub := b.client.Subscribe(ctx, livePostsChannel)
+		if _, err := pubsub.Receive(ctx); err != nil {
+			_ = pubsub.Close()
+			return nil, apperrors.NewDatabaseErrorWithCause("failed to subscribe to live feed", err)
+		}
+		b.pubsub = pubsub
+		go b.dispatch(pubsub)
+	}
+
+	ch := make(chan *livefeed.Event, subscriberBuffer)
+	b.subscribers[ch] = struct{}{}
+
+	go func() {
+		<-ctx.Done()
+		b.unsubscribe(ch)
+	}()
+
+	return ch, nil
+}
+
+// Close ends all subscriptions and rejects new ones.
+// It is called on shutdown so long-lived streams end and clients reconnect to
+// another instance.
+func (b *PostBroker) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+	for ch := range b.subscribers {
+		delete(b.subscribers, ch)
+		close(ch)
+	}
+
+	return b.stopLocked()
+}
+
+// dispatch fans the messages of a Redis subscription out to the local subscribers
+// until the subscription is closed.
+func (b *PostBroker) dispatch(pubsub *redis.PubSub) {
+	for msg := range pubsub.Channel() {
+		var message liveEvent
+		if err := json.Unmarshal([]byte(msg.Payload), &message); err != nil || message.Post == nil {
+			continue
+		}
+		event := &livefeed.Event{ID: message.ID, Post: message.Post}
+
+		b.mu.Lock()
+		// Messages still buffered in a stopped subscription were published before
+		// the current subscribers subscribed
+		if b.pubsub == pubsub {
+			for ch := range b.subscribers {
+				select {
+				case ch <- event:
+				default:
+					delete(b.subscribers, ch)
+					close(ch)
+				}
+			}
+		}
+		b.mu.Unlock()
+	}
+}
+
+// unsubscribe removes a local subscriber; the last one stops the Redis subscription.
+func (b *PostBroker) unsubscribe(ch chan *livefeed.Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.subscribers[ch]; ok {
+		delete(b.subscribers, ch)
+		close(ch)
+	}
+
+	if len(b.subscribers) == 0 {
+		_ = b.stopLocked()
+	}
+}
+
+// stopLocked closes the Redis subscription. b.mu must be held.
+func (b *PostBroker) stopLocked() error {
+	if b.pubsub == nil {
+		return nil
+	}
+
+	err := b.pubsub.Close()
+	b.pubsub = nil
+	if err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to close live feed subscription", err)
+	}
+	return nil
+}
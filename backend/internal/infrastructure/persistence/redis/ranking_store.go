@@ -0,0 +1,92 @@
+package redis
+
+import (
+	"context"
+
+	"github.com/redis/go-redis/v9"
+
+	"fuck_boss/backend/internal/application/ranking"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// rankingKeyPrefix is the prefix of ranking sorted set keys.
+const rankingKeyPrefix = "ranking:"
+
+// rankingIndexKey is the set of ranking names written by the last ReplaceAll.
+const rankingIndexKey = "ranking:index"
+
+// RankingStore is the Redis implementation of ranking.RankingStore.
+// Each ranking is a sorted set ("ranking:{name}").
+type RankingStore struct {
+	// client is the Redis client.
+	client *redis.Client
+}
+
+// NewRankingStore creates a new RankingStore instance.
+func NewRankingStore(client *redis.Client) *RankingStore {
+	return &RankingStore{
+		client: client,
+	}
+}
+
+// ReplaceAll replaces all rankings in one MULTI/EXEC transaction, so readers
+// never see a partially written ranking. Rankings written by the previous call
+// that are not in the map are deleted.
+func (s *RankingStore) ReplaceAll(ctx context.Context, rankings map[string][]ranking.Entry) error {
+	previous, err := s.client.SMembers(ctx, rankingIndexKey).Result()
+	if err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to list rankings", err)
+	}
+
+	_, err = s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, name := range previous {
+			if _, ok := rankings[name]; !ok {
+				pipe.Del(ctx, rankingKeyPrefix+name)
+			}
+		}
+		pipe.Del(ctx, rankingIndexKey)
+
+		for name, entries := range rankings {
+			key := rankingKeyPrefix + name
+			pipe.Del(ctx, key)
+			if len(entries) == 0 {
+				continue
+			}
+
+			members := make([]redis.Z, 0, len(entries))
+			for _, entry := range entries {
+				members = append(members, redis.Z{Score: entry.Score, Member: entry.Member})
+			}
+			pipe.ZAdd(ctx, key, members...)
+			pipe.SAdd(ctx, rankingIndexKey, name)
+		}
+		return nil
+	})
+	if err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to replace rankings", err)
+	}
+
+	return nil
+}
+
+// Range returns the members of the ranking from offset, highest score first,
+// and the size of the ranking.
+func (s *RankingStore) Range(ctx context.Context, name string, offset, limit int) ([]string, int, error) {
+	if name == "" {
+		return nil, 0, apperrors.NewValidationError("ranking name cannot be empty")
+	}
+	if offset < 0 || limit <= 0 {
+		return nil, 0, apperrors.NewValidationError("ranking offset must be non-negative and limit positive")
+	}
+
+	key := rankingKeyPrefix + name
+
+	pipe := s.client.Pipeline()
+	members := pipe.ZRevRange(ctx, key, int64(offset), int64(offset+limit-1))
+	total := pipe.ZCard(ctx, key)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, 0, apperrors.NewDatabaseErrorWithCause("failed to read ranking", err)
+	}
+
+	return members.Val(), int(total.Val()), nil
+}
@@ -0,0 +1,98 @@
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// viewKeyTTL is how long the view counts of a day are kept.
+// Two days leave enough time to flush a day after it has ended.
+const viewKeyTTL = 48 * time.Hour
+
+// ViewCounter is the Redis implementation of ranking.ViewCounter.
+// Each post gets a HyperLogLog per UTC day ("views:hll:{day}:{postID}"), and the
+// posts viewed on a day are tracked in a set ("views:posts:{day}") so the day
+// can be flushed without scanning keys.
+type ViewCounter struct {
+	// client is the Redis client.
+	client *redis.Client
+}
+
+// NewViewCounter creates a new ViewCounter instance.
+func NewViewCounter(client *redis.Client) *ViewCounter {
+	return &ViewCounter{
+		client: client,
+	}
+}
+
+// Add records that viewer viewed the post on the UTC day of at.
+func (c *ViewCounter) Add(ctx context.Context, postID string, viewer string, at time.Time) error {
+	if postID == "" || viewer == "" {
+		return apperrors.NewValidationError("post ID and viewer cannot be empty")
+	}
+
+	day := viewDay(at)
+	hllKey := viewHLLKey(day, postID)
+	postsKey := viewPostsKey(day)
+
+	pipe := c.client.Pipeline()
+	pipe.PFAdd(ctx, hllKey, viewer)
+	pipe.Expire(ctx, hllKey, viewKeyTTL)
+	pipe.SAdd(ctx, postsKey, postID)
+	pipe.Expire(ctx, postsKey, viewKeyTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return apperrors.NewDatabaseErrorWithCause("failed to record view", err)
+	}
+
+	return nil
+}
+
+// Counts returns the unique view count of every post viewed on the UTC day of at.
+func (c *ViewCounter) Counts(ctx context.Context, at time.Time) (map[string]int64, error) {
+	day := viewDay(at)
+
+	postIDs, err := c.client.SMembers(ctx, viewPostsKey(day)).Result()
+	if err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to list viewed posts", err)
+	}
+	if len(postIDs) == 0 {
+		return map[string]int64{}, nil
+	}
+
+	pipe := c.client.Pipeline()
+	cmds := make([]*redis.IntCmd, len(postIDs))
+	for i, postID := range postIDs {
+		cmds[i] = pipe.PFCount(ctx, viewHLLKey(day, postID))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, apperrors.NewDatabaseErrorWithCause("failed to count views", err)
+	}
+
+	counts := make(map[string]int64, len(postIDs))
+	for i, postID := range postIDs {
+		if n := cmds[i].Val(); n > 0 {
+			counts[postID] = n
+		}
+	}
+
+	return counts, nil
+}
+
+// viewDay formats the UTC day of t as used in view keys.
+func viewDay(t time.Time) string {
+	return t.UTC().Format("20060102")
+}
+
+// viewHLLKey returns the HyperLogLog key of a post on a day.
+func viewHLLKey(day, postID string) string {
+	return "views:hll:" + day + ":" + postID
+}
+
+// viewPostsKey returns the key of the set of posts viewed on a day.
+func viewPostsKey(day string) string {
+	return "views:posts:" + day
+}
@@ -0,0 +1,231 @@
+// Package synthetic generates realistic fake posts for load testing and demo
+// environments. The output only depends on the options, so a seed always
+// produces the same posts.
+package synthetic
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"strconv"
+	"time"
+
+	"fuck_boss/backend/internal/application/content"
+)
+
+const (
+	// DefaultCompanies is the default number of distinct companies.
+	DefaultCompanies = 5000
+
+	// DefaultZipfS is the default Zipf exponent of company popularity.
+	DefaultZipfS = 1.1
+
+	// DefaultDays is the default length of the created_at window (in days).
+	DefaultDays = 730
+
+	// occurredAtRate is the share of posts that say when the incident occurred.
+	occurredAtRate = 0.6
+
+	// homeCityRate is the share of a company's posts in its home city.
+	homeCityRate = 0.7
+
+	// meanOccurredLagDays is the mean time between an incident and its post.
+	meanOccurredLagDays = 45
+
+	// maxOccurredLagDays caps the time between an incident and its post.
+	maxOccurredLagDays = 365
+)
+
+// Options configures a Generator.
+type Options struct {
+	// Count is the number of posts to generate.
+	Count int
+
+	// Seed seeds the random numbers; the same options generate the same posts.
+	Seed int64
+
+	// Companies is the number of distinct companies (default DefaultCompanies).
+	Companies int
+
+	// ZipfS is the Zipf exponent of company popularity, greater than 1
+	// (default DefaultZipfS): the k-th most popular company gets posts in
+	// proportion to 1/k^s.
+	ZipfS float64
+
+	// Until is the end of the created_at window (exclusive, required).
+	Until time.Time
+
+	// Days is the length of the created_at window (default DefaultDays).
+	Days int
+}
+
+// Generator generates posts as import records, which go through the same
+// validation as imported datasets.
+type Generator struct {
+	opts Options
+	rng  *rand.Rand
+	zipf *rand.Zipf
+
+	// companies are the generated companies, most popular first.
+	companies []company
+
+	// cityWeights is the cumulative weight of each city, for sampling.
+	cityWeights []float64
+
+	// n is the number of posts generated so far.
+	n int
+}
+
+// company is a generated company and the city it is based in.
+type company struct {
+	name string
+	home int
+}
+
+// NewGenerator creates a Generator, filling in the defaults of opts.
+func NewGenerator(opts Options) (*Generator, error) {
+	if opts.Count < 0 {
+		return nil, fmt.Errorf("count must not be negative")
+	}
+	if opts.Until.IsZero() {
+		return nil, fmt.Errorf("until is required")
+	}
+	if opts.Companies == 0 {
+		opts.Companies = DefaultCompanies
+	}
+	if opts.Companies < 1 || opts.Companies > maxCompanies {
+		return nil, fmt.Errorf("companies must be between 1 and %d", maxCompanies)
+	}
+	if opts.ZipfS == 0 {
+		opts.ZipfS = DefaultZipfS
+	}
+	if opts.ZipfS <= 1 {
+		return nil, fmt.Errorf("zipf exponent must be greater than 1")
+	}
+	if opts.Days == 0 {
+		opts.Days = DefaultDays
+	}
+	if opts.Days < 1 {
+		return nil, fmt.Errorf("days must be at least 1")
+	}
+
+	g := &Generator{
+		opts: opts,
+		rng:  rand.New(rand.NewSource(opts.Seed)),
+	}
+	g.zipf = rand.NewZipf(g.rng, opts.ZipfS, 1, uint64(opts.Companies-1))
+
+	total := 0.0
+	g.cityWeights = make([]float64, len(cities))
+	for i, c := range cities {
+		total += c.weight
+		g.cityWeights[i] = total
+	}
+
+	// Company names come first so they only depend on the seed and the number of companies
+	seen := make(map[string]bool, opts.Companies)
+	for len(g.companies) < opts.Companies {
+		home := g.city()
+		name := companyName(g.rng, cities[home].name)
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		g.companies = append(g.companies, company{name: name, home: home})
+	}
+
+	return g, nil
+}
+
+// Next returns the next post, or io.EOF after Count posts.
+// The external ID is "<seed>-<n>", so generating again with the same seed
+// and importing into the same source only adds the posts beyond the last run.
+func (g *Generator) Next() (*content.ImportPostRecord, error) {
+	if g.n >= g.opts.Count {
+		return nil, io.EOF
+	}
+	g.n++
+
+	c := g.companies[g.zipf.Uint64()]
+	city := c.home
+	if g.rng.Float64() >= homeCityRate {
+		city = g.city()
+	}
+	createdAt := g.createdAt()
+
+	record := &content.ImportPostRecord{
+		Line:             g.n,
+		ExternalID:       strconv.FormatInt(g.opts.Seed, 10) + "-" + strconv.Itoa(g.n),
+		CreatedAt:        createdAt.Format(time.RFC3339),
+		CityCode:         cities[city].code,
+		CityName:         cities[city].name,
+		Company:          c.name,
+		Content:          complaintText(g.rng),
+		ResolutionStatus: g.resolution(),
+	}
+	if g.rng.Float64() < occurredAtRate {
+		record.OccurredAt = g.occurredAt(createdAt).Format("2006-01-02")
+	}
+	return record, nil
+}
+
+// city returns the index of a city, sampled by weight.
+func (g *Generator) city() int {
+	x := g.rng.Float64() * g.cityWeights[len(g.cityWeights)-1]
+	for i, w := range g.cityWeights {
+		if x < w {
+			return i
+		}
+	}
+	return len(g.cityWeights) - 1
+}
+
+// createdAt returns a time in the window. Posts get more frequent towards
+// the end of the window (the density grows linearly, like a growing site)
+// and follow a daily rhythm peaking in the evening.
+func (g *Generator) createdAt() time.Time {
+	start := g.opts.Until.UTC().AddDate(0, 0, -g.opts.Days)
+	day := int(math.Sqrt(g.rng.Float64()) * float64(g.opts.Days))
+	if day >= g.opts.Days {
+		day = g.opts.Days - 1
+	}
+
+	x := g.rng.Float64() * hourWeights[len(hourWeights)-1]
+	hour := 0
+	for hour < len(hourWeights)-1 && x >= hourWeights[hour] {
+		hour++
+	}
+
+	// Hours are local time in China (UTC+8)
+	t := start.AddDate(0, 0, day).Add(time.Duration(hour-8)*time.Hour + time.Duration(g.rng.Intn(3600))*time.Second)
+	if !t.Before(g.opts.Until) {
+		t = g.opts.Until.Add(-time.Duration(1+g.rng.Intn(3600)) * time.Second)
+	}
+	return t
+}
+
+// occurredAt returns when the incident of a post created at createdAt
+// occurred: usually a few weeks before.
+func (g *Generator) occurredAt(createdAt time.Time) time.Time {
+	lag := int(g.rng.ExpFloat64() * meanOccurredLagDays)
+	if lag > maxOccurredLagDays {
+		lag = maxOccurredLagDays
+	}
+	return createdAt.AddDate(0, 0, -lag)
+}
+
+// resolution returns a resolution status; most posts have none.
+func (g *Generator) resolution() string {
+	x := g.rng.Float64()
+	switch {
+	case x < 0.70:
+		return ""
+	case x < 0.85:
+		return "ONGOING"
+	case x < 0.90:
+		return "ESCALATED"
+	default:
+		return "RESOLVED"
+	}
+}
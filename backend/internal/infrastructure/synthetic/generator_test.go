@@ -0,0 +1,146 @@
+package synthetic
+
+import (
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+
+	"fuck_boss/backend/internal/application/content"
+	domaincontent "fuck_boss/backend/internal/domain/content"
+	"fuck_boss/backend/internal/domain/shared"
+)
+
+var testUntil = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func generateAll(t *testing.T, opts Options) []*content.ImportPostRecord {
+	t.Helper()
+
+	g, err := NewGenerator(opts)
+	if err != nil {
+		t.Fatalf("NewGenerator() error = %v", err)
+	}
+	var records []*content.ImportPostRecord
+	for {
+		record, err := g.Next()
+		if errors.Is(err, io.EOF) {
+			return records
+		}
+		if err != nil {
+			t.Fatalf("Next() error = %v", err)
+		}
+		records = append(records, record)
+	}
+}
+
+func TestGenerator_Deterministic(t *testing.T) {
+	opts := Options{Count: 200, Seed: 42, Companies: 100, Until: testUntil}
+
+	first := generateAll(t, opts)
+	second := generateAll(t, opts)
+	if len(first) != 200 {
+		t.Fatalf("generated %d posts, want 200", len(first))
+	}
+	if !reflect.DeepEqual(first, second) {
+		t.Error("the same options generated different posts")
+	}
+
+	opts.Seed = 43
+	if reflect.DeepEqual(first, generateAll(t, opts)) {
+		t.Error("different seeds generated the same posts")
+	}
+}
+
+func TestGenerator_Valid(t *testing.T) {
+	opts := Options{Count: 2000, Seed: 1, Companies: 500, Until: testUntil, Days: 30}
+	start := testUntil.AddDate(0, 0, -30)
+
+	for _, record := range generateAll(t, opts) {
+		if _, err := domaincontent.NewCompanyName(record.Company); err != nil {
+			t.Fatalf("company %q: %v", record.Company, err)
+		}
+		if _, err := shared.NewCity(record.CityCode, record.CityName); err != nil {
+			t.Fatalf("city %q: %v", record.CityCode, err)
+		}
+		if _, err := domaincontent.NewContent(record.Content); err != nil {
+			t.Fatalf("content %q: %v", record.Content, err)
+		}
+		if record.ResolutionStatus != "" {
+			if _, err := domaincontent.NewResolutionStatus(record.ResolutionStatus); err != nil {
+				t.Fatalf("resolution status %q: %v", record.ResolutionStatus, err)
+			}
+		}
+
+		createdAt, err := time.Parse(time.RFC3339, record.CreatedAt)
+		if err != nil {
+			t.Fatalf("created at %q: %v", record.CreatedAt, err)
+		}
+		if createdAt.Before(start) || !createdAt.Before(testUntil) {
+			t.Fatalf("created at %v is outside [%v, %v)", createdAt, start, testUntil)
+		}
+		if record.OccurredAt != "" {
+			occurredAt, err := time.Parse("2006-01-02", record.OccurredAt)
+			if err != nil {
+				t.Fatalf("occurred at %q: %v", record.OccurredAt, err)
+			}
+			if occurredAt.After(createdAt) {
+				t.Fatalf("occurred at %v is after created at %v", occurredAt, createdAt)
+			}
+		}
+	}
+}
+
+func TestGenerator_Distribution(t *testing.T) {
+	opts := Options{Count: 20000, Seed: 7, Companies: 1000, Until: testUntil}
+
+	companies := make(map[string]int)
+	cityCounts := make(map[string]int)
+	recent := 0
+	for _, record := range generateAll(t, opts) {
+		companies[record.Company]++
+		cityCounts[record.CityCode]++
+		if record.CreatedAt >= testUntil.AddDate(0, 0, -DefaultDays/2).Format(time.RFC3339) {
+			recent++
+		}
+	}
+
+	// With s = 1.1 over 1000 companies, the most popular one has about 1/8 of the posts
+	top := 0
+	for _, count := range companies {
+		if count > top {
+			top = count
+		}
+	}
+	if top < 1500 {
+		t.Errorf("most popular company has %d posts, want a Zipf head of at least 1500", top)
+	}
+	if cityCounts["beijing"] <= cityCounts["chongqing"] {
+		t.Errorf("beijing has %d posts and chongqing %d, want beijing to have more", cityCounts["beijing"], cityCounts["chongqing"])
+	}
+	// A linearly growing density puts 3/4 of the posts in the second half of the window
+	if recent < 14000 || recent > 16000 {
+		t.Errorf("%d posts in the second half of the window, want about 15000", recent)
+	}
+}
+
+func TestNewGenerator_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		opts Options
+	}{
+		{name: "no until", opts: Options{Count: 1}},
+		{name: "negative count", opts: Options{Count: -1, Until: testUntil}},
+		{name: "zipf exponent 1", opts: Options{Count: 1, Until: testUntil, ZipfS: 1}},
+		{name: "too many companies", opts: Options{Count: 1, Until: testUntil, Companies: maxCompanies + 1}},
+		{name: "negative days", opts: Options{Count: 1, Until: testUntil, Days: -1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewGenerator(tt.opts); err == nil {
+				t.Error("NewGenerator() error = nil, want error")
+			}
+		})
+	}
+}
@@ -0,0 +1,222 @@
+package synthetic
+
+import (
+	"math/rand"
+	"strconv"
+	"strings"
+)
+
+// maxCompanies is the maximum number of distinct companies, well below the
+// number of possible names so that generating them stays fast.
+const maxCompanies = 50000
+
+// cities are the cities of generated posts with their relative post volume.
+// They are the cities created by the server's migrations (posts reference them).
+var cities = []struct {
+	code   string
+	name   string
+	weight float64
+}{
+	{code: "beijing", name: "北京", weight: 20},
+	{code: "shanghai", name: "上海", weight: 20},
+	{code: "shenzhen", name: "深圳", weight: 15},
+	{code: "guangzhou", name: "广州", weight: 12},
+	{code: "hangzhou", name: "杭州", weight: 10},
+	{code: "chengdu", name: "成都", weight: 7},
+	{code: "wuhan", name: "武汉", weight: 5},
+	{code: "nanjing", name: "南京", weight: 4},
+	{code: "xian", name: "西安", weight: 4},
+	{code: "chongqing", name: "重庆", weight: 3},
+}
+
+// hourWeights is the cumulative relative post volume of each hour of the day
+// (local time): quiet at night, busier at lunch and in the evening.
+var hourWeights = cumulative([]float64{
+	2, 1, 1, 0.5, 0.5, 0.5, 1, 2, 3, 4, 4, 5,
+	6, 5, 4, 4, 4, 4, 5, 7, 8, 9, 8, 5,
+})
+
+func cumulative(weights []float64) []float64 {
+	total := 0.0
+	out := make([]float64, len(weights))
+	for i, w := range weights {
+		total += w
+		out[i] = total
+	}
+	return out
+}
+
+// Company names are a city (sometimes), a two-character brand, an industry
+// and a legal form, e.g. 深圳市恒达网络科技有限公司.
+var (
+	brandChars = []string{
+		"华", "盛", "新", "联", "达", "恒", "通", "鑫", "创", "优",
+		"云", "智", "远", "宏", "博", "泰", "海", "天", "晨", "星",
+		"锐", "信", "众", "嘉", "悦", "卓", "领", "汇", "源", "捷",
+	}
+	industries = []string{
+		"科技", "网络科技", "信息技术", "电子商务", "教育科技", "物流",
+		"餐饮管理", "房地产经纪", "金融服务", "医疗器械", "文化传媒", "人力资源",
+		"汽车销售", "建筑工程", "游戏", "软件", "数据服务", "广告", "商贸", "物业管理",
+	}
+	legalForms = []string{"有限公司", "有限公司", "有限公司", "股份有限公司", "集团有限公司"}
+)
+
+// companyName returns a random company name based in the city.
+func companyName(rng *rand.Rand, city string) string {
+	var b strings.Builder
+	if rng.Intn(2) == 0 {
+		b.WriteString(city)
+		b.WriteString("市")
+	}
+	first := rng.Intn(len(brandChars))
+	second := (first + 1 + rng.Intn(len(brandChars)-1)) % len(brandChars)
+	b.WriteString(brandChars[first])
+	b.WriteString(brandChars[second])
+	b.WriteString(industries[rng.Intn(len(industries))])
+	b.WriteString(legalForms[rng.Intn(len(legalForms))])
+	return b.String()
+}
+
+// complaintTopics are complaint templates by topic. A post is an opening,
+// one to three details and sometimes a closing. Placeholders: {months},
+// {hours}, {days}, {salary} and {amount} are replaced with random numbers.
+var complaintTopics = []struct {
+	openings []string
+	details  []string
+}{
+	{
+		// Overtime
+		openings: []string{
+			"入职{months}个月，几乎每天都加班到晚上十点以后。",
+			"公司实行大小周，周末经常被临时叫回来加班。",
+			"项目上线前连续{days}天通宵，事后一句感谢都没有。",
+		},
+		details: []string{
+			"加班没有加班费，也不能调休，打卡记录被要求手动修改。",
+			"每周工作时间超过{hours}小时，合同上写的却是标准工时制。",
+			"领导下班前开会布置任务，要求第二天早上交。",
+			"请假需要提前一周申请，病假也要扣全勤奖。",
+			"晚上和周末在工作群里不回消息会被点名批评。",
+		},
+	},
+	{
+		// Unpaid wages
+		openings: []string{
+			"公司已经拖欠工资{months}个月了。",
+			"离职后说好的最后一个月工资一直没有发。",
+			"每个月工资都要拖到{days}号以后才发，而且没有任何解释。",
+		},
+		details: []string{
+			"一共被拖欠了{amount}元，HR 一直说在走流程。",
+			"绩效奖金年初承诺按季度发放，到现在一分没见到。",
+			"找老板沟通，被要求签一份自愿延期支付的说明。",
+			"同部门至少还有十几个同事情况一样。",
+			"已经准备去劳动监察大队投诉。",
+		},
+	},
+	{
+		// Layoffs
+		openings: []string{
+			"公司以业务调整为由突然裁员，整个部门一天之内全部被通知离职。",
+			"被约谈要求主动辞职，否则就调岗到外地。",
+			"试用期最后一天被通知不予转正，理由是不符合录用条件。",
+		},
+		details: []string{
+			"没有按 N+1 支付赔偿，只愿意给半个月工资。",
+			"要求当天交接完工作并归还电脑，门禁卡下午就失效了。",
+			"不符合录用条件的标准从来没有书面告知过。",
+			"裁员前一个月还在招聘同岗位的人。",
+			"申请劳动仲裁后公司开始拖延，说要走完内部流程。",
+		},
+	},
+	{
+		// Offers and contracts
+		openings: []string{
+			"面试时承诺月薪{salary}元，入职后合同上只写了基本工资。",
+			"入职{days}天了，公司一直不签劳动合同。",
+			"拿到 offer 并辞掉原工作后，入职前三天被通知岗位取消。",
+		},
+		details: []string{
+			"五险一金按最低标准缴纳，和承诺的不一样。",
+			"试用期从三个月被延长到六个月，工资按八折发放。",
+			"实际岗位和招聘时描述的完全不同，每天做的都是杂活。",
+			"要求签竞业协议，但没有任何补偿。",
+			"入职时被收取了{amount}元培训费和押金。",
+		},
+	},
+	{
+		// Management
+		openings: []string{
+			"直属领导经常在会议上当众辱骂员工。",
+			"公司管理混乱，{months}个月换了三任部门负责人。",
+			"团队氛围非常压抑，这一年里已经走了一大半的人。",
+		},
+		details: []string{
+			"绩效考核全凭领导个人喜好，申诉渠道形同虚设。",
+			"工作成果被领导拿去邀功，年终评级却给了最低档。",
+			"向 HR 反映问题后，反而被领导针对。",
+			"要求员工在朋友圈转发公司广告，不转发扣绩效。",
+			"上厕所超过十分钟会被记录。",
+		},
+	},
+}
+
+// complaintClosings end some posts.
+var complaintClosings = []string{
+	"求职者请慎重考虑。",
+	"希望大家避坑。",
+	"有同样遭遇的朋友可以一起维权。",
+	"已经申请劳动仲裁，后续有进展再更新。",
+	"不知道这种情况应该怎么维权，有经验的朋友可以说说吗？",
+	"",
+}
+
+// complaintText returns a random complaint.
+func complaintText(rng *rand.Rand) string {
+	topic := complaintTopics[rng.Intn(len(complaintTopics))]
+
+	var b strings.Builder
+	b.WriteString(topic.openings[rng.Intn(len(topic.openings))])
+
+	// One to three distinct details, in random order
+	details := rng.Perm(len(topic.details))[:1+rng.Intn(3)]
+	for _, i := range details {
+		b.WriteString(topic.details[i])
+	}
+	if rng.Intn(2) == 0 {
+		b.WriteString(complaintClosings[rng.Intn(len(complaintClosings))])
+	}
+
+	return fill(rng, b.String())
+}
+
+// fill replaces the placeholders of a template with random numbers.
+func fill(rng *rand.Rand, text string) string {
+	for {
+		start := strings.IndexByte(text, '{')
+		if start < 0 {
+			return text
+		}
+		end := strings.IndexByte(text[start:], '}')
+		if end < 0 {
+			return text
+		}
+		end += start
+
+		var value int
+		switch text[start+1 : end] {
+		case "months":
+			value = 2 + rng.Intn(11)
+		case "hours":
+			value = 55 + rng.Intn(30)
+		case "days":
+			value = 3 + rng.Intn(26)
+		case "salary":
+			value = (8 + rng.Intn(28)) * 1000
+		case "amount":
+			value = (3 + rng.Intn(60)) * 1000
+		}
+		text = text[:start] + strconv.Itoa(value) + text[end+1:]
+	}
+}
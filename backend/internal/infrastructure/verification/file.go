@@ -0,0 +1,66 @@
+// Package verification provides SMTP and file implementations of the verification code sender.
+// It implements the verification.Sender interface defined in Application Layer.
+package verification
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"fuck_boss/backend/internal/application/verification"
+)
+
+// unsafeFileChars matches characters that are replaced in file names.
+var unsafeFileChars = regexp.MustCompile(`[^a-zA-Z0-9@._+-]`)
+
+// FileSender writes verification codes to files in a local directory instead of delivering them.
+// It accepts every channel and is meant for development and tests only.
+type FileSender struct {
+	// dir is the directory the code files are written to.
+	dir string
+}
+
+// NewFileSender creates a new FileSender writing to dir.
+// The directory is created if it does not exist.
+func NewFileSender(dir string) (*FileSender, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create verification code directory: %w", err)
+	}
+	return &FileSender{dir: dir}, nil
+}
+
+// Send writes the message to <dir>/<channel>_<recipient>.txt, replacing the previous code
+// for that recipient. The first line of the file is the code.
+func (s *FileSender) Send(ctx context.Context, msg verification.Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	content := fmt.Sprintf("%s\nchannel: %s\nrecipient: %s\npurpose: %s\nexpires_at: %s\n",
+		msg.Code,
+		msg.Channel,
+		msg.Recipient,
+		msg.Purpose,
+		msg.ExpiresAt.Format(time.RFC3339),
+	)
+
+	path := s.Path(msg.Channel, msg.Recipient)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, []byte(content), 0o600); err != nil {
+		return fmt.Errorf("failed to write verification code file: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("failed to write verification code file: %w", err)
+	}
+	return nil
+}
+
+// Path returns the file the latest code for the recipient is written to.
+func (s *FileSender) Path(channel, recipient string) string {
+	name := strings.ToLower(channel) + "_" + unsafeFileChars.ReplaceAllString(recipient, "_") + ".txt"
+	return filepath.Join(s.dir, name)
+}
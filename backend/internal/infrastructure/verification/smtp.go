@@ -0,0 +1,94 @@
+package verification
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"time"
+
+	"fuck_boss/backend/internal/application/verification"
+	"fuck_boss/backend/internal/domain/identity"
+)
+
+// SMTPSender delivers verification codes by email through an SMTP server.
+// It only supports the EMAIL channel. net/smtp upgrades the connection with
+// STARTTLS when the server offers it, and refuses PLAIN auth without TLS
+// (except on localhost).
+type SMTPSender struct {
+	// addr is the "host:port" of the SMTP server.
+	addr string
+
+	// auth authenticates to the server (nil if no username is configured).
+	auth smtp.Auth
+
+	// from is the envelope and header sender address.
+	from mail.Address
+
+	// sendMail sends the message; it is smtp.SendMail outside of tests.
+	sendMail func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+}
+
+// NewSMTPSender creates a new SMTPSender.
+// username and password may be empty for servers that do not require authentication.
+// Returns an error if the from address is malformed.
+func NewSMTPSender(host string, port int, username, password, from string) (*SMTPSender, error) {
+	fromAddr, err := mail.ParseAddress(from)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SMTP from address: %w", err)
+	}
+
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	return &SMTPSender{
+		addr:     net.JoinHostPort(host, strconv.Itoa(port)),
+		auth:     auth,
+		from:     *fromAddr,
+		sendMail: smtp.SendMail,
+	}, nil
+}
+
+// Send emails the code to msg.Recipient.
+// Returns verification.ErrUnsupportedChannel for SMS messages.
+func (s *SMTPSender) Send(ctx context.Context, msg verification.Message) error {
+	if msg.Channel != identity.VerificationChannelEmail.String() {
+		return verification.ErrUnsupportedChannel
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	body := buildEmail(s.from, msg)
+	if err := s.sendMail(s.addr, s.auth, s.from.Address, []string{msg.Recipient}, body); err != nil {
+		return fmt.Errorf("failed to send verification email: %w", err)
+	}
+	return nil
+}
+
+// buildEmail renders the verification email (plain text, UTF-8).
+func buildEmail(from mail.Address, msg verification.Message) []byte {
+	minutes := int(time.Until(msg.ExpiresAt).Round(time.Minute).Minutes())
+	if minutes < 1 {
+		minutes = 1
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from.String())
+	fmt.Fprintf(&buf, "To: %s\r\n", msg.Recipient)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.BEncoding.Encode("UTF-8", "验证码 "+msg.Code))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	buf.WriteString("\r\n")
+	fmt.Fprintf(&buf, "你的验证码是：%s\r\n\r\n", msg.Code)
+	fmt.Fprintf(&buf, "验证码 %d 分钟内有效，只能使用一次。如果不是你本人操作，请忽略这封邮件。\r\n", minutes)
+	return buf.Bytes()
+}
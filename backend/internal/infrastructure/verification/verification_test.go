@@ -0,0 +1,109 @@
+package verification
+
+import (
+	"context"
+	"errors"
+	"net/smtp"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"fuck_boss/backend/internal/application/verification"
+)
+
+func TestFileSender_Send(t *testing.T) {
+	dir := t.TempDir()
+	sender, err := NewFileSender(dir + "/codes")
+	if err != nil {
+		t.Fatalf("NewFileSender() error = %v", err)
+	}
+
+	msg := verification.Message{
+		Channel:   "EMAIL",
+		Recipient: "alice@example.com",
+		Purpose:   "REGISTER",
+		Code:      "012345",
+		ExpiresAt: time.Now().Add(10 * time.Minute),
+	}
+	if err := sender.Send(context.Background(), msg); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+
+	// A newer code replaces the previous one
+	msg.Code = "678901"
+	if err := sender.Send(context.Background(), msg); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+
+	data, err := os.ReadFile(sender.Path("EMAIL", "alice@example.com"))
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if firstLine := strings.SplitN(string(data), "\n", 2)[0]; firstLine != "678901" {
+		t.Errorf("first line = %q, want %q", firstLine, "678901")
+	}
+}
+
+func TestFileSender_PathSanitizesRecipient(t *testing.T) {
+	sender := &FileSender{dir: "/tmp/codes"}
+	got := sender.Path("SMS", "+86 138/../0013")
+	if got != "/tmp/codes/sms_+86_138_.._0013.txt" {
+		t.Errorf("Path() = %q", got)
+	}
+}
+
+func TestSMTPSender_Send(t *testing.T) {
+	sender, err := NewSMTPSender("smtp.example.com", 587, "user", "secret", "Fuck Boss <noreply@example.com>")
+	if err != nil {
+		t.Fatalf("NewSMTPSender() error = %v", err)
+	}
+
+	var gotAddr, gotFrom string
+	var gotTo []string
+	var gotBody []byte
+	sender.sendMail = func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+		gotAddr, gotFrom, gotTo, gotBody = addr, from, to, msg
+		return nil
+	}
+
+	err = sender.Send(context.Background(), verification.Message{
+		Channel:   "EMAIL",
+		Recipient: "alice@example.com",
+		Purpose:   "REGISTER",
+		Code:      "012345",
+		ExpiresAt: time.Now().Add(10 * time.Minute),
+	})
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+
+	if gotAddr != "smtp.example.com:587" {
+		t.Errorf("addr = %q", gotAddr)
+	}
+	if gotFrom != "noreply@example.com" {
+		t.Errorf("from = %q", gotFrom)
+	}
+	if len(gotTo) != 1 || gotTo[0] != "alice@example.com" {
+		t.Errorf("to = %v", gotTo)
+	}
+	body := string(gotBody)
+	if !strings.Contains(body, "012345") || !strings.Contains(body, "10 分钟") {
+		t.Errorf("body does not contain the code and lifetime:\n%s", body)
+	}
+	if !strings.Contains(body, "Subject: =?UTF-8?b?") {
+		t.Errorf("subject is not encoded:\n%s", body)
+	}
+}
+
+func TestSMTPSender_RejectsSMS(t *testing.T) {
+	sender, err := NewSMTPSender("smtp.example.com", 587, "", "", "noreply@example.com")
+	if err != nil {
+		t.Fatalf("NewSMTPSender() error = %v", err)
+	}
+
+	err = sender.Send(context.Background(), verification.Message{Channel: "SMS", Recipient: "+8613800138000", Code: "012345"})
+	if !errors.Is(err, verification.ErrUnsupportedChannel) {
+		t.Errorf("Send() error = %v, want ErrUnsupportedChannel", err)
+	}
+}
@@ -0,0 +1,145 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	adminv1 "fuck_boss/backend/api/proto/admin/v1"
+	"fuck_boss/backend/internal/application/admin"
+	"fuck_boss/backend/internal/application/content"
+	"fuck_boss/backend/internal/application/dto"
+)
+
+// ModeratePostUseCaseInterface defines the interface for hiding, removing and restoring posts.
+type ModeratePostUseCaseInterface interface {
+	Execute(ctx context.Context, cmd content.ModeratePostCommand) (*dto.PostModerationDTO, error)
+}
+
+// ListAuditEventsUseCaseInterface defines the interface for listing the audit log.
+type ListAuditEventsUseCaseInterface interface {
+	Execute(ctx context.Context, query admin.ListAuditEventsQuery) (*dto.AuditEventsListDTO, error)
+}
+
+// AdminService implements the AdminService gRPC service.
+// Its methods are privileged: they are guarded by middleware.APIKeyInterceptor,
+// which checks the API key scopes and records every call in the audit log.
+type AdminService struct {
+	adminv1.UnimplementedAdminServiceServer
+
+	// moderateUseCase handles post moderation.
+	moderateUseCase ModeratePostUseCaseInterface
+
+	// listAuditUseCase handles audit log queries.
+	listAuditUseCase ListAuditEventsUseCaseInterface
+}
+
+// NewAdminService creates a new AdminService instance.
+func NewAdminService(
+	moderateUseCase ModeratePostUseCaseInterface,
+	listAuditUseCase ListAuditEventsUseCaseInterface,
+) *AdminService {
+	return &AdminService{
+		moderateUseCase:  moderateUseCase,
+		listAuditUseCase: listAuditUseCase,
+	}
+}
+
+// ModeratePost handles the ModeratePost gRPC request.
+func (s *AdminService) ModeratePost(ctx context.Context, req *adminv1.ModeratePostRequest) (*adminv1.ModeratePostResponse, error) {
+	// Create command
+	cmd := content.ModeratePostCommand{
+		PostID: req.PostId,
+		Action: moderationActionFromProto(req.Action),
+		Reason: req.Reason,
+	}
+
+	// Execute use case
+	moderationDTO, err := s.moderateUseCase.Execute(ctx, cmd)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	// Convert to response
+	return &adminv1.ModeratePostResponse{
+		PostId:      moderationDTO.PostID,
+		Status:      moderationStatusToProto(moderationDTO.Status),
+		Reason:      moderationDTO.Reason,
+		ModeratedAt: moderationDTO.ModeratedAt.Unix(),
+	}, nil
+}
+
+// ListAuditEvents handles the ListAuditEvents gRPC request.
+func (s *AdminService) ListAuditEvents(ctx context.Context, req *adminv1.ListAuditEventsRequest) (*adminv1.ListAuditEventsResponse, error) {
+	// Create query
+	query := admin.ListAuditEventsQuery{
+		APIKeyID: req.ApiKeyId,
+		Action:   req.Action,
+		Target:   req.Target,
+		Cursor:   req.Cursor,
+		PageSize: int(req.PageSize),
+	}
+	if req.Since > 0 {
+		since := time.Unix(req.Since, 0)
+		query.Since = &since
+	}
+	if req.Until > 0 {
+		until := time.Unix(req.Until, 0)
+		query.Until = &until
+	}
+
+	// Execute use case
+	result, err := s.listAuditUseCase.Execute(ctx, query)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	// Convert to response
+	events := make([]*adminv1.AuditEvent, 0, len(result.Events))
+	for _, event := range result.Events {
+		events = append(events, &adminv1.AuditEvent{
+			Id:         event.ID,
+			OccurredAt: event.OccurredAt.Unix(),
+			ApiKeyId:   event.APIKeyID,
+			Actor:      event.Actor,
+			Action:     event.Action,
+			Target:     event.Target,
+			Outcome:    event.Outcome,
+			ClientIp:   event.ClientIP,
+			Details:    event.Details,
+		})
+	}
+
+	return &adminv1.ListAuditEventsResponse{
+		Events:     events,
+		NextCursor: result.NextCursor,
+	}, nil
+}
+
+// moderationActionFromProto converts the protobuf enum to a moderation action.
+// UNSPECIFIED maps to an empty action, which the use case rejects.
+func moderationActionFromProto(value adminv1.ModerationAction) content.ModerationAction {
+	switch value {
+	case adminv1.ModerationAction_MODERATION_ACTION_HIDE:
+		return content.ModerationActionHide
+	case adminv1.ModerationAction_MODERATION_ACTION_REMOVE:
+		return content.ModerationActionRemove
+	case adminv1.ModerationAction_MODERATION_ACTION_RESTORE:
+		return content.ModerationActionRestore
+	default:
+		return ""
+	}
+}
+
+// moderationStatusToProto converts a moderation status string to the protobuf enum.
+func moderationStatusToProto(value string) adminv1.ModerationStatus {
+	switch value {
+	case "VISIBLE":
+		return adminv1.ModerationStatus_MODERATION_STATUS_VISIBLE
+	case "HIDDEN":
+		return adminv1.ModerationStatus_MODERATION_STATUS_HIDDEN
+	case "REMOVED":
+		return adminv1.ModerationStatus_MODERATION_STATUS_REMOVED
+	default:
+		return adminv1.ModerationStatus_MODERATION_STATUS_UNSPECIFIED
+	}
+}
@@ -0,0 +1,184 @@
+// Package grpc provides gRPC handlers for content management.
+package grpc
+
+import (
+	"context"
+
+	authv1 "fuck_boss/backend/api/proto/auth/v1"
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/application/identity"
+	"fuck_boss/backend/internal/application/verification"
+)
+
+// RegisterUseCaseInterface defines the interface for registering user accounts.
+type RegisterUseCaseInterface interface {
+	Execute(ctx context.Context, cmd identity.RegisterCommand) (*dto.AuthSessionDTO, error)
+}
+
+// LoginUseCaseInterface defines the interface for logging in.
+type LoginUseCaseInterface interface {
+	Execute(ctx context.Context, cmd identity.LoginCommand) (*dto.AuthSessionDTO, error)
+}
+
+// RefreshUseCaseInterface defines the interface for rotating refresh tokens.
+type RefreshUseCaseInterface interface {
+	Execute(ctx context.Context, cmd identity.RefreshCommand) (*dto.AuthSessionDTO, error)
+}
+
+// LogoutUseCaseInterface defines the interface for logging out.
+type LogoutUseCaseInterface interface {
+	Execute(ctx context.Context, cmd identity.LogoutCommand) error
+}
+
+// VerificationCodeServiceInterface defines the interface for sending verification codes.
+type VerificationCodeServiceInterface interface {
+	Send(ctx context.Context, cmd verification.SendCommand) (*dto.VerificationCodeDTO, error)
+}
+
+// AuthService implements the AuthService gRPC service.
+// It handles registration, login, token refresh, logout and verification codes.
+type AuthService struct {
+	authv1.UnimplementedAuthServiceServer
+
+	// registerUseCase handles registration.
+	registerUseCase RegisterUseCaseInterface
+
+	// loginUseCase handles login.
+	loginUseCase LoginUseCaseInterface
+
+	// refreshUseCase handles refresh token rotation.
+	refreshUseCase RefreshUseCaseInterface
+
+	// logoutUseCase handles logout.
+	logoutUseCase LogoutUseCaseInterface
+
+	// verificationService sends verification codes.
+	verificationService VerificationCodeServiceInterface
+}
+
+// NewAuthService creates a new AuthService instance.
+func NewAuthService(
+	registerUseCase RegisterUseCaseInterface,
+	loginUseCase LoginUseCaseInterface,
+	refreshUseCase RefreshUseCaseInterface,
+	logoutUseCase LogoutUseCaseInterface,
+	verificationService VerificationCodeServiceInterface,
+) *AuthService {
+	return &AuthService{
+		registerUseCase:     registerUseCase,
+		loginUseCase:        loginUseCase,
+		refreshUseCase:      refreshUseCase,
+		logoutUseCase:       logoutUseCase,
+		verificationService: verificationService,
+	}
+}
+
+// Register handles the Register gRPC request.
+func (s *AuthService) Register(ctx context.Context, req *authv1.RegisterRequest) (*authv1.RegisterResponse, error) {
+	// Create command
+	cmd := identity.RegisterCommand{
+		Email:            req.Email,
+		Username:         req.Username,
+		Password:         req.Password,
+		VerificationCode: req.VerificationCode,
+		ClientIP:         extractClientIP(ctx),
+	}
+
+	// Execute use case
+	session, err := s.registerUseCase.Execute(ctx, cmd)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	// Convert to response
+	return &authv1.RegisterResponse{
+		Session: convertSessionToProto(session),
+	}, nil
+}
+
+// Login handles the Login gRPC request.
+func (s *AuthService) Login(ctx context.Context, req *authv1.LoginRequest) (*authv1.LoginResponse, error) {
+	// Create command
+	cmd := identity.LoginCommand{
+		Account:  req.Account,
+		Password: req.Password,
+		ClientIP: extractClientIP(ctx),
+	}
+
+	// Execute use case
+	session, err := s.loginUseCase.Execute(ctx, cmd)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	// Convert to response
+	return &authv1.LoginResponse{
+		Session: convertSessionToProto(session),
+	}, nil
+}
+
+// Refresh handles the Refresh gRPC request.
+func (s *AuthService) Refresh(ctx context.Context, req *authv1.RefreshRequest) (*authv1.RefreshResponse, error) {
+	// Execute use case
+	session, err := s.refreshUseCase.Execute(ctx, identity.RefreshCommand{
+		RefreshToken: req.RefreshToken,
+	})
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	// Convert to response
+	return &authv1.RefreshResponse{
+		Session: convertSessionToProto(session),
+	}, nil
+}
+
+// Logout handles the Logout gRPC request.
+func (s *AuthService) Logout(ctx context.Context, req *authv1.LogoutRequest) (*authv1.LogoutResponse, error) {
+	// Execute use case
+	if err := s.logoutUseCase.Execute(ctx, identity.LogoutCommand{
+		RefreshToken: req.RefreshToken,
+	}); err != nil {
+		return nil, convertError(err)
+	}
+
+	return &authv1.LogoutResponse{}, nil
+}
+
+// SendVerificationCode handles the SendVerificationCode gRPC request.
+func (s *AuthService) SendVerificationCode(ctx context.Context, req *authv1.SendVerificationCodeRequest) (*authv1.SendVerificationCodeResponse, error) {
+	// Execute service
+	result, err := s.verificationService.Send(ctx, verification.SendCommand{
+		Channel:   req.Channel,
+		Recipient: req.Recipient,
+		Purpose:   req.Purpose,
+		ClientIP:  extractClientIP(ctx),
+	})
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	// Convert to response
+	return &authv1.SendVerificationCodeResponse{
+		Channel:            result.Channel,
+		ExpiresAt:          result.ExpiresAt.Unix(),
+		ResendAfterSeconds: int32(result.ResendAfterSeconds),
+	}, nil
+}
+
+// convertSessionToProto converts an AuthSessionDTO to a protobuf AuthSession message.
+func convertSessionToProto(session *dto.AuthSessionDTO) *authv1.AuthSession {
+	if session == nil {
+		return nil
+	}
+
+	return &authv1.AuthSession{
+		UserId:                session.UserID,
+		Email:                 session.Email,
+		Username:              session.Username,
+		AccessToken:           session.AccessToken,
+		AccessTokenExpiresAt:  session.AccessTokenExpiresAt.Unix(),
+		RefreshToken:          session.RefreshToken,
+		RefreshTokenExpiresAt: session.RefreshTokenExpiresAt.Unix(),
+	}
+}
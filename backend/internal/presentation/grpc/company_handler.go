@@ -0,0 +1,176 @@
+// Package grpc provides gRPC handlers for content management.
+package grpc
+
+import (
+	"context"
+
+	companyv1 "fuck_boss/backend/api/proto/company/v1"
+	"fuck_boss/backend/internal/application/company"
+	"fuck_boss/backend/internal/application/dto"
+)
+
+// RegisterRepresentativeUseCaseInterface defines the interface for registering company representatives.
+type RegisterRepresentativeUseCaseInterface interface {
+	Execute(ctx context.Context, cmd company.RegisterRepresentativeCommand) (*dto.RepresentativeDTO, error)
+}
+
+// VerifyRepresentativeUseCaseInterface defines the interface for verifying company representatives.
+type VerifyRepresentativeUseCaseInterface interface {
+	Execute(ctx context.Context, cmd company.VerifyRepresentativeCommand) (*dto.RepresentativeDTO, error)
+}
+
+// PostOfficialReplyUseCaseInterface defines the interface for posting official company replies.
+type PostOfficialReplyUseCaseInterface interface {
+	Execute(ctx context.Context, cmd company.PostOfficialReplyCommand) (*dto.OfficialReplyDTO, error)
+}
+
+// CompanyService implements the CompanyService gRPC service.
+// It handles company representative registration, domain verification and official replies.
+type CompanyService struct {
+	companyv1.UnimplementedCompanyServiceServer
+
+	// registerUseCase handles representative registration.
+	registerUseCase RegisterRepresentativeUseCaseInterface
+
+	// verifyUseCase handles domain challenge verification.
+	verifyUseCase VerifyRepresentativeUseCaseInterface
+
+	// replyUseCase handles official replies.
+	replyUseCase PostOfficialReplyUseCaseInterface
+}
+
+// NewCompanyService creates a new CompanyService instance.
+func NewCompanyService(
+	registerUseCase RegisterRepresentativeUseCaseInterface,
+	verifyUseCase VerifyRepresentativeUseCaseInterface,
+	replyUseCase PostOfficialReplyUseCaseInterface,
+) *CompanyService {
+	return &CompanyService{
+		registerUseCase: registerUseCase,
+		verifyUseCase:   verifyUseCase,
+		replyUseCase:    replyUseCase,
+	}
+}
+
+// RegisterRepresentative handles the RegisterRepresentative gRPC request.
+func (s *CompanyService) RegisterRepresentative(ctx context.Context, req *companyv1.RegisterRepresentativeRequest) (*companyv1.RegisterRepresentativeResponse, error) {
+	// Create command
+	cmd := company.RegisterRepresentativeCommand{
+		Company:         req.Company,
+		Domain:          req.Domain,
+		ChallengeMethod: challengeMethodFromProto(req.ChallengeMethod),
+		ClientIP:        extractClientIP(ctx),
+	}
+
+	// Execute use case
+	representativeDTO, err := s.registerUseCase.Execute(ctx, cmd)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	// Convert to response
+	return &companyv1.RegisterRepresentativeResponse{
+		Representative: convertRepresentativeToProto(representativeDTO),
+		AccessToken:    representativeDTO.AccessToken,
+	}, nil
+}
+
+// VerifyRepresentative handles the VerifyRepresentative gRPC request.
+func (s *CompanyService) VerifyRepresentative(ctx context.Context, req *companyv1.VerifyRepresentativeRequest) (*companyv1.VerifyRepresentativeResponse, error) {
+	// Create command
+	cmd := company.VerifyRepresentativeCommand{
+		RepresentativeID: req.RepresentativeId,
+		AccessToken:      req.AccessToken,
+	}
+
+	// Execute use case
+	representativeDTO, err := s.verifyUseCase.Execute(ctx, cmd)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	// Convert to response
+	return &companyv1.VerifyRepresentativeResponse{
+		Representative: convertRepresentativeToProto(representativeDTO),
+	}, nil
+}
+
+// PostOfficialReply handles the PostOfficialReply gRPC request.
+func (s *CompanyService) PostOfficialReply(ctx context.Context, req *companyv1.PostOfficialReplyRequest) (*companyv1.PostOfficialReplyResponse, error) {
+	// Create command
+	cmd := company.PostOfficialReplyCommand{
+		PostID:           req.PostId,
+		RepresentativeID: req.RepresentativeId,
+		AccessToken:      req.AccessToken,
+		Content:          req.Content,
+	}
+
+	// Execute use case
+	replyDTO, err := s.replyUseCase.Execute(ctx, cmd)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	// Convert to response
+	return &companyv1.PostOfficialReplyResponse{
+		OfficialReply: convertOfficialReplyToProto(replyDTO),
+	}, nil
+}
+
+// convertRepresentativeToProto converts a RepresentativeDTO to a protobuf Representative message.
+func convertRepresentativeToProto(representativeDTO *dto.RepresentativeDTO) *companyv1.Representative {
+	if representativeDTO == nil {
+		return nil
+	}
+
+	var verifiedAt int64
+	if representativeDTO.VerifiedAt != nil {
+		verifiedAt = representativeDTO.VerifiedAt.Unix()
+	}
+
+	status := companyv1.RepresentativeStatus_REPRESENTATIVE_STATUS_PENDING
+	if representativeDTO.Status == "VERIFIED" {
+		status = companyv1.RepresentativeStatus_REPRESENTATIVE_STATUS_VERIFIED
+	}
+
+	return &companyv1.Representative{
+		Id:      representativeDTO.ID,
+		Company: representativeDTO.Company,
+		Domain:  representativeDTO.Domain,
+		Status:  status,
+		Challenge: &companyv1.Challenge{
+			Method:      challengeMethodToProto(representativeDTO.ChallengeMethod),
+			RecordName:  representativeDTO.ChallengeRecordName,
+			RecordValue: representativeDTO.ChallengeRecordValue,
+			FileUrl:     representativeDTO.ChallengeFileURL,
+			FileContent: representativeDTO.ChallengeCode,
+		},
+		CreatedAt:  representativeDTO.CreatedAt.Unix(),
+		VerifiedAt: verifiedAt,
+	}
+}
+
+// challengeMethodToProto converts a challenge method string to the protobuf enum.
+func challengeMethodToProto(value string) companyv1.ChallengeMethod {
+	switch value {
+	case "DNS_TXT":
+		return companyv1.ChallengeMethod_CHALLENGE_METHOD_DNS_TXT
+	case "FILE":
+		return companyv1.ChallengeMethod_CHALLENGE_METHOD_FILE
+	default:
+		return companyv1.ChallengeMethod_CHALLENGE_METHOD_UNSPECIFIED
+	}
+}
+
+// challengeMethodFromProto converts the protobuf enum to a challenge method string.
+// UNSPECIFIED maps to an empty string.
+func challengeMethodFromProto(value companyv1.ChallengeMethod) string {
+	switch value {
+	case companyv1.ChallengeMethod_CHALLENGE_METHOD_DNS_TXT:
+		return "DNS_TXT"
+	case companyv1.ChallengeMethod_CHALLENGE_METHOD_FILE:
+		return "FILE"
+	default:
+		return ""
+	}
+}
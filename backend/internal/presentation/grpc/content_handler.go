@@ -7,14 +7,15 @@ import (
 	"time"
 
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 
 	contentv1 "fuck_boss/backend/api/proto/content/v1"
 	"fuck_boss/backend/internal/application/content"
 	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/application/livefeed"
 	"fuck_boss/backend/internal/application/search"
+	"fuck_boss/backend/internal/infrastructure/logger"
 	apperrors "fuck_boss/backend/pkg/errors"
 )
 
@@ -38,6 +39,36 @@ type SearchPostsUseCaseInterface interface {
 	Execute(ctx context.Context, query search.SearchPostsQuery) (*dto.PostsListDTO, error)
 }
 
+// AppendFollowUpUseCaseInterface defines the interface for appending follow-ups.
+type AppendFollowUpUseCaseInterface interface {
+	Execute(ctx context.Context, cmd content.AppendFollowUpCommand) (*dto.FollowUpDTO, error)
+}
+
+// ListMyPostsUseCaseInterface defines the interface for listing the current user's posts.
+type ListMyPostsUseCaseInterface interface {
+	Execute(ctx context.Context, query content.ListMyPostsQuery) (*dto.PostsListDTO, error)
+}
+
+// RecordViewUseCaseInterface defines the interface for counting post views.
+type RecordViewUseCaseInterface interface {
+	Execute(ctx context.Context, cmd content.RecordViewCommand) error
+}
+
+// ListTrendingPostsUseCaseInterface defines the interface for listing trending posts.
+type ListTrendingPostsUseCaseInterface interface {
+	Execute(ctx context.Context, query content.ListTrendingPostsQuery) (*dto.PostsListDTO, error)
+}
+
+// ListRelatedPostsUseCaseInterface defines the interface for listing related posts.
+type ListRelatedPostsUseCaseInterface interface {
+	Execute(ctx context.Context, query content.ListRelatedPostsQuery) (*dto.PostsListDTO, error)
+}
+
+// WatchPostsUseCaseInterface defines the interface for watching newly published posts.
+type WatchPostsUseCaseInterface interface {
+	Execute(ctx context.Context, query content.WatchPostsQuery, send func(*livefeed.Event) error) error
+}
+
 // ContentService implements the ContentService gRPC service.
 type ContentService struct {
 	contentv1.UnimplementedContentServiceServer
@@ -53,6 +84,24 @@ type ContentService struct {
 
 	// searchUseCase handles post searching.
 	searchUseCase SearchPostsUseCaseInterface
+
+	// appendFollowUpUseCase handles author follow-ups.
+	appendFollowUpUseCase AppendFollowUpUseCaseInterface
+
+	// listMyPostsUseCase handles listing the logged-in user's posts.
+	listMyPostsUseCase ListMyPostsUseCaseInterface
+
+	// recordViewUseCase counts unique views of GetPost (optional).
+	recordViewUseCase RecordViewUseCaseInterface
+
+	// listTrendingUseCase handles listing trending posts.
+	listTrendingUseCase ListTrendingPostsUseCaseInterface
+
+	// listRelatedUseCase handles listing related posts.
+	listRelatedUseCase ListRelatedPostsUseCaseInterface
+
+	// watchUseCase handles live feed subscriptions.
+	watchUseCase WatchPostsUseCaseInterface
 }
 
 // NewContentService creates a new ContentService instance.
@@ -61,12 +110,24 @@ func NewContentService(
 	listUseCase ListPostsUseCaseInterface,
 	getUseCase GetPostUseCaseInterface,
 	searchUseCase SearchPostsUseCaseInterface,
+	appendFollowUpUseCase AppendFollowUpUseCaseInterface,
+	listMyPostsUseCase ListMyPostsUseCaseInterface,
+	recordViewUseCase RecordViewUseCaseInterface,
+	listTrendingUseCase ListTrendingPostsUseCaseInterface,
+	listRelatedUseCase ListRelatedPostsUseCaseInterface,
+	watchUseCase WatchPostsUseCaseInterface,
 ) *ContentService {
 	return &ContentService{
-		createUseCase: createUseCase,
-		listUseCase:   listUseCase,
-		getUseCase:    getUseCase,
-		searchUseCase: searchUseCase,
+		createUseCase:         createUseCase,
+		listUseCase:           listUseCase,
+		getUseCase:            getUseCase,
+		searchUseCase:         searchUseCase,
+		appendFollowUpUseCase: appendFollowUpUseCase,
+		listMyPostsUseCase:    listMyPostsUseCase,
+		recordViewUseCase:     recordViewUseCase,
+		listTrendingUseCase:   listTrendingUseCase,
+		listRelatedUseCase:    listRelatedUseCase,
+		watchUseCase:          watchUseCase,
 	}
 }
 
@@ -82,7 +143,7 @@ func (s *ContentService) CreatePost(ctx context.Context, req *contentv1.CreatePo
 		occurredAt = &t
 	}
 
-	// Create command
+	// Create command (the author is set only for logged-in users)
 	cmd := content.CreatePostCommand{
 		Company:    req.Company,
 		CityCode:   req.CityCode,
@@ -90,6 +151,7 @@ func (s *ContentService) CreatePost(ctx context.Context, req *contentv1.CreatePo
 		Content:    req.Content,
 		OccurredAt: occurredAt,
 		ClientIP:   clientIP,
+		AuthorID:   logger.UserIDFromContext(ctx),
 	}
 
 	// Execute use case
@@ -100,8 +162,9 @@ func (s *ContentService) CreatePost(ctx context.Context, req *contentv1.CreatePo
 
 	// Convert to response
 	return &contentv1.CreatePostResponse{
-		PostId:    postDTO.ID,
-		CreatedAt: postDTO.CreatedAt.Unix(),
+		PostId:          postDTO.ID,
+		CreatedAt:       postDTO.CreatedAt.Unix(),
+		ManagementToken: postDTO.ManagementToken,
 	}, nil
 }
 
@@ -109,9 +172,11 @@ func (s *ContentService) CreatePost(ctx context.Context, req *contentv1.CreatePo
 func (s *ContentService) ListPosts(ctx context.Context, req *contentv1.ListPostsRequest) (*contentv1.ListPostsResponse, error) {
 	// Create query
 	query := content.ListPostsQuery{
-		CityCode: req.CityCode,
-		Page:     int(req.Page),
-		PageSize: int(req.PageSize),
+		CityCode:         req.CityCode,
+		Page:             int(req.Page),
+		PageSize:         int(req.PageSize),
+		ResolutionStatus: resolutionStatusFromProto(req.ResolutionStatus),
+		View:             postViewFromProto(req.View),
 	}
 
 	// Execute use case
@@ -137,9 +202,20 @@ func (s *ContentService) GetPost(ctx context.Context, req *contentv1.GetPostRequ
 		return nil, convertError(err)
 	}
 
+	// Count the view (errors must not fail the read)
+	if s.recordViewUseCase != nil {
+		_ = s.recordViewUseCase.Execute(ctx, content.RecordViewCommand{
+			PostID:   postDTO.ID,
+			UserID:   logger.UserIDFromContext(ctx),
+			ClientIP: extractClientIP(ctx),
+		})
+	}
+
 	// Convert to response
 	return &contentv1.GetPostResponse{
-		Post: convertPostToProto(postDTO),
+		Post:          convertPostToProto(postDTO),
+		Timeline:      convertFollowUpsToProto(postDTO.Timeline),
+		OfficialReply: convertOfficialReplyToProto(postDTO.OfficialReply),
 	}, nil
 }
 
@@ -156,6 +232,7 @@ func (s *ContentService) SearchPosts(ctx context.Context, req *contentv1.SearchP
 		CityCode: cityCode,
 		Page:     int(req.Page),
 		PageSize: int(req.PageSize),
+		View:     postViewFromProto(req.View),
 	}
 
 	// Execute use case
@@ -173,9 +250,135 @@ func (s *ContentService) SearchPosts(ctx context.Context, req *contentv1.SearchP
 	}, nil
 }
 
-// extractClientIP extracts the client IP address from the gRPC context.
-// It tries to get the IP from peer information first, then from metadata.
+// AppendFollowUp handles the AppendFollowUp gRPC request.
+func (s *ContentService) AppendFollowUp(ctx context.Context, req *contentv1.AppendFollowUpRequest) (*contentv1.AppendFollowUpResponse, error) {
+	// Create command
+	cmd := content.AppendFollowUpCommand{
+		PostID:          req.PostId,
+		ManagementToken: req.ManagementToken,
+		Status:          resolutionStatusFromProto(req.Status),
+		Note:            req.Note,
+	}
+
+	// Execute use case
+	followUpDTO, err := s.appendFollowUpUseCase.Execute(ctx, cmd)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	// Convert to response
+	return &contentv1.AppendFollowUpResponse{
+		FollowUp: convertFollowUpToProto(followUpDTO),
+	}, nil
+}
+
+// ListMyPosts handles the ListMyPosts gRPC request.
+// The user is identified by the access token (see middleware.AuthInterceptor).
+func (s *ContentService) ListMyPosts(ctx context.Context, req *contentv1.ListMyPostsRequest) (*contentv1.ListPostsResponse, error) {
+	// Create query
+	query := content.ListMyPostsQuery{
+		UserID:   logger.UserIDFromContext(ctx),
+		Page:     int(req.Page),
+		PageSize: int(req.PageSize),
+	}
+
+	// Execute use case
+	result, err := s.listMyPostsUseCase.Execute(ctx, query)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	// Convert to response
+	return &contentv1.ListPostsResponse{
+		Posts:    convertPostsToProto(result.Posts),
+		Total:    int32(result.Total),
+		Page:     int32(result.Page),
+		PageSize: int32(result.PageSize),
+	}, nil
+}
+
+// ListTrendingPosts handles the ListTrendingPosts gRPC request.
+func (s *ContentService) ListTrendingPosts(ctx context.Context, req *contentv1.ListTrendingPostsRequest) (*contentv1.ListPostsResponse, error) {
+	// Create query
+	query := content.ListTrendingPostsQuery{
+		CityCode: req.CityCode,
+		Page:     int(req.Page),
+		PageSize: int(req.PageSize),
+		View:     postViewFromProto(req.View),
+	}
+
+	// Execute use case
+	result, err := s.listTrendingUseCase.Execute(ctx, query)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	// Convert to response
+	return &contentv1.ListPostsResponse{
+		Posts:    convertPostsToProto(result.Posts),
+		Total:    int32(result.Total),
+		Page:     int32(result.Page),
+		PageSize: int32(result.PageSize),
+	}, nil
+}
+
+// ListRelatedPosts handles the ListRelatedPosts gRPC request.
+func (s *ContentService) ListRelatedPosts(ctx context.Context, req *contentv1.ListRelatedPostsRequest) (*contentv1.ListPostsResponse, error) {
+	// Create query
+	query := content.ListRelatedPostsQuery{
+		PostID: req.PostId,
+		Limit:  int(req.Limit),
+		View:   postViewFromProto(req.View),
+	}
+
+	// Execute use case
+	result, err := s.listRelatedUseCase.Execute(ctx, query)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	// Convert to response
+	return &contentv1.ListPostsResponse{
+		Posts:    convertPostsToProto(result.Posts),
+		Total:    int32(result.Total),
+		Page:     int32(result.Page),
+		PageSize: int32(result.PageSize),
+	}, nil
+}
+
+// WatchPosts handles the WatchPosts server-streaming gRPC request.
+// The stream stays open until the client cancels it; over grpc-web it works
+// with both the HTTP streaming and the websocket transport.
+func (s *ContentService) WatchPosts(req *contentv1.WatchPostsRequest, stream contentv1.ContentService_WatchPostsServer) error {
+	// Create query
+	query := content.WatchPostsQuery{
+		CityCode: req.CityCode,
+		Company:  req.Company,
+		View:     postViewFromProto(req.View),
+	}
+
+	// Execute use case, sending every post to the stream
+	var sendErr error
+	err := s.watchUseCase.Execute(stream.Context(), query, func(event *livefeed.Event) error {
+		sendErr = stream.Send(&contentv1.WatchPostsResponse{Post: convertPostToProto(event.Post)})
+		return sendErr
+	})
+	if sendErr != nil {
+		// Transport errors are already gRPC status errors
+		return sendErr
+	}
+
+	return convertError(err)
+}
+
+// extractClientIP returns the client address resolved by
+// middleware.ClientIPInterceptor, falling back to the peer address.
+// Forwarding headers are never read here: only trusted proxies may set them.
 func extractClientIP(ctx context.Context) string {
+	if clientIP := logger.ClientIPFromContext(ctx); clientIP != "" {
+		return clientIP
+	}
+
 	// Try to get IP from peer
 	if p, ok := peer.FromContext(ctx); ok {
 		if addr, ok := p.Addr.(*net.TCPAddr); ok {
@@ -188,16 +391,6 @@ func extractClientIP(ctx context.Context) string {
 		return p.Addr.String()
 	}
 
-	// Try to get IP from metadata (X-Forwarded-For or X-Real-IP)
-	if md, ok := metadata.FromIncomingContext(ctx); ok {
-		if forwardedFor := md.Get("x-forwarded-for"); len(forwardedFor) > 0 {
-			return forwardedFor[0]
-		}
-		if realIP := md.Get("x-real-ip"); len(realIP) > 0 {
-			return realIP[0]
-		}
-	}
-
 	// Default fallback
 	return "127.0.0.1"
 }
@@ -214,8 +407,16 @@ func convertError(err error) error {
 		return status.Error(codes.InvalidArgument, err.Error())
 	case apperrors.IsNotFoundError(err):
 		return status.Error(codes.NotFound, err.Error())
+	case apperrors.IsUnauthenticatedError(err):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case apperrors.IsForbiddenError(err):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case apperrors.IsConflictError(err):
+		return status.Error(codes.AlreadyExists, err.Error())
 	case apperrors.IsRateLimitError(err):
 		return status.Error(codes.ResourceExhausted, err.Error())
+	case apperrors.IsUnavailableError(err):
+		return status.Error(codes.Unavailable, err.Error())
 	case apperrors.IsDatabaseError(err):
 		return status.Error(codes.Internal, "internal server error")
 	default:
@@ -235,13 +436,16 @@ func convertPostToProto(postDTO *dto.PostDTO) *contentv1.Post {
 	}
 
 	return &contentv1.Post{
-		Id:         postDTO.ID,
-		Company:    postDTO.Company,
-		CityCode:   postDTO.CityCode,
-		CityName:   postDTO.CityName,
-		Content:    postDTO.Content,
-		OccurredAt: occurredAt,
-		CreatedAt:  postDTO.CreatedAt.Unix(),
+		Id:               postDTO.ID,
+		Company:          postDTO.Company,
+		CityCode:         postDTO.CityCode,
+		CityName:         postDTO.CityName,
+		Content:          postDTO.Content,
+		ContentHtml:      postDTO.ContentHTML,
+		Summary:          postDTO.Summary,
+		OccurredAt:       occurredAt,
+		CreatedAt:        postDTO.CreatedAt.Unix(),
+		ResolutionStatus: resolutionStatusToProto(postDTO.ResolutionStatus),
 	}
 }
 
@@ -257,3 +461,88 @@ func convertPostsToProto(posts []*dto.PostDTO) []*contentv1.Post {
 	}
 	return result
 }
+
+// convertFollowUpToProto converts a FollowUpDTO to a protobuf FollowUp message.
+func convertFollowUpToProto(followUpDTO *dto.FollowUpDTO) *contentv1.FollowUp {
+	if followUpDTO == nil {
+		return nil
+	}
+
+	return &contentv1.FollowUp{
+		Id:        followUpDTO.ID,
+		Status:    resolutionStatusToProto(followUpDTO.Status),
+		Note:      followUpDTO.Note,
+		CreatedAt: followUpDTO.CreatedAt.Unix(),
+	}
+}
+
+// convertFollowUpsToProto converts a follow-up timeline to protobuf FollowUp messages.
+func convertFollowUpsToProto(followUps []*dto.FollowUpDTO) []*contentv1.FollowUp {
+	if followUps == nil {
+		return nil
+	}
+
+	result := make([]*contentv1.FollowUp, 0, len(followUps))
+	for _, followUp := range followUps {
+		result = append(result, convertFollowUpToProto(followUp))
+	}
+	return result
+}
+
+// convertOfficialReplyToProto converts an OfficialReplyDTO to a protobuf OfficialReply message.
+func convertOfficialReplyToProto(replyDTO *dto.OfficialReplyDTO) *contentv1.OfficialReply {
+	if replyDTO == nil {
+		return nil
+	}
+
+	return &contentv1.OfficialReply{
+		Id:             replyDTO.ID,
+		Company:        replyDTO.Company,
+		VerifiedDomain: replyDTO.VerifiedDomain,
+		Content:        replyDTO.Content,
+		Badge:          replyDTO.Badge,
+		CreatedAt:      replyDTO.CreatedAt.Unix(),
+	}
+}
+
+// resolutionStatusToProto converts a resolution status string to the protobuf enum.
+func resolutionStatusToProto(value string) contentv1.ResolutionStatus {
+	switch value {
+	case "ONGOING":
+		return contentv1.ResolutionStatus_RESOLUTION_STATUS_ONGOING
+	case "ESCALATED":
+		return contentv1.ResolutionStatus_RESOLUTION_STATUS_ESCALATED
+	case "RESOLVED":
+		return contentv1.ResolutionStatus_RESOLUTION_STATUS_RESOLVED
+	default:
+		return contentv1.ResolutionStatus_RESOLUTION_STATUS_UNSPECIFIED
+	}
+}
+
+// postViewFromProto converts the protobuf enum to a list view string.
+// UNSPECIFIED maps to an empty string (the FULL view).
+func postViewFromProto(value contentv1.PostView) string {
+	switch value {
+	case contentv1.PostView_POST_VIEW_BASIC:
+		return dto.PostViewBasic
+	case contentv1.PostView_POST_VIEW_FULL:
+		return dto.PostViewFull
+	default:
+		return ""
+	}
+}
+
+// resolutionStatusFromProto converts the protobuf enum to a resolution status string.
+// UNSPECIFIED maps to an empty string.
+func resolutionStatusFromProto(value contentv1.ResolutionStatus) string {
+	switch value {
+	case contentv1.ResolutionStatus_RESOLUTION_STATUS_ONGOING:
+		return "ONGOING"
+	case contentv1.ResolutionStatus_RESOLUTION_STATUS_ESCALATED:
+		return "ESCALATED"
+	case contentv1.ResolutionStatus_RESOLUTION_STATUS_RESOLVED:
+		return "RESOLVED"
+	default:
+		return ""
+	}
+}
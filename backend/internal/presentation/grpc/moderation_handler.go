@@ -0,0 +1,306 @@
+package grpc
+
+import (
+	"context"
+
+	adminv1 "fuck_boss/backend/api/proto/admin/v1"
+	moderationv1 "fuck_boss/backend/api/proto/moderation/v1"
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/application/moderation"
+	"fuck_boss/backend/internal/infrastructure/logger"
+)
+
+// ListModerationQueueUseCaseInterface defines the interface for listing posts pending review.
+type ListModerationQueueUseCaseInterface interface {
+	Execute(ctx context.Context, query moderation.ListModerationQueueQuery) (*dto.ModerationQueueDTO, error)
+}
+
+// ModeratorModeratePostUseCaseInterface defines the interface for moderators acting on posts.
+type ModeratorModeratePostUseCaseInterface interface {
+	Execute(ctx context.Context, cmd moderation.ModeratePostCommand) (*dto.PostModerationDTO, error)
+}
+
+// BanUserUseCaseInterface defines the interface for banning post authors.
+type BanUserUseCaseInterface interface {
+	Execute(ctx context.Context, cmd moderation.BanUserCommand) (*dto.UserBanDTO, error)
+}
+
+// FileAppealUseCaseInterface defines the interface for authors filing appeals.
+type FileAppealUseCaseInterface interface {
+	Execute(ctx context.Context, cmd moderation.FileAppealCommand) (*dto.AppealDTO, error)
+}
+
+// GetAppealStatusUseCaseInterface defines the interface for authors looking up appeals.
+type GetAppealStatusUseCaseInterface interface {
+	Execute(ctx context.Context, query moderation.GetAppealStatusQuery) (*dto.AppealStatusDTO, error)
+}
+
+// ListAppealsUseCaseInterface defines the interface for listing pending appeals.
+type ListAppealsUseCaseInterface interface {
+	Execute(ctx context.Context, query moderation.ListAppealsQuery) (*dto.AppealQueueDTO, error)
+}
+
+// DecideAppealUseCaseInterface defines the interface for deciding appeals.
+type DecideAppealUseCaseInterface interface {
+	Execute(ctx context.Context, cmd moderation.DecideAppealCommand) (*dto.AppealDTO, error)
+}
+
+// ModerationService implements the ModerationService gRPC service.
+// The moderator methods act for the user authenticated by middleware.AuthInterceptor;
+// middleware.ModeratorInterceptor checks the role and records every call in the
+// audit log, and the use cases check the moderator's cities. FileAppeal and
+// GetAppealStatus are for authors, who prove authorship with the management token.
+type ModerationService struct {
+	moderationv1.UnimplementedModerationServiceServer
+
+	// listQueueUseCase lists the moderation queue.
+	listQueueUseCase ListModerationQueueUseCaseInterface
+
+	// moderateUseCase handles moderation actions.
+	moderateUseCase ModeratorModeratePostUseCaseInterface
+
+	// banUseCase bans post authors.
+	banUseCase BanUserUseCaseInterface
+
+	// fileAppealUseCase files appeals.
+	fileAppealUseCase FileAppealUseCaseInterface
+
+	// getAppealStatusUseCase looks up appeals.
+	getAppealStatusUseCase GetAppealStatusUseCaseInterface
+
+	// listAppealsUseCase lists pending appeals.
+	listAppealsUseCase ListAppealsUseCaseInterface
+
+	// decideAppealUseCase decides appeals.
+	decideAppealUseCase DecideAppealUseCaseInterface
+}
+
+// NewModerationService creates a new ModerationService instance.
+func NewModerationService(
+	listQueueUseCase ListModerationQueueUseCaseInterface,
+	moderateUseCase ModeratorModeratePostUseCaseInterface,
+	banUseCase BanUserUseCaseInterface,
+	fileAppealUseCase FileAppealUseCaseInterface,
+	getAppealStatusUseCase GetAppealStatusUseCaseInterface,
+	listAppealsUseCase ListAppealsUseCaseInterface,
+	decideAppealUseCase DecideAppealUseCaseInterface,
+) *ModerationService {
+	return &ModerationService{
+		listQueueUseCase:       listQueueUseCase,
+		moderateUseCase:        moderateUseCase,
+		banUseCase:             banUseCase,
+		fileAppealUseCase:      fileAppealUseCase,
+		getAppealStatusUseCase: getAppealStatusUseCase,
+		listAppealsUseCase:     listAppealsUseCase,
+		decideAppealUseCase:    decideAppealUseCase,
+	}
+}
+
+// ListModerationQueue handles the ListModerationQueue gRPC request.
+func (s *ModerationService) ListModerationQueue(ctx context.Context, req *moderationv1.ListModerationQueueRequest) (*moderationv1.ListModerationQueueResponse, error) {
+	result, err := s.listQueueUseCase.Execute(ctx, moderation.ListModerationQueueQuery{
+		ModeratorID: logger.UserIDFromContext(ctx),
+		CityCode:    req.CityCode,
+		Page:        int(req.Page),
+		PageSize:    int(req.PageSize),
+	})
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	items := make([]*moderationv1.QueueItem, 0, len(result.Items))
+	for _, item := range result.Items {
+		items = append(items, queueItemToProto(item))
+	}
+
+	return &moderationv1.ListModerationQueueResponse{
+		Items:    items,
+		Total:    int32(result.Total),
+		Page:     int32(result.Page),
+		PageSize: int32(result.PageSize),
+	}, nil
+}
+
+// ModeratePost handles the ModeratePost gRPC request.
+func (s *ModerationService) ModeratePost(ctx context.Context, req *moderationv1.ModeratePostRequest) (*adminv1.ModeratePostResponse, error) {
+	moderationDTO, err := s.moderateUseCase.Execute(ctx, moderation.ModeratePostCommand{
+		ModeratorID: logger.UserIDFromContext(ctx),
+		PostID:      req.PostId,
+		Action:      moderationActionFromProto(req.Action),
+		Reason:      req.Reason,
+	})
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return &adminv1.ModeratePostResponse{
+		PostId:      moderationDTO.PostID,
+		Status:      moderationStatusToProto(moderationDTO.Status),
+		Reason:      moderationDTO.Reason,
+		ModeratedAt: moderationDTO.ModeratedAt.Unix(),
+	}, nil
+}
+
+// BanUser handles the BanUser gRPC request.
+func (s *ModerationService) BanUser(ctx context.Context, req *moderationv1.BanUserRequest) (*moderationv1.BanUserResponse, error) {
+	ban, err := s.banUseCase.Execute(ctx, moderation.BanUserCommand{
+		ModeratorID: logger.UserIDFromContext(ctx),
+		PostID:      req.PostId,
+		Reason:      req.Reason,
+	})
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return &moderationv1.BanUserResponse{
+		UserId:   ban.UserID,
+		Reason:   ban.Reason,
+		BannedAt: ban.BannedAt.Unix(),
+	}, nil
+}
+
+// FileAppeal handles the FileAppeal gRPC request.
+func (s *ModerationService) FileAppeal(ctx context.Context, req *moderationv1.FileAppealRequest) (*moderationv1.FileAppealResponse, error) {
+	appeal, err := s.fileAppealUseCase.Execute(ctx, moderation.FileAppealCommand{
+		PostID:          req.PostId,
+		ManagementToken: req.ManagementToken,
+		Statement:       req.Statement,
+	})
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return &moderationv1.FileAppealResponse{
+		Appeal: appealToProto(appeal),
+	}, nil
+}
+
+// GetAppealStatus handles the GetAppealStatus gRPC request.
+func (s *ModerationService) GetAppealStatus(ctx context.Context, req *moderationv1.GetAppealStatusRequest) (*moderationv1.GetAppealStatusResponse, error) {
+	result, err := s.getAppealStatusUseCase.Execute(ctx, moderation.GetAppealStatusQuery{
+		PostID:          req.PostId,
+		ManagementToken: req.ManagementToken,
+	})
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	resp := &moderationv1.GetAppealStatusResponse{
+		PostId: result.PostID,
+		Status: moderationStatusToProto(result.Status),
+		Reason: result.Reason,
+	}
+	if !result.ModeratedAt.IsZero() {
+		resp.ModeratedAt = result.ModeratedAt.Unix()
+	}
+	if result.Appeal != nil {
+		resp.Appeal = appealToProto(result.Appeal)
+	}
+	return resp, nil
+}
+
+// ListAppeals handles the ListAppeals gRPC request.
+func (s *ModerationService) ListAppeals(ctx context.Context, req *moderationv1.ListAppealsRequest) (*moderationv1.ListAppealsResponse, error) {
+	result, err := s.listAppealsUseCase.Execute(ctx, moderation.ListAppealsQuery{
+		ModeratorID: logger.UserIDFromContext(ctx),
+		CityCode:    req.CityCode,
+		Page:        int(req.Page),
+		PageSize:    int(req.PageSize),
+	})
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	items := make([]*moderationv1.AppealItem, 0, len(result.Items))
+	for _, item := range result.Items {
+		items = append(items, &moderationv1.AppealItem{
+			Appeal: appealToProto(item.Appeal),
+			Post:   queueItemToProto(item.Post),
+		})
+	}
+
+	return &moderationv1.ListAppealsResponse{
+		Items:    items,
+		Total:    int32(result.Total),
+		Page:     int32(result.Page),
+		PageSize: int32(result.PageSize),
+	}, nil
+}
+
+// DecideAppeal handles the DecideAppeal gRPC request.
+func (s *ModerationService) DecideAppeal(ctx context.Context, req *moderationv1.DecideAppealRequest) (*moderationv1.DecideAppealResponse, error) {
+	appeal, err := s.decideAppealUseCase.Execute(ctx, moderation.DecideAppealCommand{
+		ModeratorID: logger.UserIDFromContext(ctx),
+		AppealID:    req.AppealId,
+		Decision:    appealDecisionFromProto(req.Decision),
+		Reason:      req.Reason,
+	})
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return &moderationv1.DecideAppealResponse{
+		Appeal: appealToProto(appeal),
+	}, nil
+}
+
+// queueItemToProto converts a ModerationQueueItemDTO to its protobuf message.
+func queueItemToProto(item *dto.ModerationQueueItemDTO) *moderationv1.QueueItem {
+	return &moderationv1.QueueItem{
+		PostId:      item.PostID,
+		Company:     item.Company,
+		CityCode:    item.CityCode,
+		CityName:    item.CityName,
+		Content:     item.Content,
+		CreatedAt:   item.CreatedAt.Unix(),
+		Reason:      item.Reason,
+		ModeratedAt: item.ModeratedAt.Unix(),
+		ModeratedBy: item.ModeratedBy,
+		Status:      moderationStatusToProto(item.Status),
+	}
+}
+
+// appealToProto converts an AppealDTO to its protobuf message.
+// The moderator IDs are left out: the message is shown to authors.
+func appealToProto(appeal *dto.AppealDTO) *moderationv1.Appeal {
+	pb := &moderationv1.Appeal{
+		AppealId:       appeal.ID,
+		PostId:         appeal.PostID,
+		Status:         appealStatusToProto(appeal.Status),
+		AppealedStatus: moderationStatusToProto(appeal.AppealedStatus),
+		Statement:      appeal.Statement,
+		DecisionReason: appeal.DecisionReason,
+		FiledAt:        appeal.FiledAt.Unix(),
+	}
+	if appeal.DecidedAt != nil {
+		pb.DecidedAt = appeal.DecidedAt.Unix()
+	}
+	return pb
+}
+
+// appealStatusToProto converts an appeal status string to the protobuf enum.
+func appealStatusToProto(value string) moderationv1.AppealStatus {
+	switch value {
+	case "PENDING":
+		return moderationv1.AppealStatus_APPEAL_STATUS_PENDING
+	case "ACCEPTED":
+		return moderationv1.AppealStatus_APPEAL_STATUS_ACCEPTED
+	case "REJECTED":
+		return moderationv1.AppealStatus_APPEAL_STATUS_REJECTED
+	default:
+		return moderationv1.AppealStatus_APPEAL_STATUS_UNSPECIFIED
+	}
+}
+
+// appealDecisionFromProto converts the protobuf appeal decision to the
+// application decision. Unspecified decisions become "" and fail validation.
+func appealDecisionFromProto(value moderationv1.AppealDecision) moderation.AppealDecision {
+	switch value {
+	case moderationv1.AppealDecision_APPEAL_DECISION_ACCEPT:
+		return moderation.AppealDecisionAccept
+	case moderationv1.AppealDecision_APPEAL_DECISION_REJECT:
+		return moderation.AppealDecisionReject
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,178 @@
+// Package grpc provides gRPC handlers for content management.
+package grpc
+
+import (
+	"context"
+
+	notificationv1 "fuck_boss/backend/api/proto/notification/v1"
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/application/notification"
+	"fuck_boss/backend/internal/infrastructure/logger"
+)
+
+// ListNotificationsUseCaseInterface defines the interface for listing notifications.
+type ListNotificationsUseCaseInterface interface {
+	Execute(ctx context.Context, query notification.ListNotificationsQuery) (*dto.NotificationsListDTO, error)
+}
+
+// CountUnreadUseCaseInterface defines the interface for counting unread notifications.
+type CountUnreadUseCaseInterface interface {
+	Execute(ctx context.Context, query notification.CountUnreadQuery) (int, error)
+}
+
+// MarkReadUseCaseInterface defines the interface for marking a notification as read.
+type MarkReadUseCaseInterface interface {
+	Execute(ctx context.Context, cmd notification.MarkReadCommand) error
+}
+
+// MarkAllReadUseCaseInterface defines the interface for marking all notifications as read.
+type MarkAllReadUseCaseInterface interface {
+	Execute(ctx context.Context, cmd notification.MarkAllReadCommand) (int, error)
+}
+
+// NotificationService implements the NotificationService gRPC service.
+// All methods act for the user authenticated by middleware.AuthInterceptor.
+type NotificationService struct {
+	notificationv1.UnimplementedNotificationServiceServer
+
+	// listUseCase lists notifications.
+	listUseCase ListNotificationsUseCaseInterface
+
+	// countUnreadUseCase counts unread notifications.
+	countUnreadUseCase CountUnreadUseCaseInterface
+
+	// markReadUseCase marks a notification as read.
+	markReadUseCase MarkReadUseCaseInterface
+
+	// markAllReadUseCase marks all notifications as read.
+	markAllReadUseCase MarkAllReadUseCaseInterface
+}
+
+// NewNotificationService creates a new NotificationService instance.
+func NewNotificationService(
+	listUseCase ListNotificationsUseCaseInterface,
+	countUnreadUseCase CountUnreadUseCaseInterface,
+	markReadUseCase MarkReadUseCaseInterface,
+	markAllReadUseCase MarkAllReadUseCaseInterface,
+) *NotificationService {
+	return &NotificationService{
+		listUseCase:        listUseCase,
+		countUnreadUseCase: countUnreadUseCase,
+		markReadUseCase:    markReadUseCase,
+		markAllReadUseCase: markAllReadUseCase,
+	}
+}
+
+// ListNotifications handles the ListNotifications gRPC request.
+func (s *NotificationService) ListNotifications(ctx context.Context, req *notificationv1.ListNotificationsRequest) (*notificationv1.ListNotificationsResponse, error) {
+	result, err := s.listUseCase.Execute(ctx, notification.ListNotificationsQuery{
+		UserID:     logger.UserIDFromContext(ctx),
+		Cursor:     req.Cursor,
+		PageSize:   int(req.PageSize),
+		UnreadOnly: req.UnreadOnly,
+		Type:       notificationTypeFromProto(req.Type),
+	})
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	notifications := make([]*notificationv1.Notification, 0, len(result.Notifications))
+	for _, n := range result.Notifications {
+		notifications = append(notifications, convertNotificationToProto(n))
+	}
+
+	return &notificationv1.ListNotificationsResponse{
+		Notifications: notifications,
+		NextCursor:    result.NextCursor,
+		UnreadCount:   int32(result.UnreadCount),
+	}, nil
+}
+
+// GetUnreadCount handles the GetUnreadCount gRPC request.
+func (s *NotificationService) GetUnreadCount(ctx context.Context, req *notificationv1.GetUnreadCountRequest) (*notificationv1.GetUnreadCountResponse, error) {
+	count, err := s.countUnreadUseCase.Execute(ctx, notification.CountUnreadQuery{
+		UserID: logger.UserIDFromContext(ctx),
+	})
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return &notificationv1.GetUnreadCountResponse{
+		UnreadCount: int32(count),
+	}, nil
+}
+
+// MarkNotificationRead handles the MarkNotificationRead gRPC request.
+func (s *NotificationService) MarkNotificationRead(ctx context.Context, req *notificationv1.MarkNotificationReadRequest) (*notificationv1.MarkNotificationReadResponse, error) {
+	if err := s.markReadUseCase.Execute(ctx, notification.MarkReadCommand{
+		UserID:         logger.UserIDFromContext(ctx),
+		NotificationID: req.Id,
+	}); err != nil {
+		return nil, convertError(err)
+	}
+
+	return &notificationv1.MarkNotificationReadResponse{}, nil
+}
+
+// MarkAllNotificationsRead handles the MarkAllNotificationsRead gRPC request.
+func (s *NotificationService) MarkAllNotificationsRead(ctx context.Context, req *notificationv1.MarkAllNotificationsReadRequest) (*notificationv1.MarkAllNotificationsReadResponse, error) {
+	updated, err := s.markAllReadUseCase.Execute(ctx, notification.MarkAllReadCommand{
+		UserID: logger.UserIDFromContext(ctx),
+	})
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return &notificationv1.MarkAllNotificationsReadResponse{
+		Updated: int32(updated),
+	}, nil
+}
+
+// convertNotificationToProto converts a NotificationDTO to a protobuf Notification.
+func convertNotificationToProto(n *dto.NotificationDTO) *notificationv1.Notification {
+	pb := &notificationv1.Notification{
+		Id:        n.ID,
+		Type:      notificationTypeToProto(n.Type),
+		PostId:    n.PostID,
+		Subject:   n.Subject,
+		Excerpt:   n.Excerpt,
+		CreatedAt: n.CreatedAt.Unix(),
+	}
+	if n.ReadAt != nil {
+		pb.ReadAt = n.ReadAt.Unix()
+	}
+	return pb
+}
+
+// notificationTypeToProto converts a notification type string to the protobuf enum.
+func notificationTypeToProto(value string) notificationv1.NotificationType {
+	switch value {
+	case "COMMENT_REPLY":
+		return notificationv1.NotificationType_NOTIFICATION_TYPE_COMMENT_REPLY
+	case "WATCHED_COMPANY_POST":
+		return notificationv1.NotificationType_NOTIFICATION_TYPE_WATCHED_COMPANY_POST
+	case "MODERATION_OUTCOME":
+		return notificationv1.NotificationType_NOTIFICATION_TYPE_MODERATION_OUTCOME
+	case "COMPANY_REPLY":
+		return notificationv1.NotificationType_NOTIFICATION_TYPE_COMPANY_REPLY
+	default:
+		return notificationv1.NotificationType_NOTIFICATION_TYPE_UNSPECIFIED
+	}
+}
+
+// notificationTypeFromProto converts the protobuf enum to a notification type string
+// (empty for UNSPECIFIED, which means no filter).
+func notificationTypeFromProto(value notificationv1.NotificationType) string {
+	switch value {
+	case notificationv1.NotificationType_NOTIFICATION_TYPE_COMMENT_REPLY:
+		return "COMMENT_REPLY"
+	case notificationv1.NotificationType_NOTIFICATION_TYPE_WATCHED_COMPANY_POST:
+		return "WATCHED_COMPANY_POST"
+	case notificationv1.NotificationType_NOTIFICATION_TYPE_MODERATION_OUTCOME:
+		return "MODERATION_OUTCOME"
+	case notificationv1.NotificationType_NOTIFICATION_TYPE_COMPANY_REPLY:
+		return "COMPANY_REPLY"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,241 @@
+// Package grpc provides gRPC handlers for content management.
+package grpc
+
+import (
+	"context"
+	"time"
+
+	watchlistv1 "fuck_boss/backend/api/proto/watchlist/v1"
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/application/watchlist"
+	"fuck_boss/backend/internal/infrastructure/logger"
+)
+
+// IssueDeviceTokenUseCaseInterface defines the interface for issuing device tokens.
+type IssueDeviceTokenUseCaseInterface interface {
+	Execute(ctx context.Context, cmd watchlist.IssueDeviceTokenCommand) (*dto.DeviceTokenDTO, error)
+}
+
+// BookmarkUseCaseInterface defines the interface for adding or removing bookmarks.
+type BookmarkUseCaseInterface interface {
+	Execute(ctx context.Context, cmd watchlist.BookmarkCommand) error
+}
+
+// ListBookmarksUseCaseInterface defines the interface for listing bookmarks.
+type ListBookmarksUseCaseInterface interface {
+	Execute(ctx context.Context, query watchlist.ListBookmarksQuery) (*dto.BookmarksListDTO, error)
+}
+
+// WatchUseCaseInterface defines the interface for watching or unwatching companies.
+type WatchUseCaseInterface interface {
+	Execute(ctx context.Context, cmd watchlist.WatchCommand) error
+}
+
+// ListWatchedCompaniesUseCaseInterface defines the interface for listing watched companies.
+type ListWatchedCompaniesUseCaseInterface interface {
+	Execute(ctx context.Context, query watchlist.OwnerQuery) ([]*dto.WatchedCompanyDTO, error)
+}
+
+// ListWatchedPostsUseCaseInterface defines the interface for listing new posts about watched companies.
+type ListWatchedPostsUseCaseInterface interface {
+	Execute(ctx context.Context, query watchlist.ListWatchedPostsQuery) (*dto.WatchedPostsDTO, error)
+}
+
+// WatchlistService implements the WatchlistService gRPC service.
+// It handles bookmarks and company watchlists for logged-in users and anonymous devices.
+type WatchlistService struct {
+	watchlistv1.UnimplementedWatchlistServiceServer
+
+	// issueDeviceTokenUseCase issues device tokens.
+	issueDeviceTokenUseCase IssueDeviceTokenUseCaseInterface
+
+	// addBookmarkUseCase adds bookmarks.
+	addBookmarkUseCase BookmarkUseCaseInterface
+
+	// removeBookmarkUseCase removes bookmarks.
+	removeBookmarkUseCase BookmarkUseCaseInterface
+
+	// listBookmarksUseCase lists bookmarks.
+	listBookmarksUseCase ListBookmarksUseCaseInterface
+
+	// watchUseCase watches companies.
+	watchUseCase WatchUseCaseInterface
+
+	// unwatchUseCase unwatches companies.
+	unwatchUseCase WatchUseCaseInterface
+
+	// listWatchedCompaniesUseCase lists watched companies.
+	listWatchedCompaniesUseCase ListWatchedCompaniesUseCaseInterface
+
+	// listWatchedPostsUseCase lists new posts about watched companies.
+	listWatchedPostsUseCase ListWatchedPostsUseCaseInterface
+}
+
+// NewWatchlistService creates a new WatchlistService instance.
+func NewWatchlistService(
+	issueDeviceTokenUseCase IssueDeviceTokenUseCaseInterface,
+	addBookmarkUseCase BookmarkUseCaseInterface,
+	removeBookmarkUseCase BookmarkUseCaseInterface,
+	listBookmarksUseCase ListBookmarksUseCaseInterface,
+	watchUseCase WatchUseCaseInterface,
+	unwatchUseCase WatchUseCaseInterface,
+	listWatchedCompaniesUseCase ListWatchedCompaniesUseCaseInterface,
+	listWatchedPostsUseCase ListWatchedPostsUseCaseInterface,
+) *WatchlistService {
+	return &WatchlistService{
+		issueDeviceTokenUseCase:     issueDeviceTokenUseCase,
+		addBookmarkUseCase:          addBookmarkUseCase,
+		removeBookmarkUseCase:       removeBookmarkUseCase,
+		listBookmarksUseCase:        listBookmarksUseCase,
+		watchUseCase:                watchUseCase,
+		unwatchUseCase:              unwatchUseCase,
+		listWatchedCompaniesUseCase: listWatchedCompaniesUseCase,
+		listWatchedPostsUseCase:     listWatchedPostsUseCase,
+	}
+}
+
+// IssueDeviceToken handles the IssueDeviceToken gRPC request.
+func (s *WatchlistService) IssueDeviceToken(ctx context.Context, req *watchlistv1.IssueDeviceTokenRequest) (*watchlistv1.IssueDeviceTokenResponse, error) {
+	result, err := s.issueDeviceTokenUseCase.Execute(ctx, watchlist.IssueDeviceTokenCommand{
+		ClientIP: extractClientIP(ctx),
+	})
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return &watchlistv1.IssueDeviceTokenResponse{
+		DeviceToken: result.DeviceToken,
+		IssuedAt:    result.IssuedAt.Unix(),
+	}, nil
+}
+
+// AddBookmark handles the AddBookmark gRPC request.
+func (s *WatchlistService) AddBookmark(ctx context.Context, req *watchlistv1.AddBookmarkRequest) (*watchlistv1.AddBookmarkResponse, error) {
+	if err := s.addBookmarkUseCase.Execute(ctx, watchlist.BookmarkCommand{
+		UserID:      logger.UserIDFromContext(ctx),
+		DeviceToken: req.DeviceToken,
+		PostID:      req.PostId,
+	}); err != nil {
+		return nil, convertError(err)
+	}
+
+	return &watchlistv1.AddBookmarkResponse{}, nil
+}
+
+// RemoveBookmark handles the RemoveBookmark gRPC request.
+func (s *WatchlistService) RemoveBookmark(ctx context.Context, req *watchlistv1.RemoveBookmarkRequest) (*watchlistv1.RemoveBookmarkResponse, error) {
+	if err := s.removeBookmarkUseCase.Execute(ctx, watchlist.BookmarkCommand{
+		UserID:      logger.UserIDFromContext(ctx),
+		DeviceToken: req.DeviceToken,
+		PostID:      req.PostId,
+	}); err != nil {
+		return nil, convertError(err)
+	}
+
+	return &watchlistv1.RemoveBookmarkResponse{}, nil
+}
+
+// ListBookmarks handles the ListBookmarks gRPC request.
+func (s *WatchlistService) ListBookmarks(ctx context.Context, req *watchlistv1.ListBookmarksRequest) (*watchlistv1.ListBookmarksResponse, error) {
+	result, err := s.listBookmarksUseCase.Execute(ctx, watchlist.ListBookmarksQuery{
+		UserID:      logger.UserIDFromContext(ctx),
+		DeviceToken: req.DeviceToken,
+		Page:        int(req.Page),
+		PageSize:    int(req.PageSize),
+	})
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	bookmarks := make([]*watchlistv1.Bookmark, 0, len(result.Bookmarks))
+	for _, bookmark := range result.Bookmarks {
+		bookmarks = append(bookmarks, &watchlistv1.Bookmark{
+			Post:         convertPostToProto(bookmark.Post),
+			BookmarkedAt: bookmark.BookmarkedAt.Unix(),
+		})
+	}
+
+	return &watchlistv1.ListBookmarksResponse{
+		Bookmarks: bookmarks,
+		Total:     int32(result.Total),
+		Page:      int32(result.Page),
+		PageSize:  int32(result.PageSize),
+	}, nil
+}
+
+// WatchCompany handles the WatchCompany gRPC request.
+func (s *WatchlistService) WatchCompany(ctx context.Context, req *watchlistv1.WatchCompanyRequest) (*watchlistv1.WatchCompanyResponse, error) {
+	if err := s.watchUseCase.Execute(ctx, watchlist.WatchCommand{
+		UserID:      logger.UserIDFromContext(ctx),
+		DeviceToken: req.DeviceToken,
+		Company:     req.Company,
+	}); err != nil {
+		return nil, convertError(err)
+	}
+
+	return &watchlistv1.WatchCompanyResponse{}, nil
+}
+
+// UnwatchCompany handles the UnwatchCompany gRPC request.
+func (s *WatchlistService) UnwatchCompany(ctx context.Context, req *watchlistv1.UnwatchCompanyRequest) (*watchlistv1.UnwatchCompanyResponse, error) {
+	if err := s.unwatchUseCase.Execute(ctx, watchlist.WatchCommand{
+		UserID:      logger.UserIDFromContext(ctx),
+		DeviceToken: req.DeviceToken,
+		Company:     req.Company,
+	}); err != nil {
+		return nil, convertError(err)
+	}
+
+	return &watchlistv1.UnwatchCompanyResponse{}, nil
+}
+
+// ListWatchedCompanies handles the ListWatchedCompanies gRPC request.
+func (s *WatchlistService) ListWatchedCompanies(ctx context.Context, req *watchlistv1.ListWatchedCompaniesRequest) (*watchlistv1.ListWatchedCompaniesResponse, error) {
+	result, err := s.listWatchedCompaniesUseCase.Execute(ctx, watchlist.OwnerQuery{
+		UserID:      logger.UserIDFromContext(ctx),
+		DeviceToken: req.DeviceToken,
+	})
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	companies := make([]*watchlistv1.WatchedCompany, 0, len(result))
+	for _, watched := range result {
+		companies = append(companies, &watchlistv1.WatchedCompany{
+			Company:   watched.Company,
+			WatchedAt: watched.WatchedAt.Unix(),
+		})
+	}
+
+	return &watchlistv1.ListWatchedCompaniesResponse{
+		Companies: companies,
+	}, nil
+}
+
+// ListWatchedPosts handles the ListWatchedPosts gRPC request.
+func (s *WatchlistService) ListWatchedPosts(ctx context.Context, req *watchlistv1.ListWatchedPostsRequest) (*watchlistv1.ListWatchedPostsResponse, error) {
+	query := watchlist.ListWatchedPostsQuery{
+		UserID:      logger.UserIDFromContext(ctx),
+		DeviceToken: req.DeviceToken,
+		MarkVisited: req.MarkVisited,
+		Page:        int(req.Page),
+		PageSize:    int(req.PageSize),
+	}
+	if req.Since > 0 {
+		since := time.Unix(req.Since, 0)
+		query.Since = &since
+	}
+
+	result, err := s.listWatchedPostsUseCase.Execute(ctx, query)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return &watchlistv1.ListWatchedPostsResponse{
+		Posts:    convertPostsToProto(result.Posts),
+		Total:    int32(result.Total),
+		Page:     int32(result.Page),
+		PageSize: int32(result.PageSize),
+		Since:    result.Since.Unix(),
+	}, nil
+}
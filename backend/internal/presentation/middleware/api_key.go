@@ -0,0 +1,216 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"fuck_boss/backend/internal/application/admin"
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/infrastructure/logger"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// APIKeyHeader is the metadata key (gRPC) and header (HTTP) carrying the API key.
+// It is separate from "authorization", which carries user access tokens.
+const APIKeyHeader = "x-api-key"
+
+// APIKeyAuthenticator checks an API key for a call that requires a scope.
+// It is implemented by admin.AuthenticateAPIKeyUseCase.
+type APIKeyAuthenticator interface {
+	Execute(ctx context.Context, cmd admin.AuthenticateAPIKeyCommand) (*dto.APIKeyDTO, error)
+}
+
+// AuditRecorder appends a privileged call to the audit log.
+// It is implemented by admin.RecordAuditEventUseCase.
+type AuditRecorder interface {
+	Execute(ctx context.Context, cmd admin.RecordAuditEventCommand) error
+}
+
+// APIKeyInterceptor returns a gRPC unary server interceptor that guards privileged
+// methods. methodScopes maps full method names to the scope they require; other
+// methods pass through untouched. A privileged call needs an "x-api-key" header
+// with an active key that grants the scope, and puts the key ID into the context
+// (see logger.WithAPIKeyID). Every call with a valid key is recorded in the audit
+// log with its outcome, including calls denied for a missing scope; calls without
+// a valid key are rejected with codes.Unauthenticated and only logged. The client
+// IP recorded is forwarded by a proxy only if the peer is one of proxies.
+func APIKeyInterceptor(
+	authenticator APIKeyAuthenticator,
+	recorder AuditRecorder,
+	log logger.Logger,
+	proxies TrustedProxies,
+	methodScopes map[string]string,
+) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		scope, ok := methodScopes[info.FullMethod]
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		md, _ := metadata.FromIncomingContext(ctx)
+		var rawKey string
+		if values := md.Get(APIKeyHeader); len(values) > 0 {
+			rawKey = values[0]
+		}
+
+		event := admin.RecordAuditEventCommand{
+			Action:   info.FullMethod,
+			ClientIP: proxies.GRPCClientIP(ctx, md),
+		}
+		if msg, ok := req.(proto.Message); ok {
+			if details, err := protojson.Marshal(msg); err == nil && string(details) != "{}" {
+				event.Details = string(details)
+			}
+		}
+		if target, ok := req.(interface{ GetPostId() string }); ok {
+			event.Target = target.GetPostId()
+		}
+
+		key, err := authenticator.Execute(ctx, admin.AuthenticateAPIKeyCommand{Key: rawKey, Scope: scope})
+		if err != nil {
+			if apperrors.IsForbiddenError(err) && key != nil {
+				event.APIKeyID, event.Actor = key.ID, key.Name
+				event.Outcome = codes.PermissionDenied.String()
+				recordAuditEvent(ctx, recorder, log, event)
+				return nil, status.Error(codes.PermissionDenied, err.Error())
+			}
+			if apperrors.IsUnauthenticatedError(err) {
+				log.WithContext(ctx).Warn("API key authentication failed",
+					zap.String("method", info.FullMethod),
+					zap.String("client_ip", event.ClientIP),
+					zap.Error(err),
+				)
+				return nil, status.Error(codes.Unauthenticated, err.Error())
+			}
+			log.WithContext(ctx).Error("API key authentication error", zap.Error(err))
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+
+		resp, err := handler(logger.WithAPIKeyID(ctx, key.ID), req)
+
+		event.APIKeyID, event.Actor = key.ID, key.Name
+		event.Outcome = status.Code(err).String()
+		recordAuditEvent(ctx, recorder, log, event)
+
+		return resp, err
+	}
+}
+
+// APIKeyMiddleware is the HTTP counterpart of APIKeyInterceptor for privileged
+// REST endpoints: the handler requires an active key in the X-API-Key header that
+// grants the scope, and every call with a valid key is recorded in the audit log
+// as "<METHOD> <path>" with the query parameters as details.
+func APIKeyMiddleware(authenticator APIKeyAuthenticator, recorder AuditRecorder, log logger.Logger, proxies TrustedProxies, scope string) func(http.HandlerFunc) http.HandlerFunc {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			event := admin.RecordAuditEventCommand{
+				Action:   r.Method + " " + r.URL.Path,
+				ClientIP: proxies.HTTPClientIP(r),
+			}
+			if query := r.URL.Query(); len(query) > 0 {
+				if details, err := json.Marshal(query); err == nil {
+					event.Details = string(details)
+				}
+			}
+
+			key, err := authenticator.Execute(r.Context(), admin.AuthenticateAPIKeyCommand{
+				Key:   r.Header.Get(APIKeyHeader),
+				Scope: scope,
+			})
+			if err != nil {
+				code, message := http.StatusInternalServerError, "internal server error"
+				switch {
+				case apperrors.IsForbiddenError(err) && key != nil:
+					event.APIKeyID, event.Actor = key.ID, key.Name
+					event.Outcome = codes.PermissionDenied.String()
+					recordAuditEvent(r.Context(), recorder, log, event)
+					code, message = http.StatusForbidden, err.Error()
+				case apperrors.IsUnauthenticatedError(err):
+					log.WithContext(r.Context()).Warn("API key authentication failed",
+						zap.String("path", r.URL.Path),
+						zap.String("client_ip", event.ClientIP),
+						zap.Error(err),
+					)
+					code, message = http.StatusUnauthorized, err.Error()
+				default:
+					log.WithContext(r.Context()).Error("API key authentication error", zap.Error(err))
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(code)
+				json.NewEncoder(w).Encode(map[string]string{"error": message})
+				return
+			}
+
+			sw := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+			next(sw, r.WithContext(logger.WithAPIKeyID(r.Context(), key.ID)))
+
+			event.APIKeyID, event.Actor = key.ID, key.Name
+			event.Outcome = outcomeForHTTPStatus(sw.status)
+			recordAuditEvent(r.Context(), recorder, log, event)
+		}
+	}
+}
+
+// recordAuditEvent records the call even if the request was canceled meanwhile.
+// The call has already happened, so a failed write is logged but does not fail it.
+func recordAuditEvent(ctx context.Context, recorder AuditRecorder, log logger.Logger, event admin.RecordAuditEventCommand) {
+	if err := recorder.Execute(context.WithoutCancel(ctx), event); err != nil {
+		log.WithContext(ctx).Error("Failed to record audit event",
+			zap.String("action", event.Action),
+			zap.String("api_key_id", event.APIKeyID),
+			zap.String("outcome", event.Outcome),
+			zap.Error(err),
+		)
+	}
+}
+
+// statusRecorder remembers the status code written by an HTTP handler.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+// WriteHeader records the status code and writes it.
+func (r *statusRecorder) WriteHeader(code int) {
+	r.status = code
+	r.ResponseWriter.WriteHeader(code)
+}
+
+// outcomeForHTTPStatus converts an HTTP status code to the gRPC code name used
+// as audit outcome, so REST and gRPC calls can be filtered the same way.
+func outcomeForHTTPStatus(code int) string {
+	switch {
+	case code < 400:
+		return codes.OK.String()
+	case code == http.StatusBadRequest:
+		return codes.InvalidArgument.String()
+	case code == http.StatusUnauthorized:
+		return codes.Unauthenticated.String()
+	case code == http.StatusForbidden:
+		return codes.PermissionDenied.String()
+	case code == http.StatusNotFound:
+		return codes.NotFound.String()
+	case code == http.StatusConflict:
+		return codes.AlreadyExists.String()
+	case code == http.StatusTooManyRequests:
+		return codes.ResourceExhausted.String()
+	case code == http.StatusServiceUnavailable:
+		return codes.Unavailable.String()
+	default:
+		return codes.Internal.String()
+	}
+}
@@ -0,0 +1,127 @@
+// Package middleware provides gRPC interceptors for authentication.
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"fuck_boss/backend/internal/infrastructure/logger"
+)
+
+// AccessTokenParser validates an access token and returns the user ID it was issued to.
+type AccessTokenParser interface {
+	ParseAccessToken(token string) (string, error)
+}
+
+// AuthInterceptor returns a gRPC unary server interceptor that authenticates requests.
+// A valid "authorization: Bearer <token>" header puts the user ID into the context
+// (see logger.WithUserID). Requests without the header stay anonymous, except for
+// the listed protected methods, which are rejected with codes.Unauthenticated.
+// An invalid or expired token is always rejected, so clients know to refresh it.
+func AuthInterceptor(parser AccessTokenParser, log logger.Logger, protectedMethods ...string) grpc.UnaryServerInterceptor {
+	protected := make(map[string]bool, len(protectedMethods))
+	for _, method := range protectedMethods {
+		protected[method] = true
+	}
+
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		var authorization string
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get("authorization"); len(values) > 0 {
+				authorization = values[0]
+			}
+		}
+
+		if authorization == "" {
+			if protected[info.FullMethod] {
+				return nil, status.Error(codes.Unauthenticated, "login required")
+			}
+			return handler(ctx, req)
+		}
+
+		userID, err := authenticate(parser, authorization)
+		if err != nil {
+			log.WithContext(ctx).Warn("gRPC authentication failed",
+				zap.String("method", info.FullMethod),
+				zap.Error(err),
+			)
+			return nil, status.Error(codes.Unauthenticated, "invalid or expired access token")
+		}
+
+		return handler(logger.WithUserID(ctx, userID), req)
+	}
+}
+
+// AuthMiddleware is the HTTP counterpart of AuthInterceptor for REST handlers.
+// A valid bearer token puts the user ID into the request context; requests without
+// the header pass through anonymously; an invalid or expired token gets 401.
+// Handlers that require login check logger.UserIDFromContext themselves.
+func AuthMiddleware(parser AccessTokenParser) func(http.HandlerFunc) http.HandlerFunc {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			authorization := r.Header.Get("Authorization")
+			if authorization == "" {
+				next(w, r)
+				return
+			}
+
+			userID, err := authenticate(parser, authorization)
+			if err != nil {
+				w.Header().Set("Content-Type", "application/json")
+				w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+				w.WriteHeader(http.StatusUnauthorized)
+				json.NewEncoder(w).Encode(map[string]string{"error": "invalid or expired access token"})
+				return
+			}
+
+			next(w, r.WithContext(logger.WithUserID(r.Context(), userID)))
+		}
+	}
+}
+
+// authenticate extracts the bearer token from an Authorization header value and parses it.
+func authenticate(parser AccessTokenParser, authorization string) (string, error) {
+	token, ok := BearerToken(authorization)
+	if !ok {
+		return "", status.Error(codes.Unauthenticated, "authorization header must use the Bearer scheme")
+	}
+	return parser.ParseAccessToken(token)
+}
+
+// BearerToken extracts the token from an "Authorization: Bearer <token>" header value.
+// The scheme is matched case-insensitively.
+func BearerToken(authorization string) (string, bool) {
+	const prefix = "bearer "
+	if len(authorization) <= len(prefix) || !strings.EqualFold(authorization[:len(prefix)], prefix) {
+		return "", false
+	}
+	token := strings.TrimSpace(authorization[len(prefix):])
+	return token, token != ""
+}
+
+// redactMetadata returns a copy of md without credentials, for logging.
+func redactMetadata(md metadata.MD) metadata.MD {
+	if md == nil {
+		return nil
+	}
+	redacted := md.Copy()
+	for _, key := range []string{"authorization", "cookie", APIKeyHeader} {
+		if len(redacted.Get(key)) > 0 {
+			redacted.Set(key, "[REDACTED]")
+		}
+	}
+	return redacted
+}
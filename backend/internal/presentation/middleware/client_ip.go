@@ -0,0 +1,163 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"fuck_boss/backend/internal/infrastructure/logger"
+)
+
+// TrustedProxies are the networks of the reverse proxies whose X-Forwarded-For
+// and X-Real-IP headers are believed. The headers of any other peer are ignored
+// and its own address is used, so callers cannot put an arbitrary address into
+// the audit log. An empty list trusts no proxy.
+type TrustedProxies []*net.IPNet
+
+// ParseTrustedProxies parses proxy addresses, each a CIDR ("10.0.0.0/8") or a
+// single IP ("127.0.0.1", "::1").
+func ParseTrustedProxies(entries []string) (TrustedProxies, error) {
+	proxies := make(TrustedProxies, 0, len(entries))
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if !strings.Contains(entry, "/") {
+			ip := net.ParseIP(entry)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q", entry)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			proxies = append(proxies, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, network, err := net.ParseCIDR(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", entry, err)
+		}
+		proxies = append(proxies, network)
+	}
+	return proxies, nil
+}
+
+// GRPCClientIP returns the client address of a gRPC call: the peer address, or
+// the address forwarded by a trusted proxy if the peer is one.
+func (t TrustedProxies) GRPCClientIP(ctx context.Context, md metadata.MD) string {
+	var remote string
+	if p, ok := peer.FromContext(ctx); ok {
+		remote = p.Addr.String()
+	}
+	return t.clientIP(remote, md.Get("x-forwarded-for"), md.Get("x-real-ip"))
+}
+
+// HTTPClientIP returns the client address of an HTTP request: the remote
+// address, or the address forwarded by a trusted proxy if the remote is one.
+func (t TrustedProxies) HTTPClientIP(r *http.Request) string {
+	return t.clientIP(r.RemoteAddr, r.Header.Values("X-Forwarded-For"), r.Header.Values("X-Real-IP"))
+}
+
+// ClientIPInterceptor resolves the client address of each call once and stores
+// it in the context, where handlers read it with logger.ClientIPFromContext.
+func ClientIPInterceptor(proxies TrustedProxies) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		return handler(logger.WithClientIP(ctx, proxies.GRPCClientIP(ctx, md)), req)
+	}
+}
+
+// StreamClientIPInterceptor is ClientIPInterceptor for server streams.
+func StreamClientIPInterceptor(proxies TrustedProxies) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx := ss.Context()
+		md, _ := metadata.FromIncomingContext(ctx)
+		return handler(srv, &clientIPServerStream{ServerStream: ss, ctx: logger.WithClientIP(ctx, proxies.GRPCClientIP(ctx, md))})
+	}
+}
+
+// ClientIPHandler resolves the client address of each HTTP request once and
+// stores it in the request context, where handlers read it with
+// logger.ClientIPFromContext.
+func ClientIPHandler(proxies TrustedProxies, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r.WithContext(logger.WithClientIP(r.Context(), proxies.HTTPClientIP(r))))
+	})
+}
+
+// clientIPServerStream carries the resolved client address in the stream context.
+type clientIPServerStream struct {
+	grpc.ServerStream
+
+	// ctx is the stream context with the client address.
+	ctx context.Context
+}
+
+// Context returns the stream context with the client address.
+func (s *clientIPServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// clientIP resolves the client address from the peer address (with or without
+// a port) and the forwarding headers. X-Forwarded-For is read from the right,
+// skipping trusted proxies, so addresses a client prepends are never used.
+func (t TrustedProxies) clientIP(remote string, forwardedFor, realIP []string) string {
+	if host, _, err := net.SplitHostPort(remote); err == nil {
+		remote = host
+	}
+	if !t.trusts(remote) {
+		return remote
+	}
+
+	hops := strings.Split(strings.Join(forwardedFor, ","), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if hop == "" {
+			continue
+		}
+		if net.ParseIP(hop) == nil {
+			// A malformed hop was not written by a trusted proxy
+			return remote
+		}
+		if !t.trusts(hop) {
+			return hop
+		}
+	}
+
+	if len(realIP) > 0 && net.ParseIP(strings.TrimSpace(realIP[0])) != nil {
+		return strings.TrimSpace(realIP[0])
+	}
+	return remote
+}
+
+// trusts reports whether an address belongs to a trusted proxy.
+func (t TrustedProxies) trusts(addr string) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, network := range t {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
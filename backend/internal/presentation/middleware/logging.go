@@ -31,13 +31,13 @@ func LoggingInterceptor(log logger.Logger) grpc.UnaryServerInterceptor {
 
 		// Extract metadata for logging
 		md, _ := metadata.FromIncomingContext(ctx)
-		clientIP := extractClientIPFromMetadata(md)
+		clientIP := clientIPForLog(ctx)
 
 		// Log request start
 		ctxLogger.Info("gRPC request started",
 			zap.String("method", info.FullMethod),
 			zap.String("client_ip", clientIP),
-			zap.Any("metadata", md),
+			zap.Any("metadata", redactMetadata(md)),
 		)
 
 		// Handle request and measure duration
@@ -75,6 +75,98 @@ func LoggingInterceptor(log logger.Logger) grpc.UnaryServerInterceptor {
 	}
 }
 
+// StreamLoggingInterceptor returns a gRPC stream server interceptor that logs all streams.
+// It is the streaming equivalent of LoggingInterceptor: it logs when a stream
+// starts and ends, with its duration, status and the number of messages sent.
+func StreamLoggingInterceptor(log logger.Logger) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		// Generate request ID
+		requestID := generateRequestID()
+		ctx := logger.WithRequestID(ss.Context(), requestID)
+		stream := &loggingServerStream{ServerStream: ss, ctx: ctx}
+
+		// Get logger with context
+		ctxLogger := log.WithContext(ctx)
+
+		// Extract metadata for logging
+		md, _ := metadata.FromIncomingContext(ctx)
+		clientIP := clientIPForLog(ctx)
+
+		// Log stream start
+		ctxLogger.Info("gRPC stream started",
+			zap.String("method", info.FullMethod),
+			zap.String("client_ip", clientIP),
+			zap.Any("metadata", redactMetadata(md)),
+		)
+
+		// Handle stream and measure duration
+		start := time.Now()
+		err := handler(srv, stream)
+		duration := time.Since(start)
+
+		// Extract status code
+		statusCode := "OK"
+		if err != nil {
+			if st, ok := status.FromError(err); ok {
+				statusCode = st.Code().String()
+			} else {
+				statusCode = "Unknown"
+			}
+		}
+
+		// Log stream completion
+		if err != nil {
+			ctxLogger.Error("gRPC stream failed",
+				zap.String("method", info.FullMethod),
+				zap.String("status_code", statusCode),
+				zap.Error(err),
+				zap.Int("messages_sent", stream.sent),
+				zap.Duration("duration", duration),
+			)
+		} else {
+			ctxLogger.Info("gRPC stream completed",
+				zap.String("method", info.FullMethod),
+				zap.String("status_code", statusCode),
+				zap.Int("messages_sent", stream.sent),
+				zap.Duration("duration", duration),
+			)
+		}
+
+		return err
+	}
+}
+
+// loggingServerStream carries the request ID in the stream context and counts
+// the messages sent.
+type loggingServerStream struct {
+	grpc.ServerStream
+
+	// ctx is the stream context with the request ID.
+	ctx context.Context
+
+	// sent is the number of messages sent successfully.
+	sent int
+}
+
+// Context returns the stream context with the request ID.
+func (s *loggingServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// SendMsg sends a message and counts it.
+func (s *loggingServerStream) SendMsg(m interface{}) error {
+	err := s.ServerStream.SendMsg(m)
+	if err == nil {
+		s.sent++
+	}
+	return err
+}
+
 // generateRequestID generates a unique request ID.
 // Uses timestamp and a simple counter for uniqueness.
 // In production, you might want to use UUID or other unique identifier.
@@ -97,21 +189,10 @@ func formatInt64(n int64, width int) string {
 	return s
 }
 
-// extractClientIPFromMetadata extracts client IP from gRPC metadata.
-func extractClientIPFromMetadata(md metadata.MD) string {
-	if md == nil {
-		return "unknown"
-	}
-
-	// Try X-Forwarded-For first
-	if forwardedFor := md.Get("x-forwarded-for"); len(forwardedFor) > 0 {
-		return forwardedFor[0]
+// clientIPForLog returns the client address resolved by ClientIPInterceptor.
+func clientIPForLog(ctx context.Context) string {
+	if clientIP := logger.ClientIPFromContext(ctx); clientIP != "" {
+		return clientIP
 	}
-
-	// Try X-Real-IP
-	if realIP := md.Get("x-real-ip"); len(realIP) > 0 {
-		return realIP[0]
-	}
-
 	return "unknown"
 }
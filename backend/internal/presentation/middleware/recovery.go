@@ -44,3 +44,35 @@ func RecoveryInterceptor(log logger.Logger) grpc.UnaryServerInterceptor {
 		return handler(ctx, req)
 	}
 }
+
+// StreamRecoveryInterceptor returns a gRPC stream server interceptor that recovers from panics.
+// It is the streaming equivalent of RecoveryInterceptor.
+func StreamRecoveryInterceptor(log logger.Logger) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) (err error) {
+		// Use a named return to allow setting err from defer
+		defer func() {
+			if r := recover(); r != nil {
+				// Get logger with context
+				ctxLogger := log.WithContext(ss.Context())
+
+				// Log panic with stack trace
+				ctxLogger.Error("gRPC stream panic recovered",
+					zap.String("method", info.FullMethod),
+					zap.Any("panic", r),
+					zap.String("stack", string(debug.Stack())),
+				)
+
+				// Convert panic to gRPC error
+				err = status.Error(codes.Internal, "internal server error")
+			}
+		}()
+
+		// Call handler
+		return handler(srv, ss)
+	}
+}
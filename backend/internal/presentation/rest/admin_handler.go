@@ -0,0 +1,132 @@
+// Package rest provides REST API handlers that convert JSON requests to gRPC calls.
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"fuck_boss/backend/internal/application/content"
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/infrastructure/dataset"
+)
+
+// exportDateLayout is the layout of the from and to query parameters.
+const exportDateLayout = "2006-01-02"
+
+// exportContentTypes maps dataset formats to response content types.
+var exportContentTypes = map[dataset.Format]string{
+	dataset.FormatCSV:     "text/csv; charset=utf-8",
+	dataset.FormatJSONL:   "application/x-ndjson",
+	dataset.FormatParquet: "application/vnd.apache.parquet",
+}
+
+// ExportPostsUseCaseInterface defines the interface for streaming posts for dataset exports.
+type ExportPostsUseCaseInterface interface {
+	Execute(ctx context.Context, query content.ExportPostsQuery, write func(*dto.ExportedPostDTO) error) (int, error)
+}
+
+// AdminHandler handles privileged REST API requests. Its routes are guarded by
+// middleware.APIKeyMiddleware, which checks the API key scope and records every
+// call in the audit log.
+type AdminHandler struct {
+	exportUseCase ExportPostsUseCaseInterface
+	responder
+}
+
+// NewAdminHandler creates a new AdminHandler.
+func NewAdminHandler(exportUseCase ExportPostsUseCaseInterface, logger Logger) *AdminHandler {
+	return &AdminHandler{
+		exportUseCase: exportUseCase,
+		responder:     responder{logger: logger},
+	}
+}
+
+// ExportPosts handles GET /api/admin/export
+// Query parameters are the filters of the export subcommand:
+// format (csv, jsonl or parquet; default csv), city, company, status,
+// from and to (YYYY-MM-DD, both inclusive).
+func (h *AdminHandler) ExportPosts(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	params := r.URL.Query()
+	format := dataset.FormatCSV
+	if value := params.Get("format"); value != "" {
+		parsed, err := dataset.ParseFormat(value)
+		if err != nil {
+			h.writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		format = parsed
+	}
+
+	query := content.ExportPostsQuery{
+		CityCode:         params.Get("city"),
+		Company:          params.Get("company"),
+		ResolutionStatus: params.Get("status"),
+	}
+	if value := params.Get("from"); value != "" {
+		from, err := time.Parse(exportDateLayout, value)
+		if err != nil {
+			h.writeError(w, http.StatusBadRequest, "Invalid from date")
+			return
+		}
+		query.From = &from
+	}
+	if value := params.Get("to"); value != "" {
+		to, err := time.Parse(exportDateLayout, value)
+		if err != nil {
+			h.writeError(w, http.StatusBadRequest, "Invalid to date")
+			return
+		}
+		// The date is inclusive: export up to the start of the next day
+		to = to.AddDate(0, 0, 1)
+		query.To = &to
+	}
+
+	// The response is started on the first post, so errors found before it
+	// (invalid filters, database errors) still get a JSON error response.
+	var writer dataset.PostWriter
+	start := func() error {
+		w.Header().Set("Content-Type", exportContentTypes[format])
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="posts.%s"`, format))
+		w.Header().Set("Cache-Control", "no-store")
+		var err error
+		writer, err = dataset.NewPostWriter(format, w)
+		return err
+	}
+
+	count, err := h.exportUseCase.Execute(r.Context(), query, func(post *dto.ExportedPostDTO) error {
+		if writer == nil {
+			if err := start(); err != nil {
+				return err
+			}
+		}
+		return writer.Write(post)
+	})
+	if err != nil {
+		if writer == nil {
+			h.handleError(w, err)
+			return
+		}
+		// Too late for an error status: the client gets a truncated file
+		h.logger.Error("Export aborted", zap.Int("exported", count), zap.Error(err))
+		return
+	}
+
+	if writer == nil {
+		if err := start(); err != nil {
+			h.handleError(w, err)
+			return
+		}
+	}
+	if err := writer.Close(); err != nil {
+		h.logger.Error("Failed to finish export", zap.Int("exported", count), zap.Error(err))
+	}
+}
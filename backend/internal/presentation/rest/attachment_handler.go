@@ -0,0 +1,276 @@
+// Package rest provides REST API handlers that convert JSON requests to gRPC calls.
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"fuck_boss/backend/internal/application/attachment"
+	"fuck_boss/backend/internal/application/dto"
+)
+
+// AttachmentURLPrefix is the path attachments are downloaded from ("/api/attachments/<id>").
+const AttachmentURLPrefix = "/api/attachments/"
+
+// thumbnailSuffix is appended to an attachment URL to download the thumbnail of an image.
+const thumbnailSuffix = "/thumbnail"
+
+// maxUploadBodySize is the largest accepted upload request: one file plus multipart overhead.
+const maxUploadBodySize = attachment.MaxFileSize + 64<<10
+
+// AttachmentHandler handles REST API requests for evidence attachments.
+type AttachmentHandler struct {
+	uploadUseCase   UploadAttachmentUseCaseInterface
+	listUseCase     ListAttachmentsUseCaseInterface
+	downloadUseCase DownloadAttachmentUseCaseInterface
+	responder
+}
+
+// UploadAttachmentUseCaseInterface defines the interface for uploading attachments.
+type UploadAttachmentUseCaseInterface interface {
+	Execute(ctx context.Context, cmd attachment.UploadAttachmentCommand) (*dto.AttachmentDTO, error)
+}
+
+// ListAttachmentsUseCaseInterface defines the interface for listing the attachments of a post.
+type ListAttachmentsUseCaseInterface interface {
+	Execute(ctx context.Context, query attachment.ListAttachmentsQuery) ([]*dto.AttachmentDTO, error)
+}
+
+// DownloadAttachmentUseCaseInterface defines the interface for downloading attachments.
+type DownloadAttachmentUseCaseInterface interface {
+	Execute(ctx context.Context, query attachment.DownloadAttachmentQuery) (*attachment.Download, error)
+}
+
+// NewAttachmentHandler creates a new AttachmentHandler.
+func NewAttachmentHandler(
+	uploadUseCase UploadAttachmentUseCaseInterface,
+	listUseCase ListAttachmentsUseCaseInterface,
+	downloadUseCase DownloadAttachmentUseCaseInterface,
+	logger Logger,
+) *AttachmentHandler {
+	return &AttachmentHandler{
+		uploadUseCase:   uploadUseCase,
+		listUseCase:     listUseCase,
+		downloadUseCase: downloadUseCase,
+		responder:       responder{logger: logger},
+	}
+}
+
+// AttachmentResponse is the JSON response for an attachment.
+type AttachmentResponse struct {
+	ID           string `json:"id"`
+	PostID       string `json:"postId"`
+	ContentType  string `json:"contentType"`
+	Size         int64  `json:"size"`
+	Checksum     string `json:"checksum"`
+	FileName     string `json:"fileName"`
+	URL          string `json:"url"`
+	Width        int    `json:"width,omitempty"`
+	Height       int    `json:"height,omitempty"`
+	ThumbnailURL string `json:"thumbnailUrl,omitempty"`
+	CreatedAt    int64  `json:"createdAt"`
+}
+
+// RegionRequest is the JSON request for a sensitive region of an image.
+type RegionRequest struct {
+	X      int `json:"x"`
+	Y      int `json:"y"`
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+// ListAttachmentsResponse is the JSON response for listing the attachments of a post.
+type ListAttachmentsResponse struct {
+	Attachments []*AttachmentResponse `json:"attachments"`
+}
+
+// Attachments handles GET and POST /api/posts/:id/attachments
+func (h *AttachmentHandler) Attachments(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.listAttachments(w, r)
+	case http.MethodPost:
+		h.uploadAttachment(w, r)
+	default:
+		h.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+// uploadAttachment handles POST /api/posts/:id/attachments (multipart/form-data).
+// Form fields: managementToken, file, and optionally regions
+// (a JSON array of RegionRequest to blur in an image).
+func (h *AttachmentHandler) uploadAttachment(w http.ResponseWriter, r *http.Request) {
+	postID := attachmentsPostID(r.URL.Path)
+	if postID == "" {
+		h.writeError(w, http.StatusBadRequest, "Post ID is required")
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxUploadBodySize)
+	if err := r.ParseMultipartForm(1 << 20); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			h.writeError(w, http.StatusRequestEntityTooLarge, "File is too large")
+			return
+		}
+		h.writeError(w, http.StatusBadRequest, "Invalid multipart form: "+err.Error())
+		return
+	}
+	defer r.MultipartForm.RemoveAll()
+
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "File is required")
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "Failed to read file")
+		return
+	}
+
+	var regions []attachment.Region
+	if raw := r.FormValue("regions"); raw != "" {
+		var req []RegionRequest
+		if err := json.Unmarshal([]byte(raw), &req); err != nil {
+			h.writeError(w, http.StatusBadRequest, "Invalid regions: "+err.Error())
+			return
+		}
+		for _, region := range req {
+			regions = append(regions, attachment.Region{
+				X:      region.X,
+				Y:      region.Y,
+				Width:  region.Width,
+				Height: region.Height,
+			})
+		}
+	}
+
+	dto, err := h.uploadUseCase.Execute(r.Context(), attachment.UploadAttachmentCommand{
+		PostID:          postID,
+		ManagementToken: r.FormValue("managementToken"),
+		Data:            data,
+		Regions:         regions,
+	})
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	h.writeJSON(w, http.StatusCreated, convertAttachmentToResponse(dto))
+}
+
+// listAttachments handles GET /api/posts/:id/attachments
+func (h *AttachmentHandler) listAttachments(w http.ResponseWriter, r *http.Request) {
+	postID := attachmentsPostID(r.URL.Path)
+	if postID == "" {
+		h.writeError(w, http.StatusBadRequest, "Post ID is required")
+		return
+	}
+
+	dtos, err := h.listUseCase.Execute(r.Context(), attachment.ListAttachmentsQuery{PostID: postID})
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	attachments := make([]*AttachmentResponse, 0, len(dtos))
+	for _, dto := range dtos {
+		attachments = append(attachments, convertAttachmentToResponse(dto))
+	}
+
+	h.writeJSON(w, http.StatusOK, ListAttachmentsResponse{Attachments: attachments})
+}
+
+// DownloadAttachment handles GET /api/attachments/:id and GET /api/attachments/:id/thumbnail
+// Images are served inline, other files as downloads. The content of an attachment
+// never changes, so responses are cacheable and the checksum is used as ETag.
+func (h *AttachmentHandler) DownloadAttachment(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		h.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	// Extract attachment ID from URL path
+	id := strings.TrimPrefix(r.URL.Path, AttachmentURLPrefix)
+	thumbnail := strings.HasSuffix(id, thumbnailSuffix)
+	id = strings.TrimSuffix(id, thumbnailSuffix)
+	if id == "" {
+		h.writeError(w, http.StatusBadRequest, "Attachment ID is required")
+		return
+	}
+
+	download, err := h.downloadUseCase.Execute(r.Context(), attachment.DownloadAttachmentQuery{
+		ID:        id,
+		Thumbnail: thumbnail,
+	})
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+	defer download.Body.Close()
+
+	etag := `"` + download.ETag + `"`
+	disposition := "attachment"
+	if strings.HasPrefix(download.ContentType, "image/") {
+		disposition = "inline"
+	}
+
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Content-Security-Policy", "default-src 'none'; sandbox")
+
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", download.ContentType)
+	if download.Size > 0 {
+		w.Header().Set("Content-Length", strconv.FormatInt(download.Size, 10))
+	}
+	w.Header().Set("Content-Disposition", disposition+`; filename="`+download.FileName+`"`)
+	w.WriteHeader(http.StatusOK)
+
+	if r.Method == http.MethodHead {
+		return
+	}
+	if _, err := io.Copy(w, download.Body); err != nil {
+		h.logger.Warn("Failed to write attachment", zap.String("attachment_id", id), zap.Error(err))
+	}
+}
+
+// attachmentsPostID extracts the post ID from "/api/posts/<id>/attachments".
+func attachmentsPostID(path string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(path, "/api/posts/"), "/attachments")
+}
+
+// convertAttachmentToResponse converts an AttachmentDTO to an AttachmentResponse.
+func convertAttachmentToResponse(dto *dto.AttachmentDTO) *AttachmentResponse {
+	resp := &AttachmentResponse{
+		ID:          dto.ID,
+		PostID:      dto.PostID,
+		ContentType: dto.ContentType,
+		Size:        dto.Size,
+		Checksum:    dto.Checksum,
+		FileName:    dto.FileName,
+		URL:         AttachmentURLPrefix + dto.ID,
+		Width:       dto.Width,
+		Height:      dto.Height,
+		CreatedAt:   dto.CreatedAt.Unix(),
+	}
+	if dto.HasThumbnail {
+		resp.ThumbnailURL = AttachmentURLPrefix + dto.ID + thumbnailSuffix
+	}
+	return resp
+}
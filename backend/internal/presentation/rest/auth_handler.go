@@ -0,0 +1,286 @@
+// Package rest provides REST API handlers that convert JSON requests to gRPC calls.
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/application/identity"
+	"fuck_boss/backend/internal/application/verification"
+	"fuck_boss/backend/internal/presentation/middleware"
+)
+
+// AuthHandler handles REST API requests for registration, login, token refresh
+// and verification codes.
+type AuthHandler struct {
+	registerUseCase     RegisterUseCaseInterface
+	loginUseCase        LoginUseCaseInterface
+	refreshUseCase      RefreshUseCaseInterface
+	logoutUseCase       LogoutUseCaseInterface
+	verificationService VerificationCodeServiceInterface
+	responder
+}
+
+// RegisterUseCaseInterface defines the interface for registering user accounts.
+type RegisterUseCaseInterface interface {
+	Execute(ctx context.Context, cmd identity.RegisterCommand) (*dto.AuthSessionDTO, error)
+}
+
+// LoginUseCaseInterface defines the interface for logging in.
+type LoginUseCaseInterface interface {
+	Execute(ctx context.Context, cmd identity.LoginCommand) (*dto.AuthSessionDTO, error)
+}
+
+// RefreshUseCaseInterface defines the interface for rotating refresh tokens.
+type RefreshUseCaseInterface interface {
+	Execute(ctx context.Context, cmd identity.RefreshCommand) (*dto.AuthSessionDTO, error)
+}
+
+// LogoutUseCaseInterface defines the interface for logging out.
+type LogoutUseCaseInterface interface {
+	Execute(ctx context.Context, cmd identity.LogoutCommand) error
+}
+
+// VerificationCodeServiceInterface defines the interface for sending verification codes.
+type VerificationCodeServiceInterface interface {
+	Send(ctx context.Context, cmd verification.SendCommand) (*dto.VerificationCodeDTO, error)
+}
+
+// NewAuthHandler creates a new AuthHandler.
+func NewAuthHandler(
+	registerUseCase RegisterUseCaseInterface,
+	loginUseCase LoginUseCaseInterface,
+	refreshUseCase RefreshUseCaseInterface,
+	logoutUseCase LogoutUseCaseInterface,
+	verificationService VerificationCodeServiceInterface,
+	logger Logger,
+) *AuthHandler {
+	return &AuthHandler{
+		registerUseCase:     registerUseCase,
+		loginUseCase:        loginUseCase,
+		refreshUseCase:      refreshUseCase,
+		logoutUseCase:       logoutUseCase,
+		verificationService: verificationService,
+		responder:           responder{logger: logger},
+	}
+}
+
+// RegisterRequest is the JSON request for registering a user account.
+type RegisterRequest struct {
+	Email            string `json:"email"`
+	Username         string `json:"username,omitempty"`
+	Password         string `json:"password"`
+	VerificationCode string `json:"verificationCode,omitempty"`
+}
+
+// LoginRequest is the JSON request for logging in.
+type LoginRequest struct {
+	Account  string `json:"account"`
+	Password string `json:"password"`
+}
+
+// RefreshRequest is the JSON request for refreshing or revoking a session.
+// The refresh token may also be sent as "Authorization: Bearer <refresh token>".
+type RefreshRequest struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+// SendVerificationCodeRequest is the JSON request for sending a verification code.
+type SendVerificationCodeRequest struct {
+	Channel   string `json:"channel"`
+	Recipient string `json:"recipient"`
+	Purpose   string `json:"purpose"`
+}
+
+// VerificationCodeResponse is the JSON response for a sent verification code.
+type VerificationCodeResponse struct {
+	Channel            string `json:"channel"`
+	ExpiresAt          int64  `json:"expiresAt"`
+	ResendAfterSeconds int    `json:"resendAfterSeconds"`
+}
+
+// AuthSessionResponse is the JSON response for a successful registration, login or refresh.
+type AuthSessionResponse struct {
+	UserID                string `json:"userId"`
+	Email                 string `json:"email"`
+	Username              string `json:"username,omitempty"`
+	TokenType             string `json:"tokenType"`
+	AccessToken           string `json:"accessToken"`
+	AccessTokenExpiresAt  int64  `json:"accessTokenExpiresAt"`
+	RefreshToken          string `json:"refreshToken"`
+	RefreshTokenExpiresAt int64  `json:"refreshTokenExpiresAt"`
+}
+
+// Register handles POST /api/auth/register
+func (h *AuthHandler) Register(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	var req RegisterRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid request body: "+err.Error())
+		return
+	}
+
+	// Convert to use case command
+	cmd := identity.RegisterCommand{
+		Email:            req.Email,
+		Username:         req.Username,
+		Password:         req.Password,
+		VerificationCode: req.VerificationCode,
+		ClientIP:         extractClientIP(r),
+	}
+
+	// Execute use case
+	session, err := h.registerUseCase.Execute(r.Context(), cmd)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	h.writeSession(w, http.StatusCreated, session)
+}
+
+// Login handles POST /api/auth/login
+func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	var req LoginRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid request body: "+err.Error())
+		return
+	}
+
+	// Convert to use case command
+	cmd := identity.LoginCommand{
+		Account:  req.Account,
+		Password: req.Password,
+		ClientIP: extractClientIP(r),
+	}
+
+	// Execute use case
+	session, err := h.loginUseCase.Execute(r.Context(), cmd)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	h.writeSession(w, http.StatusOK, session)
+}
+
+// Refresh handles POST /api/auth/refresh
+func (h *AuthHandler) Refresh(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	refreshToken, ok := h.readRefreshToken(w, r)
+	if !ok {
+		return
+	}
+
+	// Execute use case
+	session, err := h.refreshUseCase.Execute(r.Context(), identity.RefreshCommand{
+		RefreshToken: refreshToken,
+	})
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	h.writeSession(w, http.StatusOK, session)
+}
+
+// Logout handles POST /api/auth/logout
+func (h *AuthHandler) Logout(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	refreshToken, ok := h.readRefreshToken(w, r)
+	if !ok {
+		return
+	}
+
+	// Execute use case
+	if err := h.logoutUseCase.Execute(r.Context(), identity.LogoutCommand{
+		RefreshToken: refreshToken,
+	}); err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// SendVerificationCode handles POST /api/auth/verification-codes
+func (h *AuthHandler) SendVerificationCode(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	var req SendVerificationCodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid request body: "+err.Error())
+		return
+	}
+
+	// Execute service
+	result, err := h.verificationService.Send(r.Context(), verification.SendCommand{
+		Channel:   req.Channel,
+		Recipient: req.Recipient,
+		Purpose:   req.Purpose,
+		ClientIP:  extractClientIP(r),
+	})
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	h.writeJSON(w, http.StatusAccepted, VerificationCodeResponse{
+		Channel:            result.Channel,
+		ExpiresAt:          result.ExpiresAt.Unix(),
+		ResendAfterSeconds: result.ResendAfterSeconds,
+	})
+}
+
+// readRefreshToken reads the refresh token from the Authorization header or the JSON body.
+// It writes an error response and returns false if the request is malformed.
+func (h *AuthHandler) readRefreshToken(w http.ResponseWriter, r *http.Request) (string, bool) {
+	if token, ok := middleware.BearerToken(r.Header.Get("Authorization")); ok {
+		return token, true
+	}
+
+	var req RefreshRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid request body: "+err.Error())
+		return "", false
+	}
+
+	return req.RefreshToken, true
+}
+
+// writeSession writes the session as JSON and disables caching of the tokens.
+func (h *AuthHandler) writeSession(w http.ResponseWriter, statusCode int, session *dto.AuthSessionDTO) {
+	w.Header().Set("Cache-Control", "no-store")
+	h.writeJSON(w, statusCode, AuthSessionResponse{
+		UserID:                session.UserID,
+		Email:                 session.Email,
+		Username:              session.Username,
+		TokenType:             "Bearer",
+		AccessToken:           session.AccessToken,
+		AccessTokenExpiresAt:  session.AccessTokenExpiresAt.Unix(),
+		RefreshToken:          session.RefreshToken,
+		RefreshTokenExpiresAt: session.RefreshTokenExpiresAt.Unix(),
+	})
+}
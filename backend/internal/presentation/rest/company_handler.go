@@ -0,0 +1,223 @@
+// Package rest provides REST API handlers that convert JSON requests to gRPC calls.
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"fuck_boss/backend/internal/application/company"
+	"fuck_boss/backend/internal/application/dto"
+)
+
+// CompanyHandler handles REST API requests for company representatives and official replies.
+type CompanyHandler struct {
+	registerUseCase RegisterRepresentativeUseCaseInterface
+	verifyUseCase   VerifyRepresentativeUseCaseInterface
+	replyUseCase    PostOfficialReplyUseCaseInterface
+	responder
+}
+
+// RegisterRepresentativeUseCaseInterface defines the interface for registering company representatives.
+type RegisterRepresentativeUseCaseInterface interface {
+	Execute(ctx context.Context, cmd company.RegisterRepresentativeCommand) (*dto.RepresentativeDTO, error)
+}
+
+// VerifyRepresentativeUseCaseInterface defines the interface for verifying company representatives.
+type VerifyRepresentativeUseCaseInterface interface {
+	Execute(ctx context.Context, cmd company.VerifyRepresentativeCommand) (*dto.RepresentativeDTO, error)
+}
+
+// PostOfficialReplyUseCaseInterface defines the interface for posting official company replies.
+type PostOfficialReplyUseCaseInterface interface {
+	Execute(ctx context.Context, cmd company.PostOfficialReplyCommand) (*dto.OfficialReplyDTO, error)
+}
+
+// NewCompanyHandler creates a new CompanyHandler.
+func NewCompanyHandler(
+	registerUseCase RegisterRepresentativeUseCaseInterface,
+	verifyUseCase VerifyRepresentativeUseCaseInterface,
+	replyUseCase PostOfficialReplyUseCaseInterface,
+	logger Logger,
+) *CompanyHandler {
+	return &CompanyHandler{
+		registerUseCase: registerUseCase,
+		verifyUseCase:   verifyUseCase,
+		replyUseCase:    replyUseCase,
+		responder:       responder{logger: logger},
+	}
+}
+
+// RegisterRepresentativeRequest is the JSON request for registering a company representative.
+type RegisterRepresentativeRequest struct {
+	Company         string `json:"company"`
+	Domain          string `json:"domain"`
+	ChallengeMethod string `json:"challengeMethod"`
+}
+
+// RepresentativeResponse is the JSON response for a company representative.
+type RepresentativeResponse struct {
+	ID         string             `json:"id"`
+	Company    string             `json:"company"`
+	Domain     string             `json:"domain"`
+	Status     string             `json:"status"`
+	Challenge  *ChallengeResponse `json:"challenge"`
+	CreatedAt  int64              `json:"createdAt"`
+	VerifiedAt *int64             `json:"verifiedAt,omitempty"`
+}
+
+// ChallengeResponse is the JSON response for a domain ownership challenge.
+type ChallengeResponse struct {
+	Method      string `json:"method"`
+	RecordName  string `json:"recordName"`
+	RecordValue string `json:"recordValue"`
+	FileURL     string `json:"fileUrl"`
+	FileContent string `json:"fileContent"`
+}
+
+// RegisterRepresentativeResponse is the JSON response for registering a company representative.
+type RegisterRepresentativeResponse struct {
+	Representative *RepresentativeResponse `json:"representative"`
+	AccessToken    string                  `json:"accessToken"`
+}
+
+// VerifyRepresentativeRequest is the JSON request for verifying a company representative.
+type VerifyRepresentativeRequest struct {
+	AccessToken string `json:"accessToken"`
+}
+
+// PostOfficialReplyRequest is the JSON request for posting an official reply.
+type PostOfficialReplyRequest struct {
+	RepresentativeID string `json:"representativeId"`
+	AccessToken      string `json:"accessToken"`
+	Content          string `json:"content"`
+}
+
+// RegisterRepresentative handles POST /api/representatives
+func (h *CompanyHandler) RegisterRepresentative(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	var req RegisterRepresentativeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid request body: "+err.Error())
+		return
+	}
+
+	// Convert to use case command
+	cmd := company.RegisterRepresentativeCommand{
+		Company:         req.Company,
+		Domain:          req.Domain,
+		ChallengeMethod: req.ChallengeMethod,
+		ClientIP:        extractClientIP(r),
+	}
+
+	// Execute use case
+	dto, err := h.registerUseCase.Execute(r.Context(), cmd)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	// Convert to response
+	resp := RegisterRepresentativeResponse{
+		Representative: convertRepresentativeToResponse(dto),
+		AccessToken:    dto.AccessToken,
+	}
+
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// VerifyRepresentative handles POST /api/representatives/:id/verify
+func (h *CompanyHandler) VerifyRepresentative(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	// Extract representative ID from URL path
+	representativeID := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/api/representatives/"), "/verify")
+	if representativeID == "" {
+		h.writeError(w, http.StatusBadRequest, "Representative ID is required")
+		return
+	}
+
+	var req VerifyRepresentativeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid request body: "+err.Error())
+		return
+	}
+
+	// Execute use case
+	dto, err := h.verifyUseCase.Execute(r.Context(), company.VerifyRepresentativeCommand{
+		RepresentativeID: representativeID,
+		AccessToken:      req.AccessToken,
+	})
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, convertRepresentativeToResponse(dto))
+}
+
+// PostOfficialReply handles POST /api/posts/:id/official-reply
+func (h *CompanyHandler) PostOfficialReply(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	// Extract post ID from URL path
+	postID := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/api/posts/"), "/official-reply")
+	if postID == "" {
+		h.writeError(w, http.StatusBadRequest, "Post ID is required")
+		return
+	}
+
+	var req PostOfficialReplyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid request body: "+err.Error())
+		return
+	}
+
+	// Execute use case
+	dto, err := h.replyUseCase.Execute(r.Context(), company.PostOfficialReplyCommand{
+		PostID:           postID,
+		RepresentativeID: req.RepresentativeID,
+		AccessToken:      req.AccessToken,
+		Content:          req.Content,
+	})
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, convertOfficialReplyToResponse(dto))
+}
+
+// convertRepresentativeToResponse converts a representative DTO to a JSON response.
+func convertRepresentativeToResponse(dto *dto.RepresentativeDTO) *RepresentativeResponse {
+	resp := &RepresentativeResponse{
+		ID:      dto.ID,
+		Company: dto.Company,
+		Domain:  dto.Domain,
+		Status:  dto.Status,
+		Challenge: &ChallengeResponse{
+			Method:      dto.ChallengeMethod,
+			RecordName:  dto.ChallengeRecordName,
+			RecordValue: dto.ChallengeRecordValue,
+			FileURL:     dto.ChallengeFileURL,
+			FileContent: dto.ChallengeCode,
+		},
+		CreatedAt: dto.CreatedAt.Unix(),
+	}
+	if dto.VerifiedAt != nil {
+		ts := dto.VerifiedAt.Unix()
+		resp.VerifiedAt = &ts
+	}
+	return resp
+}
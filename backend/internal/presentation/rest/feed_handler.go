@@ -0,0 +1,224 @@
+// Package rest provides REST API handlers that convert JSON requests to gRPC calls.
+package rest
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"fuck_boss/backend/internal/application/content"
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/pkg/feed"
+)
+
+// feedLanguage is the language of all feeds.
+const feedLanguage = "zh-CN"
+
+// feedMaxAge is how long clients and proxies may reuse a feed without revalidating (in seconds).
+const feedMaxAge = 300
+
+// feedExtensions maps feed path extensions to formats.
+var feedExtensions = map[string]feed.Format{
+	".xml":  feed.FormatAtom,
+	".atom": feed.FormatAtom,
+	".rss":  feed.FormatRSS,
+	".json": feed.FormatJSON,
+}
+
+// ListFeedPostsUseCaseInterface defines the interface for listing the posts of a feed.
+type ListFeedPostsUseCaseInterface interface {
+	Execute(ctx context.Context, query content.ListFeedPostsQuery) (*dto.PostsListDTO, error)
+}
+
+// FeedHandler serves Atom, RSS 2.0 and JSON Feed documents of the newest posts.
+type FeedHandler struct {
+	feedUseCase ListFeedPostsUseCaseInterface
+	baseURL     string
+	siteName    string
+	responder
+}
+
+// NewFeedHandler creates a new FeedHandler.
+// baseURL is the absolute public URL of the site without a trailing slash.
+func NewFeedHandler(
+	feedUseCase ListFeedPostsUseCaseInterface,
+	baseURL string,
+	siteName string,
+	logger Logger,
+) *FeedHandler {
+	return &FeedHandler{
+		feedUseCase: feedUseCase,
+		baseURL:     baseURL,
+		siteName:    siteName,
+		responder:   responder{logger: logger},
+	}
+}
+
+// CityFeed handles GET /feeds/city/{code}.xml
+func (h *FeedHandler) CityFeed(w http.ResponseWriter, r *http.Request) {
+	code, format, ok := h.parseFeedPath(w, r, "/feeds/city/")
+	if !ok {
+		return
+	}
+
+	posts, err := h.feedUseCase.Execute(r.Context(), content.ListFeedPostsQuery{CityCode: code})
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	cityName := code
+	if len(posts.Posts) > 0 {
+		cityName = posts.Posts[0].CityName
+	}
+	h.writeFeed(w, r, format, &feed.Feed{
+		Title:       h.siteName + " · " + cityName,
+		Description: cityName + "的最新曝光",
+		Link:        h.baseURL + "/city/" + url.PathEscape(code),
+		FeedURL:     h.feedURL(r, format),
+	}, posts.Posts)
+}
+
+// CompanyFeed handles GET /feeds/company/{name}.xml
+func (h *FeedHandler) CompanyFeed(w http.ResponseWriter, r *http.Request) {
+	company, format, ok := h.parseFeedPath(w, r, "/feeds/company/")
+	if !ok {
+		return
+	}
+
+	posts, err := h.feedUseCase.Execute(r.Context(), content.ListFeedPostsQuery{Company: company})
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	h.writeFeed(w, r, format, &feed.Feed{
+		Title:       h.siteName + " · " + company,
+		Description: "关于" + company + "的最新曝光",
+		Link:        h.baseURL + "/company/" + url.PathEscape(company),
+		FeedURL:     h.feedURL(r, format),
+	}, posts.Posts)
+}
+
+// SearchFeed handles GET /feeds/search?q=
+func (h *FeedHandler) SearchFeed(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		h.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	format, err := feed.ParseFormat(r.URL.Query().Get("format"))
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	keyword := strings.TrimSpace(r.URL.Query().Get("q"))
+	posts, err := h.feedUseCase.Execute(r.Context(), content.ListFeedPostsQuery{Keyword: keyword})
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	h.writeFeed(w, r, format, &feed.Feed{
+		Title:       h.siteName + " · 搜索：" + keyword,
+		Description: "包含“" + keyword + "”的最新曝光",
+		Link:        h.baseURL + "/",
+		FeedURL:     h.feedURL(r, format),
+	}, posts.Posts)
+}
+
+// parseFeedPath extracts the city code or company name and the format from
+// "{prefix}{name}.{ext}". The format query parameter overrides the extension;
+// without either the feed is Atom.
+func (h *FeedHandler) parseFeedPath(w http.ResponseWriter, r *http.Request, prefix string) (string, feed.Format, bool) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		h.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return "", "", false
+	}
+
+	name := strings.TrimPrefix(r.URL.Path, prefix)
+	format := feed.FormatAtom
+	if ext := path.Ext(name); feedExtensions[ext] != "" {
+		format = feedExtensions[ext]
+		name = strings.TrimSuffix(name, ext)
+	}
+	if name == "" || strings.Contains(name, "/") {
+		h.writeError(w, http.StatusNotFound, "Feed not found")
+		return "", "", false
+	}
+
+	if q := r.URL.Query().Get("format"); q != "" {
+		parsed, err := feed.ParseFormat(q)
+		if err != nil {
+			h.writeError(w, http.StatusBadRequest, err.Error())
+			return "", "", false
+		}
+		format = parsed
+	}
+
+	return name, format, true
+}
+
+// feedURL returns the absolute URL of the requested feed document, keeping
+// only the query parameters that select it.
+func (h *FeedHandler) feedURL(r *http.Request, format feed.Format) string {
+	query := url.Values{}
+	if q := r.URL.Query().Get("q"); q != "" {
+		query.Set("q", q)
+	}
+	if r.URL.Query().Get("format") != "" {
+		query.Set("format", string(format))
+	}
+
+	u := h.baseURL + r.URL.EscapedPath()
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	return u
+}
+
+// writeFeed renders the posts into f and writes it with ETag and Last-Modified,
+// answering conditional requests with 304 Not Modified.
+func (h *FeedHandler) writeFeed(w http.ResponseWriter, r *http.Request, format feed.Format, f *feed.Feed, posts []*dto.PostDTO) {
+	f.Author = h.siteName
+	f.Language = feedLanguage
+
+	var updated time.Time
+	for _, post := range posts {
+		if post.CreatedAt.After(updated) {
+			updated = post.CreatedAt
+		}
+		f.Items = append(f.Items, feed.Item{
+			ID:         "urn:uuid:" + post.ID,
+			Title:      post.Company + " · " + post.CityName,
+			Link:       h.baseURL + "/p/" + url.PathEscape(post.ID),
+			Summary:    post.Summary,
+			Categories: []string{post.CityName},
+			Published:  post.CreatedAt,
+		})
+	}
+	f.Updated = updated
+
+	body, err := feed.Render(f, format)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	sum := sha256.Sum256(body)
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", feedMaxAge))
+	w.Header().Set("ETag", `"`+hex.EncodeToString(sum[:16])+`"`)
+
+	// ServeContent handles If-None-Match, If-Modified-Since and HEAD; an
+	// empty feed has no Last-Modified
+	http.ServeContent(w, r, "", updated, bytes.NewReader(body))
+}
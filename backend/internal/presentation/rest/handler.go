@@ -4,18 +4,18 @@ package rest
 import (
 	"context"
 	"encoding/json"
+	"net"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"go.uber.org/zap"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 
 	"fuck_boss/backend/internal/application/content"
 	"fuck_boss/backend/internal/application/dto"
 	"fuck_boss/backend/internal/application/search"
-	apperrors "fuck_boss/backend/pkg/errors"
+	"fuck_boss/backend/internal/infrastructure/logger"
 )
 
 // ContentHandler handles REST API requests for content operations.
@@ -24,7 +24,12 @@ type ContentHandler struct {
 	listUseCase   ListPostsUseCaseInterface
 	getUseCase    GetPostUseCaseInterface
 	searchUseCase SearchPostsUseCaseInterface
-	logger        Logger
+	followUpCase  AppendFollowUpUseCaseInterface
+	listMyCase    ListMyPostsUseCaseInterface
+	viewCase      RecordViewUseCaseInterface
+	trendingCase  ListTrendingPostsUseCaseInterface
+	relatedCase   ListRelatedPostsUseCaseInterface
+	responder
 }
 
 // CreatePostUseCaseInterface defines the interface for creating posts.
@@ -47,6 +52,31 @@ type SearchPostsUseCaseInterface interface {
 	Execute(ctx context.Context, query search.SearchPostsQuery) (*dto.PostsListDTO, error)
 }
 
+// AppendFollowUpUseCaseInterface defines the interface for appending follow-ups.
+type AppendFollowUpUseCaseInterface interface {
+	Execute(ctx context.Context, cmd content.AppendFollowUpCommand) (*dto.FollowUpDTO, error)
+}
+
+// ListMyPostsUseCaseInterface defines the interface for listing the current user's posts.
+type ListMyPostsUseCaseInterface interface {
+	Execute(ctx context.Context, query content.ListMyPostsQuery) (*dto.PostsListDTO, error)
+}
+
+// RecordViewUseCaseInterface defines the interface for counting post views.
+type RecordViewUseCaseInterface interface {
+	Execute(ctx context.Context, cmd content.RecordViewCommand) error
+}
+
+// ListTrendingPostsUseCaseInterface defines the interface for listing trending posts.
+type ListTrendingPostsUseCaseInterface interface {
+	Execute(ctx context.Context, query content.ListTrendingPostsQuery) (*dto.PostsListDTO, error)
+}
+
+// ListRelatedPostsUseCaseInterface defines the interface for listing related posts.
+type ListRelatedPostsUseCaseInterface interface {
+	Execute(ctx context.Context, query content.ListRelatedPostsQuery) (*dto.PostsListDTO, error)
+}
+
 // Logger interface for logging.
 type Logger interface {
 	Info(msg string, fields ...zap.Field)
@@ -60,6 +90,11 @@ func NewContentHandler(
 	listUseCase ListPostsUseCaseInterface,
 	getUseCase GetPostUseCaseInterface,
 	searchUseCase SearchPostsUseCaseInterface,
+	followUpCase AppendFollowUpUseCaseInterface,
+	listMyCase ListMyPostsUseCaseInterface,
+	viewCase RecordViewUseCaseInterface,
+	trendingCase ListTrendingPostsUseCaseInterface,
+	relatedCase ListRelatedPostsUseCaseInterface,
 	logger Logger,
 ) *ContentHandler {
 	return &ContentHandler{
@@ -67,7 +102,12 @@ func NewContentHandler(
 		listUseCase:   listUseCase,
 		getUseCase:    getUseCase,
 		searchUseCase: searchUseCase,
-		logger:        logger,
+		followUpCase:  followUpCase,
+		listMyCase:    listMyCase,
+		viewCase:      viewCase,
+		trendingCase:  trendingCase,
+		relatedCase:   relatedCase,
+		responder:     responder{logger: logger},
 	}
 }
 
@@ -82,26 +122,60 @@ type CreatePostRequest struct {
 
 // CreatePostResponse is the JSON response for creating a post.
 type CreatePostResponse struct {
-	PostID    string `json:"postId"`
-	CreatedAt int64  `json:"createdAt"`
+	PostID          string `json:"postId"`
+	CreatedAt       int64  `json:"createdAt"`
+	ManagementToken string `json:"managementToken"`
 }
 
 // ListPostsRequest is the JSON request for listing posts.
 type ListPostsRequest struct {
-	CityCode string `json:"cityCode"`
-	Page     int    `json:"page"`
-	PageSize int    `json:"pageSize"`
+	CityCode         string `json:"cityCode"`
+	Page             int    `json:"page"`
+	PageSize         int    `json:"pageSize"`
+	ResolutionStatus string `json:"resolutionStatus,omitempty"`
+	Company          string `json:"company,omitempty"`
+	View             string `json:"view,omitempty"`
 }
 
 // PostResponse is the JSON response for a post.
 type PostResponse struct {
-	ID         string `json:"id"`
-	Company    string `json:"company"`
-	CityCode   string `json:"cityCode"`
-	CityName   string `json:"cityName"`
-	Content    string `json:"content"`
-	OccurredAt *int64 `json:"occurredAt,omitempty"`
-	CreatedAt  int64  `json:"createdAt"`
+	ID               string                 `json:"id"`
+	Company          string                 `json:"company"`
+	CityCode         string                 `json:"cityCode"`
+	CityName         string                 `json:"cityName"`
+	Content          string                 `json:"content,omitempty"`
+	ContentHTML      string                 `json:"contentHtml,omitempty"`
+	Summary          string                 `json:"summary,omitempty"`
+	OccurredAt       *int64                 `json:"occurredAt,omitempty"`
+	CreatedAt        int64                  `json:"createdAt"`
+	ResolutionStatus string                 `json:"resolutionStatus,omitempty"`
+	Timeline         []*FollowUpResponse    `json:"timeline,omitempty"`
+	OfficialReply    *OfficialReplyResponse `json:"officialReply,omitempty"`
+}
+
+// FollowUpResponse is the JSON response for a follow-up entry.
+type FollowUpResponse struct {
+	ID        string `json:"id"`
+	Status    string `json:"status"`
+	Note      string `json:"note"`
+	CreatedAt int64  `json:"createdAt"`
+}
+
+// OfficialReplyResponse is the JSON response for an official company reply.
+type OfficialReplyResponse struct {
+	ID             string `json:"id"`
+	Company        string `json:"company"`
+	VerifiedDomain string `json:"verifiedDomain"`
+	Content        string `json:"content"`
+	Badge          string `json:"badge"`
+	CreatedAt      int64  `json:"createdAt"`
+}
+
+// AppendFollowUpRequest is the JSON request for appending a follow-up.
+type AppendFollowUpRequest struct {
+	ManagementToken string `json:"managementToken"`
+	Status          string `json:"status"`
+	Note            string `json:"note"`
 }
 
 // ListPostsResponse is the JSON response for listing posts.
@@ -118,6 +192,7 @@ type SearchPostsRequest struct {
 	CityCode *string `json:"cityCode,omitempty"`
 	Page     int     `json:"page"`
 	PageSize int     `json:"pageSize"`
+	View     string  `json:"view,omitempty"`
 }
 
 // CreatePost handles POST /api/posts
@@ -149,6 +224,7 @@ func (h *ContentHandler) CreatePost(w http.ResponseWriter, r *http.Request) {
 		Content:    req.Content,
 		OccurredAt: occurredAt,
 		ClientIP:   clientIP,
+		AuthorID:   logger.UserIDFromContext(r.Context()),
 	}
 
 	// Execute use case
@@ -161,8 +237,9 @@ func (h *ContentHandler) CreatePost(w http.ResponseWriter, r *http.Request) {
 
 	// Convert to response
 	resp := CreatePostResponse{
-		PostID:    dto.ID,
-		CreatedAt: dto.CreatedAt.Unix(),
+		PostID:          dto.ID,
+		CreatedAt:       dto.CreatedAt.Unix(),
+		ManagementToken: dto.ManagementToken,
 	}
 
 	h.writeJSON(w, http.StatusOK, resp)
@@ -186,11 +263,18 @@ func (h *ContentHandler) ListPosts(w http.ResponseWriter, r *http.Request) {
 		pageSize = 20
 	}
 
+	resolutionStatus := r.URL.Query().Get("resolutionStatus")
+	company := strings.TrimSpace(r.URL.Query().Get("company"))
+	view := strings.ToUpper(r.URL.Query().Get("view"))
+
 	// Convert to use case query
 	query := content.ListPostsQuery{
-		CityCode: cityCode,
-		Page:     page,
-		PageSize: pageSize,
+		CityCode:         cityCode,
+		Page:             page,
+		PageSize:         pageSize,
+		ResolutionStatus: resolutionStatus,
+		Company:          company,
+		View:             view,
 	}
 
 	// Execute use case
@@ -212,6 +296,116 @@ func (h *ContentHandler) ListPosts(w http.ResponseWriter, r *http.Request) {
 	h.writeJSON(w, http.StatusOK, resp)
 }
 
+// ListMyPosts handles GET /api/me/posts
+// The user is identified by the bearer access token (see middleware.AuthMiddleware).
+func (h *ContentHandler) ListMyPosts(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	// Parse query parameters
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	pageSize, _ := strconv.Atoi(r.URL.Query().Get("pageSize"))
+
+	// Convert to use case query
+	query := content.ListMyPostsQuery{
+		UserID:   logger.UserIDFromContext(r.Context()),
+		Page:     page,
+		PageSize: pageSize,
+	}
+
+	// Execute use case
+	dto, err := h.listMyCase.Execute(r.Context(), query)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	// Convert to response
+	resp := ListPostsResponse{
+		Posts:    convertPostsToResponse(dto.Posts),
+		Total:    dto.Total,
+		Page:     dto.Page,
+		PageSize: dto.PageSize,
+	}
+
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// ListTrendingPosts handles GET /api/posts/trending
+func (h *ContentHandler) ListTrendingPosts(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	// Parse query parameters
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	pageSize, _ := strconv.Atoi(r.URL.Query().Get("pageSize"))
+
+	// Convert to use case query
+	query := content.ListTrendingPostsQuery{
+		CityCode: r.URL.Query().Get("cityCode"),
+		Page:     page,
+		PageSize: pageSize,
+		View:     strings.ToUpper(r.URL.Query().Get("view")),
+	}
+
+	// Execute use case
+	dto, err := h.trendingCase.Execute(r.Context(), query)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	// Convert to response
+	resp := ListPostsResponse{
+		Posts:    convertPostsToResponse(dto.Posts),
+		Total:    dto.Total,
+		Page:     dto.Page,
+		PageSize: dto.PageSize,
+	}
+
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// ListRelatedPosts handles GET /api/posts/{id}/related
+func (h *ContentHandler) ListRelatedPosts(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	// Extract post ID from path: /api/posts/{id}/related
+	postID := strings.TrimSuffix(r.URL.Path[len("/api/posts/"):], "/related")
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+	// Convert to use case query
+	query := content.ListRelatedPostsQuery{
+		PostID: postID,
+		Limit:  limit,
+		View:   strings.ToUpper(r.URL.Query().Get("view")),
+	}
+
+	// Execute use case
+	dto, err := h.relatedCase.Execute(r.Context(), query)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	// Convert to response
+	resp := ListPostsResponse{
+		Posts:    convertPostsToResponse(dto.Posts),
+		Total:    dto.Total,
+		Page:     dto.Page,
+		PageSize: dto.PageSize,
+	}
+
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
 // GetPost handles GET /api/posts/:id
 func (h *ContentHandler) GetPost(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodGet {
@@ -234,11 +428,63 @@ func (h *ContentHandler) GetPost(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Count the view (errors must not fail the read)
+	if h.viewCase != nil {
+		if err := h.viewCase.Execute(ctx, content.RecordViewCommand{
+			PostID:   dto.ID,
+			UserID:   logger.UserIDFromContext(ctx),
+			ClientIP: extractClientIP(r),
+		}); err != nil {
+			h.logger.Warn("Failed to record post view", zap.String("post_id", dto.ID), zap.Error(err))
+		}
+	}
+
 	// Convert to response
 	resp := convertPostToResponse(dto)
 	h.writeJSON(w, http.StatusOK, resp)
 }
 
+// AppendFollowUp handles POST /api/posts/:id/follow-ups
+func (h *ContentHandler) AppendFollowUp(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	// Extract post ID from URL path
+	postID := strings.TrimSuffix(r.URL.Path[len("/api/posts/"):], "/follow-ups")
+	if postID == "" {
+		h.writeError(w, http.StatusBadRequest, "Post ID is required")
+		return
+	}
+
+	var req AppendFollowUpRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid request body: "+err.Error())
+		return
+	}
+
+	// Convert to use case command
+	cmd := content.AppendFollowUpCommand{
+		PostID:          postID,
+		ManagementToken: req.ManagementToken,
+		Status:          req.Status,
+		Note:            req.Note,
+	}
+
+	// Execute use case
+	ctx := r.Context()
+	dto, err := h.followUpCase.Execute(ctx, cmd)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	// Convert to response
+	resp := convertFollowUpToResponse(dto)
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
 // SearchPosts handles GET /api/posts/search
 func (h *ContentHandler) SearchPosts(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodGet && r.Method != http.MethodPost {
@@ -270,6 +516,7 @@ func (h *ContentHandler) SearchPosts(w http.ResponseWriter, r *http.Request) {
 			pageSize = 20
 		}
 		req.PageSize = pageSize
+		req.View = r.URL.Query().Get("view")
 	}
 
 	// Convert to use case query
@@ -278,6 +525,7 @@ func (h *ContentHandler) SearchPosts(w http.ResponseWriter, r *http.Request) {
 		CityCode: nil,
 		Page:     req.Page,
 		PageSize: req.PageSize,
+		View:     strings.ToUpper(req.View),
 	}
 	if req.CityCode != nil && *req.CityCode != "" {
 		cityCode := *req.CityCode
@@ -306,99 +554,69 @@ func (h *ContentHandler) SearchPosts(w http.ResponseWriter, r *http.Request) {
 // convertPostToResponse converts a DTO to a JSON response.
 func convertPostToResponse(dto *dto.PostDTO) *PostResponse {
 	resp := &PostResponse{
-		ID:        dto.ID,
-		Company:   dto.Company,
-		CityCode:  dto.CityCode,
-		CityName:  dto.CityName,
-		Content:   dto.Content,
-		CreatedAt: dto.CreatedAt.Unix(),
+		ID:               dto.ID,
+		Company:          dto.Company,
+		CityCode:         dto.CityCode,
+		CityName:         dto.CityName,
+		Content:          dto.Content,
+		ContentHTML:      dto.ContentHTML,
+		Summary:          dto.Summary,
+		CreatedAt:        dto.CreatedAt.Unix(),
+		ResolutionStatus: dto.ResolutionStatus,
 	}
 	if dto.OccurredAt != nil {
 		ts := dto.OccurredAt.Unix()
 		resp.OccurredAt = &ts
 	}
+	for _, followUp := range dto.Timeline {
+		resp.Timeline = append(resp.Timeline, convertFollowUpToResponse(followUp))
+	}
+	if dto.OfficialReply != nil {
+		resp.OfficialReply = convertOfficialReplyToResponse(dto.OfficialReply)
+	}
 	return resp
 }
 
-// convertPostsToResponse converts a slice of DTOs to JSON responses.
-func convertPostsToResponse(dtos []*dto.PostDTO) []*PostResponse {
-	posts := make([]*PostResponse, len(dtos))
-	for i, dto := range dtos {
-		posts[i] = convertPostToResponse(dto)
+// convertOfficialReplyToResponse converts an official reply DTO to a JSON response.
+func convertOfficialReplyToResponse(dto *dto.OfficialReplyDTO) *OfficialReplyResponse {
+	return &OfficialReplyResponse{
+		ID:             dto.ID,
+		Company:        dto.Company,
+		VerifiedDomain: dto.VerifiedDomain,
+		Content:        dto.Content,
+		Badge:          dto.Badge,
+		CreatedAt:      dto.CreatedAt.Unix(),
 	}
-	return posts
 }
 
-// handleError converts application errors to HTTP responses.
-func (h *ContentHandler) handleError(w http.ResponseWriter, err error) {
-	if err == nil {
-		return
-	}
-
-	// Check for application errors
-	var appErr *apperrors.AppError
-	if apperrors.As(err, &appErr) {
-		switch appErr.Code {
-		case apperrors.ErrCodeValidation:
-			h.writeError(w, http.StatusBadRequest, appErr.Message)
-		case apperrors.ErrCodeNotFound:
-			h.writeError(w, http.StatusNotFound, appErr.Message)
-		case apperrors.ErrCodeRateLimit:
-			h.writeError(w, http.StatusTooManyRequests, appErr.Message)
-		default:
-			h.logger.Error("Internal error", zap.Error(err))
-			h.writeError(w, http.StatusInternalServerError, "Internal server error")
-		}
-		return
-	}
-
-	// Check for gRPC status errors
-	if st, ok := status.FromError(err); ok {
-		switch st.Code() {
-		case codes.InvalidArgument:
-			h.writeError(w, http.StatusBadRequest, st.Message())
-		case codes.NotFound:
-			h.writeError(w, http.StatusNotFound, st.Message())
-		case codes.ResourceExhausted:
-			h.writeError(w, http.StatusTooManyRequests, st.Message())
-		default:
-			h.logger.Error("gRPC error", zap.Error(err))
-			h.writeError(w, http.StatusInternalServerError, "Internal server error")
-		}
-		return
+// convertFollowUpToResponse converts a follow-up DTO to a JSON response.
+func convertFollowUpToResponse(dto *dto.FollowUpDTO) *FollowUpResponse {
+	return &FollowUpResponse{
+		ID:        dto.ID,
+		Status:    dto.Status,
+		Note:      dto.Note,
+		CreatedAt: dto.CreatedAt.Unix(),
 	}
-
-	// Unknown error
-	h.logger.Error("Unknown error", zap.Error(err))
-	h.writeError(w, http.StatusInternalServerError, "Internal server error")
 }
 
-// writeJSON writes a JSON response.
-func (h *ContentHandler) writeJSON(w http.ResponseWriter, statusCode int, data interface{}) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(statusCode)
-	if err := json.NewEncoder(w).Encode(data); err != nil {
-		h.logger.Error("Failed to encode JSON response", zap.Error(err))
+// convertPostsToResponse converts a slice of DTOs to JSON responses.
+func convertPostsToResponse(dtos []*dto.PostDTO) []*PostResponse {
+	posts := make([]*PostResponse, len(dtos))
+	for i, dto := range dtos {
+		posts[i] = convertPostToResponse(dto)
 	}
+	return posts
 }
 
-// writeError writes an error response.
-func (h *ContentHandler) writeError(w http.ResponseWriter, statusCode int, message string) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(statusCode)
-	json.NewEncoder(w).Encode(map[string]string{"error": message})
-}
-
-// extractClientIP extracts the client IP address from the request.
+// extractClientIP returns the client address resolved by
+// middleware.ClientIPHandler, falling back to the remote address without its
+// port. Forwarding headers are never read here: only trusted proxies may set them.
 func extractClientIP(r *http.Request) string {
-	// Check X-Forwarded-For header
-	if forwardedFor := r.Header.Get("X-Forwarded-For"); forwardedFor != "" {
-		return forwardedFor
+	if clientIP := logger.ClientIPFromContext(r.Context()); clientIP != "" {
+		return clientIP
 	}
-	// Check X-Real-IP header
-	if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
-		return realIP
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		return host
 	}
-	// Fallback to RemoteAddr
 	return r.RemoteAddr
 }
@@ -0,0 +1,195 @@
+// Package rest provides REST API handlers that convert JSON requests to gRPC calls.
+package rest
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/application/notification"
+	"fuck_boss/backend/internal/infrastructure/logger"
+)
+
+// NotificationHandler handles REST API requests for the notification center.
+type NotificationHandler struct {
+	listUseCase        ListNotificationsUseCaseInterface
+	countUnreadUseCase CountUnreadUseCaseInterface
+	markReadUseCase    MarkReadUseCaseInterface
+	markAllReadUseCase MarkAllReadUseCaseInterface
+	responder
+}
+
+// ListNotificationsUseCaseInterface defines the interface for listing notifications.
+type ListNotificationsUseCaseInterface interface {
+	Execute(ctx context.Context, query notification.ListNotificationsQuery) (*dto.NotificationsListDTO, error)
+}
+
+// CountUnreadUseCaseInterface defines the interface for counting unread notifications.
+type CountUnreadUseCaseInterface interface {
+	Execute(ctx context.Context, query notification.CountUnreadQuery) (int, error)
+}
+
+// MarkReadUseCaseInterface defines the interface for marking a notification as read.
+type MarkReadUseCaseInterface interface {
+	Execute(ctx context.Context, cmd notification.MarkReadCommand) error
+}
+
+// MarkAllReadUseCaseInterface defines the interface for marking all notifications as read.
+type MarkAllReadUseCaseInterface interface {
+	Execute(ctx context.Context, cmd notification.MarkAllReadCommand) (int, error)
+}
+
+// NewNotificationHandler creates a new NotificationHandler.
+func NewNotificationHandler(
+	listUseCase ListNotificationsUseCaseInterface,
+	countUnreadUseCase CountUnreadUseCaseInterface,
+	markReadUseCase MarkReadUseCaseInterface,
+	markAllReadUseCase MarkAllReadUseCaseInterface,
+	logger Logger,
+) *NotificationHandler {
+	return &NotificationHandler{
+		listUseCase:        listUseCase,
+		countUnreadUseCase: countUnreadUseCase,
+		markReadUseCase:    markReadUseCase,
+		markAllReadUseCase: markAllReadUseCase,
+		responder:          responder{logger: logger},
+	}
+}
+
+// NotificationResponse is the JSON response for a notification.
+type NotificationResponse struct {
+	ID        string `json:"id"`
+	Type      string `json:"type"`
+	PostID    string `json:"postId"`
+	Subject   string `json:"subject"`
+	Excerpt   string `json:"excerpt"`
+	CreatedAt int64  `json:"createdAt"`
+	ReadAt    *int64 `json:"readAt,omitempty"`
+}
+
+// ListNotificationsResponse is the JSON response for listing notifications.
+type ListNotificationsResponse struct {
+	Notifications []*NotificationResponse `json:"notifications"`
+	NextCursor    string                  `json:"nextCursor,omitempty"`
+	UnreadCount   int                     `json:"unreadCount"`
+}
+
+// UnreadCountResponse is the JSON response for the unread notification count.
+type UnreadCountResponse struct {
+	UnreadCount int `json:"unreadCount"`
+}
+
+// MarkAllReadResponse is the JSON response for marking all notifications as read.
+type MarkAllReadResponse struct {
+	Updated int `json:"updated"`
+}
+
+// ListNotifications handles GET /api/notifications
+func (h *NotificationHandler) ListNotifications(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	// Parse query parameters
+	pageSize, _ := strconv.Atoi(r.URL.Query().Get("pageSize"))
+	unreadOnly, _ := strconv.ParseBool(r.URL.Query().Get("unreadOnly"))
+
+	dto, err := h.listUseCase.Execute(r.Context(), notification.ListNotificationsQuery{
+		UserID:     logger.UserIDFromContext(r.Context()),
+		Cursor:     r.URL.Query().Get("cursor"),
+		PageSize:   pageSize,
+		UnreadOnly: unreadOnly,
+		Type:       r.URL.Query().Get("type"),
+	})
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	notifications := make([]*NotificationResponse, 0, len(dto.Notifications))
+	for _, n := range dto.Notifications {
+		resp := &NotificationResponse{
+			ID:        n.ID,
+			Type:      n.Type,
+			PostID:    n.PostID,
+			Subject:   n.Subject,
+			Excerpt:   n.Excerpt,
+			CreatedAt: n.CreatedAt.Unix(),
+		}
+		if n.ReadAt != nil {
+			ts := n.ReadAt.Unix()
+			resp.ReadAt = &ts
+		}
+		notifications = append(notifications, resp)
+	}
+
+	h.writeJSON(w, http.StatusOK, ListNotificationsResponse{
+		Notifications: notifications,
+		NextCursor:    dto.NextCursor,
+		UnreadCount:   dto.UnreadCount,
+	})
+}
+
+// UnreadCount handles GET /api/notifications/unread-count
+func (h *NotificationHandler) UnreadCount(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	count, err := h.countUnreadUseCase.Execute(r.Context(), notification.CountUnreadQuery{
+		UserID: logger.UserIDFromContext(r.Context()),
+	})
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, UnreadCountResponse{UnreadCount: count})
+}
+
+// MarkRead handles PUT /api/notifications/:id/read
+func (h *NotificationHandler) MarkRead(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut && r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	// Extract notification ID from URL path
+	notificationID := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/api/notifications/"), "/read")
+	if notificationID == "" {
+		h.writeError(w, http.StatusBadRequest, "Notification ID is required")
+		return
+	}
+
+	if err := h.markReadUseCase.Execute(r.Context(), notification.MarkReadCommand{
+		UserID:         logger.UserIDFromContext(r.Context()),
+		NotificationID: notificationID,
+	}); err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// MarkAllRead handles PUT /api/notifications/read-all
+func (h *NotificationHandler) MarkAllRead(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut && r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	updated, err := h.markAllReadUseCase.Execute(r.Context(), notification.MarkAllReadCommand{
+		UserID: logger.UserIDFromContext(r.Context()),
+	})
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, MarkAllReadResponse{Updated: updated})
+}
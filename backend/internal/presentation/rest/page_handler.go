@@ -0,0 +1,448 @@
+// Package rest provides REST API handlers that convert JSON requests to gRPC calls.
+package rest
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"html"
+	"html/template"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"go.uber.org/zap"
+
+	"fuck_boss/backend/internal/application/content"
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/infrastructure/logger"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// pagePageSize is the number of posts on a city or company page.
+const pagePageSize = 20
+
+// pageMaxAge is how long clients and proxies may reuse a page (in seconds).
+const pageMaxAge = 60
+
+// pageDescriptionLength is the maximum length of page descriptions (in characters).
+const pageDescriptionLength = 150
+
+//go:embed templates/*.html
+var templateFS embed.FS
+
+// pageTemplates holds one template set per page, each combined with the layout.
+var pageTemplates = map[string]*template.Template{}
+
+func init() {
+	funcs := template.FuncMap{
+		"date":       func(t time.Time) string { return t.Format("2006-01-02") },
+		"pathEscape": url.PathEscape,
+		"unsafeHTML": func(s string) template.HTML {
+			// Post content is sanitized when it is rendered in the domain layer
+			return template.HTML(s)
+		},
+	}
+	for _, name := range []string{"post.html", "list.html", "error.html"} {
+		pageTemplates[name] = template.Must(template.New("layout.html").Funcs(funcs).
+			ParseFS(templateFS, "templates/layout.html", "templates/"+name))
+	}
+}
+
+var (
+	// htmlTagPattern matches HTML tags, for deriving plain-text descriptions.
+	htmlTagPattern = regexp.MustCompile(`<[^>]*>`)
+
+	// spaAssetPattern matches the tags of a built index.html that load the SPA.
+	spaAssetPattern = regexp.MustCompile(`(?is)<script\b[^>]*\bsrc=[^>]*>\s*</script>|<link\b[^>]*\brel=["']?(?:stylesheet|modulepreload|icon)["']?[^>]*>`)
+)
+
+// pageMeta is the head metadata of a page: title, canonical URL, Open Graph
+// and Twitter card tags and the JSON-LD structured data.
+type pageMeta struct {
+	Title         string
+	Description   string
+	CanonicalURL  string
+	PrevURL       string
+	NextURL       string
+	OGType        string
+	PublishedTime string
+	Section       string
+	Tags          []string
+	Image         string
+	ImageAlt      string
+	ImageWidth    int
+	ImageHeight   int
+	FeedURL       string
+	NoIndex       bool
+	JSONLD        interface{}
+}
+
+// pageInitialData is embedded in pages as JSON for the SPA, in the shape of
+// the REST API responses, so it does not fetch the same data again.
+type pageInitialData struct {
+	Path  string             `json:"path"`
+	Post  *PostResponse      `json:"post,omitempty"`
+	Posts *ListPostsResponse `json:"posts,omitempty"`
+}
+
+// pageData is the data of a page template.
+type pageData struct {
+	SiteName    string
+	BaseURL     string
+	Meta        pageMeta
+	SPAAssets   template.HTML
+	InitialData *pageInitialData
+
+	// Post is the post of a post page.
+	Post *dto.PostDTO
+
+	// Heading, Posts, Page and Total describe a city or company page.
+	Heading string
+	Posts   []*dto.PostDTO
+	Page    int
+	Total   int
+
+	// Message is the message of an error page.
+	Message string
+}
+
+// PageHandler serves server-rendered HTML pages of posts, cities and companies
+// for crawlers and link previews. In browsers the SPA takes over the page.
+type PageHandler struct {
+	getUseCase  GetPostUseCaseInterface
+	listUseCase ListPostsUseCaseInterface
+	viewCase    RecordViewUseCaseInterface
+	baseURL     string
+	siteName    string
+	spaAssets   template.HTML
+	responder
+}
+
+// NewPageHandler creates a new PageHandler.
+// baseURL is the absolute public URL of the site without a trailing slash.
+// spaAssets are the SPA script and stylesheet tags added to every page (see
+// LoadSPAAssets); viewCase may be nil.
+func NewPageHandler(
+	getUseCase GetPostUseCaseInterface,
+	listUseCase ListPostsUseCaseInterface,
+	viewCase RecordViewUseCaseInterface,
+	baseURL string,
+	siteName string,
+	spaAssets template.HTML,
+	logger Logger,
+) *PageHandler {
+	return &PageHandler{
+		getUseCase:  getUseCase,
+		listUseCase: listUseCase,
+		viewCase:    viewCase,
+		baseURL:     baseURL,
+		siteName:    siteName,
+		spaAssets:   spaAssets,
+		responder:   responder{logger: logger},
+	}
+}
+
+// LoadSPAAssets reads the built index.html of the SPA and returns its script,
+// stylesheet, module preload and icon tags.
+func LoadSPAAssets(indexPath string) (template.HTML, error) {
+	data, err := os.ReadFile(indexPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read SPA index: %w", err)
+	}
+
+	tags := spaAssetPattern.FindAll(data, -1)
+	if len(tags) == 0 {
+		return "", fmt.Errorf("no scripts or stylesheets found in %s", indexPath)
+	}
+	// The tags come from our own build output
+	return template.HTML(bytes.Join(tags, []byte("\n"))), nil
+}
+
+// PostPage handles GET /p/{id}
+func (h *PageHandler) PostPage(w http.ResponseWriter, r *http.Request) {
+	postID, ok := h.parsePagePath(w, r, "/p/")
+	if !ok {
+		return
+	}
+
+	ctx := r.Context()
+	post, err := h.getUseCase.Execute(ctx, postID)
+	if err != nil {
+		h.handlePageError(w, r, err)
+		return
+	}
+
+	// Count the view (errors must not fail the read)
+	if h.viewCase != nil {
+		if err := h.viewCase.Execute(ctx, content.RecordViewCommand{
+			PostID:   post.ID,
+			UserID:   logger.UserIDFromContext(ctx),
+			ClientIP: extractClientIP(r),
+		}); err != nil {
+			h.logger.Warn("Failed to record post view", zap.String("post_id", post.ID), zap.Error(err))
+		}
+	}
+
+	pagePath := "/p/" + url.PathEscape(post.ID)
+	canonical := h.baseURL + pagePath
+	headline := post.Company + " · " + post.CityName
+	description := plainTextDescription(post.ContentHTML)
+	published := post.CreatedAt.UTC().Format(time.RFC3339)
+	image := h.baseURL + ShareCardPath(post)
+
+	h.renderPage(w, r, http.StatusOK, "post.html", &pageData{
+		Meta: pageMeta{
+			Title:         headline,
+			Description:   description,
+			CanonicalURL:  canonical,
+			OGType:        "article",
+			PublishedTime: published,
+			Section:       post.CityName,
+			Tags:          []string{post.Company},
+			Image:         image,
+			ImageAlt:      headline,
+			ImageWidth:    content.ShareCardWidth,
+			ImageHeight:   content.ShareCardHeight,
+			JSONLD: map[string]interface{}{
+				"@context":      "https://schema.org",
+				"@type":         "DiscussionForumPosting",
+				"@id":           canonical,
+				"url":           canonical,
+				"headline":      headline,
+				"text":          description,
+				"datePublished": published,
+				"image":         image,
+				"author":        map[string]interface{}{"@type": "Person", "name": "匿名用户"},
+				"about":         map[string]interface{}{"@type": "Organization", "name": post.Company},
+				"contentLocation": map[string]interface{}{
+					"@type": "Place",
+					"name":  post.CityName,
+				},
+				"isPartOf": map[string]interface{}{
+					"@type": "WebSite",
+					"name":  h.siteName,
+					"url":   h.baseURL + "/",
+				},
+			},
+		},
+		InitialData: &pageInitialData{Path: pagePath, Post: convertPostToResponse(post)},
+		Post:        post,
+	})
+}
+
+// CityPage handles GET /city/{code}?page=
+func (h *PageHandler) CityPage(w http.ResponseWriter, r *http.Request) {
+	code, ok := h.parsePagePath(w, r, "/city/")
+	if !ok {
+		return
+	}
+
+	page := pageNumber(r)
+	posts, err := h.listUseCase.Execute(r.Context(), content.ListPostsQuery{
+		CityCode: code,
+		Page:     page,
+		PageSize: pagePageSize,
+		View:     dto.PostViewBasic,
+	})
+	if err != nil {
+		h.handlePageError(w, r, err)
+		return
+	}
+
+	cityName := code
+	if len(posts.Posts) > 0 {
+		cityName = posts.Posts[0].CityName
+	}
+	h.renderListPage(w, r, "/city/"+url.PathEscape(code), "/feeds/city/"+url.PathEscape(code)+".xml",
+		cityName+"的公司曝光", cityName+"的最新职场曝光，共 "+strconv.Itoa(posts.Total)+" 条。", posts)
+}
+
+// CompanyPage handles GET /company/{name}?page=
+func (h *PageHandler) CompanyPage(w http.ResponseWriter, r *http.Request) {
+	company, ok := h.parsePagePath(w, r, "/company/")
+	if !ok {
+		return
+	}
+
+	page := pageNumber(r)
+	posts, err := h.listUseCase.Execute(r.Context(), content.ListPostsQuery{
+		Company:  company,
+		Page:     page,
+		PageSize: pagePageSize,
+		View:     dto.PostViewBasic,
+	})
+	if err != nil {
+		h.handlePageError(w, r, err)
+		return
+	}
+
+	h.renderListPage(w, r, "/company/"+url.PathEscape(company), "/feeds/company/"+url.PathEscape(company)+".xml",
+		company+"的曝光", "关于"+company+"的职场曝光，共 "+strconv.Itoa(posts.Total)+" 条。", posts)
+}
+
+// renderListPage renders a page of posts with pagination links and an ItemList.
+// Pages past the end are not found; an empty first page is not indexed.
+func (h *PageHandler) renderListPage(w http.ResponseWriter, r *http.Request, pagePath, feedPath, heading, description string, posts *dto.PostsListDTO) {
+	if posts.Page > 1 && len(posts.Posts) == 0 {
+		h.renderError(w, r, http.StatusNotFound, "页面不存在")
+		return
+	}
+
+	pageURL := func(page int) string {
+		if page <= 1 {
+			return h.baseURL + pagePath
+		}
+		return h.baseURL + pagePath + "?page=" + strconv.Itoa(page)
+	}
+
+	meta := pageMeta{
+		Title:        heading,
+		Description:  description,
+		CanonicalURL: pageURL(posts.Page),
+		OGType:       "website",
+		FeedURL:      h.baseURL + feedPath,
+		NoIndex:      posts.Total == 0,
+	}
+	if posts.Page > 1 {
+		meta.PrevURL = pageURL(posts.Page - 1)
+	}
+	if posts.Page*posts.PageSize < posts.Total {
+		meta.NextURL = pageURL(posts.Page + 1)
+	}
+
+	items := make([]interface{}, 0, len(posts.Posts))
+	for i, post := range posts.Posts {
+		items = append(items, map[string]interface{}{
+			"@type":    "ListItem",
+			"position": (posts.Page-1)*posts.PageSize + i + 1,
+			"url":      h.baseURL + "/p/" + url.PathEscape(post.ID),
+			"name":     post.Company + " · " + post.CityName,
+		})
+	}
+	meta.JSONLD = map[string]interface{}{
+		"@context":    "https://schema.org",
+		"@type":       "CollectionPage",
+		"@id":         meta.CanonicalURL,
+		"url":         meta.CanonicalURL,
+		"name":        heading,
+		"description": description,
+		"isPartOf": map[string]interface{}{
+			"@type": "WebSite",
+			"name":  h.siteName,
+			"url":   h.baseURL + "/",
+		},
+		"mainEntity": map[string]interface{}{
+			"@type":           "ItemList",
+			"numberOfItems":   posts.Total,
+			"itemListElement": items,
+		},
+	}
+
+	h.renderPage(w, r, http.StatusOK, "list.html", &pageData{
+		Meta: meta,
+		InitialData: &pageInitialData{Path: pagePath, Posts: &ListPostsResponse{
+			Posts:    convertPostsToResponse(posts.Posts),
+			Total:    posts.Total,
+			Page:     posts.Page,
+			PageSize: posts.PageSize,
+		}},
+		Heading: heading,
+		Posts:   posts.Posts,
+		Page:    posts.Page,
+		Total:   posts.Total,
+	})
+}
+
+// parsePagePath extracts the single path segment after prefix.
+func (h *PageHandler) parsePagePath(w http.ResponseWriter, r *http.Request, prefix string) (string, bool) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		h.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return "", false
+	}
+
+	name := strings.TrimPrefix(r.URL.Path, prefix)
+	if name == "" || strings.Contains(name, "/") {
+		h.renderError(w, r, http.StatusNotFound, "页面不存在")
+		return "", false
+	}
+	return name, true
+}
+
+// handlePageError renders the error page for an application error.
+// Invalid paths are reported as not found, like missing posts.
+func (h *PageHandler) handlePageError(w http.ResponseWriter, r *http.Request, err error) {
+	var appErr *apperrors.AppError
+	if apperrors.As(err, &appErr) {
+		switch appErr.Code {
+		case apperrors.ErrCodeValidation, apperrors.ErrCodeNotFound:
+			h.renderError(w, r, http.StatusNotFound, "页面不存在")
+			return
+		case apperrors.ErrCodeUnavailable:
+			h.renderError(w, r, http.StatusServiceUnavailable, "服务暂时不可用，请稍后重试")
+			return
+		}
+	}
+
+	h.logger.Error("Failed to render page", zap.String("path", r.URL.Path), zap.Error(err))
+	h.renderError(w, r, http.StatusInternalServerError, "服务器内部错误")
+}
+
+// renderError renders the error page, which is never indexed.
+func (h *PageHandler) renderError(w http.ResponseWriter, r *http.Request, statusCode int, message string) {
+	h.renderPage(w, r, statusCode, "error.html", &pageData{
+		Meta:    pageMeta{Title: message, NoIndex: true},
+		Message: message,
+	})
+}
+
+// renderPage executes the page template and writes it. Successful pages may be
+// cached briefly by clients and proxies.
+func (h *PageHandler) renderPage(w http.ResponseWriter, r *http.Request, statusCode int, name string, data *pageData) {
+	data.SiteName = h.siteName
+	data.BaseURL = h.baseURL
+	data.SPAAssets = h.spaAssets
+
+	var buf bytes.Buffer
+	if err := pageTemplates[name].Execute(&buf, data); err != nil {
+		h.logger.Error("Failed to execute page template", zap.String("template", name), zap.Error(err))
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if statusCode == http.StatusOK {
+		w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", pageMaxAge))
+	} else {
+		w.Header().Set("Cache-Control", "no-store")
+	}
+	w.WriteHeader(statusCode)
+	if r.Method != http.MethodHead {
+		_, _ = w.Write(buf.Bytes())
+	}
+}
+
+// pageNumber returns the page query parameter, 1 if it is missing or invalid.
+func pageNumber(r *http.Request) int {
+	page, err := strconv.Atoi(r.URL.Query().Get("page"))
+	if err != nil || page < 1 {
+		return 1
+	}
+	return page
+}
+
+// plainTextDescription derives a page description from sanitized content HTML:
+// tags are dropped, whitespace collapsed and the text shortened.
+func plainTextDescription(contentHTML string) string {
+	text := html.UnescapeString(htmlTagPattern.ReplaceAllString(contentHTML, " "))
+	text = strings.Join(strings.Fields(text), " ")
+	if utf8.RuneCountInString(text) <= pageDescriptionLength {
+		return text
+	}
+	return string([]rune(text)[:pageDescriptionLength]) + "…"
+}
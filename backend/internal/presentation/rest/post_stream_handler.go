@@ -0,0 +1,253 @@
+// Package rest provides REST API handlers that convert JSON requests to gRPC calls.
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"fuck_boss/backend/internal/application/content"
+	"fuck_boss/backend/internal/application/livefeed"
+)
+
+// streamOpenDelay is how long a new stream waits for the first event before
+// the response is started with a heartbeat. Errors found before the response
+// starts (invalid filters, unknown Last-Event-ID format, live feed unavailable)
+// are returned as normal JSON errors.
+const streamOpenDelay = time.Second
+
+// streamRetry is the reconnection delay suggested to EventSource clients (in milliseconds).
+const streamRetry = 3000
+
+// WatchPostsUseCaseInterface defines the interface for watching newly published posts.
+type WatchPostsUseCaseInterface interface {
+	Execute(ctx context.Context, query content.WatchPostsQuery, send func(*livefeed.Event) error) error
+}
+
+// StreamLimits bounds the Server-Sent Events connections of a PostStreamHandler.
+// Zero values disable the corresponding limit.
+type StreamLimits struct {
+	// HeartbeatInterval is how often an idle connection gets a comment, so
+	// proxies do not close it.
+	HeartbeatInterval time.Duration
+
+	// MaxConnections is the maximum number of open connections.
+	MaxConnections int
+
+	// MaxConnectionsPerIP is the maximum number of open connections per client IP.
+	MaxConnectionsPerIP int
+
+	// MaxDuration is how long a connection is kept open; clients reconnect
+	// with Last-Event-ID afterwards.
+	MaxDuration time.Duration
+}
+
+// PostStreamHandler streams newly published posts as Server-Sent Events.
+type PostStreamHandler struct {
+	watchUseCase WatchPostsUseCaseInterface
+	limits       StreamLimits
+
+	// mu guards the connection counts.
+	mu            sync.Mutex
+	connections   int
+	connectionsIP map[string]int
+
+	responder
+}
+
+// NewPostStreamHandler creates a new PostStreamHandler.
+func NewPostStreamHandler(
+	watchUseCase WatchPostsUseCaseInterface,
+	limits StreamLimits,
+	logger Logger,
+) *PostStreamHandler {
+	return &PostStreamHandler{
+		watchUseCase:  watchUseCase,
+		limits:        limits,
+		connectionsIP: make(map[string]int),
+		responder:     responder{logger: logger},
+	}
+}
+
+// StreamPosts handles GET /api/posts/stream
+func (h *PostStreamHandler) StreamPosts(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		h.writeError(w, http.StatusInternalServerError, "Streaming not supported")
+		return
+	}
+
+	// Enforce the connection limits per client address resolved through the trusted proxies
+	ip := extractClientIP(r)
+	if status, ok := h.acquire(ip); !ok {
+		w.Header().Set("Retry-After", "30")
+		h.writeError(w, status, "Too many live feed connections")
+		return
+	}
+	defer h.release(ip)
+
+	// EventSource sends the Last-Event-ID header when reconnecting; the query
+	// parameter lets clients resume on a fresh page
+	lastEventID := r.Header.Get("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = r.URL.Query().Get("lastEventId")
+	}
+
+	query := content.WatchPostsQuery{
+		CityCode:    r.URL.Query().Get("cityCode"),
+		Company:     r.URL.Query().Get("company"),
+		View:        strings.ToUpper(r.URL.Query().Get("view")),
+		LastEventID: lastEventID,
+	}
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	if h.limits.MaxDuration > 0 {
+		ctx, cancel = context.WithTimeout(ctx, h.limits.MaxDuration)
+		defer cancel()
+	}
+
+	// Run the use case in the background; all writes happen on this goroutine
+	events := make(chan *livefeed.Event)
+	done := make(chan error, 1)
+	go func() {
+		done <- h.watchUseCase.Execute(ctx, query, func(event *livefeed.Event) error {
+			select {
+			case events <- event:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+	}()
+
+	stream := &eventStream{w: w, flusher: flusher}
+	heartbeat := time.NewTimer(streamOpenDelay)
+	defer heartbeat.Stop()
+
+	for {
+		var err error
+		select {
+		case <-ctx.Done():
+			// Client disconnected or the connection reached its maximum duration
+			return
+		case err = <-done:
+			if err != nil && !stream.started {
+				h.handleError(w, err)
+				return
+			}
+			if err != nil {
+				h.logger.Warn("Live feed stream ended", zap.Error(err))
+			}
+			return
+		case event := <-events:
+			err = stream.writeEvent(event)
+		case <-heartbeat.C:
+			err = stream.writeHeartbeat()
+		}
+
+		if err != nil {
+			// The client is gone
+			return
+		}
+		if h.limits.HeartbeatInterval > 0 {
+			heartbeat.Reset(h.limits.HeartbeatInterval)
+		}
+	}
+}
+
+// acquire reserves a connection for a client IP. It returns the status code to
+// respond with if a limit is reached.
+func (h *PostStreamHandler) acquire(ip string) (int, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.limits.MaxConnections > 0 && h.connections >= h.limits.MaxConnections {
+		return http.StatusServiceUnavailable, false
+	}
+	if h.limits.MaxConnectionsPerIP > 0 && h.connectionsIP[ip] >= h.limits.MaxConnectionsPerIP {
+		return http.StatusTooManyRequests, false
+	}
+
+	h.connections++
+	h.connectionsIP[ip]++
+	return http.StatusOK, true
+}
+
+// release frees a connection reserved by acquire.
+func (h *PostStreamHandler) release(ip string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.connections--
+	h.connectionsIP[ip]--
+	if h.connectionsIP[ip] <= 0 {
+		delete(h.connectionsIP, ip)
+	}
+}
+
+// eventStream writes Server-Sent Events, starting the response on the first write.
+type eventStream struct {
+	w       http.ResponseWriter
+	flusher http.Flusher
+	started bool
+}
+
+// start writes the response headers and the reconnection delay.
+func (s *eventStream) start() error {
+	if s.started {
+		return nil
+	}
+	s.started = true
+
+	header := s.w.Header()
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	// Disable response buffering in nginx
+	header.Set("X-Accel-Buffering", "no")
+	s.w.WriteHeader(http.StatusOK)
+
+	_, err := fmt.Fprintf(s.w, "retry: %d\n\n", streamRetry)
+	return err
+}
+
+// writeEvent writes a "post" event with the event ID and the post as JSON.
+func (s *eventStream) writeEvent(event *livefeed.Event) error {
+	if err := s.start(); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(convertPostToResponse(event.Post))
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(s.w, "id: %s\nevent: post\ndata: %s\n\n", event.ID, data); err != nil {
+		return err
+	}
+	s.flusher.Flush()
+	return nil
+}
+
+// writeHeartbeat writes a comment line, which EventSource ignores.
+func (s *eventStream) writeHeartbeat() error {
+	if err := s.start(); err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprint(s.w, ": heartbeat\n\n"); err != nil {
+		return err
+	}
+	s.flusher.Flush()
+	return nil
+}
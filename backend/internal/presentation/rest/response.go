@@ -0,0 +1,93 @@
+// Package rest provides REST API handlers that convert JSON requests to gRPC calls.
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// responder writes JSON responses and maps errors to HTTP status codes.
+// It is embedded by every REST handler.
+type responder struct {
+	logger Logger
+}
+
+// handleError converts application errors to HTTP responses.
+func (h responder) handleError(w http.ResponseWriter, err error) {
+	if err == nil {
+		return
+	}
+
+	// Check for application errors
+	var appErr *apperrors.AppError
+	if apperrors.As(err, &appErr) {
+		switch appErr.Code {
+		case apperrors.ErrCodeValidation:
+			h.writeError(w, http.StatusBadRequest, appErr.Message)
+		case apperrors.ErrCodeNotFound:
+			h.writeError(w, http.StatusNotFound, appErr.Message)
+		case apperrors.ErrCodeUnauthenticated:
+			h.writeError(w, http.StatusUnauthorized, appErr.Message)
+		case apperrors.ErrCodeForbidden:
+			h.writeError(w, http.StatusForbidden, appErr.Message)
+		case apperrors.ErrCodeConflict:
+			h.writeError(w, http.StatusConflict, appErr.Message)
+		case apperrors.ErrCodeRateLimit:
+			h.writeError(w, http.StatusTooManyRequests, appErr.Message)
+		case apperrors.ErrCodeUnavailable:
+			h.writeError(w, http.StatusServiceUnavailable, appErr.Message)
+		default:
+			h.logger.Error("Internal error", zap.Error(err))
+			h.writeError(w, http.StatusInternalServerError, "Internal server error")
+		}
+		return
+	}
+
+	// Check for gRPC status errors
+	if st, ok := status.FromError(err); ok {
+		switch st.Code() {
+		case codes.InvalidArgument:
+			h.writeError(w, http.StatusBadRequest, st.Message())
+		case codes.NotFound:
+			h.writeError(w, http.StatusNotFound, st.Message())
+		case codes.Unauthenticated:
+			h.writeError(w, http.StatusUnauthorized, st.Message())
+		case codes.PermissionDenied:
+			h.writeError(w, http.StatusForbidden, st.Message())
+		case codes.AlreadyExists:
+			h.writeError(w, http.StatusConflict, st.Message())
+		case codes.ResourceExhausted:
+			h.writeError(w, http.StatusTooManyRequests, st.Message())
+		default:
+			h.logger.Error("gRPC error", zap.Error(err))
+			h.writeError(w, http.StatusInternalServerError, "Internal server error")
+		}
+		return
+	}
+
+	// Unknown error
+	h.logger.Error("Unknown error", zap.Error(err))
+	h.writeError(w, http.StatusInternalServerError, "Internal server error")
+}
+
+// writeJSON writes a JSON response.
+func (h responder) writeJSON(w http.ResponseWriter, statusCode int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		h.logger.Error("Failed to encode JSON response", zap.Error(err))
+	}
+}
+
+// writeError writes an error response.
+func (h responder) writeError(w http.ResponseWriter, statusCode int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(map[string]string{"error": message})
+}
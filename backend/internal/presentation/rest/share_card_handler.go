@@ -0,0 +1,98 @@
+// Package rest provides REST API handlers that convert JSON requests to gRPC calls.
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"fuck_boss/backend/internal/application/content"
+	"fuck_boss/backend/internal/application/dto"
+)
+
+const (
+	// ShareCardURLPrefix is the path prefix of share card images.
+	ShareCardURLPrefix = "/og/"
+
+	// shareCardMaxAge is how long a card requested without its current
+	// version may be reused (in seconds); versioned URLs never change.
+	shareCardMaxAge = 3600
+)
+
+// GetShareCardUseCaseInterface defines the interface for getting share card images.
+type GetShareCardUseCaseInterface interface {
+	Execute(ctx context.Context, postID string) (*content.ShareCardImage, error)
+}
+
+// ShareCardHandler serves the share card images of posts.
+type ShareCardHandler struct {
+	shareCardUseCase GetShareCardUseCaseInterface
+	responder
+}
+
+// NewShareCardHandler creates a new ShareCardHandler.
+func NewShareCardHandler(shareCardUseCase GetShareCardUseCaseInterface, logger Logger) *ShareCardHandler {
+	return &ShareCardHandler{
+		shareCardUseCase: shareCardUseCase,
+		responder:        responder{logger: logger},
+	}
+}
+
+// ShareCardPath returns the versioned path of a post's share card. The
+// version changes when the card does, so the image can be cached forever.
+func ShareCardPath(post *dto.PostDTO) string {
+	return ShareCardURLPrefix + url.PathEscape(post.ID) + ".png?v=" + content.ShareCardVersion(post)
+}
+
+// ShareCard handles GET /og/{post_id}.png?v=
+// Requests with the current version (see ShareCardPath) are cacheable for a
+// year; others, e.g. links without a version, for an hour.
+func (h *ShareCardHandler) ShareCard(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		h.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	postID, ok := strings.CutSuffix(strings.TrimPrefix(r.URL.Path, ShareCardURLPrefix), ".png")
+	if !ok || postID == "" || strings.Contains(postID, "/") {
+		h.writeError(w, http.StatusNotFound, "Share card not found")
+		return
+	}
+
+	card, err := h.shareCardUseCase.Execute(r.Context(), postID)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	etag := `"` + card.Version + `"`
+	cacheControl := fmt.Sprintf("public, max-age=%d", shareCardMaxAge)
+	if r.URL.Query().Get("v") == card.Version {
+		cacheControl = "public, max-age=31536000, immutable"
+	}
+
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", cacheControl)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", "image/png")
+	w.Header().Set("Content-Length", strconv.Itoa(len(card.Data)))
+	w.WriteHeader(http.StatusOK)
+
+	if r.Method == http.MethodHead {
+		return
+	}
+	if _, err := w.Write(card.Data); err != nil {
+		h.logger.Warn("Failed to write share card", zap.String("post_id", postID), zap.Error(err))
+	}
+}
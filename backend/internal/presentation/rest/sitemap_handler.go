@@ -0,0 +1,75 @@
+// Package rest provides REST API handlers that convert JSON requests to gRPC calls.
+package rest
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"fuck_boss/backend/internal/application/content"
+)
+
+// sitemapMaxAge is how long clients and proxies may reuse a sitemap file (in seconds).
+const sitemapMaxAge = 3600
+
+// GetSitemapUseCaseInterface defines the interface for opening sitemap files.
+type GetSitemapUseCaseInterface interface {
+	Execute(ctx context.Context, name string) (io.ReadCloser, error)
+}
+
+// SitemapHandler serves the sitemap index and its gzipped shards.
+type SitemapHandler struct {
+	sitemapUseCase GetSitemapUseCaseInterface
+	responder
+}
+
+// NewSitemapHandler creates a new SitemapHandler.
+func NewSitemapHandler(sitemapUseCase GetSitemapUseCaseInterface, logger Logger) *SitemapHandler {
+	return &SitemapHandler{
+		sitemapUseCase: sitemapUseCase,
+		responder:      responder{logger: logger},
+	}
+}
+
+// IsSitemapPath reports whether the path is a sitemap file served by Sitemap.
+// Shards live at the site root (/sitemap-N.xml.gz), next to the URLs they list.
+func IsSitemapPath(path string) bool {
+	return path == "/"+content.SitemapIndexName ||
+		(strings.HasPrefix(path, "/sitemap-") && strings.HasSuffix(path, ".xml.gz"))
+}
+
+// Sitemap handles GET /sitemap.xml and GET /sitemap-{n}.xml.gz
+func (h *SitemapHandler) Sitemap(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		h.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	name := strings.TrimPrefix(r.URL.Path, "/")
+	body, err := h.sitemapUseCase.Execute(r.Context(), name)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+	defer body.Close()
+
+	// Shards are served as gzip files, not with Content-Encoding, as crawlers expect
+	contentType := "application/xml; charset=utf-8"
+	if strings.HasSuffix(name, ".gz") {
+		contentType = "application/gzip"
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", sitemapMaxAge))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+	if r.Method == http.MethodHead {
+		return
+	}
+	if _, err := io.Copy(w, body); err != nil {
+		h.logger.Warn("Failed to write sitemap", zap.String("name", name), zap.Error(err))
+	}
+}
@@ -0,0 +1,372 @@
+// Package rest provides REST API handlers that convert JSON requests to gRPC calls.
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/application/watchlist"
+	"fuck_boss/backend/internal/infrastructure/logger"
+)
+
+// DeviceTokenHeader carries the anonymous device token on watchlist requests.
+const DeviceTokenHeader = "X-Device-Token"
+
+// WatchlistHandler handles REST API requests for bookmarks and company watchlists.
+type WatchlistHandler struct {
+	issueDeviceTokenUseCase     IssueDeviceTokenUseCaseInterface
+	addBookmarkUseCase          BookmarkUseCaseInterface
+	removeBookmarkUseCase       BookmarkUseCaseInterface
+	listBookmarksUseCase        ListBookmarksUseCaseInterface
+	watchUseCase                WatchUseCaseInterface
+	unwatchUseCase              WatchUseCaseInterface
+	listWatchedCompaniesUseCase ListWatchedCompaniesUseCaseInterface
+	listWatchedPostsUseCase     ListWatchedPostsUseCaseInterface
+	responder
+}
+
+// IssueDeviceTokenUseCaseInterface defines the interface for issuing device tokens.
+type IssueDeviceTokenUseCaseInterface interface {
+	Execute(ctx context.Context, cmd watchlist.IssueDeviceTokenCommand) (*dto.DeviceTokenDTO, error)
+}
+
+// BookmarkUseCaseInterface defines the interface for adding or removing bookmarks.
+type BookmarkUseCaseInterface interface {
+	Execute(ctx context.Context, cmd watchlist.BookmarkCommand) error
+}
+
+// ListBookmarksUseCaseInterface defines the interface for listing bookmarks.
+type ListBookmarksUseCaseInterface interface {
+	Execute(ctx context.Context, query watchlist.ListBookmarksQuery) (*dto.BookmarksListDTO, error)
+}
+
+// WatchUseCaseInterface defines the interface for watching or unwatching companies.
+type WatchUseCaseInterface interface {
+	Execute(ctx context.Context, cmd watchlist.WatchCommand) error
+}
+
+// ListWatchedCompaniesUseCaseInterface defines the interface for listing watched companies.
+type ListWatchedCompaniesUseCaseInterface interface {
+	Execute(ctx context.Context, query watchlist.OwnerQuery) ([]*dto.WatchedCompanyDTO, error)
+}
+
+// ListWatchedPostsUseCaseInterface defines the interface for listing new posts about watched companies.
+type ListWatchedPostsUseCaseInterface interface {
+	Execute(ctx context.Context, query watchlist.ListWatchedPostsQuery) (*dto.WatchedPostsDTO, error)
+}
+
+// NewWatchlistHandler creates a new WatchlistHandler.
+func NewWatchlistHandler(
+	issueDeviceTokenUseCase IssueDeviceTokenUseCaseInterface,
+	addBookmarkUseCase BookmarkUseCaseInterface,
+	removeBookmarkUseCase BookmarkUseCaseInterface,
+	listBookmarksUseCase ListBookmarksUseCaseInterface,
+	watchUseCase WatchUseCaseInterface,
+	unwatchUseCase WatchUseCaseInterface,
+	listWatchedCompaniesUseCase ListWatchedCompaniesUseCaseInterface,
+	listWatchedPostsUseCase ListWatchedPostsUseCaseInterface,
+	logger Logger,
+) *WatchlistHandler {
+	return &WatchlistHandler{
+		issueDeviceTokenUseCase:     issueDeviceTokenUseCase,
+		addBookmarkUseCase:          addBookmarkUseCase,
+		removeBookmarkUseCase:       removeBookmarkUseCase,
+		listBookmarksUseCase:        listBookmarksUseCase,
+		watchUseCase:                watchUseCase,
+		unwatchUseCase:              unwatchUseCase,
+		listWatchedCompaniesUseCase: listWatchedCompaniesUseCase,
+		listWatchedPostsUseCase:     listWatchedPostsUseCase,
+		responder:                   responder{logger: logger},
+	}
+}
+
+// DeviceTokenResponse is the JSON response for issuing a device token.
+type DeviceTokenResponse struct {
+	DeviceToken string `json:"deviceToken"`
+	IssuedAt    int64  `json:"issuedAt"`
+}
+
+// AddBookmarkRequest is the JSON request for bookmarking a post.
+type AddBookmarkRequest struct {
+	PostID string `json:"postId"`
+}
+
+// BookmarkResponse is the JSON response for a bookmarked post.
+type BookmarkResponse struct {
+	Post         *PostResponse `json:"post"`
+	BookmarkedAt int64         `json:"bookmarkedAt"`
+}
+
+// ListBookmarksResponse is the JSON response for listing bookmarks.
+type ListBookmarksResponse struct {
+	Bookmarks []*BookmarkResponse `json:"bookmarks"`
+	Total     int                 `json:"total"`
+	Page      int                 `json:"page"`
+	PageSize  int                 `json:"pageSize"`
+}
+
+// WatchCompanyRequest is the JSON request for watching a company.
+type WatchCompanyRequest struct {
+	Company string `json:"company"`
+}
+
+// WatchedCompanyResponse is the JSON response for a watched company.
+type WatchedCompanyResponse struct {
+	Company   string `json:"company"`
+	WatchedAt int64  `json:"watchedAt"`
+}
+
+// ListWatchedCompaniesResponse is the JSON response for listing watched companies.
+type ListWatchedCompaniesResponse struct {
+	Companies []*WatchedCompanyResponse `json:"companies"`
+}
+
+// ListWatchedPostsResponse is the JSON response for new posts about watched companies.
+type ListWatchedPostsResponse struct {
+	Posts    []*PostResponse `json:"posts"`
+	Total    int             `json:"total"`
+	Page     int             `json:"page"`
+	PageSize int             `json:"pageSize"`
+	Since    int64           `json:"since"`
+}
+
+// IssueDeviceToken handles POST /api/devices
+func (h *WatchlistHandler) IssueDeviceToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	dto, err := h.issueDeviceTokenUseCase.Execute(r.Context(), watchlist.IssueDeviceTokenCommand{
+		ClientIP: extractClientIP(r),
+	})
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	h.writeJSON(w, http.StatusCreated, DeviceTokenResponse{
+		DeviceToken: dto.DeviceToken,
+		IssuedAt:    dto.IssuedAt.Unix(),
+	})
+}
+
+// Bookmarks handles GET and POST /api/bookmarks
+func (h *WatchlistHandler) Bookmarks(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.listBookmarks(w, r)
+	case http.MethodPost:
+		h.addBookmark(w, r)
+	default:
+		h.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+// RemoveBookmark handles DELETE /api/bookmarks/:postId
+func (h *WatchlistHandler) RemoveBookmark(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		h.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	// Extract post ID from URL path
+	postID := strings.TrimPrefix(r.URL.Path, "/api/bookmarks/")
+	if postID == "" {
+		h.writeError(w, http.StatusBadRequest, "Post ID is required")
+		return
+	}
+
+	if err := h.removeBookmarkUseCase.Execute(r.Context(), watchlist.BookmarkCommand{
+		UserID:      logger.UserIDFromContext(r.Context()),
+		DeviceToken: r.Header.Get(DeviceTokenHeader),
+		PostID:      postID,
+	}); err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Watches handles GET and POST /api/watches
+func (h *WatchlistHandler) Watches(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.listWatchedCompanies(w, r)
+	case http.MethodPost:
+		h.watchCompany(w, r)
+	default:
+		h.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+// UnwatchCompany handles DELETE /api/watches/:company
+func (h *WatchlistHandler) UnwatchCompany(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		h.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	// Extract company name from URL path (company names may contain escaped characters)
+	company, err := url.PathUnescape(strings.TrimPrefix(r.URL.EscapedPath(), "/api/watches/"))
+	if err != nil || company == "" {
+		h.writeError(w, http.StatusBadRequest, "Company name is required")
+		return
+	}
+
+	if err := h.unwatchUseCase.Execute(r.Context(), watchlist.WatchCommand{
+		UserID:      logger.UserIDFromContext(r.Context()),
+		DeviceToken: r.Header.Get(DeviceTokenHeader),
+		Company:     company,
+	}); err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListWatchedPosts handles GET /api/watches/posts
+func (h *WatchlistHandler) ListWatchedPosts(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		h.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	// Parse query parameters
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	pageSize, _ := strconv.Atoi(r.URL.Query().Get("pageSize"))
+	markVisited, _ := strconv.ParseBool(r.URL.Query().Get("markVisited"))
+
+	query := watchlist.ListWatchedPostsQuery{
+		UserID:      logger.UserIDFromContext(r.Context()),
+		DeviceToken: r.Header.Get(DeviceTokenHeader),
+		MarkVisited: markVisited,
+		Page:        page,
+		PageSize:    pageSize,
+	}
+	if value := r.URL.Query().Get("since"); value != "" {
+		seconds, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			h.writeError(w, http.StatusBadRequest, "since must be a Unix timestamp")
+			return
+		}
+		since := time.Unix(seconds, 0)
+		query.Since = &since
+	}
+
+	dto, err := h.listWatchedPostsUseCase.Execute(r.Context(), query)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, ListWatchedPostsResponse{
+		Posts:    convertPostsToResponse(dto.Posts),
+		Total:    dto.Total,
+		Page:     dto.Page,
+		PageSize: dto.PageSize,
+		Since:    dto.Since.Unix(),
+	})
+}
+
+// listBookmarks handles GET /api/bookmarks
+func (h *WatchlistHandler) listBookmarks(w http.ResponseWriter, r *http.Request) {
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	pageSize, _ := strconv.Atoi(r.URL.Query().Get("pageSize"))
+
+	dto, err := h.listBookmarksUseCase.Execute(r.Context(), watchlist.ListBookmarksQuery{
+		UserID:      logger.UserIDFromContext(r.Context()),
+		DeviceToken: r.Header.Get(DeviceTokenHeader),
+		Page:        page,
+		PageSize:    pageSize,
+	})
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	bookmarks := make([]*BookmarkResponse, 0, len(dto.Bookmarks))
+	for _, bookmark := range dto.Bookmarks {
+		bookmarks = append(bookmarks, &BookmarkResponse{
+			Post:         convertPostToResponse(bookmark.Post),
+			BookmarkedAt: bookmark.BookmarkedAt.Unix(),
+		})
+	}
+
+	h.writeJSON(w, http.StatusOK, ListBookmarksResponse{
+		Bookmarks: bookmarks,
+		Total:     dto.Total,
+		Page:      dto.Page,
+		PageSize:  dto.PageSize,
+	})
+}
+
+// addBookmark handles POST /api/bookmarks
+func (h *WatchlistHandler) addBookmark(w http.ResponseWriter, r *http.Request) {
+	var req AddBookmarkRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid request body: "+err.Error())
+		return
+	}
+
+	if err := h.addBookmarkUseCase.Execute(r.Context(), watchlist.BookmarkCommand{
+		UserID:      logger.UserIDFromContext(r.Context()),
+		DeviceToken: r.Header.Get(DeviceTokenHeader),
+		PostID:      req.PostID,
+	}); err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// listWatchedCompanies handles GET /api/watches
+func (h *WatchlistHandler) listWatchedCompanies(w http.ResponseWriter, r *http.Request) {
+	dtos, err := h.listWatchedCompaniesUseCase.Execute(r.Context(), watchlist.OwnerQuery{
+		UserID:      logger.UserIDFromContext(r.Context()),
+		DeviceToken: r.Header.Get(DeviceTokenHeader),
+	})
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	companies := make([]*WatchedCompanyResponse, 0, len(dtos))
+	for _, watched := range dtos {
+		companies = append(companies, &WatchedCompanyResponse{
+			Company:   watched.Company,
+			WatchedAt: watched.WatchedAt.Unix(),
+		})
+	}
+
+	h.writeJSON(w, http.StatusOK, ListWatchedCompaniesResponse{Companies: companies})
+}
+
+// watchCompany handles POST /api/watches
+func (h *WatchlistHandler) watchCompany(w http.ResponseWriter, r *http.Request) {
+	var req WatchCompanyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid request body: "+err.Error())
+		return
+	}
+
+	if err := h.watchUseCase.Execute(r.Context(), watchlist.WatchCommand{
+		UserID:      logger.UserIDFromContext(r.Context()),
+		DeviceToken: r.Header.Get(DeviceTokenHeader),
+		Company:     req.Company,
+	}); err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -16,9 +16,22 @@ const (
 	// ErrCodeNotFound indicates a resource not found error.
 	ErrCodeNotFound ErrorCode = "NOT_FOUND"
 
+	// ErrCodeUnauthenticated indicates missing, invalid or expired credentials.
+	ErrCodeUnauthenticated ErrorCode = "UNAUTHENTICATED"
+
+	// ErrCodeForbidden indicates the caller is not allowed to perform the operation.
+	ErrCodeForbidden ErrorCode = "FORBIDDEN"
+
+	// ErrCodeConflict indicates the operation conflicts with the current state of a resource.
+	ErrCodeConflict ErrorCode = "CONFLICT"
+
 	// ErrCodeRateLimit indicates a rate limit exceeded error.
 	ErrCodeRateLimit ErrorCode = "RATE_LIMIT_EXCEEDED"
 
+	// ErrCodeUnavailable indicates the service is temporarily unable to handle
+	// the request; the client should retry, possibly on another instance.
+	ErrCodeUnavailable ErrorCode = "UNAVAILABLE"
+
 	// ErrCodeInternal indicates an internal server error.
 	ErrCodeInternal ErrorCode = "INTERNAL_ERROR"
 
@@ -79,6 +92,30 @@ func NewNotFoundError(resource string) *AppError {
 	}
 }
 
+// NewUnauthenticatedError creates a new unauthenticated error.
+func NewUnauthenticatedError(message string) *AppError {
+	return &AppError{
+		Code:    ErrCodeUnauthenticated,
+		Message: message,
+	}
+}
+
+// NewForbiddenError creates a new forbidden error.
+func NewForbiddenError(message string) *AppError {
+	return &AppError{
+		Code:    ErrCodeForbidden,
+		Message: message,
+	}
+}
+
+// NewConflictError creates a new conflict error.
+func NewConflictError(message string) *AppError {
+	return &AppError{
+		Code:    ErrCodeConflict,
+		Message: message,
+	}
+}
+
 // NewRateLimitError creates a new rate limit error.
 func NewRateLimitError(message string) *AppError {
 	return &AppError{
@@ -98,6 +135,14 @@ func NewRateLimitErrorWithWindow(message string, retryAfter int) *AppError {
 	}
 }
 
+// NewUnavailableError creates a new unavailable error.
+func NewUnavailableError(message string) *AppError {
+	return &AppError{
+		Code:    ErrCodeUnavailable,
+		Message: message,
+	}
+}
+
 // NewInternalError creates a new internal error.
 func NewInternalError(message string) *AppError {
 	return &AppError{
@@ -178,6 +223,48 @@ func IsNotFoundError(err error) bool {
 	return false
 }
 
+// IsUnauthenticatedError checks if the error is an unauthenticated error.
+func IsUnauthenticatedError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var appErr *AppError
+	if As(err, &appErr) {
+		return appErr.Code == ErrCodeUnauthenticated
+	}
+
+	return false
+}
+
+// IsForbiddenError checks if the error is a forbidden error.
+func IsForbiddenError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var appErr *AppError
+	if As(err, &appErr) {
+		return appErr.Code == ErrCodeForbidden
+	}
+
+	return false
+}
+
+// IsConflictError checks if the error is a conflict error.
+func IsConflictError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var appErr *AppError
+	if As(err, &appErr) {
+		return appErr.Code == ErrCodeConflict
+	}
+
+	return false
+}
+
 // IsRateLimitError checks if the error is a rate limit error.
 func IsRateLimitError(err error) bool {
 	if err == nil {
@@ -192,6 +279,20 @@ func IsRateLimitError(err error) bool {
 	return false
 }
 
+// IsUnavailableError checks if the error is an unavailable error.
+func IsUnavailableError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var appErr *AppError
+	if As(err, &appErr) {
+		return appErr.Code == ErrCodeUnavailable
+	}
+
+	return false
+}
+
 // IsInternalError checks if the error is an internal error.
 func IsInternalError(err error) bool {
 	if err == nil {
@@ -101,6 +101,48 @@ func TestNewNotFoundError(t *testing.T) {
 	}
 }
 
+func TestNewUnauthenticatedError(t *testing.T) {
+	err := NewUnauthenticatedError("invalid credentials")
+
+	if err == nil {
+		t.Fatal("NewUnauthenticatedError() returned nil")
+	}
+	if err.Code != ErrCodeUnauthenticated {
+		t.Errorf("NewUnauthenticatedError() Code = %v, want %v", err.Code, ErrCodeUnauthenticated)
+	}
+	if err.Message != "invalid credentials" {
+		t.Errorf("NewUnauthenticatedError() Message = %v, want %v", err.Message, "invalid credentials")
+	}
+}
+
+func TestNewForbiddenError(t *testing.T) {
+	err := NewForbiddenError("management token does not match")
+
+	if err == nil {
+		t.Fatal("NewForbiddenError() returned nil")
+	}
+	if err.Code != ErrCodeForbidden {
+		t.Errorf("NewForbiddenError() Code = %v, want %v", err.Code, ErrCodeForbidden)
+	}
+	if err.Message != "management token does not match" {
+		t.Errorf("NewForbiddenError() Message = %v, want %v", err.Message, "management token does not match")
+	}
+}
+
+func TestNewConflictError(t *testing.T) {
+	err := NewConflictError("post already has an official reply")
+
+	if err == nil {
+		t.Fatal("NewConflictError() returned nil")
+	}
+	if err.Code != ErrCodeConflict {
+		t.Errorf("NewConflictError() Code = %v, want %v", err.Code, ErrCodeConflict)
+	}
+	if err.Message != "post already has an official reply" {
+		t.Errorf("NewConflictError() Message = %v, want %v", err.Message, "post already has an official reply")
+	}
+}
+
 func TestNewRateLimitError(t *testing.T) {
 	err := NewRateLimitError("too many requests")
 
@@ -281,6 +323,42 @@ func TestIsNotFoundError(t *testing.T) {
 	}
 }
 
+func TestIsForbiddenError(t *testing.T) {
+	if !IsForbiddenError(NewForbiddenError("forbidden")) {
+		t.Error("IsForbiddenError() = false, want true for forbidden error")
+	}
+	if IsForbiddenError(NewValidationError("invalid")) {
+		t.Error("IsForbiddenError() = true, want false for validation error")
+	}
+	if IsForbiddenError(nil) {
+		t.Error("IsForbiddenError() = true, want false for nil error")
+	}
+}
+
+func TestIsUnauthenticatedError(t *testing.T) {
+	if !IsUnauthenticatedError(NewUnauthenticatedError("unauthenticated")) {
+		t.Error("IsUnauthenticatedError() = false, want true for unauthenticated error")
+	}
+	if IsUnauthenticatedError(NewForbiddenError("forbidden")) {
+		t.Error("IsUnauthenticatedError() = true, want false for forbidden error")
+	}
+	if IsUnauthenticatedError(nil) {
+		t.Error("IsUnauthenticatedError() = true, want false for nil error")
+	}
+}
+
+func TestIsConflictError(t *testing.T) {
+	if !IsConflictError(NewConflictError("conflict")) {
+		t.Error("IsConflictError() = false, want true for conflict error")
+	}
+	if IsConflictError(NewForbiddenError("forbidden")) {
+		t.Error("IsConflictError() = true, want false for forbidden error")
+	}
+	if IsConflictError(nil) {
+		t.Error("IsConflictError() = true, want false for nil error")
+	}
+}
+
 func TestIsRateLimitError(t *testing.T) {
 	if !IsRateLimitError(NewRateLimitError("rate limit")) {
 		t.Error("IsRateLimitError() = false, want true for rate limit error")
@@ -293,6 +371,29 @@ func TestIsRateLimitError(t *testing.T) {
 	}
 }
 
+func TestNewUnavailableError(t *testing.T) {
+	err := NewUnavailableError("shutting down")
+
+	if err.Code != ErrCodeUnavailable {
+		t.Errorf("NewUnavailableError() Code = %v, want %v", err.Code, ErrCodeUnavailable)
+	}
+	if err.Message != "shutting down" {
+		t.Errorf("NewUnavailableError() Message = %v, want %v", err.Message, "shutting down")
+	}
+}
+
+func TestIsUnavailableError(t *testing.T) {
+	if !IsUnavailableError(NewUnavailableError("unavailable")) {
+		t.Error("IsUnavailableError() = false, want true for unavailable error")
+	}
+	if IsUnavailableError(NewInternalError("internal")) {
+		t.Error("IsUnavailableError() = true, want false for internal error")
+	}
+	if IsUnavailableError(nil) {
+		t.Error("IsUnavailableError() = true, want false for nil error")
+	}
+}
+
 func TestIsInternalError(t *testing.T) {
 	if !IsInternalError(NewInternalError("internal")) {
 		t.Error("IsInternalError() = false, want true for internal error")
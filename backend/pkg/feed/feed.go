@@ -0,0 +1,342 @@
+// Package feed encodes syndication feeds as Atom 1.0, RSS 2.0 and JSON Feed 1.1.
+//
+// A Feed is built once and rendered in the format a client asked for. All
+// links must be absolute URLs; summaries are plain text.
+package feed
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Format is a feed document format.
+type Format string
+
+const (
+	// FormatAtom is Atom 1.0 (RFC 4287).
+	FormatAtom Format = "atom"
+
+	// FormatRSS is RSS 2.0.
+	FormatRSS Format = "rss"
+
+	// FormatJSON is JSON Feed 1.1.
+	FormatJSON Format = "json"
+)
+
+// ParseFormat parses a format name (case-insensitive). An empty name is Atom.
+func ParseFormat(name string) (Format, error) {
+	switch Format(strings.ToLower(strings.TrimSpace(name))) {
+	case "", FormatAtom:
+		return FormatAtom, nil
+	case FormatRSS:
+		return FormatRSS, nil
+	case FormatJSON:
+		return FormatJSON, nil
+	default:
+		return "", fmt.Errorf("unknown feed format %q (want atom, rss or json)", name)
+	}
+}
+
+// ContentType returns the media type of the format.
+func (f Format) ContentType() string {
+	switch f {
+	case FormatRSS:
+		return "application/rss+xml; charset=utf-8"
+	case FormatJSON:
+		return "application/feed+json; charset=utf-8"
+	default:
+		return "application/atom+xml; charset=utf-8"
+	}
+}
+
+// Feed is a syndication feed.
+type Feed struct {
+	// Title is the feed title.
+	Title string
+
+	// Description describes the feed (optional).
+	Description string
+
+	// Link is the HTML page the feed belongs to.
+	Link string
+
+	// FeedURL is the URL of the feed document itself.
+	FeedURL string
+
+	// Author is the feed author; Atom requires one.
+	Author string
+
+	// Language is the feed language, e.g. "zh-CN" (optional).
+	Language string
+
+	// Updated is when the feed last changed (usually the newest item).
+	Updated time.Time
+
+	// Items are the entries, newest first.
+	Items []Item
+}
+
+// Item is a feed entry.
+type Item struct {
+	// ID identifies the entry permanently (an absolute URI).
+	ID string
+
+	// Title is the entry title.
+	Title string
+
+	// Link is the HTML page of the entry.
+	Link string
+
+	// Summary is the plain-text summary.
+	Summary string
+
+	// Categories are the entry tags (optional).
+	Categories []string
+
+	// Published is when the entry was first published.
+	Published time.Time
+
+	// Updated is when the entry last changed (optional, defaults to Published).
+	Updated time.Time
+}
+
+// updated returns the update time of an item.
+func (i Item) updated() time.Time {
+	if i.Updated.IsZero() {
+		return i.Published
+	}
+	return i.Updated
+}
+
+// Render encodes the feed in the given format.
+func Render(f *Feed, format Format) ([]byte, error) {
+	switch format {
+	case FormatAtom:
+		return renderXML(toAtom(f))
+	case FormatRSS:
+		return renderXML(toRSS(f))
+	case FormatJSON:
+		return renderJSON(toJSONFeed(f))
+	default:
+		return nil, fmt.Errorf("unknown feed format %q", format)
+	}
+}
+
+// renderXML encodes v as an indented XML document with a declaration.
+func renderXML(v interface{}) ([]byte, error) {
+	data, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), append(data, '\n')...), nil
+}
+
+// renderJSON encodes v as indented JSON without escaping HTML characters.
+func renderJSON(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// atomFeed is the Atom 1.0 document.
+type atomFeed struct {
+	XMLName  xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Lang     string      `xml:"xml:lang,attr,omitempty"`
+	ID       string      `xml:"id"`
+	Title    string      `xml:"title"`
+	Subtitle string      `xml:"subtitle,omitempty"`
+	Updated  string      `xml:"updated"`
+	Links    []atomLink  `xml:"link"`
+	Author   atomAuthor  `xml:"author"`
+	Entries  []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+	Href string `xml:"href,attr"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+type atomEntry struct {
+	ID         string         `xml:"id"`
+	Title      string         `xml:"title"`
+	Link       atomLink       `xml:"link"`
+	Published  string         `xml:"published"`
+	Updated    string         `xml:"updated"`
+	Summary    string         `xml:"summary"`
+	Categories []atomCategory `xml:"category"`
+}
+
+// toAtom converts a feed to an Atom document; the feed URL is its ID.
+func toAtom(f *Feed) *atomFeed {
+	doc := &atomFeed{
+		Lang:     f.Language,
+		ID:       f.FeedURL,
+		Title:    f.Title,
+		Subtitle: f.Description,
+		Updated:  f.Updated.UTC().Format(time.RFC3339),
+		Links: []atomLink{
+			{Rel: "self", Type: FormatAtom.mediaType(), Href: f.FeedURL},
+			{Rel: "alternate", Type: "text/html", Href: f.Link},
+		},
+		Author: atomAuthor{Name: f.Author},
+	}
+	for _, item := range f.Items {
+		entry := atomEntry{
+			ID:        item.ID,
+			Title:     item.Title,
+			Link:      atomLink{Rel: "alternate", Type: "text/html", Href: item.Link},
+			Published: item.Published.UTC().Format(time.RFC3339),
+			Updated:   item.updated().UTC().Format(time.RFC3339),
+			Summary:   item.Summary,
+		}
+		for _, c := range item.Categories {
+			entry.Categories = append(entry.Categories, atomCategory{Term: c})
+		}
+		doc.Entries = append(doc.Entries, entry)
+	}
+	return doc
+}
+
+// rssFeed is the RSS 2.0 document. The atom:link element gives the self link
+// recommended by the RSS Advisory Board.
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	AtomNS  string     `xml:"xmlns:atom,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	Language      string    `xml:"language,omitempty"`
+	LastBuildDate string    `xml:"lastBuildDate"`
+	AtomLink      atomLink  `xml:"atom:link"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type rssItem struct {
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link"`
+	GUID        rssGUID  `xml:"guid"`
+	PubDate     string   `xml:"pubDate"`
+	Description string   `xml:"description"`
+	Categories  []string `xml:"category"`
+}
+
+// toRSS converts a feed to an RSS document.
+func toRSS(f *Feed) *rssFeed {
+	description := f.Description
+	if description == "" {
+		// description is required in RSS
+		description = f.Title
+	}
+
+	doc := &rssFeed{
+		Version: "2.0",
+		AtomNS:  "http://www.w3.org/2005/Atom",
+		Channel: rssChannel{
+			Title:         f.Title,
+			Link:          f.Link,
+			Description:   description,
+			Language:      strings.ToLower(f.Language),
+			LastBuildDate: f.Updated.UTC().Format(time.RFC1123Z),
+			AtomLink:      atomLink{Rel: "self", Type: FormatRSS.mediaType(), Href: f.FeedURL},
+		},
+	}
+	for _, item := range f.Items {
+		doc.Channel.Items = append(doc.Channel.Items, rssItem{
+			Title:       item.Title,
+			Link:        item.Link,
+			GUID:        rssGUID{IsPermaLink: item.ID == item.Link, Value: item.ID},
+			PubDate:     item.Published.UTC().Format(time.RFC1123Z),
+			Description: item.Summary,
+			Categories:  item.Categories,
+		})
+	}
+	return doc
+}
+
+// jsonFeed is the JSON Feed 1.1 document.
+type jsonFeed struct {
+	Version     string         `json:"version"`
+	Title       string         `json:"title"`
+	HomePageURL string         `json:"home_page_url"`
+	FeedURL     string         `json:"feed_url"`
+	Description string         `json:"description,omitempty"`
+	Language    string         `json:"language,omitempty"`
+	Authors     []jsonAuthor   `json:"authors,omitempty"`
+	Items       []jsonFeedItem `json:"items"`
+}
+
+type jsonAuthor struct {
+	Name string `json:"name"`
+}
+
+type jsonFeedItem struct {
+	ID            string   `json:"id"`
+	URL           string   `json:"url"`
+	Title         string   `json:"title"`
+	ContentText   string   `json:"content_text"`
+	DatePublished string   `json:"date_published"`
+	DateModified  string   `json:"date_modified,omitempty"`
+	Tags          []string `json:"tags,omitempty"`
+}
+
+// toJSONFeed converts a feed to a JSON Feed document.
+func toJSONFeed(f *Feed) *jsonFeed {
+	doc := &jsonFeed{
+		Version:     "https://jsonfeed.org/version/1.1",
+		Title:       f.Title,
+		HomePageURL: f.Link,
+		FeedURL:     f.FeedURL,
+		Description: f.Description,
+		Language:    f.Language,
+		Items:       make([]jsonFeedItem, 0, len(f.Items)),
+	}
+	if f.Author != "" {
+		doc.Authors = []jsonAuthor{{Name: f.Author}}
+	}
+	for _, item := range f.Items {
+		doc.Items = append(doc.Items, jsonFeedItem{
+			ID:            item.ID,
+			URL:           item.Link,
+			Title:         item.Title,
+			ContentText:   item.Summary,
+			DatePublished: item.Published.UTC().Format(time.RFC3339),
+			DateModified:  item.updated().UTC().Format(time.RFC3339),
+			Tags:          item.Categories,
+		})
+	}
+	return doc
+}
+
+// mediaType returns the media type of the format without parameters.
+func (f Format) mediaType() string {
+	contentType, _, _ := strings.Cut(f.ContentType(), ";")
+	return contentType
+}
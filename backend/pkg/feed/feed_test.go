@@ -0,0 +1,149 @@
+package feed
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testFeed() *Feed {
+	published := time.Date(2026, 1, 6, 16, 7, 0, 0, time.UTC)
+	return &Feed{
+		Title:    "北京 & 上海",
+		Link:     "https://example.com/city/beijing",
+		FeedURL:  "https://example.com/feeds/city/beijing.xml",
+		Author:   "全国公司曝光平台",
+		Language: "zh-CN",
+		Updated:  published,
+		Items: []Item{
+			{
+				ID:         "urn:uuid:00000000-0000-0000-0000-000000000001",
+				Title:      "某某科技 · 北京",
+				Link:       "https://example.com/p/00000000-0000-0000-0000-000000000001",
+				Summary:    "拖欠工资 <三个月>",
+				Categories: []string{"北京"},
+				Published:  published,
+			},
+		},
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	tests := map[string]Format{"": FormatAtom, "atom": FormatAtom, "RSS": FormatRSS, " json ": FormatJSON}
+	for name, want := range tests {
+		got, err := ParseFormat(name)
+		if err != nil || got != want {
+			t.Errorf("ParseFormat(%q) = %q, %v; want %q", name, got, err, want)
+		}
+	}
+	if _, err := ParseFormat("xml"); err == nil {
+		t.Error("ParseFormat(xml) should fail")
+	}
+}
+
+func TestRender_Atom(t *testing.T) {
+	data, err := Render(testFeed(), FormatAtom)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	var doc struct {
+		XMLName xml.Name
+		Title   string `xml:"title"`
+		Entries []struct {
+			ID      string `xml:"id"`
+			Summary string `xml:"summary"`
+			Updated string `xml:"updated"`
+		} `xml:"entry"`
+	}
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("invalid XML: %v\n%s", err, data)
+	}
+	if doc.XMLName.Space != "http://www.w3.org/2005/Atom" || doc.XMLName.Local != "feed" {
+		t.Errorf("root = %v, want Atom feed", doc.XMLName)
+	}
+	if doc.Title != "北京 & 上海" {
+		t.Errorf("title = %q", doc.Title)
+	}
+	if len(doc.Entries) != 1 || doc.Entries[0].Summary != "拖欠工资 <三个月>" {
+		t.Fatalf("entries = %+v", doc.Entries)
+	}
+	// Updated defaults to Published
+	if doc.Entries[0].Updated != "2026-01-06T16:07:00Z" {
+		t.Errorf("updated = %q", doc.Entries[0].Updated)
+	}
+	if !strings.Contains(string(data), `rel="self" type="application/atom+xml" href="https://example.com/feeds/city/beijing.xml"`) {
+		t.Errorf("missing self link:\n%s", data)
+	}
+}
+
+func TestRender_RSS(t *testing.T) {
+	data, err := Render(testFeed(), FormatRSS)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	var doc struct {
+		Version string `xml:"version,attr"`
+		Channel struct {
+			Description string `xml:"description"`
+			Items       []struct {
+				GUID    string `xml:"guid"`
+				PubDate string `xml:"pubDate"`
+			} `xml:"item"`
+		} `xml:"channel"`
+	}
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("invalid XML: %v\n%s", err, data)
+	}
+	if doc.Version != "2.0" {
+		t.Errorf("version = %q", doc.Version)
+	}
+	// The required description falls back to the title
+	if doc.Channel.Description != "北京 & 上海" {
+		t.Errorf("description = %q", doc.Channel.Description)
+	}
+	if len(doc.Channel.Items) != 1 || doc.Channel.Items[0].PubDate != "Tue, 06 Jan 2026 16:07:00 +0000" {
+		t.Fatalf("items = %+v", doc.Channel.Items)
+	}
+	if !strings.Contains(string(data), `<guid isPermaLink="false">`) {
+		t.Errorf("guid should not be a permalink:\n%s", data)
+	}
+}
+
+func TestRender_JSON(t *testing.T) {
+	data, err := Render(testFeed(), FormatJSON)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	var doc map[string]interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, data)
+	}
+	if doc["version"] != "https://jsonfeed.org/version/1.1" {
+		t.Errorf("version = %v", doc["version"])
+	}
+	items := doc["items"].([]interface{})
+	if len(items) != 1 || items[0].(map[string]interface{})["content_text"] != "拖欠工资 <三个月>" {
+		t.Errorf("items = %v", items)
+	}
+	if strings.Contains(string(data), `\u003c`) {
+		t.Errorf("HTML characters should not be escaped:\n%s", data)
+	}
+}
+
+func TestRender_EmptyJSONFeed(t *testing.T) {
+	f := testFeed()
+	f.Items = nil
+
+	data, err := Render(f, FormatJSON)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if !strings.Contains(string(data), `"items": []`) {
+		t.Errorf("items should be an empty array:\n%s", data)
+	}
+}
@@ -0,0 +1,183 @@
+// Package markdown renders the restricted Markdown subset used for user content.
+package markdown
+
+import (
+	"bytes"
+	"net/url"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// LinkRel is the rel attribute of every rendered link.
+// User content is not endorsed, so search engines must not follow it.
+const LinkRel = "nofollow ugc noopener noreferrer"
+
+// Renderer renders CommonMark plus strikethrough and bare URLs (GFM) to sanitized HTML.
+//
+// The subset is enforced on the syntax tree before rendering:
+//   - Raw HTML (blocks and inline tags) is dropped.
+//   - Links keep only http, https and mailto URLs or site-relative paths; other links
+//     are replaced by their text. Every link gets rel=LinkRel.
+//   - Images are kept only if their URL passes the image policy; other images
+//     are replaced by their alt text.
+//
+// Line breaks inside paragraphs are kept (hard wraps), so existing plain-text
+// content renders as it was written.
+type Renderer struct {
+	// md is the configured goldmark instance.
+	md goldmark.Markdown
+}
+
+// NewRenderer creates a new Renderer.
+// allowImage reports whether an image URL may be rendered (nil allows no images).
+func NewRenderer(allowImage func(destination string) bool) *Renderer {
+	return &Renderer{
+		md: goldmark.New(
+			goldmark.WithExtensions(extension.Strikethrough, extension.Linkify),
+			goldmark.WithParserOptions(
+				parser.WithASTTransformers(util.Prioritized(&policyTransformer{allowImage: allowImage}, 100)),
+			),
+			goldmark.WithRendererOptions(html.WithHardWraps()),
+		),
+	}
+}
+
+// HTML renders source to sanitized HTML.
+func (r *Renderer) HTML(source string) string {
+	var buf bytes.Buffer
+	if err := r.md.Convert([]byte(source), &buf); err != nil {
+		// Rendering to a bytes.Buffer does not fail; fall back to escaped text
+		return "<p>" + string(util.EscapeHTML([]byte(source))) + "</p>"
+	}
+	return buf.String()
+}
+
+// PlainText returns the text of source without any Markdown syntax.
+// Blocks are separated by newlines, links are replaced by their text and images by their alt text.
+func (r *Renderer) PlainText(source string) string {
+	src := []byte(source)
+	doc := r.md.Parser().Parse(text.NewReader(src))
+
+	var b strings.Builder
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			if n.Type() == ast.TypeBlock && b.Len() > 0 && !strings.HasSuffix(b.String(), "\n") {
+				b.WriteByte('\n')
+			}
+			return ast.WalkContinue, nil
+		}
+
+		switch n := n.(type) {
+		case *ast.Text:
+			b.Write(n.Segment.Value(src))
+			if n.SoftLineBreak() || n.HardLineBreak() {
+				b.WriteByte('\n')
+			}
+		case *ast.String:
+			b.Write(n.Value)
+		case *ast.AutoLink:
+			b.Write(n.Label(src))
+			return ast.WalkSkipChildren, nil
+		case *ast.FencedCodeBlock, *ast.CodeBlock:
+			lines := n.Lines()
+			for i := 0; i < lines.Len(); i++ {
+				line := lines.At(i)
+				b.Write(line.Value(src))
+			}
+			return ast.WalkSkipChildren, nil
+		}
+		return ast.WalkContinue, nil
+	})
+
+	return strings.TrimSpace(b.String())
+}
+
+// policyTransformer removes everything outside the allowed subset from the syntax tree.
+type policyTransformer struct {
+	// allowImage reports whether an image URL may be rendered.
+	allowImage func(destination string) bool
+}
+
+// Transform implements parser.ASTTransformer.
+func (t *policyTransformer) Transform(doc *ast.Document, reader text.Reader, pc parser.Context) {
+	source := reader.Source()
+
+	// Collect first: the tree cannot be modified while it is walked
+	var nodes []ast.Node
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		switch n.(type) {
+		case *ast.HTMLBlock, *ast.RawHTML:
+			nodes = append(nodes, n)
+			return ast.WalkSkipChildren, nil
+		case *ast.Link, *ast.AutoLink, *ast.Image:
+			nodes = append(nodes, n)
+		}
+		return ast.WalkContinue, nil
+	})
+
+	for _, n := range nodes {
+		switch n := n.(type) {
+		case *ast.HTMLBlock, *ast.RawHTML:
+			n.Parent().RemoveChild(n.Parent(), n)
+		case *ast.Link:
+			if allowedLink(string(n.Destination)) {
+				n.SetAttributeString("rel", []byte(LinkRel))
+			} else {
+				unwrap(n)
+			}
+		case *ast.AutoLink:
+			link := string(n.URL(source))
+			if n.AutoLinkType == ast.AutoLinkEmail || allowedLink(link) {
+				n.SetAttributeString("rel", []byte(LinkRel))
+			} else {
+				n.Parent().ReplaceChild(n.Parent(), n, ast.NewString(n.Label(source)))
+			}
+		case *ast.Image:
+			if t.allowImage == nil || !t.allowImage(string(n.Destination)) {
+				unwrap(n)
+			}
+		}
+	}
+}
+
+// allowedLink reports whether a link destination is an http(s) or mailto URL or a site-relative path.
+func allowedLink(destination string) bool {
+	if strings.HasPrefix(destination, "/") {
+		// "//host" is protocol-relative, i.e. another site
+		return !strings.HasPrefix(destination, "//") && !strings.HasPrefix(destination, `/\`)
+	}
+
+	u, err := url.Parse(destination)
+	if err != nil {
+		return false
+	}
+	switch strings.ToLower(u.Scheme) {
+	case "http", "https":
+		return u.Host != ""
+	case "mailto":
+		return true
+	default:
+		return false
+	}
+}
+
+// unwrap replaces n by its children (the text of a link, the alt text of an image).
+func unwrap(n ast.Node) {
+	parent := n.Parent()
+	for child := n.FirstChild(); child != nil; {
+		next := child.NextSibling()
+		parent.InsertBefore(parent, n, child)
+		child = next
+	}
+	parent.RemoveChild(parent, n)
+}
@@ -0,0 +1,86 @@
+package markdown
+
+import (
+	"strings"
+	"testing"
+)
+
+// allowOwnImages allows images from /files/ only.
+func allowOwnImages(destination string) bool {
+	return strings.HasPrefix(destination, "/files/")
+}
+
+func TestRenderer_HTML(t *testing.T) {
+	r := NewRenderer(allowOwnImages)
+
+	tests := []struct {
+		name    string
+		source  string
+		want    []string
+		notWant []string
+	}{
+		{
+			name:   "formatting",
+			source: "**3月1日** 入职\n\n- 拖欠工资\n- ~~加班费~~\n\n> 领导原话",
+			want:   []string{"<strong>3月1日</strong>", "<li>拖欠工资</li>", "<del>加班费</del>", "<blockquote>"},
+		},
+		{
+			name:   "line breaks are kept",
+			source: "第一行\n第二行",
+			want:   []string{"第一行<br>\n第二行"},
+		},
+		{
+			name:    "raw html is dropped",
+			source:  "正文<script>alert(1)</script>\n\n<div onclick=\"x()\">块</div>",
+			want:    []string{"正文alert(1)"},
+			notWant: []string{"<script", "<div", "onclick", "raw HTML"},
+		},
+		{
+			name:   "links are nofollow",
+			source: "[证据](https://example.com/a) 以及 https://example.com/b",
+			want: []string{
+				`<a href="https://example.com/a" rel="` + LinkRel + `">证据</a>`,
+				`<a href="https://example.com/b" rel="` + LinkRel + `">https://example.com/b</a>`,
+			},
+		},
+		{
+			name:    "dangerous links are unwrapped",
+			source:  "[点我](javascript:alert(1)) <javascript:alert(2)> [协议相对](//evil.example)",
+			want:    []string{"点我", "javascript:alert(2)", "协议相对"},
+			notWant: []string{"<a", "href"},
+		},
+		{
+			name:    "only allowed images",
+			source:  "![截图](/files/1.png) ![外链](https://evil.example/track.png)",
+			want:    []string{`<img src="/files/1.png" alt="截图">`, "外链"},
+			notWant: []string{"evil.example"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := r.HTML(tt.source)
+			for _, want := range tt.want {
+				if !strings.Contains(got, want) {
+					t.Errorf("HTML() = %q, want it to contain %q", got, want)
+				}
+			}
+			for _, notWant := range tt.notWant {
+				if strings.Contains(got, notWant) {
+					t.Errorf("HTML() = %q, want it not to contain %q", got, notWant)
+				}
+			}
+		})
+	}
+}
+
+func TestRenderer_PlainText(t *testing.T) {
+	r := NewRenderer(allowOwnImages)
+
+	source := "## 经过\n\n**3月1日** 入职，[合同](https://example.com)没签。\n\n- 拖欠工资\n- 无加班费\n\n![截图](/files/1.png)<b>x</b>"
+	want := "经过\n3月1日 入职，合同没签。\n拖欠工资\n无加班费\n截图x"
+
+	if got := r.PlainText(source); got != want {
+		t.Errorf("PlainText() = %q, want %q", got, want)
+	}
+}
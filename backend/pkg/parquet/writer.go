@@ -0,0 +1,205 @@
+// Package parquet writes flat tables as Apache Parquet files.
+//
+// Only what dataset exports need is supported: flat schemas of string, int64,
+// timestamp and boolean columns, which may be optional (nullable). Encoding is
+// left to github.com/parquet-go/parquet-go; this package keeps the columns in
+// the order they are declared and checks values against their column types.
+package parquet
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"time"
+
+	parquetgo "github.com/parquet-go/parquet-go"
+)
+
+// Type is the type of a column.
+type Type int
+
+const (
+	// String is a UTF-8 string (BYTE_ARRAY with the STRING logical type).
+	String Type = iota
+
+	// Int64 is a signed 64-bit integer.
+	Int64
+
+	// Timestamp is a point in time with millisecond precision
+	// (INT64 with the TIMESTAMP(MILLIS, UTC) logical type).
+	Timestamp
+
+	// Boolean is a boolean.
+	Boolean
+)
+
+// DefaultRowGroupSize is the number of rows buffered per row group.
+const DefaultRowGroupSize = 10000
+
+// ErrClosed is returned when writing to a closed Writer.
+var ErrClosed = errors.New("parquet: writer is closed")
+
+// Column describes a column of the table.
+type Column struct {
+	// Name is the column name.
+	Name string
+
+	// Type is the column type.
+	Type Type
+
+	// Optional allows nil values.
+	Optional bool
+}
+
+// Writer writes rows to a Parquet file. Rows are buffered in memory until a
+// row group is full; Close writes the last row group and the file footer.
+type Writer struct {
+	w       *parquetgo.Writer
+	columns []Column
+	closed  bool
+}
+
+// NewWriter returns a Writer of the columns to w with rowGroupSize rows per
+// row group (DefaultRowGroupSize if not positive). Pages are GZIP compressed.
+func NewWriter(w io.Writer, columns []Column, rowGroupSize int) (*Writer, error) {
+	if len(columns) == 0 {
+		return nil, errors.New("parquet: no columns")
+	}
+	root := table{fields: make([]parquetgo.Field, len(columns))}
+	for i, c := range columns {
+		if c.Name == "" {
+			return nil, errors.New("parquet: column name is empty")
+		}
+		node, err := c.node()
+		if err != nil {
+			return nil, err
+		}
+		root.fields[i] = field{Node: node, name: c.Name}
+	}
+	if rowGroupSize <= 0 {
+		rowGroupSize = DefaultRowGroupSize
+	}
+
+	config, err := parquetgo.NewWriterConfig(
+		parquetgo.NewSchema("schema", root),
+		parquetgo.MaxRowsPerRowGroup(int64(rowGroupSize)),
+		parquetgo.Compression(&parquetgo.Gzip),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("parquet: %w", err)
+	}
+	return &Writer{w: parquetgo.NewWriter(w, config), columns: columns}, nil
+}
+
+// Write adds a row. Values must match the column types: string, int64,
+// time.Time or bool, or nil for optional columns.
+func (w *Writer) Write(row []interface{}) error {
+	if w.closed {
+		return ErrClosed
+	}
+	if len(row) != len(w.columns) {
+		return fmt.Errorf("parquet: row has %d values, want %d", len(row), len(w.columns))
+	}
+
+	values := make(parquetgo.Row, len(row))
+	for i, v := range row {
+		value, err := w.columns[i].value(v)
+		if err != nil {
+			return err
+		}
+		definitionLevel := 0
+		if w.columns[i].Optional && v != nil {
+			definitionLevel = 1
+		}
+		values[i] = value.Level(0, definitionLevel, i)
+	}
+	_, err := w.w.WriteRows([]parquetgo.Row{values})
+	return err
+}
+
+// Close writes the buffered rows and the file footer.
+// It does not close the underlying writer.
+func (w *Writer) Close() error {
+	if w.closed {
+		return ErrClosed
+	}
+	w.closed = true
+	return w.w.Close()
+}
+
+// node returns the schema node of the column.
+func (c Column) node() (parquetgo.Node, error) {
+	var node parquetgo.Node
+	switch c.Type {
+	case String:
+		node = parquetgo.String()
+	case Int64:
+		node = parquetgo.Int(64)
+	case Timestamp:
+		node = parquetgo.Timestamp(parquetgo.Millisecond)
+	case Boolean:
+		node = parquetgo.Leaf(parquetgo.BooleanType)
+	default:
+		return nil, fmt.Errorf("parquet: column %s has unknown type %d", c.Name, c.Type)
+	}
+	if c.Optional {
+		node = parquetgo.Optional(node)
+	}
+	return node, nil
+}
+
+// value converts v to a value of the column, or returns an error if v is not
+// a valid value of the column.
+func (c Column) value(v interface{}) (parquetgo.Value, error) {
+	if v == nil {
+		if !c.Optional {
+			return parquetgo.Value{}, fmt.Errorf("parquet: column %s is required", c.Name)
+		}
+		return parquetgo.NullValue(), nil
+	}
+
+	switch c.Type {
+	case String:
+		if s, ok := v.(string); ok {
+			return parquetgo.ByteArrayValue([]byte(s)), nil
+		}
+	case Int64:
+		if n, ok := v.(int64); ok {
+			return parquetgo.Int64Value(n), nil
+		}
+	case Timestamp:
+		if t, ok := v.(time.Time); ok {
+			return parquetgo.Int64Value(t.UnixMilli()), nil
+		}
+	case Boolean:
+		if b, ok := v.(bool); ok {
+			return parquetgo.BooleanValue(b), nil
+		}
+	}
+	return parquetgo.Value{}, fmt.Errorf("parquet: column %s got %T", c.Name, v)
+}
+
+// table is the root of the schema. parquetgo.Group orders its fields by name;
+// table keeps the columns in the order they were declared.
+type table struct {
+	parquetgo.Group
+	fields []parquetgo.Field
+}
+
+// Fields implements parquetgo.Node.
+func (t table) Fields() []parquetgo.Field { return t.fields }
+
+// field is a named column of the table.
+type field struct {
+	parquetgo.Node
+	name string
+}
+
+// Name implements parquetgo.Field.
+func (f field) Name() string { return f.name }
+
+// Value implements parquetgo.Field for rows held in a map keyed by column name.
+func (f field) Value(base reflect.Value) reflect.Value {
+	return base.MapIndex(reflect.ValueOf(f.name))
+}
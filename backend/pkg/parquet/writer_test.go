@@ -0,0 +1,155 @@
+package parquet
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	parquetgo "github.com/parquet-go/parquet-go"
+)
+
+// readFile opens a written file with parquet-go and returns its schema and
+// rows, each value converted to string, int64, bool or nil.
+func readFile(t *testing.T, file []byte) (*parquetgo.Schema, [][]interface{}) {
+	t.Helper()
+	f, err := parquetgo.OpenFile(bytes.NewReader(file), int64(len(file)))
+	if err != nil {
+		t.Fatalf("OpenFile() error = %v", err)
+	}
+
+	r := parquetgo.NewReader(f)
+	defer r.Close()
+
+	var rows [][]interface{}
+	buf := make([]parquetgo.Row, 4)
+	for {
+		n, err := r.ReadRows(buf)
+		for _, row := range buf[:n] {
+			values := make([]interface{}, len(row))
+			for i, v := range row {
+				switch {
+				case v.IsNull():
+					values[i] = nil
+				case v.Kind() == parquetgo.ByteArray:
+					values[i] = v.String()
+				case v.Kind() == parquetgo.Int64:
+					values[i] = v.Int64()
+				case v.Kind() == parquetgo.Boolean:
+					values[i] = v.Boolean()
+				}
+			}
+			rows = append(rows, values)
+		}
+		if errors.Is(err, io.EOF) {
+			return f.Schema(), rows
+		}
+		if err != nil {
+			t.Fatalf("ReadRows() error = %v", err)
+		}
+	}
+}
+
+func TestWriter_RoundTrip(t *testing.T) {
+	columns := []Column{
+		{Name: "id", Type: String},
+		{Name: "created_at", Type: Timestamp},
+		{Name: "note", Type: String, Optional: true},
+		{Name: "count", Type: Int64},
+		{Name: "flag", Type: Boolean, Optional: true},
+	}
+
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, columns, 3)
+	if err != nil {
+		t.Fatalf("NewWriter() error = %v", err)
+	}
+
+	created := time.Date(2026, 1, 2, 3, 4, 5, 6e6, time.UTC)
+	var want [][]interface{}
+	for i := 0; i < 10; i++ {
+		var note, flag interface{}
+		if i%3 != 0 {
+			note = strings.Repeat("拖欠", i)
+		}
+		if i%4 != 1 {
+			flag = i%2 == 0
+		}
+		if err := w.Write([]interface{}{fmt.Sprintf("post-%d", i), created, note, int64(i - 5), flag}); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+		want = append(want, []interface{}{fmt.Sprintf("post-%d", i), created.UnixMilli(), note, int64(i - 5), flag})
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	f, err := parquetgo.OpenFile(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("OpenFile() error = %v", err)
+	}
+	if got := len(f.RowGroups()); got != 4 {
+		t.Errorf("row groups = %d, want 4", got)
+	}
+
+	schema, rows := readFile(t, buf.Bytes())
+	wantSchema := `message schema {
+	required binary id (STRING);
+	required int64 created_at (TIMESTAMP(isAdjustedToUTC=true,unit=MILLIS));
+	optional binary note (STRING);
+	required int64 count (INT(64,true));
+	optional boolean flag;
+}`
+	if got := schema.String(); got != wantSchema {
+		t.Errorf("schema = %s, want %s", got, wantSchema)
+	}
+	if fmt.Sprint(rows) != fmt.Sprint(want) {
+		t.Errorf("rows = %v, want %v", rows, want)
+	}
+}
+
+func TestWriter_Empty(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, []Column{{Name: "id", Type: String}}, 0)
+	if err != nil {
+		t.Fatalf("NewWriter() error = %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	if _, rows := readFile(t, buf.Bytes()); len(rows) != 0 {
+		t.Errorf("rows = %v, want none", rows)
+	}
+}
+
+func TestNewWriter_Invalid(t *testing.T) {
+	for _, columns := range [][]Column{nil, {{Type: String}}, {{Name: "id", Type: Type(9)}}} {
+		if _, err := NewWriter(io.Discard, columns, 0); err == nil {
+			t.Errorf("NewWriter(%v) error = nil, want error", columns)
+		}
+	}
+}
+
+func TestWriter_Write_Invalid(t *testing.T) {
+	w, err := NewWriter(io.Discard, []Column{{Name: "id", Type: String}}, 0)
+	if err != nil {
+		t.Fatalf("NewWriter() error = %v", err)
+	}
+
+	for _, row := range [][]interface{}{{nil}, {int64(1)}, {"a", "b"}} {
+		if err := w.Write(row); err == nil {
+			t.Errorf("Write(%v) error = nil, want error", row)
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	if err := w.Write([]interface{}{"a"}); err != ErrClosed {
+		t.Errorf("Write() after Close error = %v, want ErrClosed", err)
+	}
+}
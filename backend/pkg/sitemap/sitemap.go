@@ -0,0 +1,122 @@
+// Package sitemap encodes sitemaps and sitemap indexes (sitemaps.org protocol 0.9).
+package sitemap
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// MaxURLs is the maximum number of URLs in one sitemap file.
+const MaxURLs = 50000
+
+// namespace is the XML namespace of sitemaps and sitemap indexes.
+const namespace = "http://www.sitemaps.org/schemas/sitemap/0.9"
+
+// ErrTooManyURLs is returned by URLSetWriter.Add after MaxURLs URLs.
+var ErrTooManyURLs = errors.New("sitemap: too many URLs")
+
+// URL is an entry of a sitemap.
+type URL struct {
+	// Loc is the absolute URL of the page.
+	Loc string
+
+	// LastMod is when the page was last modified (omitted if zero).
+	LastMod time.Time
+}
+
+// Sitemap is an entry of a sitemap index.
+type Sitemap struct {
+	// Loc is the absolute URL of the sitemap file.
+	Loc string
+
+	// LastMod is when the sitemap was last modified (omitted if zero).
+	LastMod time.Time
+}
+
+// xmlEntry is a <url> or <sitemap> element.
+type xmlEntry struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+// URLSetWriter writes a sitemap one URL at a time, so large sitemaps are
+// never held in memory as a whole.
+type URLSetWriter struct {
+	enc   *xml.Encoder
+	count int
+}
+
+// NewURLSetWriter writes the XML declaration and the opening <urlset> to w.
+func NewURLSetWriter(w io.Writer) (*URLSetWriter, error) {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return nil, err
+	}
+	enc := xml.NewEncoder(w)
+	start := xml.StartElement{
+		Name: xml.Name{Local: "urlset"},
+		Attr: []xml.Attr{{Name: xml.Name{Local: "xmlns"}, Value: namespace}},
+	}
+	if err := enc.EncodeToken(start); err != nil {
+		return nil, err
+	}
+	return &URLSetWriter{enc: enc}, nil
+}
+
+// Add writes a <url> element.
+// Returns ErrTooManyURLs if the sitemap already has MaxURLs URLs.
+func (s *URLSetWriter) Add(u URL) error {
+	if s.count >= MaxURLs {
+		return ErrTooManyURLs
+	}
+	if err := s.enc.EncodeElement(newEntry(u.Loc, u.LastMod), xml.StartElement{Name: xml.Name{Local: "url"}}); err != nil {
+		return fmt.Errorf("sitemap: failed to encode url: %w", err)
+	}
+	s.count++
+	return nil
+}
+
+// Count returns the number of URLs written.
+func (s *URLSetWriter) Count() int {
+	return s.count
+}
+
+// Close writes the closing </urlset> and flushes. It does not close the
+// underlying writer.
+func (s *URLSetWriter) Close() error {
+	if err := s.enc.EncodeToken(xml.EndElement{Name: xml.Name{Local: "urlset"}}); err != nil {
+		return err
+	}
+	return s.enc.Flush()
+}
+
+// WriteIndex writes a sitemap index listing the sitemaps.
+func WriteIndex(w io.Writer, sitemaps []Sitemap) error {
+	index := struct {
+		XMLName  xml.Name   `xml:"sitemapindex"`
+		XMLNS    string     `xml:"xmlns,attr"`
+		Sitemaps []xmlEntry `xml:"sitemap"`
+	}{XMLNS: namespace}
+	for _, s := range sitemaps {
+		index.Sitemaps = append(index.Sitemaps, newEntry(s.Loc, s.LastMod))
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	if err := xml.NewEncoder(w).Encode(index); err != nil {
+		return fmt.Errorf("sitemap: failed to encode index: %w", err)
+	}
+	return nil
+}
+
+// newEntry builds an entry with the modification time in W3C Datetime format.
+func newEntry(loc string, lastMod time.Time) xmlEntry {
+	entry := xmlEntry{Loc: loc}
+	if !lastMod.IsZero() {
+		entry.LastMod = lastMod.UTC().Format(time.RFC3339)
+	}
+	return entry
+}
@@ -0,0 +1,97 @@
+package sitemap
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestURLSetWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewURLSetWriter(&buf)
+	if err != nil {
+		t.Fatalf("NewURLSetWriter() error = %v", err)
+	}
+
+	modified := time.Date(2026, 1, 2, 3, 4, 5, 0, time.FixedZone("CST", 8*3600))
+	if err := w.Add(URL{Loc: "https://example.com/p/1?a=1&b=2", LastMod: modified}); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	if err := w.Add(URL{Loc: "https://example.com/p/2"}); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	if got := w.Count(); got != 2 {
+		t.Errorf("Count() = %d, want 2", got)
+	}
+
+	out := buf.String()
+	for _, want := range []string{
+		`<?xml version="1.0" encoding="UTF-8"?>`,
+		`<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">`,
+		`<loc>https://example.com/p/1?a=1&amp;b=2</loc><lastmod>2026-01-01T19:04:05Z</lastmod>`,
+		`<url><loc>https://example.com/p/2</loc></url>`,
+		`</urlset>`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("sitemap does not contain %q:\n%s", want, out)
+		}
+	}
+
+	var parsed struct {
+		URLs []struct {
+			Loc string `xml:"loc"`
+		} `xml:"url"`
+	}
+	if err := xml.Unmarshal(buf.Bytes(), &parsed); err != nil {
+		t.Fatalf("sitemap is not valid XML: %v", err)
+	}
+	if len(parsed.URLs) != 2 {
+		t.Errorf("parsed %d urls, want 2", len(parsed.URLs))
+	}
+}
+
+func TestURLSetWriter_TooManyURLs(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewURLSetWriter(&buf)
+	if err != nil {
+		t.Fatalf("NewURLSetWriter() error = %v", err)
+	}
+	for i := 0; i < MaxURLs; i++ {
+		if err := w.Add(URL{Loc: "https://example.com/"}); err != nil {
+			t.Fatalf("Add() #%d error = %v", i, err)
+		}
+	}
+
+	if err := w.Add(URL{Loc: "https://example.com/"}); !errors.Is(err, ErrTooManyURLs) {
+		t.Errorf("Add() error = %v, want ErrTooManyURLs", err)
+	}
+}
+
+func TestWriteIndex(t *testing.T) {
+	var buf bytes.Buffer
+	err := WriteIndex(&buf, []Sitemap{
+		{Loc: "https://example.com/sitemap-1.xml.gz", LastMod: time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{Loc: "https://example.com/sitemap-2.xml.gz"},
+	})
+	if err != nil {
+		t.Fatalf("WriteIndex() error = %v", err)
+	}
+
+	out := buf.String()
+	for _, want := range []string{
+		`<sitemapindex xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">`,
+		`<sitemap><loc>https://example.com/sitemap-1.xml.gz</loc><lastmod>2026-01-02T00:00:00Z</lastmod></sitemap>`,
+		`<sitemap><loc>https://example.com/sitemap-2.xml.gz</loc></sitemap>`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("index does not contain %q:\n%s", want, out)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+// Package textseg splits mixed Chinese and Latin text into index tokens.
+//
+// There is no dictionary: runs of Han characters are split into overlapping
+// bigrams ("拖欠工资" -> "拖欠", "欠工", "工资"), which is the usual dictionary-free
+// segmentation for Chinese text similarity. Runs of other letters and digits
+// become lowercase words.
+package textseg
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Normalize folds full-width ASCII variants (e.g. "ＡＢＣ１２３") and the
+// ideographic space to their ASCII forms and lowercases the result.
+func Normalize(s string) string {
+	return strings.Map(func(r rune) rune {
+		return unicode.ToLower(fold(r))
+	}, s)
+}
+
+// Segment returns the tokens of text in order, with repetitions.
+// Latin words shorter than two characters are dropped; a Han run of a single
+// character is kept as a one-character token.
+func Segment(text string) []string {
+	var tokens []string
+	var run []rune
+	han := false
+
+	flush := func() {
+		switch {
+		case len(run) == 0:
+		case han && len(run) == 1:
+			tokens = append(tokens, string(run))
+		case han:
+			for i := 0; i+1 < len(run); i++ {
+				tokens = append(tokens, string(run[i:i+2]))
+			}
+		case len(run) >= 2:
+			tokens = append(tokens, string(run))
+		}
+		run = run[:0]
+	}
+
+	for _, r := range Normalize(text) {
+		switch {
+		case unicode.Is(unicode.Han, r):
+			if !han {
+				flush()
+				han = true
+			}
+			run = append(run, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if han {
+				flush()
+				han = false
+			}
+			run = append(run, r)
+		default:
+			flush()
+		}
+	}
+	flush()
+
+	return tokens
+}
+
+// fold maps a full-width ASCII variant to its ASCII form.
+func fold(r rune) rune {
+	switch {
+	case r >= 0xFF01 && r <= 0xFF5E:
+		return r - 0xFEE0
+	case r == 0x3000:
+		return ' '
+	default:
+		return r
+	}
+}
@@ -0,0 +1,59 @@
+package textseg
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	if got, want := Normalize("ＡＢＣ　１２３ Go"), "abc 123 go"; got != want {
+		t.Errorf("Normalize() = %q, want %q", got, want)
+	}
+}
+
+func TestSegment(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{
+			name: "han bigrams",
+			text: "拖欠工资",
+			want: []string{"拖欠", "欠工", "工资"},
+		},
+		{
+			name: "single han character",
+			text: "惨！",
+			want: []string{"惨"},
+		},
+		{
+			name: "mixed scripts and punctuation",
+			text: "996加班，HR说No。",
+			want: []string{"996", "加班", "hr", "说", "no"},
+		},
+		{
+			name: "short latin words dropped",
+			text: "a b ok",
+			want: []string{"ok"},
+		},
+		{
+			name: "full-width letters",
+			text: "ＯＫ",
+			want: []string{"ok"},
+		},
+		{
+			name: "empty",
+			text: "  ...  ",
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Segment(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Segment(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
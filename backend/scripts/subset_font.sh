@@ -0,0 +1,50 @@
+#!/usr/bin/env bash
+# Subsets CJK fonts for the share card renderer: ASCII, the GB2312 character
+# set and common Chinese punctuation. The subsets are written to
+# internal/infrastructure/imaging/fonts and embedded into the server binary.
+#
+# Usage: ./scripts/subset_font.sh FONT.otf [FONT.otf ...]
+# Requires pyftsubset (pip install fonttools).
+
+set -euo pipefail
+
+if [ $# -eq 0 ]; then
+    echo "usage: $0 FONT.otf [FONT.otf ...]" >&2
+    exit 1
+fi
+
+if ! command -v pyftsubset >/dev/null 2>&1; then
+    echo "pyftsubset not found, install it with: pip install fonttools" >&2
+    exit 1
+fi
+
+out_dir="$(cd "$(dirname "$0")/.." && pwd)/internal/infrastructure/imaging/fonts"
+chars="$(mktemp)"
+trap 'rm -f "$chars"' EXIT
+
+# All characters of GB2312 (rows 0xA1-0xF7): full-width symbols and 6763 hanzi
+python3 - > "$chars" <<'PY'
+import sys
+
+chars = set()
+for hi in range(0xA1, 0xF8):
+    for lo in range(0xA1, 0xFF):
+        try:
+            chars.add(bytes([hi, lo]).decode("gb2312"))
+        except UnicodeDecodeError:
+            pass
+sys.stdout.write("".join(sorted(chars)))
+PY
+
+for font in "$@"; do
+    name="$(basename "${font%.*}")"
+    ext="${font##*.}"
+    output="$out_dir/$name-Subset.$ext"
+    pyftsubset "$font" \
+        --text-file="$chars" \
+        --unicodes="U+0020-007E,U+00B7,U+2014,U+2018-201D,U+2026,U+3000-303F,U+FF01-FF5E" \
+        --layout-features='' \
+        --no-hinting \
+        --output-file="$output"
+    echo "wrote $output ($(du -h "$output" | cut -f1))"
+done
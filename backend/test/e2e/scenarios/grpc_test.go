@@ -141,6 +141,8 @@ func (s *GRPCE2ETestSuite) SetupSuite() {
 		s.postRepo,
 		s.cacheRepo,
 		s.rateLimiter,
+		nil,
+		nil,
 	)
 	listUseCase := content.NewListPostsUseCase(
 		s.postRepo,
@@ -154,6 +156,13 @@ func (s *GRPCE2ETestSuite) SetupSuite() {
 		s.postRepo,
 		s.cacheRepo,
 	)
+	followUpUseCase := content.NewAppendFollowUpUseCase(
+		s.postRepo,
+		s.cacheRepo,
+	)
+	listMyPostsUseCase := content.NewListMyPostsUseCase(
+		s.postRepo,
+	)
 
 	// Create gRPC service
 	contentService := grpchandler.NewContentService(
@@ -161,6 +170,12 @@ func (s *GRPCE2ETestSuite) SetupSuite() {
 		listUseCase,
 		getUseCase,
 		searchUseCase,
+		followUpUseCase,
+		listMyPostsUseCase,
+		nil,
+		nil,
+		nil,
+		nil,
 	)
 
 	// Create gRPC server with middleware
@@ -538,6 +553,11 @@ func (s *GRPCE2ETestSuite) runMigrations() error {
 		return fmt.Errorf("failed to create posts table: %w", err)
 	}
 
+	// Apply versioned migrations (follow-ups etc.)
+	if err := postgres.Migrate(ctx, s.db); err != nil {
+		return fmt.Errorf("failed to apply migrations: %w", err)
+	}
+
 	return nil
 }
 
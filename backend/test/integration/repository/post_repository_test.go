@@ -129,8 +129,12 @@ func (s *PostRepositoryTestSuite) runMigrations() error {
 		);
 	`
 
-	_, err := s.db.ExecContext(ctx, migrationSQL)
-	return err
+	if _, err := s.db.ExecContext(ctx, migrationSQL); err != nil {
+		return err
+	}
+
+	// Apply versioned migrations (follow-ups etc.)
+	return postgres.Migrate(ctx, s.db)
 }
 
 // TestPostRepository_Save tests the Save method.
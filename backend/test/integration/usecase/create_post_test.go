@@ -70,7 +70,7 @@ func (s *CreatePostUseCaseTestSuite) SetupSuite() {
 	rateLimiter := redis.NewRateLimiter(s.redisClient)
 
 	// Create use case
-	s.useCase = content.NewCreatePostUseCase(postRepo, cacheRepo, rateLimiter)
+	s.useCase = content.NewCreatePostUseCase(postRepo, cacheRepo, rateLimiter, nil, nil)
 
 	// Create context
 	s.ctx = context.Background()
@@ -218,6 +218,11 @@ func (s *CreatePostUseCaseTestSuite) runMigrations() error {
 		return fmt.Errorf("failed to create posts table: %w", err)
 	}
 
+	// Apply versioned migrations (follow-ups etc.)
+	if err := postgres.Migrate(ctx, s.db); err != nil {
+		return fmt.Errorf("failed to apply migrations: %w", err)
+	}
+
 	return nil
 }
 
@@ -219,6 +219,11 @@ func (s *GetPostUseCaseTestSuite) runMigrations() error {
 		return fmt.Errorf("failed to create posts table: %w", err)
 	}
 
+	// Apply versioned migrations (follow-ups etc.)
+	if err := postgres.Migrate(ctx, s.db); err != nil {
+		return fmt.Errorf("failed to apply migrations: %w", err)
+	}
+
 	return nil
 }
 
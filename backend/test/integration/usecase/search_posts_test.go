@@ -73,7 +73,7 @@ func (s *SearchPostsUseCaseTestSuite) SetupSuite() {
 
 	// Create use cases
 	s.useCase = appsearch.NewSearchPostsUseCase(postRepo, cacheRepo)
-	s.createUseCase = appcontent.NewCreatePostUseCase(postRepo, cacheRepo, rateLimiter) // For seeding data
+	s.createUseCase = appcontent.NewCreatePostUseCase(postRepo, cacheRepo, rateLimiter, nil, nil) // For seeding data
 
 	// Create context
 	s.ctx = context.Background()
@@ -211,6 +211,11 @@ func (s *SearchPostsUseCaseTestSuite) runMigrations() error {
 		return fmt.Errorf("failed to create posts table: %w", err)
 	}
 
+	// Apply versioned migrations (follow-ups etc.)
+	if err := postgres.Migrate(ctx, s.db); err != nil {
+		return fmt.Errorf("failed to apply migrations: %w", err)
+	}
+
 	return nil
 }
 
@@ -0,0 +1,154 @@
+package admin_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"fuck_boss/backend/internal/application/admin"
+	domainadmin "fuck_boss/backend/internal/domain/admin"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// MockAuditRepository is a mock implementation of AuditRepository.
+type MockAuditRepository struct {
+	mock.Mock
+}
+
+func (m *MockAuditRepository) Append(ctx context.Context, event *domainadmin.AuditEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (m *MockAuditRepository) Find(ctx context.Context, filter domainadmin.AuditFilter, after *domainadmin.AuditCursor, limit int) ([]*domainadmin.AuditEvent, error) {
+	args := m.Called(ctx, filter, after, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domainadmin.AuditEvent), args.Error(1)
+}
+
+// newTestAuditEvents creates n audit events, newest first.
+func newTestAuditEvents(n int) []*domainadmin.AuditEvent {
+	events := make([]*domainadmin.AuditEvent, 0, n)
+	start := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	for i := 0; i < n; i++ {
+		event, _ := domainadmin.NewAuditEvent(testAPIKeyID, "ops", "/admin.v1.AdminService/ModeratePost", "", "OK", "10.0.0.1", "")
+		events = append(events, domainadmin.NewAuditEventFromDB(event.ID(), start.Add(-time.Duration(i)*time.Minute),
+			event.APIKeyID(), event.Actor(), event.Action(), event.Target(), event.Outcome(), event.ClientIP(), event.Details()))
+	}
+	return events
+}
+
+// TestRecordAuditEventUseCase_Execute_Success tests appending an event.
+func TestRecordAuditEventUseCase_Execute_Success(t *testing.T) {
+	mockRepo := new(MockAuditRepository)
+	uc := admin.NewRecordAuditEventUseCase(mockRepo)
+
+	ctx := context.Background()
+	mockRepo.On("Append", ctx, mock.MatchedBy(func(event *domainadmin.AuditEvent) bool {
+		return event.Actor() == "ops" && event.Target() == "post-1" && event.Outcome() == "PermissionDenied"
+	})).Return(nil)
+
+	err := uc.Execute(ctx, admin.RecordAuditEventCommand{
+		APIKeyID: testAPIKeyID,
+		Actor:    "ops",
+		Action:   "/admin.v1.AdminService/ModeratePost",
+		Target:   "post-1",
+		Outcome:  "PermissionDenied",
+	})
+
+	require.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+// TestRecordAuditEventUseCase_Execute_ValidationError tests that incomplete events are rejected.
+func TestRecordAuditEventUseCase_Execute_ValidationError(t *testing.T) {
+	mockRepo := new(MockAuditRepository)
+	uc := admin.NewRecordAuditEventUseCase(mockRepo)
+
+	err := uc.Execute(context.Background(), admin.RecordAuditEventCommand{Actor: "ops", Action: "admin hide-post"})
+
+	assert.True(t, apperrors.IsValidationError(err))
+	mockRepo.AssertNotCalled(t, "Append", mock.Anything, mock.Anything)
+}
+
+// TestListAuditEventsUseCase_Execute_Pages tests the page size and the next cursor.
+func TestListAuditEventsUseCase_Execute_Pages(t *testing.T) {
+	mockRepo := new(MockAuditRepository)
+	uc := admin.NewListAuditEventsUseCase(mockRepo)
+
+	ctx := context.Background()
+	since := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+	filter := domainadmin.AuditFilter{APIKeyID: testAPIKeyID, Action: "/admin.v1.AdminService/ModeratePost", Since: &since}
+	events := newTestAuditEvents(3)
+	mockRepo.On("Find", ctx, filter, (*domainadmin.AuditCursor)(nil), 3).Return(events, nil)
+
+	result, err := uc.Execute(ctx, admin.ListAuditEventsQuery{
+		APIKeyID: testAPIKeyID,
+		Action:   "/admin.v1.AdminService/ModeratePost",
+		Since:    &since,
+		PageSize: 2,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, result.Events, 2)
+	assert.Equal(t, events[0].ID(), result.Events[0].ID)
+	assert.Equal(t, domainadmin.AuditCursorAfter(events[1]).String(), result.NextCursor)
+
+	// The next page starts after the cursor
+	cursor, err := domainadmin.ParseAuditCursor(result.NextCursor)
+	require.NoError(t, err)
+	mockRepo.On("Find", ctx, domainadmin.AuditFilter{}, &cursor, 51).Return(events[2:], nil)
+
+	result, err = uc.Execute(ctx, admin.ListAuditEventsQuery{Cursor: result.NextCursor})
+
+	require.NoError(t, err)
+	assert.Len(t, result.Events, 1)
+	assert.Empty(t, result.NextCursor)
+}
+
+// TestListAuditEventsUseCase_Execute_ValidationError tests invalid filters.
+func TestListAuditEventsUseCase_Execute_ValidationError(t *testing.T) {
+	since := time.Now()
+	until := since.Add(-time.Hour)
+	tests := []struct {
+		name  string
+		query admin.ListAuditEventsQuery
+	}{
+		{name: "invalid API key ID", query: admin.ListAuditEventsQuery{APIKeyID: "ops"}},
+		{name: "since after until", query: admin.ListAuditEventsQuery{Since: &since, Until: &until}},
+		{name: "invalid cursor", query: admin.ListAuditEventsQuery{Cursor: "not-a-cursor"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockAuditRepository)
+			uc := admin.NewListAuditEventsUseCase(mockRepo)
+
+			result, err := uc.Execute(context.Background(), tt.query)
+
+			assert.Nil(t, result)
+			assert.True(t, apperrors.IsValidationError(err))
+			mockRepo.AssertNotCalled(t, "Find", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+// TestListAuditEventsUseCase_Execute_RepositoryError tests repository failures.
+func TestListAuditEventsUseCase_Execute_RepositoryError(t *testing.T) {
+	mockRepo := new(MockAuditRepository)
+	uc := admin.NewListAuditEventsUseCase(mockRepo)
+
+	ctx := context.Background()
+	mockRepo.On("Find", ctx, mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("connection refused"))
+
+	_, err := uc.Execute(ctx, admin.ListAuditEventsQuery{})
+
+	assert.True(t, apperrors.IsDatabaseError(err))
+}
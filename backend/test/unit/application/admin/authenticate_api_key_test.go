@@ -0,0 +1,118 @@
+package admin_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"fuck_boss/backend/internal/application/admin"
+	domainadmin "fuck_boss/backend/internal/domain/admin"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// TestAuthenticateAPIKeyUseCase_Execute_Success tests accepting a key and tracking its use.
+func TestAuthenticateAPIKeyUseCase_Execute_Success(t *testing.T) {
+	mockRepo := new(MockAPIKeyRepository)
+	uc := admin.NewAuthenticateAPIKeyUseCase(mockRepo)
+
+	ctx := context.Background()
+	mockRepo.On("FindByHash", ctx, domainadmin.HashAPIKey("fbk_test")).Return(newTestAPIKey(nil, domainadmin.ScopeModerate), nil)
+	mockRepo.On("TouchLastUsed", ctx, testAPIKeyID, mock.AnythingOfType("time.Time")).Return(nil)
+
+	result, err := uc.Execute(ctx, admin.AuthenticateAPIKeyCommand{Key: " fbk_test ", Scope: "moderate"})
+
+	require.NoError(t, err)
+	assert.Equal(t, testAPIKeyID, result.ID)
+	assert.NotNil(t, result.LastUsedAt)
+	mockRepo.AssertExpectations(t)
+}
+
+// TestAuthenticateAPIKeyUseCase_Execute_RecentlyUsed tests that the last-used time is not written on every call.
+func TestAuthenticateAPIKeyUseCase_Execute_RecentlyUsed(t *testing.T) {
+	mockRepo := new(MockAPIKeyRepository)
+	uc := admin.NewAuthenticateAPIKeyUseCase(mockRepo)
+
+	ctx := context.Background()
+	lastUsedAt := time.Now().Add(-time.Second)
+	key := domainadmin.NewAPIKeyFromDB(testAPIKeyID, "ops", "fbk_abcdefgh", domainadmin.HashAPIKey("fbk_test"),
+		[]domainadmin.Scope{domainadmin.ScopeAdmin}, nil, time.Now().Add(-time.Hour), &lastUsedAt, nil)
+	mockRepo.On("FindByHash", ctx, mock.Anything).Return(key, nil)
+
+	_, err := uc.Execute(ctx, admin.AuthenticateAPIKeyCommand{Key: "fbk_test", Scope: "admin"})
+
+	require.NoError(t, err)
+	mockRepo.AssertNotCalled(t, "TouchLastUsed", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestAuthenticateAPIKeyUseCase_Execute_ScopeNotGranted tests that a key without the scope is
+// forbidden but still returned, so the call can be audited.
+func TestAuthenticateAPIKeyUseCase_Execute_ScopeNotGranted(t *testing.T) {
+	mockRepo := new(MockAPIKeyRepository)
+	uc := admin.NewAuthenticateAPIKeyUseCase(mockRepo)
+
+	ctx := context.Background()
+	mockRepo.On("FindByHash", ctx, mock.Anything).Return(newTestAPIKey(nil, domainadmin.ScopeExport), nil)
+
+	result, err := uc.Execute(ctx, admin.AuthenticateAPIKeyCommand{Key: "fbk_test", Scope: "admin"})
+
+	assert.True(t, apperrors.IsForbiddenError(err))
+	require.NotNil(t, result)
+	assert.Equal(t, testAPIKeyID, result.ID)
+	mockRepo.AssertNotCalled(t, "TouchLastUsed", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestAuthenticateAPIKeyUseCase_Execute_Unauthenticated tests missing, unknown, revoked and expired keys.
+func TestAuthenticateAPIKeyUseCase_Execute_Unauthenticated(t *testing.T) {
+	ctx := context.Background()
+	revokedAt := time.Now().Add(-time.Minute)
+	expiresAt := time.Now().Add(-time.Minute)
+	expired := domainadmin.NewAPIKeyFromDB(testAPIKeyID, "ops", "fbk_abcdefgh", "hash",
+		[]domainadmin.Scope{domainadmin.ScopeAdmin}, &expiresAt, time.Now().Add(-time.Hour), nil, nil)
+
+	tests := []struct {
+		name  string
+		key   string
+		found *domainadmin.APIKey
+	}{
+		{name: "missing", key: ""},
+		{name: "wrong prefix", key: "Bearer abc"},
+		{name: "unknown", key: "fbk_unknown"},
+		{name: "revoked", key: "fbk_test", found: newTestAPIKey(&revokedAt, domainadmin.ScopeAdmin)},
+		{name: "expired", key: "fbk_test", found: expired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockAPIKeyRepository)
+			uc := admin.NewAuthenticateAPIKeyUseCase(mockRepo)
+			if tt.found != nil {
+				mockRepo.On("FindByHash", ctx, mock.Anything).Return(tt.found, nil)
+			} else {
+				mockRepo.On("FindByHash", ctx, mock.Anything).Return(nil, apperrors.NewNotFoundError("API key"))
+			}
+
+			result, err := uc.Execute(ctx, admin.AuthenticateAPIKeyCommand{Key: tt.key, Scope: "admin"})
+
+			assert.Nil(t, result)
+			assert.True(t, apperrors.IsUnauthenticatedError(err))
+		})
+	}
+}
+
+// TestAuthenticateAPIKeyUseCase_Execute_RepositoryError tests that lookup failures are not reported as bad keys.
+func TestAuthenticateAPIKeyUseCase_Execute_RepositoryError(t *testing.T) {
+	mockRepo := new(MockAPIKeyRepository)
+	uc := admin.NewAuthenticateAPIKeyUseCase(mockRepo)
+
+	ctx := context.Background()
+	mockRepo.On("FindByHash", ctx, mock.Anything).Return(nil, errors.New("connection refused"))
+
+	_, err := uc.Execute(ctx, admin.AuthenticateAPIKeyCommand{Key: "fbk_test", Scope: "admin"})
+
+	assert.True(t, apperrors.IsDatabaseError(err))
+}
@@ -0,0 +1,125 @@
+package admin_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"fuck_boss/backend/internal/application/admin"
+	domainadmin "fuck_boss/backend/internal/domain/admin"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// MockAPIKeyRepository is a mock implementation of APIKeyRepository.
+type MockAPIKeyRepository struct {
+	mock.Mock
+}
+
+func (m *MockAPIKeyRepository) Save(ctx context.Context, key *domainadmin.APIKey) error {
+	args := m.Called(ctx, key)
+	return args.Error(0)
+}
+
+func (m *MockAPIKeyRepository) FindByID(ctx context.Context, id string) (*domainadmin.APIKey, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainadmin.APIKey), args.Error(1)
+}
+
+func (m *MockAPIKeyRepository) FindByHash(ctx context.Context, keyHash string) (*domainadmin.APIKey, error) {
+	args := m.Called(ctx, keyHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainadmin.APIKey), args.Error(1)
+}
+
+func (m *MockAPIKeyRepository) FindAll(ctx context.Context) ([]*domainadmin.APIKey, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domainadmin.APIKey), args.Error(1)
+}
+
+func (m *MockAPIKeyRepository) TouchLastUsed(ctx context.Context, id string, at time.Time) error {
+	args := m.Called(ctx, id, at)
+	return args.Error(0)
+}
+
+// TestCreateAPIKeyUseCase_Execute_Success tests issuing a key.
+func TestCreateAPIKeyUseCase_Execute_Success(t *testing.T) {
+	mockRepo := new(MockAPIKeyRepository)
+	uc := admin.NewCreateAPIKeyUseCase(mockRepo)
+
+	ctx := context.Background()
+	var saved *domainadmin.APIKey
+	mockRepo.On("Save", ctx, mock.AnythingOfType("*admin.APIKey")).Run(func(args mock.Arguments) {
+		saved = args.Get(1).(*domainadmin.APIKey)
+	}).Return(nil)
+
+	expiresAt := time.Now().Add(24 * time.Hour)
+	result, err := uc.Execute(ctx, admin.CreateAPIKeyCommand{
+		Name:      "nightly export",
+		Scopes:    []string{"EXPORT", "moderate"},
+		ExpiresAt: &expiresAt,
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, saved)
+	assert.True(t, strings.HasPrefix(result.Key, domainadmin.APIKeyPrefix))
+	assert.Equal(t, domainadmin.HashAPIKey(result.Key), saved.KeyHash())
+	assert.Equal(t, saved.ID(), result.ID)
+	assert.Equal(t, []string{"moderate", "export"}, result.Scopes)
+	assert.Equal(t, &expiresAt, result.ExpiresAt)
+	mockRepo.AssertExpectations(t)
+}
+
+// TestCreateAPIKeyUseCase_Execute_ValidationError tests that invalid keys are not saved.
+func TestCreateAPIKeyUseCase_Execute_ValidationError(t *testing.T) {
+	past := time.Now().Add(-time.Minute)
+	tests := []struct {
+		name string
+		cmd  admin.CreateAPIKeyCommand
+	}{
+		{name: "unknown scope", cmd: admin.CreateAPIKeyCommand{Name: "ops", Scopes: []string{"admin", "root"}}},
+		{name: "no scope", cmd: admin.CreateAPIKeyCommand{Name: "ops"}},
+		{name: "empty name", cmd: admin.CreateAPIKeyCommand{Scopes: []string{"admin"}}},
+		{name: "expired", cmd: admin.CreateAPIKeyCommand{Name: "ops", Scopes: []string{"admin"}, ExpiresAt: &past}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockAPIKeyRepository)
+			uc := admin.NewCreateAPIKeyUseCase(mockRepo)
+
+			result, err := uc.Execute(context.Background(), tt.cmd)
+
+			assert.Nil(t, result)
+			assert.True(t, apperrors.IsValidationError(err))
+			mockRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+// TestCreateAPIKeyUseCase_Execute_SaveError tests that the raw key is not returned if it was not saved.
+func TestCreateAPIKeyUseCase_Execute_SaveError(t *testing.T) {
+	mockRepo := new(MockAPIKeyRepository)
+	uc := admin.NewCreateAPIKeyUseCase(mockRepo)
+
+	ctx := context.Background()
+	mockRepo.On("Save", ctx, mock.Anything).Return(apperrors.NewDatabaseErrorWithCause("failed to save API key", errors.New("connection refused")))
+
+	result, err := uc.Execute(ctx, admin.CreateAPIKeyCommand{Name: "ops", Scopes: []string{"admin"}})
+
+	assert.Nil(t, result)
+	assert.True(t, apperrors.IsDatabaseError(err))
+}
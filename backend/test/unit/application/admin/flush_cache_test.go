@@ -0,0 +1,101 @@
+// Package admin_test provides unit tests for operator use cases.
+// These tests use mocked dependencies to isolate the use case logic.
+package admin_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"fuck_boss/backend/internal/application/admin"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// MockCacheRepository is a mock implementation of CacheRepository.
+type MockCacheRepository struct {
+	mock.Mock
+}
+
+func (m *MockCacheRepository) Get(ctx context.Context, key string) (string, error) {
+	args := m.Called(ctx, key)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockCacheRepository) Set(ctx context.Context, key string, value string, ttl time.Duration) error {
+	args := m.Called(ctx, key, value, ttl)
+	return args.Error(0)
+}
+
+func (m *MockCacheRepository) Delete(ctx context.Context, key string) error {
+	args := m.Called(ctx, key)
+	return args.Error(0)
+}
+
+func (m *MockCacheRepository) DeleteByPattern(ctx context.Context, pattern string) error {
+	args := m.Called(ctx, pattern)
+	return args.Error(0)
+}
+
+// TestFlushCacheUseCase_Execute_All tests flushing every namespace.
+func TestFlushCacheUseCase_Execute_All(t *testing.T) {
+	mockCache := new(MockCacheRepository)
+	uc := admin.NewFlushCacheUseCase(mockCache)
+
+	ctx := context.Background()
+	mockCache.On("DeleteByPattern", ctx, mock.Anything).Return(nil)
+
+	patterns, err := uc.Execute(ctx, admin.FlushCacheCommand{})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"post:*", "posts:*", "feed:*", "search:*", "related:*"}, patterns)
+	mockCache.AssertNumberOfCalls(t, "DeleteByPattern", len(admin.CacheNamespaces))
+}
+
+// TestFlushCacheUseCase_Execute_Selected tests flushing some namespaces.
+func TestFlushCacheUseCase_Execute_Selected(t *testing.T) {
+	mockCache := new(MockCacheRepository)
+	uc := admin.NewFlushCacheUseCase(mockCache)
+
+	ctx := context.Background()
+	mockCache.On("DeleteByPattern", ctx, "posts:*").Return(nil)
+	mockCache.On("DeleteByPattern", ctx, "search:*").Return(nil)
+
+	patterns, err := uc.Execute(ctx, admin.FlushCacheCommand{Namespaces: []string{"Search", "posts"}})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"posts:*", "search:*"}, patterns)
+	mockCache.AssertExpectations(t)
+}
+
+// TestFlushCacheUseCase_Execute_UnknownNamespace tests that nothing is flushed for an unknown namespace.
+func TestFlushCacheUseCase_Execute_UnknownNamespace(t *testing.T) {
+	mockCache := new(MockCacheRepository)
+	uc := admin.NewFlushCacheUseCase(mockCache)
+
+	patterns, err := uc.Execute(context.Background(), admin.FlushCacheCommand{Namespaces: []string{"post", "rate_limit"}})
+
+	require.Error(t, err)
+	assert.Nil(t, patterns)
+	assert.True(t, apperrors.IsValidationError(err))
+	mockCache.AssertNotCalled(t, "DeleteByPattern", mock.Anything, mock.Anything)
+}
+
+// TestFlushCacheUseCase_Execute_Error tests a failed flush.
+func TestFlushCacheUseCase_Execute_Error(t *testing.T) {
+	mockCache := new(MockCacheRepository)
+	uc := admin.NewFlushCacheUseCase(mockCache)
+
+	ctx := context.Background()
+	mockCache.On("DeleteByPattern", ctx, "post:*").Return(nil)
+	mockCache.On("DeleteByPattern", ctx, "posts:*").Return(errors.New("connection refused"))
+
+	patterns, err := uc.Execute(ctx, admin.FlushCacheCommand{})
+
+	require.Error(t, err)
+	assert.Equal(t, []string{"post:*"}, patterns)
+}
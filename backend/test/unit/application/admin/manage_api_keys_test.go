@@ -0,0 +1,94 @@
+package admin_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"fuck_boss/backend/internal/application/admin"
+	domainadmin "fuck_boss/backend/internal/domain/admin"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+const testAPIKeyID = "550e8400-e29b-41d4-a716-446655440000"
+
+// newTestAPIKey creates an API key as loaded from the database.
+func newTestAPIKey(revokedAt *time.Time, scopes ...domainadmin.Scope) *domainadmin.APIKey {
+	return domainadmin.NewAPIKeyFromDB(testAPIKeyID, "ops", "fbk_abcdefgh", domainadmin.HashAPIKey("fbk_test"),
+		scopes, nil, time.Now().Add(-time.Hour), nil, revokedAt)
+}
+
+// TestListAPIKeysUseCase_Execute tests listing keys.
+func TestListAPIKeysUseCase_Execute(t *testing.T) {
+	mockRepo := new(MockAPIKeyRepository)
+	uc := admin.NewListAPIKeysUseCase(mockRepo)
+
+	ctx := context.Background()
+	mockRepo.On("FindAll", ctx).Return([]*domainadmin.APIKey{newTestAPIKey(nil, domainadmin.ScopeExport)}, nil)
+
+	keys, err := uc.Execute(ctx)
+
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	assert.Equal(t, testAPIKeyID, keys[0].ID)
+	assert.Equal(t, []string{"export"}, keys[0].Scopes)
+	assert.Empty(t, keys[0].Key)
+}
+
+// TestRevokeAPIKeyUseCase_Execute_Success tests revoking an active key.
+func TestRevokeAPIKeyUseCase_Execute_Success(t *testing.T) {
+	mockRepo := new(MockAPIKeyRepository)
+	uc := admin.NewRevokeAPIKeyUseCase(mockRepo)
+
+	ctx := context.Background()
+	mockRepo.On("FindByID", ctx, testAPIKeyID).Return(newTestAPIKey(nil, domainadmin.ScopeAdmin), nil)
+	mockRepo.On("Save", ctx, mock.MatchedBy(func(key *domainadmin.APIKey) bool {
+		return key.IsRevoked()
+	})).Return(nil)
+
+	result, err := uc.Execute(ctx, admin.RevokeAPIKeyCommand{ID: testAPIKeyID})
+
+	require.NoError(t, err)
+	assert.NotNil(t, result.RevokedAt)
+	mockRepo.AssertExpectations(t)
+}
+
+// TestRevokeAPIKeyUseCase_Execute_AlreadyRevoked tests that revoking again keeps the revocation time.
+func TestRevokeAPIKeyUseCase_Execute_AlreadyRevoked(t *testing.T) {
+	mockRepo := new(MockAPIKeyRepository)
+	uc := admin.NewRevokeAPIKeyUseCase(mockRepo)
+
+	ctx := context.Background()
+	revokedAt := time.Now().Add(-time.Minute)
+	mockRepo.On("FindByID", ctx, testAPIKeyID).Return(newTestAPIKey(&revokedAt, domainadmin.ScopeAdmin), nil)
+
+	result, err := uc.Execute(ctx, admin.RevokeAPIKeyCommand{ID: testAPIKeyID})
+
+	require.NoError(t, err)
+	assert.Equal(t, &revokedAt, result.RevokedAt)
+	mockRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+}
+
+// TestRevokeAPIKeyUseCase_Execute_Errors tests invalid and unknown key IDs.
+func TestRevokeAPIKeyUseCase_Execute_Errors(t *testing.T) {
+	ctx := context.Background()
+
+	mockRepo := new(MockAPIKeyRepository)
+	uc := admin.NewRevokeAPIKeyUseCase(mockRepo)
+	_, err := uc.Execute(ctx, admin.RevokeAPIKeyCommand{ID: "fbk_abcdefgh"})
+	assert.True(t, apperrors.IsValidationError(err))
+	mockRepo.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+
+	mockRepo.On("FindByID", ctx, testAPIKeyID).Return(nil, apperrors.NewNotFoundError("API key")).Once()
+	_, err = uc.Execute(ctx, admin.RevokeAPIKeyCommand{ID: testAPIKeyID})
+	assert.True(t, apperrors.IsNotFoundError(err))
+
+	mockRepo.On("FindByID", ctx, testAPIKeyID).Return(nil, errors.New("connection refused")).Once()
+	_, err = uc.Execute(ctx, admin.RevokeAPIKeyCommand{ID: testAPIKeyID})
+	assert.True(t, apperrors.IsDatabaseError(err))
+}
@@ -0,0 +1,93 @@
+package admin_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"fuck_boss/backend/internal/application/admin"
+	"fuck_boss/backend/internal/application/ratelimit"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// MockRateLimiter is a mock implementation of RateLimiter.
+type MockRateLimiter struct {
+	mock.Mock
+}
+
+func (m *MockRateLimiter) Allow(ctx context.Context, key string, limit int, window time.Duration) (bool, error) {
+	args := m.Called(ctx, key, limit, window)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRateLimiter) Reset(ctx context.Context, key string) error {
+	args := m.Called(ctx, key)
+	return args.Error(0)
+}
+
+// TestResetRateLimitUseCase_Execute_All tests resetting every action of a client.
+func TestResetRateLimitUseCase_Execute_All(t *testing.T) {
+	mockLimiter := new(MockRateLimiter)
+	uc := admin.NewResetRateLimitUseCase(mockLimiter)
+
+	ctx := context.Background()
+	mockLimiter.On("Reset", ctx, mock.Anything).Return(nil)
+
+	keys, err := uc.Execute(ctx, admin.ResetRateLimitCommand{ClientIP: "203.0.113.7"})
+
+	require.NoError(t, err)
+	require.Len(t, keys, len(ratelimit.ClientActions))
+	hour := time.Now().Format("2006-01-02-15")
+	assert.Equal(t, "rate_limit:post:203.0.113.7:"+hour, keys[0])
+	for _, key := range keys {
+		assert.True(t, strings.HasSuffix(key, ":203.0.113.7:"+hour), key)
+	}
+	mockLimiter.AssertNumberOfCalls(t, "Reset", len(ratelimit.ClientActions))
+}
+
+// TestResetRateLimitUseCase_Execute_Selected tests resetting one action.
+func TestResetRateLimitUseCase_Execute_Selected(t *testing.T) {
+	mockLimiter := new(MockRateLimiter)
+	uc := admin.NewResetRateLimitUseCase(mockLimiter)
+
+	ctx := context.Background()
+	key := ratelimit.ClientKey("login", "2001:db8::1", time.Now())
+	mockLimiter.On("Reset", ctx, key).Return(nil)
+
+	keys, err := uc.Execute(ctx, admin.ResetRateLimitCommand{ClientIP: "2001:db8::1", Actions: []string{" LOGIN "}})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{key}, keys)
+	mockLimiter.AssertExpectations(t)
+}
+
+// TestResetRateLimitUseCase_Execute_ValidationError tests invalid commands.
+func TestResetRateLimitUseCase_Execute_ValidationError(t *testing.T) {
+	tests := []struct {
+		name string
+		cmd  admin.ResetRateLimitCommand
+	}{
+		{name: "missing IP", cmd: admin.ResetRateLimitCommand{}},
+		{name: "invalid IP", cmd: admin.ResetRateLimitCommand{ClientIP: "example.com"}},
+		{name: "unknown action", cmd: admin.ResetRateLimitCommand{ClientIP: "203.0.113.7", Actions: []string{"verification_daily"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockLimiter := new(MockRateLimiter)
+			uc := admin.NewResetRateLimitUseCase(mockLimiter)
+
+			keys, err := uc.Execute(context.Background(), tt.cmd)
+
+			require.Error(t, err)
+			assert.Nil(t, keys)
+			assert.True(t, apperrors.IsValidationError(err))
+			mockLimiter.AssertNotCalled(t, "Reset", mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,433 @@
+// Package attachment_test provides unit tests for attachment use cases.
+// These tests use mocked dependencies to isolate the use case logic.
+package attachment_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"fuck_boss/backend/internal/application/attachment"
+	"fuck_boss/backend/internal/application/blob"
+	domainattachment "fuck_boss/backend/internal/domain/attachment"
+	domaincontent "fuck_boss/backend/internal/domain/content"
+	"fuck_boss/backend/internal/domain/shared"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// pngData is the start of a PNG file, enough for content sniffing.
+var pngData = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00\x01\x00\x00\x00\x01")
+
+// pdfData is the start of a PDF file.
+var pdfData = []byte("%PDF-1.7\n%\xe2\xe3\xcf\xd3\n")
+
+// stubImageProcessor is an ImageProcessor returning fixed sanitized content.
+type stubImageProcessor struct {
+	regions []attachment.Region
+	err     error
+}
+
+func (p *stubImageProcessor) Process(data []byte, regions []attachment.Region) (*attachment.ProcessedImage, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	p.regions = regions
+	return &attachment.ProcessedImage{
+		Data:                 []byte("sanitized"),
+		ContentType:          "image/png",
+		Width:                640,
+		Height:               480,
+		Thumbnail:            []byte("thumbnail"),
+		ThumbnailContentType: "image/png",
+	}, nil
+}
+
+// MockAttachmentRepository is a mock implementation of AttachmentRepository.
+type MockAttachmentRepository struct {
+	mock.Mock
+}
+
+func (m *MockAttachmentRepository) Save(ctx context.Context, a *domainattachment.Attachment) error {
+	args := m.Called(ctx, a)
+	return args.Error(0)
+}
+
+func (m *MockAttachmentRepository) FindByID(ctx context.Context, id string) (*domainattachment.Attachment, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainattachment.Attachment), args.Error(1)
+}
+
+func (m *MockAttachmentRepository) FindByPost(ctx context.Context, postID domaincontent.PostID) ([]*domainattachment.Attachment, error) {
+	args := m.Called(ctx, postID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domainattachment.Attachment), args.Error(1)
+}
+
+func (m *MockAttachmentRepository) CountByPost(ctx context.Context, postID domaincontent.PostID) (int, error) {
+	args := m.Called(ctx, postID)
+	return args.Int(0), args.Error(1)
+}
+
+// MockPostRepository is a mock implementation of PostRepository.
+type MockPostRepository struct {
+	mock.Mock
+}
+
+func (m *MockPostRepository) Save(ctx context.Context, post *domaincontent.Post) error {
+	args := m.Called(ctx, post)
+	return args.Error(0)
+}
+
+func (m *MockPostRepository) FindByID(ctx context.Context, id domaincontent.PostID) (*domaincontent.Post, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domaincontent.Post), args.Error(1)
+}
+
+func (m *MockPostRepository) FindByCity(ctx context.Context, city shared.City, page, pageSize int) ([]*domaincontent.Post, int, error) {
+	args := m.Called(ctx, city, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*domaincontent.Post), args.Int(1), args.Error(2)
+}
+
+func (m *MockPostRepository) Search(ctx context.Context, keyword string, city *shared.City, page, pageSize int) ([]*domaincontent.Post, int, error) {
+	args := m.Called(ctx, keyword, city, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*domaincontent.Post), args.Int(1), args.Error(2)
+}
+
+func (m *MockPostRepository) FindAll(ctx context.Context, page, pageSize int) ([]*domaincontent.Post, int, error) {
+	args := m.Called(ctx, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*domaincontent.Post), args.Int(1), args.Error(2)
+}
+
+func (m *MockPostRepository) FindByFilter(ctx context.Context, filter domaincontent.PostFilter, page, pageSize int) ([]*domaincontent.Post, int, error) {
+	args := m.Called(ctx, filter, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*domaincontent.Post), args.Int(1), args.Error(2)
+}
+
+func (m *MockPostRepository) FindSummariesByFilter(ctx context.Context, filter domaincontent.PostFilter, page, pageSize int) ([]*domaincontent.PostSummary, int, error) {
+	args := m.Called(ctx, filter, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*domaincontent.PostSummary), args.Int(1), args.Error(2)
+}
+
+func (m *MockPostRepository) FindByFilterAfter(ctx context.Context, filter domaincontent.PostFilter, after *domaincontent.PostCursor, limit int) ([]*domaincontent.Post, error) {
+	args := m.Called(ctx, filter, after, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domaincontent.Post), args.Error(1)
+}
+
+func (m *MockPostRepository) SearchSummaries(ctx context.Context, keyword string, city *shared.City, page, pageSize int) ([]*domaincontent.PostSummary, int, error) {
+	args := m.Called(ctx, keyword, city, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*domaincontent.PostSummary), args.Int(1), args.Error(2)
+}
+
+// memoryBlobStore is an in-memory BlobStore for tests.
+type memoryBlobStore struct {
+	blobs  map[string][]byte
+	putErr error
+}
+
+func newMemoryBlobStore() *memoryBlobStore {
+	return &memoryBlobStore{blobs: make(map[string][]byte)}
+}
+
+func (s *memoryBlobStore) Put(ctx context.Context, key string, r io.Reader) error {
+	if s.putErr != nil {
+		return s.putErr
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	s.blobs[key] = data
+	return nil
+}
+
+func (s *memoryBlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	data, ok := s.blobs[key]
+	if !ok {
+		return nil, blob.ErrNotFound
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (s *memoryBlobStore) Delete(ctx context.Context, key string) error {
+	delete(s.blobs, key)
+	return nil
+}
+
+// newTestPost creates a valid post with a management token for tests.
+func newTestPost(t *testing.T) (*domaincontent.Post, string) {
+	t.Helper()
+
+	company, _ := domaincontent.NewCompanyName("测试公司")
+	city, _ := shared.NewCity("beijing", "北京")
+	postContent, _ := domaincontent.NewContent("这是一条测试内容，用于验证附件功能。内容应该足够长以满足最小长度要求。")
+	post, err := domaincontent.NewPost(company, city, postContent)
+	require.NoError(t, err)
+
+	token, err := domaincontent.GenerateManagementToken()
+	require.NoError(t, err)
+	post.AssignManagementToken(token)
+	return post, token.String()
+}
+
+// assertErrorCode asserts that err is an AppError with the given code.
+func assertErrorCode(t *testing.T, err error, code apperrors.ErrorCode) {
+	t.Helper()
+
+	require.Error(t, err)
+	appErr, ok := err.(*apperrors.AppError)
+	require.True(t, ok, "expected AppError, got %T", err)
+	assert.Equal(t, code, appErr.Code)
+}
+
+// TestUploadAttachmentUseCase_Execute_Success tests that only the sanitized image and its thumbnail are stored.
+func TestUploadAttachmentUseCase_Execute_Success(t *testing.T) {
+	repo := new(MockAttachmentRepository)
+	posts := new(MockPostRepository)
+	store := newMemoryBlobStore()
+	images := &stubImageProcessor{}
+	uc := attachment.NewUploadAttachmentUseCase(repo, posts, store, images)
+
+	ctx := context.Background()
+	post, token := newTestPost(t)
+
+	posts.On("FindByID", ctx, post.ID()).Return(post, nil)
+	repo.On("CountByPost", ctx, post.ID()).Return(2, nil)
+	repo.On("Save", ctx, mock.AnythingOfType("*attachment.Attachment")).Return(nil)
+
+	result, err := uc.Execute(ctx, attachment.UploadAttachmentCommand{
+		PostID:          post.ID().String(),
+		ManagementToken: token,
+		Data:            pngData,
+		Regions:         []attachment.Region{{X: 10, Y: 20, Width: 100, Height: 50}},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "image/png", result.ContentType)
+	assert.Equal(t, int64(len("sanitized")), result.Size)
+	assert.Len(t, result.Checksum, 64)
+	assert.Equal(t, result.ID+".png", result.FileName)
+	assert.Equal(t, 640, result.Width)
+	assert.Equal(t, 480, result.Height)
+	assert.True(t, result.HasThumbnail)
+	assert.Equal(t, []attachment.Region{{X: 10, Y: 20, Width: 100, Height: 50}}, images.regions)
+
+	prefix := "posts/" + post.ID().String() + "/" + result.ID
+	assert.Equal(t, []byte("sanitized"), store.blobs[prefix+".png"])
+	assert.Equal(t, []byte("thumbnail"), store.blobs[prefix+"_thumb.png"])
+	assert.Len(t, store.blobs, 2)
+	repo.AssertExpectations(t)
+}
+
+// TestUploadAttachmentUseCase_Execute_Document tests that documents are stored unchanged.
+func TestUploadAttachmentUseCase_Execute_Document(t *testing.T) {
+	repo := new(MockAttachmentRepository)
+	posts := new(MockPostRepository)
+	store := newMemoryBlobStore()
+	uc := attachment.NewUploadAttachmentUseCase(repo, posts, store, &stubImageProcessor{err: errors.New("not called")})
+
+	ctx := context.Background()
+	post, token := newTestPost(t)
+
+	posts.On("FindByID", ctx, post.ID()).Return(post, nil)
+	repo.On("CountByPost", ctx, post.ID()).Return(0, nil)
+	repo.On("Save", ctx, mock.AnythingOfType("*attachment.Attachment")).Return(nil)
+
+	result, err := uc.Execute(ctx, attachment.UploadAttachmentCommand{
+		PostID:          post.ID().String(),
+		ManagementToken: token,
+		Data:            pdfData,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "application/pdf", result.ContentType)
+	assert.False(t, result.HasThumbnail)
+	assert.Equal(t, pdfData, store.blobs["posts/"+post.ID().String()+"/"+result.FileName])
+	assert.Len(t, store.blobs, 1)
+}
+
+// TestUploadAttachmentUseCase_Execute_Rejected tests the checks performed before storing.
+func TestUploadAttachmentUseCase_Execute_Rejected(t *testing.T) {
+	ctx := context.Background()
+	post, token := newTestPost(t)
+
+	tooManyRegions := make([]attachment.Region, attachment.MaxRegions+1)
+	for i := range tooManyRegions {
+		tooManyRegions[i] = attachment.Region{Width: 1, Height: 1}
+	}
+
+	tests := []struct {
+		name       string
+		data       []byte
+		token      string
+		count      int
+		regions    []attachment.Region
+		processErr error
+		code       apperrors.ErrorCode
+	}{
+		{name: "empty file", data: nil, token: token, code: apperrors.ErrCodeValidation},
+		{name: "too large", data: append(append([]byte{}, pngData...), make([]byte, domainattachment.MaxSize)...), token: token, code: apperrors.ErrCodeValidation},
+		{name: "html disguised as image", data: []byte("<html><script>alert(1)</script></html>"), token: token, code: apperrors.ErrCodeValidation},
+		{name: "svg", data: []byte(`<?xml version="1.0"?><svg xmlns="http://www.w3.org/2000/svg"></svg>`), token: token, code: apperrors.ErrCodeValidation},
+		{name: "wrong token", data: pngData, token: "wrong", code: apperrors.ErrCodeForbidden},
+		{name: "per-post cap", data: pngData, token: token, count: domainattachment.MaxPerPost, code: apperrors.ErrCodeValidation},
+		{name: "corrupt image", data: pngData, token: token, processErr: errors.New("unexpected EOF"), code: apperrors.ErrCodeValidation},
+		{name: "regions on a document", data: pdfData, token: token, regions: []attachment.Region{{Width: 10, Height: 10}}, code: apperrors.ErrCodeValidation},
+		{name: "empty region", data: pngData, token: token, regions: []attachment.Region{{X: 5, Y: 5}}, code: apperrors.ErrCodeValidation},
+		{name: "negative region", data: pngData, token: token, regions: []attachment.Region{{X: -1, Width: 10, Height: 10}}, code: apperrors.ErrCodeValidation},
+		{name: "too many regions", data: pngData, token: token, regions: tooManyRegions, code: apperrors.ErrCodeValidation},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := new(MockAttachmentRepository)
+			posts := new(MockPostRepository)
+			store := newMemoryBlobStore()
+			uc := attachment.NewUploadAttachmentUseCase(repo, posts, store, &stubImageProcessor{err: tt.processErr})
+
+			posts.On("FindByID", ctx, post.ID()).Return(post, nil)
+			repo.On("CountByPost", ctx, post.ID()).Return(tt.count, nil)
+
+			_, err := uc.Execute(ctx, attachment.UploadAttachmentCommand{
+				PostID:          post.ID().String(),
+				ManagementToken: tt.token,
+				Data:            tt.data,
+				Regions:         tt.regions,
+			})
+
+			assertErrorCode(t, err, tt.code)
+			assert.Empty(t, store.blobs)
+			repo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+// TestUploadAttachmentUseCase_Execute_SaveError tests that the stored files are removed when saving fails.
+func TestUploadAttachmentUseCase_Execute_SaveError(t *testing.T) {
+	repo := new(MockAttachmentRepository)
+	posts := new(MockPostRepository)
+	store := newMemoryBlobStore()
+	uc := attachment.NewUploadAttachmentUseCase(repo, posts, store, &stubImageProcessor{})
+
+	ctx := context.Background()
+	post, token := newTestPost(t)
+
+	posts.On("FindByID", ctx, post.ID()).Return(post, nil)
+	repo.On("CountByPost", ctx, post.ID()).Return(0, nil)
+	repo.On("Save", ctx, mock.Anything).Return(apperrors.NewDatabaseError("failed to save attachment"))
+
+	_, err := uc.Execute(ctx, attachment.UploadAttachmentCommand{
+		PostID:          post.ID().String(),
+		ManagementToken: token,
+		Data:            pngData,
+	})
+
+	assertErrorCode(t, err, apperrors.ErrCodeDatabase)
+	assert.Empty(t, store.blobs)
+}
+
+// TestDownloadAttachmentUseCase_Execute tests opening a stored attachment.
+func TestDownloadAttachmentUseCase_Execute(t *testing.T) {
+	repo := new(MockAttachmentRepository)
+	store := newMemoryBlobStore()
+	uc := attachment.NewDownloadAttachmentUseCase(repo, store)
+
+	ctx := context.Background()
+	a, err := domainattachment.NewAttachment(domaincontent.GeneratePostID(), domainattachment.ContentTypePNG, int64(len(pngData)), strings.Repeat("a", 64))
+	require.NoError(t, err)
+	store.blobs[a.StorageKey()] = pngData
+
+	repo.On("FindByID", ctx, a.ID()).Return(a, nil)
+
+	download, err := uc.Execute(ctx, attachment.DownloadAttachmentQuery{ID: a.ID()})
+	require.NoError(t, err)
+	defer download.Body.Close()
+
+	data, _ := io.ReadAll(download.Body)
+	assert.Equal(t, pngData, data)
+	assert.Equal(t, "image/png", download.Attachment.ContentType)
+}
+
+// TestDownloadAttachmentUseCase_Execute_Thumbnail tests opening the thumbnail of an image.
+func TestDownloadAttachmentUseCase_Execute_Thumbnail(t *testing.T) {
+	repo := new(MockAttachmentRepository)
+	store := newMemoryBlobStore()
+	uc := attachment.NewDownloadAttachmentUseCase(repo, store)
+
+	ctx := context.Background()
+	image, err := domainattachment.NewAttachment(domaincontent.GeneratePostID(), domainattachment.ContentTypeJPEG, 2048, strings.Repeat("a", 64))
+	require.NoError(t, err)
+	require.NoError(t, image.AssignImageInfo(1280, 720, domainattachment.ContentTypeJPEG))
+	store.blobs[image.ThumbnailKey()] = []byte("thumbnail")
+
+	document, err := domainattachment.NewAttachment(domaincontent.GeneratePostID(), domainattachment.ContentTypePDF, 2048, strings.Repeat("b", 64))
+	require.NoError(t, err)
+
+	repo.On("FindByID", ctx, image.ID()).Return(image, nil)
+	repo.On("FindByID", ctx, document.ID()).Return(document, nil)
+
+	download, err := uc.Execute(ctx, attachment.DownloadAttachmentQuery{ID: image.ID(), Thumbnail: true})
+	require.NoError(t, err)
+	defer download.Body.Close()
+
+	data, _ := io.ReadAll(download.Body)
+	assert.Equal(t, []byte("thumbnail"), data)
+	assert.Equal(t, "image/jpeg", download.ContentType)
+	assert.Equal(t, strings.Repeat("a", 64)+"-thumb", download.ETag)
+	assert.Equal(t, image.ID()+"_thumb.jpg", download.FileName)
+
+	_, err = uc.Execute(ctx, attachment.DownloadAttachmentQuery{ID: document.ID(), Thumbnail: true})
+	assertErrorCode(t, err, apperrors.ErrCodeNotFound)
+}
+
+// TestDownloadAttachmentUseCase_Execute_MissingBlob tests a metadata row without a stored file.
+func TestDownloadAttachmentUseCase_Execute_MissingBlob(t *testing.T) {
+	repo := new(MockAttachmentRepository)
+	uc := attachment.NewDownloadAttachmentUseCase(repo, newMemoryBlobStore())
+
+	ctx := context.Background()
+	a, err := domainattachment.NewAttachment(domaincontent.GeneratePostID(), domainattachment.ContentTypePNG, 10, strings.Repeat("a", 64))
+	require.NoError(t, err)
+	repo.On("FindByID", ctx, a.ID()).Return(a, nil)
+
+	_, err = uc.Execute(ctx, attachment.DownloadAttachmentQuery{ID: a.ID()})
+	assertErrorCode(t, err, apperrors.ErrCodeNotFound)
+
+	_, err = uc.Execute(ctx, attachment.DownloadAttachmentQuery{ID: "not-a-uuid"})
+	assertErrorCode(t, err, apperrors.ErrCodeValidation)
+}
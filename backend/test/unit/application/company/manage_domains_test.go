@@ -0,0 +1,120 @@
+package company_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"fuck_boss/backend/internal/application/company"
+	domaincompany "fuck_boss/backend/internal/domain/company"
+	domaincontent "fuck_boss/backend/internal/domain/content"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// MockDomainApprovalRepository is a mock implementation of DomainApprovalRepository.
+type MockDomainApprovalRepository struct {
+	mock.Mock
+}
+
+func (m *MockDomainApprovalRepository) Save(ctx context.Context, approval *domaincompany.DomainApproval) error {
+	args := m.Called(ctx, approval)
+	return args.Error(0)
+}
+
+func (m *MockDomainApprovalRepository) IsApproved(ctx context.Context, companyName domaincontent.CompanyName, domain domaincompany.CompanyDomain) (bool, error) {
+	args := m.Called(ctx, companyName, domain)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockDomainApprovalRepository) FindAll(ctx context.Context) ([]*domaincompany.DomainApproval, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domaincompany.DomainApproval), args.Error(1)
+}
+
+func (m *MockDomainApprovalRepository) Delete(ctx context.Context, companyName domaincontent.CompanyName, domain domaincompany.CompanyDomain) error {
+	args := m.Called(ctx, companyName, domain)
+	return args.Error(0)
+}
+
+// approvedDomains returns a DomainApprovalRepository answering every IsApproved with approved.
+func approvedDomains(approved bool) *MockDomainApprovalRepository {
+	repo := new(MockDomainApprovalRepository)
+	repo.On("IsApproved", mock.Anything, mock.Anything, mock.Anything).Return(approved, nil)
+	return repo
+}
+
+// TestApproveCompanyDomainUseCase_Execute_Success tests approving a company domain.
+func TestApproveCompanyDomainUseCase_Execute_Success(t *testing.T) {
+	mockRepo := new(MockDomainApprovalRepository)
+	uc := company.NewApproveCompanyDomainUseCase(mockRepo)
+
+	ctx := context.Background()
+	mockRepo.On("Save", ctx, mock.MatchedBy(func(approval *domaincompany.DomainApproval) bool {
+		return approval.Company().String() == "测试公司" && approval.Domain().String() == "example.com"
+	})).Return(nil)
+
+	result, err := uc.Execute(ctx, company.CompanyDomainCommand{Company: "测试公司", Domain: "Example.COM."})
+
+	require.NoError(t, err)
+	assert.Equal(t, "测试公司", result.Company)
+	assert.Equal(t, "example.com", result.Domain)
+	assert.False(t, result.ApprovedAt.IsZero())
+	mockRepo.AssertExpectations(t)
+}
+
+// TestApproveCompanyDomainUseCase_Execute_ValidationError tests invalid company names and domains.
+func TestApproveCompanyDomainUseCase_Execute_ValidationError(t *testing.T) {
+	mockRepo := new(MockDomainApprovalRepository)
+	uc := company.NewApproveCompanyDomainUseCase(mockRepo)
+
+	for _, cmd := range []company.CompanyDomainCommand{
+		{Company: "", Domain: "example.com"},
+		{Company: "测试公司", Domain: "localhost"},
+		{Company: "测试公司", Domain: "203.0.113.7"},
+	} {
+		result, err := uc.Execute(context.Background(), cmd)
+		assert.Nil(t, result)
+		assert.True(t, apperrors.IsValidationError(err), "command %+v", cmd)
+	}
+	mockRepo.AssertNotCalled(t, "Save")
+}
+
+// TestRevokeCompanyDomainUseCase_Execute_NotFound tests revoking a domain that is not approved.
+func TestRevokeCompanyDomainUseCase_Execute_NotFound(t *testing.T) {
+	mockRepo := new(MockDomainApprovalRepository)
+	uc := company.NewRevokeCompanyDomainUseCase(mockRepo)
+
+	ctx := context.Background()
+	companyName, _ := domaincontent.NewCompanyName("测试公司")
+	domain, _ := domaincompany.NewCompanyDomain("example.com")
+	mockRepo.On("Delete", ctx, companyName, domain).Return(apperrors.NewNotFoundError("company domain"))
+
+	err := uc.Execute(ctx, company.CompanyDomainCommand{Company: "测试公司", Domain: "example.com"})
+
+	assert.True(t, apperrors.IsNotFoundError(err))
+	mockRepo.AssertExpectations(t)
+}
+
+// TestListCompanyDomainsUseCase_Execute_Success tests listing approved company domains.
+func TestListCompanyDomainsUseCase_Execute_Success(t *testing.T) {
+	mockRepo := new(MockDomainApprovalRepository)
+	uc := company.NewListCompanyDomainsUseCase(mockRepo)
+
+	ctx := context.Background()
+	companyName, _ := domaincontent.NewCompanyName("测试公司")
+	domain, _ := domaincompany.NewCompanyDomain("example.com")
+	mockRepo.On("FindAll", ctx).Return([]*domaincompany.DomainApproval{domaincompany.NewDomainApproval(companyName, domain)}, nil)
+
+	result, err := uc.Execute(ctx)
+
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, "测试公司", result[0].Company)
+	assert.Equal(t, "example.com", result[0].Domain)
+}
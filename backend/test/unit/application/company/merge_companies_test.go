@@ -0,0 +1,121 @@
+package company_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"fuck_boss/backend/internal/application/company"
+	domaincompany "fuck_boss/backend/internal/domain/company"
+	domaincontent "fuck_boss/backend/internal/domain/content"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// MockCompanyMergeRepository is a mock implementation of CompanyMergeRepository.
+type MockCompanyMergeRepository struct {
+	mock.Mock
+}
+
+func (m *MockCompanyMergeRepository) Merge(ctx context.Context, from, into domaincontent.CompanyName) (*domaincompany.MergeResult, error) {
+	args := m.Called(ctx, from, into)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domaincompany.MergeResult), args.Error(1)
+}
+
+// TestMergeCompaniesUseCase_Execute_Success tests merging a company with posts.
+func TestMergeCompaniesUseCase_Execute_Success(t *testing.T) {
+	mockRepo := new(MockCompanyMergeRepository)
+	mockCache := new(MockCacheRepository)
+	uc := company.NewMergeCompaniesUseCase(mockRepo, mockCache)
+
+	ctx := context.Background()
+	from, _ := domaincontent.NewCompanyName("测试科技有线公司")
+	into, _ := domaincontent.NewCompanyName("测试科技有限公司")
+
+	mockRepo.On("Merge", ctx, from, into).Return(&domaincompany.MergeResult{Posts: 12, Representatives: 1, Watches: 3, Domains: 1}, nil)
+	for _, pattern := range []string{"post:*", "posts:*", "feed:*", "search:*"} {
+		mockCache.On("DeleteByPattern", ctx, pattern).Return(nil)
+	}
+
+	result, err := uc.Execute(ctx, company.MergeCompaniesCommand{
+		From: " 测试科技有线公司 ",
+		Into: "测试科技有限公司",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "测试科技有线公司", result.From)
+	assert.Equal(t, "测试科技有限公司", result.Into)
+	assert.Equal(t, 12, result.Posts)
+	assert.Equal(t, 1, result.Representatives)
+	assert.Equal(t, 3, result.Watches)
+	assert.Equal(t, 1, result.Domains)
+
+	mockRepo.AssertExpectations(t)
+	mockCache.AssertExpectations(t)
+}
+
+// TestMergeCompaniesUseCase_Execute_NoPosts tests that caches are kept when no post changed.
+func TestMergeCompaniesUseCase_Execute_NoPosts(t *testing.T) {
+	mockRepo := new(MockCompanyMergeRepository)
+	mockCache := new(MockCacheRepository)
+	uc := company.NewMergeCompaniesUseCase(mockRepo, mockCache)
+
+	ctx := context.Background()
+	mockRepo.On("Merge", ctx, mock.Anything, mock.Anything).Return(&domaincompany.MergeResult{Watches: 1}, nil)
+
+	result, err := uc.Execute(ctx, company.MergeCompaniesCommand{From: "甲公司", Into: "乙公司"})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Watches)
+	mockCache.AssertNotCalled(t, "DeleteByPattern", mock.Anything, mock.Anything)
+}
+
+// TestMergeCompaniesUseCase_Execute_ValidationError tests invalid commands.
+func TestMergeCompaniesUseCase_Execute_ValidationError(t *testing.T) {
+	tests := []struct {
+		name string
+		cmd  company.MergeCompaniesCommand
+	}{
+		{name: "missing from", cmd: company.MergeCompaniesCommand{Into: "乙公司"}},
+		{name: "missing into", cmd: company.MergeCompaniesCommand{From: "甲公司"}},
+		{name: "same company", cmd: company.MergeCompaniesCommand{From: "甲公司", Into: " 甲公司"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockCompanyMergeRepository)
+			uc := company.NewMergeCompaniesUseCase(mockRepo, new(MockCacheRepository))
+
+			result, err := uc.Execute(context.Background(), tt.cmd)
+
+			require.Error(t, err)
+			assert.Nil(t, result)
+			assert.True(t, apperrors.IsValidationError(err))
+			mockRepo.AssertNotCalled(t, "Merge", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+// TestMergeCompaniesUseCase_Execute_RepositoryError tests a failed merge.
+func TestMergeCompaniesUseCase_Execute_RepositoryError(t *testing.T) {
+	mockRepo := new(MockCompanyMergeRepository)
+	mockCache := new(MockCacheRepository)
+	uc := company.NewMergeCompaniesUseCase(mockRepo, mockCache)
+
+	ctx := context.Background()
+	mockRepo.On("Merge", ctx, mock.Anything, mock.Anything).
+		Return(nil, apperrors.NewDatabaseErrorWithCause("failed to merge posts", errors.New("connection refused")))
+
+	result, err := uc.Execute(ctx, company.MergeCompaniesCommand{From: "甲公司", Into: "乙公司"})
+
+	require.Error(t, err)
+	assert.Nil(t, result)
+	assert.True(t, apperrors.IsDatabaseError(err))
+	mockCache.AssertNotCalled(t, "DeleteByPattern", mock.Anything, mock.Anything)
+}
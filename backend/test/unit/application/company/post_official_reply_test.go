@@ -0,0 +1,201 @@
+package company_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"fuck_boss/backend/internal/application/company"
+	domaincompany "fuck_boss/backend/internal/domain/company"
+	domaincontent "fuck_boss/backend/internal/domain/content"
+	"fuck_boss/backend/internal/domain/shared"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// newTestPost creates a post about the given company.
+func newTestPost(t *testing.T, companyName string) *domaincontent.Post {
+	t.Helper()
+
+	companyVO, _ := domaincontent.NewCompanyName(companyName)
+	city, _ := shared.NewCity("beijing", "北京")
+	postContent, _ := domaincontent.NewContent("这是一条测试内容，用于验证官方回应功能。内容应该足够长以满足最小长度要求。")
+	post, err := domaincontent.NewPost(companyVO, city, postContent)
+	require.NoError(t, err)
+
+	return post
+}
+
+// newVerifiedRepresentative registers and verifies a representative for "测试公司".
+func newVerifiedRepresentative(t *testing.T) (*domaincompany.Representative, string) {
+	t.Helper()
+
+	representative, token := newPendingRepresentative(t)
+	representative.MarkVerified()
+	return representative, token
+}
+
+// TestPostOfficialReplyUseCase_Execute_Success tests attaching an official reply.
+func TestPostOfficialReplyUseCase_Execute_Success(t *testing.T) {
+	// Setup mocks
+	mockRepRepo := new(MockRepresentativeRepository)
+	mockPostRepo := new(MockPostRepository)
+	mockCache := new(MockCacheRepository)
+
+	// Create use case
+	uc := company.NewPostOfficialReplyUseCase(mockRepRepo, approvedDomains(true), mockPostRepo, mockCache, nil)
+
+	ctx := context.Background()
+	representative, token := newVerifiedRepresentative(t)
+	post := newTestPost(t, "测试公司")
+	originalContent := post.Content().String()
+
+	// Setup expectations
+	mockRepRepo.On("FindByID", ctx, representative.ID()).Return(representative, nil)
+	mockPostRepo.On("FindByID", ctx, post.ID()).Return(post, nil)
+	mockPostRepo.On("Save", ctx, post).Return(nil)
+	mockCache.On("Delete", ctx, "post:"+post.ID().String()).Return(nil)
+
+	// Execute
+	result, err := uc.Execute(ctx, company.PostOfficialReplyCommand{
+		PostID:           post.ID().String(),
+		RepresentativeID: representative.ID().String(),
+		AccessToken:      token,
+		Content:          "我们已经核实情况，并已补发全部拖欠工资。",
+	})
+
+	// Assertions
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.NotEmpty(t, result.ID)
+	assert.Equal(t, "测试公司", result.Company)
+	assert.Equal(t, "example.com", result.VerifiedDomain)
+	assert.Equal(t, domaincontent.OfficialReplyBadge, result.Badge)
+	assert.Equal(t, originalContent, post.Content().String())
+	assert.NotNil(t, post.OfficialReply())
+
+	// Verify all expectations were met
+	mockRepRepo.AssertExpectations(t)
+	mockPostRepo.AssertExpectations(t)
+	mockCache.AssertExpectations(t)
+}
+
+// TestPostOfficialReplyUseCase_Execute_AlreadyReplied tests the one-reply-per-post rule.
+func TestPostOfficialReplyUseCase_Execute_AlreadyReplied(t *testing.T) {
+	mockRepRepo := new(MockRepresentativeRepository)
+	mockPostRepo := new(MockPostRepository)
+	mockCache := new(MockCacheRepository)
+
+	uc := company.NewPostOfficialReplyUseCase(mockRepRepo, approvedDomains(true), mockPostRepo, mockCache, nil)
+
+	ctx := context.Background()
+	representative, token := newVerifiedRepresentative(t)
+	post := newTestPost(t, "测试公司")
+	existing, _ := domaincontent.NewReplyContent("这是之前已经发布的官方回应内容。")
+	_, err := post.AttachOfficialReply("other-representative", representative.Company(), "example.com", existing)
+	require.NoError(t, err)
+
+	mockRepRepo.On("FindByID", ctx, representative.ID()).Return(representative, nil)
+	mockPostRepo.On("FindByID", ctx, post.ID()).Return(post, nil)
+
+	result, err := uc.Execute(ctx, company.PostOfficialReplyCommand{
+		PostID:           post.ID().String(),
+		RepresentativeID: representative.ID().String(),
+		AccessToken:      token,
+		Content:          "我们已经核实情况，并已补发全部拖欠工资。",
+	})
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.True(t, apperrors.IsConflictError(err))
+	mockPostRepo.AssertNotCalled(t, "Save")
+}
+
+// TestPostOfficialReplyUseCase_Execute_CompanyMismatch tests replying to another company's post.
+func TestPostOfficialReplyUseCase_Execute_CompanyMismatch(t *testing.T) {
+	mockRepRepo := new(MockRepresentativeRepository)
+	mockPostRepo := new(MockPostRepository)
+	mockCache := new(MockCacheRepository)
+
+	uc := company.NewPostOfficialReplyUseCase(mockRepRepo, approvedDomains(true), mockPostRepo, mockCache, nil)
+
+	ctx := context.Background()
+	representative, token := newVerifiedRepresentative(t)
+	post := newTestPost(t, "其他公司")
+
+	mockRepRepo.On("FindByID", ctx, representative.ID()).Return(representative, nil)
+	mockPostRepo.On("FindByID", ctx, post.ID()).Return(post, nil)
+
+	result, err := uc.Execute(ctx, company.PostOfficialReplyCommand{
+		PostID:           post.ID().String(),
+		RepresentativeID: representative.ID().String(),
+		AccessToken:      token,
+		Content:          "我们已经核实情况，并已补发全部拖欠工资。",
+	})
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.True(t, apperrors.IsForbiddenError(err))
+	assert.Nil(t, post.OfficialReply())
+	mockPostRepo.AssertNotCalled(t, "Save")
+}
+
+// TestPostOfficialReplyUseCase_Execute_NotVerified tests a pending representative.
+func TestPostOfficialReplyUseCase_Execute_NotVerified(t *testing.T) {
+	mockRepRepo := new(MockRepresentativeRepository)
+	mockPostRepo := new(MockPostRepository)
+	mockCache := new(MockCacheRepository)
+
+	uc := company.NewPostOfficialReplyUseCase(mockRepRepo, new(MockDomainApprovalRepository), mockPostRepo, mockCache, nil)
+
+	ctx := context.Background()
+	representative, token := newPendingRepresentative(t)
+	post := newTestPost(t, "测试公司")
+
+	mockRepRepo.On("FindByID", ctx, representative.ID()).Return(representative, nil)
+
+	result, err := uc.Execute(ctx, company.PostOfficialReplyCommand{
+		PostID:           post.ID().String(),
+		RepresentativeID: representative.ID().String(),
+		AccessToken:      token,
+		Content:          "我们已经核实情况，并已补发全部拖欠工资。",
+	})
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.True(t, apperrors.IsForbiddenError(err))
+	mockPostRepo.AssertNotCalled(t, "FindByID")
+}
+
+// TestPostOfficialReplyUseCase_Execute_DomainNotApproved tests a verified
+// representative whose domain is not (or no longer) approved for the company.
+func TestPostOfficialReplyUseCase_Execute_DomainNotApproved(t *testing.T) {
+	mockRepRepo := new(MockRepresentativeRepository)
+	mockApprovalRepo := new(MockDomainApprovalRepository)
+	mockPostRepo := new(MockPostRepository)
+	mockCache := new(MockCacheRepository)
+
+	uc := company.NewPostOfficialReplyUseCase(mockRepRepo, mockApprovalRepo, mockPostRepo, mockCache, nil)
+
+	ctx := context.Background()
+	representative, token := newVerifiedRepresentative(t)
+	post := newTestPost(t, "测试公司")
+
+	mockRepRepo.On("FindByID", ctx, representative.ID()).Return(representative, nil)
+	mockApprovalRepo.On("IsApproved", ctx, representative.Company(), representative.Domain()).Return(false, nil)
+
+	result, err := uc.Execute(ctx, company.PostOfficialReplyCommand{
+		PostID:           post.ID().String(),
+		RepresentativeID: representative.ID().String(),
+		AccessToken:      token,
+		Content:          "我们已经核实情况，并已补发全部拖欠工资。",
+	})
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.True(t, apperrors.IsForbiddenError(err))
+	assert.Nil(t, post.OfficialReply())
+	mockPostRepo.AssertNotCalled(t, "FindByID")
+	mockApprovalRepo.AssertExpectations(t)
+}
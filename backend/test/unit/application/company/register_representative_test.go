@@ -0,0 +1,290 @@
+// Package company_test provides unit tests for company representative use cases.
+// These tests use mocked dependencies to isolate the use case logic.
+package company_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"fuck_boss/backend/internal/application/company"
+	domaincompany "fuck_boss/backend/internal/domain/company"
+	domaincontent "fuck_boss/backend/internal/domain/content"
+	"fuck_boss/backend/internal/domain/shared"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// MockRepresentativeRepository is a mock implementation of RepresentativeRepository.
+type MockRepresentativeRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepresentativeRepository) Save(ctx context.Context, representative *domaincompany.Representative) error {
+	args := m.Called(ctx, representative)
+	return args.Error(0)
+}
+
+func (m *MockRepresentativeRepository) FindByID(ctx context.Context, id domaincompany.RepresentativeID) (*domaincompany.Representative, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domaincompany.Representative), args.Error(1)
+}
+
+// MockPostRepository is a mock implementation of PostRepository.
+type MockPostRepository struct {
+	mock.Mock
+}
+
+func (m *MockPostRepository) Save(ctx context.Context, post *domaincontent.Post) error {
+	args := m.Called(ctx, post)
+	return args.Error(0)
+}
+
+func (m *MockPostRepository) FindByID(ctx context.Context, id domaincontent.PostID) (*domaincontent.Post, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domaincontent.Post), args.Error(1)
+}
+
+func (m *MockPostRepository) FindByCity(ctx context.Context, city shared.City, page, pageSize int) ([]*domaincontent.Post, int, error) {
+	args := m.Called(ctx, city, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*domaincontent.Post), args.Int(1), args.Error(2)
+}
+
+func (m *MockPostRepository) Search(ctx context.Context, keyword string, city *shared.City, page, pageSize int) ([]*domaincontent.Post, int, error) {
+	args := m.Called(ctx, keyword, city, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*domaincontent.Post), args.Int(1), args.Error(2)
+}
+
+func (m *MockPostRepository) FindAll(ctx context.Context, page, pageSize int) ([]*domaincontent.Post, int, error) {
+	args := m.Called(ctx, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*domaincontent.Post), args.Int(1), args.Error(2)
+}
+
+func (m *MockPostRepository) FindByFilter(ctx context.Context, filter domaincontent.PostFilter, page, pageSize int) ([]*domaincontent.Post, int, error) {
+	args := m.Called(ctx, filter, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*domaincontent.Post), args.Int(1), args.Error(2)
+}
+
+func (m *MockPostRepository) FindSummariesByFilter(ctx context.Context, filter domaincontent.PostFilter, page, pageSize int) ([]*domaincontent.PostSummary, int, error) {
+	args := m.Called(ctx, filter, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*domaincontent.PostSummary), args.Int(1), args.Error(2)
+}
+
+func (m *MockPostRepository) FindByFilterAfter(ctx context.Context, filter domaincontent.PostFilter, after *domaincontent.PostCursor, limit int) ([]*domaincontent.Post, error) {
+	args := m.Called(ctx, filter, after, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domaincontent.Post), args.Error(1)
+}
+
+func (m *MockPostRepository) SearchSummaries(ctx context.Context, keyword string, city *shared.City, page, pageSize int) ([]*domaincontent.PostSummary, int, error) {
+	args := m.Called(ctx, keyword, city, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*domaincontent.PostSummary), args.Int(1), args.Error(2)
+}
+
+// MockCacheRepository is a mock implementation of CacheRepository.
+type MockCacheRepository struct {
+	mock.Mock
+}
+
+func (m *MockCacheRepository) Get(ctx context.Context, key string) (string, error) {
+	args := m.Called(ctx, key)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockCacheRepository) Set(ctx context.Context, key string, value string, ttl time.Duration) error {
+	args := m.Called(ctx, key, value, ttl)
+	return args.Error(0)
+}
+
+func (m *MockCacheRepository) Delete(ctx context.Context, key string) error {
+	args := m.Called(ctx, key)
+	return args.Error(0)
+}
+
+func (m *MockCacheRepository) DeleteByPattern(ctx context.Context, pattern string) error {
+	args := m.Called(ctx, pattern)
+	return args.Error(0)
+}
+
+// MockRateLimiter is a mock implementation of RateLimiter.
+type MockRateLimiter struct {
+	mock.Mock
+}
+
+func (m *MockRateLimiter) Allow(ctx context.Context, key string, limit int, window time.Duration) (bool, error) {
+	args := m.Called(ctx, key, limit, window)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRateLimiter) Reset(ctx context.Context, key string) error {
+	args := m.Called(ctx, key)
+	return args.Error(0)
+}
+
+// StubVerifier is a stub implementation of challenge.Verifier.
+type StubVerifier struct {
+	ok    bool
+	err   error
+	calls int
+}
+
+func (s *StubVerifier) Verify(ctx context.Context, challenge domaincompany.Challenge) (bool, error) {
+	s.calls++
+	return s.ok, s.err
+}
+
+// TestRegisterRepresentativeUseCase_Execute_Success tests successful registration.
+func TestRegisterRepresentativeUseCase_Execute_Success(t *testing.T) {
+	// Setup mocks
+	mockRepo := new(MockRepresentativeRepository)
+	mockRateLimiter := new(MockRateLimiter)
+
+	// Create use case
+	uc := company.NewRegisterRepresentativeUseCase(mockRepo, mockRateLimiter)
+
+	ctx := context.Background()
+
+	// Setup expectations
+	mockRateLimiter.On("Allow", ctx, mock.AnythingOfType("string"), 5, time.Hour).Return(true, nil)
+	mockRepo.On("Save", ctx, mock.AnythingOfType("*company.Representative")).Return(nil)
+
+	// Execute
+	result, err := uc.Execute(ctx, company.RegisterRepresentativeCommand{
+		Company:         "测试公司",
+		Domain:          "Example.com",
+		ChallengeMethod: "dns_txt",
+		ClientIP:        "192.168.1.1",
+	})
+
+	// Assertions
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.NotEmpty(t, result.ID)
+	assert.Equal(t, "测试公司", result.Company)
+	assert.Equal(t, "example.com", result.Domain)
+	assert.Equal(t, "PENDING", result.Status)
+	assert.Equal(t, "DNS_TXT", result.ChallengeMethod)
+	assert.Equal(t, "_fuckboss-challenge.example.com", result.ChallengeRecordName)
+	assert.Contains(t, result.ChallengeRecordValue, result.ChallengeCode)
+	assert.NotEmpty(t, result.AccessToken)
+	assert.Nil(t, result.VerifiedAt)
+
+	// Verify all expectations were met
+	mockRepo.AssertExpectations(t)
+	mockRateLimiter.AssertExpectations(t)
+}
+
+// TestRegisterRepresentativeUseCase_Execute_ValidationError tests validation errors.
+func TestRegisterRepresentativeUseCase_Execute_ValidationError(t *testing.T) {
+	tests := []struct {
+		name string
+		cmd  company.RegisterRepresentativeCommand
+	}{
+		{
+			name: "empty company",
+			cmd:  company.RegisterRepresentativeCommand{Domain: "example.com", ChallengeMethod: "FILE", ClientIP: "192.168.1.1"},
+		},
+		{
+			name: "invalid domain",
+			cmd:  company.RegisterRepresentativeCommand{Company: "测试公司", Domain: "127.0.0.1", ChallengeMethod: "FILE", ClientIP: "192.168.1.1"},
+		},
+		{
+			name: "invalid challenge method",
+			cmd:  company.RegisterRepresentativeCommand{Company: "测试公司", Domain: "example.com", ChallengeMethod: "EMAIL", ClientIP: "192.168.1.1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockRepresentativeRepository)
+			mockRateLimiter := new(MockRateLimiter)
+			mockRateLimiter.On("Allow", mock.Anything, mock.AnythingOfType("string"), 5, time.Hour).Return(true, nil).Maybe()
+
+			uc := company.NewRegisterRepresentativeUseCase(mockRepo, mockRateLimiter)
+
+			result, err := uc.Execute(context.Background(), tt.cmd)
+
+			assert.Error(t, err)
+			assert.Nil(t, result)
+			assert.True(t, apperrors.IsValidationError(err))
+			mockRepo.AssertNotCalled(t, "Save")
+		})
+	}
+}
+
+// TestRegisterRepresentativeUseCase_Execute_RateLimitExceeded tests the registration rate limit.
+func TestRegisterRepresentativeUseCase_Execute_RateLimitExceeded(t *testing.T) {
+	mockRepo := new(MockRepresentativeRepository)
+	mockRateLimiter := new(MockRateLimiter)
+
+	uc := company.NewRegisterRepresentativeUseCase(mockRepo, mockRateLimiter)
+
+	ctx := context.Background()
+	mockRateLimiter.On("Allow", ctx, mock.AnythingOfType("string"), 5, time.Hour).Return(false, nil)
+
+	result, err := uc.Execute(ctx, company.RegisterRepresentativeCommand{
+		Company:         "测试公司",
+		Domain:          "example.com",
+		ChallengeMethod: "FILE",
+		ClientIP:        "192.168.1.1",
+	})
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.True(t, apperrors.IsRateLimitError(err))
+	mockRepo.AssertNotCalled(t, "Save")
+}
+
+// TestRegisterRepresentativeUseCase_Execute_RepositoryError tests repository errors.
+func TestRegisterRepresentativeUseCase_Execute_RepositoryError(t *testing.T) {
+	mockRepo := new(MockRepresentativeRepository)
+	mockRateLimiter := new(MockRateLimiter)
+
+	uc := company.NewRegisterRepresentativeUseCase(mockRepo, mockRateLimiter)
+
+	ctx := context.Background()
+	mockRateLimiter.On("Allow", ctx, mock.AnythingOfType("string"), 5, time.Hour).Return(true, nil)
+	mockRepo.On("Save", ctx, mock.AnythingOfType("*company.Representative")).
+		Return(apperrors.NewDatabaseErrorWithCause("failed to save representative", errors.New("connection refused")))
+
+	result, err := uc.Execute(ctx, company.RegisterRepresentativeCommand{
+		Company:         "测试公司",
+		Domain:          "example.com",
+		ChallengeMethod: "FILE",
+		ClientIP:        "192.168.1.1",
+	})
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.True(t, apperrors.IsDatabaseError(err))
+}
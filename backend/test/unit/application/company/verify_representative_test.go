@@ -0,0 +1,196 @@
+package company_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"fuck_boss/backend/internal/application/company"
+	domaincompany "fuck_boss/backend/internal/domain/company"
+	domaincontent "fuck_boss/backend/internal/domain/content"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// newPendingRepresentative registers a pending representative for "测试公司".
+func newPendingRepresentative(t *testing.T) (*domaincompany.Representative, string) {
+	t.Helper()
+
+	companyName, _ := domaincontent.NewCompanyName("测试公司")
+	domain, _ := domaincompany.NewCompanyDomain("example.com")
+	representative, token, err := domaincompany.NewRepresentative(companyName, domain, domaincompany.ChallengeMethodDNSTXT)
+	require.NoError(t, err)
+
+	return representative, token.String()
+}
+
+// TestVerifyRepresentativeUseCase_Execute_Success tests a passed domain challenge.
+func TestVerifyRepresentativeUseCase_Execute_Success(t *testing.T) {
+	// Setup mocks
+	mockRepo := new(MockRepresentativeRepository)
+	mockRateLimiter := new(MockRateLimiter)
+	verifier := &StubVerifier{ok: true}
+
+	// Create use case
+	uc := company.NewVerifyRepresentativeUseCase(mockRepo, approvedDomains(true), verifier, mockRateLimiter)
+
+	ctx := context.Background()
+	representative, token := newPendingRepresentative(t)
+
+	// Setup expectations
+	mockRepo.On("FindByID", ctx, representative.ID()).Return(representative, nil)
+	mockRateLimiter.On("Allow", ctx, mock.AnythingOfType("string"), 10, time.Hour).Return(true, nil)
+	mockRepo.On("Save", ctx, representative).Return(nil)
+
+	// Execute
+	result, err := uc.Execute(ctx, company.VerifyRepresentativeCommand{
+		RepresentativeID: representative.ID().String(),
+		AccessToken:      token,
+	})
+
+	// Assertions
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, "VERIFIED", result.Status)
+	assert.NotNil(t, result.VerifiedAt)
+	assert.Empty(t, result.AccessToken)
+	assert.Equal(t, 1, verifier.calls)
+
+	// Verify all expectations were met
+	mockRepo.AssertExpectations(t)
+	mockRateLimiter.AssertExpectations(t)
+}
+
+// TestVerifyRepresentativeUseCase_Execute_ChallengeNotFound tests a missing DNS record.
+func TestVerifyRepresentativeUseCase_Execute_ChallengeNotFound(t *testing.T) {
+	mockRepo := new(MockRepresentativeRepository)
+	mockRateLimiter := new(MockRateLimiter)
+	verifier := &StubVerifier{ok: false}
+
+	uc := company.NewVerifyRepresentativeUseCase(mockRepo, approvedDomains(true), verifier, mockRateLimiter)
+
+	ctx := context.Background()
+	representative, token := newPendingRepresentative(t)
+
+	mockRepo.On("FindByID", ctx, representative.ID()).Return(representative, nil)
+	mockRateLimiter.On("Allow", ctx, mock.AnythingOfType("string"), 10, time.Hour).Return(true, nil)
+
+	result, err := uc.Execute(ctx, company.VerifyRepresentativeCommand{
+		RepresentativeID: representative.ID().String(),
+		AccessToken:      token,
+	})
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.True(t, apperrors.IsValidationError(err))
+	assert.False(t, representative.IsVerified())
+	mockRepo.AssertNotCalled(t, "Save")
+}
+
+// TestVerifyRepresentativeUseCase_Execute_VerifierError tests a failing DNS server.
+func TestVerifyRepresentativeUseCase_Execute_VerifierError(t *testing.T) {
+	mockRepo := new(MockRepresentativeRepository)
+	mockRateLimiter := new(MockRateLimiter)
+	verifier := &StubVerifier{err: errors.New("server misbehaving")}
+
+	uc := company.NewVerifyRepresentativeUseCase(mockRepo, approvedDomains(true), verifier, mockRateLimiter)
+
+	ctx := context.Background()
+	representative, token := newPendingRepresentative(t)
+
+	mockRepo.On("FindByID", ctx, representative.ID()).Return(representative, nil)
+	mockRateLimiter.On("Allow", ctx, mock.AnythingOfType("string"), 10, time.Hour).Return(true, nil)
+
+	result, err := uc.Execute(ctx, company.VerifyRepresentativeCommand{
+		RepresentativeID: representative.ID().String(),
+		AccessToken:      token,
+	})
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.True(t, apperrors.IsInternalError(err))
+	mockRepo.AssertNotCalled(t, "Save")
+}
+
+// TestVerifyRepresentativeUseCase_Execute_TokenMismatch tests a wrong access token.
+func TestVerifyRepresentativeUseCase_Execute_TokenMismatch(t *testing.T) {
+	mockRepo := new(MockRepresentativeRepository)
+	mockRateLimiter := new(MockRateLimiter)
+	verifier := &StubVerifier{ok: true}
+
+	uc := company.NewVerifyRepresentativeUseCase(mockRepo, new(MockDomainApprovalRepository), verifier, mockRateLimiter)
+
+	ctx := context.Background()
+	representative, _ := newPendingRepresentative(t)
+
+	mockRepo.On("FindByID", ctx, representative.ID()).Return(representative, nil)
+
+	result, err := uc.Execute(ctx, company.VerifyRepresentativeCommand{
+		RepresentativeID: representative.ID().String(),
+		AccessToken:      "wrong-token",
+	})
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.True(t, apperrors.IsForbiddenError(err))
+	assert.Equal(t, 0, verifier.calls)
+	mockRateLimiter.AssertNotCalled(t, "Allow")
+}
+
+// TestVerifyRepresentativeUseCase_Execute_NotFound tests an unknown representative.
+func TestVerifyRepresentativeUseCase_Execute_NotFound(t *testing.T) {
+	mockRepo := new(MockRepresentativeRepository)
+	mockRateLimiter := new(MockRateLimiter)
+	verifier := &StubVerifier{ok: true}
+
+	uc := company.NewVerifyRepresentativeUseCase(mockRepo, new(MockDomainApprovalRepository), verifier, mockRateLimiter)
+
+	ctx := context.Background()
+	id := domaincompany.GenerateRepresentativeID()
+
+	mockRepo.On("FindByID", ctx, id).Return(nil, apperrors.NewNotFoundError("representative"))
+
+	result, err := uc.Execute(ctx, company.VerifyRepresentativeCommand{
+		RepresentativeID: id.String(),
+		AccessToken:      "token",
+	})
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.True(t, apperrors.IsNotFoundError(err))
+}
+
+// TestVerifyRepresentativeUseCase_Execute_DomainNotApproved tests that a passed
+// challenge does not verify a domain nobody approved for the company.
+func TestVerifyRepresentativeUseCase_Execute_DomainNotApproved(t *testing.T) {
+	mockRepo := new(MockRepresentativeRepository)
+	mockApprovalRepo := new(MockDomainApprovalRepository)
+	mockRateLimiter := new(MockRateLimiter)
+	verifier := &StubVerifier{ok: true}
+
+	uc := company.NewVerifyRepresentativeUseCase(mockRepo, mockApprovalRepo, verifier, mockRateLimiter)
+
+	ctx := context.Background()
+	representative, token := newPendingRepresentative(t)
+
+	mockRepo.On("FindByID", ctx, representative.ID()).Return(representative, nil)
+	mockApprovalRepo.On("IsApproved", ctx, representative.Company(), representative.Domain()).Return(false, nil)
+
+	result, err := uc.Execute(ctx, company.VerifyRepresentativeCommand{
+		RepresentativeID: representative.ID().String(),
+		AccessToken:      token,
+	})
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.True(t, apperrors.IsForbiddenError(err))
+	assert.False(t, representative.IsVerified())
+	assert.Equal(t, 0, verifier.calls)
+	mockRateLimiter.AssertNotCalled(t, "Allow")
+	mockRepo.AssertNotCalled(t, "Save")
+	mockApprovalRepo.AssertExpectations(t)
+}
@@ -0,0 +1,231 @@
+package content_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"fuck_boss/backend/internal/application/content"
+	domaincontent "fuck_boss/backend/internal/domain/content"
+	"fuck_boss/backend/internal/domain/shared"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// newManagedPost creates a post with a management token for follow-up tests.
+func newManagedPost(t *testing.T) (*domaincontent.Post, string) {
+	t.Helper()
+
+	company, _ := domaincontent.NewCompanyName("测试公司")
+	city, _ := shared.NewCity("beijing", "北京")
+	postContent, _ := domaincontent.NewContent("这是一条测试内容，用于验证追加进展功能。内容应该足够长以满足最小长度要求。")
+	post, err := domaincontent.NewPost(company, city, postContent)
+	require.NoError(t, err)
+
+	token, err := domaincontent.GenerateManagementToken()
+	require.NoError(t, err)
+	post.AssignManagementToken(token)
+
+	return post, token.String()
+}
+
+// TestAppendFollowUpUseCase_Execute_Success tests appending a follow-up.
+func TestAppendFollowUpUseCase_Execute_Success(t *testing.T) {
+	// Setup mocks
+	mockRepo := new(MockPostRepository)
+	mockCache := new(MockCacheRepository)
+
+	// Create use case
+	uc := content.NewAppendFollowUpUseCase(mockRepo, mockCache)
+
+	ctx := context.Background()
+	post, token := newManagedPost(t)
+
+	// Setup expectations
+	mockRepo.On("FindByID", ctx, post.ID()).Return(post, nil)
+	mockRepo.On("Save", ctx, post).Return(nil)
+	mockCache.On("Delete", ctx, "post:"+post.ID().String()).Return(nil)
+	mockCache.On("DeleteByPattern", ctx, "post:"+post.ID().String()+":card:*").Return(nil)
+	mockCache.On("DeleteByPattern", ctx, "posts:city:beijing:*").Return(nil)
+	mockCache.On("DeleteByPattern", ctx, "posts:city:all:*").Return(nil)
+
+	// Execute
+	result, err := uc.Execute(ctx, content.AppendFollowUpCommand{
+		PostID:          post.ID().String(),
+		ManagementToken: token,
+		Status:          "resolved",
+		Note:            "公司已补发全部工资",
+	})
+
+	// Assertions
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.NotEmpty(t, result.ID)
+	assert.Equal(t, "RESOLVED", result.Status)
+	assert.Equal(t, "公司已补发全部工资", result.Note)
+	assert.Equal(t, domaincontent.ResolutionStatusResolved, post.Resolution())
+	assert.Len(t, post.FollowUps(), 1)
+
+	// Verify all expectations were met
+	mockRepo.AssertExpectations(t)
+	mockCache.AssertExpectations(t)
+}
+
+// TestAppendFollowUpUseCase_Execute_TokenMismatch tests a wrong management token.
+func TestAppendFollowUpUseCase_Execute_TokenMismatch(t *testing.T) {
+	// Setup mocks
+	mockRepo := new(MockPostRepository)
+	mockCache := new(MockCacheRepository)
+
+	// Create use case
+	uc := content.NewAppendFollowUpUseCase(mockRepo, mockCache)
+
+	ctx := context.Background()
+	post, _ := newManagedPost(t)
+
+	// Setup expectations
+	mockRepo.On("FindByID", ctx, post.ID()).Return(post, nil)
+
+	// Execute
+	result, err := uc.Execute(ctx, content.AppendFollowUpCommand{
+		PostID:          post.ID().String(),
+		ManagementToken: "wrong-token",
+		Status:          "ONGOING",
+		Note:            "仍在协商中",
+	})
+
+	// Assertions
+	require.Error(t, err)
+	assert.Nil(t, result)
+	assert.True(t, apperrors.IsForbiddenError(err))
+	assert.Empty(t, post.FollowUps())
+
+	// Save must not be called
+	mockRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	mockRepo.AssertExpectations(t)
+}
+
+// TestAppendFollowUpUseCase_Execute_NotFound tests a missing post.
+func TestAppendFollowUpUseCase_Execute_NotFound(t *testing.T) {
+	// Setup mocks
+	mockRepo := new(MockPostRepository)
+	mockCache := new(MockCacheRepository)
+
+	// Create use case
+	uc := content.NewAppendFollowUpUseCase(mockRepo, mockCache)
+
+	ctx := context.Background()
+	postID := domaincontent.GeneratePostID()
+
+	// Setup expectations
+	mockRepo.On("FindByID", ctx, postID).Return(nil, apperrors.NewNotFoundError("post not found"))
+
+	// Execute
+	result, err := uc.Execute(ctx, content.AppendFollowUpCommand{
+		PostID:          postID.String(),
+		ManagementToken: "token",
+		Status:          "ONGOING",
+		Note:            "仍在协商中",
+	})
+
+	// Assertions
+	require.Error(t, err)
+	assert.Nil(t, result)
+	assert.True(t, apperrors.IsNotFoundError(err))
+
+	mockRepo.AssertExpectations(t)
+}
+
+// TestAppendFollowUpUseCase_Execute_RepositoryError tests a save failure.
+func TestAppendFollowUpUseCase_Execute_RepositoryError(t *testing.T) {
+	// Setup mocks
+	mockRepo := new(MockPostRepository)
+	mockCache := new(MockCacheRepository)
+
+	// Create use case
+	uc := content.NewAppendFollowUpUseCase(mockRepo, mockCache)
+
+	ctx := context.Background()
+	post, token := newManagedPost(t)
+
+	// Setup expectations
+	mockRepo.On("FindByID", ctx, post.ID()).Return(post, nil)
+	mockRepo.On("Save", ctx, post).Return(errors.New("database error"))
+
+	// Execute
+	result, err := uc.Execute(ctx, content.AppendFollowUpCommand{
+		PostID:          post.ID().String(),
+		ManagementToken: token,
+		Status:          "ESCALATED",
+		Note:            "已经申请劳动仲裁",
+	})
+
+	// Assertions
+	require.Error(t, err)
+	assert.Nil(t, result)
+
+	mockRepo.AssertExpectations(t)
+	mockCache.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+}
+
+// TestAppendFollowUpUseCase_Execute_ValidationError tests validation errors.
+func TestAppendFollowUpUseCase_Execute_ValidationError(t *testing.T) {
+	// Setup mocks
+	mockRepo := new(MockPostRepository)
+	mockCache := new(MockCacheRepository)
+
+	// Create use case
+	uc := content.NewAppendFollowUpUseCase(mockRepo, mockCache)
+
+	ctx := context.Background()
+	postID := domaincontent.GeneratePostID().String()
+
+	testCases := []struct {
+		name    string
+		cmd     content.AppendFollowUpCommand
+		wantErr string
+	}{
+		{
+			name:    "empty post ID",
+			cmd:     content.AppendFollowUpCommand{ManagementToken: "token", Status: "ONGOING", Note: "仍在协商中"},
+			wantErr: "post ID is required",
+		},
+		{
+			name:    "empty management token",
+			cmd:     content.AppendFollowUpCommand{PostID: postID, Status: "ONGOING", Note: "仍在协商中"},
+			wantErr: "management token is required",
+		},
+		{
+			name:    "empty status",
+			cmd:     content.AppendFollowUpCommand{PostID: postID, ManagementToken: "token", Note: "仍在协商中"},
+			wantErr: "status is required",
+		},
+		{
+			name:    "invalid status",
+			cmd:     content.AppendFollowUpCommand{PostID: postID, ManagementToken: "token", Status: "CLOSED", Note: "仍在协商中"},
+			wantErr: "invalid resolution status",
+		},
+		{
+			name:    "note too short",
+			cmd:     content.AppendFollowUpCommand{PostID: postID, ManagementToken: "token", Status: "ONGOING", Note: "好"},
+			wantErr: "invalid follow-up note",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := uc.Execute(ctx, tc.cmd)
+
+			require.Error(t, err)
+			assert.Nil(t, result)
+			assert.True(t, apperrors.IsValidationError(err))
+			assert.Contains(t, err.Error(), tc.wantErr)
+		})
+	}
+
+	// Repository must not be called
+	mockRepo.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+}
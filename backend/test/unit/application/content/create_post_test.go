@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"fuck_boss/backend/internal/application/content"
+	"fuck_boss/backend/internal/application/dto"
 	domaincontent "fuck_boss/backend/internal/domain/content"
 	"fuck_boss/backend/internal/domain/shared"
 	apperrors "fuck_boss/backend/pkg/errors"
@@ -60,6 +61,38 @@ func (m *MockPostRepository) FindAll(ctx context.Context, page, pageSize int) ([
 	return args.Get(0).([]*domaincontent.Post), args.Int(1), args.Error(2)
 }
 
+func (m *MockPostRepository) FindByFilter(ctx context.Context, filter domaincontent.PostFilter, page, pageSize int) ([]*domaincontent.Post, int, error) {
+	args := m.Called(ctx, filter, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*domaincontent.Post), args.Int(1), args.Error(2)
+}
+
+func (m *MockPostRepository) FindSummariesByFilter(ctx context.Context, filter domaincontent.PostFilter, page, pageSize int) ([]*domaincontent.PostSummary, int, error) {
+	args := m.Called(ctx, filter, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*domaincontent.PostSummary), args.Int(1), args.Error(2)
+}
+
+func (m *MockPostRepository) FindByFilterAfter(ctx context.Context, filter domaincontent.PostFilter, after *domaincontent.PostCursor, limit int) ([]*domaincontent.Post, error) {
+	args := m.Called(ctx, filter, after, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domaincontent.Post), args.Error(1)
+}
+
+func (m *MockPostRepository) SearchSummaries(ctx context.Context, keyword string, city *shared.City, page, pageSize int) ([]*domaincontent.PostSummary, int, error) {
+	args := m.Called(ctx, keyword, city, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*domaincontent.PostSummary), args.Int(1), args.Error(2)
+}
+
 // MockCacheRepository is a mock implementation of CacheRepository.
 type MockCacheRepository struct {
 	mock.Mock
@@ -85,6 +118,16 @@ func (m *MockCacheRepository) DeleteByPattern(ctx context.Context, pattern strin
 	return args.Error(0)
 }
 
+// MockLiveFeedPublisher is a mock implementation of livefeed.Publisher.
+type MockLiveFeedPublisher struct {
+	mock.Mock
+}
+
+func (m *MockLiveFeedPublisher) Publish(ctx context.Context, post *dto.PostDTO) error {
+	args := m.Called(ctx, post)
+	return args.Error(0)
+}
+
 // MockRateLimiter is a mock implementation of RateLimiter.
 type MockRateLimiter struct {
 	mock.Mock
@@ -95,7 +138,22 @@ func (m *MockRateLimiter) Allow(ctx context.Context, key string, limit int, wind
 	return args.Bool(0), args.Error(1)
 }
 
+func (m *MockRateLimiter) Reset(ctx context.Context, key string) error {
+	args := m.Called(ctx, key)
+	return args.Error(0)
+}
+
 // TestCreatePostUseCase_Execute_Success tests successful post creation.
+// MockPostNotifier is a mock implementation of PostNotifier.
+type MockPostNotifier struct {
+	mock.Mock
+}
+
+func (m *MockPostNotifier) NotifyWatchedCompanyPost(ctx context.Context, post *domaincontent.Post) error {
+	args := m.Called(ctx, post)
+	return args.Error(0)
+}
+
 func TestCreatePostUseCase_Execute_Success(t *testing.T) {
 	// Setup mocks
 	mockRepo := new(MockPostRepository)
@@ -103,7 +161,7 @@ func TestCreatePostUseCase_Execute_Success(t *testing.T) {
 	mockRateLimiter := new(MockRateLimiter)
 
 	// Create use case
-	uc := content.NewCreatePostUseCase(mockRepo, mockCache, mockRateLimiter)
+	uc := content.NewCreatePostUseCase(mockRepo, mockCache, mockRateLimiter, nil, nil)
 
 	ctx := context.Background()
 	cmd := content.CreatePostCommand{
@@ -132,6 +190,7 @@ func TestCreatePostUseCase_Execute_Success(t *testing.T) {
 	assert.Equal(t, "北京", result.CityName)
 	assert.Equal(t, cmd.Content, result.Content)
 	assert.NotZero(t, result.CreatedAt)
+	assert.NotEmpty(t, result.ManagementToken)
 
 	// Verify all expectations were met
 	mockRepo.AssertExpectations(t)
@@ -139,6 +198,107 @@ func TestCreatePostUseCase_Execute_Success(t *testing.T) {
 	mockRateLimiter.AssertExpectations(t)
 }
 
+// TestCreatePostUseCase_Execute_WithAuthor tests that logged-in users are recorded as author.
+func TestCreatePostUseCase_Execute_WithAuthor(t *testing.T) {
+	// Setup mocks
+	mockRepo := new(MockPostRepository)
+	mockCache := new(MockCacheRepository)
+	mockRateLimiter := new(MockRateLimiter)
+
+	// Create use case
+	uc := content.NewCreatePostUseCase(mockRepo, mockCache, mockRateLimiter, nil, nil)
+
+	ctx := context.Background()
+	cmd := content.CreatePostCommand{
+		Company:  "测试公司",
+		CityCode: "beijing",
+		CityName: "北京",
+		Content:  "这是一条测试内容，用于验证创建功能。内容应该足够长以满足最小长度要求。",
+		ClientIP: "127.0.0.1",
+		AuthorID: "550e8400-e29b-41d4-a716-446655440000",
+	}
+
+	// Setup expectations
+	mockRateLimiter.On("Allow", ctx, mock.AnythingOfType("string"), 3, time.Hour).Return(true, nil)
+	mockRepo.On("Save", ctx, mock.MatchedBy(func(post *domaincontent.Post) bool {
+		return post.AuthorID() == cmd.AuthorID
+	})).Return(nil)
+	mockCache.On("DeleteByPattern", ctx, "posts:city:beijing:*").Return(nil)
+
+	// Execute
+	result, err := uc.Execute(ctx, cmd)
+
+	// Assertions
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	mockRepo.AssertExpectations(t)
+}
+
+// TestCreatePostUseCase_Execute_NotifiesWatchers tests that watchers are notified
+// and that a notifier failure does not fail post creation.
+func TestCreatePostUseCase_Execute_NotifiesWatchers(t *testing.T) {
+	mockRepo := new(MockPostRepository)
+	mockCache := new(MockCacheRepository)
+	mockRateLimiter := new(MockRateLimiter)
+	mockNotifier := new(MockPostNotifier)
+
+	uc := content.NewCreatePostUseCase(mockRepo, mockCache, mockRateLimiter, mockNotifier, nil)
+
+	ctx := context.Background()
+	cmd := content.CreatePostCommand{
+		Company:  "测试公司",
+		CityCode: "beijing",
+		CityName: "北京",
+		Content:  "这是一条测试内容，用于验证通知功能。内容应该足够长以满足最小长度要求。",
+		ClientIP: "127.0.0.1",
+	}
+
+	mockRateLimiter.On("Allow", ctx, mock.AnythingOfType("string"), 3, time.Hour).Return(true, nil)
+	mockRepo.On("Save", ctx, mock.AnythingOfType("*content.Post")).Return(nil)
+	mockCache.On("DeleteByPattern", ctx, "posts:city:beijing:*").Return(nil)
+	mockNotifier.On("NotifyWatchedCompanyPost", ctx, mock.AnythingOfType("*content.Post")).Return(errors.New("notification failed"))
+
+	result, err := uc.Execute(ctx, cmd)
+
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	mockNotifier.AssertExpectations(t)
+}
+
+// TestCreatePostUseCase_Execute_PublishesToLiveFeed tests that the new post is
+// pushed to live feed subscribers without the management token.
+func TestCreatePostUseCase_Execute_PublishesToLiveFeed(t *testing.T) {
+	mockRepo := new(MockPostRepository)
+	mockCache := new(MockCacheRepository)
+	mockRateLimiter := new(MockRateLimiter)
+	mockPublisher := new(MockLiveFeedPublisher)
+
+	uc := content.NewCreatePostUseCase(mockRepo, mockCache, mockRateLimiter, nil, mockPublisher)
+
+	ctx := context.Background()
+	cmd := content.CreatePostCommand{
+		Company:  "测试公司",
+		CityCode: "beijing",
+		CityName: "北京",
+		Content:  "这是一条测试内容，用于验证实时推送功能。内容应该足够长以满足最小长度要求。",
+		ClientIP: "127.0.0.1",
+	}
+
+	mockRateLimiter.On("Allow", ctx, mock.AnythingOfType("string"), 3, time.Hour).Return(true, nil)
+	mockRepo.On("Save", ctx, mock.AnythingOfType("*content.Post")).Return(nil)
+	mockCache.On("DeleteByPattern", ctx, "posts:city:beijing:*").Return(nil)
+	mockPublisher.On("Publish", ctx, mock.MatchedBy(func(post *dto.PostDTO) bool {
+		return post.CityCode == "beijing" && post.Summary != "" && post.ManagementToken == ""
+	})).Return(errors.New("redis down"))
+
+	result, err := uc.Execute(ctx, cmd)
+
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.NotEmpty(t, result.ManagementToken)
+	mockPublisher.AssertExpectations(t)
+}
+
 // TestCreatePostUseCase_Execute_ValidationError tests validation errors.
 func TestCreatePostUseCase_Execute_ValidationError(t *testing.T) {
 	// Setup mocks
@@ -147,7 +307,7 @@ func TestCreatePostUseCase_Execute_ValidationError(t *testing.T) {
 	mockRateLimiter := new(MockRateLimiter)
 
 	// Create use case
-	uc := content.NewCreatePostUseCase(mockRepo, mockCache, mockRateLimiter)
+	uc := content.NewCreatePostUseCase(mockRepo, mockCache, mockRateLimiter, nil, nil)
 
 	ctx := context.Background()
 
@@ -238,7 +398,7 @@ func TestCreatePostUseCase_Execute_RateLimitExceeded(t *testing.T) {
 	mockRateLimiter := new(MockRateLimiter)
 
 	// Create use case
-	uc := content.NewCreatePostUseCase(mockRepo, mockCache, mockRateLimiter)
+	uc := content.NewCreatePostUseCase(mockRepo, mockCache, mockRateLimiter, nil, nil)
 
 	ctx := context.Background()
 	cmd := content.CreatePostCommand{
@@ -274,7 +434,7 @@ func TestCreatePostUseCase_Execute_RateLimitCheckError(t *testing.T) {
 	mockRateLimiter := new(MockRateLimiter)
 
 	// Create use case
-	uc := content.NewCreatePostUseCase(mockRepo, mockCache, mockRateLimiter)
+	uc := content.NewCreatePostUseCase(mockRepo, mockCache, mockRateLimiter, nil, nil)
 
 	ctx := context.Background()
 	cmd := content.CreatePostCommand{
@@ -311,7 +471,7 @@ func TestCreatePostUseCase_Execute_InvalidValueObjects(t *testing.T) {
 	mockRateLimiter := new(MockRateLimiter)
 
 	// Create use case
-	uc := content.NewCreatePostUseCase(mockRepo, mockCache, mockRateLimiter)
+	uc := content.NewCreatePostUseCase(mockRepo, mockCache, mockRateLimiter, nil, nil)
 
 	ctx := context.Background()
 
@@ -382,7 +542,7 @@ func TestCreatePostUseCase_Execute_RepositoryError(t *testing.T) {
 	mockRateLimiter := new(MockRateLimiter)
 
 	// Create use case
-	uc := content.NewCreatePostUseCase(mockRepo, mockCache, mockRateLimiter)
+	uc := content.NewCreatePostUseCase(mockRepo, mockCache, mockRateLimiter, nil, nil)
 
 	ctx := context.Background()
 	cmd := content.CreatePostCommand{
@@ -419,7 +579,7 @@ func TestCreatePostUseCase_Execute_CacheError(t *testing.T) {
 	mockRateLimiter := new(MockRateLimiter)
 
 	// Create use case
-	uc := content.NewCreatePostUseCase(mockRepo, mockCache, mockRateLimiter)
+	uc := content.NewCreatePostUseCase(mockRepo, mockCache, mockRateLimiter, nil, nil)
 
 	ctx := context.Background()
 	cmd := content.CreatePostCommand{
@@ -460,7 +620,7 @@ func TestCreatePostUseCase_Execute_RateLimitKeyFormat(t *testing.T) {
 	mockRateLimiter := new(MockRateLimiter)
 
 	// Create use case
-	uc := content.NewCreatePostUseCase(mockRepo, mockCache, mockRateLimiter)
+	uc := content.NewCreatePostUseCase(mockRepo, mockCache, mockRateLimiter, nil, nil)
 
 	ctx := context.Background()
 	cmd := content.CreatePostCommand{
@@ -0,0 +1,156 @@
+package content_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"fuck_boss/backend/internal/application/content"
+	"fuck_boss/backend/internal/application/dto"
+	domaincontent "fuck_boss/backend/internal/domain/content"
+	"fuck_boss/backend/internal/domain/shared"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// newExportPosts creates n posts created one minute apart, oldest first.
+func newExportPosts(t *testing.T, n int, start time.Time) []*domaincontent.Post {
+	t.Helper()
+
+	company, _ := domaincontent.NewCompanyName("测试公司")
+	city, _ := shared.NewCity("beijing", "北京")
+	postContent, _ := domaincontent.NewContent("这是一条测试内容，用于验证导出功能。内容应该足够长以满足最小长度要求。")
+
+	posts := make([]*domaincontent.Post, 0, n)
+	for i := 0; i < n; i++ {
+		post, err := domaincontent.NewPostFromDB(domaincontent.GeneratePostID(), company, city, postContent,
+			start.Add(time.Duration(i)*time.Minute),
+			domaincontent.WithManagementTokenHash("secret-hash"),
+			domaincontent.WithResolution(domaincontent.ResolutionStatusOngoing))
+		require.NoError(t, err)
+		posts = append(posts, post)
+	}
+	return posts
+}
+
+// TestExportPostsUseCase_Execute_Batches tests walking several batches with the keyset cursor.
+func TestExportPostsUseCase_Execute_Batches(t *testing.T) {
+	mockRepo := new(MockPostRepository)
+	uc := content.NewExportPostsUseCase(mockRepo)
+	ctx := context.Background()
+
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	posts := newExportPosts(t, content.ExportBatchSize+1, from)
+	first, second := posts[:content.ExportBatchSize], posts[content.ExportBatchSize:]
+	last := first[len(first)-1]
+
+	matchFilter := mock.MatchedBy(func(filter domaincontent.PostFilter) bool {
+		return filter.City != nil && filter.City.Code() == "beijing" &&
+			len(filter.Companies) == 1 && filter.Companies[0].String() == "测试公司" &&
+			filter.Resolution != nil && *filter.Resolution == domaincontent.ResolutionStatusOngoing &&
+			filter.CreatedSince.Equal(from) && filter.CreatedBefore.Equal(to)
+	})
+	mockRepo.On("FindByFilterAfter", ctx, matchFilter, (*domaincontent.PostCursor)(nil), content.ExportBatchSize).Return(first, nil)
+	mockRepo.On("FindByFilterAfter", ctx, matchFilter, &domaincontent.PostCursor{CreatedAt: last.CreatedAt(), ID: last.ID()}, content.ExportBatchSize).Return(second, nil)
+
+	var exported []*dto.ExportedPostDTO
+	count, err := uc.Execute(ctx, content.ExportPostsQuery{
+		CityCode:         "beijing",
+		Company:          "测试公司",
+		ResolutionStatus: "ongoing",
+		From:             &from,
+		To:               &to,
+	}, func(post *dto.ExportedPostDTO) error {
+		exported = append(exported, post)
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, len(posts), count)
+	require.Len(t, exported, len(posts))
+	assert.Equal(t, &dto.ExportedPostDTO{
+		ID:               posts[0].ID().String(),
+		CreatedAt:        from,
+		CityCode:         "beijing",
+		CityName:         "北京",
+		Company:          "测试公司",
+		ResolutionStatus: "ONGOING",
+		Content:          posts[0].Content().String(),
+	}, exported[0])
+	assert.Equal(t, second[0].ID().String(), exported[len(exported)-1].ID)
+	mockRepo.AssertExpectations(t)
+}
+
+// TestExportPostsUseCase_Execute_WriteError tests that the export stops at the first write error.
+func TestExportPostsUseCase_Execute_WriteError(t *testing.T) {
+	mockRepo := new(MockPostRepository)
+	uc := content.NewExportPostsUseCase(mockRepo)
+	ctx := context.Background()
+
+	posts := newExportPosts(t, 3, time.Now())
+	mockRepo.On("FindByFilterAfter", ctx, domaincontent.PostFilter{}, (*domaincontent.PostCursor)(nil), content.ExportBatchSize).Return(posts, nil)
+
+	writeErr := errors.New("disk full")
+	calls := 0
+	count, err := uc.Execute(ctx, content.ExportPostsQuery{}, func(*dto.ExportedPostDTO) error {
+		calls++
+		if calls == 2 {
+			return writeErr
+		}
+		return nil
+	})
+
+	assert.ErrorIs(t, err, writeErr)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, 2, calls)
+}
+
+// TestExportPostsUseCase_Execute_RepositoryError tests that repository errors are wrapped.
+func TestExportPostsUseCase_Execute_RepositoryError(t *testing.T) {
+	mockRepo := new(MockPostRepository)
+	uc := content.NewExportPostsUseCase(mockRepo)
+	ctx := context.Background()
+
+	mockRepo.On("FindByFilterAfter", ctx, domaincontent.PostFilter{}, (*domaincontent.PostCursor)(nil), content.ExportBatchSize).
+		Return(nil, errors.New("connection refused"))
+
+	_, err := uc.Execute(ctx, content.ExportPostsQuery{}, func(*dto.ExportedPostDTO) error { return nil })
+
+	var appErr *apperrors.AppError
+	require.True(t, apperrors.As(err, &appErr))
+	assert.Equal(t, apperrors.ErrCodeDatabase, appErr.Code)
+}
+
+// TestExportPostsUseCase_Execute_ValidationError tests that invalid filters are rejected.
+func TestExportPostsUseCase_Execute_ValidationError(t *testing.T) {
+	from := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		query content.ExportPostsQuery
+	}{
+		{name: "invalid status", query: content.ExportPostsQuery{ResolutionStatus: "CLOSED"}},
+		{name: "from after to", query: content.ExportPostsQuery{From: &from, To: &to}},
+		{name: "empty range", query: content.ExportPostsQuery{From: &from, To: &from}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockPostRepository)
+			uc := content.NewExportPostsUseCase(mockRepo)
+
+			_, err := uc.Execute(context.Background(), tt.query, func(*dto.ExportedPostDTO) error { return nil })
+
+			var appErr *apperrors.AppError
+			require.True(t, apperrors.As(err, &appErr))
+			assert.Equal(t, apperrors.ErrCodeValidation, appErr.Code)
+			mockRepo.AssertNotCalled(t, "FindByFilterAfter", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,240 @@
+package content_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"fuck_boss/backend/internal/application/content"
+	domaincontent "fuck_boss/backend/internal/domain/content"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// MockPostImportRepository is a mock implementation of domaincontent.PostImportRepository.
+type MockPostImportRepository struct {
+	mock.Mock
+}
+
+func (m *MockPostImportRepository) FindImported(ctx context.Context, source string, externalIDs []string) (map[string]bool, error) {
+	args := m.Called(ctx, source, externalIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]bool), args.Error(1)
+}
+
+func (m *MockPostImportRepository) Import(ctx context.Context, posts []*domaincontent.ImportedPost) (int, error) {
+	args := m.Called(ctx, posts)
+	return args.Int(0), args.Error(1)
+}
+
+// importRecords returns a next function yielding the records, then io.EOF.
+func importRecords(records ...*content.ImportPostRecord) func() (*content.ImportPostRecord, error) {
+	return func() (*content.ImportPostRecord, error) {
+		if len(records) == 0 {
+			return nil, io.EOF
+		}
+		record := records[0]
+		records = records[1:]
+		return record, nil
+	}
+}
+
+// importRecord returns a valid record with the given line and external ID.
+func importRecord(line int, externalID string) *content.ImportPostRecord {
+	return &content.ImportPostRecord{
+		Line:       line,
+		ExternalID: externalID,
+		CreatedAt:  "2020-05-01 09:00:00",
+		CityCode:   "beijing",
+		CityName:   "北京",
+		Company:    "测试公司",
+		Content:    "这是一条导入的历史投诉内容，长度满足要求。",
+	}
+}
+
+// TestImportPostsUseCase_Execute_Import tests importing valid rows and reporting the others.
+func TestImportPostsUseCase_Execute_Import(t *testing.T) {
+	mockRepo := new(MockPostImportRepository)
+	mockCache := new(MockCacheRepository)
+	uc := content.NewImportPostsUseCase(mockRepo, mockCache)
+	ctx := context.Background()
+
+	short := importRecord(4, "4")
+	short.Content = "太短"
+	resolved := importRecord(3, "3")
+	resolved.ResolutionStatus = "resolved"
+	resolved.OccurredAt = "2020-04-01"
+	badOccurredAt := importRecord(7, "7")
+	badOccurredAt.OccurredAt = "上个月"
+
+	mockRepo.On("FindImported", ctx, "partner-a", []string{"1", "2", "3"}).Return(map[string]bool{"2": true}, nil)
+	mockRepo.On("Import", ctx, mock.MatchedBy(func(posts []*domaincontent.ImportedPost) bool {
+		return len(posts) == 2 &&
+			posts[0].Ref().ID() == "1" && posts[0].Ref().Source() == "partner-a" &&
+			posts[0].Post().CreatedAt().Equal(time.Date(2020, 5, 1, 9, 0, 0, 0, time.UTC)) && posts[0].OccurredAt() == nil &&
+			posts[1].Ref().ID() == "3" && posts[1].Post().Resolution() == domaincontent.ResolutionStatusResolved &&
+			posts[1].OccurredAt() != nil && posts[1].OccurredAt().Equal(time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC))
+	})).Return(2, nil)
+	mockCache.On("DeleteByPattern", ctx, "posts:city:beijing:*").Return(nil)
+	mockCache.On("DeleteByPattern", ctx, "posts:city:all:*").Return(nil)
+
+	report, err := uc.Execute(ctx, content.ImportPostsCommand{Source: "partner-a"}, importRecords(
+		importRecord(1, "1"),
+		importRecord(2, "2"),
+		resolved,
+		short,
+		importRecord(5, "1"),
+		&content.ImportPostRecord{Line: 6, Err: errors.New("malformed JSON")},
+		badOccurredAt,
+	))
+
+	require.NoError(t, err)
+	assert.False(t, report.DryRun)
+	assert.Equal(t, 7, report.Rows)
+	assert.Equal(t, 2, report.Imported)
+	assert.Equal(t, 1, report.Skipped)
+	assert.Equal(t, 4, report.Invalid)
+	require.Len(t, report.Errors, 4)
+	assert.Equal(t, 4, report.Errors[0].Line)
+	assert.Equal(t, "4", report.Errors[0].ExternalID)
+	assert.Contains(t, report.Errors[0].Error, "invalid content")
+	assert.Equal(t, "duplicate external ID (first on line 1)", report.Errors[1].Error)
+	assert.Equal(t, "malformed JSON", report.Errors[2].Error)
+	assert.Contains(t, report.Errors[3].Error, "invalid occurred at")
+	mockRepo.AssertExpectations(t)
+	mockCache.AssertExpectations(t)
+}
+
+// TestImportPostsUseCase_Execute_DryRun tests that a dry run validates and counts without writing.
+func TestImportPostsUseCase_Execute_DryRun(t *testing.T) {
+	mockRepo := new(MockPostImportRepository)
+	mockCache := new(MockCacheRepository)
+	uc := content.NewImportPostsUseCase(mockRepo, mockCache)
+	ctx := context.Background()
+
+	mockRepo.On("FindImported", ctx, "partner-a", []string{"1", "2"}).Return(map[string]bool{"1": true}, nil)
+
+	noCity := importRecord(3, "3")
+	noCity.CityName = ""
+
+	report, err := uc.Execute(ctx, content.ImportPostsCommand{Source: "partner-a", DryRun: true}, importRecords(
+		importRecord(1, "1"),
+		importRecord(2, "2"),
+		noCity,
+	))
+
+	require.NoError(t, err)
+	assert.True(t, report.DryRun)
+	assert.Equal(t, 1, report.Imported)
+	assert.Equal(t, 1, report.Skipped)
+	assert.Equal(t, 1, report.Invalid)
+	mockRepo.AssertNotCalled(t, "Import", mock.Anything, mock.Anything)
+	mockCache.AssertNotCalled(t, "DeleteByPattern", mock.Anything, mock.Anything)
+}
+
+// TestImportPostsUseCase_Execute_Batches tests that valid rows are written in batches.
+func TestImportPostsUseCase_Execute_Batches(t *testing.T) {
+	mockRepo := new(MockPostImportRepository)
+	uc := content.NewImportPostsUseCase(mockRepo, nil)
+	ctx := context.Background()
+
+	total := content.ImportBatchSize + 1
+	n := 0
+	next := func() (*content.ImportPostRecord, error) {
+		if n == total {
+			return nil, io.EOF
+		}
+		n++
+		return importRecord(n, time.Duration(n).String()), nil
+	}
+
+	mockRepo.On("FindImported", ctx, "partner-a", mock.Anything).Return(map[string]bool{}, nil)
+	mockRepo.On("Import", ctx, mock.MatchedBy(func(posts []*domaincontent.ImportedPost) bool {
+		return len(posts) == content.ImportBatchSize
+	})).Return(content.ImportBatchSize, nil).Once()
+	mockRepo.On("Import", ctx, mock.MatchedBy(func(posts []*domaincontent.ImportedPost) bool {
+		return len(posts) == 1
+	})).Return(1, nil).Once()
+
+	report, err := uc.Execute(ctx, content.ImportPostsCommand{Source: "partner-a"}, next)
+
+	require.NoError(t, err)
+	assert.Equal(t, total, report.Imported)
+	mockRepo.AssertExpectations(t)
+}
+
+// TestImportPostsUseCase_Execute_Location tests reading times without an offset in the given time zone.
+func TestImportPostsUseCase_Execute_Location(t *testing.T) {
+	mockRepo := new(MockPostImportRepository)
+	uc := content.NewImportPostsUseCase(mockRepo, nil)
+	ctx := context.Background()
+
+	withOffset := importRecord(2, "2")
+	withOffset.CreatedAt = "2020-05-01T09:00:00Z"
+	dateOnly := importRecord(3, "3")
+	dateOnly.CreatedAt = "2020/05/01"
+
+	mockRepo.On("FindImported", ctx, "partner-a", mock.Anything).Return(map[string]bool{}, nil)
+	mockRepo.On("Import", ctx, mock.MatchedBy(func(posts []*domaincontent.ImportedPost) bool {
+		return len(posts) == 3 &&
+			posts[0].Post().CreatedAt().Equal(time.Date(2020, 5, 1, 1, 0, 0, 0, time.UTC)) &&
+			posts[1].Post().CreatedAt().Equal(time.Date(2020, 5, 1, 9, 0, 0, 0, time.UTC)) &&
+			posts[2].Post().CreatedAt().Equal(time.Date(2020, 4, 30, 16, 0, 0, 0, time.UTC))
+	})).Return(3, nil)
+
+	report, err := uc.Execute(ctx, content.ImportPostsCommand{Source: "partner-a", Location: time.FixedZone("CST", 8*3600)},
+		importRecords(importRecord(1, "1"), withOffset, dateOnly))
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, report.Imported)
+	mockRepo.AssertExpectations(t)
+}
+
+// TestImportPostsUseCase_Execute_InvalidTime tests that unparseable times are reported per row.
+func TestImportPostsUseCase_Execute_InvalidTime(t *testing.T) {
+	mockRepo := new(MockPostImportRepository)
+	uc := content.NewImportPostsUseCase(mockRepo, nil)
+
+	future := importRecord(1, "1")
+	future.CreatedAt = time.Now().Add(48 * time.Hour).Format(time.RFC3339)
+	garbage := importRecord(2, "2")
+	garbage.CreatedAt = "去年"
+
+	report, err := uc.Execute(context.Background(), content.ImportPostsCommand{Source: "partner-a"}, importRecords(future, garbage))
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, report.Invalid)
+	assert.Contains(t, report.Errors[0].Error, "future")
+	assert.Contains(t, report.Errors[1].Error, "invalid created at")
+	mockRepo.AssertNotCalled(t, "Import", mock.Anything, mock.Anything)
+}
+
+// TestImportPostsUseCase_Execute_InvalidSource tests that the source name is validated.
+func TestImportPostsUseCase_Execute_InvalidSource(t *testing.T) {
+	uc := content.NewImportPostsUseCase(new(MockPostImportRepository), nil)
+
+	_, err := uc.Execute(context.Background(), content.ImportPostsCommand{Source: "Partner A"}, importRecords())
+
+	var appErr *apperrors.AppError
+	require.True(t, apperrors.As(err, &appErr))
+	assert.Equal(t, apperrors.ErrCodeValidation, appErr.Code)
+}
+
+// TestImportPostsUseCase_Execute_ReadError tests that reader errors stop the import.
+func TestImportPostsUseCase_Execute_ReadError(t *testing.T) {
+	uc := content.NewImportPostsUseCase(new(MockPostImportRepository), nil)
+	readErr := errors.New("unexpected EOF in quoted field")
+
+	_, err := uc.Execute(context.Background(), content.ImportPostsCommand{Source: "partner-a"}, func() (*content.ImportPostRecord, error) {
+		return nil, readErr
+	})
+
+	assert.ErrorIs(t, err, readErr)
+}
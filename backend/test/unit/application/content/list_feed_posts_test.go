@@ -0,0 +1,108 @@
+package content_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"fuck_boss/backend/internal/application/content"
+	domaincontent "fuck_boss/backend/internal/domain/content"
+	"fuck_boss/backend/internal/domain/shared"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// TestListFeedPostsUseCase_Execute_City tests a city feed cached under the city list prefix.
+func TestListFeedPostsUseCase_Execute_City(t *testing.T) {
+	mockRepo := new(MockPostRepository)
+	mockCache := new(MockCacheRepository)
+	uc := content.NewListFeedPostsUseCase(mockRepo, mockCache)
+
+	ctx := context.Background()
+	company, _ := domaincontent.NewCompanyName("测试公司")
+	city, _ := shared.NewCity("beijing", "北京")
+	summary := domaincontent.NewPostSummaryFromDB(domaincontent.GeneratePostID(), company, city,
+		"拖欠工资", false, time.Now(), "")
+
+	cacheKey := "posts:city:beijing:feed"
+	mockCache.On("Get", ctx, cacheKey).Return("", errors.New("cache miss"))
+	mockRepo.On("FindSummariesByFilter", ctx, mock.MatchedBy(func(filter domaincontent.PostFilter) bool {
+		return filter.City != nil && filter.City.Code() == "beijing" && filter.Companies == nil
+	}), 1, content.FeedSize).Return([]*domaincontent.PostSummary{summary}, 120, nil)
+	mockCache.On("Set", ctx, cacheKey, mock.AnythingOfType("string"), 10*time.Minute).Return(nil)
+
+	result, err := uc.Execute(ctx, content.ListFeedPostsQuery{CityCode: "beijing"})
+
+	require.NoError(t, err)
+	require.Len(t, result.Posts, 1)
+	assert.Equal(t, "北京", result.Posts[0].CityName)
+	assert.Equal(t, "拖欠工资", result.Posts[0].Summary)
+	assert.Empty(t, result.Posts[0].Content)
+	assert.Equal(t, 1, result.Total)
+	mockRepo.AssertExpectations(t)
+	mockCache.AssertExpectations(t)
+}
+
+// TestListFeedPostsUseCase_Execute_Company tests a company feed served from the cache.
+func TestListFeedPostsUseCase_Execute_Company(t *testing.T) {
+	mockRepo := new(MockPostRepository)
+	mockCache := new(MockCacheRepository)
+	uc := content.NewListFeedPostsUseCase(mockRepo, mockCache)
+
+	ctx := context.Background()
+	mockCache.On("Get", ctx, "feed:company:某某科技").
+		Return(`{"posts":[{"id":"post-1","company":"某某科技"}],"total":1,"page":1,"pageSize":50}`, nil)
+
+	result, err := uc.Execute(ctx, content.ListFeedPostsQuery{Company: " 某某科技 "})
+
+	require.NoError(t, err)
+	require.Len(t, result.Posts, 1)
+	assert.Equal(t, "post-1", result.Posts[0].ID)
+	mockRepo.AssertNotCalled(t, "FindSummariesByFilter", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestListFeedPostsUseCase_Execute_Search tests a search feed.
+func TestListFeedPostsUseCase_Execute_Search(t *testing.T) {
+	mockRepo := new(MockPostRepository)
+	mockCache := new(MockCacheRepository)
+	uc := content.NewListFeedPostsUseCase(mockRepo, mockCache)
+
+	ctx := context.Background()
+	mockCache.On("Get", ctx, "feed:search:hr").Return("", errors.New("cache miss"))
+	mockRepo.On("SearchSummaries", ctx, "HR", (*shared.City)(nil), 1, content.FeedSize).
+		Return([]*domaincontent.PostSummary{}, 0, nil)
+	mockCache.On("Set", ctx, "feed:search:hr", mock.AnythingOfType("string"), 10*time.Minute).Return(nil)
+
+	result, err := uc.Execute(ctx, content.ListFeedPostsQuery{Keyword: "HR"})
+
+	require.NoError(t, err)
+	assert.Empty(t, result.Posts)
+	mockRepo.AssertExpectations(t)
+}
+
+// TestListFeedPostsUseCase_Execute_ValidationError tests feed selections that are not exactly one filter.
+func TestListFeedPostsUseCase_Execute_ValidationError(t *testing.T) {
+	tests := []struct {
+		name  string
+		query content.ListFeedPostsQuery
+	}{
+		{name: "no filter", query: content.ListFeedPostsQuery{}},
+		{name: "two filters", query: content.ListFeedPostsQuery{CityCode: "beijing", Company: "某某科技"}},
+		{name: "short keyword", query: content.ListFeedPostsQuery{Keyword: "a"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := content.NewListFeedPostsUseCase(new(MockPostRepository), new(MockCacheRepository))
+
+			_, err := uc.Execute(context.Background(), tt.query)
+
+			require.Error(t, err)
+			assert.True(t, apperrors.IsValidationError(err))
+		})
+	}
+}
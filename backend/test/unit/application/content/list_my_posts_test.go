@@ -0,0 +1,59 @@
+package content_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"fuck_boss/backend/internal/application/content"
+	domaincontent "fuck_boss/backend/internal/domain/content"
+	"fuck_boss/backend/internal/domain/shared"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+func TestListMyPostsUseCase_Execute_Success(t *testing.T) {
+	// Setup mocks
+	mockRepo := new(MockPostRepository)
+	uc := content.NewListMyPostsUseCase(mockRepo)
+
+	ctx := context.Background()
+	userID := "550e8400-e29b-41d4-a716-446655440000"
+
+	company, _ := domaincontent.NewCompanyName("测试公司")
+	city, _ := shared.NewCity("beijing", "北京")
+	postContent, _ := domaincontent.NewContent("这是一条测试内容，用于验证我的帖子列表功能。内容应该足够长以满足最小长度要求。")
+	post, err := domaincontent.NewPost(company, city, postContent)
+	require.NoError(t, err)
+	post.AssignAuthor(userID)
+
+	// Only the user's own posts are requested
+	mockRepo.On("FindByFilter", ctx, mock.MatchedBy(func(filter domaincontent.PostFilter) bool {
+		return filter.AuthorID != nil && *filter.AuthorID == userID && filter.City == nil
+	}), 1, 20).Return([]*domaincontent.Post{post}, 1, nil)
+
+	// Execute
+	result, err := uc.Execute(ctx, content.ListMyPostsQuery{UserID: userID})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Total)
+	assert.Equal(t, 1, result.Page)
+	assert.Equal(t, 20, result.PageSize)
+	require.Len(t, result.Posts, 1)
+	assert.Equal(t, post.ID().String(), result.Posts[0].ID)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestListMyPostsUseCase_Execute_Anonymous(t *testing.T) {
+	mockRepo := new(MockPostRepository)
+	uc := content.NewListMyPostsUseCase(mockRepo)
+
+	result, err := uc.Execute(context.Background(), content.ListMyPostsQuery{})
+
+	assert.Nil(t, result)
+	assert.True(t, apperrors.IsUnauthenticatedError(err))
+	mockRepo.AssertNotCalled(t, "FindByFilter", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -4,6 +4,7 @@ package content_test
 
 import (
 	"context"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"testing"
@@ -433,3 +434,225 @@ func TestListPostsUseCase_Execute_EmptyResult(t *testing.T) {
 	mockRepo.AssertExpectations(t)
 	mockCache.AssertExpectations(t)
 }
+
+// TestListPostsUseCase_Execute_ResolutionFilter tests filtering by resolution status.
+func TestListPostsUseCase_Execute_ResolutionFilter(t *testing.T) {
+	// Setup mocks
+	mockRepo := new(MockPostRepository)
+	mockCache := new(MockCacheRepository)
+
+	// Create use case
+	uc := content.NewListPostsUseCase(mockRepo, mockCache)
+
+	ctx := context.Background()
+	query := content.ListPostsQuery{
+		CityCode:         "beijing",
+		Page:             1,
+		PageSize:         20,
+		ResolutionStatus: "resolved",
+	}
+
+	// Create test post
+	company, _ := domaincontent.NewCompanyName("测试公司")
+	city, _ := shared.NewCity("beijing", "北京")
+	postContent, _ := domaincontent.NewContent("这是一条测试内容，用于验证创建功能。内容应该足够长以满足最小长度要求。")
+	post, _ := domaincontent.NewPostFromDB(domaincontent.GeneratePostID(), company, city, postContent, time.Now(),
+		domaincontent.WithResolution(domaincontent.ResolutionStatusResolved))
+
+	// Setup expectations
+	cacheKey := "posts:city:beijing:resolution:RESOLVED:page:1"
+	mockCache.On("Get", ctx, cacheKey).Return("", errors.New("cache miss"))
+	mockRepo.On("FindByFilter", ctx, mock.MatchedBy(func(filter domaincontent.PostFilter) bool {
+		return filter.City != nil && filter.City.Code() == "beijing" &&
+			filter.Resolution != nil && *filter.Resolution == domaincontent.ResolutionStatusResolved
+	}), 1, 20).Return([]*domaincontent.Post{post}, 1, nil)
+	mockCache.On("Set", ctx, cacheKey, mock.AnythingOfType("string"), 5*time.Minute).Return(nil)
+
+	// Execute
+	result, err := uc.Execute(ctx, query)
+
+	// Assertions
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, 1, result.Total)
+	assert.Equal(t, "RESOLVED", result.Posts[0].ResolutionStatus)
+
+	// Verify all expectations
+	mockRepo.AssertExpectations(t)
+	mockCache.AssertExpectations(t)
+}
+
+// TestListPostsUseCase_Execute_CompanyFilter tests filtering by company across all cities.
+func TestListPostsUseCase_Execute_CompanyFilter(t *testing.T) {
+	// Setup mocks
+	mockRepo := new(MockPostRepository)
+	mockCache := new(MockCacheRepository)
+
+	// Create use case
+	uc := content.NewListPostsUseCase(mockRepo, mockCache)
+
+	ctx := context.Background()
+	query := content.ListPostsQuery{
+		Company: "测试公司",
+		Page:    2,
+		View:    dto.PostViewBasic,
+	}
+
+	// Create test summary
+	company, _ := domaincontent.NewCompanyName("测试公司")
+	city, _ := shared.NewCity("beijing", "北京")
+	summary := domaincontent.NewPostSummaryFromDB(domaincontent.GeneratePostID(), company, city, "摘要", false, time.Now(), "")
+
+	// Setup expectations
+	cacheKey := "posts:city:all:company:" + hex.EncodeToString([]byte("测试公司")) + ":view:basic:page:2"
+	mockCache.On("Get", ctx, cacheKey).Return("", errors.New("cache miss"))
+	mockRepo.On("FindSummariesByFilter", ctx, mock.MatchedBy(func(filter domaincontent.PostFilter) bool {
+		return filter.City == nil && len(filter.Companies) == 1 && filter.Companies[0].String() == "测试公司"
+	}), 2, 20).Return([]*domaincontent.PostSummary{summary}, 21, nil)
+	mockCache.On("Set", ctx, cacheKey, mock.AnythingOfType("string"), 10*time.Minute).Return(nil)
+
+	// Execute
+	result, err := uc.Execute(ctx, query)
+
+	// Assertions
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, 21, result.Total)
+	require.Len(t, result.Posts, 1)
+	assert.Equal(t, "测试公司", result.Posts[0].Company)
+
+	// Verify all expectations
+	mockRepo.AssertExpectations(t)
+	mockCache.AssertExpectations(t)
+}
+
+// TestListPostsUseCase_Execute_CompanyCacheKey tests that company names containing
+// key separators get their own cache keys.
+func TestListPostsUseCase_Execute_CompanyCacheKey(t *testing.T) {
+	// Setup mocks
+	mockRepo := new(MockPostRepository)
+	mockCache := new(MockCacheRepository)
+
+	// Create use case
+	uc := content.NewListPostsUseCase(mockRepo, mockCache)
+
+	ctx := context.Background()
+
+	// Unescaped, both queries would use "posts:city:all:company:某公司:resolution:RESOLVED:page:1"
+	spoofed := content.ListPostsQuery{Company: "某公司:resolution:RESOLVED", Page: 1}
+	resolved := content.ListPostsQuery{Company: "某公司", ResolutionStatus: "RESOLVED", Page: 1}
+	spoofedKey := "posts:city:all:company:" + hex.EncodeToString([]byte("某公司:resolution:RESOLVED")) + ":page:1"
+	resolvedKey := "posts:city:all:company:" + hex.EncodeToString([]byte("某公司")) + ":resolution:RESOLVED:page:1"
+
+	// Setup expectations
+	mockCache.On("Get", ctx, spoofedKey).Return("", errors.New("cache miss"))
+	mockCache.On("Get", ctx, resolvedKey).Return("", errors.New("cache miss"))
+	mockRepo.On("FindByFilter", ctx, mock.Anything, 1, 20).Return([]*domaincontent.Post{}, 0, nil)
+	mockCache.On("Set", ctx, spoofedKey, mock.AnythingOfType("string"), mock.Anything).Return(nil)
+	mockCache.On("Set", ctx, resolvedKey, mock.AnythingOfType("string"), mock.Anything).Return(nil)
+
+	// Execute
+	_, err := uc.Execute(ctx, spoofed)
+	require.NoError(t, err)
+	_, err = uc.Execute(ctx, resolved)
+	require.NoError(t, err)
+
+	// Assertions
+	mockRepo.AssertNumberOfCalls(t, "FindByFilter", 2)
+	mockCache.AssertExpectations(t)
+}
+
+// TestListPostsUseCase_Execute_InvalidResolutionStatus tests an unknown resolution status.
+func TestListPostsUseCase_Execute_InvalidResolutionStatus(t *testing.T) {
+	// Setup mocks
+	mockRepo := new(MockPostRepository)
+	mockCache := new(MockCacheRepository)
+
+	// Create use case
+	uc := content.NewListPostsUseCase(mockRepo, mockCache)
+
+	// Execute
+	result, err := uc.Execute(context.Background(), content.ListPostsQuery{
+		CityCode:         "beijing",
+		Page:             1,
+		PageSize:         20,
+		ResolutionStatus: "CLOSED",
+	})
+
+	// Assertions
+	require.Error(t, err)
+	assert.Nil(t, result)
+	assert.True(t, apperrors.IsValidationError(err))
+}
+
+// TestListPostsUseCase_Execute_BasicView tests the summary-only list view.
+func TestListPostsUseCase_Execute_BasicView(t *testing.T) {
+	// Setup mocks
+	mockRepo := new(MockPostRepository)
+	mockCache := new(MockCacheRepository)
+
+	// Create use case
+	uc := content.NewListPostsUseCase(mockRepo, mockCache)
+
+	ctx := context.Background()
+	query := content.ListPostsQuery{
+		CityCode: "beijing",
+		Page:     1,
+		PageSize: 20,
+		View:     dto.PostViewBasic,
+	}
+
+	// Create test summary
+	company, _ := domaincontent.NewCompanyName("测试公司")
+	city, _ := shared.NewCity("beijing", "北京")
+	summary := domaincontent.NewPostSummaryFromDB(domaincontent.GeneratePostID(), company, city,
+		"这是一条**测试**内容", true, time.Now(), "")
+
+	// Setup expectations
+	cacheKey := "posts:city:beijing:view:basic:page:1"
+	mockCache.On("Get", ctx, cacheKey).Return("", errors.New("cache miss"))
+	mockRepo.On("FindSummariesByFilter", ctx, mock.MatchedBy(func(filter domaincontent.PostFilter) bool {
+		return filter.City != nil && filter.City.Code() == "beijing" && filter.Resolution == nil
+	}), 1, 20).Return([]*domaincontent.PostSummary{summary}, 1, nil)
+	mockCache.On("Set", ctx, cacheKey, mock.AnythingOfType("string"), 5*time.Minute).Return(nil)
+
+	// Execute
+	result, err := uc.Execute(ctx, query)
+
+	// Assertions
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.Len(t, result.Posts, 1)
+	assert.Equal(t, "这是一条测试内容...", result.Posts[0].Summary)
+	assert.Empty(t, result.Posts[0].Content)
+	assert.Empty(t, result.Posts[0].ContentHTML)
+	assert.Equal(t, "测试公司", result.Posts[0].Company)
+
+	// Verify all expectations
+	mockRepo.AssertExpectations(t)
+	mockCache.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "FindByFilter", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestListPostsUseCase_Execute_InvalidView tests an unknown list view.
+func TestListPostsUseCase_Execute_InvalidView(t *testing.T) {
+	// Setup mocks
+	mockRepo := new(MockPostRepository)
+	mockCache := new(MockCacheRepository)
+
+	// Create use case
+	uc := content.NewListPostsUseCase(mockRepo, mockCache)
+
+	// Execute
+	result, err := uc.Execute(context.Background(), content.ListPostsQuery{
+		CityCode: "beijing",
+		Page:     1,
+		PageSize: 20,
+		View:     "COMPACT",
+	})
+
+	// Assertions
+	require.Error(t, err)
+	assert.Nil(t, result)
+	assert.True(t, apperrors.IsValidationError(err))
+}
@@ -0,0 +1,195 @@
+package content_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"fuck_boss/backend/internal/application/content"
+	domaincontent "fuck_boss/backend/internal/domain/content"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// MockPostModerationRepository is a mock implementation of PostModerationRepository.
+type MockPostModerationRepository struct {
+	mock.Mock
+}
+
+func (m *MockPostModerationRepository) FindByIDAnyStatus(ctx context.Context, id domaincontent.PostID) (*domaincontent.Post, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domaincontent.Post), args.Error(1)
+}
+
+func (m *MockPostModerationRepository) SaveModeration(ctx context.Context, post *domaincontent.Post) error {
+	args := m.Called(ctx, post)
+	return args.Error(0)
+}
+
+// MockModerationNotifier is a mock implementation of ModerationNotifier.
+type MockModerationNotifier struct {
+	mock.Mock
+}
+
+func (m *MockModerationNotifier) NotifyModerationOutcome(ctx context.Context, post *domaincontent.Post, outcome, reason string) error {
+	args := m.Called(ctx, post, outcome, reason)
+	return args.Error(0)
+}
+
+// expectModerationCacheClear sets up the cache invalidation of a moderated post.
+func expectModerationCacheClear(mockCache *MockCacheRepository, ctx context.Context, post *domaincontent.Post) {
+	mockCache.On("Delete", ctx, "post:"+post.ID().String()).Return(nil)
+	mockCache.On("DeleteByPattern", ctx, "post:"+post.ID().String()+":card:*").Return(nil)
+	mockCache.On("DeleteByPattern", ctx, "posts:city:beijing:*").Return(nil)
+	mockCache.On("DeleteByPattern", ctx, "posts:city:all:*").Return(nil)
+	mockCache.On("DeleteByPattern", ctx, "feed:*").Return(nil)
+	mockCache.On("DeleteByPattern", ctx, "search:*").Return(nil)
+	mockCache.On("DeleteByPattern", ctx, "related:*").Return(nil)
+}
+
+// TestModeratePostUseCase_Execute_Hide tests hiding a visible post.
+func TestModeratePostUseCase_Execute_Hide(t *testing.T) {
+	mockRepo := new(MockPostModerationRepository)
+	mockCache := new(MockCacheRepository)
+	mockNotifier := new(MockModerationNotifier)
+	mockHistory := new(MockLiveFeedHistory)
+	uc := content.NewModeratePostUseCase(mockRepo, mockCache, mockNotifier, mockHistory)
+
+	ctx := context.Background()
+	post, _ := newManagedPost(t)
+
+	mockRepo.On("FindByIDAnyStatus", ctx, post.ID()).Return(post, nil)
+	mockRepo.On("SaveModeration", ctx, post).Return(nil)
+	expectModerationCacheClear(mockCache, ctx, post)
+	mockHistory.On("Forget", ctx, post.ID().String()).Return(nil)
+	mockNotifier.On("NotifyModerationOutcome", ctx, post, "HIDDEN", "包含个人隐私信息").Return(nil)
+
+	result, err := uc.Execute(ctx, content.ModeratePostCommand{
+		PostID:      post.ID().String(),
+		Action:      "hide",
+		Reason:      " 包含个人隐私信息 ",
+		ModeratorID: "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, post.ID().String(), result.PostID)
+	assert.Equal(t, "HIDDEN", result.Status)
+	assert.Equal(t, "包含个人隐私信息", result.Reason)
+	assert.False(t, result.ModeratedAt.IsZero())
+	assert.Equal(t, "7c9e6679-7425-40de-944b-e07fc1f90ae7", result.ModeratedBy)
+	assert.False(t, post.IsVisible())
+
+	mockRepo.AssertExpectations(t)
+	mockCache.AssertExpectations(t)
+	mockHistory.AssertExpectations(t)
+	mockNotifier.AssertExpectations(t)
+}
+
+// TestModeratePostUseCase_Execute_Restore tests restoring a removed post.
+func TestModeratePostUseCase_Execute_Restore(t *testing.T) {
+	mockRepo := new(MockPostModerationRepository)
+	mockCache := new(MockCacheRepository)
+	mockNotifier := new(MockModerationNotifier)
+	mockHistory := new(MockLiveFeedHistory)
+	uc := content.NewModeratePostUseCase(mockRepo, mockCache, mockNotifier, mockHistory)
+
+	ctx := context.Background()
+	post, _ := newManagedPost(t)
+	reason, _ := domaincontent.NewModerationReason("重复发布")
+	require.NoError(t, post.Remove(reason, ""))
+
+	mockRepo.On("FindByIDAnyStatus", ctx, post.ID()).Return(post, nil)
+	mockRepo.On("SaveModeration", ctx, post).Return(nil)
+	expectModerationCacheClear(mockCache, ctx, post)
+	mockNotifier.On("NotifyModerationOutcome", ctx, post, "RESTORED", "").Return(nil)
+
+	result, err := uc.Execute(ctx, content.ModeratePostCommand{
+		PostID: post.ID().String(),
+		Action: content.ModerationActionRestore,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "VISIBLE", result.Status)
+	assert.Empty(t, result.Reason)
+	assert.True(t, post.IsVisible())
+
+	mockRepo.AssertExpectations(t)
+	mockNotifier.AssertExpectations(t)
+	mockHistory.AssertNotCalled(t, "Forget", mock.Anything, mock.Anything)
+}
+
+// TestModeratePostUseCase_Execute_Conflict tests an action that does not apply.
+func TestModeratePostUseCase_Execute_Conflict(t *testing.T) {
+	mockRepo := new(MockPostModerationRepository)
+	mockCache := new(MockCacheRepository)
+	uc := content.NewModeratePostUseCase(mockRepo, mockCache, nil, nil)
+
+	ctx := context.Background()
+	post, _ := newManagedPost(t)
+
+	mockRepo.On("FindByIDAnyStatus", ctx, post.ID()).Return(post, nil)
+
+	result, err := uc.Execute(ctx, content.ModeratePostCommand{
+		PostID: post.ID().String(),
+		Action: content.ModerationActionRestore,
+	})
+
+	require.Error(t, err)
+	assert.Nil(t, result)
+	assert.True(t, apperrors.IsConflictError(err))
+	mockRepo.AssertNotCalled(t, "SaveModeration", mock.Anything, mock.Anything)
+}
+
+// TestModeratePostUseCase_Execute_ValidationError tests invalid commands.
+func TestModeratePostUseCase_Execute_ValidationError(t *testing.T) {
+	postID := domaincontent.GeneratePostID().String()
+	tests := []struct {
+		name string
+		cmd  content.ModeratePostCommand
+	}{
+		{name: "missing post ID", cmd: content.ModeratePostCommand{Action: content.ModerationActionRestore}},
+		{name: "invalid post ID", cmd: content.ModeratePostCommand{PostID: "not-a-uuid", Action: content.ModerationActionRestore}},
+		{name: "unknown action", cmd: content.ModeratePostCommand{PostID: postID, Action: "DELETE", Reason: "垃圾广告"}},
+		{name: "hide without reason", cmd: content.ModeratePostCommand{PostID: postID, Action: content.ModerationActionHide}},
+		{name: "remove without reason", cmd: content.ModeratePostCommand{PostID: postID, Action: content.ModerationActionRemove, Reason: "  "}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockPostModerationRepository)
+			uc := content.NewModeratePostUseCase(mockRepo, new(MockCacheRepository), nil, nil)
+
+			result, err := uc.Execute(context.Background(), tt.cmd)
+
+			require.Error(t, err)
+			assert.Nil(t, result)
+			assert.True(t, apperrors.IsValidationError(err))
+			mockRepo.AssertNotCalled(t, "FindByIDAnyStatus", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+// TestModeratePostUseCase_Execute_NotFound tests a missing post.
+func TestModeratePostUseCase_Execute_NotFound(t *testing.T) {
+	mockRepo := new(MockPostModerationRepository)
+	uc := content.NewModeratePostUseCase(mockRepo, new(MockCacheRepository), nil, nil)
+
+	ctx := context.Background()
+	postID := domaincontent.GeneratePostID()
+	mockRepo.On("FindByIDAnyStatus", ctx, postID).Return(nil, apperrors.NewNotFoundError("post"))
+
+	result, err := uc.Execute(ctx, content.ModeratePostCommand{
+		PostID: postID.String(),
+		Action: content.ModerationActionRemove,
+		Reason: "垃圾广告",
+	})
+
+	require.Error(t, err)
+	assert.Nil(t, result)
+	assert.True(t, apperrors.IsNotFoundError(err))
+}
@@ -0,0 +1,246 @@
+package content_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"fuck_boss/backend/internal/application/content"
+	"fuck_boss/backend/internal/application/dto"
+	domaincontent "fuck_boss/backend/internal/domain/content"
+	"fuck_boss/backend/internal/domain/shared"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// MockPostDocumentRepository is a mock implementation of PostDocumentRepository.
+type MockPostDocumentRepository struct {
+	mock.Mock
+}
+
+func (m *MockPostDocumentRepository) FindDocumentsSince(ctx context.Context, since time.Time, limit int) ([]*domaincontent.PostDocument, error) {
+	args := m.Called(ctx, since, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domaincontent.PostDocument), args.Error(1)
+}
+
+// TestRefreshRelatedUseCase_Execute tests that related post IDs are cached per post.
+func TestRefreshRelatedUseCase_Execute(t *testing.T) {
+	docsRepo := new(MockPostDocumentRepository)
+	mockCache := new(MockCacheRepository)
+	uc := content.NewRefreshRelatedUseCase(docsRepo, mockCache, 24*time.Hour, 100, 5, time.Hour)
+
+	ctx := context.Background()
+	now := time.Now()
+	acme, _ := domaincontent.NewCompanyName("某某科技有限公司")
+	acmeShort, _ := domaincontent.NewCompanyName("某某科技")
+	other, _ := domaincontent.NewCompanyName("另一家公司")
+	first := domaincontent.NewPostDocumentFromDB(domaincontent.GeneratePostID(), acme, "beijing", "拖欠工资", now)
+	second := domaincontent.NewPostDocumentFromDB(domaincontent.GeneratePostID(), acmeShort, "shanghai", "加班严重", now.Add(-time.Hour))
+	lonely := domaincontent.NewPostDocumentFromDB(domaincontent.GeneratePostID(), other, "wuhan", "食堂不错", now)
+
+	docsRepo.On("FindDocumentsSince", ctx, mock.MatchedBy(func(since time.Time) bool {
+		return since.Before(now.Add(-23*time.Hour)) && since.After(now.Add(-25*time.Hour))
+	}), 100).Return([]*domaincontent.PostDocument{first, second, lonely}, nil)
+	mockCache.On("Set", ctx, "related:"+first.ID().String(), jsonIDs(t, second.ID().String()), time.Hour).Return(nil)
+	mockCache.On("Set", ctx, "related:"+second.ID().String(), jsonIDs(t, first.ID().String()), time.Hour).Return(nil)
+
+	err := uc.Execute(ctx)
+
+	require.NoError(t, err)
+	docsRepo.AssertExpectations(t)
+	mockCache.AssertExpectations(t)
+	mockCache.AssertNotCalled(t, "Set", ctx, "related:"+lonely.ID().String(), mock.Anything, mock.Anything)
+}
+
+// TestRefreshRelatedUseCase_Execute_RepositoryError tests that load failures are reported.
+func TestRefreshRelatedUseCase_Execute_RepositoryError(t *testing.T) {
+	docsRepo := new(MockPostDocumentRepository)
+	mockCache := new(MockCacheRepository)
+	uc := content.NewRefreshRelatedUseCase(docsRepo, mockCache, time.Hour, 100, 5, time.Hour)
+
+	ctx := context.Background()
+	docsRepo.On("FindDocumentsSince", ctx, mock.Anything, 100).Return(nil, errors.New("connection refused"))
+
+	err := uc.Execute(ctx)
+
+	require.Error(t, err)
+	assert.True(t, apperrors.IsDatabaseError(err))
+	mockCache.AssertNotCalled(t, "Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestListRelatedPostsUseCase_Execute_Cached tests reading precomputed related posts.
+func TestListRelatedPostsUseCase_Execute_Cached(t *testing.T) {
+	mockRepo := new(MockPostRepository)
+	mockCache := new(MockCacheRepository)
+	uc := content.NewListRelatedPostsUseCase(mockRepo, mockCache)
+
+	ctx := context.Background()
+	postID := domaincontent.GeneratePostID()
+	company, _ := domaincontent.NewCompanyName("测试公司")
+	city, _ := shared.NewCity("beijing", "北京")
+	postContent, _ := domaincontent.NewContent("这是一条测试内容，用于验证相关内容列表。内容应该足够长以满足最小长度要求。")
+	first, _ := domaincontent.NewPost(company, city, postContent)
+	second, _ := domaincontent.NewPost(company, city, postContent)
+	deleted := domaincontent.GeneratePostID()
+
+	mockCache.On("Get", ctx, "related:"+postID.String()).
+		Return(jsonIDs(t, second.ID().String(), deleted.String(), first.ID().String()), nil)
+	mockRepo.On("FindByFilter", ctx, mock.MatchedBy(func(filter domaincontent.PostFilter) bool {
+		return len(filter.IDs) == 2
+	}), 1, 2).Return([]*domaincontent.Post{first, second}, 2, nil)
+
+	// Limit 2 keeps the first two related IDs, one of which was deleted
+	result, err := uc.Execute(ctx, content.ListRelatedPostsQuery{PostID: postID.String(), Limit: 2})
+
+	require.NoError(t, err)
+	require.Len(t, result.Posts, 1)
+	assert.Equal(t, second.ID().String(), result.Posts[0].ID)
+	assert.Equal(t, 1, result.Total)
+	assert.Equal(t, 2, result.PageSize)
+	mockRepo.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+}
+
+// TestListRelatedPostsUseCase_Execute_FallbackToCompany tests posts that have not been processed yet.
+func TestListRelatedPostsUseCase_Execute_FallbackToCompany(t *testing.T) {
+	mockRepo := new(MockPostRepository)
+	mockCache := new(MockCacheRepository)
+	uc := content.NewListRelatedPostsUseCase(mockRepo, mockCache)
+
+	ctx := context.Background()
+	company, _ := domaincontent.NewCompanyName("测试公司")
+	city, _ := shared.NewCity("beijing", "北京")
+	postContent, _ := domaincontent.NewContent("这是一条测试内容，用于验证相关内容列表。内容应该足够长以满足最小长度要求。")
+	post, _ := domaincontent.NewPost(company, city, postContent)
+	self := domaincontent.NewPostSummaryFromDB(post.ID(), company, city, "本帖", false, time.Now(), "")
+	sibling := domaincontent.NewPostSummaryFromDB(domaincontent.GeneratePostID(), company, city, "同公司", false, time.Now(), "")
+
+	mockCache.On("Get", ctx, "related:"+post.ID().String()).Return("", errors.New("cache miss"))
+	mockRepo.On("FindByID", ctx, post.ID()).Return(post, nil)
+	mockRepo.On("FindSummariesByFilter", ctx, mock.MatchedBy(func(filter domaincontent.PostFilter) bool {
+		return filter.CompanyCanonical == company.Canonical()
+	}), 1, content.DefaultRelatedLimit+1).Return([]*domaincontent.PostSummary{self, sibling}, 2, nil)
+	mockRepo.On("FindSummariesByFilter", ctx, mock.MatchedBy(func(filter domaincontent.PostFilter) bool {
+		return len(filter.IDs) == 1 && filter.IDs[0].Equals(sibling.ID())
+	}), 1, 1).Return([]*domaincontent.PostSummary{sibling}, 1, nil)
+
+	result, err := uc.Execute(ctx, content.ListRelatedPostsQuery{PostID: post.ID().String(), View: dto.PostViewBasic})
+
+	require.NoError(t, err)
+	require.Len(t, result.Posts, 1)
+	assert.Equal(t, sibling.ID().String(), result.Posts[0].ID)
+	assert.Equal(t, "同公司", result.Posts[0].Summary)
+	mockRepo.AssertExpectations(t)
+}
+
+// TestListRelatedPostsUseCase_Execute_FallbackToCompanySpellings tests that the fallback
+// finds posts about the same company written differently.
+func TestListRelatedPostsUseCase_Execute_FallbackToCompanySpellings(t *testing.T) {
+	mockRepo := new(MockPostRepository)
+	mockCache := new(MockCacheRepository)
+	uc := content.NewListRelatedPostsUseCase(mockRepo, mockCache)
+
+	ctx := context.Background()
+	fullName, _ := domaincontent.NewCompanyName("ＸＸ科技（北京）有限公司")
+	shortName, _ := domaincontent.NewCompanyName("xx科技")
+	otherName, _ := domaincontent.NewCompanyName("另一家公司")
+	city, _ := shared.NewCity("beijing", "北京")
+	postContent, _ := domaincontent.NewContent("这是一条测试内容，用于验证相关内容列表。内容应该足够长以满足最小长度要求。")
+	post, _ := domaincontent.NewPost(fullName, city, postContent)
+	now := time.Now()
+	stored := []*domaincontent.PostSummary{
+		domaincontent.NewPostSummaryFromDB(post.ID(), fullName, city, "本帖", false, now, ""),
+		domaincontent.NewPostSummaryFromDB(domaincontent.GeneratePostID(), shortName, city, "简称", false, now.Add(-time.Minute), ""),
+		domaincontent.NewPostSummaryFromDB(domaincontent.GeneratePostID(), fullName, city, "全称", false, now.Add(-2*time.Minute), ""),
+		domaincontent.NewPostSummaryFromDB(domaincontent.GeneratePostID(), otherName, city, "别家", false, now, ""),
+	}
+
+	// The repository applies the canonical company filter like the database does
+	byCanonical := func(canonical string) []*domaincontent.PostSummary {
+		var matched []*domaincontent.PostSummary
+		for _, summary := range stored {
+			if summary.Company().Canonical() == canonical {
+				matched = append(matched, summary)
+			}
+		}
+		return matched
+	}
+	matched := byCanonical(post.Company().Canonical())
+	require.Len(t, matched, 3)
+
+	mockCache.On("Get", ctx, "related:"+post.ID().String()).Return("", errors.New("cache miss"))
+	mockRepo.On("FindByID", ctx, post.ID()).Return(post, nil)
+	mockRepo.On("FindSummariesByFilter", ctx, mock.MatchedBy(func(filter domaincontent.PostFilter) bool {
+		return filter.CompanyCanonical != "" && filter.IDs == nil
+	}), 1, content.DefaultRelatedLimit+1).Return(matched, len(matched), nil)
+	mockRepo.On("FindSummariesByFilter", ctx, mock.MatchedBy(func(filter domaincontent.PostFilter) bool {
+		return len(filter.IDs) == 2
+	}), 1, 2).Return([]*domaincontent.PostSummary{stored[1], stored[2]}, 2, nil)
+
+	result, err := uc.Execute(ctx, content.ListRelatedPostsQuery{PostID: post.ID().String(), View: dto.PostViewBasic})
+
+	require.NoError(t, err)
+	require.Len(t, result.Posts, 2)
+	assert.Equal(t, stored[1].ID().String(), result.Posts[0].ID)
+	assert.Equal(t, "简称", result.Posts[0].Summary)
+	assert.Equal(t, stored[2].ID().String(), result.Posts[1].ID)
+	assert.Equal(t, "全称", result.Posts[1].Summary)
+	mockRepo.AssertExpectations(t)
+}
+
+// TestListRelatedPostsUseCase_Execute_NotFound tests the fallback for a post that does not exist.
+func TestListRelatedPostsUseCase_Execute_NotFound(t *testing.T) {
+	mockRepo := new(MockPostRepository)
+	mockCache := new(MockCacheRepository)
+	uc := content.NewListRelatedPostsUseCase(mockRepo, mockCache)
+
+	ctx := context.Background()
+	postID := domaincontent.GeneratePostID()
+
+	mockCache.On("Get", ctx, "related:"+postID.String()).Return("", errors.New("cache miss"))
+	mockRepo.On("FindByID", ctx, postID).Return(nil, apperrors.NewNotFoundError("post"))
+
+	result, err := uc.Execute(ctx, content.ListRelatedPostsQuery{PostID: postID.String()})
+
+	require.Error(t, err)
+	assert.Nil(t, result)
+	assert.True(t, apperrors.IsNotFoundError(err))
+}
+
+// TestListRelatedPostsUseCase_Execute_ValidationError tests invalid queries.
+func TestListRelatedPostsUseCase_Execute_ValidationError(t *testing.T) {
+	uc := content.NewListRelatedPostsUseCase(new(MockPostRepository), new(MockCacheRepository))
+
+	tests := []struct {
+		name  string
+		query content.ListRelatedPostsQuery
+	}{
+		{name: "missing post ID", query: content.ListRelatedPostsQuery{}},
+		{name: "invalid post ID", query: content.ListRelatedPostsQuery{PostID: "not-a-uuid"}},
+		{name: "invalid view", query: content.ListRelatedPostsQuery{PostID: domaincontent.GeneratePostID().String(), View: "TINY"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := uc.Execute(context.Background(), tt.query)
+
+			require.Error(t, err)
+			assert.True(t, apperrors.IsValidationError(err))
+		})
+	}
+}
+
+// jsonIDs returns the JSON array of the IDs, as cached by RefreshRelatedUseCase.
+func jsonIDs(t *testing.T, ids ...string) string {
+	t.Helper()
+	data, err := json.Marshal(ids)
+	require.NoError(t, err)
+	return string(data)
+}
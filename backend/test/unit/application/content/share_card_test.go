@@ -0,0 +1,120 @@
+package content_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"fuck_boss/backend/internal/application/content"
+	"fuck_boss/backend/internal/application/dto"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// MockShareCardRenderer is a mock implementation of content.ShareCardRenderer.
+type MockShareCardRenderer struct {
+	mock.Mock
+}
+
+func (m *MockShareCardRenderer) Render(card content.ShareCard) ([]byte, error) {
+	args := m.Called(card)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+// cachedSharePost returns a post and caches it for GetPostUseCase.
+func cachedSharePost(mockCache *MockCacheRepository, ctx context.Context) *dto.PostDTO {
+	post := &dto.PostDTO{
+		ID:               "550e8400-e29b-41d4-a716-446655440000",
+		Company:          "测试公司",
+		CityCode:         "beijing",
+		CityName:         "北京",
+		Content:          "连续三个月拖欠工资，多次沟通无果。",
+		CreatedAt:        time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC),
+		ResolutionStatus: "RESOLVED",
+	}
+	data, _ := json.Marshal(post)
+	mockCache.On("Get", ctx, "post:"+post.ID).Return(string(data), nil)
+	return post
+}
+
+// TestGetShareCardUseCase_Execute_Render tests rendering and caching a card on a cache miss.
+func TestGetShareCardUseCase_Execute_Render(t *testing.T) {
+	mockCache := new(MockCacheRepository)
+	mockRenderer := new(MockShareCardRenderer)
+	uc := content.NewGetShareCardUseCase(content.NewGetPostUseCase(new(MockPostRepository), mockCache), mockCache, mockRenderer, "Fuck Boss")
+	ctx := context.Background()
+
+	post := cachedSharePost(mockCache, ctx)
+	version := content.ShareCardVersion(post)
+	cardKey := "post:" + post.ID + ":card:" + version
+	mockCache.On("Get", ctx, cardKey).Return("", errors.New("cache miss"))
+	mockRenderer.On("Render", content.ShareCard{
+		Company:  "测试公司",
+		City:     "北京",
+		Summary:  "连续三个月拖欠工资，多次沟通无果。",
+		Status:   "已解决",
+		Date:     post.CreatedAt,
+		SiteName: "Fuck Boss",
+	}).Return([]byte("png"), nil)
+	mockCache.On("Set", ctx, cardKey, "png", mock.Anything).Return(nil)
+
+	card, err := uc.Execute(ctx, post.ID)
+
+	require.NoError(t, err)
+	assert.Equal(t, []byte("png"), card.Data)
+	assert.Equal(t, version, card.Version)
+	mockCache.AssertExpectations(t)
+	mockRenderer.AssertExpectations(t)
+}
+
+// TestGetShareCardUseCase_Execute_CacheHit tests that cached cards are not rendered again.
+func TestGetShareCardUseCase_Execute_CacheHit(t *testing.T) {
+	mockCache := new(MockCacheRepository)
+	mockRenderer := new(MockShareCardRenderer)
+	uc := content.NewGetShareCardUseCase(content.NewGetPostUseCase(new(MockPostRepository), mockCache), mockCache, mockRenderer, "Fuck Boss")
+	ctx := context.Background()
+
+	post := cachedSharePost(mockCache, ctx)
+	mockCache.On("Get", ctx, "post:"+post.ID+":card:"+content.ShareCardVersion(post)).Return("cached", nil)
+
+	card, err := uc.Execute(ctx, post.ID)
+
+	require.NoError(t, err)
+	assert.Equal(t, []byte("cached"), card.Data)
+	mockRenderer.AssertNotCalled(t, "Render", mock.Anything)
+}
+
+// TestGetShareCardUseCase_Execute_InvalidID tests that invalid post IDs are rejected.
+func TestGetShareCardUseCase_Execute_InvalidID(t *testing.T) {
+	mockCache := new(MockCacheRepository)
+	uc := content.NewGetShareCardUseCase(content.NewGetPostUseCase(new(MockPostRepository), mockCache), mockCache, new(MockShareCardRenderer), "Fuck Boss")
+
+	_, err := uc.Execute(context.Background(), "not-a-uuid")
+
+	var appErr *apperrors.AppError
+	require.True(t, apperrors.As(err, &appErr))
+	assert.Equal(t, apperrors.ErrCodeValidation, appErr.Code)
+}
+
+// TestShareCardVersion tests that the version changes with the text on the card.
+func TestShareCardVersion(t *testing.T) {
+	post := &dto.PostDTO{ID: "id", Company: "测试公司", CityName: "北京", Content: "内容", ResolutionStatus: "ONGOING"}
+	version := content.ShareCardVersion(post)
+	assert.Len(t, version, 16)
+
+	same := *post
+	same.Timeline = []*dto.FollowUpDTO{{Note: "不影响卡片"}}
+	assert.Equal(t, version, content.ShareCardVersion(&same))
+
+	resolved := *post
+	resolved.ResolutionStatus = "RESOLVED"
+	assert.NotEqual(t, version, content.ShareCardVersion(&resolved))
+}
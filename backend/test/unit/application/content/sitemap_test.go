@@ -0,0 +1,230 @@
+package content_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"fuck_boss/backend/internal/application/blob"
+	"fuck_boss/backend/internal/application/content"
+	domaincontent "fuck_boss/backend/internal/domain/content"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// fakePostRefRepository keeps posts in memory in (created_at, id) order.
+type fakePostRefRepository struct {
+	refs []*domaincontent.PostRef
+}
+
+func (r *fakePostRefRepository) add(createdAt, updatedAt time.Time) *domaincontent.PostRef {
+	ref := domaincontent.NewPostRefFromDB(domaincontent.GeneratePostID(), createdAt, updatedAt)
+	r.refs = append(r.refs, ref)
+	sort.Slice(r.refs, func(i, j int) bool { return r.refs[j].Cursor().After(r.refs[i].Cursor()) })
+	return ref
+}
+
+func (r *fakePostRefRepository) touch(ref *domaincontent.PostRef, updatedAt time.Time) {
+	for i, existing := range r.refs {
+		if existing.ID().Equals(ref.ID()) {
+			r.refs[i] = domaincontent.NewPostRefFromDB(ref.ID(), ref.CreatedAt(), updatedAt)
+		}
+	}
+}
+
+func (r *fakePostRefRepository) FindRefsAfter(ctx context.Context, after *domaincontent.PostCursor, limit int) ([]*domaincontent.PostRef, error) {
+	var result []*domaincontent.PostRef
+	for _, ref := range r.refs {
+		if after != nil && !ref.Cursor().After(*after) {
+			continue
+		}
+		if len(result) == limit {
+			break
+		}
+		result = append(result, ref)
+	}
+	return result, nil
+}
+
+func (r *fakePostRefRepository) FindRefsUpdatedSince(ctx context.Context, since time.Time, limit int) ([]*domaincontent.PostRef, error) {
+	var result []*domaincontent.PostRef
+	for _, ref := range r.refs {
+		if ref.UpdatedAt().After(since) && len(result) < limit {
+			result = append(result, ref)
+		}
+	}
+	return result, nil
+}
+
+// recordingBlobStore is an in-memory BlobStore that records the keys written.
+type recordingBlobStore struct {
+	blobs map[string][]byte
+	puts  []string
+}
+
+func newRecordingBlobStore() *recordingBlobStore {
+	return &recordingBlobStore{blobs: make(map[string][]byte)}
+}
+
+func (s *recordingBlobStore) Put(ctx context.Context, key string, r io.Reader) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	s.blobs[key] = data
+	s.puts = append(s.puts, key)
+	return nil
+}
+
+func (s *recordingBlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	data, ok := s.blobs[key]
+	if !ok {
+		return nil, blob.ErrNotFound
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (s *recordingBlobStore) Delete(ctx context.Context, key string) error {
+	delete(s.blobs, key)
+	return nil
+}
+
+// takePuts returns the sitemap files written since the last call.
+func (s *recordingBlobStore) takePuts() []string {
+	var files []string
+	for _, key := range s.puts {
+		if strings.HasPrefix(key, "sitemap-") && strings.HasSuffix(key, ".xml.gz") {
+			files = append(files, key)
+		}
+	}
+	s.puts = nil
+	return files
+}
+
+// gunzip returns the uncompressed shard.
+func gunzip(t *testing.T, data []byte) string {
+	t.Helper()
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	out, err := io.ReadAll(r)
+	require.NoError(t, err)
+	return string(out)
+}
+
+// TestRefreshSitemapUseCase_Execute_Incremental tests that only the shards with
+// new or changed posts are regenerated.
+func TestRefreshSitemapUseCase_Execute_Incremental(t *testing.T) {
+	repo := &fakePostRefRepository{}
+	store := newRecordingBlobStore()
+	uc := content.NewRefreshSitemapUseCase(repo, store, "https://example.com", 2)
+	ctx := context.Background()
+
+	old := time.Now().Add(-24 * time.Hour).UTC()
+	var refs []*domaincontent.PostRef
+	for i := 0; i < 5; i++ {
+		refs = append(refs, repo.add(old.Add(time.Duration(i)*time.Minute), old.Add(time.Duration(i)*time.Minute)))
+	}
+
+	// First run: everything is generated
+	require.NoError(t, uc.Execute(ctx))
+	assert.Equal(t, []string{"sitemap-1.xml.gz", "sitemap-2.xml.gz", "sitemap-3.xml.gz"}, store.takePuts())
+
+	index := string(store.blobs["sitemap.xml"])
+	assert.Contains(t, index, "<loc>https://example.com/sitemap-3.xml.gz</loc>")
+	first := gunzip(t, store.blobs["sitemap-1.xml.gz"])
+	assert.Contains(t, first, "<loc>https://example.com/p/"+refs[0].ID().String()+"</loc>")
+	assert.Contains(t, first, "<loc>https://example.com/p/"+refs[1].ID().String()+"</loc>")
+	assert.NotContains(t, first, refs[2].ID().String())
+
+	// Nothing changed: no shard is written
+	require.NoError(t, uc.Execute(ctx))
+	assert.Empty(t, store.takePuts())
+
+	// A new post goes into the open last shard
+	added := repo.add(time.Now(), time.Now())
+	require.NoError(t, uc.Execute(ctx))
+	assert.Equal(t, []string{"sitemap-3.xml.gz"}, store.takePuts())
+	assert.Contains(t, gunzip(t, store.blobs["sitemap-3.xml.gz"]), added.ID().String())
+
+	// A changed post regenerates its own shard (and the recent last one again)
+	repo.touch(refs[0], time.Now())
+	require.NoError(t, uc.Execute(ctx))
+	assert.Equal(t, []string{"sitemap-1.xml.gz", "sitemap-3.xml.gz"}, store.takePuts())
+
+	// A full last shard grows into a new shard
+	repo.add(time.Now().Add(time.Second), time.Now())
+	require.NoError(t, uc.Execute(ctx))
+	assert.Contains(t, store.takePuts(), "sitemap-4.xml.gz")
+	assert.Contains(t, string(store.blobs["sitemap.xml"]), "<loc>https://example.com/sitemap-4.xml.gz</loc>")
+}
+
+// TestRefreshSitemapUseCase_Execute_LateInsert tests a post that lands in a
+// closed shard and makes it overflow.
+func TestRefreshSitemapUseCase_Execute_LateInsert(t *testing.T) {
+	repo := &fakePostRefRepository{}
+	store := newRecordingBlobStore()
+	uc := content.NewRefreshSitemapUseCase(repo, store, "https://example.com", 2)
+	ctx := context.Background()
+
+	old := time.Now().Add(-24 * time.Hour).UTC()
+	for i := 0; i < 4; i++ {
+		repo.add(old.Add(time.Duration(i)*time.Minute), old)
+	}
+	require.NoError(t, uc.Execute(ctx))
+	store.takePuts()
+
+	// Created before the end of the first shard, but committed now
+	late := repo.add(old.Add(30*time.Second), time.Now())
+	require.NoError(t, uc.Execute(ctx))
+
+	assert.Equal(t, []string{"sitemap-1.xml.gz", "sitemap-2.xml.gz", "sitemap-3.xml.gz"}, store.takePuts())
+	var listed int
+	for _, name := range []string{"sitemap-1.xml.gz", "sitemap-2.xml.gz", "sitemap-3.xml.gz"} {
+		shard := gunzip(t, store.blobs[name])
+		listed += strings.Count(shard, "<url>")
+		if strings.Contains(shard, late.ID().String()) {
+			assert.Equal(t, "sitemap-1.xml.gz", name)
+		}
+	}
+	assert.Equal(t, 5, listed)
+}
+
+// TestRefreshSitemapUseCase_Execute_NoPosts tests an empty index without posts.
+func TestRefreshSitemapUseCase_Execute_NoPosts(t *testing.T) {
+	store := newRecordingBlobStore()
+	uc := content.NewRefreshSitemapUseCase(&fakePostRefRepository{}, store, "https://example.com", 0)
+
+	require.NoError(t, uc.Execute(context.Background()))
+
+	assert.Empty(t, store.takePuts())
+	assert.Contains(t, string(store.blobs["sitemap.xml"]), "<sitemapindex")
+}
+
+// TestGetSitemapUseCase_Execute tests opening generated files and rejecting other names.
+func TestGetSitemapUseCase_Execute(t *testing.T) {
+	store := newRecordingBlobStore()
+	store.blobs["sitemap.xml"] = []byte("index")
+	store.blobs["sitemap-manifest.json"] = []byte("{}")
+	uc := content.NewGetSitemapUseCase(store)
+	ctx := context.Background()
+
+	body, err := uc.Execute(ctx, "sitemap.xml")
+	require.NoError(t, err)
+	data, _ := io.ReadAll(body)
+	body.Close()
+	assert.Equal(t, "index", string(data))
+
+	for _, name := range []string{"sitemap-manifest.json", "sitemap-1.xml.gz", "sitemap-0.xml.gz", "../sitemap.xml"} {
+		_, err := uc.Execute(ctx, name)
+		var appErr *apperrors.AppError
+		require.True(t, apperrors.As(err, &appErr), name)
+		assert.Equal(t, apperrors.ErrCodeNotFound, appErr.Code, name)
+	}
+}
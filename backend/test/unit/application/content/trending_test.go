@@ -0,0 +1,277 @@
+package content_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"fuck_boss/backend/internal/application/content"
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/application/ranking"
+	domaincontent "fuck_boss/backend/internal/domain/content"
+	"fuck_boss/backend/internal/domain/shared"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// MockViewCounter is a mock implementation of ranking.ViewCounter.
+type MockViewCounter struct {
+	mock.Mock
+}
+
+func (m *MockViewCounter) Add(ctx context.Context, postID string, viewer string, at time.Time) error {
+	args := m.Called(ctx, postID, viewer, at)
+	return args.Error(0)
+}
+
+func (m *MockViewCounter) Counts(ctx context.Context, at time.Time) (map[string]int64, error) {
+	args := m.Called(ctx, at)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]int64), args.Error(1)
+}
+
+// MockRankingStore is a mock implementation of ranking.RankingStore.
+type MockRankingStore struct {
+	mock.Mock
+}
+
+func (m *MockRankingStore) ReplaceAll(ctx context.Context, rankings map[string][]ranking.Entry) error {
+	args := m.Called(ctx, rankings)
+	return args.Error(0)
+}
+
+func (m *MockRankingStore) Range(ctx context.Context, name string, offset, limit int) ([]string, int, error) {
+	args := m.Called(ctx, name, offset, limit)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]string), args.Int(1), args.Error(2)
+}
+
+// MockEngagementRepository is a mock implementation of EngagementRepository.
+type MockEngagementRepository struct {
+	mock.Mock
+}
+
+func (m *MockEngagementRepository) SaveDailyViews(ctx context.Context, day time.Time, views map[domaincontent.PostID]int64) error {
+	args := m.Called(ctx, day, views)
+	return args.Error(0)
+}
+
+func (m *MockEngagementRepository) FindEngagementSince(ctx context.Context, since time.Time) ([]*domaincontent.PostEngagement, error) {
+	args := m.Called(ctx, since)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domaincontent.PostEngagement), args.Error(1)
+}
+
+const trendingPostID = "123e4567-e89b-12d3-a456-426614174000"
+
+// TestRecordViewUseCase_Execute_Viewer tests how viewers are identified.
+func TestRecordViewUseCase_Execute_Viewer(t *testing.T) {
+	tests := []struct {
+		name   string
+		cmd    content.RecordViewCommand
+		viewer string
+	}{
+		{
+			name:   "logged-in user",
+			cmd:    content.RecordViewCommand{PostID: trendingPostID, UserID: "user-1", ClientIP: "10.0.0.1"},
+			viewer: "user:user-1",
+		},
+		{
+			name:   "anonymous",
+			cmd:    content.RecordViewCommand{PostID: trendingPostID, ClientIP: "10.0.0.1"},
+			viewer: "ip:10.0.0.1",
+		},
+		{
+			name:   "port is ignored",
+			cmd:    content.RecordViewCommand{PostID: trendingPostID, ClientIP: "10.0.0.1:52341"},
+			viewer: "ip:10.0.0.1",
+		},
+		{
+			name:   "IPv6 in canonical form",
+			cmd:    content.RecordViewCommand{PostID: trendingPostID, ClientIP: "[2001:DB8:0::1]:443"},
+			viewer: "ip:2001:db8::1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			counter := new(MockViewCounter)
+			uc := content.NewRecordViewUseCase(counter)
+
+			counter.On("Add", mock.Anything, trendingPostID, tt.viewer, mock.AnythingOfType("time.Time")).Return(nil)
+
+			require.NoError(t, uc.Execute(context.Background(), tt.cmd))
+			counter.AssertExpectations(t)
+		})
+	}
+}
+
+// TestRecordViewUseCase_Execute_NoViewer tests that views without a valid identity are ignored.
+func TestRecordViewUseCase_Execute_NoViewer(t *testing.T) {
+	counter := new(MockViewCounter)
+	uc := content.NewRecordViewUseCase(counter)
+
+	require.NoError(t, uc.Execute(context.Background(), content.RecordViewCommand{PostID: trendingPostID}))
+	require.NoError(t, uc.Execute(context.Background(), content.RecordViewCommand{PostID: trendingPostID, ClientIP: "1.2.3.4, 5.6.7.8"}))
+	counter.AssertNotCalled(t, "Add", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestRecordViewUseCase_Execute_InvalidPostID tests an invalid post ID.
+func TestRecordViewUseCase_Execute_InvalidPostID(t *testing.T) {
+	uc := content.NewRecordViewUseCase(new(MockViewCounter))
+
+	err := uc.Execute(context.Background(), content.RecordViewCommand{PostID: "not-a-uuid", ClientIP: "10.0.0.1"})
+
+	require.Error(t, err)
+	assert.True(t, apperrors.IsValidationError(err))
+}
+
+// TestRefreshTrendingUseCase_Execute tests flushing views and ranking posts per city.
+func TestRefreshTrendingUseCase_Execute(t *testing.T) {
+	engagementRepo := new(MockEngagementRepository)
+	counter := new(MockViewCounter)
+	store := new(MockRankingStore)
+	uc := content.NewRefreshTrendingUseCase(engagementRepo, counter, store, 7*24*time.Hour, 2)
+
+	now := time.Now()
+	hot := domaincontent.GeneratePostID()
+	warm := domaincontent.GeneratePostID()
+	cold := domaincontent.GeneratePostID()
+	other := domaincontent.GeneratePostID()
+
+	// Today's counts include an ID that is not a post ID, which is dropped
+	counter.On("Counts", mock.Anything, mock.AnythingOfType("time.Time")).
+		Return(map[string]int64{hot.String(): 40, "garbage": 3}, nil)
+	engagementRepo.On("SaveDailyViews", mock.Anything, mock.AnythingOfType("time.Time"),
+		map[domaincontent.PostID]int64{hot: 40}).Return(nil).Twice()
+	engagementRepo.On("FindEngagementSince", mock.Anything, mock.MatchedBy(func(since time.Time) bool {
+		return since.Before(now.Add(-7*24*time.Hour + time.Minute))
+	})).Return([]*domaincontent.PostEngagement{
+		domaincontent.NewPostEngagementFromDB(cold, "beijing", now.Add(-48*time.Hour), 40, 0, 0),
+		domaincontent.NewPostEngagementFromDB(hot, "beijing", now.Add(-time.Hour), 40, 1, 0),
+		domaincontent.NewPostEngagementFromDB(warm, "beijing", now.Add(-time.Hour), 10, 0, 1),
+		domaincontent.NewPostEngagementFromDB(other, "shanghai", now.Add(-time.Hour), 1, 0, 0),
+	}, nil)
+
+	var rankings map[string][]ranking.Entry
+	store.On("ReplaceAll", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		rankings = args.Get(1).(map[string][]ranking.Entry)
+	}).Return(nil)
+
+	require.NoError(t, uc.Execute(context.Background()))
+
+	// Rankings are capped at size and ordered by hot score
+	require.Len(t, rankings, 3)
+	assert.Equal(t, []string{hot.String(), warm.String()}, members(rankings[content.TrendingRankingAll]))
+	assert.Equal(t, []string{hot.String(), warm.String()}, members(rankings["trending:city:beijing"]))
+	assert.Equal(t, []string{other.String()}, members(rankings["trending:city:shanghai"]))
+
+	engagementRepo.AssertExpectations(t)
+	counter.AssertNumberOfCalls(t, "Counts", 2)
+}
+
+// TestRefreshTrendingUseCase_Execute_CounterError tests that a counter failure stops the refresh.
+func TestRefreshTrendingUseCase_Execute_CounterError(t *testing.T) {
+	engagementRepo := new(MockEngagementRepository)
+	counter := new(MockViewCounter)
+	store := new(MockRankingStore)
+	uc := content.NewRefreshTrendingUseCase(engagementRepo, counter, store, time.Hour, 10)
+
+	counter.On("Counts", mock.Anything, mock.Anything).Return(nil, errors.New("redis down"))
+
+	require.Error(t, uc.Execute(context.Background()))
+	store.AssertNotCalled(t, "ReplaceAll", mock.Anything, mock.Anything)
+}
+
+// TestListTrendingPostsUseCase_Execute tests that posts are returned in ranking order.
+func TestListTrendingPostsUseCase_Execute(t *testing.T) {
+	mockRepo := new(MockPostRepository)
+	store := new(MockRankingStore)
+	uc := content.NewListTrendingPostsUseCase(mockRepo, store)
+
+	ctx := context.Background()
+	company, _ := domaincontent.NewCompanyName("测试公司")
+	city, _ := shared.NewCity("beijing", "北京")
+	postContent, _ := domaincontent.NewContent("这是一条测试内容，用于验证热门列表。内容应该足够长以满足最小长度要求。")
+	first, _ := domaincontent.NewPost(company, city, postContent)
+	second, _ := domaincontent.NewPost(company, city, postContent)
+	deleted := domaincontent.GeneratePostID()
+
+	// Page 2 with page size 3 starts at offset 3
+	store.On("Range", ctx, "trending:city:beijing", 3, 3).
+		Return([]string{second.ID().String(), deleted.String(), first.ID().String()}, 8, nil)
+	mockRepo.On("FindByFilter", ctx, mock.MatchedBy(func(filter domaincontent.PostFilter) bool {
+		return len(filter.IDs) == 3 && filter.City == nil
+	}), 1, 3).Return([]*domaincontent.Post{first, second}, 2, nil)
+
+	result, err := uc.Execute(ctx, content.ListTrendingPostsQuery{CityCode: "beijing", Page: 2, PageSize: 3})
+
+	require.NoError(t, err)
+	assert.Equal(t, 8, result.Total)
+	assert.Equal(t, 2, result.Page)
+	require.Len(t, result.Posts, 2)
+	assert.Equal(t, second.ID().String(), result.Posts[0].ID)
+	assert.Equal(t, first.ID().String(), result.Posts[1].ID)
+	assert.NotEmpty(t, result.Posts[0].Content)
+
+	mockRepo.AssertExpectations(t)
+	store.AssertExpectations(t)
+}
+
+// TestListTrendingPostsUseCase_Execute_BasicView tests the summary-only view.
+func TestListTrendingPostsUseCase_Execute_BasicView(t *testing.T) {
+	mockRepo := new(MockPostRepository)
+	store := new(MockRankingStore)
+	uc := content.NewListTrendingPostsUseCase(mockRepo, store)
+
+	ctx := context.Background()
+	company, _ := domaincontent.NewCompanyName("测试公司")
+	city, _ := shared.NewCity("beijing", "北京")
+	summary := domaincontent.NewPostSummaryFromDB(domaincontent.GeneratePostID(), company, city, "热门内容", false, time.Now(), "")
+
+	store.On("Range", ctx, content.TrendingRankingAll, 0, 20).Return([]string{summary.ID().String()}, 1, nil)
+	mockRepo.On("FindSummariesByFilter", ctx, mock.Anything, 1, 1).Return([]*domaincontent.PostSummary{summary}, 1, nil)
+
+	result, err := uc.Execute(ctx, content.ListTrendingPostsQuery{View: dto.PostViewBasic})
+
+	require.NoError(t, err)
+	require.Len(t, result.Posts, 1)
+	assert.Equal(t, "热门内容", result.Posts[0].Summary)
+	assert.Empty(t, result.Posts[0].Content)
+}
+
+// TestListTrendingPostsUseCase_Execute_EmptyRanking tests a ranking that has not been computed yet.
+func TestListTrendingPostsUseCase_Execute_EmptyRanking(t *testing.T) {
+	mockRepo := new(MockPostRepository)
+	store := new(MockRankingStore)
+	uc := content.NewListTrendingPostsUseCase(mockRepo, store)
+
+	ctx := context.Background()
+	store.On("Range", ctx, "trending:city:wuhan", 0, 20).Return([]string{}, 0, nil)
+
+	result, err := uc.Execute(ctx, content.ListTrendingPostsQuery{CityCode: "wuhan"})
+
+	require.NoError(t, err)
+	assert.Empty(t, result.Posts)
+	assert.Equal(t, 0, result.Total)
+	mockRepo.AssertNotCalled(t, "FindByFilter", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+// members returns the members of ranking entries in order.
+func members(entries []ranking.Entry) []string {
+	result := make([]string, 0, len(entries))
+	for _, e := range entries {
+		result = append(result, e.Member)
+	}
+	return result
+}
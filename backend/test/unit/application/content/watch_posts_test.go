@@ -0,0 +1,182 @@
+package content_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"fuck_boss/backend/internal/application/content"
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/application/livefeed"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// MockLiveFeedSubscriber is a mock implementation of livefeed.Subscriber.
+type MockLiveFeedSubscriber struct {
+	mock.Mock
+}
+
+func (m *MockLiveFeedSubscriber) Subscribe(ctx context.Context) (<-chan *livefeed.Event, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(chan *livefeed.Event), args.Error(1)
+}
+
+// MockLiveFeedHistory is a mock implementation of livefeed.History.
+type MockLiveFeedHistory struct {
+	mock.Mock
+}
+
+func (m *MockLiveFeedHistory) EventsAfter(ctx context.Context, lastEventID string, limit int) ([]*livefeed.Event, error) {
+	args := m.Called(ctx, lastEventID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*livefeed.Event), args.Error(1)
+}
+
+func (m *MockLiveFeedHistory) Forget(ctx context.Context, postID string) error {
+	args := m.Called(ctx, postID)
+	return args.Error(0)
+}
+
+// TestWatchPostsUseCase_Execute_Filters tests the city and company filters and the BASIC view.
+func TestWatchPostsUseCase_Execute_Filters(t *testing.T) {
+	subscriber := new(MockLiveFeedSubscriber)
+	uc := content.NewWatchPostsUseCase(subscriber, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events := make(chan *livefeed.Event, 4)
+	events <- &livefeed.Event{ID: "1-0", Post: &dto.PostDTO{ID: "other-city", CityCode: "shanghai", Company: "某某科技"}}
+	events <- &livefeed.Event{ID: "2-0", Post: &dto.PostDTO{ID: "other-company", CityCode: "beijing", Company: "另一家公司"}}
+	shared := &dto.PostDTO{ID: "match", CityCode: "beijing", Company: "某某科技（北京）有限公司", Content: "内容", ContentHTML: "<p>内容</p>", Summary: "内容"}
+	events <- &livefeed.Event{ID: "3-0", Post: shared}
+	subscriber.On("Subscribe", ctx).Return(events, nil)
+
+	var received []*livefeed.Event
+	err := uc.Execute(ctx, content.WatchPostsQuery{CityCode: "beijing", Company: "某某科技有限公司", View: dto.PostViewBasic}, func(event *livefeed.Event) error {
+		received = append(received, event)
+		cancel()
+		return nil
+	})
+
+	require.NoError(t, err)
+	require.Len(t, received, 1)
+	assert.Equal(t, "3-0", received[0].ID)
+	assert.Equal(t, "match", received[0].Post.ID)
+	assert.Empty(t, received[0].Post.Content)
+	assert.Equal(t, "内容", received[0].Post.Summary)
+	// The shared post is not modified
+	assert.Equal(t, "内容", shared.Content)
+}
+
+// TestWatchPostsUseCase_Execute_Resume tests replaying the history after Last-Event-ID.
+func TestWatchPostsUseCase_Execute_Resume(t *testing.T) {
+	subscriber := new(MockLiveFeedSubscriber)
+	history := new(MockLiveFeedHistory)
+	uc := content.NewWatchPostsUseCase(subscriber, history)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Event 3-0 was published after subscribing but before the replay finished,
+	// so it is both in the history and in the live feed
+	events := make(chan *livefeed.Event, 2)
+	events <- &livefeed.Event{ID: "3-0", Post: &dto.PostDTO{ID: "post-3", CityCode: "beijing"}}
+	events <- &livefeed.Event{ID: "4-0", Post: &dto.PostDTO{ID: "post-4", CityCode: "beijing"}}
+	subscriber.On("Subscribe", ctx).Return(events, nil)
+	history.On("EventsAfter", ctx, "1-0", 100).Return([]*livefeed.Event{
+		{ID: "2-0", Post: &dto.PostDTO{ID: "post-2", CityCode: "beijing"}},
+		{ID: "3-0", Post: &dto.PostDTO{ID: "post-3", CityCode: "beijing"}},
+	}, nil)
+
+	var received []string
+	err := uc.Execute(ctx, content.WatchPostsQuery{LastEventID: "1-0"}, func(event *livefeed.Event) error {
+		received = append(received, event.ID)
+		if event.ID == "4-0" {
+			cancel()
+		}
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2-0", "3-0", "4-0"}, received)
+	history.AssertExpectations(t)
+}
+
+// TestWatchPostsUseCase_Execute_InvalidLastEventID tests a malformed Last-Event-ID.
+func TestWatchPostsUseCase_Execute_InvalidLastEventID(t *testing.T) {
+	subscriber := new(MockLiveFeedSubscriber)
+	history := new(MockLiveFeedHistory)
+	uc := content.NewWatchPostsUseCase(subscriber, history)
+
+	ctx := context.Background()
+	subscriber.On("Subscribe", ctx).Return(make(chan *livefeed.Event), nil)
+	history.On("EventsAfter", ctx, "bogus", 100).Return(nil, apperrors.NewValidationError("invalid last event ID"))
+
+	err := uc.Execute(ctx, content.WatchPostsQuery{LastEventID: "bogus"}, func(event *livefeed.Event) error {
+		return nil
+	})
+
+	require.Error(t, err)
+	assert.True(t, apperrors.IsValidationError(err))
+}
+
+// TestWatchPostsUseCase_Execute_Interrupted tests a feed closed by the broker.
+func TestWatchPostsUseCase_Execute_Interrupted(t *testing.T) {
+	subscriber := new(MockLiveFeedSubscriber)
+	uc := content.NewWatchPostsUseCase(subscriber, nil)
+
+	ctx := context.Background()
+	events := make(chan *livefeed.Event)
+	close(events)
+	subscriber.On("Subscribe", ctx).Return(events, nil)
+
+	err := uc.Execute(ctx, content.WatchPostsQuery{}, func(event *livefeed.Event) error {
+		return nil
+	})
+
+	require.Error(t, err)
+	assert.True(t, apperrors.IsUnavailableError(err))
+}
+
+// TestWatchPostsUseCase_Execute_SendError tests that a failed send ends the subscription.
+func TestWatchPostsUseCase_Execute_SendError(t *testing.T) {
+	subscriber := new(MockLiveFeedSubscriber)
+	uc := content.NewWatchPostsUseCase(subscriber, nil)
+
+	ctx := context.Background()
+	events := make(chan *livefeed.Event, 1)
+	events <- &livefeed.Event{ID: "1-0", Post: &dto.PostDTO{ID: "post-1", CreatedAt: time.Now()}}
+	subscriber.On("Subscribe", ctx).Return(events, nil)
+	sendErr := errors.New("client gone")
+
+	err := uc.Execute(ctx, content.WatchPostsQuery{}, func(event *livefeed.Event) error {
+		return sendErr
+	})
+
+	assert.Equal(t, sendErr, err)
+}
+
+// TestWatchPostsUseCase_Execute_ValidationError tests invalid filters.
+func TestWatchPostsUseCase_Execute_ValidationError(t *testing.T) {
+	subscriber := new(MockLiveFeedSubscriber)
+	uc := content.NewWatchPostsUseCase(subscriber, nil)
+
+	err := uc.Execute(context.Background(), content.WatchPostsQuery{View: "TINY"}, func(event *livefeed.Event) error {
+		return nil
+	})
+
+	require.Error(t, err)
+	assert.True(t, apperrors.IsValidationError(err))
+	subscriber.AssertNotCalled(t, "Subscribe", mock.Anything)
+}
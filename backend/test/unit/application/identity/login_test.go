@@ -0,0 +1,148 @@
+package identity_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"fuck_boss/backend/internal/application/identity"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+func TestLoginUseCase_Execute_ByEmail(t *testing.T) {
+	mockUsers := new(MockUserRepository)
+	mockTokens := new(MockRefreshTokenRepository)
+	mockRateLimiter := new(MockRateLimiter)
+	uc := identity.NewLoginUseCase(mockUsers, mockTokens, plainHasher{}, stubTokenIssuer{}, mockRateLimiter)
+
+	ctx := context.Background()
+	user := newTestUser(t, "alice@example.com", "alice", "hunter2hunter2")
+
+	mockRateLimiter.On("Allow", ctx, mock.AnythingOfType("string"), 20, time.Hour).Return(true, nil)
+	mockUsers.On("FindByEmail", ctx, user.Email()).Return(user, nil)
+	mockTokens.On("Save", ctx, mock.AnythingOfType("*identity.RefreshToken")).Return(nil)
+
+	result, err := uc.Execute(ctx, identity.LoginCommand{
+		Account:  "ALICE@example.com",
+		Password: "hunter2hunter2",
+		ClientIP: "192.168.1.1",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, user.ID().String(), result.UserID)
+	assert.NotEmpty(t, result.RefreshToken)
+	mockUsers.AssertNotCalled(t, "FindByUsername", mock.Anything, mock.Anything)
+	mockTokens.AssertExpectations(t)
+}
+
+func TestLoginUseCase_Execute_ByUsername(t *testing.T) {
+	mockUsers := new(MockUserRepository)
+	mockTokens := new(MockRefreshTokenRepository)
+	mockRateLimiter := new(MockRateLimiter)
+	uc := identity.NewLoginUseCase(mockUsers, mockTokens, plainHasher{}, stubTokenIssuer{}, mockRateLimiter)
+
+	ctx := context.Background()
+	user := newTestUser(t, "alice@example.com", "alice", "hunter2hunter2")
+
+	mockRateLimiter.On("Allow", ctx, mock.AnythingOfType("string"), 20, time.Hour).Return(true, nil)
+	mockUsers.On("FindByUsername", ctx, user.Username()).Return(user, nil)
+	mockTokens.On("Save", ctx, mock.AnythingOfType("*identity.RefreshToken")).Return(nil)
+
+	result, err := uc.Execute(ctx, identity.LoginCommand{
+		Account:  "alice",
+		Password: "hunter2hunter2",
+		ClientIP: "192.168.1.1",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, user.ID().String(), result.UserID)
+}
+
+func TestLoginUseCase_Execute_Banned(t *testing.T) {
+	mockUsers := new(MockUserRepository)
+	mockTokens := new(MockRefreshTokenRepository)
+	mockRateLimiter := new(MockRateLimiter)
+	uc := identity.NewLoginUseCase(mockUsers, mockTokens, plainHasher{}, stubTokenIssuer{}, mockRateLimiter)
+
+	ctx := context.Background()
+	user := newTestUser(t, "alice@example.com", "alice", "hunter2hunter2")
+	require.NoError(t, user.Ban("散布他人隐私"))
+
+	mockRateLimiter.On("Allow", ctx, mock.AnythingOfType("string"), 20, time.Hour).Return(true, nil)
+	mockUsers.On("FindByUsername", ctx, user.Username()).Return(user, nil)
+
+	result, err := uc.Execute(ctx, identity.LoginCommand{
+		Account:  "alice",
+		Password: "hunter2hunter2",
+		ClientIP: "192.168.1.1",
+	})
+
+	assert.Nil(t, result)
+	assert.True(t, apperrors.IsForbiddenError(err))
+	mockTokens.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+}
+
+func TestLoginUseCase_Execute_InvalidCredentials(t *testing.T) {
+	tests := []struct {
+		name     string
+		account  string
+		password string
+		found    bool
+	}{
+		{name: "wrong password", account: "alice@example.com", password: "wrong-password1", found: true},
+		{name: "unknown account", account: "bob@example.com", password: "hunter2hunter2", found: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUsers := new(MockUserRepository)
+			mockTokens := new(MockRefreshTokenRepository)
+			mockRateLimiter := new(MockRateLimiter)
+			uc := identity.NewLoginUseCase(mockUsers, mockTokens, plainHasher{}, stubTokenIssuer{}, mockRateLimiter)
+
+			ctx := context.Background()
+			mockRateLimiter.On("Allow", ctx, mock.AnythingOfType("string"), 20, time.Hour).Return(true, nil)
+			if tt.found {
+				mockUsers.On("FindByEmail", ctx, mock.Anything).Return(newTestUser(t, tt.account, "", "hunter2hunter2"), nil)
+			} else {
+				mockUsers.On("FindByEmail", ctx, mock.Anything).Return(nil, apperrors.NewNotFoundError("user"))
+			}
+
+			result, err := uc.Execute(ctx, identity.LoginCommand{
+				Account:  tt.account,
+				Password: tt.password,
+				ClientIP: "192.168.1.1",
+			})
+
+			// Both cases return the same error so accounts cannot be enumerated
+			assert.Nil(t, result)
+			assert.True(t, apperrors.IsUnauthenticatedError(err))
+			assert.Contains(t, err.Error(), "invalid account or password")
+			mockTokens.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestLoginUseCase_Execute_RateLimitExceeded(t *testing.T) {
+	mockUsers := new(MockUserRepository)
+	mockTokens := new(MockRefreshTokenRepository)
+	mockRateLimiter := new(MockRateLimiter)
+	uc := identity.NewLoginUseCase(mockUsers, mockTokens, plainHasher{}, stubTokenIssuer{}, mockRateLimiter)
+
+	ctx := context.Background()
+	mockRateLimiter.On("Allow", ctx, mock.AnythingOfType("string"), 20, time.Hour).Return(false, nil)
+
+	result, err := uc.Execute(ctx, identity.LoginCommand{
+		Account:  "alice@example.com",
+		Password: "hunter2hunter2",
+		ClientIP: "192.168.1.1",
+	})
+
+	assert.Nil(t, result)
+	assert.True(t, apperrors.IsRateLimitError(err))
+	mockUsers.AssertNotCalled(t, "FindByEmail", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,151 @@
+package identity_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"fuck_boss/backend/internal/application/identity"
+	domainidentity "fuck_boss/backend/internal/domain/identity"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+func TestRefreshUseCase_Execute_Success(t *testing.T) {
+	mockUsers := new(MockUserRepository)
+	mockTokens := new(MockRefreshTokenRepository)
+	uc := identity.NewRefreshUseCase(mockUsers, mockTokens, stubTokenIssuer{})
+
+	ctx := context.Background()
+	user := newTestUser(t, "alice@example.com", "", "hunter2hunter2")
+	current, raw, err := domainidentity.IssueRefreshToken(user.ID(), time.Hour)
+	require.NoError(t, err)
+
+	mockTokens.On("FindByHash", ctx, domainidentity.HashRefreshToken(raw)).Return(current, nil)
+	mockTokens.On("Rotate", ctx, current, mock.AnythingOfType("*identity.RefreshToken")).Return(nil)
+	mockUsers.On("FindByID", ctx, user.ID()).Return(user, nil)
+
+	result, err := uc.Execute(ctx, identity.RefreshCommand{RefreshToken: raw})
+
+	require.NoError(t, err)
+	assert.Equal(t, user.ID().String(), result.UserID)
+	assert.NotEqual(t, raw, result.RefreshToken)
+	assert.True(t, current.IsRevoked())
+
+	next := mockTokens.Calls[1].Arguments.Get(2).(*domainidentity.RefreshToken)
+	assert.Equal(t, current.FamilyID(), next.FamilyID())
+	assert.Equal(t, domainidentity.HashRefreshToken(result.RefreshToken), next.TokenHash())
+	mockTokens.AssertNotCalled(t, "RevokeFamily", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRefreshUseCase_Execute_BannedRevokesFamily(t *testing.T) {
+	mockUsers := new(MockUserRepository)
+	mockTokens := new(MockRefreshTokenRepository)
+	uc := identity.NewRefreshUseCase(mockUsers, mockTokens, stubTokenIssuer{})
+
+	ctx := context.Background()
+	user := newTestUser(t, "alice@example.com", "", "hunter2hunter2")
+	require.NoError(t, user.Ban("散布他人隐私"))
+	current, raw, err := domainidentity.IssueRefreshToken(user.ID(), time.Hour)
+	require.NoError(t, err)
+
+	mockTokens.On("FindByHash", ctx, domainidentity.HashRefreshToken(raw)).Return(current, nil)
+	mockTokens.On("Rotate", ctx, current, mock.AnythingOfType("*identity.RefreshToken")).Return(nil)
+	mockTokens.On("RevokeFamily", ctx, current.FamilyID(), mock.AnythingOfType("time.Time")).Return(nil)
+	mockUsers.On("FindByID", ctx, user.ID()).Return(user, nil)
+
+	result, err := uc.Execute(ctx, identity.RefreshCommand{RefreshToken: raw})
+
+	assert.Nil(t, result)
+	assert.True(t, apperrors.IsForbiddenError(err))
+	mockTokens.AssertExpectations(t)
+}
+
+func TestRefreshUseCase_Execute_ReuseRevokesFamily(t *testing.T) {
+	mockUsers := new(MockUserRepository)
+	mockTokens := new(MockRefreshTokenRepository)
+	uc := identity.NewRefreshUseCase(mockUsers, mockTokens, stubTokenIssuer{})
+
+	ctx := context.Background()
+	current, raw, err := domainidentity.IssueRefreshToken(domainidentity.GenerateUserID(), time.Hour)
+	require.NoError(t, err)
+	current.Revoke(time.Now()) // already rotated
+
+	mockTokens.On("FindByHash", ctx, domainidentity.HashRefreshToken(raw)).Return(current, nil)
+	mockTokens.On("RevokeFamily", ctx, current.FamilyID(), mock.AnythingOfType("time.Time")).Return(nil)
+
+	result, err := uc.Execute(ctx, identity.RefreshCommand{RefreshToken: raw})
+
+	assert.Nil(t, result)
+	assert.True(t, apperrors.IsUnauthenticatedError(err))
+	mockTokens.AssertExpectations(t)
+	mockTokens.AssertNotCalled(t, "Rotate", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRefreshUseCase_Execute_ConcurrentRotation(t *testing.T) {
+	mockUsers := new(MockUserRepository)
+	mockTokens := new(MockRefreshTokenRepository)
+	uc := identity.NewRefreshUseCase(mockUsers, mockTokens, stubTokenIssuer{})
+
+	ctx := context.Background()
+	current, raw, err := domainidentity.IssueRefreshToken(domainidentity.GenerateUserID(), time.Hour)
+	require.NoError(t, err)
+
+	mockTokens.On("FindByHash", ctx, domainidentity.HashRefreshToken(raw)).Return(current, nil)
+	mockTokens.On("Rotate", ctx, current, mock.Anything).Return(apperrors.NewConflictError("refresh token was already rotated"))
+	mockTokens.On("RevokeFamily", ctx, current.FamilyID(), mock.AnythingOfType("time.Time")).Return(nil)
+
+	result, err := uc.Execute(ctx, identity.RefreshCommand{RefreshToken: raw})
+
+	assert.Nil(t, result)
+	assert.True(t, apperrors.IsUnauthenticatedError(err))
+	mockTokens.AssertExpectations(t)
+	mockUsers.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+}
+
+func TestRefreshUseCase_Execute_UnknownToken(t *testing.T) {
+	mockUsers := new(MockUserRepository)
+	mockTokens := new(MockRefreshTokenRepository)
+	uc := identity.NewRefreshUseCase(mockUsers, mockTokens, stubTokenIssuer{})
+
+	ctx := context.Background()
+	mockTokens.On("FindByHash", ctx, mock.Anything).Return(nil, apperrors.NewNotFoundError("refresh token"))
+
+	result, err := uc.Execute(ctx, identity.RefreshCommand{RefreshToken: "unknown"})
+
+	assert.Nil(t, result)
+	assert.True(t, apperrors.IsUnauthenticatedError(err))
+}
+
+func TestLogoutUseCase_Execute(t *testing.T) {
+	mockTokens := new(MockRefreshTokenRepository)
+	uc := identity.NewLogoutUseCase(mockTokens)
+
+	ctx := context.Background()
+	current, raw, err := domainidentity.IssueRefreshToken(domainidentity.GenerateUserID(), time.Hour)
+	require.NoError(t, err)
+
+	mockTokens.On("FindByHash", ctx, domainidentity.HashRefreshToken(raw)).Return(current, nil)
+	mockTokens.On("RevokeFamily", ctx, current.FamilyID(), mock.AnythingOfType("time.Time")).Return(nil)
+
+	err = uc.Execute(ctx, identity.LogoutCommand{RefreshToken: raw})
+
+	require.NoError(t, err)
+	mockTokens.AssertExpectations(t)
+}
+
+func TestLogoutUseCase_Execute_UnknownToken(t *testing.T) {
+	mockTokens := new(MockRefreshTokenRepository)
+	uc := identity.NewLogoutUseCase(mockTokens)
+
+	ctx := context.Background()
+	mockTokens.On("FindByHash", ctx, mock.Anything).Return(nil, apperrors.NewNotFoundError("refresh token"))
+
+	err := uc.Execute(ctx, identity.LogoutCommand{RefreshToken: "unknown"})
+
+	assert.NoError(t, err)
+	mockTokens.AssertNotCalled(t, "RevokeFamily", mock.Anything, mock.Anything, mock.Anything)
+}
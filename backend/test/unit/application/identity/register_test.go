@@ -0,0 +1,333 @@
+// Package identity_test provides unit tests for identity use cases.
+// These tests use mocked dependencies to isolate the use case logic.
+package identity_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"fuck_boss/backend/internal/application/identity"
+	"fuck_boss/backend/internal/application/verification"
+	domainidentity "fuck_boss/backend/internal/domain/identity"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// MockUserRepository is a mock implementation of UserRepository.
+type MockUserRepository struct {
+	mock.Mock
+}
+
+func (m *MockUserRepository) Save(ctx context.Context, user *domainidentity.User) error {
+	args := m.Called(ctx, user)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) FindByID(ctx context.Context, id domainidentity.UserID) (*domainidentity.User, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainidentity.User), args.Error(1)
+}
+
+func (m *MockUserRepository) FindByEmail(ctx context.Context, email domainidentity.Email) (*domainidentity.User, error) {
+	args := m.Called(ctx, email)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainidentity.User), args.Error(1)
+}
+
+func (m *MockUserRepository) FindByUsername(ctx context.Context, username domainidentity.Username) (*domainidentity.User, error) {
+	args := m.Called(ctx, username)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainidentity.User), args.Error(1)
+}
+
+// MockRefreshTokenRepository is a mock implementation of RefreshTokenRepository.
+type MockRefreshTokenRepository struct {
+	mock.Mock
+}
+
+func (m *MockRefreshTokenRepository) Save(ctx context.Context, token *domainidentity.RefreshToken) error {
+	args := m.Called(ctx, token)
+	return args.Error(0)
+}
+
+func (m *MockRefreshTokenRepository) Rotate(ctx context.Context, revoked *domainidentity.RefreshToken, next *domainidentity.RefreshToken) error {
+	args := m.Called(ctx, revoked, next)
+	return args.Error(0)
+}
+
+func (m *MockRefreshTokenRepository) FindByHash(ctx context.Context, tokenHash string) (*domainidentity.RefreshToken, error) {
+	args := m.Called(ctx, tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainidentity.RefreshToken), args.Error(1)
+}
+
+func (m *MockRefreshTokenRepository) RevokeFamily(ctx context.Context, familyID string, at time.Time) error {
+	args := m.Called(ctx, familyID, at)
+	return args.Error(0)
+}
+
+// MockRateLimiter is a mock implementation of RateLimiter.
+type MockRateLimiter struct {
+	mock.Mock
+}
+
+func (m *MockRateLimiter) Allow(ctx context.Context, key string, limit int, window time.Duration) (bool, error) {
+	args := m.Called(ctx, key, limit, window)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRateLimiter) Reset(ctx context.Context, key string) error {
+	args := m.Called(ctx, key)
+	return args.Error(0)
+}
+
+// MockCodeVerifier is a mock implementation of CodeVerifier.
+type MockCodeVerifier struct {
+	mock.Mock
+}
+
+func (m *MockCodeVerifier) Verify(ctx context.Context, cmd verification.VerifyCommand) error {
+	args := m.Called(ctx, cmd)
+	return args.Error(0)
+}
+
+// plainHasher is a fast, insecure PasswordHasher for tests.
+type plainHasher struct{}
+
+func (plainHasher) Hash(password string) (string, error) {
+	return "plain:" + password, nil
+}
+
+func (plainHasher) Verify(password, encodedHash string) (bool, error) {
+	return encodedHash == "plain:"+password, nil
+}
+
+// stubTokenIssuer is a TokenIssuer that issues predictable access tokens.
+type stubTokenIssuer struct{}
+
+func (stubTokenIssuer) IssueAccessToken(userID string) (string, time.Time, error) {
+	return "access-" + userID, time.Now().Add(15 * time.Minute), nil
+}
+
+func (stubTokenIssuer) ParseAccessToken(token string) (string, error) {
+	return token[len("access-"):], nil
+}
+
+func (stubTokenIssuer) RefreshTokenTTL() time.Duration {
+	return 24 * time.Hour
+}
+
+// newTestUser creates a user with the given email, username and password.
+func newTestUser(t *testing.T, email, username, password string) *domainidentity.User {
+	t.Helper()
+
+	emailVO, err := domainidentity.NewEmail(email)
+	require.NoError(t, err)
+	usernameVO, err := domainidentity.NewUsername(username)
+	require.NoError(t, err)
+	passwordVO, err := domainidentity.NewPassword(password)
+	require.NoError(t, err)
+
+	user, err := domainidentity.NewUser(emailVO, usernameVO, passwordVO, plainHasher{})
+	require.NoError(t, err)
+	return user
+}
+
+func TestRegisterUseCase_Execute_Success(t *testing.T) {
+	// Setup mocks
+	mockUsers := new(MockUserRepository)
+	mockTokens := new(MockRefreshTokenRepository)
+	mockRateLimiter := new(MockRateLimiter)
+
+	// Create use case
+	uc := identity.NewRegisterUseCase(mockUsers, mockTokens, plainHasher{}, stubTokenIssuer{}, mockRateLimiter, nil)
+
+	ctx := context.Background()
+
+	// Setup expectations
+	mockRateLimiter.On("Allow", ctx, mock.AnythingOfType("string"), 5, time.Hour).Return(true, nil)
+	mockUsers.On("FindByEmail", ctx, mock.Anything).Return(nil, apperrors.NewNotFoundError("user"))
+	mockUsers.On("FindByUsername", ctx, mock.Anything).Return(nil, apperrors.NewNotFoundError("user"))
+	mockUsers.On("Save", ctx, mock.AnythingOfType("*identity.User")).Return(nil)
+	mockTokens.On("Save", ctx, mock.AnythingOfType("*identity.RefreshToken")).Return(nil)
+
+	// Execute
+	result, err := uc.Execute(ctx, identity.RegisterCommand{
+		Email:    "Alice@Example.com",
+		Username: "alice",
+		Password: "hunter2hunter2",
+		ClientIP: "192.168.1.1",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.NotEmpty(t, result.UserID)
+	assert.Equal(t, "alice@example.com", result.Email)
+	assert.Equal(t, "alice", result.Username)
+	assert.Equal(t, "access-"+result.UserID, result.AccessToken)
+	assert.NotEmpty(t, result.RefreshToken)
+
+	// The stored refresh token is hashed
+	savedToken := mockTokens.Calls[0].Arguments.Get(1).(*domainidentity.RefreshToken)
+	assert.Equal(t, domainidentity.HashRefreshToken(result.RefreshToken), savedToken.TokenHash())
+
+	// The stored password is hashed
+	savedUser := mockUsers.Calls[2].Arguments.Get(1).(*domainidentity.User)
+	assert.NotEqual(t, "hunter2hunter2", savedUser.PasswordHash())
+
+	mockUsers.AssertExpectations(t)
+	mockTokens.AssertExpectations(t)
+}
+
+func TestRegisterUseCase_Execute_EmailTaken(t *testing.T) {
+	mockUsers := new(MockUserRepository)
+	mockTokens := new(MockRefreshTokenRepository)
+	mockRateLimiter := new(MockRateLimiter)
+	uc := identity.NewRegisterUseCase(mockUsers, mockTokens, plainHasher{}, stubTokenIssuer{}, mockRateLimiter, nil)
+
+	ctx := context.Background()
+	existing := newTestUser(t, "alice@example.com", "", "hunter2hunter2")
+
+	mockRateLimiter.On("Allow", ctx, mock.AnythingOfType("string"), 5, time.Hour).Return(true, nil)
+	mockUsers.On("FindByEmail", ctx, mock.Anything).Return(existing, nil)
+
+	result, err := uc.Execute(ctx, identity.RegisterCommand{
+		Email:    "alice@example.com",
+		Password: "hunter2hunter2",
+		ClientIP: "192.168.1.1",
+	})
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.True(t, apperrors.IsConflictError(err))
+	mockUsers.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	mockTokens.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+}
+
+func TestRegisterUseCase_Execute_RateLimitExceeded(t *testing.T) {
+	mockUsers := new(MockUserRepository)
+	mockTokens := new(MockRefreshTokenRepository)
+	mockRateLimiter := new(MockRateLimiter)
+	uc := identity.NewRegisterUseCase(mockUsers, mockTokens, plainHasher{}, stubTokenIssuer{}, mockRateLimiter, nil)
+
+	ctx := context.Background()
+	mockRateLimiter.On("Allow", ctx, mock.AnythingOfType("string"), 5, time.Hour).Return(false, nil)
+
+	result, err := uc.Execute(ctx, identity.RegisterCommand{
+		Email:    "alice@example.com",
+		Password: "hunter2hunter2",
+		ClientIP: "192.168.1.1",
+	})
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.True(t, apperrors.IsRateLimitError(err))
+	mockUsers.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+}
+
+func TestRegisterUseCase_Execute_ValidationError(t *testing.T) {
+	tests := []struct {
+		name string
+		cmd  identity.RegisterCommand
+	}{
+		{
+			name: "missing email",
+			cmd:  identity.RegisterCommand{Password: "hunter2hunter2", ClientIP: "192.168.1.1"},
+		},
+		{
+			name: "invalid email",
+			cmd:  identity.RegisterCommand{Email: "not-an-email", Password: "hunter2hunter2", ClientIP: "192.168.1.1"},
+		},
+		{
+			name: "weak password",
+			cmd:  identity.RegisterCommand{Email: "alice@example.com", Password: "password", ClientIP: "192.168.1.1"},
+		},
+		{
+			name: "invalid username",
+			cmd:  identity.RegisterCommand{Email: "alice@example.com", Username: "a b", Password: "hunter2hunter2", ClientIP: "192.168.1.1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUsers := new(MockUserRepository)
+			mockTokens := new(MockRefreshTokenRepository)
+			mockRateLimiter := new(MockRateLimiter)
+			mockRateLimiter.On("Allow", mock.Anything, mock.AnythingOfType("string"), 5, time.Hour).Return(true, nil).Maybe()
+
+			uc := identity.NewRegisterUseCase(mockUsers, mockTokens, plainHasher{}, stubTokenIssuer{}, mockRateLimiter, nil)
+
+			result, err := uc.Execute(context.Background(), tt.cmd)
+
+			assert.Error(t, err)
+			assert.Nil(t, result)
+			assert.True(t, apperrors.IsValidationError(err))
+			mockUsers.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestRegisterUseCase_Execute_RequiresVerificationCode(t *testing.T) {
+	mockUsers := new(MockUserRepository)
+	mockTokens := new(MockRefreshTokenRepository)
+	mockRateLimiter := new(MockRateLimiter)
+	mockCodes := new(MockCodeVerifier)
+	uc := identity.NewRegisterUseCase(mockUsers, mockTokens, plainHasher{}, stubTokenIssuer{}, mockRateLimiter, mockCodes)
+
+	result, err := uc.Execute(context.Background(), identity.RegisterCommand{
+		Email:    "alice@example.com",
+		Password: "hunter2hunter2",
+		ClientIP: "192.168.1.1",
+	})
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.True(t, apperrors.IsValidationError(err))
+	mockCodes.AssertNotCalled(t, "Verify", mock.Anything, mock.Anything)
+}
+
+func TestRegisterUseCase_Execute_WrongVerificationCode(t *testing.T) {
+	mockUsers := new(MockUserRepository)
+	mockTokens := new(MockRefreshTokenRepository)
+	mockRateLimiter := new(MockRateLimiter)
+	mockCodes := new(MockCodeVerifier)
+	uc := identity.NewRegisterUseCase(mockUsers, mockTokens, plainHasher{}, stubTokenIssuer{}, mockRateLimiter, mockCodes)
+
+	ctx := context.Background()
+
+	mockRateLimiter.On("Allow", ctx, mock.AnythingOfType("string"), 5, time.Hour).Return(true, nil)
+	mockUsers.On("FindByEmail", ctx, mock.Anything).Return(nil, apperrors.NewNotFoundError("user"))
+	mockCodes.On("Verify", ctx, verification.VerifyCommand{
+		Channel:   "EMAIL",
+		Recipient: "alice@example.com",
+		Purpose:   "REGISTER",
+		Code:      "123456",
+	}).Return(apperrors.NewValidationError("verification code is invalid or expired"))
+
+	result, err := uc.Execute(ctx, identity.RegisterCommand{
+		Email:            "Alice@Example.com",
+		Password:         "hunter2hunter2",
+		VerificationCode: "123456",
+		ClientIP:         "192.168.1.1",
+	})
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.True(t, apperrors.IsValidationError(err))
+	mockUsers.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	mockCodes.AssertExpectations(t)
+}
@@ -0,0 +1,120 @@
+package moderation_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"fuck_boss/backend/internal/application/moderation"
+	domainmoderation "fuck_boss/backend/internal/domain/moderation"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+const (
+	testModeratorID = "7c9e6679-7425-40de-944b-e07fc1f90ae7"
+	testAuthorID    = "550e8400-e29b-41d4-a716-446655440000"
+)
+
+// MockModeratorRepository is a mock implementation of ModeratorRepository.
+type MockModeratorRepository struct {
+	mock.Mock
+}
+
+func (m *MockModeratorRepository) Save(ctx context.Context, moderator *domainmoderation.Moderator) error {
+	args := m.Called(ctx, moderator)
+	return args.Error(0)
+}
+
+func (m *MockModeratorRepository) FindByUserID(ctx context.Context, userID string) (*domainmoderation.Moderator, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainmoderation.Moderator), args.Error(1)
+}
+
+func (m *MockModeratorRepository) FindAll(ctx context.Context) ([]*domainmoderation.Moderator, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domainmoderation.Moderator), args.Error(1)
+}
+
+func (m *MockModeratorRepository) Delete(ctx context.Context, userID string) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+// newTestModerator creates the test moderator as loaded from the database.
+func newTestModerator(role domainmoderation.Role, cityCodes ...string) *domainmoderation.Moderator {
+	assignedAt := time.Now().Add(-24 * time.Hour)
+	return domainmoderation.NewModeratorFromDB(testModeratorID, role, cityCodes, assignedAt, assignedAt)
+}
+
+// TestAuthorizeModeratorUseCase_Execute tests the role check of moderator calls.
+func TestAuthorizeModeratorUseCase_Execute(t *testing.T) {
+	tests := []struct {
+		name       string
+		moderator  *domainmoderation.Moderator
+		findErr    error
+		permission string
+		wantErr    func(error) bool
+		wantResult bool
+	}{
+		{name: "junior hides", moderator: newTestModerator(domainmoderation.RoleJunior, "beijing"), permission: "HIDE_POST", wantResult: true},
+		{name: "senior bans", moderator: newTestModerator(domainmoderation.RoleSenior), permission: "BAN_USER", wantResult: true},
+		{name: "junior cannot remove", moderator: newTestModerator(domainmoderation.RoleJunior), permission: "REMOVE_POST", wantErr: apperrors.IsForbiddenError, wantResult: true},
+		{name: "not a moderator", findErr: apperrors.NewNotFoundError("moderator"), permission: "VIEW_QUEUE", wantErr: apperrors.IsForbiddenError},
+		{name: "database error", findErr: errors.New("connection refused"), permission: "VIEW_QUEUE", wantErr: apperrors.IsDatabaseError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockModeratorRepository)
+			uc := moderation.NewAuthorizeModeratorUseCase(mockRepo)
+
+			ctx := context.Background()
+			if tt.moderator != nil {
+				mockRepo.On("FindByUserID", ctx, testModeratorID).Return(tt.moderator, nil)
+			} else {
+				mockRepo.On("FindByUserID", ctx, testModeratorID).Return(nil, tt.findErr)
+			}
+
+			result, err := uc.Execute(ctx, moderation.AuthorizeModeratorCommand{UserID: testModeratorID, Permission: tt.permission})
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+			} else {
+				require.NoError(t, err)
+			}
+			if tt.wantResult {
+				require.NotNil(t, result)
+				assert.Equal(t, testModeratorID, result.UserID)
+			} else {
+				assert.Nil(t, result)
+			}
+		})
+	}
+}
+
+// TestAuthorizeModeratorUseCase_Execute_InvalidInput tests calls without a valid user or permission.
+func TestAuthorizeModeratorUseCase_Execute_InvalidInput(t *testing.T) {
+	mockRepo := new(MockModeratorRepository)
+	uc := moderation.NewAuthorizeModeratorUseCase(mockRepo)
+	ctx := context.Background()
+
+	_, err := uc.Execute(ctx, moderation.AuthorizeModeratorCommand{Permission: "VIEW_QUEUE"})
+	assert.True(t, apperrors.IsUnauthenticatedError(err))
+
+	_, err = uc.Execute(ctx, moderation.AuthorizeModeratorCommand{UserID: testModeratorID, Permission: "DELETE_POST"})
+	assert.True(t, apperrors.IsInternalError(err))
+
+	mockRepo.AssertNotCalled(t, "FindByUserID", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,199 @@
+package moderation_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"fuck_boss/backend/internal/application/moderation"
+	domainidentity "fuck_boss/backend/internal/domain/identity"
+	domainmoderation "fuck_boss/backend/internal/domain/moderation"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// MockUserRepository is a mock implementation of UserRepository.
+type MockUserRepository struct {
+	mock.Mock
+}
+
+func (m *MockUserRepository) Save(ctx context.Context, user *domainidentity.User) error {
+	args := m.Called(ctx, user)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) FindByID(ctx context.Context, id domainidentity.UserID) (*domainidentity.User, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainidentity.User), args.Error(1)
+}
+
+func (m *MockUserRepository) FindByEmail(ctx context.Context, email domainidentity.Email) (*domainidentity.User, error) {
+	args := m.Called(ctx, email)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainidentity.User), args.Error(1)
+}
+
+func (m *MockUserRepository) FindByUsername(ctx context.Context, username domainidentity.Username) (*domainidentity.User, error) {
+	args := m.Called(ctx, username)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainidentity.User), args.Error(1)
+}
+
+// newTestUser creates the user with the given ID as loaded from the database.
+func newTestUser(t *testing.T, id string, bannedAt *time.Time) *domainidentity.User {
+	t.Helper()
+
+	userID, err := domainidentity.NewUserID(id)
+	require.NoError(t, err)
+	email, err := domainidentity.NewEmail("author@example.com")
+	require.NoError(t, err)
+	username, err := domainidentity.NewUsername("author")
+	require.NoError(t, err)
+
+	banReason := ""
+	if bannedAt != nil {
+		banReason = "spam"
+	}
+	createdAt := time.Now().Add(-48 * time.Hour)
+	return domainidentity.NewUserFromDB(userID, email, username, "hash", createdAt, createdAt, bannedAt, banReason)
+}
+
+// TestBanUserUseCase_Execute_Success tests banning the author of a post.
+func TestBanUserUseCase_Execute_Success(t *testing.T) {
+	mockModerators := new(MockModeratorRepository)
+	mockPosts := new(MockPostModerationRepository)
+	mockUsers := new(MockUserRepository)
+	uc := moderation.NewBanUserUseCase(mockModerators, mockPosts, mockUsers)
+
+	ctx := context.Background()
+	post := newTestPost(t, "beijing", testAuthorID)
+	author := newTestUser(t, testAuthorID, nil)
+	mockModerators.On("FindByUserID", ctx, testModeratorID).Return(newTestModerator(domainmoderation.RoleSenior, "beijing"), nil)
+	mockModerators.On("FindByUserID", ctx, testAuthorID).Return(nil, apperrors.NewNotFoundError("moderator"))
+	mockPosts.On("FindByIDAnyStatus", ctx, post.ID()).Return(post, nil)
+	mockUsers.On("FindByID", ctx, author.ID()).Return(author, nil)
+	mockUsers.On("Save", ctx, author).Return(nil)
+
+	result, err := uc.Execute(ctx, moderation.BanUserCommand{
+		ModeratorID: testModeratorID,
+		PostID:      post.ID().String(),
+		Reason:      "  反复发布广告  ",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, testAuthorID, result.UserID)
+	assert.Equal(t, "反复发布广告", result.Reason)
+	assert.False(t, result.BannedAt.IsZero())
+	assert.True(t, author.IsBanned())
+	mockUsers.AssertExpectations(t)
+}
+
+// TestBanUserUseCase_Execute_Rejected tests bans that are not allowed.
+func TestBanUserUseCase_Execute_Rejected(t *testing.T) {
+	bannedAt := time.Now().Add(-time.Hour)
+	tests := []struct {
+		name           string
+		moderator      *domainmoderation.Moderator
+		authorID       string
+		authorIsMod    bool
+		author         *domainidentity.User
+		wantErr        func(error) bool
+		wantLoadedPost bool
+	}{
+		{name: "junior cannot ban", moderator: newTestModerator(domainmoderation.RoleJunior), authorID: testAuthorID, wantErr: apperrors.IsForbiddenError},
+		{name: "other city", moderator: newTestModerator(domainmoderation.RoleSenior, "shanghai"), authorID: testAuthorID, wantErr: apperrors.IsForbiddenError, wantLoadedPost: true},
+		{name: "anonymous post", moderator: newTestModerator(domainmoderation.RoleSenior), wantErr: apperrors.IsValidationError, wantLoadedPost: true},
+		{name: "own post", moderator: newTestModerator(domainmoderation.RoleSenior), authorID: testModeratorID, wantErr: apperrors.IsValidationError, wantLoadedPost: true},
+		{name: "author is a moderator", moderator: newTestModerator(domainmoderation.RoleSenior), authorID: testAuthorID, authorIsMod: true, wantErr: apperrors.IsForbiddenError, wantLoadedPost: true},
+		{name: "already banned", moderator: newTestModerator(domainmoderation.RoleSenior), authorID: testAuthorID, author: newTestUser(t, testAuthorID, &bannedAt), wantErr: apperrors.IsConflictError, wantLoadedPost: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockModerators := new(MockModeratorRepository)
+			mockPosts := new(MockPostModerationRepository)
+			mockUsers := new(MockUserRepository)
+			uc := moderation.NewBanUserUseCase(mockModerators, mockPosts, mockUsers)
+
+			ctx := context.Background()
+			post := newTestPost(t, "beijing", tt.authorID)
+			mockModerators.On("FindByUserID", ctx, testModeratorID).Return(tt.moderator, nil)
+			if tt.authorIsMod {
+				mockModerators.On("FindByUserID", ctx, testAuthorID).Return(newTestModerator(domainmoderation.RoleJunior), nil)
+			} else {
+				mockModerators.On("FindByUserID", ctx, testAuthorID).Return(nil, apperrors.NewNotFoundError("moderator"))
+			}
+			mockPosts.On("FindByIDAnyStatus", ctx, post.ID()).Return(post, nil)
+			if tt.author != nil {
+				mockUsers.On("FindByID", ctx, tt.author.ID()).Return(tt.author, nil)
+			}
+
+			result, err := uc.Execute(ctx, moderation.BanUserCommand{
+				ModeratorID: testModeratorID,
+				PostID:      post.ID().String(),
+				Reason:      "反复发布广告",
+			})
+
+			require.Error(t, err)
+			assert.Nil(t, result)
+			assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+			if !tt.wantLoadedPost {
+				mockPosts.AssertNotCalled(t, "FindByIDAnyStatus", mock.Anything, mock.Anything)
+			}
+			mockUsers.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+// TestUnbanUserUseCase_Execute tests lifting bans by account.
+func TestUnbanUserUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	bannedAt := time.Now().Add(-time.Hour)
+
+	t.Run("by email", func(t *testing.T) {
+		mockUsers := new(MockUserRepository)
+		uc := moderation.NewUnbanUserUseCase(mockUsers)
+		user := newTestUser(t, testAuthorID, &bannedAt)
+		mockUsers.On("FindByEmail", ctx, user.Email()).Return(user, nil)
+		mockUsers.On("Save", ctx, user).Return(nil)
+
+		userID, err := uc.Execute(ctx, moderation.UnbanUserCommand{Account: "author@example.com"})
+
+		require.NoError(t, err)
+		assert.Equal(t, testAuthorID, userID)
+		assert.False(t, user.IsBanned())
+	})
+
+	t.Run("not banned", func(t *testing.T) {
+		mockUsers := new(MockUserRepository)
+		uc := moderation.NewUnbanUserUseCase(mockUsers)
+		user := newTestUser(t, testAuthorID, nil)
+		mockUsers.On("FindByUsername", ctx, user.Username()).Return(user, nil)
+
+		_, err := uc.Execute(ctx, moderation.UnbanUserCommand{Account: "author"})
+
+		assert.True(t, apperrors.IsConflictError(err))
+		mockUsers.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+
+	t.Run("unknown account", func(t *testing.T) {
+		mockUsers := new(MockUserRepository)
+		uc := moderation.NewUnbanUserUseCase(mockUsers)
+		userID, _ := domainidentity.NewUserID(testAuthorID)
+		mockUsers.On("FindByID", ctx, userID).Return(nil, apperrors.NewNotFoundError("user"))
+
+		_, err := uc.Execute(ctx, moderation.UnbanUserCommand{Account: testAuthorID})
+
+		assert.True(t, apperrors.IsNotFoundError(err))
+	})
+}
@@ -0,0 +1,266 @@
+package moderation_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"fuck_boss/backend/internal/application/content"
+	"fuck_boss/backend/internal/application/moderation"
+	domaincontent "fuck_boss/backend/internal/domain/content"
+	domainmoderation "fuck_boss/backend/internal/domain/moderation"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// MockModerationAnnouncer is a mock implementation of ModerationAnnouncer.
+type MockModerationAnnouncer struct {
+	mock.Mock
+}
+
+func (m *MockModerationAnnouncer) Announce(ctx context.Context, post *domaincontent.Post, action content.ModerationAction) {
+	m.Called(ctx, post, action)
+}
+
+// otherModeratorID is the moderator who hid or removed the appealed posts.
+const otherModeratorID = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+
+// newPendingAppeal creates a pending appeal of a post hidden in Beijing by actedBy.
+func newPendingAppeal(t *testing.T, actedBy string) (*domainmoderation.Appeal, *domaincontent.Post) {
+	t.Helper()
+
+	reason, err := domaincontent.NewModerationReason("待核实")
+	require.NoError(t, err)
+	post := newTestPost(t, "beijing", "", domaincontent.WithModeration(domaincontent.Moderation{
+		Status:      domaincontent.ModerationStatusHidden,
+		Reason:      reason,
+		ModeratedAt: time.Now().Add(-time.Hour),
+		ModeratedBy: actedBy,
+	}))
+
+	appeal := domainmoderation.NewAppealFromDB(uuid.New().String(), post.ID(), domaincontent.ModerationStatusHidden,
+		actedBy, "这是误判", domainmoderation.AppealStatusPending, "", "", time.Now().Add(-time.Minute), nil)
+	return appeal, post
+}
+
+// TestListAppealsUseCase_Execute tests listing the appeals a senior moderator may decide.
+func TestListAppealsUseCase_Execute(t *testing.T) {
+	mockModerators := new(MockModeratorRepository)
+	mockAppeals := new(MockAppealRepository)
+	mockPosts := new(MockPostModerationRepository)
+	uc := moderation.NewListAppealsUseCase(mockModerators, mockAppeals, mockPosts)
+
+	ctx := context.Background()
+	appeal, post := newPendingAppeal(t, otherModeratorID)
+	mockModerators.On("FindByUserID", ctx, testModeratorID).
+		Return(newTestModerator(domainmoderation.RoleSenior, "beijing", "shanghai"), nil)
+	mockAppeals.On("FindPending", ctx, testModeratorID, []string{"beijing", "shanghai"}, 1, 20).
+		Return([]*domainmoderation.Appeal{appeal}, 1, nil)
+	mockPosts.On("FindByIDAnyStatus", ctx, post.ID()).Return(post, nil)
+
+	result, err := uc.Execute(ctx, moderation.ListAppealsQuery{ModeratorID: testModeratorID})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Total)
+	require.Len(t, result.Items, 1)
+	assert.Equal(t, appeal.ID(), result.Items[0].Appeal.ID)
+	assert.Equal(t, otherModeratorID, result.Items[0].Appeal.ActedBy)
+	assert.Equal(t, "HIDDEN", result.Items[0].Post.Status)
+	mockAppeals.AssertExpectations(t)
+}
+
+// TestListAppealsUseCase_Execute_Denied tests moderators who may not list appeals.
+func TestListAppealsUseCase_Execute_Denied(t *testing.T) {
+	tests := []struct {
+		name      string
+		moderator *domainmoderation.Moderator
+		cityCode  string
+	}{
+		{name: "junior moderator", moderator: newTestModerator(domainmoderation.RoleJunior, "beijing")},
+		{name: "other city", moderator: newTestModerator(domainmoderation.RoleSenior, "beijing"), cityCode: "shanghai"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockModerators := new(MockModeratorRepository)
+			mockAppeals := new(MockAppealRepository)
+			uc := moderation.NewListAppealsUseCase(mockModerators, mockAppeals, new(MockPostModerationRepository))
+
+			ctx := context.Background()
+			mockModerators.On("FindByUserID", ctx, testModeratorID).Return(tt.moderator, nil)
+
+			_, err := uc.Execute(ctx, moderation.ListAppealsQuery{ModeratorID: testModeratorID, CityCode: tt.cityCode})
+
+			assert.True(t, apperrors.IsForbiddenError(err), "unexpected error: %v", err)
+			mockAppeals.AssertNotCalled(t, "FindPending", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+// TestDecideAppealUseCase_Execute_Accept tests that accepting an appeal restores the post.
+func TestDecideAppealUseCase_Execute_Accept(t *testing.T) {
+	mockModerators := new(MockModeratorRepository)
+	mockAppeals := new(MockAppealRepository)
+	mockPosts := new(MockPostModerationRepository)
+	mockAnnouncer := new(MockModerationAnnouncer)
+	uc := moderation.NewDecideAppealUseCase(mockModerators, mockAppeals, mockPosts, mockAnnouncer)
+
+	ctx := context.Background()
+	appeal, post := newPendingAppeal(t, otherModeratorID)
+	mockModerators.On("FindByUserID", ctx, testModeratorID).Return(newTestModerator(domainmoderation.RoleSenior, "beijing"), nil)
+	mockAppeals.On("FindByID", ctx, appeal.ID()).Return(appeal, nil)
+	mockPosts.On("FindByIDAnyStatus", ctx, post.ID()).Return(post, nil)
+	mockAppeals.On("SaveDecision", ctx, appeal, mock.MatchedBy(func(restored *domaincontent.Post) bool {
+		return restored == post && restored.IsVisible() && restored.Moderation().ModeratedBy == testModeratorID
+	})).Return(nil)
+	mockAnnouncer.On("Announce", ctx, post, content.ModerationActionRestore).Return()
+
+	result, err := uc.Execute(ctx, moderation.DecideAppealCommand{
+		ModeratorID: testModeratorID,
+		AppealID:    appeal.ID(),
+		Decision:    "accept",
+		Reason:      "经核实内容属实",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "ACCEPTED", result.Status)
+	assert.Equal(t, testModeratorID, result.DecidedBy)
+	assert.Equal(t, "经核实内容属实", result.DecisionReason)
+	assert.NotNil(t, result.DecidedAt)
+	mockAnnouncer.AssertExpectations(t)
+	mockAppeals.AssertExpectations(t)
+}
+
+// TestDecideAppealUseCase_Execute_DecidedMeanwhile tests that a concurrent
+// decision wins: the repository rejects the second one and nothing is announced.
+func TestDecideAppealUseCase_Execute_DecidedMeanwhile(t *testing.T) {
+	mockModerators := new(MockModeratorRepository)
+	mockAppeals := new(MockAppealRepository)
+	mockPosts := new(MockPostModerationRepository)
+	mockAnnouncer := new(MockModerationAnnouncer)
+	uc := moderation.NewDecideAppealUseCase(mockModerators, mockAppeals, mockPosts, mockAnnouncer)
+
+	ctx := context.Background()
+	appeal, post := newPendingAppeal(t, otherModeratorID)
+	mockModerators.On("FindByUserID", ctx, testModeratorID).Return(newTestModerator(domainmoderation.RoleSenior), nil)
+	mockAppeals.On("FindByID", ctx, appeal.ID()).Return(appeal, nil)
+	mockPosts.On("FindByIDAnyStatus", ctx, post.ID()).Return(post, nil)
+	mockAppeals.On("SaveDecision", ctx, appeal, post).Return(apperrors.NewConflictError("appeal has already been decided"))
+
+	result, err := uc.Execute(ctx, moderation.DecideAppealCommand{
+		ModeratorID: testModeratorID,
+		AppealID:    appeal.ID(),
+		Decision:    moderation.AppealDecisionAccept,
+		Reason:      "经核实内容属实",
+	})
+
+	assert.Nil(t, result)
+	assert.True(t, apperrors.IsConflictError(err), "unexpected error: %v", err)
+	mockAnnouncer.AssertNotCalled(t, "Announce", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestDecideAppealUseCase_Execute_Reject tests that rejecting an appeal leaves the post alone.
+func TestDecideAppealUseCase_Execute_Reject(t *testing.T) {
+	mockModerators := new(MockModeratorRepository)
+	mockAppeals := new(MockAppealRepository)
+	mockPosts := new(MockPostModerationRepository)
+	mockAnnouncer := new(MockModerationAnnouncer)
+	uc := moderation.NewDecideAppealUseCase(mockModerators, mockAppeals, mockPosts, mockAnnouncer)
+
+	ctx := context.Background()
+	appeal, post := newPendingAppeal(t, "")
+	mockModerators.On("FindByUserID", ctx, testModeratorID).Return(newTestModerator(domainmoderation.RoleSenior), nil)
+	mockAppeals.On("FindByID", ctx, appeal.ID()).Return(appeal, nil)
+	mockPosts.On("FindByIDAnyStatus", ctx, post.ID()).Return(post, nil)
+	mockAppeals.On("SaveDecision", ctx, appeal, (*domaincontent.Post)(nil)).Return(nil)
+
+	result, err := uc.Execute(ctx, moderation.DecideAppealCommand{
+		ModeratorID: testModeratorID,
+		AppealID:    appeal.ID(),
+		Decision:    moderation.AppealDecisionReject,
+		Reason:      "内容确属广告",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "REJECTED", result.Status)
+	assert.False(t, post.IsVisible())
+	mockAnnouncer.AssertNotCalled(t, "Announce", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestDecideAppealUseCase_Execute_Denied tests decisions that are not allowed.
+func TestDecideAppealUseCase_Execute_Denied(t *testing.T) {
+	decidedAt := time.Now()
+	tests := []struct {
+		name      string
+		moderator *domainmoderation.Moderator
+		actedBy   string
+		decided   bool
+		wantErr   func(error) bool
+	}{
+		{name: "own action", moderator: newTestModerator(domainmoderation.RoleSenior), actedBy: testModeratorID, wantErr: apperrors.IsForbiddenError},
+		{name: "junior moderator", moderator: newTestModerator(domainmoderation.RoleJunior, "beijing"), actedBy: otherModeratorID, wantErr: apperrors.IsForbiddenError},
+		{name: "other city", moderator: newTestModerator(domainmoderation.RoleSenior, "shanghai"), actedBy: otherModeratorID, wantErr: apperrors.IsForbiddenError},
+		{name: "already decided", moderator: newTestModerator(domainmoderation.RoleSenior), actedBy: otherModeratorID, decided: true, wantErr: apperrors.IsConflictError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockModerators := new(MockModeratorRepository)
+			mockAppeals := new(MockAppealRepository)
+			mockPosts := new(MockPostModerationRepository)
+			mockAnnouncer := new(MockModerationAnnouncer)
+			uc := moderation.NewDecideAppealUseCase(mockModerators, mockAppeals, mockPosts, mockAnnouncer)
+
+			ctx := context.Background()
+			appeal, post := newPendingAppeal(t, tt.actedBy)
+			if tt.decided {
+				appeal = domainmoderation.NewAppealFromDB(appeal.ID(), post.ID(), domaincontent.ModerationStatusHidden, tt.actedBy, "这是误判",
+					domainmoderation.AppealStatusRejected, testAuthorID, "内容确属广告", appeal.FiledAt(), &decidedAt)
+			}
+			mockModerators.On("FindByUserID", ctx, testModeratorID).Return(tt.moderator, nil)
+			mockAppeals.On("FindByID", ctx, appeal.ID()).Return(appeal, nil)
+			mockPosts.On("FindByIDAnyStatus", ctx, post.ID()).Return(post, nil)
+
+			result, err := uc.Execute(ctx, moderation.DecideAppealCommand{
+				ModeratorID: testModeratorID,
+				AppealID:    appeal.ID(),
+				Decision:    moderation.AppealDecisionAccept,
+				Reason:      "经核实内容属实",
+			})
+
+			require.Error(t, err)
+			assert.Nil(t, result)
+			assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+			mockAnnouncer.AssertNotCalled(t, "Announce", mock.Anything, mock.Anything, mock.Anything)
+			mockAppeals.AssertNotCalled(t, "SaveDecision", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+// TestDecideAppealUseCase_Execute_InvalidInput tests commands rejected before any lookup.
+func TestDecideAppealUseCase_Execute_InvalidInput(t *testing.T) {
+	tests := []struct {
+		name string
+		cmd  moderation.DecideAppealCommand
+	}{
+		{name: "invalid decision", cmd: moderation.DecideAppealCommand{ModeratorID: testModeratorID, AppealID: uuid.New().String(), Decision: "WITHDRAW", Reason: "x"}},
+		{name: "invalid appeal ID", cmd: moderation.DecideAppealCommand{ModeratorID: testModeratorID, AppealID: "x", Decision: moderation.AppealDecisionReject, Reason: "x"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockModerators := new(MockModeratorRepository)
+			uc := moderation.NewDecideAppealUseCase(mockModerators, new(MockAppealRepository),
+				new(MockPostModerationRepository), new(MockModerationAnnouncer))
+
+			_, err := uc.Execute(context.Background(), tt.cmd)
+
+			assert.True(t, apperrors.IsValidationError(err), "unexpected error: %v", err)
+			mockModerators.AssertNotCalled(t, "FindByUserID", mock.Anything, mock.Anything)
+		})
+	}
+}
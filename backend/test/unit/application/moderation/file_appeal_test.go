@@ -0,0 +1,263 @@
+package moderation_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"fuck_boss/backend/internal/application/moderation"
+	domaincontent "fuck_boss/backend/internal/domain/content"
+	domainmoderation "fuck_boss/backend/internal/domain/moderation"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// MockAppealRepository is a mock implementation of AppealRepository.
+type MockAppealRepository struct {
+	mock.Mock
+}
+
+func (m *MockAppealRepository) Save(ctx context.Context, appeal *domainmoderation.Appeal) error {
+	args := m.Called(ctx, appeal)
+	return args.Error(0)
+}
+
+func (m *MockAppealRepository) SaveDecision(ctx context.Context, appeal *domainmoderation.Appeal, restored *domaincontent.Post) error {
+	args := m.Called(ctx, appeal, restored)
+	return args.Error(0)
+}
+
+func (m *MockAppealRepository) FindByID(ctx context.Context, id string) (*domainmoderation.Appeal, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainmoderation.Appeal), args.Error(1)
+}
+
+func (m *MockAppealRepository) FindLatestByPostID(ctx context.Context, postID domaincontent.PostID) (*domainmoderation.Appeal, error) {
+	args := m.Called(ctx, postID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domainmoderation.Appeal), args.Error(1)
+}
+
+func (m *MockAppealRepository) FindPending(ctx context.Context, excludeActedBy string, cityCodes []string, page, pageSize int) ([]*domainmoderation.Appeal, int, error) {
+	args := m.Called(ctx, excludeActedBy, cityCodes, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*domainmoderation.Appeal), args.Int(1), args.Error(2)
+}
+
+// newModeratedPost creates a post in Beijing with a management token, moderated
+// by the test moderator an hour ago. It returns the post and the raw token.
+func newModeratedPost(t *testing.T, status domaincontent.ModerationStatus) (*domaincontent.Post, string) {
+	t.Helper()
+
+	token, err := domaincontent.GenerateManagementToken()
+	require.NoError(t, err)
+
+	state := domaincontent.Moderation{Status: status}
+	if status != domaincontent.ModerationStatusVisible {
+		reason, err := domaincontent.NewModerationReason("待核实")
+		require.NoError(t, err)
+		state.Reason = reason
+		state.ModeratedAt = time.Now().Add(-time.Hour)
+		state.ModeratedBy = testModeratorID
+	}
+
+	post := newTestPost(t, "beijing", "",
+		domaincontent.WithManagementTokenHash(token.Hash()),
+		domaincontent.WithModeration(state),
+	)
+	return post, token.String()
+}
+
+// TestFileAppealUseCase_Execute_Success tests filing an appeal against a hidden post.
+func TestFileAppealUseCase_Execute_Success(t *testing.T) {
+	mockPosts := new(MockPostModerationRepository)
+	mockAppeals := new(MockAppealRepository)
+	uc := moderation.NewFileAppealUseCase(mockPosts, mockAppeals)
+
+	ctx := context.Background()
+	post, token := newModeratedPost(t, domaincontent.ModerationStatusHidden)
+	mockPosts.On("FindByIDAnyStatus", ctx, post.ID()).Return(post, nil)
+	mockAppeals.On("FindLatestByPostID", ctx, post.ID()).Return(nil, apperrors.NewNotFoundError("appeal"))
+	mockAppeals.On("Save", ctx, mock.MatchedBy(func(appeal *domainmoderation.Appeal) bool {
+		return appeal.PostID() == post.ID() && appeal.ActedBy() == testModeratorID
+	})).Return(nil)
+
+	result, err := uc.Execute(ctx, moderation.FileAppealCommand{
+		PostID:          post.ID().String(),
+		ManagementToken: token,
+		Statement:       "  这是真实经历，没有泄露个人隐私  ",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "PENDING", result.Status)
+	assert.Equal(t, "HIDDEN", result.AppealedStatus)
+	assert.Equal(t, "这是真实经历，没有泄露个人隐私", result.Statement)
+	assert.Nil(t, result.DecidedAt)
+	mockAppeals.AssertExpectations(t)
+}
+
+// TestFileAppealUseCase_Execute_Rejected tests appeals that cannot be filed.
+func TestFileAppealUseCase_Execute_Rejected(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    domaincontent.ModerationStatus
+		wrongKey  bool
+		latest    func(post *domaincontent.Post) *domainmoderation.Appeal
+		statement string
+		wantErr   func(error) bool
+	}{
+		{name: "wrong management token", status: domaincontent.ModerationStatusHidden, wrongKey: true, statement: "误判", wantErr: apperrors.IsForbiddenError},
+		{name: "visible post", status: domaincontent.ModerationStatusVisible, statement: "误判", wantErr: apperrors.IsConflictError},
+		{
+			name:      "pending appeal",
+			status:    domaincontent.ModerationStatusRemoved,
+			statement: "误判",
+			latest: func(post *domaincontent.Post) *domainmoderation.Appeal {
+				return domainmoderation.NewAppealFromDB("a1", post.ID(), domaincontent.ModerationStatusRemoved, testModeratorID, "误判",
+					domainmoderation.AppealStatusPending, "", "", time.Now().Add(-time.Minute), nil)
+			},
+			wantErr: apperrors.IsConflictError,
+		},
+		{name: "statement too long", status: domaincontent.ModerationStatusHidden, statement: strings.Repeat("长", domainmoderation.MaxAppealStatementLength+1), wantErr: apperrors.IsValidationError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPosts := new(MockPostModerationRepository)
+			mockAppeals := new(MockAppealRepository)
+			uc := moderation.NewFileAppealUseCase(mockPosts, mockAppeals)
+
+			ctx := context.Background()
+			post, token := newModeratedPost(t, tt.status)
+			if tt.wrongKey {
+				token = "wrong-token"
+			}
+			mockPosts.On("FindByIDAnyStatus", ctx, post.ID()).Return(post, nil)
+			if tt.latest != nil {
+				mockAppeals.On("FindLatestByPostID", ctx, post.ID()).Return(tt.latest(post), nil)
+			} else {
+				mockAppeals.On("FindLatestByPostID", ctx, post.ID()).Return(nil, apperrors.NewNotFoundError("appeal"))
+			}
+
+			result, err := uc.Execute(ctx, moderation.FileAppealCommand{
+				PostID:          post.ID().String(),
+				ManagementToken: token,
+				Statement:       tt.statement,
+			})
+
+			require.Error(t, err)
+			assert.Nil(t, result)
+			assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+			mockAppeals.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+// TestFileAppealUseCase_Execute_InvalidInput tests commands with missing fields.
+func TestFileAppealUseCase_Execute_InvalidInput(t *testing.T) {
+	postID := domaincontent.GeneratePostID().String()
+	tests := []struct {
+		name string
+		cmd  moderation.FileAppealCommand
+	}{
+		{name: "no statement", cmd: moderation.FileAppealCommand{PostID: postID, ManagementToken: "token"}},
+		{name: "no token", cmd: moderation.FileAppealCommand{PostID: postID, Statement: "误判"}},
+		{name: "invalid post ID", cmd: moderation.FileAppealCommand{PostID: "x", ManagementToken: "token", Statement: "误判"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPosts := new(MockPostModerationRepository)
+			uc := moderation.NewFileAppealUseCase(mockPosts, new(MockAppealRepository))
+
+			_, err := uc.Execute(context.Background(), tt.cmd)
+
+			assert.True(t, apperrors.IsValidationError(err), "unexpected error: %v", err)
+			mockPosts.AssertNotCalled(t, "FindByIDAnyStatus", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+// TestGetAppealStatusUseCase_Execute tests the author's status lookup.
+func TestGetAppealStatusUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("decided appeal", func(t *testing.T) {
+		mockPosts := new(MockPostModerationRepository)
+		mockAppeals := new(MockAppealRepository)
+		uc := moderation.NewGetAppealStatusUseCase(mockPosts, mockAppeals)
+
+		post, token := newModeratedPost(t, domaincontent.ModerationStatusRemoved)
+		decidedAt := time.Now()
+		appeal := domainmoderation.NewAppealFromDB("a1", post.ID(), domaincontent.ModerationStatusRemoved, testModeratorID, "误判",
+			domainmoderation.AppealStatusRejected, testAuthorID, "内容确属广告", time.Now().Add(-time.Minute), &decidedAt)
+		mockPosts.On("FindByIDAnyStatus", ctx, post.ID()).Return(post, nil)
+		mockAppeals.On("FindLatestByPostID", ctx, post.ID()).Return(appeal, nil)
+
+		result, err := uc.Execute(ctx, moderation.GetAppealStatusQuery{PostID: post.ID().String(), ManagementToken: token})
+
+		require.NoError(t, err)
+		assert.Equal(t, "REMOVED", result.Status)
+		assert.Equal(t, "待核实", result.Reason)
+		require.NotNil(t, result.Appeal)
+		assert.Equal(t, "REJECTED", result.Appeal.Status)
+		assert.Equal(t, "内容确属广告", result.Appeal.DecisionReason)
+		assert.Equal(t, &decidedAt, result.Appeal.DecidedAt)
+	})
+
+	t.Run("never appealed", func(t *testing.T) {
+		mockPosts := new(MockPostModerationRepository)
+		mockAppeals := new(MockAppealRepository)
+		uc := moderation.NewGetAppealStatusUseCase(mockPosts, mockAppeals)
+
+		post, token := newModeratedPost(t, domaincontent.ModerationStatusHidden)
+		mockPosts.On("FindByIDAnyStatus", ctx, post.ID()).Return(post, nil)
+		mockAppeals.On("FindLatestByPostID", ctx, post.ID()).Return(nil, apperrors.NewNotFoundError("appeal"))
+
+		result, err := uc.Execute(ctx, moderation.GetAppealStatusQuery{PostID: post.ID().String(), ManagementToken: token})
+
+		require.NoError(t, err)
+		assert.Equal(t, "HIDDEN", result.Status)
+		assert.Nil(t, result.Appeal)
+	})
+
+	t.Run("wrong management token", func(t *testing.T) {
+		mockPosts := new(MockPostModerationRepository)
+		mockAppeals := new(MockAppealRepository)
+		uc := moderation.NewGetAppealStatusUseCase(mockPosts, mockAppeals)
+
+		post, _ := newModeratedPost(t, domaincontent.ModerationStatusHidden)
+		mockPosts.On("FindByIDAnyStatus", ctx, post.ID()).Return(post, nil)
+
+		_, err := uc.Execute(ctx, moderation.GetAppealStatusQuery{PostID: post.ID().String(), ManagementToken: "wrong-token"})
+
+		assert.True(t, apperrors.IsForbiddenError(err), "unexpected error: %v", err)
+		mockAppeals.AssertNotCalled(t, "FindLatestByPostID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		mockPosts := new(MockPostModerationRepository)
+		mockAppeals := new(MockAppealRepository)
+		uc := moderation.NewGetAppealStatusUseCase(mockPosts, mockAppeals)
+
+		post, token := newModeratedPost(t, domaincontent.ModerationStatusHidden)
+		mockPosts.On("FindByIDAnyStatus", ctx, post.ID()).Return(post, nil)
+		mockAppeals.On("FindLatestByPostID", ctx, post.ID()).Return(nil, errors.New("connection refused"))
+
+		_, err := uc.Execute(ctx, moderation.GetAppealStatusQuery{PostID: post.ID().String(), ManagementToken: token})
+
+		assert.True(t, apperrors.IsDatabaseError(err), "unexpected error: %v", err)
+	})
+}
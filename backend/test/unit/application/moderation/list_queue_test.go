@@ -0,0 +1,126 @@
+package moderation_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"fuck_boss/backend/internal/application/moderation"
+	domaincontent "fuck_boss/backend/internal/domain/content"
+	domainmoderation "fuck_boss/backend/internal/domain/moderation"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// MockModerationQueueRepository is a mock implementation of ModerationQueueRepository.
+type MockModerationQueueRepository struct {
+	mock.Mock
+}
+
+func (m *MockModerationQueueRepository) FindByModerationStatus(ctx context.Context, status domaincontent.ModerationStatus, cityCodes []string, page, pageSize int) ([]*domaincontent.Post, int, error) {
+	args := m.Called(ctx, status, cityCodes, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*domaincontent.Post), args.Int(1), args.Error(2)
+}
+
+// TestListModerationQueueUseCase_Execute_OwnCities tests that regional moderators only see their own cities.
+func TestListModerationQueueUseCase_Execute_OwnCities(t *testing.T) {
+	mockModerators := new(MockModeratorRepository)
+	mockQueue := new(MockModerationQueueRepository)
+	uc := moderation.NewListModerationQueueUseCase(mockModerators, mockQueue)
+
+	ctx := context.Background()
+	hiddenAt := time.Now().Add(-10 * time.Minute)
+	reason, err := domaincontent.NewModerationReason("待核实")
+	require.NoError(t, err)
+	post := newTestPost(t, "beijing", "", domaincontent.WithModeration(domaincontent.Moderation{
+		Status:      domaincontent.ModerationStatusHidden,
+		Reason:      reason,
+		ModeratedAt: hiddenAt,
+		ModeratedBy: testModeratorID,
+	}))
+	mockModerators.On("FindByUserID", ctx, testModeratorID).Return(newTestModerator(domainmoderation.RoleJunior, "beijing", "shanghai"), nil)
+	mockQueue.On("FindByModerationStatus", ctx, domaincontent.ModerationStatusHidden, []string{"beijing", "shanghai"}, 1, 20).
+		Return([]*domaincontent.Post{post}, 1, nil)
+
+	result, err := uc.Execute(ctx, moderation.ListModerationQueueQuery{ModeratorID: testModeratorID})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Total)
+	assert.Equal(t, 1, result.Page)
+	assert.Equal(t, 20, result.PageSize)
+	require.Len(t, result.Items, 1)
+	assert.Equal(t, post.ID().String(), result.Items[0].PostID)
+	assert.Equal(t, "HIDDEN", result.Items[0].Status)
+	assert.Equal(t, "待核实", result.Items[0].Reason)
+	assert.Equal(t, testModeratorID, result.Items[0].ModeratedBy)
+	mockQueue.AssertExpectations(t)
+}
+
+// TestListModerationQueueUseCase_Execute_CityFilter tests filtering the queue by city.
+func TestListModerationQueueUseCase_Execute_CityFilter(t *testing.T) {
+	mockModerators := new(MockModeratorRepository)
+	mockQueue := new(MockModerationQueueRepository)
+	uc := moderation.NewListModerationQueueUseCase(mockModerators, mockQueue)
+
+	ctx := context.Background()
+	mockModerators.On("FindByUserID", ctx, testModeratorID).Return(newTestModerator(domainmoderation.RoleSenior), nil)
+	mockQueue.On("FindByModerationStatus", ctx, domaincontent.ModerationStatusHidden, []string{"guangzhou"}, 2, 100).
+		Return([]*domaincontent.Post{}, 0, nil)
+
+	result, err := uc.Execute(ctx, moderation.ListModerationQueueQuery{
+		ModeratorID: testModeratorID,
+		CityCode:    " guangzhou ",
+		Page:        2,
+		PageSize:    500,
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, result.Items)
+	assert.Equal(t, 100, result.PageSize)
+	mockQueue.AssertExpectations(t)
+}
+
+// TestListModerationQueueUseCase_Execute_Errors tests rejected callers and repository errors.
+func TestListModerationQueueUseCase_Execute_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		cityCode string
+		findErr  error
+		queueErr error
+		wantErr  func(error) bool
+	}{
+		{name: "not a moderator", findErr: apperrors.NewNotFoundError("moderator"), wantErr: apperrors.IsForbiddenError},
+		{name: "other city", cityCode: "shanghai", wantErr: apperrors.IsForbiddenError},
+		{name: "database error", queueErr: errors.New("connection refused"), wantErr: apperrors.IsDatabaseError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockModerators := new(MockModeratorRepository)
+			mockQueue := new(MockModerationQueueRepository)
+			uc := moderation.NewListModerationQueueUseCase(mockModerators, mockQueue)
+
+			ctx := context.Background()
+			if tt.findErr != nil {
+				mockModerators.On("FindByUserID", ctx, testModeratorID).Return(nil, tt.findErr)
+			} else {
+				mockModerators.On("FindByUserID", ctx, testModeratorID).Return(newTestModerator(domainmoderation.RoleJunior, "beijing"), nil)
+			}
+			mockQueue.On("FindByModerationStatus", ctx, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Return(nil, 0, tt.queueErr)
+
+			result, err := uc.Execute(ctx, moderation.ListModerationQueueQuery{ModeratorID: testModeratorID, CityCode: tt.cityCode})
+
+			require.Error(t, err)
+			assert.Nil(t, result)
+			assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+		})
+	}
+}
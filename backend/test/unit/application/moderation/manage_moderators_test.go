@@ -0,0 +1,150 @@
+package moderation_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"fuck_boss/backend/internal/application/moderation"
+	domainidentity "fuck_boss/backend/internal/domain/identity"
+	domainmoderation "fuck_boss/backend/internal/domain/moderation"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// TestAssignModeratorUseCase_Execute_New tests making a user a moderator.
+func TestAssignModeratorUseCase_Execute_New(t *testing.T) {
+	mockModerators := new(MockModeratorRepository)
+	mockUsers := new(MockUserRepository)
+	uc := moderation.NewAssignModeratorUseCase(mockModerators, mockUsers)
+
+	ctx := context.Background()
+	user := newTestUser(t, testModeratorID, nil)
+	mockUsers.On("FindByEmail", ctx, user.Email()).Return(user, nil)
+	mockModerators.On("FindByUserID", ctx, testModeratorID).Return(nil, apperrors.NewNotFoundError("moderator"))
+	mockModerators.On("Save", ctx, mock.AnythingOfType("*moderation.Moderator")).Return(nil)
+
+	result, err := uc.Execute(ctx, moderation.AssignModeratorCommand{
+		Account:   "author@example.com",
+		Role:      "junior",
+		CityCodes: []string{"shanghai", "beijing"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, testModeratorID, result.UserID)
+	assert.Equal(t, "JUNIOR", result.Role)
+	assert.Equal(t, []string{"VIEW_QUEUE", "HIDE_POST"}, result.Permissions)
+	assert.Equal(t, []string{"beijing", "shanghai"}, result.CityCodes)
+	mockModerators.AssertExpectations(t)
+}
+
+// TestAssignModeratorUseCase_Execute_Promote tests changing the role and cities of a moderator.
+func TestAssignModeratorUseCase_Execute_Promote(t *testing.T) {
+	mockModerators := new(MockModeratorRepository)
+	mockUsers := new(MockUserRepository)
+	uc := moderation.NewAssignModeratorUseCase(mockModerators, mockUsers)
+
+	ctx := context.Background()
+	user := newTestUser(t, testModeratorID, nil)
+	existing := newTestModerator(domainmoderation.RoleJunior, "beijing")
+	mockUsers.On("FindByID", ctx, user.ID()).Return(user, nil)
+	mockModerators.On("FindByUserID", ctx, testModeratorID).Return(existing, nil)
+	mockModerators.On("Save", ctx, existing).Return(nil)
+
+	result, err := uc.Execute(ctx, moderation.AssignModeratorCommand{Account: testModeratorID, Role: "SENIOR"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "SENIOR", result.Role)
+	assert.Empty(t, result.CityCodes)
+	assert.True(t, result.UpdatedAt.After(result.AssignedAt))
+}
+
+// TestAssignModeratorUseCase_Execute_Errors tests invalid assignments.
+func TestAssignModeratorUseCase_Execute_Errors(t *testing.T) {
+	bannedAt := time.Now().Add(-time.Hour)
+	tests := []struct {
+		name      string
+		cmd       moderation.AssignModeratorCommand
+		user      *domainidentity.User
+		userErr   error
+		findErr   error
+		wantErr   func(error) bool
+		wantQuery bool
+	}{
+		{name: "invalid role", cmd: moderation.AssignModeratorCommand{Account: "author", Role: "ADMIN"}, wantErr: apperrors.IsValidationError},
+		{name: "unknown user", cmd: moderation.AssignModeratorCommand{Account: "author", Role: "JUNIOR"}, userErr: apperrors.NewNotFoundError("user"), wantErr: apperrors.IsNotFoundError},
+		{name: "banned user", cmd: moderation.AssignModeratorCommand{Account: "author", Role: "JUNIOR"}, user: newTestUser(t, testModeratorID, &bannedAt), wantErr: apperrors.IsConflictError},
+		{name: "invalid city", cmd: moderation.AssignModeratorCommand{Account: "author", Role: "JUNIOR", CityCodes: []string{" "}}, user: newTestUser(t, testModeratorID, nil), findErr: apperrors.NewNotFoundError("moderator"), wantErr: apperrors.IsValidationError},
+		{name: "database error", cmd: moderation.AssignModeratorCommand{Account: "author", Role: "JUNIOR"}, user: newTestUser(t, testModeratorID, nil), findErr: errors.New("connection refused"), wantErr: apperrors.IsDatabaseError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockModerators := new(MockModeratorRepository)
+			mockUsers := new(MockUserRepository)
+			uc := moderation.NewAssignModeratorUseCase(mockModerators, mockUsers)
+
+			ctx := context.Background()
+			if tt.user != nil {
+				mockUsers.On("FindByUsername", ctx, mock.Anything).Return(tt.user, nil)
+			} else {
+				mockUsers.On("FindByUsername", ctx, mock.Anything).Return(nil, tt.userErr)
+			}
+			mockModerators.On("FindByUserID", ctx, testModeratorID).Return(nil, tt.findErr)
+
+			result, err := uc.Execute(ctx, tt.cmd)
+
+			require.Error(t, err)
+			assert.Nil(t, result)
+			assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+			mockModerators.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+// TestRemoveModeratorUseCase_Execute tests taking the role away from a user.
+func TestRemoveModeratorUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	user := newTestUser(t, testModeratorID, nil)
+
+	mockModerators := new(MockModeratorRepository)
+	mockUsers := new(MockUserRepository)
+	uc := moderation.NewRemoveModeratorUseCase(mockModerators, mockUsers)
+	mockUsers.On("FindByUsername", ctx, user.Username()).Return(user, nil)
+	mockModerators.On("Delete", ctx, testModeratorID).Return(nil).Once()
+
+	userID, err := uc.Execute(ctx, moderation.RemoveModeratorCommand{Account: "author"})
+	require.NoError(t, err)
+	assert.Equal(t, testModeratorID, userID)
+
+	// Removing again reports the user is not a moderator
+	mockModerators.On("Delete", ctx, testModeratorID).Return(apperrors.NewNotFoundError("moderator"))
+	_, err = uc.Execute(ctx, moderation.RemoveModeratorCommand{Account: "author"})
+	assert.True(t, apperrors.IsNotFoundError(err))
+
+	_, err = uc.Execute(ctx, moderation.RemoveModeratorCommand{Account: " "})
+	assert.True(t, apperrors.IsValidationError(err))
+}
+
+// TestListModeratorsUseCase_Execute tests listing moderators.
+func TestListModeratorsUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	mockModerators := new(MockModeratorRepository)
+	uc := moderation.NewListModeratorsUseCase(mockModerators)
+	mockModerators.On("FindAll", ctx).Return([]*domainmoderation.Moderator{
+		newTestModerator(domainmoderation.RoleSenior),
+		newTestModerator(domainmoderation.RoleJunior, "beijing"),
+	}, nil)
+
+	result, err := uc.Execute(ctx)
+
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, "SENIOR", result[0].Role)
+	assert.Len(t, result[0].Permissions, len(domainmoderation.Permissions))
+	assert.Equal(t, []string{"beijing"}, result[1].CityCodes)
+}
@@ -0,0 +1,177 @@
+package moderation_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"fuck_boss/backend/internal/application/content"
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/application/moderation"
+	domaincontent "fuck_boss/backend/internal/domain/content"
+	domainmoderation "fuck_boss/backend/internal/domain/moderation"
+	"fuck_boss/backend/internal/domain/shared"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// MockPostModerationRepository is a mock implementation of PostModerationRepository.
+type MockPostModerationRepository struct {
+	mock.Mock
+}
+
+func (m *MockPostModerationRepository) FindByIDAnyStatus(ctx context.Context, id domaincontent.PostID) (*domaincontent.Post, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domaincontent.Post), args.Error(1)
+}
+
+func (m *MockPostModerationRepository) SaveModeration(ctx context.Context, post *domaincontent.Post) error {
+	args := m.Called(ctx, post)
+	return args.Error(0)
+}
+
+// MockPostModerator is a mock implementation of PostModerator.
+type MockPostModerator struct {
+	mock.Mock
+}
+
+func (m *MockPostModerator) Execute(ctx context.Context, cmd content.ModeratePostCommand) (*dto.PostModerationDTO, error) {
+	args := m.Called(ctx, cmd)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.PostModerationDTO), args.Error(1)
+}
+
+// newTestPost creates a post in the city as loaded from the database.
+func newTestPost(t *testing.T, cityCode, authorID string, opts ...domaincontent.PostOption) *domaincontent.Post {
+	t.Helper()
+
+	company, _ := domaincontent.NewCompanyName("测试公司")
+	city, _ := shared.NewCity(cityCode, cityCode)
+	postContent, _ := domaincontent.NewContent("这是一条测试内容，用于验证审核员权限。内容应该足够长以满足最小长度要求。")
+	post, err := domaincontent.NewPostFromDB(domaincontent.GeneratePostID(), company, city, postContent,
+		time.Now().Add(-time.Hour), append(opts, domaincontent.WithAuthorID(authorID))...)
+	require.NoError(t, err)
+	return post
+}
+
+// TestModeratePostUseCase_Execute_Allowed tests actions within the moderator's role and cities.
+func TestModeratePostUseCase_Execute_Allowed(t *testing.T) {
+	tests := []struct {
+		name      string
+		moderator *domainmoderation.Moderator
+		action    content.ModerationAction
+	}{
+		{name: "junior hides in own city", moderator: newTestModerator(domainmoderation.RoleJunior, "beijing"), action: "hide"},
+		{name: "senior removes anywhere", moderator: newTestModerator(domainmoderation.RoleSenior), action: content.ModerationActionRemove},
+		{name: "senior restores in own city", moderator: newTestModerator(domainmoderation.RoleSenior, "beijing", "shanghai"), action: content.ModerationActionRestore},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockModerators := new(MockModeratorRepository)
+			mockPosts := new(MockPostModerationRepository)
+			mockModerator := new(MockPostModerator)
+			uc := moderation.NewModeratePostUseCase(mockModerators, mockPosts, mockModerator)
+
+			ctx := context.Background()
+			post := newTestPost(t, "beijing", "")
+			mockModerators.On("FindByUserID", ctx, testModeratorID).Return(tt.moderator, nil)
+			mockPosts.On("FindByIDAnyStatus", ctx, post.ID()).Return(post, nil)
+			mockModerator.On("Execute", ctx, mock.MatchedBy(func(cmd content.ModeratePostCommand) bool {
+				return cmd.PostID == post.ID().String() && cmd.ModeratorID == testModeratorID && cmd.Reason == "待核实"
+			})).Return(&dto.PostModerationDTO{PostID: post.ID().String(), ModeratedBy: testModeratorID}, nil)
+
+			result, err := uc.Execute(ctx, moderation.ModeratePostCommand{
+				ModeratorID: testModeratorID,
+				PostID:      post.ID().String(),
+				Action:      tt.action,
+				Reason:      "待核实",
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, testModeratorID, result.ModeratedBy)
+			mockModerator.AssertExpectations(t)
+		})
+	}
+}
+
+// TestModeratePostUseCase_Execute_Denied tests actions outside the moderator's role or cities.
+func TestModeratePostUseCase_Execute_Denied(t *testing.T) {
+	tests := []struct {
+		name        string
+		moderator   *domainmoderation.Moderator
+		action      content.ModerationAction
+		loadsPost   bool
+		wantMessage string
+	}{
+		{name: "junior cannot remove", moderator: newTestModerator(domainmoderation.RoleJunior), action: content.ModerationActionRemove, wantMessage: "REMOVE_POST"},
+		{name: "junior cannot restore", moderator: newTestModerator(domainmoderation.RoleJunior), action: content.ModerationActionRestore, wantMessage: "RESTORE_POST"},
+		{name: "other city", moderator: newTestModerator(domainmoderation.RoleSenior, "shanghai"), action: content.ModerationActionHide, loadsPost: true, wantMessage: "beijing"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockModerators := new(MockModeratorRepository)
+			mockPosts := new(MockPostModerationRepository)
+			mockModerator := new(MockPostModerator)
+			uc := moderation.NewModeratePostUseCase(mockModerators, mockPosts, mockModerator)
+
+			ctx := context.Background()
+			post := newTestPost(t, "beijing", "")
+			mockModerators.On("FindByUserID", ctx, testModeratorID).Return(tt.moderator, nil)
+			mockPosts.On("FindByIDAnyStatus", ctx, post.ID()).Return(post, nil)
+
+			result, err := uc.Execute(ctx, moderation.ModeratePostCommand{
+				ModeratorID: testModeratorID,
+				PostID:      post.ID().String(),
+				Action:      tt.action,
+				Reason:      "垃圾广告",
+			})
+
+			require.Error(t, err)
+			assert.Nil(t, result)
+			assert.True(t, apperrors.IsForbiddenError(err))
+			assert.Contains(t, err.Error(), tt.wantMessage)
+			if !tt.loadsPost {
+				mockPosts.AssertNotCalled(t, "FindByIDAnyStatus", mock.Anything, mock.Anything)
+			}
+			mockModerator.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+// TestModeratePostUseCase_Execute_InvalidInput tests invalid commands and callers.
+func TestModeratePostUseCase_Execute_InvalidInput(t *testing.T) {
+	postID := domaincontent.GeneratePostID().String()
+	tests := []struct {
+		name    string
+		cmd     moderation.ModeratePostCommand
+		wantErr func(error) bool
+	}{
+		{name: "unknown action", cmd: moderation.ModeratePostCommand{ModeratorID: testModeratorID, PostID: postID, Action: "DELETE"}, wantErr: apperrors.IsValidationError},
+		{name: "invalid post ID", cmd: moderation.ModeratePostCommand{ModeratorID: testModeratorID, PostID: "x", Action: "HIDE"}, wantErr: apperrors.IsValidationError},
+		{name: "no user", cmd: moderation.ModeratePostCommand{PostID: postID, Action: "HIDE"}, wantErr: apperrors.IsUnauthenticatedError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockModerators := new(MockModeratorRepository)
+			uc := moderation.NewModeratePostUseCase(mockModerators, new(MockPostModerationRepository), new(MockPostModerator))
+
+			result, err := uc.Execute(context.Background(), tt.cmd)
+
+			require.Error(t, err)
+			assert.Nil(t, result)
+			assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+			mockModerators.AssertNotCalled(t, "FindByUserID", mock.Anything, mock.Anything)
+		})
+	}
+}
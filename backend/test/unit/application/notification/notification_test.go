@@ -0,0 +1,286 @@
+// Package notification_test provides unit tests for notification use cases.
+// These tests use mocked dependencies to isolate the use case logic.
+package notification_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"fuck_boss/backend/internal/application/notification"
+	domaincontent "fuck_boss/backend/internal/domain/content"
+	"fuck_boss/backend/internal/domain/identity"
+	domainnotification "fuck_boss/backend/internal/domain/notification"
+	"fuck_boss/backend/internal/domain/shared"
+	domainwatchlist "fuck_boss/backend/internal/domain/watchlist"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+const (
+	testUserID   = "550e8400-e29b-41d4-a716-446655440000"
+	otherUserID  = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+	authorUserID = "6ba7b811-9dad-11d1-80b4-00c04fd430c8"
+	testCompany  = "测试公司"
+)
+
+// MockNotificationRepository is a mock implementation of NotificationRepository.
+type MockNotificationRepository struct {
+	mock.Mock
+}
+
+func (m *MockNotificationRepository) SaveAll(ctx context.Context, notifications []*domainnotification.Notification) error {
+	args := m.Called(ctx, notifications)
+	return args.Error(0)
+}
+
+func (m *MockNotificationRepository) FindByRecipient(ctx context.Context, recipientID identity.UserID, filter domainnotification.Filter, after *domainnotification.Cursor, limit int) ([]*domainnotification.Notification, error) {
+	args := m.Called(ctx, recipientID, filter, after, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domainnotification.Notification), args.Error(1)
+}
+
+func (m *MockNotificationRepository) CountUnread(ctx context.Context, recipientID identity.UserID) (int, error) {
+	args := m.Called(ctx, recipientID)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockNotificationRepository) MarkRead(ctx context.Context, recipientID identity.UserID, id string, at time.Time) error {
+	args := m.Called(ctx, recipientID, id, at)
+	return args.Error(0)
+}
+
+func (m *MockNotificationRepository) MarkAllRead(ctx context.Context, recipientID identity.UserID, at time.Time) (int, error) {
+	args := m.Called(ctx, recipientID, at)
+	return args.Int(0), args.Error(1)
+}
+
+// MockWatchRepository is a mock implementation of WatchRepository.
+// Only FindUserWatchers is used by the Notifier.
+type MockWatchRepository struct {
+	mock.Mock
+}
+
+func (m *MockWatchRepository) Save(ctx context.Context, watch *domainwatchlist.CompanyWatch) error {
+	args := m.Called(ctx, watch)
+	return args.Error(0)
+}
+
+func (m *MockWatchRepository) Delete(ctx context.Context, owner domainwatchlist.Owner, company domaincontent.CompanyName) error {
+	args := m.Called(ctx, owner, company)
+	return args.Error(0)
+}
+
+func (m *MockWatchRepository) FindByOwner(ctx context.Context, owner domainwatchlist.Owner) ([]*domainwatchlist.CompanyWatch, error) {
+	args := m.Called(ctx, owner)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domainwatchlist.CompanyWatch), args.Error(1)
+}
+
+func (m *MockWatchRepository) FindUserWatchers(ctx context.Context, company domaincontent.CompanyName) ([]string, error) {
+	args := m.Called(ctx, company)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockWatchRepository) LastVisit(ctx context.Context, owner domainwatchlist.Owner) (time.Time, error) {
+	args := m.Called(ctx, owner)
+	return args.Get(0).(time.Time), args.Error(1)
+}
+
+func (m *MockWatchRepository) MarkVisited(ctx context.Context, owner domainwatchlist.Owner, at time.Time) error {
+	args := m.Called(ctx, owner, at)
+	return args.Error(0)
+}
+
+// newTestPost creates a valid post for tests, optionally owned by a user.
+func newTestPost(t *testing.T, authorID string) *domaincontent.Post {
+	t.Helper()
+
+	company, _ := domaincontent.NewCompanyName(testCompany)
+	city, _ := shared.NewCity("beijing", "北京")
+	postContent, _ := domaincontent.NewContent("这是一条测试内容，用于验证通知中心功能。内容应该足够长以满足最小长度要求。")
+	post, err := domaincontent.NewPost(company, city, postContent)
+	require.NoError(t, err)
+	if authorID != "" {
+		post.AssignAuthor(authorID)
+	}
+	return post
+}
+
+// TestNotifier_NotifyWatchedCompanyPost tests fan-out to watchers, skipping the author.
+func TestNotifier_NotifyWatchedCompanyPost(t *testing.T) {
+	repo := new(MockNotificationRepository)
+	watches := new(MockWatchRepository)
+	notifier := notification.NewNotifier(repo, watches)
+
+	ctx := context.Background()
+	post := newTestPost(t, authorUserID)
+
+	watches.On("FindUserWatchers", ctx, post.Company()).Return([]string{testUserID, authorUserID, otherUserID}, nil)
+	repo.On("SaveAll", ctx, mock.MatchedBy(func(ns []*domainnotification.Notification) bool {
+		if len(ns) != 2 {
+			return false
+		}
+		for _, n := range ns {
+			if n.RecipientID().String() == authorUserID || n.Type() != domainnotification.TypeWatchedCompanyPost || !n.PostID().Equals(post.ID()) {
+				return false
+			}
+		}
+		return true
+	})).Return(nil)
+
+	err := notifier.NotifyWatchedCompanyPost(ctx, post)
+
+	require.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+// TestNotifier_NotifyCompanyReply_Anonymous tests that anonymous posts produce no notification.
+func TestNotifier_NotifyCompanyReply_Anonymous(t *testing.T) {
+	repo := new(MockNotificationRepository)
+	notifier := notification.NewNotifier(repo, new(MockWatchRepository))
+
+	replyContent, _ := domaincontent.NewReplyContent("感谢您的反馈，我们会认真改进。")
+	reply := domaincontent.NewOfficialReplyFromDB("reply-id", testUserID, "example.com", replyContent, time.Now())
+
+	err := notifier.NotifyCompanyReply(context.Background(), newTestPost(t, ""), reply)
+
+	require.NoError(t, err)
+	repo.AssertNotCalled(t, "SaveAll", mock.Anything, mock.Anything)
+}
+
+// TestNotifier_NotifyCompanyReply tests notifying the author about an official reply.
+func TestNotifier_NotifyCompanyReply(t *testing.T) {
+	repo := new(MockNotificationRepository)
+	notifier := notification.NewNotifier(repo, new(MockWatchRepository))
+
+	ctx := context.Background()
+	post := newTestPost(t, authorUserID)
+	replyContent, _ := domaincontent.NewReplyContent("感谢您的反馈，我们会认真改进。")
+	reply := domaincontent.NewOfficialReplyFromDB("reply-id", testUserID, "example.com", replyContent, time.Now())
+
+	repo.On("SaveAll", ctx, mock.MatchedBy(func(ns []*domainnotification.Notification) bool {
+		return len(ns) == 1 &&
+			ns[0].RecipientID().String() == authorUserID &&
+			ns[0].Type() == domainnotification.TypeCompanyReply &&
+			ns[0].Excerpt() == replyContent.String()
+	})).Return(nil)
+
+	err := notifier.NotifyCompanyReply(ctx, post, reply)
+
+	require.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+// TestNotifier_NotifyCommentReply_Self tests that replying to one's own comment is not notified.
+func TestNotifier_NotifyCommentReply_Self(t *testing.T) {
+	repo := new(MockNotificationRepository)
+	notifier := notification.NewNotifier(repo, new(MockWatchRepository))
+
+	err := notifier.NotifyCommentReply(context.Background(), testUserID, testUserID, domaincontent.GeneratePostID(), "回复")
+
+	require.NoError(t, err)
+	repo.AssertNotCalled(t, "SaveAll", mock.Anything, mock.Anything)
+}
+
+// TestListNotificationsUseCase_Execute_NextCursor tests that a full page returns a next cursor.
+func TestListNotificationsUseCase_Execute_NextCursor(t *testing.T) {
+	repo := new(MockNotificationRepository)
+	uc := notification.NewListNotificationsUseCase(repo)
+
+	ctx := context.Background()
+	recipientID, _ := identity.NewUserID(testUserID)
+	notifications := make([]*domainnotification.Notification, 0, 3)
+	for i := 0; i < 3; i++ {
+		notifications = append(notifications, domainnotification.NewNotification(recipientID, domainnotification.TypeCompanyReply, domaincontent.GeneratePostID(), testCompany, "回复"))
+	}
+
+	repo.On("FindByRecipient", ctx, recipientID, domainnotification.Filter{}, (*domainnotification.Cursor)(nil), 3).Return(notifications, nil)
+	repo.On("CountUnread", ctx, recipientID).Return(5, nil)
+
+	result, err := uc.Execute(ctx, notification.ListNotificationsQuery{UserID: testUserID, PageSize: 2})
+
+	require.NoError(t, err)
+	assert.Len(t, result.Notifications, 2)
+	assert.Equal(t, 5, result.UnreadCount)
+	require.NotEmpty(t, result.NextCursor)
+
+	cursor, err := domainnotification.ParseCursor(result.NextCursor)
+	require.NoError(t, err)
+	assert.Equal(t, notifications[1].ID(), cursor.ID())
+}
+
+// TestListNotificationsUseCase_Execute_LastPage tests that a partial page has no next cursor.
+func TestListNotificationsUseCase_Execute_LastPage(t *testing.T) {
+	repo := new(MockNotificationRepository)
+	uc := notification.NewListNotificationsUseCase(repo)
+
+	ctx := context.Background()
+	recipientID, _ := identity.NewUserID(testUserID)
+	kind := domainnotification.TypeCompanyReply
+
+	repo.On("FindByRecipient", ctx, recipientID, domainnotification.Filter{UnreadOnly: true, Type: &kind}, (*domainnotification.Cursor)(nil), 21).
+		Return([]*domainnotification.Notification{}, nil)
+	repo.On("CountUnread", ctx, recipientID).Return(0, nil)
+
+	result, err := uc.Execute(ctx, notification.ListNotificationsQuery{UserID: testUserID, UnreadOnly: true, Type: "company_reply"})
+
+	require.NoError(t, err)
+	assert.Empty(t, result.Notifications)
+	assert.Empty(t, result.NextCursor)
+}
+
+// TestListNotificationsUseCase_Execute_ValidationError tests invalid input.
+func TestListNotificationsUseCase_Execute_ValidationError(t *testing.T) {
+	uc := notification.NewListNotificationsUseCase(new(MockNotificationRepository))
+
+	tests := []struct {
+		name  string
+		query notification.ListNotificationsQuery
+		code  apperrors.ErrorCode
+	}{
+		{name: "anonymous", query: notification.ListNotificationsQuery{}, code: apperrors.ErrCodeUnauthenticated},
+		{name: "invalid type", query: notification.ListNotificationsQuery{UserID: testUserID, Type: "UNKNOWN"}, code: apperrors.ErrCodeValidation},
+		{name: "invalid cursor", query: notification.ListNotificationsQuery{UserID: testUserID, Cursor: "!!!"}, code: apperrors.ErrCodeValidation},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := uc.Execute(context.Background(), tt.query)
+
+			require.Error(t, err)
+			appErr, ok := err.(*apperrors.AppError)
+			require.True(t, ok)
+			assert.Equal(t, tt.code, appErr.Code)
+		})
+	}
+}
+
+// TestMarkReadUseCase_Execute tests marking a notification as read.
+func TestMarkReadUseCase_Execute(t *testing.T) {
+	repo := new(MockNotificationRepository)
+	uc := notification.NewMarkReadUseCase(repo)
+
+	ctx := context.Background()
+	recipientID, _ := identity.NewUserID(testUserID)
+
+	repo.On("MarkRead", ctx, recipientID, otherUserID, mock.AnythingOfType("time.Time")).Return(nil)
+
+	err := uc.Execute(ctx, notification.MarkReadCommand{UserID: testUserID, NotificationID: otherUserID})
+
+	require.NoError(t, err)
+	repo.AssertExpectations(t)
+
+	err = uc.Execute(ctx, notification.MarkReadCommand{UserID: testUserID, NotificationID: "not-a-uuid"})
+	require.Error(t, err)
+}
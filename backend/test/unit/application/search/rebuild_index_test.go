@@ -0,0 +1,57 @@
+package search_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"fuck_boss/backend/internal/application/search"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// MockIndex is a mock implementation of search.Index.
+type MockIndex struct {
+	mock.Mock
+}
+
+func (m *MockIndex) Rebuild(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+// TestRebuildIndexUseCase_Execute_Success tests rebuilding the index.
+func TestRebuildIndexUseCase_Execute_Success(t *testing.T) {
+	mockIndex := new(MockIndex)
+	mockCache := new(MockCacheRepository)
+	uc := search.NewRebuildIndexUseCase(mockIndex, mockCache)
+
+	ctx := context.Background()
+	mockIndex.On("Rebuild", ctx).Return(nil)
+	mockCache.On("DeleteByPattern", ctx, "search:*").Return(nil)
+	mockCache.On("DeleteByPattern", ctx, "feed:search:*").Return(nil)
+
+	require.NoError(t, uc.Execute(ctx))
+
+	mockIndex.AssertExpectations(t)
+	mockCache.AssertExpectations(t)
+}
+
+// TestRebuildIndexUseCase_Execute_Error tests a failed rebuild.
+func TestRebuildIndexUseCase_Execute_Error(t *testing.T) {
+	mockIndex := new(MockIndex)
+	mockCache := new(MockCacheRepository)
+	uc := search.NewRebuildIndexUseCase(mockIndex, mockCache)
+
+	ctx := context.Background()
+	mockIndex.On("Rebuild", ctx).Return(errors.New("deadlock detected"))
+
+	err := uc.Execute(ctx)
+
+	require.Error(t, err)
+	assert.True(t, apperrors.IsDatabaseError(err))
+	mockCache.AssertNotCalled(t, "DeleteByPattern", mock.Anything, mock.Anything)
+}
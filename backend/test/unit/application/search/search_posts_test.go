@@ -62,6 +62,38 @@ func (m *MockPostRepository) FindAll(ctx context.Context, page, pageSize int) ([
 	return args.Get(0).([]*domaincontent.Post), args.Int(1), args.Error(2)
 }
 
+func (m *MockPostRepository) FindByFilter(ctx context.Context, filter domaincontent.PostFilter, page, pageSize int) ([]*domaincontent.Post, int, error) {
+	args := m.Called(ctx, filter, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*domaincontent.Post), args.Int(1), args.Error(2)
+}
+
+func (m *MockPostRepository) FindSummariesByFilter(ctx context.Context, filter domaincontent.PostFilter, page, pageSize int) ([]*domaincontent.PostSummary, int, error) {
+	args := m.Called(ctx, filter, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*domaincontent.PostSummary), args.Int(1), args.Error(2)
+}
+
+func (m *MockPostRepository) FindByFilterAfter(ctx context.Context, filter domaincontent.PostFilter, after *domaincontent.PostCursor, limit int) ([]*domaincontent.Post, error) {
+	args := m.Called(ctx, filter, after, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domaincontent.Post), args.Error(1)
+}
+
+func (m *MockPostRepository) SearchSummaries(ctx context.Context, keyword string, city *shared.City, page, pageSize int) ([]*domaincontent.PostSummary, int, error) {
+	args := m.Called(ctx, keyword, city, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*domaincontent.PostSummary), args.Int(1), args.Error(2)
+}
+
 // MockCacheRepository is a mock implementation of CacheRepository.
 type MockCacheRepository struct {
 	mock.Mock
@@ -548,3 +580,70 @@ func TestSearchPostsUseCase_Execute_InvalidCityCode(t *testing.T) {
 	mockRepo.AssertExpectations(t)
 	mockCache.AssertExpectations(t)
 }
+
+// TestSearchPostsUseCase_Execute_BasicView tests the summary-only search view.
+func TestSearchPostsUseCase_Execute_BasicView(t *testing.T) {
+	// Setup mocks
+	mockRepo := new(MockPostRepository)
+	mockCache := new(MockCacheRepository)
+
+	// Create use case
+	uc := search.NewSearchPostsUseCase(mockRepo, mockCache)
+
+	ctx := context.Background()
+	query := search.SearchPostsQuery{
+		Keyword:  "测试",
+		Page:     1,
+		PageSize: 20,
+		View:     dto.PostViewBasic,
+	}
+
+	// Create test summary
+	company, _ := domaincontent.NewCompanyName("测试公司")
+	city, _ := shared.NewCity("beijing", "北京")
+	summary := domaincontent.NewPostSummaryFromDB(domaincontent.GeneratePostID(), company, city,
+		"这是一条测试内容，用于验证搜索功能。", false, time.Now(), "")
+
+	// Setup expectations
+	mockCache.On("Get", ctx, "search:测试:view:basic:page:1").Return("", errors.New("cache miss"))
+	mockRepo.On("SearchSummaries", ctx, "测试", (*shared.City)(nil), 1, 20).
+		Return([]*domaincontent.PostSummary{summary}, 1, nil)
+	mockCache.On("Set", ctx, "search:测试:view:basic:page:1", mock.AnythingOfType("string"), 5*time.Minute).Return(nil)
+
+	// Execute
+	result, err := uc.Execute(ctx, query)
+
+	// Assertions
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.Len(t, result.Posts, 1)
+	assert.Equal(t, "这是一条测试内容，用于验证搜索功能。", result.Posts[0].Summary)
+	assert.Empty(t, result.Posts[0].Content)
+
+	// Verify all expectations
+	mockRepo.AssertExpectations(t)
+	mockCache.AssertExpectations(t)
+}
+
+// TestSearchPostsUseCase_Execute_InvalidView tests an unknown search view.
+func TestSearchPostsUseCase_Execute_InvalidView(t *testing.T) {
+	// Setup mocks
+	mockRepo := new(MockPostRepository)
+	mockCache := new(MockCacheRepository)
+
+	// Create use case
+	uc := search.NewSearchPostsUseCase(mockRepo, mockCache)
+
+	// Execute
+	result, err := uc.Execute(context.Background(), search.SearchPostsQuery{
+		Keyword:  "测试",
+		Page:     1,
+		PageSize: 20,
+		View:     "basic",
+	})
+
+	// Assertions
+	require.Error(t, err)
+	assert.Nil(t, result)
+	assert.True(t, apperrors.IsValidationError(err))
+}
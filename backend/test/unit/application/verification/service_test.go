@@ -0,0 +1,345 @@
+package verification_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"fuck_boss/backend/internal/application/verification"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// memoryCache is an in-memory CacheRepository for tests.
+type memoryCache struct {
+	mu     sync.Mutex
+	values map[string]string
+}
+
+func newMemoryCache() *memoryCache {
+	return &memoryCache{values: make(map[string]string)}
+}
+
+func (c *memoryCache) Get(ctx context.Context, key string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	value, ok := c.values[key]
+	if !ok {
+		return "", errors.New("cache miss")
+	}
+	return value, nil
+}
+
+func (c *memoryCache) Set(ctx context.Context, key string, value string, ttl time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.values[key] = value
+	return nil
+}
+
+func (c *memoryCache) Delete(ctx context.Context, key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.values, key)
+	return nil
+}
+
+func (c *memoryCache) DeleteByPattern(ctx context.Context, pattern string) error {
+	return nil
+}
+
+// MockRateLimiter is a mock implementation of RateLimiter.
+type MockRateLimiter struct {
+	mock.Mock
+}
+
+func (m *MockRateLimiter) Allow(ctx context.Context, key string, limit int, window time.Duration) (bool, error) {
+	args := m.Called(ctx, key, limit, window)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRateLimiter) Reset(ctx context.Context, key string) error {
+	args := m.Called(ctx, key)
+	return args.Error(0)
+}
+
+// barrierCache holds the first n Gets until all of them have read, so
+// concurrent Verify calls all load the code before any of them writes it back.
+type barrierCache struct {
+	*memoryCache
+	mu      sync.Mutex
+	waiting int
+	release chan struct{}
+}
+
+func newBarrierCache(n int) *barrierCache {
+	return &barrierCache{memoryCache: newMemoryCache(), waiting: n, release: make(chan struct{})}
+}
+
+func (c *barrierCache) Get(ctx context.Context, key string) (string, error) {
+	value, err := c.memoryCache.Get(ctx, key)
+	c.mu.Lock()
+	if c.waiting > 0 {
+		c.waiting--
+		if c.waiting == 0 {
+			close(c.release)
+		}
+	}
+	c.mu.Unlock()
+	<-c.release
+	return value, err
+}
+
+// memoryRateLimiter counts requests per key atomically, like the Redis INCR limiter.
+type memoryRateLimiter struct {
+	mu     sync.Mutex
+	counts map[string]int
+}
+
+func newMemoryRateLimiter() *memoryRateLimiter {
+	return &memoryRateLimiter{counts: make(map[string]int)}
+}
+
+func (l *memoryRateLimiter) Allow(ctx context.Context, key string, limit int, window time.Duration) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.counts[key]++
+	return l.counts[key] <= limit, nil
+}
+
+func (l *memoryRateLimiter) Reset(ctx context.Context, key string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.counts, key)
+	return nil
+}
+
+// recordingSender records sent messages.
+type recordingSender struct {
+	sent []verification.Message
+	err  error
+}
+
+func (s *recordingSender) Send(ctx context.Context, msg verification.Message) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.sent = append(s.sent, msg)
+	return nil
+}
+
+func allowAll() *MockRateLimiter {
+	limiter := new(MockRateLimiter)
+	limiter.On("Allow", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
+	return limiter
+}
+
+func sendCommand() verification.SendCommand {
+	return verification.SendCommand{
+		Channel:   "email",
+		Recipient: "Alice@Example.com",
+		Purpose:   "register",
+		ClientIP:  "127.0.0.1",
+	}
+}
+
+func verifyCommand(code string) verification.VerifyCommand {
+	return verification.VerifyCommand{
+		Channel:   "EMAIL",
+		Recipient: "alice@example.com",
+		Purpose:   "REGISTER",
+		Code:      code,
+	}
+}
+
+// TestService_Send_DeliversCode tests that a code is generated, stored and delivered.
+func TestService_Send_DeliversCode(t *testing.T) {
+	sender := &recordingSender{}
+	service := verification.NewService(newMemoryCache(), allowAll(), sender, 10*time.Minute, 5)
+
+	result, err := service.Send(context.Background(), sendCommand())
+
+	require.NoError(t, err)
+	assert.Equal(t, "EMAIL", result.Channel)
+	assert.Equal(t, 60, result.ResendAfterSeconds)
+	assert.WithinDuration(t, time.Now().Add(10*time.Minute), result.ExpiresAt, time.Second)
+
+	require.Len(t, sender.sent, 1)
+	assert.Equal(t, "alice@example.com", sender.sent[0].Recipient)
+	assert.Equal(t, "REGISTER", sender.sent[0].Purpose)
+	assert.Len(t, sender.sent[0].Code, 6)
+}
+
+// TestService_Send_Throttled tests that a second code within the resend interval is rejected.
+func TestService_Send_Throttled(t *testing.T) {
+	limiter := new(MockRateLimiter)
+	limiter.On("Allow", mock.Anything, mock.MatchedBy(func(key string) bool {
+		return strings.HasPrefix(key, "rate_limit:verification_send:")
+	}), 20, time.Hour).Return(true, nil)
+	limiter.On("Allow", mock.Anything, mock.Anything, 1, time.Minute).Return(false, nil)
+
+	sender := &recordingSender{}
+	service := verification.NewService(newMemoryCache(), limiter, sender, 10*time.Minute, 5)
+
+	_, err := service.Send(context.Background(), sendCommand())
+
+	require.Error(t, err)
+	assert.True(t, apperrors.IsRateLimitError(err))
+	assert.Empty(t, sender.sent)
+}
+
+// TestService_Send_UnsupportedChannel tests that a sender without SMS support yields a validation error.
+func TestService_Send_UnsupportedChannel(t *testing.T) {
+	cacheRepo := newMemoryCache()
+	sender := &recordingSender{err: verification.ErrUnsupportedChannel}
+	service := verification.NewService(cacheRepo, allowAll(), sender, 10*time.Minute, 5)
+
+	_, err := service.Send(context.Background(), verification.SendCommand{
+		Channel:   "SMS",
+		Recipient: "13800138000",
+		Purpose:   "REGISTER",
+		ClientIP:  "127.0.0.1",
+	})
+
+	require.Error(t, err)
+	assert.True(t, apperrors.IsValidationError(err))
+	assert.Empty(t, cacheRepo.values, "undelivered code must not stay usable")
+}
+
+// TestService_Send_InvalidRecipient tests recipient validation.
+func TestService_Send_InvalidRecipient(t *testing.T) {
+	service := verification.NewService(newMemoryCache(), allowAll(), &recordingSender{}, 10*time.Minute, 5)
+
+	cmd := sendCommand()
+	cmd.Recipient = "not-an-email"
+	_, err := service.Send(context.Background(), cmd)
+
+	require.Error(t, err)
+	assert.True(t, apperrors.IsValidationError(err))
+}
+
+// TestService_Verify_ConsumesCode tests that a correct code is accepted once.
+func TestService_Verify_ConsumesCode(t *testing.T) {
+	sender := &recordingSender{}
+	service := verification.NewService(newMemoryCache(), allowAll(), sender, 10*time.Minute, 5)
+	ctx := context.Background()
+
+	_, err := service.Send(ctx, sendCommand())
+	require.NoError(t, err)
+	code := sender.sent[0].Code
+
+	require.NoError(t, service.Verify(ctx, verifyCommand(code)))
+
+	err = service.Verify(ctx, verifyCommand(code))
+	require.Error(t, err)
+	assert.True(t, apperrors.IsValidationError(err))
+}
+
+// TestService_Verify_WrongRecipient tests that a code is bound to its recipient.
+func TestService_Verify_WrongRecipient(t *testing.T) {
+	sender := &recordingSender{}
+	service := verification.NewService(newMemoryCache(), allowAll(), sender, 10*time.Minute, 5)
+	ctx := context.Background()
+
+	_, err := service.Send(ctx, sendCommand())
+	require.NoError(t, err)
+
+	cmd := verifyCommand(sender.sent[0].Code)
+	cmd.Recipient = "bob@example.com"
+	err = service.Verify(ctx, cmd)
+
+	require.Error(t, err)
+	assert.True(t, apperrors.IsValidationError(err))
+}
+
+// TestService_Verify_AttemptLimit tests that the code is discarded after too many wrong attempts.
+func TestService_Verify_AttemptLimit(t *testing.T) {
+	sender := &recordingSender{}
+	service := verification.NewService(newMemoryCache(), allowAll(), sender, 10*time.Minute, 3)
+	ctx := context.Background()
+
+	_, err := service.Send(ctx, sendCommand())
+	require.NoError(t, err)
+	code := sender.sent[0].Code
+
+	wrong := "000000"
+	if code == wrong {
+		wrong = "111111"
+	}
+
+	for i := 2; i >= 0; i-- {
+		err := service.Verify(ctx, verifyCommand(wrong))
+		require.Error(t, err)
+		var appErr *apperrors.AppError
+		require.True(t, apperrors.As(err, &appErr))
+		assert.Equal(t, i, appErr.Details["remainingAttempts"])
+	}
+
+	// The correct code no longer works
+	err = service.Verify(ctx, verifyCommand(code))
+	require.Error(t, err)
+	assert.True(t, apperrors.IsValidationError(err))
+}
+
+// TestService_Verify_ConcurrentAttempts tests that parallel wrong guesses
+// cannot exceed the attempt limit.
+func TestService_Verify_ConcurrentAttempts(t *testing.T) {
+	sender := &recordingSender{}
+	service := verification.NewService(newBarrierCache(20), newMemoryRateLimiter(), sender, 10*time.Minute, 3)
+	ctx := context.Background()
+
+	_, err := service.Send(ctx, sendCommand())
+	require.NoError(t, err)
+	code := sender.sent[0].Code
+
+	wrong := "000000"
+	if code == wrong {
+		wrong = "111111"
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		compared int
+		rejected int
+	)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := service.Verify(ctx, verifyCommand(wrong))
+			mu.Lock()
+			defer mu.Unlock()
+			// Only guesses compared with the code report the remaining attempts
+			var appErr *apperrors.AppError
+			if apperrors.As(err, &appErr) && appErr.Details["remainingAttempts"] != nil {
+				compared++
+			} else if err != nil {
+				rejected++
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 3, compared, "only maxAttempts guesses may be compared")
+	assert.Equal(t, 17, rejected)
+
+	// Later attempts are rejected, even with the correct code
+	require.Error(t, service.Verify(ctx, verifyCommand(code)))
+}
+
+// TestService_Verify_MissingCode tests validation of the submitted code.
+func TestService_Verify_MissingCode(t *testing.T) {
+	service := verification.NewService(newMemoryCache(), allowAll(), &recordingSender{}, 10*time.Minute, 5)
+
+	err := service.Verify(context.Background(), verifyCommand(""))
+
+	require.Error(t, err)
+	assert.True(t, apperrors.IsValidationError(err))
+}
@@ -0,0 +1,322 @@
+// Package watchlist_test provides unit tests for bookmark and watchlist use cases.
+// These tests use mocked dependencies to isolate the use case logic.
+package watchlist_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"fuck_boss/backend/internal/application/watchlist"
+	domaincontent "fuck_boss/backend/internal/domain/content"
+	"fuck_boss/backend/internal/domain/shared"
+	domainwatchlist "fuck_boss/backend/internal/domain/watchlist"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+const testUserID = "550e8400-e29b-41d4-a716-446655440000"
+
+// MockDeviceRepository is a mock implementation of DeviceRepository.
+type MockDeviceRepository struct {
+	mock.Mock
+}
+
+func (m *MockDeviceRepository) Register(ctx context.Context, tokenHash string, issuedAt time.Time) error {
+	args := m.Called(ctx, tokenHash, issuedAt)
+	return args.Error(0)
+}
+
+func (m *MockDeviceRepository) Exists(ctx context.Context, tokenHash string) (bool, error) {
+	args := m.Called(ctx, tokenHash)
+	return args.Bool(0), args.Error(1)
+}
+
+// MockBookmarkRepository is a mock implementation of BookmarkRepository.
+type MockBookmarkRepository struct {
+	mock.Mock
+}
+
+func (m *MockBookmarkRepository) Save(ctx context.Context, bookmark *domainwatchlist.Bookmark) error {
+	args := m.Called(ctx, bookmark)
+	return args.Error(0)
+}
+
+func (m *MockBookmarkRepository) Delete(ctx context.Context, owner domainwatchlist.Owner, postID domaincontent.PostID) error {
+	args := m.Called(ctx, owner, postID)
+	return args.Error(0)
+}
+
+func (m *MockBookmarkRepository) FindByOwner(ctx context.Context, owner domainwatchlist.Owner, page, pageSize int) ([]*domainwatchlist.Bookmark, int, error) {
+	args := m.Called(ctx, owner, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*domainwatchlist.Bookmark), args.Int(1), args.Error(2)
+}
+
+// MockWatchRepository is a mock implementation of WatchRepository.
+type MockWatchRepository struct {
+	mock.Mock
+}
+
+func (m *MockWatchRepository) Save(ctx context.Context, watch *domainwatchlist.CompanyWatch) error {
+	args := m.Called(ctx, watch)
+	return args.Error(0)
+}
+
+func (m *MockWatchRepository) Delete(ctx context.Context, owner domainwatchlist.Owner, company domaincontent.CompanyName) error {
+	args := m.Called(ctx, owner, company)
+	return args.Error(0)
+}
+
+func (m *MockWatchRepository) FindByOwner(ctx context.Context, owner domainwatchlist.Owner) ([]*domainwatchlist.CompanyWatch, error) {
+	args := m.Called(ctx, owner)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domainwatchlist.CompanyWatch), args.Error(1)
+}
+
+func (m *MockWatchRepository) FindUserWatchers(ctx context.Context, company domaincontent.CompanyName) ([]string, error) {
+	args := m.Called(ctx, company)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockWatchRepository) LastVisit(ctx context.Context, owner domainwatchlist.Owner) (time.Time, error) {
+	args := m.Called(ctx, owner)
+	return args.Get(0).(time.Time), args.Error(1)
+}
+
+func (m *MockWatchRepository) MarkVisited(ctx context.Context, owner domainwatchlist.Owner, at time.Time) error {
+	args := m.Called(ctx, owner, at)
+	return args.Error(0)
+}
+
+// MockPostRepository is a mock implementation of PostRepository.
+type MockPostRepository struct {
+	mock.Mock
+}
+
+func (m *MockPostRepository) Save(ctx context.Context, post *domaincontent.Post) error {
+	args := m.Called(ctx, post)
+	return args.Error(0)
+}
+
+func (m *MockPostRepository) FindByID(ctx context.Context, id domaincontent.PostID) (*domaincontent.Post, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domaincontent.Post), args.Error(1)
+}
+
+func (m *MockPostRepository) FindByCity(ctx context.Context, city shared.City, page, pageSize int) ([]*domaincontent.Post, int, error) {
+	args := m.Called(ctx, city, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*domaincontent.Post), args.Int(1), args.Error(2)
+}
+
+func (m *MockPostRepository) Search(ctx context.Context, keyword string, city *shared.City, page, pageSize int) ([]*domaincontent.Post, int, error) {
+	args := m.Called(ctx, keyword, city, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*domaincontent.Post), args.Int(1), args.Error(2)
+}
+
+func (m *MockPostRepository) FindAll(ctx context.Context, page, pageSize int) ([]*domaincontent.Post, int, error) {
+	args := m.Called(ctx, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*domaincontent.Post), args.Int(1), args.Error(2)
+}
+
+func (m *MockPostRepository) FindByFilter(ctx context.Context, filter domaincontent.PostFilter, page, pageSize int) ([]*domaincontent.Post, int, error) {
+	args := m.Called(ctx, filter, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*domaincontent.Post), args.Int(1), args.Error(2)
+}
+
+func (m *MockPostRepository) FindSummariesByFilter(ctx context.Context, filter domaincontent.PostFilter, page, pageSize int) ([]*domaincontent.PostSummary, int, error) {
+	args := m.Called(ctx, filter, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*domaincontent.PostSummary), args.Int(1), args.Error(2)
+}
+
+func (m *MockPostRepository) FindByFilterAfter(ctx context.Context, filter domaincontent.PostFilter, after *domaincontent.PostCursor, limit int) ([]*domaincontent.Post, error) {
+	args := m.Called(ctx, filter, after, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domaincontent.Post), args.Error(1)
+}
+
+func (m *MockPostRepository) SearchSummaries(ctx context.Context, keyword string, city *shared.City, page, pageSize int) ([]*domaincontent.PostSummary, int, error) {
+	args := m.Called(ctx, keyword, city, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*domaincontent.PostSummary), args.Int(1), args.Error(2)
+}
+
+// newTestPost creates a valid post for tests.
+func newTestPost(t *testing.T, companyName string) *domaincontent.Post {
+	t.Helper()
+
+	company, _ := domaincontent.NewCompanyName(companyName)
+	city, _ := shared.NewCity("beijing", "北京")
+	postContent, _ := domaincontent.NewContent("这是一条测试内容，用于验证收藏和关注功能。内容应该足够长以满足最小长度要求。")
+	post, err := domaincontent.NewPost(company, city, postContent)
+	require.NoError(t, err)
+	return post
+}
+
+// TestAddBookmarkUseCase_Execute_User tests bookmarking a post as a logged-in user.
+func TestAddBookmarkUseCase_Execute_User(t *testing.T) {
+	bookmarks := new(MockBookmarkRepository)
+	devices := new(MockDeviceRepository)
+	posts := new(MockPostRepository)
+	uc := watchlist.NewAddBookmarkUseCase(bookmarks, devices, posts)
+
+	ctx := context.Background()
+	post := newTestPost(t, "测试公司")
+	owner, _ := domainwatchlist.NewUserOwner(testUserID)
+
+	posts.On("FindByID", ctx, post.ID()).Return(post, nil)
+	bookmarks.On("FindByOwner", ctx, owner, 1, 1).Return([]*domainwatchlist.Bookmark{}, 3, nil)
+	bookmarks.On("Save", ctx, mock.MatchedBy(func(b *domainwatchlist.Bookmark) bool {
+		return b.Owner().Equals(owner) && b.PostID().Equals(post.ID())
+	})).Return(nil)
+
+	err := uc.Execute(ctx, watchlist.BookmarkCommand{UserID: testUserID, PostID: post.ID().String()})
+
+	require.NoError(t, err)
+	bookmarks.AssertExpectations(t)
+	// Users never need a device token
+	devices.AssertNotCalled(t, "Exists", mock.Anything, mock.Anything)
+}
+
+// TestAddBookmarkUseCase_Execute_Device tests bookmarking a post with a device token.
+func TestAddBookmarkUseCase_Execute_Device(t *testing.T) {
+	bookmarks := new(MockBookmarkRepository)
+	devices := new(MockDeviceRepository)
+	posts := new(MockPostRepository)
+	uc := watchlist.NewAddBookmarkUseCase(bookmarks, devices, posts)
+
+	ctx := context.Background()
+	post := newTestPost(t, "测试公司")
+	token, _ := domainwatchlist.GenerateDeviceToken()
+	owner, _ := domainwatchlist.NewDeviceOwner(token.Hash())
+
+	devices.On("Exists", ctx, token.Hash()).Return(true, nil)
+	posts.On("FindByID", ctx, post.ID()).Return(post, nil)
+	bookmarks.On("FindByOwner", ctx, owner, 1, 1).Return([]*domainwatchlist.Bookmark{}, 0, nil)
+	bookmarks.On("Save", ctx, mock.Anything).Return(nil)
+
+	err := uc.Execute(ctx, watchlist.BookmarkCommand{DeviceToken: token.String(), PostID: post.ID().String()})
+
+	require.NoError(t, err)
+	bookmarks.AssertExpectations(t)
+	devices.AssertExpectations(t)
+}
+
+// TestAddBookmarkUseCase_Execute_NoOwner tests that an owner is required.
+func TestAddBookmarkUseCase_Execute_NoOwner(t *testing.T) {
+	bookmarks := new(MockBookmarkRepository)
+	uc := watchlist.NewAddBookmarkUseCase(bookmarks, new(MockDeviceRepository), new(MockPostRepository))
+
+	err := uc.Execute(context.Background(), watchlist.BookmarkCommand{PostID: domaincontent.GeneratePostID().String()})
+
+	assert.True(t, apperrors.IsUnauthenticatedError(err))
+	bookmarks.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+}
+
+// TestAddBookmarkUseCase_Execute_UnknownDevice tests that self-made device tokens are rejected.
+func TestAddBookmarkUseCase_Execute_UnknownDevice(t *testing.T) {
+	bookmarks := new(MockBookmarkRepository)
+	devices := new(MockDeviceRepository)
+	uc := watchlist.NewAddBookmarkUseCase(bookmarks, devices, new(MockPostRepository))
+
+	devices.On("Exists", mock.Anything, domainwatchlist.HashDeviceToken("made-up")).Return(false, nil)
+
+	err := uc.Execute(context.Background(), watchlist.BookmarkCommand{
+		DeviceToken: "made-up",
+		PostID:      domaincontent.GeneratePostID().String(),
+	})
+
+	assert.True(t, apperrors.IsUnauthenticatedError(err))
+	bookmarks.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+}
+
+// TestAddBookmarkUseCase_Execute_LimitReached tests the per-owner bookmark limit.
+func TestAddBookmarkUseCase_Execute_LimitReached(t *testing.T) {
+	bookmarks := new(MockBookmarkRepository)
+	posts := new(MockPostRepository)
+	uc := watchlist.NewAddBookmarkUseCase(bookmarks, new(MockDeviceRepository), posts)
+
+	post := newTestPost(t, "测试公司")
+	posts.On("FindByID", mock.Anything, post.ID()).Return(post, nil)
+	bookmarks.On("FindByOwner", mock.Anything, mock.Anything, 1, 1).Return([]*domainwatchlist.Bookmark{}, domainwatchlist.MaxBookmarks, nil)
+
+	err := uc.Execute(context.Background(), watchlist.BookmarkCommand{UserID: testUserID, PostID: post.ID().String()})
+
+	assert.True(t, apperrors.IsValidationError(err))
+	bookmarks.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+}
+
+// TestAddBookmarkUseCase_Execute_PostNotFound tests bookmarking a missing post.
+func TestAddBookmarkUseCase_Execute_PostNotFound(t *testing.T) {
+	bookmarks := new(MockBookmarkRepository)
+	posts := new(MockPostRepository)
+	uc := watchlist.NewAddBookmarkUseCase(bookmarks, new(MockDeviceRepository), posts)
+
+	posts.On("FindByID", mock.Anything, mock.Anything).Return(nil, apperrors.NewNotFoundError("post not found"))
+
+	err := uc.Execute(context.Background(), watchlist.BookmarkCommand{
+		UserID: testUserID,
+		PostID: domaincontent.GeneratePostID().String(),
+	})
+
+	assert.True(t, apperrors.IsNotFoundError(err))
+	bookmarks.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+}
+
+// TestListBookmarksUseCase_Execute_SkipsDeletedPosts tests that missing posts are skipped.
+func TestListBookmarksUseCase_Execute_SkipsDeletedPosts(t *testing.T) {
+	bookmarks := new(MockBookmarkRepository)
+	posts := new(MockPostRepository)
+	uc := watchlist.NewListBookmarksUseCase(bookmarks, new(MockDeviceRepository), posts)
+
+	ctx := context.Background()
+	owner, _ := domainwatchlist.NewUserOwner(testUserID)
+	post := newTestPost(t, "测试公司")
+	missingID := domaincontent.GeneratePostID()
+
+	bookmarks.On("FindByOwner", ctx, owner, 1, 20).Return([]*domainwatchlist.Bookmark{
+		domainwatchlist.NewBookmark(owner, post.ID()),
+		domainwatchlist.NewBookmark(owner, missingID),
+	}, 2, nil)
+	posts.On("FindByID", ctx, post.ID()).Return(post, nil)
+	posts.On("FindByID", ctx, missingID).Return(nil, apperrors.NewNotFoundError("post not found"))
+
+	result, err := uc.Execute(ctx, watchlist.ListBookmarksQuery{UserID: testUserID})
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Total)
+	require.Len(t, result.Bookmarks, 1)
+	assert.Equal(t, post.ID().String(), result.Bookmarks[0].Post.ID)
+}
@@ -0,0 +1,173 @@
+package watchlist_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"fuck_boss/backend/internal/application/watchlist"
+	domaincontent "fuck_boss/backend/internal/domain/content"
+	domainwatchlist "fuck_boss/backend/internal/domain/watchlist"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// newTestWatches creates n watches with distinct company names for the owner.
+func newTestWatches(owner domainwatchlist.Owner, names ...string) []*domainwatchlist.CompanyWatch {
+	watches := make([]*domainwatchlist.CompanyWatch, 0, len(names))
+	for _, name := range names {
+		company, _ := domaincontent.NewCompanyName(name)
+		watches = append(watches, domainwatchlist.NewCompanyWatch(owner, company))
+	}
+	return watches
+}
+
+// TestWatchCompanyUseCase_Execute_Success tests watching a company.
+func TestWatchCompanyUseCase_Execute_Success(t *testing.T) {
+	watches := new(MockWatchRepository)
+	uc := watchlist.NewWatchCompanyUseCase(watches, new(MockDeviceRepository))
+
+	ctx := context.Background()
+	owner, _ := domainwatchlist.NewUserOwner(testUserID)
+
+	watches.On("FindByOwner", ctx, owner).Return(newTestWatches(owner, "其他公司"), nil)
+	watches.On("Save", ctx, mock.MatchedBy(func(w *domainwatchlist.CompanyWatch) bool {
+		return w.Company().String() == "测试公司" && w.Owner().Equals(owner)
+	})).Return(nil)
+
+	err := uc.Execute(ctx, watchlist.WatchCommand{UserID: testUserID, Company: "测试公司"})
+
+	require.NoError(t, err)
+	watches.AssertExpectations(t)
+}
+
+// TestWatchCompanyUseCase_Execute_AlreadyWatched tests that watching twice is a no-op.
+func TestWatchCompanyUseCase_Execute_AlreadyWatched(t *testing.T) {
+	watches := new(MockWatchRepository)
+	uc := watchlist.NewWatchCompanyUseCase(watches, new(MockDeviceRepository))
+
+	owner, _ := domainwatchlist.NewUserOwner(testUserID)
+	watches.On("FindByOwner", mock.Anything, owner).Return(newTestWatches(owner, "测试公司"), nil)
+
+	err := uc.Execute(context.Background(), watchlist.WatchCommand{UserID: testUserID, Company: "测试公司"})
+
+	require.NoError(t, err)
+	watches.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+}
+
+// TestWatchCompanyUseCase_Execute_LimitReached tests the per-owner watchlist limit.
+func TestWatchCompanyUseCase_Execute_LimitReached(t *testing.T) {
+	watches := new(MockWatchRepository)
+	uc := watchlist.NewWatchCompanyUseCase(watches, new(MockDeviceRepository))
+
+	owner, _ := domainwatchlist.NewUserOwner(testUserID)
+	names := make([]string, domainwatchlist.MaxWatchedCompanies)
+	for i := range names {
+		names[i] = "公司" + string(rune('A'+i))
+	}
+	watches.On("FindByOwner", mock.Anything, owner).Return(newTestWatches(owner, names...), nil)
+
+	err := uc.Execute(context.Background(), watchlist.WatchCommand{UserID: testUserID, Company: "测试公司"})
+
+	assert.True(t, apperrors.IsValidationError(err))
+	watches.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+}
+
+// TestWatchCompanyUseCase_Execute_EmptyCompany tests that a company name is required.
+func TestWatchCompanyUseCase_Execute_EmptyCompany(t *testing.T) {
+	watches := new(MockWatchRepository)
+	uc := watchlist.NewWatchCompanyUseCase(watches, new(MockDeviceRepository))
+
+	err := uc.Execute(context.Background(), watchlist.WatchCommand{UserID: testUserID})
+
+	assert.True(t, apperrors.IsValidationError(err))
+	watches.AssertNotCalled(t, "FindByOwner", mock.Anything, mock.Anything)
+}
+
+// TestListWatchedPostsUseCase_Execute_SinceLastVisit tests the feed after a previous visit.
+func TestListWatchedPostsUseCase_Execute_SinceLastVisit(t *testing.T) {
+	watches := new(MockWatchRepository)
+	posts := new(MockPostRepository)
+	uc := watchlist.NewListWatchedPostsUseCase(watches, new(MockDeviceRepository), posts)
+
+	ctx := context.Background()
+	owner, _ := domainwatchlist.NewUserOwner(testUserID)
+	lastVisit := time.Now().Add(-2 * time.Hour).Truncate(time.Second)
+	post := newTestPost(t, "测试公司")
+
+	watches.On("LastVisit", ctx, owner).Return(lastVisit, nil)
+	watches.On("FindByOwner", ctx, owner).Return(newTestWatches(owner, "测试公司", "其他公司"), nil)
+	posts.On("FindByFilter", ctx, mock.MatchedBy(func(filter domaincontent.PostFilter) bool {
+		return len(filter.Companies) == 2 &&
+			filter.CreatedAfter != nil && filter.CreatedAfter.Equal(lastVisit)
+	}), 1, 20).Return([]*domaincontent.Post{post}, 1, nil)
+	watches.On("MarkVisited", ctx, owner, mock.AnythingOfType("time.Time")).Return(nil)
+
+	result, err := uc.Execute(ctx, watchlist.ListWatchedPostsQuery{UserID: testUserID, MarkVisited: true})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Total)
+	assert.True(t, result.Since.Equal(lastVisit))
+	require.Len(t, result.Posts, 1)
+	assert.Equal(t, post.ID().String(), result.Posts[0].ID)
+	watches.AssertExpectations(t)
+}
+
+// TestListWatchedPostsUseCase_Execute_ExplicitSince tests that later pages reuse the first page's cutoff.
+func TestListWatchedPostsUseCase_Execute_ExplicitSince(t *testing.T) {
+	watches := new(MockWatchRepository)
+	posts := new(MockPostRepository)
+	uc := watchlist.NewListWatchedPostsUseCase(watches, new(MockDeviceRepository), posts)
+
+	owner, _ := domainwatchlist.NewUserOwner(testUserID)
+	since := time.Now().Add(-time.Hour)
+
+	watches.On("FindByOwner", mock.Anything, owner).Return(newTestWatches(owner, "测试公司"), nil)
+	posts.On("FindByFilter", mock.Anything, mock.MatchedBy(func(filter domaincontent.PostFilter) bool {
+		return filter.CreatedAfter != nil && filter.CreatedAfter.Equal(since)
+	}), 2, 20).Return([]*domaincontent.Post{}, 21, nil)
+
+	result, err := uc.Execute(context.Background(), watchlist.ListWatchedPostsQuery{
+		UserID: testUserID,
+		Since:  &since,
+		Page:   2,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 21, result.Total)
+	watches.AssertNotCalled(t, "LastVisit", mock.Anything, mock.Anything)
+	watches.AssertNotCalled(t, "MarkVisited", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestListWatchedPostsUseCase_Execute_EmptyWatchlist tests that no posts are queried without watches.
+func TestListWatchedPostsUseCase_Execute_EmptyWatchlist(t *testing.T) {
+	watches := new(MockWatchRepository)
+	posts := new(MockPostRepository)
+	uc := watchlist.NewListWatchedPostsUseCase(watches, new(MockDeviceRepository), posts)
+
+	owner, _ := domainwatchlist.NewUserOwner(testUserID)
+	watches.On("LastVisit", mock.Anything, owner).Return(time.Time{}, nil)
+	watches.On("FindByOwner", mock.Anything, owner).Return([]*domainwatchlist.CompanyWatch{}, nil)
+
+	result, err := uc.Execute(context.Background(), watchlist.ListWatchedPostsQuery{UserID: testUserID})
+
+	require.NoError(t, err)
+	assert.Empty(t, result.Posts)
+	// First visit reaches back 7 days
+	assert.WithinDuration(t, time.Now().Add(-7*24*time.Hour), result.Since, time.Minute)
+	posts.AssertNotCalled(t, "FindByFilter", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestListWatchedPostsUseCase_Execute_FutureSince tests that a cutoff in the future is rejected.
+func TestListWatchedPostsUseCase_Execute_FutureSince(t *testing.T) {
+	uc := watchlist.NewListWatchedPostsUseCase(new(MockWatchRepository), new(MockDeviceRepository), new(MockPostRepository))
+
+	since := time.Now().Add(time.Hour)
+	result, err := uc.Execute(context.Background(), watchlist.ListWatchedPostsQuery{UserID: testUserID, Since: &since})
+
+	assert.Nil(t, result)
+	assert.True(t, apperrors.IsValidationError(err))
+}
@@ -0,0 +1,190 @@
+package admin_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"fuck_boss/backend/internal/domain/admin"
+)
+
+func TestNewScope(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    admin.Scope
+		wantErr bool
+	}{
+		{input: "moderate", want: admin.ScopeModerate},
+		{input: " Export ", want: admin.ScopeExport},
+		{input: "ADMIN", want: admin.ScopeAdmin},
+		{input: "", wantErr: true},
+		{input: "root", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := admin.NewScope(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("NewScope(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("NewScope(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestIssueAPIKey(t *testing.T) {
+	key, raw, err := admin.IssueAPIKey(" nightly export ", []admin.Scope{admin.ScopeAdmin, admin.ScopeExport, admin.ScopeAdmin}, nil)
+	if err != nil {
+		t.Fatalf("IssueAPIKey() error = %v", err)
+	}
+
+	if !strings.HasPrefix(raw, admin.APIKeyPrefix) {
+		t.Errorf("raw key %q does not start with %q", raw, admin.APIKeyPrefix)
+	}
+	if !strings.HasPrefix(raw, key.Prefix()) || len(key.Prefix()) >= len(raw) {
+		t.Errorf("Prefix() = %q, want a strict prefix of the raw key", key.Prefix())
+	}
+	if key.KeyHash() != admin.HashAPIKey(raw) {
+		t.Error("KeyHash() does not match HashAPIKey(raw)")
+	}
+	if strings.Contains(key.KeyHash(), raw) {
+		t.Error("KeyHash() contains the raw key")
+	}
+	if key.Name() != "nightly export" {
+		t.Errorf("Name() = %q, want %q", key.Name(), "nightly export")
+	}
+	if got := key.Scopes(); len(got) != 2 || got[0] != admin.ScopeExport || got[1] != admin.ScopeAdmin {
+		t.Errorf("Scopes() = %v, want [export admin]", got)
+	}
+	if key.IsRevoked() || key.LastUsedAt() != nil || key.ExpiresAt() != nil {
+		t.Error("new key should be active, unused and without expiry")
+	}
+
+	_, other, err := admin.IssueAPIKey("other", []admin.Scope{admin.ScopeExport}, nil)
+	if err != nil {
+		t.Fatalf("IssueAPIKey() error = %v", err)
+	}
+	if other == raw {
+		t.Error("two issued keys are equal")
+	}
+}
+
+func TestIssueAPIKey_Invalid(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	tests := []struct {
+		name      string
+		keyName   string
+		scopes    []admin.Scope
+		expiresAt *time.Time
+	}{
+		{name: "empty name", keyName: "  ", scopes: []admin.Scope{admin.ScopeAdmin}},
+		{name: "long name", keyName: strings.Repeat("键", admin.MaxAPIKeyNameLength+1), scopes: []admin.Scope{admin.ScopeAdmin}},
+		{name: "no scope", keyName: "ops"},
+		{name: "unknown scope only", keyName: "ops", scopes: []admin.Scope{"root"}},
+		{name: "expired", keyName: "ops", scopes: []admin.Scope{admin.ScopeAdmin}, expiresAt: &past},
+	}
+
+	for _, tt := range tests {
+		if _, _, err := admin.IssueAPIKey(tt.keyName, tt.scopes, tt.expiresAt); err == nil {
+			t.Errorf("%s: IssueAPIKey() error = nil, want error", tt.name)
+		}
+	}
+}
+
+func TestAPIKey_Authorize(t *testing.T) {
+	now := time.Now()
+	expiresAt := now.Add(time.Hour)
+	key := admin.NewAPIKeyFromDB("id", "ops", "fbk_abcdefgh", "hash", []admin.Scope{admin.ScopeModerate}, &expiresAt, now, nil, nil)
+
+	if err := key.Authorize(admin.ScopeModerate, now); err != nil {
+		t.Errorf("Authorize(moderate) error = %v, want nil", err)
+	}
+	if err := key.Authorize(admin.ScopeAdmin, now); !errors.Is(err, admin.ErrScopeNotGranted) {
+		t.Errorf("Authorize(admin) error = %v, want ErrScopeNotGranted", err)
+	}
+	if err := key.Authorize(admin.ScopeModerate, expiresAt); !errors.Is(err, admin.ErrAPIKeyExpired) {
+		t.Errorf("Authorize() at expiry error = %v, want ErrAPIKeyExpired", err)
+	}
+
+	key.Revoke(now)
+	revokedAt := key.RevokedAt()
+	key.Revoke(now.Add(time.Minute))
+	if key.RevokedAt() != revokedAt {
+		t.Error("Revoke() on a revoked key changed the revocation time")
+	}
+	if err := key.Authorize(admin.ScopeModerate, now); !errors.Is(err, admin.ErrAPIKeyRevoked) {
+		t.Errorf("Authorize() after Revoke() error = %v, want ErrAPIKeyRevoked", err)
+	}
+}
+
+func TestAPIKey_Use(t *testing.T) {
+	now := time.Now()
+	key := admin.NewAPIKeyFromDB("id", "ops", "fbk_abcdefgh", "hash", []admin.Scope{admin.ScopeAdmin}, nil, now, nil, nil)
+
+	if !key.Use(now) {
+		t.Error("first Use() = false, want true")
+	}
+	if key.Use(now.Add(admin.LastUsedResolution - time.Second)) {
+		t.Error("Use() within LastUsedResolution = true, want false")
+	}
+	if !key.LastUsedAt().Equal(now) {
+		t.Errorf("LastUsedAt() = %v, want %v", key.LastUsedAt(), now)
+	}
+	if !key.Use(now.Add(admin.LastUsedResolution)) {
+		t.Error("Use() after LastUsedResolution = false, want true")
+	}
+}
+
+func TestHashAPIKey_TrimsSpace(t *testing.T) {
+	if admin.HashAPIKey(" fbk_abc\n") != admin.HashAPIKey("fbk_abc") {
+		t.Error("HashAPIKey() depends on surrounding whitespace")
+	}
+	if admin.HashAPIKey("fbk_abc") == admin.HashAPIKey("fbk_abd") {
+		t.Error("HashAPIKey() is equal for different keys")
+	}
+}
+
+func TestNewAuditEvent(t *testing.T) {
+	event, err := admin.NewAuditEvent("", admin.CLIActor, "admin hide-post", "post-1", "OK", "", `{"reason":"spam"}`)
+	if err != nil {
+		t.Fatalf("NewAuditEvent() error = %v", err)
+	}
+	if event.ID() == "" || event.OccurredAt().IsZero() {
+		t.Error("NewAuditEvent() should set the ID and time")
+	}
+	if event.Actor() != admin.CLIActor || event.Target() != "post-1" || event.Outcome() != "OK" {
+		t.Errorf("NewAuditEvent() = %+v, fields not kept", event)
+	}
+
+	for _, fields := range [][3]string{{"", "action", "OK"}, {"actor", " ", "OK"}, {"actor", "action", ""}} {
+		if _, err := admin.NewAuditEvent("", fields[0], fields[1], "", fields[2], "", ""); err == nil {
+			t.Errorf("NewAuditEvent(actor=%q, action=%q, outcome=%q) error = nil, want error", fields[0], fields[1], fields[2])
+		}
+	}
+}
+
+func TestAuditCursor_RoundTrip(t *testing.T) {
+	occurredAt := time.Date(2026, 5, 1, 12, 30, 0, 123456789, time.UTC)
+	event := admin.NewAuditEventFromDB("550e8400-e29b-41d4-a716-446655440000", occurredAt, "", "cli", "admin flush-cache", "", "OK", "", "")
+
+	cursor, err := admin.ParseAuditCursor(admin.AuditCursorAfter(event).String())
+	if err != nil {
+		t.Fatalf("ParseAuditCursor() error = %v", err)
+	}
+	if !cursor.OccurredAt().Equal(occurredAt) {
+		t.Errorf("OccurredAt() = %v, want %v", cursor.OccurredAt(), occurredAt)
+	}
+	if cursor.ID() != event.ID() {
+		t.Errorf("ID() = %q, want %q", cursor.ID(), event.ID())
+	}
+}
+
+func TestParseAuditCursor_Invalid(t *testing.T) {
+	for _, value := range []string{"", "not base64!", "bm9jb2xvbg", "MTIzOm5vdC1hLXV1aWQ"} {
+		if _, err := admin.ParseAuditCursor(value); err == nil {
+			t.Errorf("ParseAuditCursor(%q) error = nil, want error", value)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package attachment_test
+
+import (
+	"strings"
+	"testing"
+
+	"fuck_boss/backend/internal/domain/attachment"
+	"fuck_boss/backend/internal/domain/content"
+)
+
+var testChecksum = strings.Repeat("a", 64)
+
+func TestNewContentType(t *testing.T) {
+	tests := []struct {
+		input   string
+		wantExt string
+		wantErr bool
+	}{
+		{input: "image/jpeg", wantExt: ".jpg"},
+		{input: "image/png", wantExt: ".png"},
+		{input: "image/gif", wantExt: ".gif"},
+		{input: "image/webp", wantExt: ".webp"},
+		{input: "application/pdf", wantExt: ".pdf"},
+		{input: "text/html; charset=utf-8", wantErr: true},
+		{input: "text/xml; charset=utf-8", wantErr: true},
+		{input: "application/zip", wantErr: true},
+		{input: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := attachment.NewContentType(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("NewContentType(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if got.Extension() != tt.wantExt {
+			t.Errorf("NewContentType(%q).Extension() = %q, want %q", tt.input, got.Extension(), tt.wantExt)
+		}
+	}
+
+	if attachment.ContentTypePDF.IsImage() {
+		t.Error("ContentTypePDF.IsImage() = true, want false")
+	}
+}
+
+func TestNewAttachment(t *testing.T) {
+	postID := content.GeneratePostID()
+
+	a, err := attachment.NewAttachment(postID, attachment.ContentTypePNG, 1024, testChecksum)
+	if err != nil {
+		t.Fatalf("NewAttachment() error = %v, want nil", err)
+	}
+	if a.ID() == "" {
+		t.Error("ID() is empty")
+	}
+	if got, want := a.StorageKey(), "posts/"+postID.String()+"/"+a.ID()+".png"; got != want {
+		t.Errorf("StorageKey() = %q, want %q", got, want)
+	}
+	if got, want := a.FileName(), a.ID()+".png"; got != want {
+		t.Errorf("FileName() = %q, want %q", got, want)
+	}
+}
+
+func TestNewAttachment_Invalid(t *testing.T) {
+	postID := content.GeneratePostID()
+
+	tests := []struct {
+		name     string
+		size     int64
+		checksum string
+	}{
+		{name: "empty", size: 0, checksum: testChecksum},
+		{name: "too large", size: attachment.MaxStoredSize + 1, checksum: testChecksum},
+		{name: "bad checksum", size: 10, checksum: "abc"},
+	}
+
+	for _, tt := range tests {
+		if _, err := attachment.NewAttachment(postID, attachment.ContentTypePNG, tt.size, tt.checksum); err == nil {
+			t.Errorf("%s: NewAttachment() error = nil, want error", tt.name)
+		}
+	}
+}
+
+func TestAttachment_AssignImageInfo(t *testing.T) {
+	postID := content.GeneratePostID()
+
+	a, _ := attachment.NewAttachment(postID, attachment.ContentTypeWebP, 1024, testChecksum)
+	if a.HasThumbnail() || a.ThumbnailKey() != "" {
+		t.Errorf("new attachment has a thumbnail: %q", a.ThumbnailKey())
+	}
+
+	if err := a.AssignImageInfo(0, 10, attachment.ContentTypePNG); err == nil {
+		t.Error("AssignImageInfo() with zero width error = nil, want error")
+	}
+	if err := a.AssignImageInfo(640, 480, attachment.ContentTypePNG); err != nil {
+		t.Fatalf("AssignImageInfo() error = %v, want nil", err)
+	}
+	if a.Width() != 640 || a.Height() != 480 {
+		t.Errorf("dimensions = %dx%d, want 640x480", a.Width(), a.Height())
+	}
+	if got, want := a.ThumbnailKey(), "posts/"+postID.String()+"/"+a.ID()+"_thumb.png"; got != want {
+		t.Errorf("ThumbnailKey() = %q, want %q", got, want)
+	}
+
+	pdf, _ := attachment.NewAttachment(postID, attachment.ContentTypePDF, 1024, testChecksum)
+	if err := pdf.AssignImageInfo(640, 480, attachment.ContentTypePNG); err == nil {
+		t.Error("AssignImageInfo() on a PDF error = nil, want error")
+	}
+}
@@ -0,0 +1,157 @@
+package company_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"fuck_boss/backend/internal/domain/company"
+	"fuck_boss/backend/internal/domain/content"
+)
+
+// newTestRepresentative registers a pending representative for tests.
+func newTestRepresentative(t *testing.T, method company.ChallengeMethod) (*company.Representative, company.AccessToken) {
+	t.Helper()
+
+	companyName, _ := content.NewCompanyName("Example Company")
+	domain, err := company.NewCompanyDomain("example.com")
+	if err != nil {
+		t.Fatalf("NewCompanyDomain() error = %v, want nil", err)
+	}
+
+	representative, token, err := company.NewRepresentative(companyName, domain, method)
+	if err != nil {
+		t.Fatalf("NewRepresentative() error = %v, want nil", err)
+	}
+	return representative, token
+}
+
+func TestNewCompanyDomain(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{name: "valid", value: "example.com", want: "example.com"},
+		{name: "upper case and trailing dot", value: " Jobs.Example.COM. ", want: "jobs.example.com"},
+		{name: "empty", value: "", wantErr: true},
+		{name: "single label", value: "localhost", wantErr: true},
+		{name: "ip address", value: "10.0.0.1", wantErr: true},
+		{name: "url", value: "https://example.com/path", wantErr: true},
+		{name: "too long", value: strings.Repeat("a", 250) + ".com", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := company.NewCompanyDomain(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewCompanyDomain(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if !tt.wantErr && got.String() != tt.want {
+				t.Errorf("NewCompanyDomain(%q) = %q, want %q", tt.value, got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestNewChallengeMethod(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    company.ChallengeMethod
+		wantErr bool
+	}{
+		{name: "dns txt", value: "DNS_TXT", want: company.ChallengeMethodDNSTXT},
+		{name: "file lower case", value: "file", want: company.ChallengeMethodFile},
+		{name: "empty", value: "", wantErr: true},
+		{name: "unknown", value: "EMAIL", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := company.NewChallengeMethod(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewChallengeMethod(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("NewChallengeMethod(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChallenge_Instructions(t *testing.T) {
+	domain, _ := company.NewCompanyDomain("example.com")
+	challenge := company.NewChallenge(company.ChallengeMethodDNSTXT, domain, "abc123")
+
+	if got, want := challenge.RecordName(), "_fuckboss-challenge.example.com"; got != want {
+		t.Errorf("RecordName() = %q, want %q", got, want)
+	}
+	if got, want := challenge.RecordValue(), "fuckboss-verification=abc123"; got != want {
+		t.Errorf("RecordValue() = %q, want %q", got, want)
+	}
+	if got, want := challenge.FileURL(), "https://example.com/.well-known/fuckboss-verification.txt"; got != want {
+		t.Errorf("FileURL() = %q, want %q", got, want)
+	}
+}
+
+func TestNewRepresentative(t *testing.T) {
+	representative, token := newTestRepresentative(t, company.ChallengeMethodDNSTXT)
+
+	if representative.ID().IsZero() {
+		t.Error("ID() is zero, want generated ID")
+	}
+	if representative.Status() != company.RepresentativeStatusPending {
+		t.Errorf("Status() = %q, want %q", representative.Status(), company.RepresentativeStatusPending)
+	}
+	if representative.IsVerified() {
+		t.Error("IsVerified() = true, want false")
+	}
+	if representative.Challenge().Code() == "" {
+		t.Error("Challenge().Code() is empty, want generated code")
+	}
+	if token.String() == "" {
+		t.Fatal("token is empty, want generated token")
+	}
+	if representative.AccessTokenHash() == token.String() {
+		t.Error("AccessTokenHash() equals raw token, want hash")
+	}
+	if representative.AccessTokenHash() != company.HashAccessToken(token.String()) {
+		t.Error("AccessTokenHash() does not match HashAccessToken(token)")
+	}
+}
+
+func TestRepresentative_Authenticate(t *testing.T) {
+	representative, token := newTestRepresentative(t, company.ChallengeMethodFile)
+
+	if err := representative.Authenticate(token.String()); err != nil {
+		t.Errorf("Authenticate(valid) error = %v, want nil", err)
+	}
+	if err := representative.Authenticate("wrong-token"); !errors.Is(err, company.ErrAccessTokenMismatch) {
+		t.Errorf("Authenticate(wrong) error = %v, want ErrAccessTokenMismatch", err)
+	}
+	if err := representative.Authenticate(""); !errors.Is(err, company.ErrAccessTokenMismatch) {
+		t.Errorf("Authenticate(empty) error = %v, want ErrAccessTokenMismatch", err)
+	}
+}
+
+func TestRepresentative_MarkVerified(t *testing.T) {
+	representative, _ := newTestRepresentative(t, company.ChallengeMethodDNSTXT)
+
+	if err := representative.EnsureCanSpeak(); !errors.Is(err, company.ErrNotVerified) {
+		t.Errorf("EnsureCanSpeak() before verification error = %v, want ErrNotVerified", err)
+	}
+
+	representative.MarkVerified()
+
+	if !representative.IsVerified() {
+		t.Error("IsVerified() = false, want true")
+	}
+	if representative.VerifiedAt() == nil {
+		t.Error("VerifiedAt() = nil, want timestamp")
+	}
+	if err := representative.EnsureCanSpeak(); err != nil {
+		t.Errorf("EnsureCanSpeak() after verification error = %v, want nil", err)
+	}
+}
@@ -0,0 +1,49 @@
+package content_test
+
+import (
+	"testing"
+	"time"
+
+	"fuck_boss/backend/internal/domain/content"
+)
+
+func TestPostEngagement_HotScore_Weights(t *testing.T) {
+	now := time.Now()
+	createdAt := now.Add(-2 * time.Hour)
+
+	views := content.NewPostEngagementFromDB(content.GeneratePostID(), "beijing", createdAt, 10, 0, 0)
+	followUps := content.NewPostEngagementFromDB(content.GeneratePostID(), "beijing", createdAt, 0, 2, 0)
+	reply := content.NewPostEngagementFromDB(content.GeneratePostID(), "beijing", createdAt, 0, 0, 1)
+
+	// 10 views, 2 follow-ups and 1 official reply all score 10 points
+	if views.HotScore(now) != followUps.HotScore(now) || views.HotScore(now) != reply.HotScore(now) {
+		t.Errorf("HotScore() = %v, %v, %v, want equal scores",
+			views.HotScore(now), followUps.HotScore(now), reply.HotScore(now))
+	}
+
+	// (2h + 2)^1.5 = 8
+	if got := views.HotScore(now); got < 1.2499 || got > 1.2501 {
+		t.Errorf("HotScore() = %v, want 1.25", got)
+	}
+}
+
+func TestPostEngagement_HotScore_Decay(t *testing.T) {
+	now := time.Now()
+
+	fresh := content.NewPostEngagementFromDB(content.GeneratePostID(), "beijing", now.Add(-time.Hour), 10, 0, 0)
+	old := content.NewPostEngagementFromDB(content.GeneratePostID(), "beijing", now.Add(-72*time.Hour), 100, 0, 0)
+
+	if fresh.HotScore(now) <= old.HotScore(now) {
+		t.Errorf("fresh HotScore() = %v, want more than old HotScore() = %v", fresh.HotScore(now), old.HotScore(now))
+	}
+}
+
+func TestPostEngagement_HotScore_NoEngagement(t *testing.T) {
+	now := time.Now()
+
+	e := content.NewPostEngagementFromDB(content.GeneratePostID(), "beijing", now.Add(time.Minute), 0, 0, 0)
+
+	if got := e.HotScore(now); got != 0 {
+		t.Errorf("HotScore() = %v, want 0", got)
+	}
+}
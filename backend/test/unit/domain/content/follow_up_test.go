@@ -0,0 +1,178 @@
+package content_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"fuck_boss/backend/internal/domain/content"
+	"fuck_boss/backend/internal/domain/shared"
+)
+
+// newTestPost creates a post for follow-up tests.
+func newTestPost(t *testing.T) *content.Post {
+	t.Helper()
+
+	company, _ := content.NewCompanyName("Example Company")
+	city, _ := shared.NewCity("beijing", "北京")
+	postContent, _ := content.NewContent(strings.Repeat("A", 50))
+
+	post, err := content.NewPost(company, city, postContent)
+	if err != nil {
+		t.Fatalf("NewPost() error = %v, want nil", err)
+	}
+	return post
+}
+
+func TestNewResolutionStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    content.ResolutionStatus
+		wantErr bool
+	}{
+		{name: "ongoing", value: "ONGOING", want: content.ResolutionStatusOngoing},
+		{name: "escalated lower case", value: "escalated", want: content.ResolutionStatusEscalated},
+		{name: "resolved with spaces", value: "  Resolved ", want: content.ResolutionStatusResolved},
+		{name: "empty", value: "", wantErr: true},
+		{name: "unknown", value: "CLOSED", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := content.NewResolutionStatus(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewResolutionStatus(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("NewResolutionStatus(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewFollowUpNote(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		wantErr bool
+	}{
+		{name: "valid", value: "公司已补发工资", wantErr: false},
+		{name: "trimmed too short", value: "  好 ", wantErr: true},
+		{name: "empty", value: "", wantErr: true},
+		{name: "max length", value: strings.Repeat("好", content.MaxFollowUpNoteLength), wantErr: false},
+		{name: "too long", value: strings.Repeat("好", content.MaxFollowUpNoteLength+1), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := content.NewFollowUpNote(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewFollowUpNote() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGenerateManagementToken(t *testing.T) {
+	token1, err := content.GenerateManagementToken()
+	if err != nil {
+		t.Fatalf("GenerateManagementToken() error = %v, want nil", err)
+	}
+	token2, _ := content.GenerateManagementToken()
+
+	if token1.String() == "" {
+		t.Error("GenerateManagementToken() returned empty token")
+	}
+	if token1.String() == token2.String() {
+		t.Error("GenerateManagementToken() returned the same token twice")
+	}
+	if token1.Hash() == token1.String() {
+		t.Error("ManagementToken.Hash() returned the raw token")
+	}
+	if token1.Hash() != content.HashManagementToken(token1.String()) {
+		t.Error("ManagementToken.Hash() does not match HashManagementToken()")
+	}
+}
+
+func TestPost_VerifyManagementToken(t *testing.T) {
+	post := newTestPost(t)
+
+	// A post without a token cannot be managed
+	if post.VerifyManagementToken("anything") {
+		t.Error("VerifyManagementToken() = true for a post without token")
+	}
+
+	token, _ := content.GenerateManagementToken()
+	post.AssignManagementToken(token)
+
+	if !post.VerifyManagementToken(token.String()) {
+		t.Error("VerifyManagementToken() = false for the correct token")
+	}
+	if post.VerifyManagementToken("wrong-token") {
+		t.Error("VerifyManagementToken() = true for a wrong token")
+	}
+	if post.ManagementTokenHash() != token.Hash() {
+		t.Error("ManagementTokenHash() does not match token hash")
+	}
+}
+
+func TestPost_AppendFollowUp(t *testing.T) {
+	post := newTestPost(t)
+	token, _ := content.GenerateManagementToken()
+	post.AssignManagementToken(token)
+
+	if !post.Resolution().IsZero() {
+		t.Errorf("Resolution() = %q, want empty", post.Resolution())
+	}
+
+	note1, _ := content.NewFollowUpNote("已经申请劳动仲裁")
+	followUp, err := post.AppendFollowUp(token.String(), content.ResolutionStatusEscalated, note1)
+	if err != nil {
+		t.Fatalf("AppendFollowUp() error = %v, want nil", err)
+	}
+	if followUp.ID() == "" {
+		t.Error("FollowUp.ID() is empty")
+	}
+	if followUp.IsPersisted() {
+		t.Error("new FollowUp.IsPersisted() = true, want false")
+	}
+
+	note2, _ := content.NewFollowUpNote("公司已补发工资")
+	if _, err := post.AppendFollowUp(token.String(), content.ResolutionStatusResolved, note2); err != nil {
+		t.Fatalf("AppendFollowUp() error = %v, want nil", err)
+	}
+
+	// Resolution follows the latest entry; the timeline keeps order
+	if post.Resolution() != content.ResolutionStatusResolved {
+		t.Errorf("Resolution() = %q, want %q", post.Resolution(), content.ResolutionStatusResolved)
+	}
+	followUps := post.FollowUps()
+	if len(followUps) != 2 {
+		t.Fatalf("len(FollowUps()) = %d, want 2", len(followUps))
+	}
+	if followUps[0].Status() != content.ResolutionStatusEscalated {
+		t.Errorf("FollowUps()[0].Status() = %q, want %q", followUps[0].Status(), content.ResolutionStatusEscalated)
+	}
+}
+
+func TestPost_AppendFollowUp_Errors(t *testing.T) {
+	post := newTestPost(t)
+	token, _ := content.GenerateManagementToken()
+	post.AssignManagementToken(token)
+	note, _ := content.NewFollowUpNote("已经申请劳动仲裁")
+
+	_, err := post.AppendFollowUp("wrong-token", content.ResolutionStatusOngoing, note)
+	if !errors.Is(err, content.ErrManagementTokenMismatch) {
+		t.Errorf("AppendFollowUp() with wrong token error = %v, want ErrManagementTokenMismatch", err)
+	}
+
+	_, err = post.AppendFollowUp(token.String(), content.ResolutionStatusNone, note)
+	if err == nil {
+		t.Error("AppendFollowUp() with empty status error = nil, want error")
+	}
+
+	if len(post.FollowUps()) != 0 {
+		t.Errorf("len(FollowUps()) = %d, want 0", len(post.FollowUps()))
+	}
+}
@@ -0,0 +1,95 @@
+package content_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"fuck_boss/backend/internal/domain/content"
+	"fuck_boss/backend/internal/domain/shared"
+)
+
+func TestNewExternalRef_Valid(t *testing.T) {
+	ref, err := content.NewExternalRef(" partner-a ", " row 42 ")
+	if err != nil {
+		t.Fatalf("NewExternalRef() error = %v, want nil", err)
+	}
+	if ref.Source() != "partner-a" || ref.ID() != "row 42" {
+		t.Errorf("NewExternalRef() = (%q, %q), want (partner-a, row 42)", ref.Source(), ref.ID())
+	}
+}
+
+func TestNewExternalRef_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		id     string
+	}{
+		{name: "empty source", source: "", id: "1"},
+		{name: "uppercase source", source: "Partner", id: "1"},
+		{name: "source with space", source: "partner a", id: "1"},
+		{name: "source starting with hyphen", source: "-partner", id: "1"},
+		{name: "source too long", source: strings.Repeat("a", 51), id: "1"},
+		{name: "empty id", source: "partner", id: "  "},
+		{name: "id too long", source: "partner", id: strings.Repeat("1", content.MaxExternalIDLength+1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := content.NewExternalRef(tt.source, tt.id); err == nil {
+				t.Errorf("NewExternalRef(%q, %q) error = nil, want error", tt.source, tt.id)
+			}
+		})
+	}
+}
+
+func TestNewImportedPost(t *testing.T) {
+	ref, _ := content.NewExternalRef("partner", "1")
+	company, _ := content.NewCompanyName("测试公司")
+	city, _ := shared.NewCity("beijing", "北京")
+	postContent, _ := content.NewContent("这是一条导入的历史投诉内容，长度满足要求。")
+	createdAt := time.Date(2020, 5, 1, 9, 0, 0, 0, time.UTC)
+
+	occurredAt := createdAt.AddDate(0, -1, 0)
+
+	imported, err := content.NewImportedPost(ref, company, city, postContent, createdAt, &occurredAt, content.ResolutionStatusResolved)
+	if err != nil {
+		t.Fatalf("NewImportedPost() error = %v, want nil", err)
+	}
+
+	post := imported.Post()
+	if imported.Ref() != ref {
+		t.Errorf("Ref() = %v, want %v", imported.Ref(), ref)
+	}
+	if !post.CreatedAt().Equal(createdAt) {
+		t.Errorf("CreatedAt() = %v, want %v", post.CreatedAt(), createdAt)
+	}
+	if imported.OccurredAt() == nil || !imported.OccurredAt().Equal(occurredAt) {
+		t.Errorf("OccurredAt() = %v, want %v", imported.OccurredAt(), occurredAt)
+	}
+	if post.Resolution() != content.ResolutionStatusResolved {
+		t.Errorf("Resolution() = %v, want RESOLVED", post.Resolution())
+	}
+	if post.ManagementTokenHash() != "" || post.AuthorID() != "" {
+		t.Error("imported post has a management token or an author")
+	}
+}
+
+func TestNewImportedPost_InvalidCreatedAt(t *testing.T) {
+	ref, _ := content.NewExternalRef("partner", "1")
+	company, _ := content.NewCompanyName("测试公司")
+	city, _ := shared.NewCity("beijing", "北京")
+	postContent, _ := content.NewContent("这是一条导入的历史投诉内容，长度满足要求。")
+
+	for _, createdAt := range []time.Time{{}, time.Now().Add(time.Hour)} {
+		if _, err := content.NewImportedPost(ref, company, city, postContent, createdAt, nil, content.ResolutionStatusNone); err == nil {
+			t.Errorf("NewImportedPost(createdAt = %v) error = nil, want error", createdAt)
+		}
+	}
+
+	createdAt := time.Date(2020, 5, 1, 9, 0, 0, 0, time.UTC)
+	occurredAt := createdAt.Add(time.Hour)
+	if _, err := content.NewImportedPost(ref, company, city, postContent, createdAt, &occurredAt, content.ResolutionStatusNone); err == nil {
+		t.Error("NewImportedPost(occurredAt after createdAt) error = nil, want error")
+	}
+}
@@ -0,0 +1,130 @@
+package content_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"fuck_boss/backend/internal/domain/content"
+)
+
+func TestNewModerationStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    content.ModerationStatus
+		wantErr bool
+	}{
+		{name: "visible", value: "VISIBLE", want: content.ModerationStatusVisible},
+		{name: "hidden lower case", value: "hidden", want: content.ModerationStatusHidden},
+		{name: "removed with spaces", value: " Removed ", want: content.ModerationStatusRemoved},
+		{name: "empty", value: "", wantErr: true},
+		{name: "unknown", value: "DELETED", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := content.NewModerationStatus(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewModerationStatus(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("NewModerationStatus(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewModerationReason(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		wantErr bool
+	}{
+		{name: "valid", value: "包含个人隐私信息", wantErr: false},
+		{name: "blank", value: "  ", wantErr: true},
+		{name: "max length", value: strings.Repeat("好", content.MaxModerationReasonLength), wantErr: false},
+		{name: "too long", value: strings.Repeat("好", content.MaxModerationReasonLength+1), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := content.NewModerationReason(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewModerationReason() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPost_Moderation(t *testing.T) {
+	post := newTestPost(t)
+	if !post.IsVisible() {
+		t.Fatal("new post is not visible")
+	}
+
+	reason, _ := content.NewModerationReason("包含个人隐私信息")
+	moderatorID := "7c9e6679-7425-40de-944b-e07fc1f90ae7"
+
+	// Visible -> hidden
+	if err := post.Hide(reason, moderatorID); err != nil {
+		t.Fatalf("Hide() error = %v, want nil", err)
+	}
+	if post.IsVisible() || post.Moderation().Status != content.ModerationStatusHidden {
+		t.Errorf("status = %q, want HIDDEN", post.Moderation().Status)
+	}
+	if post.Moderation().Reason != reason || post.Moderation().ModeratedAt.IsZero() || post.Moderation().ModeratedBy != moderatorID {
+		t.Errorf("moderation = %+v, want reason, time and moderator", post.Moderation())
+	}
+	if err := post.Hide(reason, moderatorID); !errors.Is(err, content.ErrModerationTransition) {
+		t.Errorf("Hide() of hidden post error = %v, want ErrModerationTransition", err)
+	}
+
+	// Hidden -> removed, by an operator
+	if err := post.Remove(reason, ""); err != nil {
+		t.Fatalf("Remove() error = %v, want nil", err)
+	}
+	if post.Moderation().Status != content.ModerationStatusRemoved || post.Moderation().ModeratedBy != "" {
+		t.Errorf("moderation = %+v, want REMOVED without moderator", post.Moderation())
+	}
+	if err := post.Remove(reason, moderatorID); !errors.Is(err, content.ErrModerationTransition) {
+		t.Errorf("Remove() of removed post error = %v, want ErrModerationTransition", err)
+	}
+	if err := post.Hide(reason, moderatorID); !errors.Is(err, content.ErrModerationTransition) {
+		t.Errorf("Hide() of removed post error = %v, want ErrModerationTransition", err)
+	}
+
+	// Removed -> visible
+	if err := post.Restore(moderatorID); err != nil {
+		t.Fatalf("Restore() error = %v, want nil", err)
+	}
+	if !post.IsVisible() || !post.Moderation().Reason.IsZero() {
+		t.Errorf("moderation = %+v, want visible without reason", post.Moderation())
+	}
+	if err := post.Restore(moderatorID); !errors.Is(err, content.ErrModerationTransition) {
+		t.Errorf("Restore() of visible post error = %v, want ErrModerationTransition", err)
+	}
+}
+
+func TestNewPostFromDB_Moderation(t *testing.T) {
+	post := newTestPost(t)
+	reason, _ := content.NewModerationReason("重复发布")
+	moderatedAt := time.Now().Add(-time.Hour)
+
+	restored, err := content.NewPostFromDB(post.ID(), post.Company(), post.City(), post.Content(), post.CreatedAt(),
+		content.WithModeration(content.Moderation{Status: content.ModerationStatusRemoved, Reason: reason, ModeratedAt: moderatedAt}),
+	)
+	if err != nil {
+		t.Fatalf("NewPostFromDB() error = %v, want nil", err)
+	}
+	if restored.IsVisible() || restored.Moderation().Reason != reason || !restored.Moderation().ModeratedAt.Equal(moderatedAt) {
+		t.Errorf("moderation = %+v, want the restored state", restored.Moderation())
+	}
+
+	// Posts without stored moderation state are visible
+	plain, _ := content.NewPostFromDB(post.ID(), post.Company(), post.City(), post.Content(), post.CreatedAt())
+	if !plain.IsVisible() {
+		t.Error("post without moderation state is not visible")
+	}
+}
@@ -0,0 +1,75 @@
+package content_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"fuck_boss/backend/internal/domain/content"
+)
+
+func TestNewReplyContent(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		wantErr bool
+	}{
+		{name: "valid", value: "我们已经核实情况并补发了工资", wantErr: false},
+		{name: "too short", value: "已处理", wantErr: true},
+		{name: "too long", value: strings.Repeat("a", 5001), wantErr: true},
+		{name: "whitespace only", value: "            ", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := content.NewReplyContent(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewReplyContent(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPost_AttachOfficialReply(t *testing.T) {
+	post := newTestPost(t)
+	company, _ := content.NewCompanyName("Example Company")
+	replyContent, _ := content.NewReplyContent("我们已经核实情况并补发了工资")
+
+	reply, err := post.AttachOfficialReply("rep-1", company, "example.com", replyContent)
+	if err != nil {
+		t.Fatalf("AttachOfficialReply() error = %v, want nil", err)
+	}
+	if reply.ID() == "" {
+		t.Error("reply ID is empty, want generated ID")
+	}
+	if reply.Badge() != content.OfficialReplyBadge {
+		t.Errorf("Badge() = %q, want %q", reply.Badge(), content.OfficialReplyBadge)
+	}
+	if reply.IsPersisted() {
+		t.Error("IsPersisted() = true, want false for a new reply")
+	}
+	if post.OfficialReply() != reply {
+		t.Error("OfficialReply() does not return the attached reply")
+	}
+	if post.Content().String() != strings.Repeat("A", 50) {
+		t.Error("post content changed after official reply")
+	}
+
+	// A second reply is rejected
+	if _, err := post.AttachOfficialReply("rep-2", company, "example.com", replyContent); !errors.Is(err, content.ErrOfficialReplyExists) {
+		t.Errorf("second AttachOfficialReply() error = %v, want ErrOfficialReplyExists", err)
+	}
+}
+
+func TestPost_AttachOfficialReply_CompanyMismatch(t *testing.T) {
+	post := newTestPost(t)
+	other, _ := content.NewCompanyName("Other Company")
+	replyContent, _ := content.NewReplyContent("我们已经核实情况并补发了工资")
+
+	if _, err := post.AttachOfficialReply("rep-1", other, "other.com", replyContent); !errors.Is(err, content.ErrCompanyMismatch) {
+		t.Errorf("AttachOfficialReply() error = %v, want ErrCompanyMismatch", err)
+	}
+	if post.OfficialReply() != nil {
+		t.Error("OfficialReply() is set after a rejected reply")
+	}
+}
@@ -0,0 +1,54 @@
+package content_test
+
+import (
+	"testing"
+	"time"
+
+	"fuck_boss/backend/internal/domain/content"
+)
+
+func mustPostID(t *testing.T, value string) content.PostID {
+	t.Helper()
+	id, err := content.NewPostID(value)
+	if err != nil {
+		t.Fatalf("NewPostID(%q) error = %v", value, err)
+	}
+	return id
+}
+
+func TestPostCursor_After(t *testing.T) {
+	now := time.Now()
+	low := mustPostID(t, "0b0c8a43-9a5e-4d8c-a1f1-6c2f1f0e9a01")
+	high := mustPostID(t, "f10c8a43-9a5e-4d8c-a1f1-6c2f1f0e9a01")
+
+	tests := []struct {
+		name  string
+		c     content.PostCursor
+		other content.PostCursor
+		want  bool
+	}{
+		{"later time", content.PostCursor{CreatedAt: now.Add(time.Second), ID: low}, content.PostCursor{CreatedAt: now, ID: high}, true},
+		{"earlier time", content.PostCursor{CreatedAt: now, ID: high}, content.PostCursor{CreatedAt: now.Add(time.Second), ID: low}, false},
+		{"same time higher id", content.PostCursor{CreatedAt: now, ID: high}, content.PostCursor{CreatedAt: now, ID: low}, true},
+		{"same time lower id", content.PostCursor{CreatedAt: now, ID: low}, content.PostCursor{CreatedAt: now, ID: high}, false},
+		{"same position", content.PostCursor{CreatedAt: now, ID: low}, content.PostCursor{CreatedAt: now, ID: low}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.c.After(tt.other); got != tt.want {
+				t.Errorf("After() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPostRef_Cursor(t *testing.T) {
+	createdAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	id := content.GeneratePostID()
+	ref := content.NewPostRefFromDB(id, createdAt, createdAt.Add(time.Hour))
+
+	cursor := ref.Cursor()
+	if !cursor.CreatedAt.Equal(createdAt) || !cursor.ID.Equals(id) {
+		t.Errorf("Cursor() = %+v, want {%v %v}", cursor, createdAt, id)
+	}
+}
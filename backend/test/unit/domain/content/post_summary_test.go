@@ -0,0 +1,65 @@
+package content_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"fuck_boss/backend/internal/domain/content"
+	"fuck_boss/backend/internal/domain/shared"
+)
+
+func newSummary(t *testing.T, prefix string, truncated bool) *content.PostSummary {
+	t.Helper()
+
+	company, err := content.NewCompanyName("测试公司")
+	if err != nil {
+		t.Fatalf("NewCompanyName() error = %v", err)
+	}
+	city, err := shared.NewCity("beijing", "北京")
+	if err != nil {
+		t.Fatalf("NewCity() error = %v", err)
+	}
+
+	return content.NewPostSummaryFromDB(content.GeneratePostID(), company, city, prefix, truncated,
+		time.Now(), content.ResolutionStatusResolved)
+}
+
+func TestNewPostSummaryFromDB_PlainText(t *testing.T) {
+	s := newSummary(t, "**加班**没有[加班费](https://example.com)", false)
+
+	if got, want := s.Summary(), "加班没有加班费"; got != want {
+		t.Errorf("Summary() = %q, want %q", got, want)
+	}
+	if s.Company().String() != "测试公司" {
+		t.Errorf("Company() = %v, want 测试公司", s.Company().String())
+	}
+	if s.Resolution() != content.ResolutionStatusResolved {
+		t.Errorf("Resolution() = %v, want %v", s.Resolution(), content.ResolutionStatusResolved)
+	}
+}
+
+func TestNewPostSummaryFromDB_Truncated(t *testing.T) {
+	s := newSummary(t, "这是一条很短的内容前缀", true)
+
+	if got, want := s.Summary(), "这是一条很短的内容前缀..."; got != want {
+		t.Errorf("Summary() = %q, want %q", got, want)
+	}
+}
+
+func TestNewPostSummaryFromDB_MatchesContentSummary(t *testing.T) {
+	text := strings.Repeat("长", content.SummaryLength+50)
+	c, err := content.NewContent(text)
+	if err != nil {
+		t.Fatalf("NewContent() error = %v", err)
+	}
+
+	s := newSummary(t, text, false)
+
+	if s.Summary() != c.Summary() {
+		t.Errorf("Summary() = %q, want %q", s.Summary(), c.Summary())
+	}
+	if got := len([]rune(s.Summary())); got != content.SummaryLength+3 {
+		t.Errorf("len(Summary()) = %d, want %d", got, content.SummaryLength+3)
+	}
+}
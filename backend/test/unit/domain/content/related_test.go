@@ -0,0 +1,81 @@
+package content_test
+
+import (
+	"testing"
+	"time"
+
+	"fuck_boss/backend/internal/domain/content"
+)
+
+func newDocument(t *testing.T, company, cityCode, text string, createdAt time.Time) *content.PostDocument {
+	t.Helper()
+	name, err := content.NewCompanyName(company)
+	if err != nil {
+		t.Fatalf("NewCompanyName(%q) error = %v", company, err)
+	}
+	return content.NewPostDocumentFromDB(content.GeneratePostID(), name, cityCode, text, createdAt)
+}
+
+func TestNewPostDocumentFromDB_PlainText(t *testing.T) {
+	doc := newDocument(t, "某某科技", "beijing", "**拖欠**工资", time.Now())
+
+	if got, want := doc.Text(), "拖欠工资"; got != want {
+		t.Errorf("Text() = %q, want %q", got, want)
+	}
+}
+
+func TestRelatedPosts_SameCompanyFirst(t *testing.T) {
+	now := time.Now()
+	post := newDocument(t, "某某科技有限公司", "beijing", "连续三个月拖欠工资，HR不回消息", now)
+	older := newDocument(t, "某某科技（北京）有限公司", "shanghai", "面试体验很差", now.Add(-2*time.Hour))
+	newer := newDocument(t, "某某科技", "shenzhen", "加班没有加班费", now.Add(-time.Hour))
+	similar := newDocument(t, "另一家公司", "beijing", "也是拖欠工资三个月，HR不回消息", now)
+	unrelated := newDocument(t, "第三家公司", "beijing", "食堂不错，环境很好", now)
+
+	related := content.RelatedPosts([]*content.PostDocument{post, older, newer, similar, unrelated}, 10)
+
+	got := related[post.ID()]
+	want := []content.PostID{newer.ID(), older.ID(), similar.ID()}
+	if len(got) != len(want) {
+		t.Fatalf("RelatedPosts() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if !got[i].Equals(want[i]) {
+			t.Errorf("RelatedPosts()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestRelatedPosts_SimilarOnlyInSameCity(t *testing.T) {
+	now := time.Now()
+	post := newDocument(t, "甲公司", "beijing", "拖欠工资三个月", now)
+	otherCity := newDocument(t, "乙公司", "shanghai", "拖欠工资三个月", now)
+	filler := newDocument(t, "丙公司", "beijing", "食堂不错，环境很好", now)
+
+	related := content.RelatedPosts([]*content.PostDocument{post, otherCity, filler}, 10)
+
+	if got, ok := related[post.ID()]; ok {
+		t.Errorf("RelatedPosts() = %v, want no related posts", got)
+	}
+}
+
+func TestRelatedPosts_Limit(t *testing.T) {
+	now := time.Now()
+	docs := make([]*content.PostDocument, 0, 5)
+	for i := 0; i < 5; i++ {
+		docs = append(docs, newDocument(t, "某某科技", "beijing", "加班", now.Add(-time.Duration(i)*time.Hour)))
+	}
+
+	related := content.RelatedPosts(docs, 2)
+
+	for _, doc := range docs {
+		if got := len(related[doc.ID()]); got != 2 {
+			t.Errorf("len(RelatedPosts()[%v]) = %d, want 2", doc.ID(), got)
+		}
+		for _, id := range related[doc.ID()] {
+			if id.Equals(doc.ID()) {
+				t.Errorf("RelatedPosts()[%v] contains the post itself", doc.ID())
+			}
+		}
+	}
+}
@@ -319,6 +319,36 @@ func TestCompanyName_Equals(t *testing.T) {
 	}
 }
 
+func TestCompanyName_Canonical(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "chinese legal suffix", input: "某某科技有限公司", want: "某某科技"},
+		{name: "parenthesized city", input: "某某科技（北京）有限公司", want: "某某科技"},
+		{name: "stacked suffixes", input: "某某集团股份有限公司", want: "某某"},
+		{name: "full-width letters", input: "ＡＢＣ网络", want: "abc网络"},
+		{name: "latin legal words", input: "Example Tech Co., Ltd.", want: "exampletech"},
+		{name: "case and spaces", input: "EXAMPLE tech", want: "exampletech"},
+		{name: "suffix only is kept", input: "公司", want: "公司"},
+		{name: "legal word only is kept", input: "Company", want: "company"},
+		{name: "punctuation only falls back", input: "!!!", want: "!!!"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cn, err := content.NewCompanyName(tt.input)
+			if err != nil {
+				t.Fatalf("NewCompanyName(%q) error = %v", tt.input, err)
+			}
+			if got := cn.Canonical(); got != tt.want {
+				t.Errorf("CompanyName.Canonical() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestCompanyName_WhitespaceHandling(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -531,6 +561,16 @@ func TestContent_Summary(t *testing.T) {
 			content: strings.Repeat("中", 300),
 			want:    strings.Repeat("中", 200) + "...",
 		},
+		{
+			name:    "Markdown syntax is not counted",
+			content: "**" + strings.Repeat("中", 200) + "**",
+			want:    strings.Repeat("中", 200),
+		},
+		{
+			name:    "Markdown rendered to plain text",
+			content: "> 领导说：[不签合同](https://example.com)\n\n- 拖欠工资<br>",
+			want:    "领导说：不签合同\n拖欠工资",
+		},
 	}
 
 	for _, tt := range tests {
@@ -549,6 +589,26 @@ func TestContent_Summary(t *testing.T) {
 	}
 }
 
+func TestContent_HTML(t *testing.T) {
+	attachment := "/api/attachments/550e8400-e29b-41d4-a716-446655440000"
+	c, err := content.NewContent("**拖欠工资**三个月 ![工资条](" + attachment + "/thumbnail) ![外链](https://example.com/a.png) <img src=x onerror=alert(1)>")
+	if err != nil {
+		t.Fatalf("NewContent() error = %v, want nil", err)
+	}
+
+	html := c.HTML()
+	for _, want := range []string{"<strong>拖欠工资</strong>", `<img src="` + attachment + `/thumbnail" alt="工资条">`, "外链"} {
+		if !strings.Contains(html, want) {
+			t.Errorf("Content.HTML() = %q, want it to contain %q", html, want)
+		}
+	}
+	for _, notWant := range []string{"example.com", "onerror", "<img src=x"} {
+		if strings.Contains(html, notWant) {
+			t.Errorf("Content.HTML() = %q, want it not to contain %q", html, notWant)
+		}
+	}
+}
+
 func TestContent_IsZero(t *testing.T) {
 	tests := []struct {
 		name string
@@ -0,0 +1,86 @@
+package identity_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"fuck_boss/backend/internal/domain/identity"
+)
+
+func TestIssueRefreshToken(t *testing.T) {
+	userID := identity.GenerateUserID()
+
+	token, raw, err := identity.IssueRefreshToken(userID, time.Hour)
+	if err != nil {
+		t.Fatalf("IssueRefreshToken() error = %v, want nil", err)
+	}
+
+	if raw == "" {
+		t.Fatal("IssueRefreshToken() raw token is empty")
+	}
+	if token.TokenHash() != identity.HashRefreshToken(raw) {
+		t.Error("IssueRefreshToken() token hash does not match the raw token")
+	}
+	if token.TokenHash() == raw {
+		t.Error("IssueRefreshToken() stored the raw token")
+	}
+	if !token.UserID().Equals(userID) {
+		t.Errorf("IssueRefreshToken() user = %v, want %v", token.UserID(), userID)
+	}
+	if token.IsRevoked() {
+		t.Error("IssueRefreshToken() token is already revoked")
+	}
+}
+
+func TestRefreshToken_Rotate(t *testing.T) {
+	token, raw, _ := identity.IssueRefreshToken(identity.GenerateUserID(), time.Hour)
+
+	next, nextRaw, err := token.Rotate(time.Now(), time.Hour)
+	if err != nil {
+		t.Fatalf("Rotate() error = %v, want nil", err)
+	}
+
+	if nextRaw == raw {
+		t.Error("Rotate() returned the same raw token")
+	}
+	if next.FamilyID() != token.FamilyID() {
+		t.Errorf("Rotate() family = %q, want %q", next.FamilyID(), token.FamilyID())
+	}
+	if !token.IsRevoked() {
+		t.Error("Rotate() did not revoke the old token")
+	}
+	if token.ReplacedBy() != next.ID() {
+		t.Errorf("Rotate() replacedBy = %q, want %q", token.ReplacedBy(), next.ID())
+	}
+
+	// Reusing a rotated token is rejected
+	if _, _, err := token.Rotate(time.Now(), time.Hour); !errors.Is(err, identity.ErrRefreshTokenRevoked) {
+		t.Errorf("Rotate() on rotated token error = %v, want ErrRefreshTokenRevoked", err)
+	}
+}
+
+func TestRefreshToken_RotateExpired(t *testing.T) {
+	token, _, _ := identity.IssueRefreshToken(identity.GenerateUserID(), time.Hour)
+
+	_, _, err := token.Rotate(time.Now().Add(2*time.Hour), time.Hour)
+	if !errors.Is(err, identity.ErrRefreshTokenExpired) {
+		t.Errorf("Rotate() on expired token error = %v, want ErrRefreshTokenExpired", err)
+	}
+}
+
+func TestRefreshToken_Revoke(t *testing.T) {
+	token, _, _ := identity.IssueRefreshToken(identity.GenerateUserID(), time.Hour)
+
+	token.Revoke(time.Now())
+	if !token.IsRevoked() {
+		t.Fatal("Revoke() did not revoke the token")
+	}
+	revokedAt := *token.RevokedAt()
+
+	// Revoking again keeps the original revocation time
+	token.Revoke(time.Now().Add(time.Minute))
+	if !token.RevokedAt().Equal(revokedAt) {
+		t.Errorf("Revoke() changed revokedAt from %v to %v", revokedAt, *token.RevokedAt())
+	}
+}
@@ -0,0 +1,162 @@
+package identity_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"fuck_boss/backend/internal/domain/identity"
+)
+
+// plainHasher is a fast, insecure PasswordHasher for tests.
+type plainHasher struct{}
+
+func (plainHasher) Hash(password string) (string, error) {
+	return "plain:" + password, nil
+}
+
+func (plainHasher) Verify(password, encodedHash string) (bool, error) {
+	return encodedHash == "plain:"+password, nil
+}
+
+func TestNewEmail(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{name: "valid", value: "alice@example.com", want: "alice@example.com"},
+		{name: "normalized", value: "  Alice@Example.COM ", want: "alice@example.com"},
+		{name: "empty", value: "", wantErr: true},
+		{name: "missing at", value: "alice.example.com", wantErr: true},
+		{name: "display name", value: "Alice <alice@example.com>", wantErr: true},
+		{name: "dotless domain", value: "alice@localhost", wantErr: true},
+		{name: "too long", value: strings.Repeat("a", 250) + "@example.com", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := identity.NewEmail(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewEmail(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if !tt.wantErr && got.String() != tt.want {
+				t.Errorf("NewEmail(%q) = %q, want %q", tt.value, got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestNewUsername(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		wantZero bool
+		wantErr  bool
+	}{
+		{name: "valid", value: "alice_01"},
+		{name: "chinese", value: "打工人"},
+		{name: "empty is optional", value: "", wantZero: true},
+		{name: "too short", value: "ab", wantErr: true},
+		{name: "too long", value: strings.Repeat("a", 33), wantErr: true},
+		{name: "spaces", value: "alice bob", wantErr: true},
+		{name: "at sign", value: "alice@x", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := identity.NewUsername(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewUsername(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if !tt.wantErr && got.IsZero() != tt.wantZero {
+				t.Errorf("NewUsername(%q).IsZero() = %v, want %v", tt.value, got.IsZero(), tt.wantZero)
+			}
+		})
+	}
+}
+
+func TestNewPassword(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		wantErr bool
+	}{
+		{name: "letters and digits", value: "hunter2hunter2"},
+		{name: "letters and symbol", value: "correct-horse"},
+		{name: "too short", value: "abc123", wantErr: true},
+		{name: "letters only", value: "abcdefghij", wantErr: true},
+		{name: "digits only", value: "1234567890", wantErr: true},
+		{name: "too long", value: strings.Repeat("a1", 65), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := identity.NewPassword(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewPassword(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewUser_Authenticate(t *testing.T) {
+	email, _ := identity.NewEmail("alice@example.com")
+	username, _ := identity.NewUsername("alice")
+	password, _ := identity.NewPassword("hunter2hunter2")
+
+	user, err := identity.NewUser(email, username, password, plainHasher{})
+	if err != nil {
+		t.Fatalf("NewUser() error = %v, want nil", err)
+	}
+
+	if user.ID().IsZero() {
+		t.Error("NewUser() ID is zero")
+	}
+	if user.PasswordHash() == "hunter2hunter2" {
+		t.Error("NewUser() stored the plaintext password")
+	}
+	if err := user.Authenticate("hunter2hunter2", plainHasher{}); err != nil {
+		t.Errorf("Authenticate() with correct password error = %v, want nil", err)
+	}
+	if err := user.Authenticate("wrong-password1", plainHasher{}); !errors.Is(err, identity.ErrInvalidCredentials) {
+		t.Errorf("Authenticate() with wrong password error = %v, want ErrInvalidCredentials", err)
+	}
+}
+
+func TestUser_Ban(t *testing.T) {
+	email, _ := identity.NewEmail("alice@example.com")
+	password, _ := identity.NewPassword("hunter2hunter2")
+	user, _ := identity.NewUser(email, identity.Username{}, password, plainHasher{})
+
+	if user.IsBanned() {
+		t.Fatal("new user is banned")
+	}
+	if err := user.Ban("  "); err == nil {
+		t.Error("Ban() with empty reason error = nil, want error")
+	}
+	if err := user.Ban(strings.Repeat("长", identity.MaxBanReasonLength+1)); err == nil {
+		t.Error("Ban() with long reason error = nil, want error")
+	}
+	if err := user.Unban(); !errors.Is(err, identity.ErrUserNotBanned) {
+		t.Errorf("Unban() of user not banned error = %v, want ErrUserNotBanned", err)
+	}
+
+	if err := user.Ban(" 散布他人隐私 "); err != nil {
+		t.Fatalf("Ban() error = %v, want nil", err)
+	}
+	if !user.IsBanned() || user.BannedAt() == nil || user.BanReason() != "散布他人隐私" {
+		t.Errorf("ban = %v %q, want banned with trimmed reason", user.BannedAt(), user.BanReason())
+	}
+	if err := user.Ban("再次封禁"); !errors.Is(err, identity.ErrUserBanned) {
+		t.Errorf("Ban() of banned user error = %v, want ErrUserBanned", err)
+	}
+
+	if err := user.Unban(); err != nil {
+		t.Fatalf("Unban() error = %v, want nil", err)
+	}
+	if user.IsBanned() || user.BanReason() != "" {
+		t.Errorf("ban = %v %q, want not banned", user.BannedAt(), user.BanReason())
+	}
+}
@@ -0,0 +1,122 @@
+package identity_test
+
+import (
+	"testing"
+
+	"fuck_boss/backend/internal/domain/identity"
+)
+
+func TestNewPhoneNumber(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{name: "mainland mobile", value: "13800138000", want: "+8613800138000"},
+		{name: "with separators", value: "138-0013 8000", want: "+8613800138000"},
+		{name: "e164", value: "+85291234567", want: "+85291234567"},
+		{name: "empty", value: "", wantErr: true},
+		{name: "landline", value: "02012345678", wantErr: true},
+		{name: "letters", value: "+86abc", wantErr: true},
+		{name: "too long", value: "+1234567890123456", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := identity.NewPhoneNumber(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewPhoneNumber(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if !tt.wantErr && got.String() != tt.want {
+				t.Errorf("NewPhoneNumber(%q) = %q, want %q", tt.value, got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestNewVerificationRecipient(t *testing.T) {
+	tests := []struct {
+		name    string
+		channel identity.VerificationChannel
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{name: "email", channel: identity.VerificationChannelEmail, value: "Alice@Example.com", want: "alice@example.com"},
+		{name: "sms", channel: identity.VerificationChannelSMS, value: "13800138000", want: "+8613800138000"},
+		{name: "phone on email channel", channel: identity.VerificationChannelEmail, value: "13800138000", wantErr: true},
+		{name: "email on sms channel", channel: identity.VerificationChannelSMS, value: "alice@example.com", wantErr: true},
+		{name: "zero channel", value: "alice@example.com", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := identity.NewVerificationRecipient(tt.channel, tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewVerificationRecipient(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("NewVerificationRecipient(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewVerificationChannelAndPurpose(t *testing.T) {
+	if c, err := identity.NewVerificationChannel("sms"); err != nil || c != identity.VerificationChannelSMS {
+		t.Errorf("NewVerificationChannel(sms) = %v, %v", c, err)
+	}
+	if _, err := identity.NewVerificationChannel("fax"); err == nil {
+		t.Error("NewVerificationChannel(fax) should fail")
+	}
+	if p, err := identity.NewVerificationPurpose("register"); err != nil || p != identity.VerificationPurposeRegister {
+		t.Errorf("NewVerificationPurpose(register) = %v, %v", p, err)
+	}
+	if _, err := identity.NewVerificationPurpose("login"); err == nil {
+		t.Error("NewVerificationPurpose(login) should fail")
+	}
+}
+
+func TestGenerateVerificationCode(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		code, err := identity.GenerateVerificationCode()
+		if err != nil {
+			t.Fatalf("GenerateVerificationCode() error = %v", err)
+		}
+		if _, err := identity.NewVerificationCodeFromString(code.String()); err != nil {
+			t.Fatalf("generated code %q is not 6 digits: %v", code.String(), err)
+		}
+		if !code.Matches(code.String()) {
+			t.Fatalf("code %q does not match itself", code.String())
+		}
+	}
+}
+
+func TestVerificationCode_Matches(t *testing.T) {
+	code, err := identity.NewVerificationCodeFromString("012345")
+	if err != nil {
+		t.Fatalf("NewVerificationCodeFromString() error = %v", err)
+	}
+
+	tests := []struct {
+		submitted string
+		want      bool
+	}{
+		{submitted: "012345", want: true},
+		{submitted: " 012345 ", want: true},
+		{submitted: "12345", want: false},
+		{submitted: "012346", want: false},
+		{submitted: "", want: false},
+	}
+
+	for _, tt := range tests {
+		if got := code.Matches(tt.submitted); got != tt.want {
+			t.Errorf("Matches(%q) = %v, want %v", tt.submitted, got, tt.want)
+		}
+	}
+
+	if _, err := identity.NewVerificationCodeFromString("12a456"); err == nil {
+		t.Error("NewVerificationCodeFromString(12a456) should fail")
+	}
+}
@@ -0,0 +1,157 @@
+package moderation_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"fuck_boss/backend/internal/domain/content"
+	"fuck_boss/backend/internal/domain/moderation"
+	"fuck_boss/backend/internal/domain/shared"
+)
+
+const otherUserID = "550e8400-e29b-41d4-a716-446655440000"
+
+// newModeratedPost creates a post moderated by the moderator at moderatedAt.
+func newModeratedPost(t *testing.T, status content.ModerationStatus, moderatedBy string, moderatedAt time.Time) *content.Post {
+	t.Helper()
+
+	company, _ := content.NewCompanyName("测试公司")
+	city, _ := shared.NewCity("beijing", "北京")
+	postContent, _ := content.NewContent("这是一条测试内容，用于验证申诉流程。内容应该足够长以满足最小长度要求。")
+	reason, _ := content.NewModerationReason("待核实")
+
+	moderation := content.Moderation{Status: status, ModeratedAt: moderatedAt, ModeratedBy: moderatedBy}
+	if status != content.ModerationStatusVisible {
+		moderation.Reason = reason
+	}
+	post, err := content.NewPostFromDB(content.GeneratePostID(), company, city, postContent,
+		moderatedAt.Add(-time.Hour), content.WithModeration(moderation))
+	if err != nil {
+		t.Fatalf("NewPostFromDB() error = %v", err)
+	}
+	return post
+}
+
+func TestNewAppeal(t *testing.T) {
+	moderatedAt := time.Now().Add(-time.Hour)
+	post := newModeratedPost(t, content.ModerationStatusHidden, testUserID, moderatedAt)
+
+	appeal, err := moderation.NewAppeal(post, "  这是误判，内容属实  ", nil)
+	if err != nil {
+		t.Fatalf("NewAppeal() error = %v", err)
+	}
+	if appeal.Statement() != "这是误判，内容属实" {
+		t.Errorf("Statement() = %q, want trimmed statement", appeal.Statement())
+	}
+	if appeal.Status() != moderation.AppealStatusPending || !appeal.IsPending() {
+		t.Errorf("Status() = %s, want PENDING", appeal.Status())
+	}
+	if appeal.AppealedStatus() != content.ModerationStatusHidden || appeal.ActedBy() != testUserID {
+		t.Errorf("appealed %s by %q, want HIDDEN by %q", appeal.AppealedStatus(), appeal.ActedBy(), testUserID)
+	}
+	if appeal.PostID() != post.ID() || appeal.ID() == "" || appeal.DecidedAt() != nil {
+		t.Errorf("appeal = %+v, want a new appeal of the post", appeal)
+	}
+
+	visible := newModeratedPost(t, content.ModerationStatusVisible, "", time.Time{})
+	if _, err := moderation.NewAppeal(visible, "这是误判", nil); !errors.Is(err, moderation.ErrPostNotModerated) {
+		t.Errorf("NewAppeal() of visible post error = %v, want ErrPostNotModerated", err)
+	}
+	if _, err := moderation.NewAppeal(post, " ", nil); err == nil {
+		t.Error("NewAppeal() with empty statement error = nil, want error")
+	}
+	if _, err := moderation.NewAppeal(post, strings.Repeat("长", moderation.MaxAppealStatementLength+1), nil); err == nil {
+		t.Error("NewAppeal() with long statement error = nil, want error")
+	}
+}
+
+func TestNewAppeal_OncePerAction(t *testing.T) {
+	moderatedAt := time.Now().Add(-time.Hour)
+	post := newModeratedPost(t, content.ModerationStatusHidden, testUserID, moderatedAt)
+	decidedAt := time.Now()
+
+	tests := []struct {
+		name    string
+		latest  *moderation.Appeal
+		wantErr bool
+	}{
+		{
+			name:    "pending appeal",
+			latest:  moderation.NewAppealFromDB("a1", post.ID(), content.ModerationStatusHidden, testUserID, "误判", moderation.AppealStatusPending, "", "", moderatedAt.Add(time.Minute), nil),
+			wantErr: true,
+		},
+		{
+			name:    "rejected appeal of the same action",
+			latest:  moderation.NewAppealFromDB("a1", post.ID(), content.ModerationStatusHidden, testUserID, "误判", moderation.AppealStatusRejected, otherUserID, "内容不实", moderatedAt.Add(time.Minute), &decidedAt),
+			wantErr: true,
+		},
+		{
+			name:   "rejected appeal of an earlier action",
+			latest: moderation.NewAppealFromDB("a1", post.ID(), content.ModerationStatusHidden, testUserID, "误判", moderation.AppealStatusRejected, otherUserID, "内容不实", moderatedAt.Add(-time.Minute), &decidedAt),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := moderation.NewAppeal(post, "这是误判", tt.latest)
+			if tt.wantErr && !errors.Is(err, moderation.ErrAlreadyAppealed) {
+				t.Errorf("NewAppeal() error = %v, want ErrAlreadyAppealed", err)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("NewAppeal() error = %v, want nil", err)
+			}
+		})
+	}
+}
+
+func TestAppeal_Decide(t *testing.T) {
+	post := newModeratedPost(t, content.ModerationStatusRemoved, testUserID, time.Now().Add(-time.Hour))
+	appeal, _ := moderation.NewAppeal(post, "这是误判", nil)
+
+	if err := appeal.Decide(testUserID, true, "同意"); !errors.Is(err, moderation.ErrOwnAction) {
+		t.Errorf("Decide() by acting moderator error = %v, want ErrOwnAction", err)
+	}
+	if err := appeal.Decide(otherUserID, true, " "); err == nil {
+		t.Error("Decide() with empty reason error = nil, want error")
+	}
+	if !appeal.IsPending() {
+		t.Fatal("failed decisions changed the appeal")
+	}
+
+	if err := appeal.Decide(otherUserID, false, " 内容确属广告 "); err != nil {
+		t.Fatalf("Decide() error = %v", err)
+	}
+	if appeal.Status() != moderation.AppealStatusRejected || appeal.DecidedBy() != otherUserID || appeal.DecisionReason() != "内容确属广告" {
+		t.Errorf("appeal = %s by %q (%q), want REJECTED by %q", appeal.Status(), appeal.DecidedBy(), appeal.DecisionReason(), otherUserID)
+	}
+	if appeal.DecidedAt() == nil || appeal.DecidedAt().Before(appeal.FiledAt()) {
+		t.Errorf("DecidedAt() = %v, want a time after filing", appeal.DecidedAt())
+	}
+
+	if err := appeal.Decide(otherUserID, true, "改判"); !errors.Is(err, moderation.ErrAppealDecided) {
+		t.Errorf("Decide() of decided appeal error = %v, want ErrAppealDecided", err)
+	}
+}
+
+func TestAppeal_Decide_OperatorAction(t *testing.T) {
+	post := newModeratedPost(t, content.ModerationStatusHidden, "", time.Now().Add(-time.Hour))
+	appeal, _ := moderation.NewAppeal(post, "这是误判", nil)
+
+	if err := appeal.Decide(testUserID, true, "信息已核实"); err != nil {
+		t.Fatalf("Decide() error = %v", err)
+	}
+	if appeal.Status() != moderation.AppealStatusAccepted {
+		t.Errorf("Status() = %s, want ACCEPTED", appeal.Status())
+	}
+}
+
+func TestNewAppealStatus(t *testing.T) {
+	if got, err := moderation.NewAppealStatus(" accepted "); err != nil || got != moderation.AppealStatusAccepted {
+		t.Errorf("NewAppealStatus(accepted) = %q, %v, want ACCEPTED", got, err)
+	}
+	if _, err := moderation.NewAppealStatus("WITHDRAWN"); err == nil {
+		t.Error("NewAppealStatus(WITHDRAWN) error = nil, want error")
+	}
+}
@@ -0,0 +1,133 @@
+package moderation_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"fuck_boss/backend/internal/domain/moderation"
+)
+
+const testUserID = "7c9e6679-7425-40de-944b-e07fc1f90ae7"
+
+func TestNewRole(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    moderation.Role
+		wantErr bool
+	}{
+		{input: "junior", want: moderation.RoleJunior},
+		{input: " SENIOR ", want: moderation.RoleSenior},
+		{input: "", wantErr: true},
+		{input: "admin", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := moderation.NewRole(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("NewRole(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("NewRole(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestNewPermission(t *testing.T) {
+	if got, err := moderation.NewPermission("hide_post"); err != nil || got != moderation.PermissionHidePost {
+		t.Errorf("NewPermission(hide_post) = %q, %v, want HIDE_POST", got, err)
+	}
+	if _, err := moderation.NewPermission("DELETE_POST"); err == nil {
+		t.Error("NewPermission(DELETE_POST) error = nil, want error")
+	}
+}
+
+func TestRole_Permissions(t *testing.T) {
+	tests := []struct {
+		role    moderation.Role
+		allowed []moderation.Permission
+	}{
+		{
+			role:    moderation.RoleJunior,
+			allowed: []moderation.Permission{moderation.PermissionViewQueue, moderation.PermissionHidePost},
+		},
+		{
+			role:    moderation.RoleSenior,
+			allowed: moderation.Permissions,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.role.String(), func(t *testing.T) {
+			allowed := make(map[moderation.Permission]bool)
+			for _, permission := range tt.allowed {
+				allowed[permission] = true
+			}
+			for _, permission := range moderation.Permissions {
+				if got := tt.role.Can(permission); got != allowed[permission] {
+					t.Errorf("Can(%s) = %v, want %v", permission, got, allowed[permission])
+				}
+			}
+			if got := tt.role.Permissions(); len(got) != len(tt.allowed) {
+				t.Errorf("Permissions() = %v, want %v", got, tt.allowed)
+			}
+		})
+	}
+
+	if moderation.Role("ROOT").Can(moderation.PermissionViewQueue) {
+		t.Error("unknown role grants a permission")
+	}
+}
+
+func TestNewModerator(t *testing.T) {
+	moderator, err := moderation.NewModerator(testUserID, moderation.RoleJunior, []string{" shanghai", "beijing", "shanghai"})
+	if err != nil {
+		t.Fatalf("NewModerator() error = %v", err)
+	}
+
+	if got := moderator.CityCodes(); len(got) != 2 || got[0] != "beijing" || got[1] != "shanghai" {
+		t.Errorf("CityCodes() = %v, want [beijing shanghai]", got)
+	}
+	if moderator.CoversAllCities() || !moderator.CoversCity("shanghai") || moderator.CoversCity("guangzhou") {
+		t.Error("moderator covers the wrong cities")
+	}
+	if moderator.AssignedAt().IsZero() || !moderator.AssignedAt().Equal(moderator.UpdatedAt()) {
+		t.Errorf("AssignedAt() = %v, UpdatedAt() = %v, want the same time", moderator.AssignedAt(), moderator.UpdatedAt())
+	}
+
+	if _, err := moderation.NewModerator("not-a-uuid", moderation.RoleJunior, nil); err == nil {
+		t.Error("NewModerator() with invalid user ID error = nil, want error")
+	}
+	if _, err := moderation.NewModerator(testUserID, moderation.RoleJunior, []string{"beijing", " "}); err == nil {
+		t.Error("NewModerator() with empty city error = nil, want error")
+	}
+	if _, err := moderation.NewModerator(testUserID, moderation.RoleJunior, []string{strings.Repeat("a", 51)}); err == nil {
+		t.Error("NewModerator() with long city error = nil, want error")
+	}
+}
+
+func TestModerator_Authorize(t *testing.T) {
+	junior, _ := moderation.NewModerator(testUserID, moderation.RoleJunior, []string{"beijing"})
+
+	if err := junior.Authorize(moderation.PermissionHidePost, "beijing"); err != nil {
+		t.Errorf("Authorize(HIDE_POST, beijing) error = %v, want nil", err)
+	}
+	if err := junior.Authorize(moderation.PermissionHidePost, "shanghai"); !errors.Is(err, moderation.ErrCityNotAssigned) {
+		t.Errorf("Authorize(HIDE_POST, shanghai) error = %v, want ErrCityNotAssigned", err)
+	}
+	if err := junior.Authorize(moderation.PermissionRemovePost, "beijing"); !errors.Is(err, moderation.ErrPermissionDenied) {
+		t.Errorf("Authorize(REMOVE_POST, beijing) error = %v, want ErrPermissionDenied", err)
+	}
+
+	// Promoted to senior for all cities
+	if err := junior.Assign(moderation.RoleSenior, nil); err != nil {
+		t.Fatalf("Assign() error = %v", err)
+	}
+	if !junior.CoversAllCities() || junior.Role() != moderation.RoleSenior {
+		t.Errorf("moderator = %s %v, want SENIOR for all cities", junior.Role(), junior.CityCodes())
+	}
+	if err := junior.Authorize(moderation.PermissionBanUser, "shanghai"); err != nil {
+		t.Errorf("Authorize(BAN_USER, shanghai) error = %v, want nil", err)
+	}
+}
@@ -0,0 +1,118 @@
+package notification_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"fuck_boss/backend/internal/domain/content"
+	"fuck_boss/backend/internal/domain/identity"
+	"fuck_boss/backend/internal/domain/notification"
+)
+
+const testUserID = "550e8400-e29b-41d4-a716-446655440000"
+
+func TestNewType(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    notification.Type
+		wantErr bool
+	}{
+		{input: "COMMENT_REPLY", want: notification.TypeCommentReply},
+		{input: "watched_company_post", want: notification.TypeWatchedCompanyPost},
+		{input: " Moderation_Outcome ", want: notification.TypeModerationOutcome},
+		{input: "COMPANY_REPLY", want: notification.TypeCompanyReply},
+		{input: "", wantErr: true},
+		{input: "UNKNOWN", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := notification.NewType(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("NewType(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("NewType(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestNewNotification(t *testing.T) {
+	recipientID, _ := identity.NewUserID(testUserID)
+	postID := content.GeneratePostID()
+
+	n := notification.NewNotification(recipientID, notification.TypeCompanyReply, postID, "测试公司", "感谢反馈")
+
+	if n.ID() == "" {
+		t.Error("ID() is empty")
+	}
+	if n.RecipientID() != recipientID {
+		t.Errorf("RecipientID() = %v, want %v", n.RecipientID(), recipientID)
+	}
+	if n.Type() != notification.TypeCompanyReply {
+		t.Errorf("Type() = %q, want %q", n.Type(), notification.TypeCompanyReply)
+	}
+	if !n.PostID().Equals(postID) {
+		t.Errorf("PostID() = %v, want %v", n.PostID(), postID)
+	}
+	if n.IsRead() || n.ReadAt() != nil {
+		t.Error("new notification should be unread")
+	}
+}
+
+func TestNewNotification_TruncatesExcerpt(t *testing.T) {
+	recipientID, _ := identity.NewUserID(testUserID)
+	long := strings.Repeat("长", notification.MaxExcerptLength+20)
+
+	n := notification.NewNotification(recipientID, notification.TypeWatchedCompanyPost, content.GeneratePostID(), "测试公司", long)
+
+	if got := utf8.RuneCountInString(n.Excerpt()); got > notification.MaxExcerptLength+3 {
+		t.Errorf("Excerpt() length = %d, want <= %d", got, notification.MaxExcerptLength+3)
+	}
+	if !strings.HasSuffix(n.Excerpt(), "...") {
+		t.Errorf("Excerpt() = %q, want suffix \"...\"", n.Excerpt())
+	}
+
+	short := notification.NewNotification(recipientID, notification.TypeWatchedCompanyPost, content.GeneratePostID(), "测试公司", "短内容")
+	if short.Excerpt() != "短内容" {
+		t.Errorf("Excerpt() = %q, want %q", short.Excerpt(), "短内容")
+	}
+}
+
+func TestNewNotificationFromDB_Read(t *testing.T) {
+	recipientID, _ := identity.NewUserID(testUserID)
+	readAt := time.Now()
+
+	n := notification.NewNotificationFromDB("id", recipientID, notification.TypeModerationOutcome, content.GeneratePostID(), "HIDDEN", "违规", time.Now(), &readAt)
+
+	if !n.IsRead() {
+		t.Error("IsRead() = false, want true")
+	}
+}
+
+func TestCursor_RoundTrip(t *testing.T) {
+	recipientID, _ := identity.NewUserID(testUserID)
+	n := notification.NewNotification(recipientID, notification.TypeCompanyReply, content.GeneratePostID(), "测试公司", "回复")
+
+	cursor := notification.CursorAfter(n)
+	parsed, err := notification.ParseCursor(cursor.String())
+	if err != nil {
+		t.Fatalf("ParseCursor() error = %v, want nil", err)
+	}
+	if parsed.ID() != n.ID() {
+		t.Errorf("ID() = %q, want %q", parsed.ID(), n.ID())
+	}
+	if !parsed.CreatedAt().Equal(n.CreatedAt()) {
+		t.Errorf("CreatedAt() = %v, want %v", parsed.CreatedAt(), n.CreatedAt())
+	}
+}
+
+func TestParseCursor_Invalid(t *testing.T) {
+	for _, value := range []string{"", "!!!", "bm90LWEtY3Vyc29y", "MTIzOm5vdC1hLXV1aWQ"} {
+		if _, err := notification.ParseCursor(value); err == nil {
+			t.Errorf("ParseCursor(%q) error = nil, want error", value)
+		}
+	}
+}
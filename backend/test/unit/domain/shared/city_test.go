@@ -100,6 +100,24 @@ func TestNewCity_Invalid(t *testing.T) {
 			cityName: "   ",
 			wantErr:  true,
 		},
+		{
+			testName: "code too long",
+			code:     strings.Repeat("a", shared.MaxCityCodeLength+1),
+			cityName: "北京",
+			wantErr:  true,
+		},
+		{
+			testName: "name too long",
+			code:     "beijing",
+			cityName: strings.Repeat("北", shared.MaxCityNameLength+1),
+			wantErr:  true,
+		},
+		{
+			testName: "longest name",
+			code:     "beijing",
+			cityName: strings.Repeat("北", shared.MaxCityNameLength),
+			wantErr:  false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,104 @@
+package watchlist_test
+
+import (
+	"strings"
+	"testing"
+
+	"fuck_boss/backend/internal/domain/content"
+	"fuck_boss/backend/internal/domain/watchlist"
+)
+
+const testUserID = "550e8400-e29b-41d4-a716-446655440000"
+
+func TestNewUserOwner(t *testing.T) {
+	owner, err := watchlist.NewUserOwner(testUserID)
+	if err != nil {
+		t.Fatalf("NewUserOwner() error = %v, want nil", err)
+	}
+	if !owner.IsUser() {
+		t.Error("IsUser() = false, want true")
+	}
+	if got, want := owner.Key(), "user:"+testUserID; got != want {
+		t.Errorf("Key() = %q, want %q", got, want)
+	}
+
+	if _, err := watchlist.NewUserOwner("not-a-uuid"); err == nil {
+		t.Error("NewUserOwner(not-a-uuid) error = nil, want error")
+	}
+}
+
+func TestNewDeviceOwner(t *testing.T) {
+	token, err := watchlist.GenerateDeviceToken()
+	if err != nil {
+		t.Fatalf("GenerateDeviceToken() error = %v, want nil", err)
+	}
+
+	owner, err := watchlist.NewDeviceOwner(token.Hash())
+	if err != nil {
+		t.Fatalf("NewDeviceOwner() error = %v, want nil", err)
+	}
+	if owner.IsUser() {
+		t.Error("IsUser() = true, want false")
+	}
+	if got, want := owner.Key(), "device:"+token.Hash(); got != want {
+		t.Errorf("Key() = %q, want %q", got, want)
+	}
+
+	for _, hash := range []string{"", "abc", strings.Repeat("z", 64)} {
+		if _, err := watchlist.NewDeviceOwner(hash); err == nil {
+			t.Errorf("NewDeviceOwner(%q) error = nil, want error", hash)
+		}
+	}
+}
+
+func TestNewOwnerFromKey(t *testing.T) {
+	userOwner, _ := watchlist.NewUserOwner(testUserID)
+	deviceOwner, _ := watchlist.NewDeviceOwner(watchlist.HashDeviceToken("token"))
+
+	for _, owner := range []watchlist.Owner{userOwner, deviceOwner} {
+		restored, err := watchlist.NewOwnerFromKey(owner.Key())
+		if err != nil {
+			t.Fatalf("NewOwnerFromKey(%q) error = %v, want nil", owner.Key(), err)
+		}
+		if !restored.Equals(owner) {
+			t.Errorf("NewOwnerFromKey(%q) = %q, want %q", owner.Key(), restored.Key(), owner.Key())
+		}
+	}
+
+	for _, key := range []string{"", "user", "admin:" + testUserID, "user:not-a-uuid"} {
+		if _, err := watchlist.NewOwnerFromKey(key); err == nil {
+			t.Errorf("NewOwnerFromKey(%q) error = nil, want error", key)
+		}
+	}
+}
+
+func TestDeviceToken_Hash(t *testing.T) {
+	first, _ := watchlist.GenerateDeviceToken()
+	second, _ := watchlist.GenerateDeviceToken()
+
+	if first.String() == second.String() {
+		t.Error("GenerateDeviceToken() returned the same token twice")
+	}
+	if first.Hash() == first.String() {
+		t.Error("Hash() returned the raw token")
+	}
+	if got, want := watchlist.HashDeviceToken(" "+first.String()+" "), first.Hash(); got != want {
+		t.Errorf("HashDeviceToken() = %q, want %q", got, want)
+	}
+}
+
+func TestNewCompanyWatch(t *testing.T) {
+	owner, _ := watchlist.NewUserOwner(testUserID)
+	company, _ := content.NewCompanyName("测试公司")
+
+	watch := watchlist.NewCompanyWatch(owner, company)
+	if !watch.Owner().Equals(owner) {
+		t.Errorf("Owner() = %q, want %q", watch.Owner().Key(), owner.Key())
+	}
+	if !watch.Company().Equals(company) {
+		t.Errorf("Company() = %q, want %q", watch.Company().String(), company.String())
+	}
+	if watch.CreatedAt().IsZero() {
+		t.Error("CreatedAt() is zero")
+	}
+}
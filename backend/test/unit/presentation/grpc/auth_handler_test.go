@@ -0,0 +1,205 @@
+package grpc_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	authv1 "fuck_boss/backend/api/proto/auth/v1"
+	contentv1 "fuck_boss/backend/api/proto/content/v1"
+	"fuck_boss/backend/internal/application/content"
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/application/identity"
+	"fuck_boss/backend/internal/application/verification"
+	"fuck_boss/backend/internal/infrastructure/logger"
+	grpchandler "fuck_boss/backend/internal/presentation/grpc"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// MockLoginUseCase is a mock implementation of LoginUseCase.
+type MockLoginUseCase struct {
+	mock.Mock
+}
+
+func (m *MockLoginUseCase) Execute(ctx context.Context, cmd identity.LoginCommand) (*dto.AuthSessionDTO, error) {
+	args := m.Called(ctx, cmd)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.AuthSessionDTO), args.Error(1)
+}
+
+// MockListMyPostsUseCase is a mock implementation of ListMyPostsUseCase.
+type MockListMyPostsUseCase struct {
+	mock.Mock
+}
+
+func (m *MockListMyPostsUseCase) Execute(ctx context.Context, query content.ListMyPostsQuery) (*dto.PostsListDTO, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.PostsListDTO), args.Error(1)
+}
+
+// MockVerificationCodeService is a mock implementation of the verification code service.
+type MockVerificationCodeService struct {
+	mock.Mock
+}
+
+func (m *MockVerificationCodeService) Send(ctx context.Context, cmd verification.SendCommand) (*dto.VerificationCodeDTO, error) {
+	args := m.Called(ctx, cmd)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.VerificationCodeDTO), args.Error(1)
+}
+
+// TestAuthService_Login_Success tests a successful login.
+func TestAuthService_Login_Success(t *testing.T) {
+	// Setup mocks
+	mockLogin := new(MockLoginUseCase)
+
+	// Create service
+	service := grpchandler.NewAuthService(nil, mockLogin, nil, nil, nil)
+
+	// Create context with peer info (for client IP extraction)
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{
+			IP:   net.ParseIP("192.168.1.100"),
+			Port: 12345,
+		},
+	})
+
+	expiresAt := time.Now().Add(15 * time.Minute)
+	session := &dto.AuthSessionDTO{
+		UserID:                "user-1",
+		Email:                 "alice@example.com",
+		AccessToken:           "access-token",
+		AccessTokenExpiresAt:  expiresAt,
+		RefreshToken:          "refresh-token",
+		RefreshTokenExpiresAt: expiresAt.Add(24 * time.Hour),
+	}
+
+	// Setup expectations
+	mockLogin.On("Execute", ctx, identity.LoginCommand{
+		Account:  "alice@example.com",
+		Password: "hunter2hunter2",
+		ClientIP: "192.168.1.100",
+	}).Return(session, nil)
+
+	// Execute
+	resp, err := service.Login(ctx, &authv1.LoginRequest{
+		Account:  "alice@example.com",
+		Password: "hunter2hunter2",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", resp.Session.UserId)
+	assert.Equal(t, "access-token", resp.Session.AccessToken)
+	assert.Equal(t, "refresh-token", resp.Session.RefreshToken)
+	assert.Equal(t, expiresAt.Unix(), resp.Session.AccessTokenExpiresAt)
+	mockLogin.AssertExpectations(t)
+}
+
+// TestAuthService_Login_InvalidCredentials tests that invalid credentials map to Unauthenticated.
+func TestAuthService_Login_InvalidCredentials(t *testing.T) {
+	mockLogin := new(MockLoginUseCase)
+	service := grpchandler.NewAuthService(nil, mockLogin, nil, nil, nil)
+
+	ctx := context.Background()
+	mockLogin.On("Execute", ctx, mock.Anything).Return(nil, apperrors.NewUnauthenticatedError("invalid account or password"))
+
+	resp, err := service.Login(ctx, &authv1.LoginRequest{
+		Account:  "alice@example.com",
+		Password: "wrong-password1",
+	})
+
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.Unauthenticated, st.Code())
+}
+
+// TestAuthService_SendVerificationCode_Success tests sending a verification code.
+func TestAuthService_SendVerificationCode_Success(t *testing.T) {
+	// Setup mocks
+	mockCodes := new(MockVerificationCodeService)
+	service := grpchandler.NewAuthService(nil, nil, nil, nil, mockCodes)
+
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP("192.168.1.100"), Port: 12345},
+	})
+	expiresAt := time.Now().Add(10 * time.Minute)
+
+	mockCodes.On("Send", ctx, verification.SendCommand{
+		Channel:   "EMAIL",
+		Recipient: "alice@example.com",
+		Purpose:   "REGISTER",
+		ClientIP:  "192.168.1.100",
+	}).Return(&dto.VerificationCodeDTO{
+		Channel:            "EMAIL",
+		ExpiresAt:          expiresAt,
+		ResendAfterSeconds: 60,
+	}, nil)
+
+	// Execute
+	resp, err := service.SendVerificationCode(ctx, &authv1.SendVerificationCodeRequest{
+		Channel:   "EMAIL",
+		Recipient: "alice@example.com",
+		Purpose:   "REGISTER",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "EMAIL", resp.Channel)
+	assert.Equal(t, expiresAt.Unix(), resp.ExpiresAt)
+	assert.Equal(t, int32(60), resp.ResendAfterSeconds)
+	mockCodes.AssertExpectations(t)
+}
+
+// TestAuthService_SendVerificationCode_Throttled tests that throttling maps to ResourceExhausted.
+func TestAuthService_SendVerificationCode_Throttled(t *testing.T) {
+	mockCodes := new(MockVerificationCodeService)
+	service := grpchandler.NewAuthService(nil, nil, nil, nil, mockCodes)
+
+	mockCodes.On("Send", mock.Anything, mock.Anything).
+		Return(nil, apperrors.NewRateLimitErrorWithWindow("a verification code was sent recently", 60))
+
+	_, err := service.SendVerificationCode(context.Background(), &authv1.SendVerificationCodeRequest{
+		Channel:   "EMAIL",
+		Recipient: "alice@example.com",
+		Purpose:   "REGISTER",
+	})
+
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+// TestContentService_ListMyPosts_UsesAuthenticatedUser tests that ListMyPosts reads the user from context.
+func TestContentService_ListMyPosts_UsesAuthenticatedUser(t *testing.T) {
+	mockListMy := new(MockListMyPostsUseCase)
+	service := grpchandler.NewContentService(nil, nil, nil, nil, nil, mockListMy, nil, nil, nil, nil)
+
+	ctx := logger.WithUserID(context.Background(), "user-1")
+	mockListMy.On("Execute", ctx, content.ListMyPostsQuery{
+		UserID:   "user-1",
+		Page:     2,
+		PageSize: 10,
+	}).Return(&dto.PostsListDTO{Posts: []*dto.PostDTO{}, Total: 11, Page: 2, PageSize: 10}, nil)
+
+	resp, err := service.ListMyPosts(ctx, &contentv1.ListMyPostsRequest{Page: 2, PageSize: 10})
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(11), resp.Total)
+	mockListMy.AssertExpectations(t)
+}
@@ -0,0 +1,128 @@
+package grpc_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	companyv1 "fuck_boss/backend/api/proto/company/v1"
+	"fuck_boss/backend/internal/application/company"
+	"fuck_boss/backend/internal/application/dto"
+	grpchandler "fuck_boss/backend/internal/presentation/grpc"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// MockRegisterRepresentativeUseCase is a mock implementation of RegisterRepresentativeUseCase.
+type MockRegisterRepresentativeUseCase struct {
+	mock.Mock
+}
+
+func (m *MockRegisterRepresentativeUseCase) Execute(ctx context.Context, cmd company.RegisterRepresentativeCommand) (*dto.RepresentativeDTO, error) {
+	args := m.Called(ctx, cmd)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.RepresentativeDTO), args.Error(1)
+}
+
+// MockPostOfficialReplyUseCase is a mock implementation of PostOfficialReplyUseCase.
+type MockPostOfficialReplyUseCase struct {
+	mock.Mock
+}
+
+func (m *MockPostOfficialReplyUseCase) Execute(ctx context.Context, cmd company.PostOfficialReplyCommand) (*dto.OfficialReplyDTO, error) {
+	args := m.Called(ctx, cmd)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.OfficialReplyDTO), args.Error(1)
+}
+
+// TestCompanyService_RegisterRepresentative_Success tests representative registration.
+func TestCompanyService_RegisterRepresentative_Success(t *testing.T) {
+	// Setup mocks
+	mockRegister := new(MockRegisterRepresentativeUseCase)
+
+	// Create service
+	service := grpchandler.NewCompanyService(mockRegister, nil, nil)
+
+	// Create context with peer info (for client IP extraction)
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{
+			IP:   net.ParseIP("192.168.1.100"),
+			Port: 12345,
+		},
+	})
+
+	// Create expected DTO
+	expectedDTO := &dto.RepresentativeDTO{
+		ID:                   "rep-1",
+		Company:              "测试公司",
+		Domain:               "example.com",
+		Status:               "PENDING",
+		ChallengeMethod:      "DNS_TXT",
+		ChallengeRecordName:  "_fuckboss-challenge.example.com",
+		ChallengeRecordValue: "fuckboss-verification=abc123",
+		ChallengeCode:        "abc123",
+		CreatedAt:            time.Now(),
+		AccessToken:          "raw-token",
+	}
+
+	// Setup expectations
+	mockRegister.On("Execute", ctx, mock.MatchedBy(func(cmd company.RegisterRepresentativeCommand) bool {
+		return cmd.Company == "测试公司" &&
+			cmd.Domain == "example.com" &&
+			cmd.ChallengeMethod == "DNS_TXT" &&
+			cmd.ClientIP == "192.168.1.100"
+	})).Return(expectedDTO, nil)
+
+	// Execute
+	resp, err := service.RegisterRepresentative(ctx, &companyv1.RegisterRepresentativeRequest{
+		Company:         "测试公司",
+		Domain:          "example.com",
+		ChallengeMethod: companyv1.ChallengeMethod_CHALLENGE_METHOD_DNS_TXT,
+	})
+
+	// Assertions
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	assert.Equal(t, "raw-token", resp.AccessToken)
+	assert.Equal(t, "rep-1", resp.Representative.Id)
+	assert.Equal(t, companyv1.RepresentativeStatus_REPRESENTATIVE_STATUS_PENDING, resp.Representative.Status)
+	assert.Equal(t, "fuckboss-verification=abc123", resp.Representative.Challenge.RecordValue)
+	assert.Equal(t, int64(0), resp.Representative.VerifiedAt)
+
+	// Verify mock was called
+	mockRegister.AssertExpectations(t)
+}
+
+// TestCompanyService_PostOfficialReply_Conflict tests the one-reply-per-post error mapping.
+func TestCompanyService_PostOfficialReply_Conflict(t *testing.T) {
+	mockReply := new(MockPostOfficialReplyUseCase)
+	service := grpchandler.NewCompanyService(nil, nil, mockReply)
+
+	ctx := context.Background()
+	mockReply.On("Execute", ctx, mock.AnythingOfType("company.PostOfficialReplyCommand")).
+		Return(nil, apperrors.NewConflictError("post already has an official reply"))
+
+	resp, err := service.PostOfficialReply(ctx, &companyv1.PostOfficialReplyRequest{
+		PostId:           "post-1",
+		RepresentativeId: "rep-1",
+		AccessToken:      "token",
+		Content:          "我们已经核实情况，并已补发全部拖欠工资。",
+	})
+
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.AlreadyExists, st.Code())
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
@@ -19,8 +20,11 @@ import (
 	contentv1 "fuck_boss/backend/api/proto/content/v1"
 	"fuck_boss/backend/internal/application/content"
 	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/application/livefeed"
 	"fuck_boss/backend/internal/application/search"
+	"fuck_boss/backend/internal/infrastructure/logger"
 	grpchandler "fuck_boss/backend/internal/presentation/grpc"
+	"fuck_boss/backend/internal/presentation/middleware"
 	apperrors "fuck_boss/backend/pkg/errors"
 )
 
@@ -76,6 +80,19 @@ func (m *MockSearchPostsUseCase) Execute(ctx context.Context, query search.Searc
 	return args.Get(0).(*dto.PostsListDTO), args.Error(1)
 }
 
+// MockAppendFollowUpUseCase is a mock implementation of AppendFollowUpUseCase.
+type MockAppendFollowUpUseCase struct {
+	mock.Mock
+}
+
+func (m *MockAppendFollowUpUseCase) Execute(ctx context.Context, cmd content.AppendFollowUpCommand) (*dto.FollowUpDTO, error) {
+	args := m.Called(ctx, cmd)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.FollowUpDTO), args.Error(1)
+}
+
 // TestContentService_CreatePost_Success tests successful post creation.
 func TestContentService_CreatePost_Success(t *testing.T) {
 	// Setup mocks
@@ -85,7 +102,7 @@ func TestContentService_CreatePost_Success(t *testing.T) {
 	mockSearch := new(MockSearchPostsUseCase)
 
 	// Create service
-	service := grpchandler.NewContentService(mockCreate, mockList, mockGet, mockSearch)
+	service := grpchandler.NewContentService(mockCreate, mockList, mockGet, mockSearch, nil, nil, nil, nil, nil, nil)
 
 	// Create context with peer info (for client IP extraction)
 	ctx := peer.NewContext(context.Background(), &peer.Peer{
@@ -145,7 +162,7 @@ func TestContentService_CreatePost_WithOccurredAt(t *testing.T) {
 	mockSearch := new(MockSearchPostsUseCase)
 
 	// Create service
-	service := grpchandler.NewContentService(mockCreate, mockList, mockGet, mockSearch)
+	service := grpchandler.NewContentService(mockCreate, mockList, mockGet, mockSearch, nil, nil, nil, nil, nil, nil)
 
 	// Create context
 	ctx := peer.NewContext(context.Background(), &peer.Peer{
@@ -206,7 +223,7 @@ func TestContentService_CreatePost_ValidationError(t *testing.T) {
 	mockSearch := new(MockSearchPostsUseCase)
 
 	// Create service
-	service := grpchandler.NewContentService(mockCreate, mockList, mockGet, mockSearch)
+	service := grpchandler.NewContentService(mockCreate, mockList, mockGet, mockSearch, nil, nil, nil, nil, nil, nil)
 
 	// Create context
 	ctx := peer.NewContext(context.Background(), &peer.Peer{
@@ -254,7 +271,7 @@ func TestContentService_CreatePost_RateLimitError(t *testing.T) {
 	mockSearch := new(MockSearchPostsUseCase)
 
 	// Create service
-	service := grpchandler.NewContentService(mockCreate, mockList, mockGet, mockSearch)
+	service := grpchandler.NewContentService(mockCreate, mockList, mockGet, mockSearch, nil, nil, nil, nil, nil, nil)
 
 	// Create context
 	ctx := peer.NewContext(context.Background(), &peer.Peer{
@@ -302,7 +319,7 @@ func TestContentService_ListPosts_Success(t *testing.T) {
 	mockSearch := new(MockSearchPostsUseCase)
 
 	// Create service
-	service := grpchandler.NewContentService(mockCreate, mockList, mockGet, mockSearch)
+	service := grpchandler.NewContentService(mockCreate, mockList, mockGet, mockSearch, nil, nil, nil, nil, nil, nil)
 
 	// Create context
 	ctx := context.Background()
@@ -363,7 +380,7 @@ func TestContentService_GetPost_Success(t *testing.T) {
 	mockSearch := new(MockSearchPostsUseCase)
 
 	// Create service
-	service := grpchandler.NewContentService(mockCreate, mockList, mockGet, mockSearch)
+	service := grpchandler.NewContentService(mockCreate, mockList, mockGet, mockSearch, nil, nil, nil, nil, nil, nil)
 
 	// Create context
 	ctx := context.Background()
@@ -412,7 +429,7 @@ func TestContentService_GetPost_NotFound(t *testing.T) {
 	mockSearch := new(MockSearchPostsUseCase)
 
 	// Create service
-	service := grpchandler.NewContentService(mockCreate, mockList, mockGet, mockSearch)
+	service := grpchandler.NewContentService(mockCreate, mockList, mockGet, mockSearch, nil, nil, nil, nil, nil, nil)
 
 	// Create context
 	ctx := context.Background()
@@ -451,7 +468,7 @@ func TestContentService_SearchPosts_Success(t *testing.T) {
 	mockSearch := new(MockSearchPostsUseCase)
 
 	// Create service
-	service := grpchandler.NewContentService(mockCreate, mockList, mockGet, mockSearch)
+	service := grpchandler.NewContentService(mockCreate, mockList, mockGet, mockSearch, nil, nil, nil, nil, nil, nil)
 
 	// Create context
 	ctx := context.Background()
@@ -511,7 +528,7 @@ func TestContentService_SearchPosts_WithoutCity(t *testing.T) {
 	mockSearch := new(MockSearchPostsUseCase)
 
 	// Create service
-	service := grpchandler.NewContentService(mockCreate, mockList, mockGet, mockSearch)
+	service := grpchandler.NewContentService(mockCreate, mockList, mockGet, mockSearch, nil, nil, nil, nil, nil, nil)
 
 	// Create context
 	ctx := context.Background()
@@ -576,7 +593,7 @@ func TestContentService_ErrorConversion(t *testing.T) {
 					},
 				})
 				mockCreate.On("Execute", createCtx, mock.Anything).Return(nil, apperrors.NewValidationError("validation failed"))
-				s = grpchandler.NewContentService(mockCreate, nil, nil, nil)
+				s = grpchandler.NewContentService(mockCreate, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 				return s.CreatePost(createCtx, &contentv1.CreatePostRequest{
 					Company:  "test",
 					CityCode: "beijing",
@@ -593,7 +610,7 @@ func TestContentService_ErrorConversion(t *testing.T) {
 			handler: func(s *grpchandler.ContentService, ctx context.Context) (interface{}, error) {
 				mockGet := new(MockGetPostUseCase)
 				mockGet.On("Execute", ctx, "test-id").Return(nil, apperrors.NewNotFoundError("not found"))
-				s = grpchandler.NewContentService(nil, nil, mockGet, nil)
+				s = grpchandler.NewContentService(nil, nil, mockGet, nil, nil, nil, nil, nil, nil, nil)
 				return s.GetPost(ctx, &contentv1.GetPostRequest{PostId: "test-id"})
 			},
 		},
@@ -612,7 +629,7 @@ func TestContentService_ErrorConversion(t *testing.T) {
 					},
 				})
 				mockCreate.On("Execute", createCtx, mock.Anything).Return(nil, apperrors.NewRateLimitError("rate limit exceeded"))
-				s = grpchandler.NewContentService(mockCreate, nil, nil, nil)
+				s = grpchandler.NewContentService(mockCreate, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 				return s.CreatePost(createCtx, &contentv1.CreatePostRequest{
 					Company:  "test",
 					CityCode: "beijing",
@@ -629,16 +646,28 @@ func TestContentService_ErrorConversion(t *testing.T) {
 			handler: func(s *grpchandler.ContentService, ctx context.Context) (interface{}, error) {
 				mockGet := new(MockGetPostUseCase)
 				mockGet.On("Execute", ctx, "test-id").Return(nil, apperrors.NewDatabaseError("database error"))
-				s = grpchandler.NewContentService(nil, nil, mockGet, nil)
+				s = grpchandler.NewContentService(nil, nil, mockGet, nil, nil, nil, nil, nil, nil, nil)
 				return s.GetPost(ctx, &contentv1.GetPostRequest{PostId: "test-id"})
 			},
 		},
+		{
+			name:          "forbidden error",
+			appError:      apperrors.NewForbiddenError("management token does not match post"),
+			expectedCode:  codes.PermissionDenied,
+			expectedInMsg: "management token does not match post",
+			handler: func(s *grpchandler.ContentService, ctx context.Context) (interface{}, error) {
+				mockFollowUp := new(MockAppendFollowUpUseCase)
+				mockFollowUp.On("Execute", ctx, mock.Anything).Return(nil, apperrors.NewForbiddenError("management token does not match post"))
+				s = grpchandler.NewContentService(nil, nil, nil, nil, mockFollowUp, nil, nil, nil, nil, nil)
+				return s.AppendFollowUp(ctx, &contentv1.AppendFollowUpRequest{PostId: "test-id"})
+			},
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			ctx := context.Background()
-			service := grpchandler.NewContentService(nil, nil, nil, nil)
+			service := grpchandler.NewContentService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			_, err := tc.handler(service, ctx)
 
@@ -669,18 +698,21 @@ func TestContentService_ExtractClientIP(t *testing.T) {
 			expected: "192.168.1.100",
 		},
 		{
-			name: "from metadata X-Forwarded-For",
-			ctx: metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{
-				"x-forwarded-for": "10.0.0.1",
-			})),
-			expected: "10.0.0.1",
+			name: "resolved by the client IP interceptor",
+			ctx: logger.WithClientIP(peer.NewContext(context.Background(), &peer.Peer{
+				Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 12345},
+			}), "203.0.113.7"),
+			expected: "203.0.113.7",
 		},
 		{
-			name: "from metadata X-Real-IP",
-			ctx: metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{
-				"x-real-ip": "10.0.0.2",
+			name: "forwarding headers ignored",
+			ctx: metadata.NewIncomingContext(peer.NewContext(context.Background(), &peer.Peer{
+				Addr: &net.TCPAddr{IP: net.ParseIP("192.168.1.100"), Port: 12345},
+			}), metadata.New(map[string]string{
+				"x-forwarded-for": "10.0.0.1",
+				"x-real-ip":       "10.0.0.2",
 			})),
-			expected: "10.0.0.2",
+			expected: "192.168.1.100",
 		},
 		{
 			name:     "default fallback",
@@ -697,7 +729,7 @@ func TestContentService_ExtractClientIP(t *testing.T) {
 			mockGet := new(MockGetPostUseCase)
 			mockSearch := new(MockSearchPostsUseCase)
 
-			service := grpchandler.NewContentService(mockCreate, mockList, mockGet, mockSearch)
+			service := grpchandler.NewContentService(mockCreate, mockList, mockGet, mockSearch, nil, nil, nil, nil, nil, nil)
 
 			req := &contentv1.CreatePostRequest{
 				Company:  "测试公司",
@@ -728,3 +760,309 @@ func TestContentService_ExtractClientIP(t *testing.T) {
 		})
 	}
 }
+
+// TestContentService_AppendFollowUp_Success tests appending a follow-up.
+func TestContentService_AppendFollowUp_Success(t *testing.T) {
+	mockFollowUp := new(MockAppendFollowUpUseCase)
+	service := grpchandler.NewContentService(nil, nil, nil, nil, mockFollowUp, nil, nil, nil, nil, nil)
+
+	ctx := context.Background()
+	now := time.Now()
+
+	// Setup expectations: the proto enum is converted to the domain string
+	mockFollowUp.On("Execute", ctx, content.AppendFollowUpCommand{
+		PostID:          "test-id",
+		ManagementToken: "token",
+		Status:          "RESOLVED",
+		Note:            "公司已补发工资",
+	}).Return(&dto.FollowUpDTO{
+		ID:        "follow-up-id",
+		Status:    "RESOLVED",
+		Note:      "公司已补发工资",
+		CreatedAt: now,
+	}, nil)
+
+	// Execute
+	resp, err := service.AppendFollowUp(ctx, &contentv1.AppendFollowUpRequest{
+		PostId:          "test-id",
+		ManagementToken: "token",
+		Status:          contentv1.ResolutionStatus_RESOLUTION_STATUS_RESOLVED,
+		Note:            "公司已补发工资",
+	})
+
+	// Assertions
+	require.NoError(t, err)
+	require.NotNil(t, resp.FollowUp)
+	assert.Equal(t, "follow-up-id", resp.FollowUp.Id)
+	assert.Equal(t, contentv1.ResolutionStatus_RESOLUTION_STATUS_RESOLVED, resp.FollowUp.Status)
+	assert.Equal(t, now.Unix(), resp.FollowUp.CreatedAt)
+
+	mockFollowUp.AssertExpectations(t)
+}
+
+// MockRecordViewUseCase is a mock implementation of RecordViewUseCase.
+type MockRecordViewUseCase struct {
+	mock.Mock
+}
+
+func (m *MockRecordViewUseCase) Execute(ctx context.Context, cmd content.RecordViewCommand) error {
+	args := m.Called(ctx, cmd)
+	return args.Error(0)
+}
+
+// MockListTrendingPostsUseCase is a mock implementation of ListTrendingPostsUseCase.
+type MockListTrendingPostsUseCase struct {
+	mock.Mock
+}
+
+func (m *MockListTrendingPostsUseCase) Execute(ctx context.Context, query content.ListTrendingPostsQuery) (*dto.PostsListDTO, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.PostsListDTO), args.Error(1)
+}
+
+// TestContentService_GetPost_RecordsView tests that GetPost counts the view
+// and that a counting failure does not fail the request.
+func TestContentService_GetPost_RecordsView(t *testing.T) {
+	// Setup mocks
+	mockGet := new(MockGetPostUseCase)
+	mockView := new(MockRecordViewUseCase)
+
+	// Create service
+	service := grpchandler.NewContentService(nil, nil, mockGet, nil, nil, nil, mockView, nil, nil, nil)
+
+	// Create context with a client address
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 1234},
+	})
+	postID := "123e4567-e89b-12d3-a456-426614174000"
+
+	// Setup expectations
+	mockGet.On("Execute", ctx, postID).Return(&dto.PostDTO{ID: postID, CreatedAt: time.Now()}, nil)
+	mockView.On("Execute", ctx, content.RecordViewCommand{PostID: postID, ClientIP: "10.0.0.1"}).
+		Return(apperrors.NewDatabaseError("redis down"))
+
+	// Execute
+	resp, err := service.GetPost(ctx, &contentv1.GetPostRequest{PostId: postID})
+
+	// Assertions
+	require.NoError(t, err)
+	assert.Equal(t, postID, resp.Post.Id)
+
+	// Verify mocks were called
+	mockGet.AssertExpectations(t)
+	mockView.AssertExpectations(t)
+}
+
+// viewerSet is a ranking.ViewCounter that records the distinct viewers.
+type viewerSet map[string]bool
+
+func (v viewerSet) Add(ctx context.Context, postID string, viewer string, at time.Time) error {
+	v[viewer] = true
+	return nil
+}
+
+func (v viewerSet) Counts(ctx context.Context, at time.Time) (map[string]int64, error) {
+	return map[string]int64{}, nil
+}
+
+// TestContentService_GetPost_SpoofedForwardingHeaders tests that forwarding
+// headers sent by an untrusted peer do not create new viewers.
+func TestContentService_GetPost_SpoofedForwardingHeaders(t *testing.T) {
+	mockGet := new(MockGetPostUseCase)
+	viewers := viewerSet{}
+	service := grpchandler.NewContentService(nil, nil, mockGet, nil, nil, nil, content.NewRecordViewUseCase(viewers), nil, nil, nil)
+	interceptor := middleware.ClientIPInterceptor(nil)
+
+	postID := "123e4567-e89b-12d3-a456-426614174000"
+	mockGet.On("Execute", mock.Anything, postID).Return(&dto.PostDTO{ID: postID, CreatedAt: time.Now()}, nil)
+
+	for i, forwardedFor := range []string{"1.1.1.1", "2.2.2.2, 3.3.3.3", "not-an-ip"} {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{
+			Addr: &net.TCPAddr{IP: net.ParseIP("203.0.113.7"), Port: 40000 + i},
+		})
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", forwardedFor, "x-real-ip", forwardedFor))
+
+		_, err := interceptor(ctx, &contentv1.GetPostRequest{PostId: postID}, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return service.GetPost(ctx, req.(*contentv1.GetPostRequest))
+		})
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, viewerSet{"ip:203.0.113.7": true}, viewers)
+}
+
+// TestContentService_ListTrendingPosts_Success tests listing trending posts.
+func TestContentService_ListTrendingPosts_Success(t *testing.T) {
+	// Setup mocks
+	mockTrending := new(MockListTrendingPostsUseCase)
+
+	// Create service
+	service := grpchandler.NewContentService(nil, nil, nil, nil, nil, nil, nil, mockTrending, nil, nil)
+
+	ctx := context.Background()
+	req := &contentv1.ListTrendingPostsRequest{
+		CityCode: "beijing",
+		Page:     2,
+		PageSize: 10,
+		View:     contentv1.PostView_POST_VIEW_BASIC,
+	}
+
+	// Setup expectations
+	mockTrending.On("Execute", ctx, content.ListTrendingPostsQuery{
+		CityCode: "beijing",
+		Page:     2,
+		PageSize: 10,
+		View:     dto.PostViewBasic,
+	}).Return(&dto.PostsListDTO{
+		Posts:    []*dto.PostDTO{{ID: "post-1", Summary: "摘要", CreatedAt: time.Now()}},
+		Total:    11,
+		Page:     2,
+		PageSize: 10,
+	}, nil)
+
+	// Execute
+	resp, err := service.ListTrendingPosts(ctx, req)
+
+	// Assertions
+	require.NoError(t, err)
+	require.Len(t, resp.Posts, 1)
+	assert.Equal(t, "post-1", resp.Posts[0].Id)
+	assert.Equal(t, "摘要", resp.Posts[0].Summary)
+	assert.Equal(t, int32(11), resp.Total)
+
+	// Verify mock was called
+	mockTrending.AssertExpectations(t)
+}
+
+// MockListRelatedPostsUseCase is a mock implementation of ListRelatedPostsUseCase.
+type MockListRelatedPostsUseCase struct {
+	mock.Mock
+}
+
+func (m *MockListRelatedPostsUseCase) Execute(ctx context.Context, query content.ListRelatedPostsQuery) (*dto.PostsListDTO, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.PostsListDTO), args.Error(1)
+}
+
+// TestContentService_ListRelatedPosts tests listing related posts and error conversion.
+func TestContentService_ListRelatedPosts(t *testing.T) {
+	// Setup mocks
+	mockRelated := new(MockListRelatedPostsUseCase)
+
+	// Create service
+	service := grpchandler.NewContentService(nil, nil, nil, nil, nil, nil, nil, nil, mockRelated, nil)
+
+	ctx := context.Background()
+
+	// Setup expectations
+	mockRelated.On("Execute", ctx, content.ListRelatedPostsQuery{
+		PostID: "post-1",
+		Limit:  3,
+		View:   dto.PostViewBasic,
+	}).Return(&dto.PostsListDTO{
+		Posts:    []*dto.PostDTO{{ID: "post-2", CreatedAt: time.Now()}},
+		Total:    1,
+		Page:     1,
+		PageSize: 3,
+	}, nil)
+	mockRelated.On("Execute", ctx, content.ListRelatedPostsQuery{PostID: "missing"}).
+		Return(nil, apperrors.NewNotFoundError("post"))
+
+	// Execute
+	resp, err := service.ListRelatedPosts(ctx, &contentv1.ListRelatedPostsRequest{
+		PostId: "post-1",
+		Limit:  3,
+		View:   contentv1.PostView_POST_VIEW_BASIC,
+	})
+
+	// Assertions
+	require.NoError(t, err)
+	require.Len(t, resp.Posts, 1)
+	assert.Equal(t, "post-2", resp.Posts[0].Id)
+	assert.Equal(t, int32(1), resp.Total)
+
+	_, err = service.ListRelatedPosts(ctx, &contentv1.ListRelatedPostsRequest{PostId: "missing"})
+	require.Error(t, err)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Verify mock was called
+	mockRelated.AssertExpectations(t)
+}
+
+// MockWatchPostsUseCase is a mock implementation of WatchPostsUseCase.
+type MockWatchPostsUseCase struct {
+	mock.Mock
+	events []*livefeed.Event
+}
+
+func (m *MockWatchPostsUseCase) Execute(ctx context.Context, query content.WatchPostsQuery, send func(*livefeed.Event) error) error {
+	args := m.Called(ctx, query)
+	for _, event := range m.events {
+		if err := send(event); err != nil {
+			return err
+		}
+	}
+	return args.Error(0)
+}
+
+// fakeWatchPostsStream records the responses sent on a WatchPosts stream.
+type fakeWatchPostsStream struct {
+	contentv1.ContentService_WatchPostsServer
+	ctx  context.Context
+	sent []*contentv1.WatchPostsResponse
+}
+
+func (s *fakeWatchPostsStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeWatchPostsStream) Send(resp *contentv1.WatchPostsResponse) error {
+	s.sent = append(s.sent, resp)
+	return nil
+}
+
+// TestContentService_WatchPosts tests streaming posts and error conversion.
+func TestContentService_WatchPosts(t *testing.T) {
+	// Setup mocks
+	mockWatch := &MockWatchPostsUseCase{
+		events: []*livefeed.Event{
+			{ID: "1-0", Post: &dto.PostDTO{ID: "post-1", CityCode: "beijing", CreatedAt: time.Now()}},
+			{ID: "2-0", Post: &dto.PostDTO{ID: "post-2", CityCode: "beijing", CreatedAt: time.Now()}},
+		},
+	}
+
+	// Create service
+	service := grpchandler.NewContentService(nil, nil, nil, nil, nil, nil, nil, nil, nil, mockWatch)
+
+	stream := &fakeWatchPostsStream{ctx: context.Background()}
+
+	// Setup expectations: the feed is interrupted after two posts
+	mockWatch.On("Execute", stream.ctx, content.WatchPostsQuery{
+		CityCode: "beijing",
+		Company:  "某某科技",
+		View:     dto.PostViewBasic,
+	}).Return(apperrors.NewUnavailableError("live feed interrupted"))
+
+	// Execute
+	err := service.WatchPosts(&contentv1.WatchPostsRequest{
+		CityCode: "beijing",
+		Company:  "某某科技",
+		View:     contentv1.PostView_POST_VIEW_BASIC,
+	}, stream)
+
+	// Assertions
+	require.Error(t, err)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	require.Len(t, stream.sent, 2)
+	assert.Equal(t, "post-1", stream.sent[0].Post.Id)
+	assert.Equal(t, "post-2", stream.sent[1].Post.Id)
+
+	// Verify mock was called
+	mockWatch.AssertExpectations(t)
+}
@@ -0,0 +1,85 @@
+package grpc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	notificationv1 "fuck_boss/backend/api/proto/notification/v1"
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/application/notification"
+	"fuck_boss/backend/internal/infrastructure/logger"
+	grpchandler "fuck_boss/backend/internal/presentation/grpc"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// MockListNotificationsUseCase is a mock implementation of ListNotificationsUseCase.
+type MockListNotificationsUseCase struct {
+	mock.Mock
+}
+
+func (m *MockListNotificationsUseCase) Execute(ctx context.Context, query notification.ListNotificationsQuery) (*dto.NotificationsListDTO, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.NotificationsListDTO), args.Error(1)
+}
+
+// TestNotificationService_ListNotifications tests request and response conversion.
+func TestNotificationService_ListNotifications(t *testing.T) {
+	mockList := new(MockListNotificationsUseCase)
+	service := grpchandler.NewNotificationService(mockList, nil, nil, nil)
+
+	ctx := logger.WithUserID(context.Background(), "user-1")
+	createdAt := time.Unix(1700000000, 0)
+	readAt := time.Unix(1700000100, 0)
+
+	mockList.On("Execute", ctx, notification.ListNotificationsQuery{
+		UserID:     "user-1",
+		Cursor:     "cursor",
+		PageSize:   10,
+		UnreadOnly: true,
+		Type:       "COMPANY_REPLY",
+	}).Return(&dto.NotificationsListDTO{
+		Notifications: []*dto.NotificationDTO{
+			{ID: "n-1", Type: "COMPANY_REPLY", PostID: "post-1", Subject: "测试公司", Excerpt: "回复", CreatedAt: createdAt, ReadAt: &readAt},
+		},
+		NextCursor:  "next",
+		UnreadCount: 3,
+	}, nil)
+
+	resp, err := service.ListNotifications(ctx, &notificationv1.ListNotificationsRequest{
+		Cursor:     "cursor",
+		PageSize:   10,
+		UnreadOnly: true,
+		Type:       notificationv1.NotificationType_NOTIFICATION_TYPE_COMPANY_REPLY,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Notifications, 1)
+	assert.Equal(t, notificationv1.NotificationType_NOTIFICATION_TYPE_COMPANY_REPLY, resp.Notifications[0].Type)
+	assert.Equal(t, createdAt.Unix(), resp.Notifications[0].CreatedAt)
+	assert.Equal(t, readAt.Unix(), resp.Notifications[0].ReadAt)
+	assert.Equal(t, "next", resp.NextCursor)
+	assert.Equal(t, int32(3), resp.UnreadCount)
+}
+
+// TestNotificationService_ListNotifications_Unauthenticated tests the error mapping for anonymous requests.
+func TestNotificationService_ListNotifications_Unauthenticated(t *testing.T) {
+	mockList := new(MockListNotificationsUseCase)
+	service := grpchandler.NewNotificationService(mockList, nil, nil, nil)
+
+	mockList.On("Execute", mock.Anything, mock.Anything).Return(nil, apperrors.NewUnauthenticatedError("login required"))
+
+	resp, err := service.ListNotifications(context.Background(), &notificationv1.ListNotificationsRequest{})
+
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
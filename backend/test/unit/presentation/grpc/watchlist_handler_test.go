@@ -0,0 +1,106 @@
+package grpc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	watchlistv1 "fuck_boss/backend/api/proto/watchlist/v1"
+	"fuck_boss/backend/internal/application/dto"
+	"fuck_boss/backend/internal/application/watchlist"
+	"fuck_boss/backend/internal/infrastructure/logger"
+	grpchandler "fuck_boss/backend/internal/presentation/grpc"
+	apperrors "fuck_boss/backend/pkg/errors"
+)
+
+// MockBookmarkUseCase is a mock implementation of AddBookmarkUseCase and RemoveBookmarkUseCase.
+type MockBookmarkUseCase struct {
+	mock.Mock
+}
+
+func (m *MockBookmarkUseCase) Execute(ctx context.Context, cmd watchlist.BookmarkCommand) error {
+	args := m.Called(ctx, cmd)
+	return args.Error(0)
+}
+
+// MockListWatchedPostsUseCase is a mock implementation of ListWatchedPostsUseCase.
+type MockListWatchedPostsUseCase struct {
+	mock.Mock
+}
+
+func (m *MockListWatchedPostsUseCase) Execute(ctx context.Context, query watchlist.ListWatchedPostsQuery) (*dto.WatchedPostsDTO, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.WatchedPostsDTO), args.Error(1)
+}
+
+// TestWatchlistService_AddBookmark_UserFromContext tests that the authenticated user owns the bookmark.
+func TestWatchlistService_AddBookmark_UserFromContext(t *testing.T) {
+	mockAdd := new(MockBookmarkUseCase)
+	service := grpchandler.NewWatchlistService(nil, mockAdd, nil, nil, nil, nil, nil, nil)
+
+	ctx := logger.WithUserID(context.Background(), "user-1")
+	mockAdd.On("Execute", ctx, watchlist.BookmarkCommand{
+		UserID:      "user-1",
+		DeviceToken: "device-token",
+		PostID:      "post-1",
+	}).Return(nil)
+
+	resp, err := service.AddBookmark(ctx, &watchlistv1.AddBookmarkRequest{
+		DeviceToken: "device-token",
+		PostId:      "post-1",
+	})
+
+	require.NoError(t, err)
+	assert.NotNil(t, resp)
+	mockAdd.AssertExpectations(t)
+}
+
+// TestWatchlistService_AddBookmark_Unauthenticated tests the error mapping for a missing owner.
+func TestWatchlistService_AddBookmark_Unauthenticated(t *testing.T) {
+	mockAdd := new(MockBookmarkUseCase)
+	service := grpchandler.NewWatchlistService(nil, mockAdd, nil, nil, nil, nil, nil, nil)
+
+	mockAdd.On("Execute", mock.Anything, mock.Anything).Return(apperrors.NewUnauthenticatedError("login or device token required"))
+
+	resp, err := service.AddBookmark(context.Background(), &watchlistv1.AddBookmarkRequest{PostId: "post-1"})
+
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+// TestWatchlistService_ListWatchedPosts_Since tests the since conversion in both directions.
+func TestWatchlistService_ListWatchedPosts_Since(t *testing.T) {
+	mockList := new(MockListWatchedPostsUseCase)
+	service := grpchandler.NewWatchlistService(nil, nil, nil, nil, nil, nil, nil, mockList)
+
+	since := time.Unix(1700000000, 0)
+	mockList.On("Execute", mock.Anything, mock.MatchedBy(func(query watchlist.ListWatchedPostsQuery) bool {
+		return query.Since != nil && query.Since.Equal(since) && query.MarkVisited && query.DeviceToken == "device-token"
+	})).Return(&dto.WatchedPostsDTO{
+		Posts:    []*dto.PostDTO{{ID: "post-1", Company: "测试公司", CreatedAt: since.Add(time.Minute)}},
+		Total:    1,
+		Page:     1,
+		PageSize: 20,
+		Since:    since,
+	}, nil)
+
+	resp, err := service.ListWatchedPosts(context.Background(), &watchlistv1.ListWatchedPostsRequest{
+		DeviceToken: "device-token",
+		Since:       since.Unix(),
+		MarkVisited: true,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, since.Unix(), resp.Since)
+	require.Len(t, resp.Posts, 1)
+	assert.Equal(t, "post-1", resp.Posts[0].Id)
+}
@@ -0,0 +1,112 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"fuck_boss/backend/internal/infrastructure/logger"
+	"fuck_boss/backend/internal/presentation/middleware"
+)
+
+// stubParser accepts only the token "valid" and maps it to "user-1".
+type stubParser struct{}
+
+func (stubParser) ParseAccessToken(token string) (string, error) {
+	if token != "valid" {
+		return "", errors.New("invalid token")
+	}
+	return "user-1", nil
+}
+
+const protectedMethod = "/content.v1.ContentService/ListMyPosts"
+
+func newTestLogger(t *testing.T) logger.Logger {
+	t.Helper()
+	log, err := logger.NewLogger("development", nil)
+	require.NoError(t, err)
+	return log
+}
+
+// echoUserID is a unary handler that returns the user ID found in context.
+func echoUserID(ctx context.Context, req interface{}) (interface{}, error) {
+	return logger.UserIDFromContext(ctx), nil
+}
+
+func TestAuthInterceptor(t *testing.T) {
+	interceptor := middleware.AuthInterceptor(stubParser{}, newTestLogger(t), protectedMethod)
+
+	tests := []struct {
+		name          string
+		authorization string
+		method        string
+		wantUserID    string
+		wantCode      codes.Code
+	}{
+		{name: "anonymous public method", method: "/content.v1.ContentService/CreatePost", wantCode: codes.OK},
+		{name: "anonymous protected method", method: protectedMethod, wantCode: codes.Unauthenticated},
+		{name: "valid token", authorization: "Bearer valid", method: protectedMethod, wantUserID: "user-1", wantCode: codes.OK},
+		{name: "lower-case scheme", authorization: "bearer valid", method: protectedMethod, wantUserID: "user-1", wantCode: codes.OK},
+		{name: "invalid token on public method", authorization: "Bearer expired", method: "/content.v1.ContentService/CreatePost", wantCode: codes.Unauthenticated},
+		{name: "wrong scheme", authorization: "Basic dXNlcjpwYXNz", method: protectedMethod, wantCode: codes.Unauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.authorization != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", tt.authorization))
+			}
+
+			resp, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, echoUserID)
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode == codes.OK {
+				assert.Equal(t, tt.wantUserID, resp)
+			}
+		})
+	}
+}
+
+func TestAuthMiddleware(t *testing.T) {
+	handler := middleware.AuthMiddleware(stubParser{})(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(logger.UserIDFromContext(r.Context())))
+	})
+
+	tests := []struct {
+		name          string
+		authorization string
+		wantStatus    int
+		wantBody      string
+	}{
+		{name: "anonymous", wantStatus: http.StatusOK, wantBody: ""},
+		{name: "valid token", authorization: "Bearer valid", wantStatus: http.StatusOK, wantBody: "user-1"},
+		{name: "invalid token", authorization: "Bearer expired", wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/me/posts", nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			rec := httptest.NewRecorder()
+
+			handler(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus == http.StatusOK {
+				assert.Equal(t, tt.wantBody, rec.Body.String())
+			}
+		})
+	}
+}
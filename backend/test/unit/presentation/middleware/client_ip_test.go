@@ -0,0 +1,110 @@
+package middleware_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"fuck_boss/backend/internal/infrastructure/logger"
+	"fuck_boss/backend/internal/presentation/middleware"
+)
+
+func TestParseTrustedProxies_Invalid(t *testing.T) {
+	_, err := middleware.ParseTrustedProxies([]string{"10.0.0.0/8", "not-an-ip"})
+	assert.Error(t, err)
+}
+
+func TestTrustedProxies_HTTPClientIP(t *testing.T) {
+	proxies, err := middleware.ParseTrustedProxies([]string{"10.0.0.0/8", "127.0.0.1"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		remoteAddr   string
+		forwardedFor string
+		realIP       string
+		want         string
+	}{
+		{"untrusted peer ignores headers", "203.0.113.7:5000", "1.2.3.4", "5.6.7.8", "203.0.113.7"},
+		{"trusted proxy forwards client", "10.0.0.2:5000", "198.51.100.1", "", "198.51.100.1"},
+		{"spoofed left entries are skipped", "127.0.0.1:5000", "1.2.3.4, 198.51.100.1, 10.0.0.3", "", "198.51.100.1"},
+		{"malformed hop falls back to peer", "10.0.0.2:5000", "1.2.3.4, bogus", "", "10.0.0.2"},
+		{"real IP without forwarded for", "10.0.0.2:5000", "", "198.51.100.9", "198.51.100.9"},
+		{"only trusted hops", "10.0.0.2:5000", "10.0.0.5", "", "10.0.0.2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/api/admin/export", nil)
+			r.RemoteAddr = tt.remoteAddr
+			if tt.forwardedFor != "" {
+				r.Header.Set("X-Forwarded-For", tt.forwardedFor)
+			}
+			if tt.realIP != "" {
+				r.Header.Set("X-Real-IP", tt.realIP)
+			}
+			assert.Equal(t, tt.want, proxies.HTTPClientIP(r))
+		})
+	}
+}
+
+func TestTrustedProxies_GRPCClientIP(t *testing.T) {
+	md := metadata.Pairs("x-forwarded-for", "198.51.100.1")
+	peerCtx := func(ip string) context.Context {
+		return peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 5000}})
+	}
+
+	// No proxy is trusted by default
+	var none middleware.TrustedProxies
+	assert.Equal(t, "203.0.113.7", none.GRPCClientIP(peerCtx("203.0.113.7"), md))
+
+	proxies, err := middleware.ParseTrustedProxies([]string{"10.0.0.0/8"})
+	require.NoError(t, err)
+	assert.Equal(t, "198.51.100.1", proxies.GRPCClientIP(peerCtx("10.1.2.3"), md))
+	assert.Equal(t, "203.0.113.7", proxies.GRPCClientIP(peerCtx("203.0.113.7"), md))
+}
+
+func TestClientIPHandler(t *testing.T) {
+	proxies, err := middleware.ParseTrustedProxies([]string{"10.0.0.0/8"})
+	require.NoError(t, err)
+
+	var got string
+	handler := middleware.ClientIPHandler(proxies, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = logger.ClientIPFromContext(r.Context())
+	}))
+
+	r := httptest.NewRequest("GET", "/api/posts", nil)
+	r.RemoteAddr = "203.0.113.7:5000"
+	r.Header.Set("X-Forwarded-For", "1.2.3.4")
+	handler.ServeHTTP(httptest.NewRecorder(), r)
+	assert.Equal(t, "203.0.113.7", got)
+
+	r = httptest.NewRequest("GET", "/api/posts", nil)
+	r.RemoteAddr = "10.0.0.2:5000"
+	r.Header.Set("X-Forwarded-For", "198.51.100.1")
+	handler.ServeHTTP(httptest.NewRecorder(), r)
+	assert.Equal(t, "198.51.100.1", got)
+}
+
+func TestClientIPInterceptor(t *testing.T) {
+	proxies, err := middleware.ParseTrustedProxies([]string{"10.0.0.0/8"})
+	require.NoError(t, err)
+
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("203.0.113.7"), Port: 5000}})
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", "1.2.3.4"))
+
+	var got string
+	_, err = middleware.ClientIPInterceptor(proxies)(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		got = logger.ClientIPFromContext(ctx)
+		return nil, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "203.0.113.7", got)
+}
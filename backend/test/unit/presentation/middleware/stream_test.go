@@ -0,0 +1,61 @@
+package middleware_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"fuck_boss/backend/internal/infrastructure/logger"
+	"fuck_boss/backend/internal/presentation/middleware"
+)
+
+const streamMethod = "/content.v1.ContentService/WatchPosts"
+
+// fakeServerStream is a grpc.ServerStream that records the messages sent.
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []interface{}
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeServerStream) SendMsg(m interface{}) error {
+	s.sent = append(s.sent, m)
+	return nil
+}
+
+func TestStreamRecoveryInterceptor(t *testing.T) {
+	interceptor := middleware.StreamRecoveryInterceptor(newTestLogger(t))
+	stream := &fakeServerStream{ctx: context.Background()}
+
+	err := interceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: streamMethod}, func(srv interface{}, ss grpc.ServerStream) error {
+		panic("boom")
+	})
+
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestStreamLoggingInterceptor(t *testing.T) {
+	interceptor := middleware.StreamLoggingInterceptor(newTestLogger(t))
+	stream := &fakeServerStream{ctx: logger.WithUserID(context.Background(), "user-1")}
+
+	err := interceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: streamMethod, IsServerStream: true}, func(srv interface{}, ss grpc.ServerStream) error {
+		// Values set by earlier interceptors are kept
+		assert.Equal(t, "user-1", logger.UserIDFromContext(ss.Context()))
+
+		require.NoError(t, ss.SendMsg("first"))
+		require.NoError(t, ss.SendMsg("second"))
+		return status.Error(codes.Unavailable, "live feed interrupted")
+	})
+
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, []interface{}{"first", "second"}, stream.sent)
+}
@@ -7,7 +7,7 @@ import type { Post, PostListResponse, CreatePostRequest, SearchRequest, SearchRe
 // Content Service client interface
 export interface ContentServiceClient {
   createPost(request: CreatePostRequest): Promise<{ postId: string; createdAt: number }>
-  listPosts(cityCode: string, page: number, pageSize: number): Promise<PostListResponse>
+  listPosts(cityCode: string, page: number, pageSize: number, company?: string): Promise<PostListResponse>
   getPost(postId: string): Promise<Post>
   searchPosts(request: SearchRequest): Promise<SearchResponse>
 }
@@ -99,13 +99,13 @@ class GrpcWebContentServiceClient implements ContentServiceClient {
     )
   }
 
-  async listPosts(cityCode: string, page: number, pageSize: number): Promise<PostListResponse> {
+  async listPosts(cityCode: string, page: number, pageSize: number, company?: string): Promise<PostListResponse> {
     return this.call<
-      { cityCode: string; page: number; pageSize: number },
+      { cityCode: string; page: number; pageSize: number; company?: string },
       PostListResponse
     >(
       '/api/posts',
-      { cityCode: cityCode || '', page, pageSize },
+      { cityCode: cityCode || '', page, pageSize, company },
       'GET'
     )
   }
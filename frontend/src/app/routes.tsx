@@ -4,6 +4,7 @@ import { AppHeader } from '@/shared/components/AppHeader'
 import { HomePage } from '@/features/post/pages/HomePage'
 import { CreatePostPage } from '@/features/post/pages/CreatePostPage'
 import { PostDetailPage } from '@/features/post/pages/PostDetailPage'
+import { CompanyPage } from '@/features/post/pages/CompanyPage'
 import { SearchPage } from '@/features/search/pages/SearchPage'
 import './routes.css'
 
@@ -18,6 +19,10 @@ export function AppRoutes() {
           <Route path="/" element={<HomePage />} />
           <Route path="/create" element={<CreatePostPage />} />
           <Route path="/post/:id" element={<PostDetailPage />} />
+          {/* Also rendered by the backend for crawlers and link previews */}
+          <Route path="/p/:id" element={<PostDetailPage />} />
+          <Route path="/city/:code" element={<HomePage />} />
+          <Route path="/company/:name" element={<CompanyPage />} />
           <Route path="/search" element={<SearchPage />} />
         </Routes>
       </Content>
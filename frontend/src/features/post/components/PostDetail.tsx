@@ -3,6 +3,7 @@ import { Card, Spin, Empty, message, Tag, Typography, Space, Button } from 'antd
 import { useNavigate } from 'react-router-dom'
 import type { Post } from '@/shared/types'
 import { contentServiceClient } from '@/api/grpc/contentClient'
+import { takeInitialPost } from '@/shared/initialData'
 import dayjs from 'dayjs'
 import relativeTime from 'dayjs/plugin/relativeTime'
 import 'dayjs/locale/zh-cn'
@@ -24,6 +25,13 @@ export function PostDetail({ postId }: PostDetailProps) {
 
   useEffect(() => {
     const loadPost = async () => {
+      // Use the post of the server-rendered page on the first render
+      const initial = takeInitialPost(postId)
+      if (initial) {
+        setPost(initial)
+        return
+      }
+
       setLoading(true)
       try {
         const data = await contentServiceClient.getPost(postId)
@@ -57,7 +57,7 @@ export function PostForm({ onSuccess }: PostFormProps) {
         onSuccess()
       } else {
         // Navigate to post detail page
-        navigate(`/post/${response.postId}`)
+        navigate(`/p/${response.postId}`)
       }
     } catch (error) {
       console.error('Failed to create post:', error)
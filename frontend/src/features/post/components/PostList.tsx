@@ -2,6 +2,7 @@ import { useState, useEffect } from 'react'
 import { List, Card, Pagination, Empty, Spin, message, Tag, Typography, Space } from 'antd'
 import type { Post } from '@/shared/types'
 import { contentServiceClient } from '@/api/grpc/contentClient'
+import { takeInitialPosts } from '@/shared/initialData'
 import dayjs from 'dayjs'
 import relativeTime from 'dayjs/plugin/relativeTime'
 import 'dayjs/locale/zh-cn'
@@ -14,6 +15,7 @@ const { Text, Paragraph } = Typography
 
 interface PostListProps {
   cityCode?: string
+  company?: string
   initialPage?: number
   pageSize?: number
   onPostClick?: (postId: string) => void
@@ -21,6 +23,7 @@ interface PostListProps {
 
 export function PostList({
   cityCode = '',
+  company,
   initialPage = 1,
   pageSize = 20,
   onPostClick,
@@ -31,9 +34,17 @@ export function PostList({
   const [total, setTotal] = useState(0)
 
   const loadPosts = async (page: number) => {
+    // Use the list of the server-rendered page on the first render
+    const initial = takeInitialPosts(page)
+    if (initial) {
+      setPosts(initial.posts)
+      setTotal(initial.total)
+      return
+    }
+
     setLoading(true)
     try {
-      const response = await contentServiceClient.listPosts(cityCode, page, pageSize)
+      const response = await contentServiceClient.listPosts(cityCode, page, pageSize, company)
       setPosts(response.posts)
       setTotal(response.total)
       setCurrentPage(response.page)
@@ -49,7 +60,7 @@ export function PostList({
 
   useEffect(() => {
     loadPosts(currentPage)
-  }, [cityCode, currentPage, pageSize])
+  }, [cityCode, company, currentPage, pageSize])
 
   const handlePageChange = (page: number) => {
     setCurrentPage(page)
@@ -109,7 +120,7 @@ export function PostList({
                 ellipsis={{ rows: 3, expandable: false }}
                 className="post-list-content"
               >
-                {post.content}
+                {post.content || post.summary}
               </Paragraph>
               <div className="post-list-footer">
                 <Text type="secondary" className="post-list-time">
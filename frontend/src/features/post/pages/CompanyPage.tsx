@@ -0,0 +1,35 @@
+import { Card, Space, Typography } from 'antd'
+import { useNavigate, useParams, useSearchParams } from 'react-router-dom'
+import { PostList } from '../components/PostList'
+import './HomePage.css'
+
+const { Title } = Typography
+
+export function CompanyPage() {
+  const navigate = useNavigate()
+  const { name } = useParams<{ name: string }>()
+  const [searchParams] = useSearchParams()
+
+  if (!name) {
+    return <div>无效的公司名称</div>
+  }
+
+  return (
+    <div className="home-page">
+      <Card className="home-page-card">
+        <Space direction="vertical" className="home-page-content" size="large">
+          <div className="home-page-header">
+            <Title level={2} className="home-page-title">
+              {name}的曝光
+            </Title>
+          </div>
+          <PostList
+            company={name}
+            initialPage={Number(searchParams.get('page')) || 1}
+            onPostClick={(postId) => navigate(`/p/${postId}`)}
+          />
+        </Space>
+      </Card>
+    </div>
+  )
+}
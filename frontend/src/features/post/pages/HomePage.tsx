@@ -1,7 +1,7 @@
 import { useState } from 'react'
 import { Card, Select, Space, Typography, Input, Row, Col } from 'antd'
 import { SearchOutlined } from '@ant-design/icons'
-import { useNavigate } from 'react-router-dom'
+import { useNavigate, useParams, useSearchParams } from 'react-router-dom'
 import { PostList } from '../components/PostList'
 import { CITIES } from '@/shared/constants/cities'
 import './HomePage.css'
@@ -11,11 +11,13 @@ const { Search } = Input
 
 export function HomePage() {
   const navigate = useNavigate()
-  const [cityCode, setCityCode] = useState<string>('')
+  const { code } = useParams<{ code: string }>()
+  const [searchParams] = useSearchParams()
+  const [cityCode, setCityCode] = useState<string>(code || '')
   const [searchKeyword, setSearchKeyword] = useState<string>('')
 
   const handlePostClick = (postId: string) => {
-    navigate(`/post/${postId}`)
+    navigate(`/p/${postId}`)
   }
 
   const handleSearch = (value: string) => {
@@ -67,7 +69,11 @@ export function HomePage() {
               </Col>
             </Row>
           </div>
-          <PostList cityCode={cityCode} onPostClick={handlePostClick} />
+          <PostList
+            cityCode={cityCode}
+            initialPage={Number(searchParams.get('page')) || 1}
+            onPostClick={handlePostClick}
+          />
         </Space>
       </Card>
     </div>
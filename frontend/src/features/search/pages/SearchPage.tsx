@@ -40,7 +40,7 @@ export function SearchPage() {
   }
 
   const handlePostClick = (postId: string) => {
-    navigate(`/post/${postId}`)
+    navigate(`/p/${postId}`)
   }
 
   return (
@@ -0,0 +1,52 @@
+// Data embedded by the server-rendered pages (/p/:id, /city/:code, /company/:name)
+// in <script id="initial-data">, in the shape of the REST API responses.
+// It is used once, for the first render, so later navigation fetches fresh data.
+
+import type { Post, PostListResponse } from '@/shared/types'
+
+interface InitialData {
+  path: string
+  post?: Post
+  posts?: PostListResponse
+}
+
+let initialData: InitialData | null | undefined
+
+function readInitialData(): InitialData | null {
+  if (initialData === undefined) {
+    initialData = null
+    const element = document.getElementById('initial-data')
+    if (element?.textContent) {
+      try {
+        initialData = JSON.parse(element.textContent) as InitialData
+      } catch {
+        // Ignore malformed data and fetch from the API instead
+      }
+    }
+  }
+  // Only the page the server rendered may use the data
+  if (initialData && decodeURI(initialData.path) !== decodeURI(window.location.pathname)) {
+    return null
+  }
+  return initialData
+}
+
+// takeInitialPost returns the embedded post if the page was rendered for it
+export function takeInitialPost(postId: string): Post | undefined {
+  const data = readInitialData()
+  if (!data?.post || data.post.id !== postId) {
+    return undefined
+  }
+  initialData = null
+  return data.post
+}
+
+// takeInitialPosts returns the embedded list if the page was rendered for it
+export function takeInitialPosts(page: number): PostListResponse | undefined {
+  const data = readInitialData()
+  if (!data?.posts || data.posts.page !== page) {
+    return undefined
+  }
+  initialData = null
+  return data.posts
+}
@@ -7,6 +7,7 @@ export interface Post {
   cityCode: string
   cityName: string
   content: string
+  summary?: string // Plain-text summary (lists in the BASIC view have no content)
   occurredAt?: number // Unix timestamp (optional)
   createdAt: number // Unix timestamp
 }